@@ -32,6 +32,9 @@ import (
 	"github.com/milvus-io/milvus/internal/datanode"
 	"github.com/milvus-io/milvus/internal/indexcoord"
 	"github.com/milvus-io/milvus/internal/indexnode"
+	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
+	memkv "github.com/milvus-io/milvus/internal/kv/mem"
+	rocksdbkv "github.com/milvus-io/milvus/internal/kv/rocksdb"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/logutil"
 	"github.com/milvus-io/milvus/internal/metrics"
@@ -48,9 +51,33 @@ func newMsgFactory(localMsg bool) msgstream.Factory {
 	if localMsg {
 		return msgstream.NewRmsFactory()
 	}
+	paramtable.Params.Init()
+	if paramtable.Params.MqType == paramtable.MqTypeKafka {
+		return msgstream.NewKmsFactory(paramtable.Params.KafkaBrokerList)
+	}
 	return msgstream.NewPmsFactory()
 }
 
+// initLocalMetaStore creates the in-process meta store persisted in rocksdb, and makes all the
+// components of this process use it instead of etcd.
+func initLocalMetaStore() *memkv.MetaStore {
+	dir := paramtable.Params.MetaStoreLocalPath
+	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+		panic(err)
+	}
+	backend, err := rocksdbkv.NewRocksdbKV(dir)
+	if err != nil {
+		panic(err)
+	}
+	store, err := memkv.NewMetaStore(backend)
+	if err != nil {
+		panic(err)
+	}
+	etcdkv.SetLocalMetaStore(store)
+	log.Debug("use local meta store", zap.String("path", dir))
+	return store
+}
+
 type MilvusRoles struct {
 	EnableRootCoord      bool `env:"ENABLE_ROOT_COORD"`
 	EnableProxy          bool `env:"ENABLE_PROXY"`
@@ -366,6 +393,11 @@ func (mr *MilvusRoles) Run(localMsg bool, alias string) {
 		cfg := mr.setLogConfigFilename("standalone.log")
 		logutil.SetupLogger(cfg)
 		defer log.Sync()
+		metrics.RegisterRocksMQ()
+		if paramtable.Params.MetaStoreType == paramtable.MetaStoreTypeLocal {
+			store := initLocalMetaStore()
+			defer store.Close()
+		}
 	} else {
 		err := os.Setenv(metricsinfo.DeployModeEnvKey, metricsinfo.ClusterDeployMode)
 		if err != nil {
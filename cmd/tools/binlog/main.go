@@ -12,19 +12,472 @@
 package main
 
 import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"flag"
 	"fmt"
+	"io"
 	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
 
+	"github.com/golang/protobuf/proto"
+
+	"github.com/milvus-io/milvus/internal/datacoord"
+	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
+	miniokv "github.com/milvus-io/milvus/internal/kv/minio"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+)
+
+const (
+	formatText = "text"
+	formatJSON = "json"
+	formatCSV  = "csv"
 )
 
+func usage() {
+	fmt.Println("usage: binlog [options] list [-segment id] [-field id] key|prefix ...")
+	fmt.Println("       binlog [options] show [-segment id] [-field id] [-values] key|prefix ...")
+	fmt.Println("       binlog [options] verify [-collection id] [-segment id]")
+	fmt.Println("the binlogs are read from local files unless -minio is set, verify reads the segment infos from etcd and the binlogs from minio.")
+	fmt.Println("options:")
+	flag.PrintDefaults()
+}
+
+type tool struct {
+	chunkManager storage.ChunkManager
+	local        bool
+	format       string
+	out          io.Writer
+}
+
+func formatTs(ts storage.Timestamp) string {
+	physical, logical := tsoutil.ParseTS(ts)
+	return fmt.Sprintf("%d (%v, %d)", ts, physical, logical)
+}
+
+// resolve returns the keys of the arguments, an argument is either a key or a prefix of keys.
+func (t *tool) resolve(args []string) ([]string, error) {
+	var result []string
+	for _, arg := range args {
+		if t.local {
+			// the local chunk manager is rooted at "/", so the keys are absolute paths without the leading slash
+			abs, err := filepath.Abs(arg)
+			if err != nil {
+				return nil, err
+			}
+			if strings.HasSuffix(arg, "/") {
+				abs += "/"
+			}
+			arg = strings.TrimPrefix(abs, "/")
+		}
+		keys, err := t.chunkManager.ListWithPrefix(arg)
+		if err != nil {
+			return nil, err
+		}
+		exact := false
+		for _, key := range keys {
+			if key == arg {
+				exact = true
+				break
+			}
+		}
+		if exact {
+			result = append(result, arg)
+		} else {
+			sort.Strings(keys)
+			result = append(result, keys...)
+		}
+	}
+	return result, nil
+}
+
+// parseBinlogKey returns the segment and field of a binlog from its key, which is
+// ${root}/{insert_log|stats_log}/${collection_id}/${partition_id}/${segment_id}/${field_id}/${log_idx}
+// if saved by data nodes, ok is false if the key is not in the layout, e.g. a local file copied elsewhere.
+func parseBinlogKey(key string) (segmentID, fieldID int64, ok bool) {
+	parts := strings.Split(key, "/")
+	if len(parts) < 6 {
+		return 0, 0, false
+	}
+	parts = parts[len(parts)-6:]
+	if parts[0] != "insert_log" && parts[0] != "stats_log" {
+		return 0, 0, false
+	}
+	segmentID, err := strconv.ParseInt(parts[3], 10, 64)
+	if err != nil {
+		return 0, 0, false
+	}
+	fieldID, err = strconv.ParseInt(parts[4], 10, 64)
+	if err != nil {
+		return 0, 0, false
+	}
+	return segmentID, fieldID, true
+}
+
+func matchFilter(segmentID, fieldID, segmentFilter, fieldFilter int64) bool {
+	return (segmentFilter < 0 || segmentID == segmentFilter) && (fieldFilter < 0 || fieldID == fieldFilter)
+}
+
+// inspect reads the binlogs of the keys, the binlogs not matching the segment and field filters are skipped.
+// The filters are checked on the keys first, so that the binlogs filtered out by their keys are not downloaded.
+func (t *tool) inspect(keys []string, segmentID, fieldID int64, withValues bool) ([]*storage.BinlogInfo, error) {
+	var infos []*storage.BinlogInfo
+	for _, key := range keys {
+		if keySegmentID, keyFieldID, ok := parseBinlogKey(key); ok && !matchFilter(keySegmentID, keyFieldID, segmentID, fieldID) {
+			continue
+		}
+		data, err := t.chunkManager.Read(key)
+		if err != nil {
+			return nil, err
+		}
+		info, err := storage.InspectBinlog(key, data, withValues)
+		if err != nil {
+			return nil, fmt.Errorf("inspect binlog %s failed, %w", key, err)
+		}
+		if !matchFilter(info.SegmentID, info.FieldID, segmentID, fieldID) {
+			continue
+		}
+		infos = append(infos, info)
+	}
+	return infos, nil
+}
+
+func (t *tool) writeJSON(v interface{}) error {
+	encoder := json.NewEncoder(t.out)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(v)
+}
+
+func (t *tool) writeCSV(records [][]string) error {
+	writer := csv.NewWriter(t.out)
+	if err := writer.WriteAll(records); err != nil {
+		return err
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+func i64(v int64) string {
+	return strconv.FormatInt(v, 10)
+}
+
+func u64(v uint64) string {
+	return strconv.FormatUint(v, 10)
+}
+
+func (t *tool) list(args []string) error {
+	fs := flag.NewFlagSet("list", flag.ExitOnError)
+	segmentID := fs.Int64("segment", -1, "only list the binlogs of the segment")
+	fieldID := fs.Int64("field", -1, "only list the binlogs of the field")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	keys, err := t.resolve(fs.Args())
+	if err != nil {
+		return err
+	}
+	infos, err := t.inspect(keys, *segmentID, *fieldID, false)
+	if err != nil {
+		return err
+	}
+
+	switch t.format {
+	case formatJSON:
+		for _, info := range infos {
+			info.Events = nil
+		}
+		return t.writeJSON(infos)
+	case formatCSV:
+		records := [][]string{{"key", "size", "formatVersion", "collectionID", "partitionID", "segmentID", "fieldID",
+			"dataType", "startTimestamp", "endTimestamp", "events", "rows"}}
+		for _, info := range infos {
+			records = append(records, []string{info.Key, strconv.Itoa(info.Size), i64(info.FormatVersion),
+				i64(info.CollectionID), i64(info.PartitionID), i64(info.SegmentID), i64(info.FieldID),
+				info.PayloadDataType, u64(info.StartTimestamp), u64(info.EndTimestamp),
+				strconv.Itoa(len(info.Events)), i64(info.RowNum)})
+		}
+		return t.writeCSV(records)
+	default:
+		for _, info := range infos {
+			fmt.Fprintf(t.out, "%s: size: %d, version: %d, collection: %d, partition: %d, segment: %d, field: %d, type: %s, events: %d, rows: %d\n",
+				info.Key, info.Size, info.FormatVersion, info.CollectionID, info.PartitionID, info.SegmentID, info.FieldID,
+				info.PayloadDataType, len(info.Events), info.RowNum)
+		}
+		fmt.Fprintf(t.out, "list %d binlogs complete.\n", len(infos))
+	}
+	return nil
+}
+
+func (t *tool) show(args []string) error {
+	fs := flag.NewFlagSet("show", flag.ExitOnError)
+	segmentID := fs.Int64("segment", -1, "only show the binlogs of the segment")
+	fieldID := fs.Int64("field", -1, "only show the binlogs of the field")
+	withValues := fs.Bool("values", false, "decode the payload values")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	keys, err := t.resolve(fs.Args())
+	if err != nil {
+		return err
+	}
+	infos, err := t.inspect(keys, *segmentID, *fieldID, *withValues)
+	if err != nil {
+		return err
+	}
+
+	switch t.format {
+	case formatJSON:
+		return t.writeJSON(infos)
+	case formatCSV:
+		records := [][]string{{"key", "event", "type", "startTimestamp", "endTimestamp", "row", "value"}}
+		for _, info := range infos {
+			for i, event := range info.Events {
+				prefix := []string{info.Key, strconv.Itoa(i), event.TypeCode, u64(event.StartTimestamp), u64(event.EndTimestamp)}
+				if len(event.Values) == 0 {
+					records = append(records, append(prefix, "", ""))
+				}
+				for row, value := range event.Values {
+					records = append(records, append(append([]string{}, prefix...), strconv.Itoa(row), formatValue(value)))
+				}
+			}
+		}
+		return t.writeCSV(records)
+	default:
+		for _, info := range infos {
+			t.printBinlog(info)
+		}
+	}
+	return nil
+}
+
+func formatValue(value interface{}) string {
+	switch v := value.(type) {
+	case *storage.DeleteLogEntry:
+		return fmt.Sprintf("pk: %s, ts: %s", v.PrimaryKey, formatTs(v.Timestamp))
+	case []float32:
+		strs := make([]string, 0, len(v))
+		for _, f := range v {
+			strs = append(strs, strconv.FormatFloat(float64(f), 'f', -1, 32))
+		}
+		return "[" + strings.Join(strs, " ") + "]"
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
+
+func (t *tool) printBinlog(info *storage.BinlogInfo) {
+	fmt.Fprintf(t.out, "binlog: %s\n", info.Key)
+	fmt.Fprintf(t.out, "\tSize: %d\n", info.Size)
+	fmt.Fprintf(t.out, "\tFormatVersion: %d\n", info.FormatVersion)
+	fmt.Fprintf(t.out, "\tCollectionID: %d\n", info.CollectionID)
+	fmt.Fprintf(t.out, "\tPartitionID: %d\n", info.PartitionID)
+	fmt.Fprintf(t.out, "\tSegmentID: %d\n", info.SegmentID)
+	fmt.Fprintf(t.out, "\tFieldID: %d\n", info.FieldID)
+	fmt.Fprintf(t.out, "\tPayloadDataType: %s\n", info.PayloadDataType)
+	fmt.Fprintf(t.out, "\tStartTimestamp: %s\n", formatTs(info.StartTimestamp))
+	fmt.Fprintf(t.out, "\tEndTimestamp: %s\n", formatTs(info.EndTimestamp))
+	fmt.Fprintf(t.out, "\tRows: %d\n", info.RowNum)
+	extraKeys := make([]string, 0, len(info.Extras))
+	for k := range info.Extras {
+		extraKeys = append(extraKeys, k)
+	}
+	sort.Strings(extraKeys)
+	fmt.Fprintln(t.out, "\tExtras:")
+	for _, k := range extraKeys {
+		fmt.Fprintf(t.out, "\t\t%s: %v\n", k, info.Extras[k])
+	}
+	for i, event := range info.Events {
+		fmt.Fprintf(t.out, "\tevent %d:\n", i)
+		fmt.Fprintf(t.out, "\t\tTypeCode: %s\n", event.TypeCode)
+		fmt.Fprintf(t.out, "\t\tTimestamp: %s\n", formatTs(event.Timestamp))
+		fmt.Fprintf(t.out, "\t\tEventLength: %d\n", event.EventLength)
+		fmt.Fprintf(t.out, "\t\tNextPosition: %d\n", event.NextPosition)
+		fmt.Fprintf(t.out, "\t\tStartTimestamp: %s\n", formatTs(event.StartTimestamp))
+		fmt.Fprintf(t.out, "\t\tEndTimestamp: %s\n", formatTs(event.EndTimestamp))
+		fmt.Fprintf(t.out, "\t\tRows: %d\n", event.RowNum)
+		if len(event.Values) > 0 {
+			fmt.Fprintln(t.out, "\t\tpayload values:")
+			for row, value := range event.Values {
+				fmt.Fprintf(t.out, "\t\t\t%d : %s\n", row, formatValue(value))
+			}
+		}
+	}
+}
+
+type verifyResult struct {
+	CollectionID int64  `json:"collectionID"`
+	PartitionID  int64  `json:"partitionID"`
+	SegmentID    int64  `json:"segmentID"`
+	State        string `json:"state"`
+	FieldID      int64  `json:"fieldID"`
+	Binlogs      int    `json:"binlogs"`
+	NumOfRows    int64  `json:"numOfRows"`
+	BinlogRows   int64  `json:"binlogRows"`
+	Match        bool   `json:"match"`
+}
+
+func loadSegmentInfos(etcdKV *etcdkv.EtcdKV) ([]*datapb.SegmentInfo, error) {
+	_, values, err := etcdKV.LoadWithPrefix(datacoord.SegmentPrefix)
+	if err != nil {
+		return nil, err
+	}
+	segments := make([]*datapb.SegmentInfo, 0, len(values))
+	for _, value := range values {
+		segment := &datapb.SegmentInfo{}
+		if err := proto.Unmarshal([]byte(value), segment); err != nil {
+			return nil, err
+		}
+		segments = append(segments, segment)
+	}
+	sort.Slice(segments, func(i, j int) bool { return segments[i].ID < segments[j].ID })
+	return segments, nil
+}
+
+// verify checks the row count of the binlogs of each field against the num_of_rows of the segment info.
+func (t *tool) verify(etcdKV *etcdkv.EtcdKV, args []string) error {
+	fs := flag.NewFlagSet("verify", flag.ExitOnError)
+	collectionID := fs.Int64("collection", -1, "only verify the segments of the collection")
+	segmentID := fs.Int64("segment", -1, "only verify the segment")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	segments, err := loadSegmentInfos(etcdKV)
+	if err != nil {
+		return err
+	}
+
+	var results []*verifyResult
+	mismatched := 0
+	for _, segment := range segments {
+		if (*collectionID >= 0 && segment.CollectionID != *collectionID) || (*segmentID >= 0 && segment.ID != *segmentID) {
+			continue
+		}
+		for _, fieldBinlog := range segment.Binlogs {
+			infos, err := t.inspect(fieldBinlog.Binlogs, -1, -1, false)
+			if err != nil {
+				return err
+			}
+			result := &verifyResult{
+				CollectionID: segment.CollectionID,
+				PartitionID:  segment.PartitionID,
+				SegmentID:    segment.ID,
+				State:        segment.State.String(),
+				FieldID:      fieldBinlog.FieldID,
+				Binlogs:      len(fieldBinlog.Binlogs),
+				NumOfRows:    segment.NumOfRows,
+			}
+			for _, info := range infos {
+				result.BinlogRows += info.RowNum
+			}
+			result.Match = result.BinlogRows == result.NumOfRows
+			if !result.Match {
+				mismatched++
+			}
+			results = append(results, result)
+		}
+	}
+
+	switch t.format {
+	case formatJSON:
+		err = t.writeJSON(results)
+	case formatCSV:
+		records := [][]string{{"collectionID", "partitionID", "segmentID", "state", "fieldID", "binlogs", "numOfRows", "binlogRows", "match"}}
+		for _, r := range results {
+			records = append(records, []string{i64(r.CollectionID), i64(r.PartitionID), i64(r.SegmentID), r.State,
+				i64(r.FieldID), strconv.Itoa(r.Binlogs), i64(r.NumOfRows), i64(r.BinlogRows), strconv.FormatBool(r.Match)})
+		}
+		err = t.writeCSV(records)
+	default:
+		for _, r := range results {
+			status := "ok"
+			if !r.Match {
+				status = "MISMATCH"
+			}
+			fmt.Fprintf(t.out, "%s: collection: %d, partition: %d, segment: %d, state: %s, field: %d, binlogs: %d, num_of_rows: %d, binlog rows: %d\n",
+				status, r.CollectionID, r.PartitionID, r.SegmentID, r.State, r.FieldID, r.Binlogs, r.NumOfRows, r.BinlogRows)
+		}
+		fmt.Fprintf(t.out, "verify %d field binlogs complete, mismatched: %d.\n", len(results), mismatched)
+	}
+	if err != nil {
+		return err
+	}
+	if mismatched > 0 {
+		return fmt.Errorf("%d field binlogs mismatch the num_of_rows of segment", mismatched)
+	}
+	return nil
+}
+
 func main() {
-	if len(os.Args) == 1 {
-		fmt.Println("usage: binlog file1 file2 ...")
+	minioAddress := flag.String("minio", "", "minio address, the binlogs are read from local files if empty")
+	accessKey := flag.String("access-key", "minioadmin", "minio access key id")
+	secretKey := flag.String("secret-key", "minioadmin", "minio secret access key")
+	bucket := flag.String("bucket", "a-bucket", "minio bucket name")
+	useSSL := flag.Bool("ssl", false, "connect minio with ssl")
+	etcdEndpoints := flag.String("etcd", "localhost:2379", "etcd endpoints, separated by comma")
+	metaRoot := flag.String("meta", "by-dev/meta", "the meta root path in etcd")
+	format := flag.String("format", formatText, "output format, text, json or csv")
+	flag.Parse()
+	if flag.NArg() == 0 {
+		usage()
+		os.Exit(1)
 	}
-	if err := storage.PrintBinlogFiles(os.Args[1:]); err != nil {
-		fmt.Printf("error: %s\n", err.Error())
+	if *format != formatText && *format != formatJSON && *format != formatCSV {
+		fmt.Printf("error: unknown format %s\n", *format)
+		os.Exit(1)
+	}
+
+	t := &tool{
+		format: *format,
+		out:    os.Stdout,
+	}
+	if *minioAddress == "" {
+		t.chunkManager = storage.NewLocalChunkManager("/")
+		t.local = true
 	} else {
-		fmt.Printf("print binlog complete.\n")
+		chunkManager, err := storage.NewMinioChunkManager(context.Background(), &miniokv.Option{
+			Address:           *minioAddress,
+			AccessKeyID:       *accessKey,
+			SecretAccessKeyID: *secretKey,
+			BucketName:        *bucket,
+			UseSSL:            *useSSL,
+		})
+		if err != nil {
+			fmt.Printf("error: %s\n", err.Error())
+			os.Exit(1)
+		}
+		t.chunkManager = chunkManager
+	}
+
+	var err error
+	args := flag.Args()[1:]
+	switch flag.Arg(0) {
+	case "list":
+		err = t.list(args)
+	case "show":
+		err = t.show(args)
+	case "verify":
+		if t.local {
+			err = fmt.Errorf("verify reads the binlogs from minio, please set -minio")
+			break
+		}
+		var etcdKV *etcdkv.EtcdKV
+		etcdKV, err = etcdkv.NewEtcdKV(strings.Split(*etcdEndpoints, ","), *metaRoot)
+		if err == nil {
+			err = t.verify(etcdKV, args)
+			etcdKV.Close()
+		}
+	default:
+		usage()
+		os.Exit(1)
+	}
+	if err != nil {
+		fmt.Printf("error: %s\n", err.Error())
+		os.Exit(1)
 	}
 }
@@ -0,0 +1,235 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package main
+
+import (
+	"context"
+	"encoding/binary"
+	"flag"
+	"fmt"
+	"os"
+	"path"
+	"strings"
+
+	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
+	miniokv "github.com/milvus-io/milvus/internal/kv/minio"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/migration"
+)
+
+func usage() {
+	fmt.Println("usage: migration [-etcd endpoints] [-meta root] status")
+	fmt.Println("       migration [-etcd endpoints] [-meta root] upgrade-meta [-dry-run]")
+	fmt.Println("       migration [-minio address] [-bucket name] ... upgrade-binlog [-dry-run] [-backup root] [prefix ...]")
+	fmt.Println("       migration [-minio address] [-bucket name] ... restore-binlog [-dry-run] [-backup root]")
+	fmt.Println("all the milvus components must be stopped before upgrading.")
+	flag.PrintDefaults()
+}
+
+func metaStatus(etcdKV *etcdkv.EtcdKV) error {
+	for _, schema := range migration.CoordinatorSchemas() {
+		version, saved, err := schema.CurrentVersion(etcdKV)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("%s: meta version: %d, saved: %t, latest version: %d, min supported version: %d\n",
+			schema.Component, version, saved, schema.Version, schema.MinSupportedVersion)
+	}
+	return nil
+}
+
+func upgradeMeta(etcdKV *etcdkv.EtcdKV, args []string) error {
+	fs := flag.NewFlagSet("upgrade-meta", flag.ExitOnError)
+	dryRun := fs.Bool("dry-run", false, "only print the migrations to run")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	for _, schema := range migration.CoordinatorSchemas() {
+		migrations, err := schema.Upgrade(etcdKV, *dryRun)
+		for _, m := range migrations {
+			fmt.Printf("%s: migrate meta from version %d to %d: %s\n", schema.Component, m.From, m.From+1, m.Desc)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	fmt.Printf("upgrade meta complete, dry run = %t.\n", *dryRun)
+	return nil
+}
+
+func isBinlog(data []byte) bool {
+	return len(data) >= binary.Size(storage.MagicNumber) &&
+		int32(binary.LittleEndian.Uint32(data)) == storage.MagicNumber
+}
+
+// defaultBinlogBackupRoot is the path the original binlogs are copied to before they are upgraded in place.
+const defaultBinlogBackupRoot = "files/binlog_backup"
+
+func isUnder(key, root string) bool {
+	return key == root || strings.HasPrefix(key, strings.TrimSuffix(root, "/")+"/")
+}
+
+// upgradeBinlog upgrades the binlogs under the prefixes in place, the original binlog is copied under the backup root
+// before it's overwritten, so it can be restored by restore-binlog.
+func upgradeBinlog(ctx context.Context, option *miniokv.Option, args []string) error {
+	fs := flag.NewFlagSet("upgrade-binlog", flag.ExitOnError)
+	dryRun := fs.Bool("dry-run", false, "only print the binlogs to upgrade")
+	backupRoot := fs.String("backup", defaultBinlogBackupRoot, "the path the original binlogs are copied to")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *backupRoot == "" {
+		return fmt.Errorf("the backup root of the binlogs is empty")
+	}
+	prefixes := fs.Args()
+	if len(prefixes) == 0 {
+		prefixes = []string{"files/insert_log", "files/delta_log", "files/index_files"}
+	}
+	for _, prefix := range prefixes {
+		if isUnder(*backupRoot, prefix) || isUnder(prefix, *backupRoot) {
+			return fmt.Errorf("the backup root %s overlaps the binlog prefix %s", *backupRoot, prefix)
+		}
+	}
+
+	chunkManager, err := storage.NewMinioChunkManager(ctx, option)
+	if err != nil {
+		return err
+	}
+	upgradedNum, skippedNum := 0, 0
+	for _, prefix := range prefixes {
+		keys, err := chunkManager.ListWithPrefix(prefix)
+		if err != nil {
+			return err
+		}
+		for _, key := range keys {
+			data, err := chunkManager.Read(key)
+			if err != nil {
+				return err
+			}
+			if !isBinlog(data) {
+				skippedNum++
+				continue
+			}
+			upgraded, ok, err := storage.UpgradeBinlog(data)
+			if err != nil {
+				return fmt.Errorf("upgrade binlog %s failed, %w", key, err)
+			}
+			if !ok {
+				continue
+			}
+			backupKey := path.Join(*backupRoot, key)
+			fmt.Printf("upgrade binlog: %s, backup: %s\n", key, backupKey)
+			if !*dryRun {
+				if err := chunkManager.Write(backupKey, data); err != nil {
+					return fmt.Errorf("backup binlog %s failed, %w", key, err)
+				}
+				if err := chunkManager.Write(key, upgraded); err != nil {
+					return err
+				}
+			}
+			upgradedNum++
+		}
+	}
+	fmt.Printf("upgrade binlog complete, upgraded: %d, skipped non-binlog files: %d, backup root: %s, dry run = %t.\n",
+		upgradedNum, skippedNum, *backupRoot, *dryRun)
+	return nil
+}
+
+// restoreBinlog copies the original binlogs under the backup root back to their paths.
+func restoreBinlog(ctx context.Context, option *miniokv.Option, args []string) error {
+	fs := flag.NewFlagSet("restore-binlog", flag.ExitOnError)
+	dryRun := fs.Bool("dry-run", false, "only print the binlogs to restore")
+	backupRoot := fs.String("backup", defaultBinlogBackupRoot, "the path the original binlogs are copied to")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *backupRoot == "" {
+		return fmt.Errorf("the backup root of the binlogs is empty")
+	}
+
+	chunkManager, err := storage.NewMinioChunkManager(ctx, option)
+	if err != nil {
+		return err
+	}
+	root := strings.TrimSuffix(*backupRoot, "/") + "/"
+	backupKeys, err := chunkManager.ListWithPrefix(root)
+	if err != nil {
+		return err
+	}
+	for _, backupKey := range backupKeys {
+		key := strings.TrimPrefix(backupKey, root)
+		fmt.Printf("restore binlog: %s, backup: %s\n", key, backupKey)
+		if *dryRun {
+			continue
+		}
+		data, err := chunkManager.Read(backupKey)
+		if err != nil {
+			return err
+		}
+		if err := chunkManager.Write(key, data); err != nil {
+			return err
+		}
+	}
+	fmt.Printf("restore binlog complete, restored: %d, dry run = %t.\n", len(backupKeys), *dryRun)
+	return nil
+}
+
+func main() {
+	etcdEndpoints := flag.String("etcd", "localhost:2379", "etcd endpoints, separated by comma")
+	metaRoot := flag.String("meta", "by-dev/meta", "the meta root path in etcd")
+	minioAddress := flag.String("minio", "localhost:9000", "minio address")
+	accessKey := flag.String("access-key", "minioadmin", "minio access key id")
+	secretKey := flag.String("secret-key", "minioadmin", "minio secret access key")
+	bucket := flag.String("bucket", "a-bucket", "minio bucket name")
+	useSSL := flag.Bool("ssl", false, "connect minio with ssl")
+	flag.Parse()
+	if flag.NArg() == 0 {
+		usage()
+		os.Exit(1)
+	}
+
+	var err error
+	switch cmd := flag.Arg(0); cmd {
+	case "status", "upgrade-meta":
+		var etcdKV *etcdkv.EtcdKV
+		etcdKV, err = etcdkv.NewEtcdKV(strings.Split(*etcdEndpoints, ","), *metaRoot)
+		if err != nil {
+			break
+		}
+		if cmd == "status" {
+			err = metaStatus(etcdKV)
+		} else {
+			err = upgradeMeta(etcdKV, flag.Args()[1:])
+		}
+		etcdKV.Close()
+	case "upgrade-binlog", "restore-binlog":
+		option := &miniokv.Option{
+			Address:           *minioAddress,
+			AccessKeyID:       *accessKey,
+			SecretAccessKeyID: *secretKey,
+			BucketName:        *bucket,
+			UseSSL:            *useSSL,
+		}
+		if cmd == "upgrade-binlog" {
+			err = upgradeBinlog(context.Background(), option, flag.Args()[1:])
+		} else {
+			err = restoreBinlog(context.Background(), option, flag.Args()[1:])
+		}
+	default:
+		usage()
+		os.Exit(1)
+	}
+	if err != nil {
+		fmt.Printf("error: %s\n", err.Error())
+		os.Exit(1)
+	}
+}
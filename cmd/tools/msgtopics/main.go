@@ -0,0 +1,121 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	rcc "github.com/milvus-io/milvus/internal/distributed/rootcoord/client"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+)
+
+func usage() {
+	fmt.Println("usage: msgtopics [-etcd endpoints] [-meta root] list")
+	fmt.Println("       msgtopics [-etcd endpoints] [-meta root] purge [-dry-run]")
+	flag.PrintDefaults()
+}
+
+func printTopic(topic *rootcoordpb.MsgTopic) {
+	fmt.Printf("topic: %s, referenced: %t\n", topic.Name, topic.Referenced)
+	for _, sub := range topic.Subscriptions {
+		fmt.Printf("\tsubscription: %s, backlog: %d, consumers: %d\n", sub.Name, sub.Backlog, sub.Consumers)
+	}
+}
+
+func run(ctx context.Context, client *rcc.GrpcClient, cmd string, args []string) error {
+	switch cmd {
+	case "list":
+		resp, err := client.ListMsgTopics(ctx, &rootcoordpb.ListMsgTopicsRequest{
+			Base: &commonpb.MsgBase{MsgType: commonpb.MsgType_Undefined},
+		})
+		if err != nil {
+			return err
+		}
+		if resp.Status.ErrorCode != commonpb.ErrorCode_Success {
+			return fmt.Errorf("list msg topics failed, reason = %s", resp.Status.Reason)
+		}
+		for _, topic := range resp.Topics {
+			printTopic(topic)
+		}
+		fmt.Printf("list %d msg topics complete.\n", len(resp.Topics))
+	case "purge":
+		fs := flag.NewFlagSet("purge", flag.ExitOnError)
+		dryRun := fs.Bool("dry-run", false, "only print the topics and subscriptions to be purged")
+		if err := fs.Parse(args); err != nil {
+			return err
+		}
+		resp, err := client.PurgeMsgTopics(ctx, &rootcoordpb.PurgeMsgTopicsRequest{
+			Base:   &commonpb.MsgBase{MsgType: commonpb.MsgType_Undefined},
+			DryRun: *dryRun,
+		})
+		if err != nil {
+			return err
+		}
+		for _, topic := range resp.PurgedTopics {
+			fmt.Printf("purged topic: %s\n", topic)
+		}
+		for _, topic := range resp.PurgedSubscriptions {
+			for _, sub := range topic.Subscriptions {
+				fmt.Printf("purged subscription: %s of topic: %s\n", sub.Name, topic.Name)
+			}
+		}
+		if resp.Status.ErrorCode != commonpb.ErrorCode_Success {
+			return fmt.Errorf("purge msg topics failed, reason = %s", resp.Status.Reason)
+		}
+		fmt.Printf("purge msg topics complete, dry run = %t.\n", *dryRun)
+	default:
+		usage()
+	}
+	return nil
+}
+
+func connect(ctx context.Context, metaRoot string, etcdEndpoints []string) (*rcc.GrpcClient, error) {
+	client, err := rcc.NewClient(ctx, metaRoot, etcdEndpoints)
+	if err != nil {
+		return nil, err
+	}
+	if err = client.Init(); err != nil {
+		return nil, err
+	}
+	if err = client.Start(); err != nil {
+		return nil, err
+	}
+	return client, nil
+}
+
+func main() {
+	etcdEndpoints := flag.String("etcd", "localhost:2379", "etcd endpoints, separated by comma")
+	metaRoot := flag.String("meta", "by-dev/meta", "the meta root path in etcd")
+	flag.Parse()
+	if flag.NArg() == 0 {
+		usage()
+		os.Exit(1)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	client, err := connect(ctx, *metaRoot, strings.Split(*etcdEndpoints, ","))
+	if err == nil {
+		err = run(ctx, client, flag.Arg(0), flag.Args()[1:])
+		client.Stop()
+	}
+	cancel()
+	if err != nil {
+		fmt.Printf("error: %s\n", err.Error())
+		os.Exit(1)
+	}
+}
@@ -26,3 +26,26 @@ const (
 	TimeStampFieldName = "Timestamp"
 	DefaultShardsNum   = int32(2)
 )
+
+const (
+	// DefaultRootUsername is the username of the builtin user created when authorization is first enabled
+	DefaultRootUsername = "root"
+	// DefaultRootPassword is the initial password of the builtin root user
+	DefaultRootPassword = "Milvus"
+
+	// HeaderAuthorize is the grpc metadata key carrying the credential, whose value is base64("username:password")
+	HeaderAuthorize = "authorization"
+	// CredentialSeperator separates username and password in the authorization header
+	CredentialSeperator = ":"
+
+	// RoleAdmin is the builtin role owning all privileges, the root user is bound to it
+	RoleAdmin = "admin"
+	// RolePublic is the builtin role every user is implicitly bound to
+	RolePublic = "public"
+	// AnyWord matches all the object names when used as the object name of a grant
+	AnyWord = "*"
+
+	MaxUsernameLength = 32
+	MinPasswordLength = 6
+	MaxPasswordLength = 256
+)
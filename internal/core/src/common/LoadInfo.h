@@ -17,6 +17,7 @@
 
 struct LoadIndexInfo {
     int64_t field_id;
+    int64_t index_id = 0;
     std::map<std::string, std::string> index_params;
     milvus::knowhere::VecIndexPtr index;
 };
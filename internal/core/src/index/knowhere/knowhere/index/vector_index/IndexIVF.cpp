@@ -70,6 +70,19 @@ IVF::Load(const BinarySet& binary_set) {
     }
 }
 
+void
+IVF::LoadFromFile(const std::string& path) {
+    // the inverted lists are mapped from the file, the codes and ids are paged in by the OS on search
+    faiss::Index* index = faiss::read_index(path.c_str(), faiss::IO_FLAG_MMAP | faiss::IO_FLAG_READ_ONLY);
+    index_.reset(index);
+    SealImpl();
+
+    if (IndexMode() == IndexMode::MODE_CPU && STATISTICS_LEVEL >= 3) {
+        auto ivf_index = static_cast<faiss::IndexIVF*>(index_.get());
+        ivf_index->nprobe_statistics.resize(ivf_index->nlist, 0);
+    }
+}
+
 void
 IVF::Train(const DatasetPtr& dataset_ptr, const Config& config) {
     GET_TENSOR_DATA_DIM(dataset_ptr)
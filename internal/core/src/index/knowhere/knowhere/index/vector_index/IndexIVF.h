@@ -12,6 +12,7 @@
 #pragma once
 
 #include <memory>
+#include <string>
 #include <utility>
 #include <vector>
 
@@ -42,6 +43,9 @@ class IVF : public VecIndex, public FaissBaseIndex {
     void
     Load(const BinarySet&) override;
 
+    void
+    LoadFromFile(const std::string& path) override;
+
     void
     Train(const DatasetPtr&, const Config&) override;
 
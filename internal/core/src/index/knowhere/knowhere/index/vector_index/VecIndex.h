@@ -14,6 +14,7 @@
 #include <faiss/utils/BitsetView.h>
 #include <faiss/utils/ConcurrentBitset.h>
 #include <memory>
+#include <string>
 #include <utility>
 #include <vector>
 
@@ -49,6 +50,13 @@ class VecIndex : public Index {
     virtual DatasetPtr
     Query(const DatasetPtr& dataset, const Config& config, const faiss::BitsetView bitset) = 0;
 
+    // load the index serialized under its binary name from a local file, the index types supporting it keep
+    // their bulk data mapped from the file instead of copied into memory
+    virtual void
+    LoadFromFile(const std::string& path) {
+        KNOWHERE_THROW_MSG("index type " + index_type_ + " can't be loaded from file");
+    }
+
     virtual int64_t
     Dim() = 0;
 
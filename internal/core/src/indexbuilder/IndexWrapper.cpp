@@ -13,7 +13,10 @@
 #include <exception>
 #include <google/protobuf/text_format.h>
 
+#include <faiss/IndexIVF.h>
+
 #include "pb/index_cgo_msg.pb.h"
+#include "knowhere/index/vector_index/IndexIVF.h"
 #include "knowhere/index/vector_index/VecIndexFactory.h"
 #include "knowhere/index/vector_index/helpers/IndexParameter.h"
 #include "exceptions/EasyAssert.h"
@@ -267,8 +270,8 @@ IndexWrapper::Serialize() {
     return binary;
 }
 
-void
-IndexWrapper::Load(const char* serialized_sliced_blob_buffer, int32_t size) {
+static void
+LoadSlicedBuffer(const knowhere::VecIndexPtr& index, const char* serialized_sliced_blob_buffer, int32_t size) {
     namespace indexcgo = milvus::proto::indexcgo;
     auto data = std::string(serialized_sliced_blob_buffer, size);
     indexcgo::BinarySet blob_buffer;
@@ -286,7 +289,68 @@ IndexWrapper::Load(const char* serialized_sliced_blob_buffer, int32_t size) {
         binarySet.Append(binary.key(), bptr);
     }
 
-    index_->Load(binarySet);
+    index->Load(binarySet);
+}
+
+void
+IndexWrapper::Load(const char* serialized_sliced_blob_buffer, int32_t size) {
+    LoadSlicedBuffer(index_, serialized_sliced_blob_buffer, size);
+    // the raw data of nm indexes is loaded with the binary set, don't append the empty raw_data_ when querying
+    std::call_once(raw_data_loaded_, [] {});
+}
+
+void
+IndexWrapper::Train(const knowhere::DatasetPtr& dataset) {
+    auto index_type = get_index_type();
+    auto index_mode = get_index_mode();
+    AssertInfo(is_in_distributed_build_list(index_type), index_type + " doesn't support training separately");
+    config_[knowhere::meta::ROWS] = dataset->Get<int64_t>(knowhere::meta::ROWS);
+    if (index_type == knowhere::IndexEnum::INDEX_FAISS_IVFPQ) {
+        if (!config_.contains(knowhere::IndexParams::nbits)) {
+            config_[knowhere::IndexParams::nbits] = 8;
+        }
+    }
+    auto conf_adapter = knowhere::AdapterMgr::GetInstance().GetAdapter(index_type);
+    AssertInfo(conf_adapter->CheckTrain(config_, index_mode), "something wrong in index parameters!");
+
+    knowhere::TimeRecorder rc("Train", 1);
+    index_->Train(dataset, config_);
+    rc.ElapseFromBegin("Done");
+}
+
+void
+IndexWrapper::AddWithoutIds(const knowhere::DatasetPtr& dataset) {
+    auto index_type = get_index_type();
+    AssertInfo(is_in_distributed_build_list(index_type), index_type + " doesn't support adding to a trained index");
+
+    knowhere::TimeRecorder rc("AddWithoutIds", 1);
+    index_->AddWithoutIds(dataset, config_);
+    rc.ElapseFromBegin("Done");
+}
+
+/*
+ * brief Append the vectors of another index trained with the same centroids, the ids of the appended vectors
+ * follow the vectors already in the index
+ */
+void
+IndexWrapper::Merge(const char* serialized_sliced_blob_buffer, int32_t size) {
+    auto index_type = get_index_type();
+    AssertInfo(is_in_distributed_build_list(index_type), index_type + " doesn't support merging");
+
+    auto other = knowhere::VecIndexFactory::GetInstance().CreateVecIndex(index_type, get_index_mode());
+    AssertInfo(other != nullptr, "[IndexWrapper]Index is null after create index");
+    LoadSlicedBuffer(other, serialized_sliced_blob_buffer, size);
+
+    auto ivf = std::dynamic_pointer_cast<knowhere::IVF>(index_);
+    auto other_ivf = std::dynamic_pointer_cast<knowhere::IVF>(other);
+    AssertInfo(ivf != nullptr && other_ivf != nullptr, "[IndexWrapper]" + index_type + " isn't an IVF index");
+    auto dst = dynamic_cast<faiss::IndexIVF*>(ivf->index_.get());
+    auto src = dynamic_cast<faiss::IndexIVF*>(other_ivf->index_.get());
+    AssertInfo(dst != nullptr && src != nullptr, "[IndexWrapper]Can't merge into an index which isn't loaded");
+
+    knowhere::TimeRecorder rc("Merge", 1);
+    dst->merge_from(*src, dst->ntotal);
+    rc.ElapseFromBegin("Done");
 }
 
 std::string
@@ -357,7 +421,7 @@ IndexWrapper::QueryImpl(const knowhere::DatasetPtr& dataset, const knowhere::Con
     auto ids = res->Get<int64_t*>(milvus::knowhere::meta::IDS);
     auto distances = res->Get<float*>(milvus::knowhere::meta::DISTANCE);
     auto nq = dataset->Get<int64_t>(milvus::knowhere::meta::ROWS);
-    auto k = config_[milvus::knowhere::meta::TOPK].get<int64_t>();
+    auto k = conf[milvus::knowhere::meta::TOPK].get<int64_t>();
 
     auto query_res = std::make_unique<IndexWrapper::QueryResult>();
     query_res->nq = nq;
@@ -39,6 +39,16 @@ class IndexWrapper {
     void
     Load(const char* serialized_sliced_blob_buffer, int32_t size);
 
+    // Train, AddWithoutIds and Merge build an index step by step, only for the index types in Distributed_Build_List
+    void
+    Train(const knowhere::DatasetPtr& dataset);
+
+    void
+    AddWithoutIds(const knowhere::DatasetPtr& dataset);
+
+    void
+    Merge(const char* serialized_sliced_blob_buffer, int32_t size);
+
     struct QueryResult {
         std::vector<milvus::knowhere::IDType> ids;
         std::vector<float> distances;
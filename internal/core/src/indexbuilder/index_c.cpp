@@ -134,6 +134,57 @@ LoadFromSlicedBuffer(CIndex index, const char* serialized_sliced_blob_buffer, in
     return status;
 }
 
+CStatus
+TrainFloatVecIndex(CIndex index, int64_t float_value_num, const float* vectors) {
+    auto status = CStatus();
+    try {
+        auto cIndex = (milvus::indexbuilder::IndexWrapper*)index;
+        auto dim = cIndex->dim();
+        auto row_nums = float_value_num / dim;
+        auto ds = milvus::knowhere::GenDataset(row_nums, dim, vectors);
+        cIndex->Train(ds);
+        status.error_code = Success;
+        status.error_msg = "";
+    } catch (std::exception& e) {
+        status.error_code = UnexpectedError;
+        status.error_msg = strdup(e.what());
+    }
+    return status;
+}
+
+CStatus
+AddFloatVecIndexWithoutIds(CIndex index, int64_t float_value_num, const float* vectors) {
+    auto status = CStatus();
+    try {
+        auto cIndex = (milvus::indexbuilder::IndexWrapper*)index;
+        auto dim = cIndex->dim();
+        auto row_nums = float_value_num / dim;
+        auto ds = milvus::knowhere::GenDataset(row_nums, dim, vectors);
+        cIndex->AddWithoutIds(ds);
+        status.error_code = Success;
+        status.error_msg = "";
+    } catch (std::exception& e) {
+        status.error_code = UnexpectedError;
+        status.error_msg = strdup(e.what());
+    }
+    return status;
+}
+
+CStatus
+MergeFromSlicedBuffer(CIndex index, const char* serialized_sliced_blob_buffer, int32_t size) {
+    auto status = CStatus();
+    try {
+        auto cIndex = (milvus::indexbuilder::IndexWrapper*)index;
+        cIndex->Merge(serialized_sliced_blob_buffer, size);
+        status.error_code = Success;
+        status.error_msg = "";
+    } catch (std::exception& e) {
+        status.error_code = UnexpectedError;
+        status.error_msg = strdup(e.what());
+    }
+    return status;
+}
+
 CStatus
 QueryOnFloatVecIndex(CIndex index, int64_t float_value_num, const float* vectors, CIndexQueryResult* res) {
     auto status = CStatus();
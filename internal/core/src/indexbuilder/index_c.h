@@ -65,6 +65,15 @@ DeleteCBinary(CBinary c_binary);
 CStatus
 LoadFromSlicedBuffer(CIndex index, const char* serialized_sliced_blob_buffer, int32_t size);
 
+CStatus
+TrainFloatVecIndex(CIndex index, int64_t float_value_num, const float* vectors);
+
+CStatus
+AddFloatVecIndexWithoutIds(CIndex index, int64_t float_value_num, const float* vectors);
+
+CStatus
+MergeFromSlicedBuffer(CIndex index, const char* serialized_sliced_blob_buffer, int32_t size);
+
 CStatus
 QueryOnFloatVecIndex(CIndex index, int64_t float_value_num, const float* vectors, CIndexQueryResult* res);
 
@@ -58,6 +58,16 @@ Need_BuildAll_list() {
     return ret;
 }
 
+// index types whose build can be split into training, adding shards and merging the shard indexes
+std::vector<std::string>
+Distributed_Build_List() {
+    static std::vector<std::string> ret{
+        milvus::knowhere::IndexEnum::INDEX_FAISS_IVFSQ8,
+        milvus::knowhere::IndexEnum::INDEX_FAISS_IVFPQ,
+    };
+    return ret;
+}
+
 std::vector<std::tuple<std::string, std::string>>
 unsupported_index_combinations() {
     static std::vector<std::tuple<std::string, std::string>> ret{
@@ -93,6 +103,11 @@ is_in_need_id_list(const milvus::knowhere::IndexType& index_type) {
     return is_in_list<std::string>(index_type, Need_ID_List);
 }
 
+bool
+is_in_distributed_build_list(const milvus::knowhere::IndexType& index_type) {
+    return is_in_list<std::string>(index_type, Distributed_Build_List);
+}
+
 bool
 is_unsupported(const milvus::knowhere::IndexType& index_type, const milvus::knowhere::MetricType& metric_type) {
     return is_in_list<std::tuple<std::string, std::string>>(std::make_tuple(index_type, metric_type),
@@ -96,4 +96,12 @@ using PlanPtr = std::unique_ptr<Plan>;
 struct PlaceholderGroup : std::vector<Placeholder> {
     using std::vector<Placeholder>::vector;
 };
+
+// rows of the segment pre-filtered by the caller, a superset of the rows matching the predicate of the plan,
+// or exactly the matching rows if exact_ is set, in which case the predicate isn't evaluated. A superset only
+// narrows the rows searched, the predicate is still evaluated on all the rows unless none of them is set.
+struct RowFilter {
+    boost::dynamic_bitset<> rows_;
+    bool exact_ = false;
+};
 }  // namespace milvus::query
@@ -35,6 +35,8 @@ struct SearchInfo {
     FieldOffset field_offset_;
     MetricType metric_type_;
     nlohmann::json search_params_;
+    // the loaded index of sealed segments to search with, 0 for the default one
+    int64_t index_id_ = 0;
 };
 
 struct VectorPlanNode : PlanNode {
@@ -74,7 +74,7 @@ SearchOnSealed(const Schema& schema,
     auto dim = field.get_dim();
 
     AssertInfo(record.is_ready(field_offset), "[SearchOnSealed]Record isn't ready");
-    auto field_indexing = record.get_field_indexing(field_offset);
+    auto field_indexing = record.get_field_indexing(field_offset, search_info.index_id_);
     AssertInfo(field_indexing->metric_type_ == search_info.metric_type_,
                "Metric type of field index isn't the same with search info");
 
@@ -35,8 +35,9 @@ class ExecPlanNodeVisitor : public PlanNodeVisitor {
     using RetrieveRetType = RetrieveResult;
     ExecPlanNodeVisitor(const segcore::SegmentInterface& segment,
                         Timestamp timestamp,
-                        const PlaceholderGroup& placeholder_group)
-        : segment_(segment), timestamp_(timestamp), placeholder_group_(placeholder_group) {
+                        const PlaceholderGroup& placeholder_group,
+                        const RowFilter* row_filter = nullptr)
+        : segment_(segment), timestamp_(timestamp), placeholder_group_(placeholder_group), row_filter_(row_filter) {
     }
 
     ExecPlanNodeVisitor(const segcore::SegmentInterface& segment, Timestamp timestamp)
@@ -75,6 +76,7 @@ class ExecPlanNodeVisitor : public PlanNodeVisitor {
     const segcore::SegmentInterface& segment_;
     Timestamp timestamp_;
     PlaceholderGroup placeholder_group_;
+    const RowFilter* row_filter_ = nullptr;
 
     std::optional<RetType> ret_;
     std::optional<RetrieveResult> retrieve_ret_;
@@ -31,8 +31,9 @@ class ExecPlanNodeVisitor : PlanNodeVisitor {
     using RetType = SearchResult;
     ExecPlanNodeVisitor(const segcore::SegmentInterface& segment,
                         Timestamp timestamp,
-                        const PlaceholderGroup& placeholder_group)
-        : segment_(segment), timestamp_(timestamp), placeholder_group_(placeholder_group) {
+                        const PlaceholderGroup& placeholder_group,
+                        const RowFilter* row_filter = nullptr)
+        : segment_(segment), timestamp_(timestamp), placeholder_group_(placeholder_group), row_filter_(row_filter) {
     }
     // using RetType = nlohmann::json;
 
@@ -56,6 +57,7 @@ class ExecPlanNodeVisitor : PlanNodeVisitor {
     const segcore::SegmentInterface& segment_;
     Timestamp timestamp_;
     const PlaceholderGroup& placeholder_group_;
+    const RowFilter* row_filter_ = nullptr;
 
     std::optional<RetType> ret_;
 };
@@ -97,10 +99,24 @@ ExecPlanNodeVisitor::VectorVisitorImpl(VectorPlanNode& node) {
         return;
     }
 
+    // the row filter covers all the rows of the sealed segment, it's ignored if the rows don't match
+    auto row_filter = row_filter_;
+    if (row_filter != nullptr && static_cast<int64_t>(row_filter->rows_.size()) != active_count) {
+        row_filter = nullptr;
+    }
     if (node.predicate_.has_value()) {
-        ExecExprVisitor::RetType expr_ret =
-            ExecExprVisitor(*segment, active_count, timestamp_).call_child(*node.predicate_.value());
-        bitset_holder = std::move(expr_ret);
+        // the predicate isn't evaluated if the filter decides all the rows, otherwise it's evaluated on all the rows
+        // and the rows filtered out are masked afterwards, since the expressions are evaluated column by column
+        if (row_filter != nullptr && (row_filter->exact_ || row_filter->rows_.none())) {
+            bitset_holder = row_filter->rows_;
+        } else {
+            ExecExprVisitor::RetType expr_ret =
+                ExecExprVisitor(*segment, active_count, timestamp_).call_child(*node.predicate_.value());
+            bitset_holder = std::move(expr_ret);
+            if (row_filter != nullptr) {
+                bitset_holder &= row_filter->rows_;
+            }
+        }
     }
     segment->mask_with_timestamps(bitset_holder, timestamp_);
 
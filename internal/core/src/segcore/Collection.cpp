@@ -49,4 +49,17 @@ Collection::parse() {
     int i = 1 + 1;
 }
 
+void
+Collection::update_schema(const std::string& collection_proto) {
+    milvus::proto::schema::CollectionSchema collection_schema;
+    auto suc = google::protobuf::TextFormat::ParseFromString(collection_proto, &collection_schema);
+    AssertInfo(suc, "unmarshal schema string failed");
+    auto schema = Schema::ParseFrom(collection_schema);
+
+    std::lock_guard<std::mutex> lck(mutex_);
+    replaced_schemas_.push_back(schema_);
+    schema_proto_ = collection_proto;
+    schema_ = schema;
+}
+
 }  // namespace milvus::segcore
@@ -14,6 +14,8 @@
 #include "common/Schema.h"
 #include <string>
 #include <memory>
+#include <mutex>
+#include <vector>
 
 namespace milvus::segcore {
 
@@ -24,9 +26,15 @@ class Collection {
     void
     parse();
 
+    // replace the schema, the segments created afterwards use the new schema,
+    // the replaced schemas are kept alive since the existing plans refer to them
+    void
+    update_schema(const std::string& collection_proto);
+
  public:
-    SchemaPtr&
+    SchemaPtr
     get_schema() {
+        std::lock_guard<std::mutex> lck(mutex_);
         return schema_;
     }
 
@@ -39,6 +47,8 @@ class Collection {
     std::string collection_name_;
     std::string schema_proto_;
     SchemaPtr schema_;
+    std::vector<SchemaPtr> replaced_schemas_;
+    std::mutex mutex_;
 };
 
 using CollectionPtr = std::unique_ptr<Collection>;
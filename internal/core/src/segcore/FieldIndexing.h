@@ -136,6 +136,19 @@ class IndexingRecord {
     void
     UpdateResourceAck(int64_t chunk_ack, const InsertRecord& record);
 
+    // drop the small indexes of vector fields, the chunks are searched by brute force after that,
+    // not concurrent with insert and search
+    void
+    DropVectorIndexing() {
+        for (auto iter = field_indexings_.begin(); iter != field_indexings_.end();) {
+            if (schema_[iter->first].is_vector()) {
+                iter = field_indexings_.erase(iter);
+            } else {
+                ++iter;
+            }
+        }
+    }
+
     // concurrent
     int64_t
     get_finished_ack() const {
@@ -0,0 +1,68 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License
+
+#pragma once
+#include <fcntl.h>
+#include <sys/mman.h>
+#include <sys/stat.h>
+#include <unistd.h>
+#include <cerrno>
+#include <cstring>
+#include <memory>
+#include <string>
+
+#include "exceptions/EasyAssert.h"
+
+namespace milvus::segcore {
+
+// read-only mapping of a local file, the pages are loaded by the OS on access and unmapped on destruction
+class MmapRegion {
+ public:
+    MmapRegion(const std::string& path, size_t size) : size_(size) {
+        AssertInfo(size > 0, "can't mmap empty data of " + path);
+        auto fd = open(path.c_str(), O_RDONLY);
+        AssertInfo(fd >= 0, "failed to open " + path + ": " + strerror(errno));
+        struct stat st;
+        if (fstat(fd, &st) != 0 || static_cast<size_t>(st.st_size) < size) {
+            close(fd);
+            PanicInfo("file " + path + " is smaller than " + std::to_string(size) + " bytes");
+        }
+        data_ = mmap(nullptr, size, PROT_READ, MAP_SHARED, fd, 0);
+        close(fd);
+        AssertInfo(data_ != MAP_FAILED, "failed to mmap " + path + ": " + strerror(errno));
+    }
+
+    ~MmapRegion() {
+        munmap(data_, size_);
+    }
+
+    MmapRegion(const MmapRegion&) = delete;
+    MmapRegion&
+    operator=(const MmapRegion&) = delete;
+
+    const char*
+    data() const {
+        return reinterpret_cast<const char*>(data_);
+    }
+
+    size_t
+    size() const {
+        return size_;
+    }
+
+ private:
+    void* data_ = nullptr;
+    size_t size_;
+};
+
+using MmapRegionPtr = std::unique_ptr<MmapRegion>;
+
+}  // namespace milvus::segcore
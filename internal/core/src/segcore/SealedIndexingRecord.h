@@ -27,23 +27,48 @@ struct SealedIndexingEntry {
     knowhere::VecIndexPtr indexing_;
 };
 
-using SealedIndexingEntryPtr = std::unique_ptr<SealedIndexingEntry>;
+// shared, so an index dropped while being searched is released after the search
+using SealedIndexingEntryPtr = std::shared_ptr<SealedIndexingEntry>;
 
+// A field may have several indexes loaded side by side, identified by the index id.
+// The default one is searched if the search doesn't specify a loaded index.
 struct SealedIndexingRecord {
     void
-    append_field_indexing(FieldOffset field_offset, MetricType metric_type, knowhere::VecIndexPtr indexing) {
-        auto ptr = std::make_unique<SealedIndexingEntry>();
+    append_field_indexing(FieldOffset field_offset,
+                          int64_t index_id,
+                          MetricType metric_type,
+                          knowhere::VecIndexPtr indexing) {
+        auto ptr = std::make_shared<SealedIndexingEntry>();
         ptr->indexing_ = indexing;
         ptr->metric_type_ = metric_type;
         std::unique_lock lck(mutex_);
-        field_indexings_[field_offset] = std::move(ptr);
+        auto& field = field_indexings_[field_offset];
+        field.indexings_[index_id] = std::move(ptr);
+        if (!field.indexings_.count(field.default_index_id_)) {
+            field.default_index_id_ = index_id;
+        }
     }
 
-    const SealedIndexingEntry*
-    get_field_indexing(FieldOffset field_offset) const {
+    // get the index with index_id, or the default index of the field if it's not loaded
+    SealedIndexingEntryPtr
+    get_field_indexing(FieldOffset field_offset, int64_t index_id = 0) const {
         std::shared_lock lck(mutex_);
         AssertInfo(field_indexings_.count(field_offset), "field_offset not found");
-        return field_indexings_.at(field_offset).get();
+        auto& field = field_indexings_.at(field_offset);
+        auto iter = field.indexings_.find(index_id);
+        if (iter == field.indexings_.end()) {
+            iter = field.indexings_.find(field.default_index_id_);
+        }
+        AssertInfo(iter != field.indexings_.end(), "default index of field not found");
+        return iter->second;
+    }
+
+    void
+    set_default_indexing(FieldOffset field_offset, int64_t index_id) {
+        std::unique_lock lck(mutex_);
+        AssertInfo(field_indexings_.count(field_offset) && field_indexings_[field_offset].indexings_.count(index_id),
+                   "index " + std::to_string(index_id) + " not loaded");
+        field_indexings_[field_offset].default_index_id_ = index_id;
     }
 
     void
@@ -52,15 +77,45 @@ struct SealedIndexingRecord {
         field_indexings_.erase(field_offset);
     }
 
+    // drop one index of the field, another loaded index becomes the default one if the default index is dropped
+    void
+    drop_field_indexing(FieldOffset field_offset, int64_t index_id) {
+        std::unique_lock lck(mutex_);
+        auto iter = field_indexings_.find(field_offset);
+        if (iter == field_indexings_.end()) {
+            return;
+        }
+        auto& field = iter->second;
+        field.indexings_.erase(index_id);
+        if (field.indexings_.empty()) {
+            field_indexings_.erase(iter);
+        } else if (field.default_index_id_ == index_id) {
+            field.default_index_id_ = field.indexings_.begin()->first;
+        }
+    }
+
     bool
     is_ready(FieldOffset field_offset) const {
         std::shared_lock lck(mutex_);
         return field_indexings_.count(field_offset);
     }
 
+    bool
+    has_indexing(FieldOffset field_offset, int64_t index_id) const {
+        std::shared_lock lck(mutex_);
+        auto iter = field_indexings_.find(field_offset);
+        return iter != field_indexings_.end() && iter->second.indexings_.count(index_id);
+    }
+
  private:
-    // field_offset -> SealedIndexingEntry
-    std::map<FieldOffset, SealedIndexingEntryPtr> field_indexings_;
+    struct FieldIndexings {
+        int64_t default_index_id_ = 0;
+        // index_id -> SealedIndexingEntry
+        std::map<int64_t, SealedIndexingEntryPtr> indexings_;
+    };
+
+    // field_offset -> loaded indexes of the field
+    std::map<FieldOffset, FieldIndexings> field_indexings_;
     mutable std::shared_mutex mutex_;
 };
 }  // namespace milvus::segcore
@@ -43,6 +43,9 @@ class SegmentGrowing : public SegmentInternalInterface {
     virtual void
     disable_small_index() = 0;
 
+    virtual void
+    drop_vector_small_index() = 0;
+
     virtual int64_t
     PreInsert(int64_t size) = 0;
 
@@ -119,6 +119,11 @@ class SegmentGrowingImpl : public SegmentGrowing {
         enable_small_index_ = false;
     }
 
+    void
+    drop_vector_small_index() override {
+        indexing_record_.DropVectorIndexing();
+    }
+
     ssize_t
     get_row_count() const override {
         return record_.ack_responder_.GetAck();
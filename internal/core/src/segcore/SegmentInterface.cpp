@@ -76,10 +76,11 @@ SegmentInternalInterface::FillTargetEntry(const query::Plan* plan, SearchResult&
 SearchResult
 SegmentInternalInterface::Search(const query::Plan* plan,
                                  const query::PlaceholderGroup& placeholder_group,
-                                 Timestamp timestamp) const {
+                                 Timestamp timestamp,
+                                 const query::RowFilter* row_filter) const {
     std::shared_lock lck(mutex_);
     check_search(plan);
-    query::ExecPlanNodeVisitor visitor(*this, timestamp, placeholder_group);
+    query::ExecPlanNodeVisitor visitor(*this, timestamp, placeholder_group, row_filter);
     auto results = visitor.get_moved_result(*plan->plan_node_);
     results.segment_ = (void*)this;
     return results;
@@ -37,7 +37,10 @@ class SegmentInterface {
     FillTargetEntry(const query::Plan* plan, SearchResult& results) const = 0;
 
     virtual SearchResult
-    Search(const query::Plan* Plan, const query::PlaceholderGroup& placeholder_group, Timestamp timestamp) const = 0;
+    Search(const query::Plan* Plan,
+           const query::PlaceholderGroup& placeholder_group,
+           Timestamp timestamp,
+           const query::RowFilter* row_filter = nullptr) const = 0;
 
     virtual std::unique_ptr<proto::segcore::RetrieveResults>
     Retrieve(const query::RetrievePlan* Plan, Timestamp timestamp) const = 0;
@@ -80,7 +83,8 @@ class SegmentInternalInterface : public SegmentInterface {
     SearchResult
     Search(const query::Plan* Plan,
            const query::PlaceholderGroup& placeholder_group,
-           Timestamp timestamp) const override;
+           Timestamp timestamp,
+           const query::RowFilter* row_filter = nullptr) const override;
 
     void
     FillTargetEntry(const query::Plan* plan, SearchResult& results) const override;
@@ -14,6 +14,7 @@
 #include "segcore/SegmentInterface.h"
 #include "pb/segcore.pb.h"
 #include "common/LoadInfo.h"
+#include <string>
 #include <utility>
 
 namespace milvus::segcore {
@@ -27,8 +28,14 @@ class SegmentSealed : public SegmentInternalInterface {
     virtual void
     LoadFieldData(const LoadFieldDataInfo& info) = 0;
     virtual void
+    LoadFieldDataFromFile(FieldId field_id, const std::string& path, int64_t row_count) = 0;
+    virtual void
     DropIndex(const FieldId field_id) = 0;
     virtual void
+    DropIndex(const FieldId field_id, int64_t index_id) = 0;
+    virtual void
+    SetDefaultIndex(const FieldId field_id, int64_t index_id) = 0;
+    virtual void
     DropFieldData(const FieldId field_id) = 0;
     virtual bool
     HasIndex(FieldId field_id) const = 0;
@@ -38,15 +38,15 @@ SegmentSealedImpl::LoadIndex(const LoadIndexInfo& info) {
     AssertInfo(row_count > 0, "Index count is 0");
 
     std::unique_lock lck(mutex_);
-    AssertInfo(!get_bit(vecindex_ready_bitset_, field_offset),
-               "Can't get bitset element at " + std::to_string(field_offset.get()));
     if (row_count_opt_.has_value()) {
         AssertInfo(row_count_opt_.value() == row_count, "load data has different row count from other columns");
     } else {
         row_count_opt_ = row_count;
     }
-    AssertInfo(!vecindexs_.is_ready(field_offset), "vec index is not ready");
-    vecindexs_.append_field_indexing(field_offset, GetMetricType(metric_type_str), info.index);
+    // other indexes of the field may have been loaded, the index is loaded side by side with them
+    AssertInfo(!vecindexs_.has_indexing(field_offset, info.index_id),
+               "index " + std::to_string(info.index_id) + " has been loaded");
+    vecindexs_.append_field_indexing(field_offset, info.index_id, GetMetricType(metric_type_str), info.index);
 
     set_bit(vecindex_ready_bitset_, field_offset, true);
     lck.unlock();
@@ -139,7 +139,8 @@ SegmentSealedImpl::LoadFieldData(const LoadFieldDataInfo& info) {
         // write data under lock
         std::unique_lock lck(mutex_);
         update_row_count(info.row_count);
-        AssertInfo(fields_data_[field_offset.get()].empty(), "field data already exists");
+        AssertInfo(fields_data_[field_offset.get()].empty() && !mmap_fields_data_[field_offset.get()],
+                   "field data already exists");
 
         if (field_meta.is_vector()) {
             AssertInfo(!vecindexs_.is_ready(field_offset), "field data can't be loaded when indexing exists");
@@ -158,6 +159,25 @@ SegmentSealedImpl::LoadFieldData(const LoadFieldDataInfo& info) {
     }
 }
 
+void
+SegmentSealedImpl::LoadFieldDataFromFile(FieldId field_id, const std::string& path, int64_t row_count) {
+    AssertInfo(row_count > 0, "The row count of field data is 0");
+    AssertInfo(!SystemProperty::Instance().IsSystem(field_id), "system field can't be loaded from file");
+    auto field_offset = schema_->get_offset(field_id);
+    auto& field_meta = schema_->operator[](field_offset);
+    AssertInfo(field_meta.is_vector(), "only vector field data can be loaded from file");
+    auto region = std::make_unique<MmapRegion>(path, field_meta.get_sizeof() * row_count);
+
+    // write data under lock
+    std::unique_lock lck(mutex_);
+    update_row_count(row_count);
+    AssertInfo(fields_data_[field_offset.get()].empty() && !mmap_fields_data_[field_offset.get()],
+               "field data already exists");
+    AssertInfo(!vecindexs_.is_ready(field_offset), "field data can't be loaded when indexing exists");
+    mmap_fields_data_[field_offset.get()] = std::move(region);
+    set_bit(field_data_ready_bitset_, field_offset, true);
+}
+
 int64_t
 SegmentSealedImpl::num_chunk_index(FieldOffset field_offset) const {
     return 1;
@@ -180,7 +200,7 @@ SegmentSealedImpl::chunk_data_impl(FieldOffset field_offset, int64_t chunk_id) c
                "Can't get bitset element at " + std::to_string(field_offset.get()));
     auto& field_meta = schema_->operator[](field_offset);
     auto element_sizeof = field_meta.get_sizeof();
-    SpanBase base(fields_data_[field_offset.get()].data(), row_count_opt_.value(), element_sizeof);
+    SpanBase base(get_field_data(field_offset), row_count_opt_.value(), element_sizeof);
     return base;
 }
 
@@ -198,7 +218,14 @@ SegmentSealedImpl::GetMemoryUsageInBytes() const {
     // TODO: add estimate for index
     std::shared_lock lck(mutex_);
     auto row_count = row_count_opt_.value_or(0);
-    return schema_->get_total_sizeof() * row_count;
+    int64_t size = schema_->get_total_sizeof() * row_count;
+    // the mapped field data is paged in and out by the OS, not held in memory
+    for (auto& region : mmap_fields_data_) {
+        if (region) {
+            size -= region->size();
+        }
+    }
+    return size;
 }
 
 int64_t
@@ -246,7 +273,7 @@ SegmentSealedImpl::vector_search(int64_t vec_count,
                "Can't get bitset element at " + std::to_string(field_offset.get()));
     AssertInfo(row_count_opt_.has_value(), "Can't get row count value");
     auto row_count = row_count_opt_.value();
-    auto chunk_data = fields_data_[field_offset.get()].data();
+    auto chunk_data = get_field_data(field_offset);
 
     auto sub_qr = [&] {
         if (field_meta.get_data_type() == DataType::VECTOR_FLOAT) {
@@ -285,6 +312,7 @@ SegmentSealedImpl::DropFieldData(const FieldId field_id) {
         std::unique_lock lck(mutex_);
         set_bit(field_data_ready_bitset_, field_offset, false);
         auto vec = std::move(fields_data_[field_offset.get()]);
+        auto region = std::move(mmap_fields_data_[field_offset.get()]);
         lck.unlock();
 
         vec.clear();
@@ -305,6 +333,25 @@ SegmentSealedImpl::DropIndex(const FieldId field_id) {
     set_bit(vecindex_ready_bitset_, field_offset, false);
 }
 
+void
+SegmentSealedImpl::DropIndex(const FieldId field_id, int64_t index_id) {
+    AssertInfo(!SystemProperty::Instance().IsSystem(field_id),
+               "Field id:" + std::to_string(field_id.get()) + " isn't one of system type when drop index");
+    auto field_offset = schema_->get_offset(field_id);
+
+    std::unique_lock lck(mutex_);
+    vecindexs_.drop_field_indexing(field_offset, index_id);
+    set_bit(vecindex_ready_bitset_, field_offset, vecindexs_.is_ready(field_offset));
+}
+
+void
+SegmentSealedImpl::SetDefaultIndex(const FieldId field_id, int64_t index_id) {
+    auto field_offset = schema_->get_offset(field_id);
+
+    std::unique_lock lck(mutex_);
+    vecindexs_.set_default_indexing(field_offset, index_id);
+}
+
 void
 SegmentSealedImpl::check_search(const query::Plan* plan) const {
     AssertInfo(plan, "Search plan is null");
@@ -330,6 +377,7 @@ SegmentSealedImpl::check_search(const query::Plan* plan) const {
 SegmentSealedImpl::SegmentSealedImpl(SchemaPtr schema)
     : schema_(schema),
       fields_data_(schema->size()),
+      mmap_fields_data_(schema->size()),
       field_data_ready_bitset_(schema->size()),
       vecindex_ready_bitset_(schema->size()),
       scalar_indexings_(schema->size()) {
@@ -385,7 +433,7 @@ SegmentSealedImpl::bulk_subscript(FieldOffset field_offset,
         return;
     }
     auto& field_meta = schema_->operator[](field_offset);
-    auto src_vec = fields_data_[field_offset.get()].data();
+    auto src_vec = get_field_data(field_offset);
     switch (field_meta.get_data_type()) {
         case DataType::BOOL: {
             bulk_subscript_impl<bool>(src_vec, seg_offsets, count, output);
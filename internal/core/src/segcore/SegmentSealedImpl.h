@@ -14,6 +14,7 @@
 #include "segcore/SegmentSealed.h"
 #include "SealedIndexingRecord.h"
 #include "ScalarIndex.h"
+#include "MmapRegion.h"
 #include <deque>
 #include <map>
 #include <vector>
@@ -30,10 +31,16 @@ class SegmentSealedImpl : public SegmentSealed {
     void
     LoadFieldData(const LoadFieldDataInfo& info) override;
     void
+    LoadFieldDataFromFile(FieldId field_id, const std::string& path, int64_t row_count) override;
+    void
     LoadSegmentMeta(const milvus::proto::segcore::LoadSegmentMeta& segment_meta) override;
     void
     DropIndex(const FieldId field_id) override;
     void
+    DropIndex(const FieldId field_id, int64_t index_id) override;
+    void
+    SetDefaultIndex(const FieldId field_id, int64_t index_id) override;
+    void
     DropFieldData(const FieldId field_id) override;
     bool
     HasIndex(FieldId field_id) const override;
@@ -97,6 +104,16 @@ class SegmentSealedImpl : public SegmentSealed {
     bulk_subscript_impl(
         int64_t element_sizeof, const void* src_raw, const int64_t* seg_offsets, int64_t count, void* dst_raw);
 
+    // the data of the field, either loaded into memory or mapped from a local file
+    const char*
+    get_field_data(FieldOffset field_offset) const {
+        auto& region = mmap_fields_data_[field_offset.get()];
+        if (region) {
+            return region->data();
+        }
+        return fields_data_[field_offset.get()].data();
+    }
+
     void
     update_row_count(int64_t row_count) {
         if (row_count_opt_.has_value()) {
@@ -148,6 +165,7 @@ class SegmentSealedImpl : public SegmentSealed {
     std::unique_ptr<ScalarIndexBase> primary_key_index_;
 
     std::vector<aligned_vector<char>> fields_data_;
+    std::vector<MmapRegionPtr> mmap_fields_data_;
 
     SealedIndexingRecord vecindexs_;
     aligned_vector<idx_t> row_ids_;
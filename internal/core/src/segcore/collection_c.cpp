@@ -31,3 +31,20 @@ GetCollectionName(CCollection collection) {
     auto col = (milvus::segcore::Collection*)collection;
     return strdup(col->get_collection_name().data());
 }
+
+CStatus
+UpdateCollectionSchema(CCollection collection, const char* schema_proto_blob) {
+    try {
+        auto col = (milvus::segcore::Collection*)collection;
+        col->update_schema(std::string(schema_proto_blob));
+        auto status = CStatus();
+        status.error_code = Success;
+        status.error_msg = "";
+        return status;
+    } catch (std::exception& e) {
+        auto status = CStatus();
+        status.error_code = UnexpectedError;
+        status.error_msg = strdup(e.what());
+        return status;
+    }
+}
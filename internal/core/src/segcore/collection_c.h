@@ -15,6 +15,8 @@
 extern "C" {
 #endif
 
+#include "common/type_c.h"
+
 typedef void* CCollection;
 
 CCollection
@@ -26,6 +28,9 @@ DeleteCollection(CCollection collection);
 const char*
 GetCollectionName(CCollection collection);
 
+CStatus
+UpdateCollectionSchema(CCollection collection, const char* schema_proto_blob);
+
 #ifdef __cplusplus
 }
 #endif
@@ -58,6 +58,24 @@ AppendIndexParam(CLoadIndexInfo c_load_index_info, const char* c_index_key, cons
     }
 }
 
+CStatus
+AppendIndexID(CLoadIndexInfo c_load_index_info, int64_t index_id) {
+    try {
+        auto load_index_info = (LoadIndexInfo*)c_load_index_info;
+        load_index_info->index_id = index_id;
+
+        auto status = CStatus();
+        status.error_code = Success;
+        status.error_msg = "";
+        return status;
+    } catch (std::exception& e) {
+        auto status = CStatus();
+        status.error_code = UnexpectedError;
+        status.error_msg = strdup(e.what());
+        return status;
+    }
+}
+
 CStatus
 AppendFieldInfo(CLoadIndexInfo c_load_index_info, int64_t field_id) {
     try {
@@ -76,24 +94,28 @@ AppendFieldInfo(CLoadIndexInfo c_load_index_info, int64_t field_id) {
     }
 }
 
+static milvus::knowhere::VecIndexPtr
+CreateVecIndex(LoadIndexInfo* load_index_info) {
+    auto& index_params = load_index_info->index_params;
+    bool find_index_type = index_params.count("index_type") > 0 ? true : false;
+    bool find_index_mode = index_params.count("index_mode") > 0 ? true : false;
+    AssertInfo(find_index_type == true, "Can't find index type in index_params");
+    milvus::knowhere::IndexMode mode;
+    if (find_index_mode) {
+        mode = index_params["index_mode"] == "CPU" ? milvus::knowhere::IndexMode::MODE_CPU
+                                                   : milvus::knowhere::IndexMode::MODE_GPU;
+    } else {
+        mode = milvus::knowhere::IndexMode::MODE_CPU;
+    }
+    return milvus::knowhere::VecIndexFactory::GetInstance().CreateVecIndex(index_params["index_type"], mode);
+}
+
 CStatus
 AppendIndex(CLoadIndexInfo c_load_index_info, CBinarySet c_binary_set) {
     try {
         auto load_index_info = (LoadIndexInfo*)c_load_index_info;
         auto binary_set = (milvus::knowhere::BinarySet*)c_binary_set;
-        auto& index_params = load_index_info->index_params;
-        bool find_index_type = index_params.count("index_type") > 0 ? true : false;
-        bool find_index_mode = index_params.count("index_mode") > 0 ? true : false;
-        AssertInfo(find_index_type == true, "Can't find index type in index_params");
-        milvus::knowhere::IndexMode mode;
-        if (find_index_mode) {
-            mode = index_params["index_mode"] == "CPU" ? milvus::knowhere::IndexMode::MODE_CPU
-                                                       : milvus::knowhere::IndexMode::MODE_GPU;
-        } else {
-            mode = milvus::knowhere::IndexMode::MODE_CPU;
-        }
-        load_index_info->index =
-            milvus::knowhere::VecIndexFactory::GetInstance().CreateVecIndex(index_params["index_type"], mode);
+        load_index_info->index = CreateVecIndex(load_index_info);
         load_index_info->index->Load(*binary_set);
         auto status = CStatus();
         status.error_code = Success;
@@ -107,6 +129,25 @@ AppendIndex(CLoadIndexInfo c_load_index_info, CBinarySet c_binary_set) {
     }
 }
 
+CStatus
+AppendIndexFromFile(CLoadIndexInfo c_load_index_info, const char* c_file_path) {
+    try {
+        auto load_index_info = (LoadIndexInfo*)c_load_index_info;
+        std::string file_path(c_file_path);
+        load_index_info->index = CreateVecIndex(load_index_info);
+        load_index_info->index->LoadFromFile(file_path);
+        auto status = CStatus();
+        status.error_code = Success;
+        status.error_msg = "";
+        return status;
+    } catch (std::exception& e) {
+        auto status = CStatus();
+        status.error_code = UnexpectedError;
+        status.error_msg = strdup(e.what());
+        return status;
+    }
+}
+
 CStatus
 NewBinarySet(CBinarySet* c_binary_set) {
     try {
@@ -35,9 +35,15 @@ AppendIndexParam(CLoadIndexInfo c_load_index_info, const char* index_key, const
 CStatus
 AppendFieldInfo(CLoadIndexInfo c_load_index_info, int64_t field_id);
 
+CStatus
+AppendIndexID(CLoadIndexInfo c_load_index_info, int64_t index_id);
+
 CStatus
 AppendIndex(CLoadIndexInfo c_load_index_info, CBinarySet c_binary_set);
 
+CStatus
+AppendIndexFromFile(CLoadIndexInfo c_load_index_info, const char* file_path);
+
 CStatus
 NewBinarySet(CBinarySet* c_binary_set);
 
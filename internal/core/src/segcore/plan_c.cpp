@@ -116,6 +116,12 @@ GetMetricType(CSearchPlan plan) {
     return strdup(metric_str.c_str());
 }
 
+void
+SetSearchPlanIndexID(CSearchPlan plan, int64_t index_id) {
+    auto search_plan = static_cast<milvus::query::Plan*>(plan);
+    search_plan->plan_node_->search_info_.index_id_ = index_id;
+}
+
 void
 DeleteSearchPlan(CSearchPlan cPlan) {
     auto plan = (milvus::query::Plan*)cPlan;
@@ -44,6 +44,10 @@ GetTopK(CSearchPlan plan);
 const char*
 GetMetricType(CSearchPlan plan);
 
+// search the sealed segments with the loaded index of index_id, 0 for the default index
+void
+SetSearchPlanIndexID(CSearchPlan plan, int64_t index_id);
+
 void
 DeleteSearchPlan(CSearchPlan plan);
 
@@ -29,6 +29,18 @@ SegcoreSetChunkRows(const int64_t value) {
     LOG_SEGCORE_DEBUG_ << "set config chunk_size: " << config.get_chunk_rows();
 }
 
+extern "C" void
+SegcoreSetSmallIndexParams(const int64_t nlist, const int64_t nprobe) {
+    milvus::segcore::SegcoreConfig& config = milvus::segcore::SegcoreConfig::default_config();
+    SmallIndexConf sub_conf;
+    sub_conf.build_params["nlist"] = nlist;
+    sub_conf.search_params["nprobe"] = nprobe;
+    sub_conf.index_type = "IVF";
+    config.set_small_index_config(MetricType::METRIC_L2, sub_conf);
+    config.set_small_index_config(MetricType::METRIC_INNER_PRODUCT, sub_conf);
+    LOG_SEGCORE_DEBUG_ << "set config small index nlist: " << nlist << ", nprobe: " << nprobe;
+}
+
 // return value must be freed by the caller
 extern "C" char*
 SegcoreSetSimdType(const char* value) {
@@ -21,6 +21,9 @@ SegcoreInit();
 void
 SegcoreSetChunkRows(const int64_t);
 
+void
+SegcoreSetSmallIndexParams(const int64_t nlist, const int64_t nprobe);
+
 // return value must be freed by the caller
 char*
 SegcoreSetSimdType(const char*);
@@ -70,12 +70,33 @@ Search(CSegmentInterface c_segment,
        CPlaceholderGroup c_placeholder_group,
        uint64_t timestamp,
        CSearchResult* result) {
+    return SearchWithRowFilter(c_segment, c_plan, c_placeholder_group, timestamp, nullptr, 0, false, result);
+}
+
+CStatus
+SearchWithRowFilter(CSegmentInterface c_segment,
+                    CSearchPlan c_plan,
+                    CPlaceholderGroup c_placeholder_group,
+                    uint64_t timestamp,
+                    const uint8_t* rows,
+                    int64_t num_rows,
+                    bool exact,
+                    CSearchResult* result) {
     auto search_result = std::make_unique<milvus::SearchResult>();
     try {
         auto segment = (milvus::segcore::SegmentInterface*)c_segment;
         auto plan = (milvus::query::Plan*)c_plan;
         auto phg_ptr = reinterpret_cast<const milvus::query::PlaceholderGroup*>(c_placeholder_group);
-        *search_result = segment->Search(plan, *phg_ptr, timestamp);
+        std::unique_ptr<milvus::query::RowFilter> row_filter;
+        if (rows != nullptr) {
+            row_filter = std::make_unique<milvus::query::RowFilter>();
+            row_filter->rows_.resize(num_rows);
+            for (int64_t i = 0; i < num_rows; ++i) {
+                row_filter->rows_[i] = (rows[i / 8] >> (i % 8)) & 1;
+            }
+            row_filter->exact_ = exact;
+        }
+        *search_result = segment->Search(plan, *phg_ptr, timestamp, row_filter.get());
         if (plan->plan_node_->search_info_.metric_type_ != milvus::MetricType::METRIC_INNER_PRODUCT) {
             for (auto& dis : search_result->result_distances_) {
                 dis *= -1;
@@ -184,6 +205,19 @@ LoadFieldData(CSegmentInterface c_segment, CLoadFieldDataInfo load_field_data_in
     }
 }
 
+CStatus
+LoadFieldDataFromFile(CSegmentInterface c_segment, int64_t field_id, const char* file_path, int64_t row_count) {
+    try {
+        auto segment_interface = reinterpret_cast<milvus::segcore::SegmentInterface*>(c_segment);
+        auto segment = dynamic_cast<milvus::segcore::SegmentSealed*>(segment_interface);
+        AssertInfo(segment != nullptr, "segment conversion failed");
+        segment->LoadFieldDataFromFile(milvus::FieldId(field_id), std::string(file_path), row_count);
+        return milvus::SuccessCStatus();
+    } catch (std::exception& e) {
+        return milvus::FailureCStatus(UnexpectedError, e.what());
+    }
+}
+
 CStatus
 UpdateSealedSegmentIndex(CSegmentInterface c_segment, CLoadIndexInfo c_load_index_info) {
     try {
@@ -224,6 +258,45 @@ DropSealedSegmentIndex(CSegmentInterface c_segment, int64_t field_id) {
     }
 }
 
+CStatus
+DropSealedSegmentIndexByID(CSegmentInterface c_segment, int64_t field_id, int64_t index_id) {
+    try {
+        auto segment_interface = reinterpret_cast<milvus::segcore::SegmentInterface*>(c_segment);
+        auto segment = dynamic_cast<milvus::segcore::SegmentSealed*>(segment_interface);
+        AssertInfo(segment != nullptr, "segment conversion failed");
+        segment->DropIndex(milvus::FieldId(field_id), index_id);
+        return milvus::SuccessCStatus();
+    } catch (std::exception& e) {
+        return milvus::FailureCStatus(UnexpectedError, e.what());
+    }
+}
+
+CStatus
+SetSealedSegmentDefaultIndex(CSegmentInterface c_segment, int64_t field_id, int64_t index_id) {
+    try {
+        auto segment_interface = reinterpret_cast<milvus::segcore::SegmentInterface*>(c_segment);
+        auto segment = dynamic_cast<milvus::segcore::SegmentSealed*>(segment_interface);
+        AssertInfo(segment != nullptr, "segment conversion failed");
+        segment->SetDefaultIndex(milvus::FieldId(field_id), index_id);
+        return milvus::SuccessCStatus();
+    } catch (std::exception& e) {
+        return milvus::FailureCStatus(UnexpectedError, e.what());
+    }
+}
+
+CStatus
+DropGrowingSegmentSmallIndex(CSegmentInterface c_segment) {
+    try {
+        auto segment_interface = reinterpret_cast<milvus::segcore::SegmentInterface*>(c_segment);
+        auto segment = dynamic_cast<milvus::segcore::SegmentGrowing*>(segment_interface);
+        AssertInfo(segment != nullptr, "segment conversion failed");
+        segment->drop_vector_small_index();
+        return milvus::SuccessCStatus();
+    } catch (std::exception& e) {
+        return milvus::FailureCStatus(UnexpectedError, e.what());
+    }
+}
+
 CProtoResult
 Retrieve(CSegmentInterface c_segment, CRetrievePlan c_plan, uint64_t timestamp) {
     try {
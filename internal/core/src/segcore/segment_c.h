@@ -44,6 +44,18 @@ Search(CSegmentInterface c_segment,
        uint64_t timestamp,
        CSearchResult* result);
 
+// rows is the bitmap of num_rows bits of the rows pre-filtered for the predicate of the plan, bit i of byte j for
+// row j * 8 + i, which are exactly the rows matching the predicate if exact is set
+CStatus
+SearchWithRowFilter(CSegmentInterface c_segment,
+                    CSearchPlan c_plan,
+                    CPlaceholderGroup c_placeholder_group,
+                    uint64_t timestamp,
+                    const uint8_t* rows,
+                    int64_t num_rows,
+                    bool exact,
+                    CSearchResult* result);
+
 CProtoResult
 Retrieve(CSegmentInterface c_segment, CRetrievePlan c_plan, uint64_t timestamp);
 
@@ -84,6 +96,9 @@ PreDelete(CSegmentInterface c_segment, int64_t size);
 CStatus
 LoadFieldData(CSegmentInterface c_segment, CLoadFieldDataInfo load_field_data_info);
 
+CStatus
+LoadFieldDataFromFile(CSegmentInterface c_segment, int64_t field_id, const char* file_path, int64_t row_count);
+
 CStatus
 UpdateSealedSegmentIndex(CSegmentInterface c_segment, CLoadIndexInfo c_load_index_info);
 
@@ -93,6 +108,15 @@ DropFieldData(CSegmentInterface c_segment, int64_t field_id);
 CStatus
 DropSealedSegmentIndex(CSegmentInterface c_segment, int64_t field_id);
 
+CStatus
+DropSealedSegmentIndexByID(CSegmentInterface c_segment, int64_t field_id, int64_t index_id);
+
+CStatus
+SetSealedSegmentDefaultIndex(CSegmentInterface c_segment, int64_t field_id, int64_t index_id);
+
+CStatus
+DropGrowingSegmentSmallIndex(CSegmentInterface c_segment);
+
 #ifdef __cplusplus
 }
 #endif
@@ -190,6 +190,32 @@ TEST(CApiTest, GetCollectionNameTest) {
     DeleteCollection(collection);
 }
 
+TEST(CApiTest, UpdateCollectionSchemaTest) {
+    auto collection = NewCollection(get_default_schema_config());
+    auto col = (milvus::segcore::Collection*)collection;
+    auto old_schema = col->get_schema();
+
+    std::string conf = get_default_schema_config();
+    auto pos = conf.find("value: \"L2\"");
+    conf.replace(pos, strlen("value: \"L2\""), "value: \"IP\"");
+    auto status = UpdateCollectionSchema(collection, conf.c_str());
+    ASSERT_EQ(status.error_code, Success);
+    auto new_schema = col->get_schema();
+    ASSERT_NE(old_schema.get(), new_schema.get());
+    ASSERT_EQ((*new_schema)[FieldOffset(0)].get_metric_type(), MetricType::METRIC_INNER_PRODUCT);
+    // the replaced schema is kept for the existing plans
+    ASSERT_EQ((*old_schema)[FieldOffset(0)].get_metric_type(), MetricType::METRIC_L2);
+
+    status = UpdateCollectionSchema(collection, "invalid schema");
+    ASSERT_NE(status.error_code, Success);
+    free((char*)status.error_msg);
+    ASSERT_EQ(col->get_schema().get(), new_schema.get());
+
+    auto segment = NewSegment(collection, 0, Growing);
+    DeleteSegment(segment);
+    DeleteCollection(collection);
+}
+
 TEST(CApiTest, SegmentTest) {
     auto collection = NewCollection(get_default_schema_config());
     auto segment = NewSegment(collection, 0, Growing);
@@ -257,6 +257,49 @@ TEST(PQWrapper, Build) {
     ASSERT_NO_THROW(index->BuildWithoutIds(xb_dataset));
 }
 
+TEST(IVFSQ8Wrapper, TrainAddAndMerge) {
+    auto index_type = milvus::knowhere::IndexEnum::INDEX_FAISS_IVFSQ8;
+    auto metric_type = milvus::knowhere::Metric::L2;
+    indexcgo::TypeParams type_params;
+    indexcgo::IndexParams index_params;
+    std::tie(type_params, index_params) = generate_params(index_type, metric_type);
+    std::string type_params_str, index_params_str;
+    bool ok;
+    ok = google::protobuf::TextFormat::PrintToString(type_params, &type_params_str);
+    assert(ok);
+    ok = google::protobuf::TextFormat::PrintToString(index_params, &index_params_str);
+    assert(ok);
+    auto dataset = GenDataset(NB, metric_type, false);
+    auto xb_data = dataset.get_col<float>(0);
+    auto half = NB / 2;
+    auto first_half = milvus::knowhere::GenDataset(half, DIM, xb_data.data());
+    auto second_half = milvus::knowhere::GenDataset(NB - half, DIM, xb_data.data() + half * DIM);
+
+    auto trained =
+        std::make_unique<milvus::indexbuilder::IndexWrapper>(type_params_str.c_str(), index_params_str.c_str());
+    ASSERT_NO_THROW(trained->Train(first_half));
+    auto trained_binary = trained->Serialize();
+
+    std::vector<std::unique_ptr<milvus::indexbuilder::IndexWrapper::Binary>> shard_binaries;
+    for (auto& shard : {first_half, second_half}) {
+        auto shard_index =
+            std::make_unique<milvus::indexbuilder::IndexWrapper>(type_params_str.c_str(), index_params_str.c_str());
+        ASSERT_NO_THROW(shard_index->Load(trained_binary->data.data(), trained_binary->data.size()));
+        ASSERT_NO_THROW(shard_index->AddWithoutIds(shard));
+        shard_binaries.push_back(shard_index->Serialize());
+    }
+
+    auto merged =
+        std::make_unique<milvus::indexbuilder::IndexWrapper>(type_params_str.c_str(), index_params_str.c_str());
+    ASSERT_NO_THROW(merged->Load(shard_binaries[0]->data.data(), shard_binaries[0]->data.size()));
+    ASSERT_NO_THROW(merged->Merge(shard_binaries[1]->data.data(), shard_binaries[1]->data.size()));
+
+    // the vectors of the second shard keep their offsets in the segment
+    auto xq_dataset = milvus::knowhere::GenDataset(1, DIM, xb_data.data() + (NB - 1) * DIM);
+    auto query_result = merged->Query(xq_dataset);
+    ASSERT_EQ(query_result->ids[0], NB - 1);
+}
+
 TEST(IVFFLATNMWrapper, Build) {
     auto index_type = milvus::knowhere::IndexEnum::INDEX_FAISS_IVFFLAT;
     auto metric_type = milvus::knowhere::Metric::L2;
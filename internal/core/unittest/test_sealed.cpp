@@ -14,6 +14,8 @@
 //
 #include "test_utils/DataGen.h"
 #include <gtest/gtest.h>
+#include <cstdio>
+#include <fstream>
 #include <knowhere/index/vector_index/VecIndex.h>
 #include <knowhere/index/vector_index/adapter/VectorAdapter.h>
 #include <knowhere/index/vector_index/VecIndexFactory.h>
@@ -314,3 +316,239 @@ TEST(Sealed, LoadFieldData) {
 ])");
     ASSERT_EQ(std_json.dump(-2), json.dump(-2));
 }
+
+TEST(Sealed, RowFilter) {
+    auto dim = 16;
+    auto N = ROW_COUNT;
+    auto metric_type = MetricType::METRIC_L2;
+    auto schema = std::make_shared<Schema>();
+    auto fakevec_id = schema->AddDebugField("fakevec", DataType::VECTOR_FLOAT, dim, metric_type);
+    auto counter_id = schema->AddDebugField("counter", DataType::INT64);
+    auto double_id = schema->AddDebugField("double", DataType::DOUBLE);
+
+    auto dataset = DataGen(schema, N);
+    auto segment = CreateSealedSegment(schema);
+    std::string dsl = R"({
+        "bool": {
+            "must": [
+            {
+                "range": {
+                    "double": {
+                        "GE": -1,
+                        "LT": 1
+                    }
+                }
+            },
+            {
+                "vector": {
+                    "fakevec": {
+                        "metric_type": "L2",
+                        "params": {
+                            "nprobe": 10
+                        },
+                        "query": "$0",
+                        "topk": 5
+                    }
+                }
+            }
+            ]
+        }
+    })";
+
+    Timestamp time = 1000000;
+    auto plan = CreatePlan(*schema, dsl);
+    auto num_queries = 5;
+    auto ph_group_raw = CreatePlaceholderGroup(num_queries, 16, 1024);
+    auto ph_group = ParsePlaceholderGroup(plan.get(), ph_group_raw.SerializeAsString());
+    SealedLoader(dataset, *segment);
+
+    auto json = SearchResultToJson(segment->Search(plan.get(), *ph_group, time)).dump(-2);
+
+    auto ref = dataset.get_col<double>(2);
+    query::RowFilter matched;
+    matched.rows_.resize(N);
+    for (int i = 0; i < N; ++i) {
+        matched.rows_[i] = ref[i] >= -1 && ref[i] < 1;
+    }
+    matched.exact_ = true;
+    ASSERT_EQ(json, SearchResultToJson(segment->Search(plan.get(), *ph_group, time, &matched)).dump(-2));
+
+    query::RowFilter superset;
+    superset.rows_.resize(N, true);
+    ASSERT_EQ(json, SearchResultToJson(segment->Search(plan.get(), *ph_group, time, &superset)).dump(-2));
+
+    // the predicate is evaluated on the rows of the inexact filter
+    query::RowFilter partial;
+    partial.rows_.resize(N);
+    for (int i = 0; i < N; ++i) {
+        partial.rows_[i] = ref[i] >= -1;
+    }
+    ASSERT_EQ(json, SearchResultToJson(segment->Search(plan.get(), *ph_group, time, &partial)).dump(-2));
+    query::RowFilter narrower;
+    narrower.rows_.resize(N);
+    for (int i = 0; i < N; ++i) {
+        narrower.rows_[i] = ref[i] >= 0;
+    }
+    auto narrowed = segment->Search(plan.get(), *ph_group, time, &narrower);
+    for (auto offset : narrowed.internal_seg_offsets_) {
+        ASSERT_TRUE(offset == -1 || (ref[offset] >= 0 && ref[offset] < 1));
+    }
+
+    // the rows filtered out aren't searched
+    query::RowFilter none;
+    none.rows_.resize(N);
+    auto sr = segment->Search(plan.get(), *ph_group, time, &none);
+    for (auto offset : sr.internal_seg_offsets_) {
+        ASSERT_EQ(offset, -1);
+    }
+    none.exact_ = true;
+    sr = segment->Search(plan.get(), *ph_group, time, &none);
+    for (auto offset : sr.internal_seg_offsets_) {
+        ASSERT_EQ(offset, -1);
+    }
+
+    // the row filter not covering all the rows is ignored
+    none.rows_.resize(N - 1);
+    ASSERT_EQ(json, SearchResultToJson(segment->Search(plan.get(), *ph_group, time, &none)).dump(-2));
+}
+
+TEST(Sealed, MultipleIndexes) {
+    auto dim = 16;
+    auto N = ROW_COUNT;
+    auto metric_type = MetricType::METRIC_L2;
+    auto schema = std::make_shared<Schema>();
+    auto fakevec_id = schema->AddDebugField("fakevec", DataType::VECTOR_FLOAT, dim, metric_type);
+    auto counter_id = schema->AddDebugField("counter", DataType::INT64);
+
+    auto dataset = DataGen(schema, N);
+    auto fakevec = dataset.get_col<float>(0);
+
+    auto segment = CreateSealedSegment(schema);
+    std::string dsl = R"({
+        "bool": {
+            "must": [
+            {
+                "vector": {
+                    "fakevec": {
+                        "metric_type": "L2",
+                        "params": {
+                            "nprobe": 10
+                        },
+                        "query": "$0",
+                        "topk": 5
+                    }
+                }
+            }
+            ]
+        }
+    })";
+
+    Timestamp time = 1000000;
+    auto plan = CreatePlan(*schema, dsl);
+    auto num_queries = 5;
+    auto ph_group_raw = CreatePlaceholderGroup(num_queries, 16, 1024);
+    auto ph_group = ParsePlaceholderGroup(plan.get(), ph_group_raw.SerializeAsString());
+
+    SealedLoader(dataset, *segment);
+    segment->DropFieldData(fakevec_id);
+
+    LoadIndexInfo vec_info;
+    vec_info.field_id = fakevec_id.get();
+    vec_info.index_id = 1;
+    vec_info.index = GenIndexing(N, dim, fakevec.data());
+    vec_info.index_params["metric_type"] = milvus::knowhere::Metric::L2;
+    segment->LoadIndex(vec_info);
+    // the same index can't be loaded twice
+    ASSERT_ANY_THROW(segment->LoadIndex(vec_info));
+
+    LoadIndexInfo vec_info2 = vec_info;
+    vec_info2.index_id = 2;
+    vec_info2.index = GenIndexing(N, dim, fakevec.data());
+    segment->LoadIndex(vec_info2);
+
+    auto json = SearchResultToJson(segment->Search(plan.get(), *ph_group, time));
+    // searched with the index 2, and with the default index if the requested one isn't loaded
+    plan->plan_node_->search_info_.index_id_ = 2;
+    ASSERT_EQ(json.dump(-2), SearchResultToJson(segment->Search(plan.get(), *ph_group, time)).dump(-2));
+    plan->plan_node_->search_info_.index_id_ = 3;
+    ASSERT_EQ(json.dump(-2), SearchResultToJson(segment->Search(plan.get(), *ph_group, time)).dump(-2));
+
+    segment->SetDefaultIndex(fakevec_id, 2);
+    ASSERT_ANY_THROW(segment->SetDefaultIndex(fakevec_id, 3));
+    segment->DropIndex(fakevec_id, 1);
+    ASSERT_TRUE(segment->HasIndex(fakevec_id));
+    ASSERT_EQ(json.dump(-2), SearchResultToJson(segment->Search(plan.get(), *ph_group, time)).dump(-2));
+
+    segment->DropIndex(fakevec_id, 2);
+    ASSERT_FALSE(segment->HasIndex(fakevec_id));
+    ASSERT_ANY_THROW(segment->Search(plan.get(), *ph_group, time));
+}
+
+TEST(Sealed, LoadFieldDataFromFile) {
+    auto dim = 16;
+    auto N = ROW_COUNT;
+    auto metric_type = MetricType::METRIC_L2;
+    auto schema = std::make_shared<Schema>();
+    auto fakevec_id = schema->AddDebugField("fakevec", DataType::VECTOR_FLOAT, dim, metric_type);
+    auto counter_id = schema->AddDebugField("counter", DataType::INT64);
+
+    auto dataset = DataGen(schema, N);
+    std::string path = "/tmp/milvus_test_sealed_fakevec";
+    {
+        auto& vec_data = dataset.cols_[0];
+        std::ofstream file(path, std::ios::binary);
+        file.write(reinterpret_cast<const char*>(vec_data.data()), vec_data.size());
+    }
+
+    auto segment = CreateSealedSegment(schema);
+    auto mmap_segment = CreateSealedSegment(schema);
+    SealedLoader(dataset, *segment);
+    {
+        LoadFieldDataInfo info;
+        info.blob = dataset.row_ids_.data();
+        info.row_count = N;
+        info.field_id = 0;
+        mmap_segment->LoadFieldData(info);
+        info.blob = dataset.timestamps_.data();
+        info.field_id = 1;
+        mmap_segment->LoadFieldData(info);
+        info.blob = dataset.cols_[1].data();
+        info.field_id = counter_id.get();
+        mmap_segment->LoadFieldData(info);
+    }
+    ASSERT_ANY_THROW(mmap_segment->LoadFieldDataFromFile(counter_id, path, N));
+    mmap_segment->LoadFieldDataFromFile(fakevec_id, path, N);
+    ASSERT_ANY_THROW(mmap_segment->LoadFieldDataFromFile(fakevec_id, path, N));
+    ASSERT_LT(mmap_segment->GetMemoryUsageInBytes(), segment->GetMemoryUsageInBytes());
+
+    std::string dsl = R"({
+        "bool": {
+            "must": [
+            {
+                "vector": {
+                    "fakevec": {
+                        "metric_type": "L2",
+                        "params": {
+                            "nprobe": 10
+                        },
+                        "query": "$0",
+                        "topk": 5
+                    }
+                }
+            }
+            ]
+        }
+    })";
+    Timestamp time = 1000000;
+    auto plan = CreatePlan(*schema, dsl);
+    auto ph_group_raw = CreatePlaceholderGroup(5, dim, 1024);
+    auto ph_group = ParsePlaceholderGroup(plan.get(), ph_group_raw.SerializeAsString());
+
+    auto sr = segment->Search(plan.get(), *ph_group, time);
+    auto mmap_sr = mmap_segment->Search(plan.get(), *ph_group, time);
+    ASSERT_EQ(SearchResultToJson(sr).dump(-2), SearchResultToJson(mmap_sr).dump(-2));
+
+    mmap_segment->DropFieldData(fakevec_id);
+    ASSERT_ANY_THROW(mmap_segment->Search(plan.get(), *ph_group, time));
+    std::remove(path.c_str());
+}
@@ -26,8 +26,9 @@ import (
 )
 
 const (
-	metaPrefix    = "datacoord-meta"
-	segmentPrefix = metaPrefix + "/s"
+	metaPrefix = "datacoord-meta"
+	// SegmentPrefix is the prefix of the segment infos, relative to the meta root path
+	SegmentPrefix = metaPrefix + "/s"
 )
 
 type meta struct {
@@ -53,7 +54,7 @@ func NewMeta(kv kv.TxnKV) (*meta, error) {
 
 // realodFromKV load meta from KV storage
 func (m *meta) reloadFromKV() error {
-	_, values, err := m.client.LoadWithPrefix(segmentPrefix)
+	_, values, err := m.client.LoadWithPrefix(SegmentPrefix)
 	if err != nil {
 		return err
 	}
@@ -407,7 +408,7 @@ func (m *meta) saveKvTxn(kv map[string]string) error {
 
 // buildSegmentPath common logic mapping segment info to corresponding key in kv store
 func buildSegmentPath(collectionID UniqueID, partitionID UniqueID, segmentID UniqueID) string {
-	return fmt.Sprintf("%s/%d/%d/%d", segmentPrefix, collectionID, partitionID, segmentID)
+	return fmt.Sprintf("%s/%d/%d/%d", SegmentPrefix, collectionID, partitionID, segmentID)
 }
 
 // buildSegment utility function for compose datapb.SegmentInfo struct with provided info
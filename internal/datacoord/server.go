@@ -27,10 +27,12 @@ import (
 	"github.com/milvus-io/milvus/internal/logutil"
 	"go.uber.org/zap"
 
+	"github.com/milvus-io/milvus/internal/kv"
 	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/msgstream"
 	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/migration"
 	"github.com/milvus-io/milvus/internal/util/retry"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
@@ -86,7 +88,7 @@ type Server struct {
 	isServing        ServerState
 	helper           ServerHelper
 
-	kvClient        *etcdkv.EtcdKV
+	kvClient        kv.MetaKv
 	meta            *meta
 	segmentManager  Manager
 	allocator       allocator
@@ -280,12 +282,15 @@ func (s *Server) startSegmentManager() {
 
 func (s *Server) initMeta() error {
 	connectEtcdFn := func() error {
-		etcdKV, err := etcdkv.NewEtcdKV(Params.EtcdEndpoints, Params.MetaRootPath)
+		etcdKV, err := etcdkv.NewMetaKv(Params.EtcdEndpoints, Params.MetaRootPath)
 		if err != nil {
 			return err
 		}
 
 		s.kvClient = etcdKV
+		if err = migration.CoordinatorSchema(typeutil.DataCoordRole).Check(s.kvClient); err != nil {
+			return err
+		}
 		s.meta, err = NewMeta(s.kvClient)
 		if err != nil {
 			return err
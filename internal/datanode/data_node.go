@@ -26,10 +26,10 @@ import (
 	"sync/atomic"
 	"time"
 
-	clientv3 "go.etcd.io/etcd/client/v3"
 	"go.uber.org/zap"
 
 	"github.com/golang/protobuf/proto"
+	"github.com/milvus-io/milvus/internal/kv"
 	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/logutil"
@@ -97,7 +97,7 @@ type DataNode struct {
 
 	session  *sessionutil.Session
 	liveCh   <-chan bool
-	kvClient *etcdkv.EtcdKV
+	kvClient kv.MetaKv
 
 	closer io.Closer
 
@@ -198,7 +198,7 @@ func (node *DataNode) StartWatchChannels(ctx context.Context) {
 			return
 		case event := <-evtChan:
 			if event.Canceled { // failed to watch
-				log.Warn("Watch channel failed", zap.Error(event.Err()))
+				log.Warn("Watch channel failed", zap.Error(event.Err))
 				// if watch loop return due to event canceled, the datanode is not functional anymore
 				// stop the datanode and wait for restart
 				node.Stop()
@@ -212,9 +212,9 @@ func (node *DataNode) StartWatchChannels(ctx context.Context) {
 }
 
 // handleChannelEvt handles event from kv watch event
-func (node *DataNode) handleChannelEvt(evt *clientv3.Event) {
+func (node *DataNode) handleChannelEvt(evt *kv.WatchEvent) {
 	switch evt.Type {
-	case clientv3.EventTypePut: // datacoord shall put channels needs to be watched here
+	case kv.EventTypePut: // datacoord shall put channels needs to be watched here
 		watchInfo := datapb.ChannelWatchInfo{}
 		err := proto.Unmarshal(evt.Kv.Value, &watchInfo)
 		if err != nil {
@@ -245,7 +245,7 @@ func (node *DataNode) handleChannelEvt(evt *clientv3.Event) {
 			node.ReleaseDataSyncService(string(evt.Kv.Key))
 			// TODO GOOSE: maybe retry logic and exit logic
 		}
-	case clientv3.EventTypeDelete:
+	case kv.EventTypeDelete:
 		// guaranteed there is no "/" in channel name
 		parts := strings.Split(string(evt.Kv.Key), "/")
 		node.ReleaseDataSyncService(parts[len(parts)-1])
@@ -345,7 +345,7 @@ func (node *DataNode) Start() error {
 	}
 
 	connectEtcdFn := func() error {
-		etcdKV, err := etcdkv.NewEtcdKV(Params.EtcdEndpoints, Params.MetaRootPath)
+		etcdKV, err := etcdkv.NewMetaKv(Params.EtcdEndpoints, Params.MetaRootPath)
 		if err != nil {
 			return err
 		}
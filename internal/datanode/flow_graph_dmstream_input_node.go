@@ -46,5 +46,7 @@ func newDmInputNode(ctx context.Context, factory msgstream.Factory, collID Uniqu
 
 	var stream msgstream.MsgStream = insertStream
 	node := flowgraph.NewInputNode(stream, "dmInputNode", maxQueueLength, maxParallelism)
+	node.SetDedupWindow(Params.FlowGraphDedupWindow)
+	node.SeedDedup(seekPos)
 	return node, nil
 }
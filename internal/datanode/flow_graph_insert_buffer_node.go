@@ -26,7 +26,6 @@ import (
 	"github.com/opentracing/opentracing-go"
 	"go.uber.org/zap"
 
-	"github.com/milvus-io/milvus/internal/kv"
 	miniokv "github.com/milvus-io/milvus/internal/kv/minio"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/msgstream"
@@ -59,7 +58,7 @@ type insertBufferNode struct {
 	flushChan        <-chan *flushMsg
 	flushingSegCache *Cache
 
-	minIOKV kv.BaseKV
+	chunkManager storage.ChunkManager
 
 	timeTickStream          msgstream.MsgStream
 	segmentStatisticsStream msgstream.MsgStream
@@ -244,7 +243,7 @@ func (ibNode *insertBufferNode) Operate(in []Msg) []Msg {
 			finishCnt.Add(1)
 
 			go flushSegment(collMeta, segToFlush, partitionID, collID,
-				&ibNode.flushMap, ibNode.minIOKV, finishCh, &finishCnt, ibNode, ibNode.idAllocator)
+				&ibNode.flushMap, ibNode.chunkManager, finishCh, &finishCnt, ibNode, ibNode.idAllocator)
 		}
 	}
 	finishCnt.Wait()
@@ -314,7 +313,7 @@ func (ibNode *insertBufferNode) Operate(in []Msg) []Msg {
 			}
 
 			flushSegment(collMeta, currentSegID, partitionID, collID,
-				&ibNode.flushMap, ibNode.minIOKV, finishCh, nil, ibNode, ibNode.idAllocator)
+				&ibNode.flushMap, ibNode.chunkManager, finishCh, nil, ibNode, ibNode.idAllocator)
 			fu := <-finishCh
 			close(finishCh)
 			if fu.field2Path != nil {
@@ -664,7 +663,7 @@ func flushSegment(
 	collMeta *etcdpb.CollectionMeta,
 	segID, partitionID, collID UniqueID,
 	insertData *sync.Map,
-	kv kv.BaseKV,
+	chunkManager storage.ChunkManager,
 	flushUnit chan<- segmentFlushUnit,
 	wgFinish *sync.WaitGroup,
 	ibNode *insertBufferNode,
@@ -684,6 +683,7 @@ func flushSegment(
 	}
 
 	inCodec := storage.NewInsertCodec(collMeta)
+	inCodec.PayloadOptions = Params.BinlogPayloadOptions
 
 	// buffer data to binlogs
 	data, ok := insertData.Load(segID)
@@ -702,7 +702,7 @@ func flushSegment(
 
 	log.Debug(".. Saving binlogs to MinIO ..", zap.Int("number", len(binLogs)))
 	field2Path := make(map[UniqueID]string, len(binLogs))
-	kvs := make(map[string]string, len(binLogs))
+	kvs := make(map[string][]byte, len(binLogs))
 	paths := make([]string, 0, len(binLogs))
 	field2Logidx := make(map[UniqueID]UniqueID, len(binLogs))
 
@@ -728,7 +728,7 @@ func flushSegment(
 
 		key := path.Join(Params.InsertBinlogRootPath, k)
 		paths = append(paths, key)
-		kvs[key] = string(blob.Value[:])
+		kvs[key] = blob.Value
 		field2Path[fieldID] = key
 		field2Logidx[fieldID] = logidx
 	}
@@ -748,14 +748,14 @@ func flushSegment(
 		k, _ := idAllocator.genKey(false, collID, partitionID, segID, fieldID, logidx)
 
 		key := path.Join(Params.StatsBinlogRootPath, k)
-		kvs[key] = string(blob.Value[:])
+		kvs[key] = blob.Value
 	}
 	log.Debug("save binlog file to MinIO/S3")
 
-	err = kv.MultiSave(kvs)
+	err = chunkManager.MultiWrite(kvs)
 	if err != nil {
 		log.Error("Flush failed ... cannot save to MinIO ..", zap.Error(err))
-		_ = kv.MultiRemove(paths)
+		_ = chunkManager.MultiRemove(paths)
 		clearFn(false)
 		return
 	}
@@ -879,7 +879,7 @@ func newInsertBufferNode(
 		BucketName:        Params.MinioBucketName,
 	}
 
-	minIOKV, err := miniokv.NewMinIOKV(ctx, option)
+	chunkManager, err := storage.NewMinioChunkManager(ctx, option)
 	if err != nil {
 		return nil, err
 	}
@@ -907,7 +907,7 @@ func newInsertBufferNode(
 	return &insertBufferNode{
 		BaseNode:     baseNode,
 		insertBuffer: sync.Map{},
-		minIOKV:      minIOKV,
+		chunkManager: chunkManager,
 		channelName:  channelName,
 
 		timeTickStream:          wTtMsgStream,
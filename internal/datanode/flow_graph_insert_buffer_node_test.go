@@ -24,7 +24,6 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
-	memkv "github.com/milvus-io/milvus/internal/kv/mem"
 	"github.com/milvus-io/milvus/internal/msgstream"
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/types"
@@ -222,7 +221,8 @@ func TestFlushSegment(t *testing.T) {
 	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
 	defer cancel()
 	idAllocMock := NewAllocatorFactory(1)
-	mockMinIO := memkv.NewMemoryKV()
+	mockChunkManager := storage.NewLocalChunkManager("/tmp/milvus_test/datanode")
+	defer mockChunkManager.RemoveWithPrefix("")
 	insertChannelName := "datanode-02-test-flushsegment"
 
 	segmentID, _ := idAllocMock.allocID()
@@ -279,7 +279,7 @@ func TestFlushSegment(t *testing.T) {
 		partitionID,
 		collectionID,
 		&flushMap,
-		mockMinIO,
+		mockChunkManager,
 		finishCh,
 		nil,
 		ibNode,
@@ -291,9 +291,9 @@ func TestFlushSegment(t *testing.T) {
 
 	k, _ := idAllocMock.genKey(false, collectionID, partitionID, segmentID, 0)
 	key := path.Join(Params.StatsBinlogRootPath, k)
-	_, values, _ := mockMinIO.LoadWithPrefix(key)
+	_, values, _ := mockChunkManager.ReadWithPrefix(key)
 	assert.Equal(t, len(values), 1)
-	assert.Equal(t, values[0], `{"max":9,"min":0}`)
+	assert.Equal(t, string(values[0]), `{"max":9,"min":0}`)
 }
 
 func genCollectionMeta(collectionID UniqueID, collectionName string) *etcdpb.CollectionMeta {
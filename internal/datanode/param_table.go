@@ -18,6 +18,8 @@ import (
 	"sync"
 	"time"
 
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/util/paramtable"
 )
 
@@ -31,9 +33,11 @@ type ParamTable struct {
 	Port                    int
 	FlowGraphMaxQueueLength int32
 	FlowGraphMaxParallelism int32
+	FlowGraphDedupWindow    int
 	FlushInsertBufferSize   int64
 	InsertBinlogRootPath    string
 	StatsBinlogRootPath     string
+	BinlogPayloadOptions    map[schemapb.DataType]storage.PayloadOptions
 	Alias                   string // Different datanode in one machine
 
 	// === DataNode External Components Configs ===
@@ -97,9 +101,11 @@ func (p *ParamTable) Init() {
 	// === DataNode Internal Components Configs ===
 	p.initFlowGraphMaxQueueLength()
 	p.initFlowGraphMaxParallelism()
+	p.initFlowGraphDedupWindow()
 	p.initFlushInsertBufferSize()
 	p.initInsertBinlogRootPath()
 	p.initStatsBinlogRootPath()
+	p.initBinlogPayloadOptions()
 
 	// === DataNode External Components Configs ===
 	// --- Pulsar ---
@@ -140,6 +146,10 @@ func (p *ParamTable) initFlowGraphMaxParallelism() {
 	p.FlowGraphMaxParallelism = p.ParseInt32("dataNode.dataSync.flowGraph.maxParallelism")
 }
 
+func (p *ParamTable) initFlowGraphDedupWindow() {
+	p.FlowGraphDedupWindow = p.ParseInt("dataNode.dataSync.flowGraph.dedupWindow")
+}
+
 // ---- flush configs ----
 func (p *ParamTable) initFlushInsertBufferSize() {
 	p.FlushInsertBufferSize = p.ParseInt64("datanode.flush.insertBufSize")
@@ -162,6 +172,34 @@ func (p *ParamTable) initStatsBinlogRootPath() {
 	p.StatsBinlogRootPath = path.Join(rootPath, "stats_log")
 }
 
+func (p *ParamTable) initBinlogPayloadOptions() {
+	defaultCompression, err := p.LoadWithDefault("dataNode.binlog.compression.default", "none")
+	if err != nil {
+		panic(err)
+	}
+	defaultDictionary := p.ParseBool("dataNode.binlog.dictionary.default", true)
+
+	p.BinlogPayloadOptions = make(map[schemapb.DataType]storage.PayloadOptions)
+	for value, name := range schemapb.DataType_name {
+		dataType := schemapb.DataType(value)
+		if dataType == schemapb.DataType_None {
+			continue
+		}
+		codec, err := p.LoadWithDefault("dataNode.binlog.compression."+name, defaultCompression)
+		if err != nil {
+			panic(err)
+		}
+		compression, err := storage.ParseCompressionType(codec)
+		if err != nil {
+			panic(err)
+		}
+		p.BinlogPayloadOptions[dataType] = storage.PayloadOptions{
+			Compression:      compression,
+			EnableDictionary: p.ParseBool("dataNode.binlog.dictionary."+name, defaultDictionary),
+		}
+	}
+}
+
 // ---- Pulsar ----
 func (p *ParamTable) initPulsarAddress() {
 	url, err := p.Load("_PulsarAddress")
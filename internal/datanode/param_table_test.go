@@ -17,6 +17,8 @@ import (
 	"testing"
 	"time"
 
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -45,6 +47,21 @@ func TestParamTable(t *testing.T) {
 		log.Println("flowGraphMaxParallelism:", maxParallelism)
 	})
 
+	t.Run("Test flowGraphDedupWindow", func(t *testing.T) {
+		window := Params.FlowGraphDedupWindow
+		assert.Equal(t, 8192, window)
+		log.Println("flowGraphDedupWindow:", window)
+	})
+
+	t.Run("Test BinlogPayloadOptions", func(t *testing.T) {
+		opts := Params.BinlogPayloadOptions
+		assert.Equal(t, storage.CompressionZstd, opts[schemapb.DataType_Int64].Compression)
+		assert.True(t, opts[schemapb.DataType_Int64].EnableDictionary)
+		assert.Equal(t, storage.CompressionLz4, opts[schemapb.DataType_FloatVector].Compression)
+		assert.False(t, opts[schemapb.DataType_FloatVector].EnableDictionary)
+		log.Println("BinlogPayloadOptions:", opts)
+	})
+
 	t.Run("Test FlushInsertBufSize", func(t *testing.T) {
 		size := Params.FlushInsertBufferSize
 		log.Println("FlushInsertBufferSize:", size)
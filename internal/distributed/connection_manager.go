@@ -16,6 +16,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
 	"github.com/milvus-io/milvus/internal/util/retry"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
+	"github.com/milvus-io/milvus/internal/util/tlsutil"
 	"github.com/milvus-io/milvus/internal/util/trace"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 	"google.golang.org/grpc"
@@ -358,8 +359,12 @@ func (bct *buildClientTask) Run() {
 		connectGrpcFunc := func() error {
 			opts := trace.GetInterceptorOpts()
 			log.Debug("Grpc connect ", zap.String("Address", bct.sess.Address))
+			credsOpt, err := tlsutil.DialOption()
+			if err != nil {
+				return err
+			}
 			conn, err := grpc.DialContext(bct.ctx, bct.sess.Address,
-				grpc.WithInsecure(), grpc.WithBlock(), grpc.WithTimeout(30*time.Second),
+				credsOpt, grpc.WithBlock(), grpc.WithTimeout(30*time.Second),
 				grpc.WithDisableRetry(),
 				grpc.WithUnaryInterceptor(
 					grpc_middleware.ChainUnaryClient(
@@ -107,6 +107,27 @@ func TestIndexCoordClient(t *testing.T) {
 		assert.Equal(t, len(req.IndexBuildIDs), len(resp.FilePaths))
 	})
 
+	t.Run("EstimateRecall", func(t *testing.T) {
+		req := &indexpb.EstimateRecallRequest{
+			IndexBuildID: 0,
+			Nq:           10,
+			Topk:         10,
+		}
+		resp, err := icc.EstimateRecall(ctx, req)
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+	})
+
+	t.Run("GetRecallEstimations", func(t *testing.T) {
+		req := &indexpb.GetRecallEstimationsRequest{
+			JobIDs: []int64{0},
+		}
+		resp, err := icc.GetRecallEstimations(ctx, req)
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+		assert.Equal(t, len(req.JobIDs), len(resp.Results))
+	})
+
 	t.Run("GetMetrics", func(t *testing.T) {
 		req := &milvuspb.GetMetricsRequest{}
 		resp, err := icc.GetMetrics(ctx, req)
@@ -31,6 +31,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/tlsutil"
 	"github.com/milvus-io/milvus/internal/util/trace"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
@@ -165,6 +166,16 @@ func (s *Server) GetIndexFilePaths(ctx context.Context, req *indexpb.GetIndexFil
 	return s.indexcoord.GetIndexFilePaths(ctx, req)
 }
 
+// EstimateRecall estimates the recall of a finished index build through IndexCoord.
+func (s *Server) EstimateRecall(ctx context.Context, req *indexpb.EstimateRecallRequest) (*indexpb.EstimateRecallResponse, error) {
+	return s.indexcoord.EstimateRecall(ctx, req)
+}
+
+// GetRecallEstimations gets the results of the recall estimation jobs through IndexCoord.
+func (s *Server) GetRecallEstimations(ctx context.Context, req *indexpb.GetRecallEstimationsRequest) (*indexpb.GetRecallEstimationsResponse, error) {
+	return s.indexcoord.GetRecallEstimations(ctx, req)
+}
+
 // GetMetrics gets the metrics info of IndexCoord.
 func (s *Server) GetMetrics(ctx context.Context, request *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
 	return s.indexcoord.GetMetrics(ctx, request)
@@ -185,8 +196,16 @@ func (s *Server) startGrpcLoop(grpcPort int) {
 	ctx, cancel := context.WithCancel(s.loopCtx)
 	defer cancel()
 
+	credsOpt, err := tlsutil.ServerOption()
+	if err != nil {
+		log.Error("failed to load tls credentials", zap.Error(err))
+		s.grpcErrChan <- err
+		return
+	}
+
 	opts := trace.GetInterceptorOpts()
 	s.grpcServer = grpc.NewServer(
+		credsOpt,
 		grpc.MaxRecvMsgSize(Params.ServerMaxRecvSize),
 		grpc.MaxSendMsgSize(Params.ServerMaxSendSize),
 		grpc.UnaryInterceptor(ot.UnaryServerInterceptor(opts...)),
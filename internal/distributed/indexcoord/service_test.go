@@ -95,6 +95,27 @@ func TestIndexCoordinateServer(t *testing.T) {
 		assert.Equal(t, len(req.IndexBuildIDs), len(resp.FilePaths))
 	})
 
+	t.Run("EstimateRecall", func(t *testing.T) {
+		req := &indexpb.EstimateRecallRequest{
+			IndexBuildID: 0,
+			Nq:           10,
+			Topk:         10,
+		}
+		resp, err := indexCoord.EstimateRecall(ctx, req)
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+	})
+
+	t.Run("GetRecallEstimations", func(t *testing.T) {
+		req := &indexpb.GetRecallEstimationsRequest{
+			JobIDs: []int64{0},
+		}
+		resp, err := indexCoord.GetRecallEstimations(ctx, req)
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+		assert.Equal(t, len(req.JobIDs), len(resp.Results))
+	})
+
 	t.Run("GetMetrics", func(t *testing.T) {
 		req := &milvuspb.GetMetricsRequest{
 			Request: "",
@@ -47,6 +47,14 @@ func (m *MockIndexNodeClient) CreateIndex(ctx context.Context, in *indexpb.Creat
 	return &commonpb.Status{}, m.err
 }
 
+func (m *MockIndexNodeClient) CancelIndexBuild(ctx context.Context, in *indexpb.CancelIndexBuildRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.err
+}
+
+func (m *MockIndexNodeClient) EstimateRecall(ctx context.Context, in *indexpb.EstimateRecallRequest, opts ...grpc.CallOption) (*indexpb.EstimateRecallResponse, error) {
+	return &indexpb.EstimateRecallResponse{}, m.err
+}
+
 func (m *MockIndexNodeClient) GetMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error) {
 	return &milvuspb.GetMetricsResponse{}, m.err
 }
@@ -97,6 +105,12 @@ func Test_NewClient(t *testing.T) {
 
 		r5, err := client.GetMetrics(ctx, nil)
 		retCheck(retNotNil, r5, err)
+
+		r6, err := client.CancelIndexBuild(ctx, nil)
+		retCheck(retNotNil, r6, err)
+
+		r7, err := client.EstimateRecall(ctx, nil)
+		retCheck(retNotNil, r7, err)
 	}
 
 	client.getGrpcClient = func() (indexpb.IndexNodeClient, error) {
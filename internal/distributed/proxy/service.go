@@ -28,6 +28,8 @@ import (
 	grpcquerycoordclient "github.com/milvus-io/milvus/internal/distributed/querycoord/client"
 	rcc "github.com/milvus-io/milvus/internal/distributed/rootcoord/client"
 
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	grpc_auth "github.com/grpc-ecosystem/go-grpc-middleware/auth"
 	grpc_opentracing "github.com/grpc-ecosystem/go-grpc-middleware/tracing/opentracing"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/msgstream"
@@ -37,6 +39,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/proxypb"
 	"github.com/milvus-io/milvus/internal/proxy"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/tlsutil"
 	"github.com/milvus-io/milvus/internal/util/trace"
 	"github.com/opentracing/opentracing-go"
 )
@@ -92,15 +95,29 @@ func (s *Server) startGrpcLoop(grpcPort int) {
 	ctx, cancel := context.WithCancel(s.ctx)
 	defer cancel()
 
+	credsOpt, err := tlsutil.ProxyServerOption()
+	if err != nil {
+		log.Error("failed to load tls credentials", zap.Error(err))
+		s.grpcErrChan <- err
+		return
+	}
+
 	opts := trace.GetInterceptorOpts()
 	s.grpcServer = grpc.NewServer(
+		credsOpt,
 		grpc.MaxRecvMsgSize(Params.ServerMaxRecvSize),
 		grpc.MaxSendMsgSize(Params.ServerMaxSendSize),
 		grpc.MaxRecvMsgSize(GRPCMaxMagSize),
-		grpc.UnaryInterceptor(
-			grpc_opentracing.UnaryServerInterceptor(opts...)),
-		grpc.StreamInterceptor(
-			grpc_opentracing.StreamServerInterceptor(opts...)))
+		grpc.UnaryInterceptor(grpc_middleware.ChainUnaryServer(
+			grpc_opentracing.UnaryServerInterceptor(opts...),
+			tlsutil.InternalUnaryServerInterceptor("/milvus.proto.proxy.Proxy/"),
+			grpc_auth.UnaryServerInterceptor(proxy.AuthenticationInterceptor),
+			proxy.PrivilegeUnaryServerInterceptor,
+		)),
+		grpc.StreamInterceptor(grpc_middleware.ChainStreamServer(
+			grpc_opentracing.StreamServerInterceptor(opts...),
+			grpc_auth.StreamServerInterceptor(proxy.AuthenticationInterceptor),
+		)))
 	proxypb.RegisterProxyServer(s.grpcServer, s)
 	milvuspb.RegisterMilvusServiceServer(s.grpcServer, s)
 
@@ -135,6 +152,10 @@ func (s *Server) init() error {
 
 	proxy.Params.InitOnce()
 	log.Debug("init params done ...")
+	if proxy.Params.AuthorizationEnabled && !tlsutil.InternalCredentialEnabled() {
+		log.Warn("authorization is enabled without internal credential, the calls from other components to proxy will be rejected, " +
+			"please set common.security.internalToken or common.security.internalTlsEnabled")
+	}
 
 	// NetworkPort & IP don't matter here, NetworkAddress matters
 	proxy.Params.NetworkPort = Params.Port
@@ -352,6 +373,10 @@ func (s *Server) DropIndex(ctx context.Context, request *milvuspb.DropIndexReque
 	return s.proxy.DropIndex(ctx, request)
 }
 
+func (s *Server) AlterIndex(ctx context.Context, request *milvuspb.AlterIndexRequest) (*commonpb.Status, error) {
+	return s.proxy.AlterIndex(ctx, request)
+}
+
 func (s *Server) DescribeIndex(ctx context.Context, request *milvuspb.DescribeIndexRequest) (*milvuspb.DescribeIndexResponse, error) {
 	return s.proxy.DescribeIndex(ctx, request)
 }
@@ -366,6 +391,16 @@ func (s *Server) GetIndexState(ctx context.Context, request *milvuspb.GetIndexSt
 	return s.proxy.GetIndexState(ctx, request)
 }
 
+// GetIndexStatistics gets the type, params, file size, build time and IndexNode of the index on every segment.
+func (s *Server) GetIndexStatistics(ctx context.Context, request *milvuspb.GetIndexStatisticsRequest) (*milvuspb.GetIndexStatisticsResponse, error) {
+	return s.proxy.GetIndexStatistics(ctx, request)
+}
+
+// EstimateIndexRecall estimates the recall@topk of the index on the segments.
+func (s *Server) EstimateIndexRecall(ctx context.Context, request *milvuspb.EstimateIndexRecallRequest) (*milvuspb.EstimateIndexRecallResponse, error) {
+	return s.proxy.EstimateIndexRecall(ctx, request)
+}
+
 func (s *Server) Insert(ctx context.Context, request *milvuspb.InsertRequest) (*milvuspb.MutationResult, error) {
 	return s.proxy.Insert(ctx, request)
 }
@@ -426,3 +461,73 @@ func (s *Server) DropAlias(ctx context.Context, request *milvuspb.DropAliasReque
 func (s *Server) AlterAlias(ctx context.Context, request *milvuspb.AlterAliasRequest) (*commonpb.Status, error) {
 	return s.proxy.AlterAlias(ctx, request)
 }
+
+// InvalidateCredentialCache notifies Proxy to clear the cached credential of specific user
+func (s *Server) InvalidateCredentialCache(ctx context.Context, request *proxypb.InvalidateCredCacheRequest) (*commonpb.Status, error) {
+	return s.proxy.InvalidateCredentialCache(ctx, request)
+}
+
+// CreateCredential create new user and password
+func (s *Server) CreateCredential(ctx context.Context, req *milvuspb.CreateCredentialRequest) (*commonpb.Status, error) {
+	return s.proxy.CreateCredential(ctx, req)
+}
+
+// UpdateCredential update password for a user
+func (s *Server) UpdateCredential(ctx context.Context, req *milvuspb.UpdateCredentialRequest) (*commonpb.Status, error) {
+	return s.proxy.UpdateCredential(ctx, req)
+}
+
+// DeleteCredential delete a user
+func (s *Server) DeleteCredential(ctx context.Context, req *milvuspb.DeleteCredentialRequest) (*commonpb.Status, error) {
+	return s.proxy.DeleteCredential(ctx, req)
+}
+
+// ListCredUsers list all usernames
+func (s *Server) ListCredUsers(ctx context.Context, req *milvuspb.ListCredUsersRequest) (*milvuspb.ListCredUsersResponse, error) {
+	return s.proxy.ListCredUsers(ctx, req)
+}
+
+// InvalidatePolicyInfoCache notifies Proxy to clear the cached grants and user-role bindings
+func (s *Server) InvalidatePolicyInfoCache(ctx context.Context, request *proxypb.InvalidatePolicyInfoCacheRequest) (*commonpb.Status, error) {
+	return s.proxy.InvalidatePolicyInfoCache(ctx, request)
+}
+
+// SetQuotaFactors notifies Proxy to throttle dml and dql requests by the factors of the quota center
+func (s *Server) SetQuotaFactors(ctx context.Context, request *proxypb.SetQuotaFactorsRequest) (*commonpb.Status, error) {
+	return s.proxy.SetQuotaFactors(ctx, request)
+}
+
+// CreateRole create a new role
+func (s *Server) CreateRole(ctx context.Context, req *milvuspb.CreateRoleRequest) (*commonpb.Status, error) {
+	return s.proxy.CreateRole(ctx, req)
+}
+
+// DropRole drop a role
+func (s *Server) DropRole(ctx context.Context, req *milvuspb.DropRoleRequest) (*commonpb.Status, error) {
+	return s.proxy.DropRole(ctx, req)
+}
+
+// OperateUserRole bind a user to a role, or unbind it
+func (s *Server) OperateUserRole(ctx context.Context, req *milvuspb.OperateUserRoleRequest) (*commonpb.Status, error) {
+	return s.proxy.OperateUserRole(ctx, req)
+}
+
+// SelectRole select roles with their users
+func (s *Server) SelectRole(ctx context.Context, req *milvuspb.SelectRoleRequest) (*milvuspb.SelectRoleResponse, error) {
+	return s.proxy.SelectRole(ctx, req)
+}
+
+// SelectUser select users with their roles
+func (s *Server) SelectUser(ctx context.Context, req *milvuspb.SelectUserRequest) (*milvuspb.SelectUserResponse, error) {
+	return s.proxy.SelectUser(ctx, req)
+}
+
+// OperatePrivilege grant a privilege to a role, or revoke it
+func (s *Server) OperatePrivilege(ctx context.Context, req *milvuspb.OperatePrivilegeRequest) (*commonpb.Status, error) {
+	return s.proxy.OperatePrivilege(ctx, req)
+}
+
+// SelectGrant select the grants of a role
+func (s *Server) SelectGrant(ctx context.Context, req *milvuspb.SelectGrantRequest) (*milvuspb.SelectGrantResponse, error) {
+	return s.proxy.SelectGrant(ctx, req)
+}
@@ -57,6 +57,10 @@ func (m *MockQueryNodeClient) ReleaseSegments(ctx context.Context, in *querypb.R
 	return &commonpb.Status{}, m.err
 }
 
+func (m *MockQueryNodeClient) SwapSegmentIndex(ctx context.Context, in *querypb.SwapSegmentIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.err
+}
+
 func (m *MockQueryNodeClient) GetSegmentInfo(ctx context.Context, in *querypb.GetSegmentInfoRequest, opts ...grpc.CallOption) (*querypb.GetSegmentInfoResponse, error) {
 	return &querypb.GetSegmentInfoResponse{}, m.err
 }
@@ -94,6 +94,10 @@ func (m *MockQueryNode) ReleaseSegments(ctx context.Context, req *querypb.Releas
 	return m.status, m.err
 }
 
+func (m *MockQueryNode) SwapSegmentIndex(ctx context.Context, req *querypb.SwapSegmentIndexRequest) (*commonpb.Status, error) {
+	return m.status, m.err
+}
+
 func (m *MockQueryNode) GetSegmentInfo(ctx context.Context, req *querypb.GetSegmentInfoRequest) (*querypb.GetSegmentInfoResponse, error) {
 	return m.infoResp, m.err
 }
@@ -69,6 +69,66 @@ func (m *MockRootCoordClient) AlterAlias(ctx context.Context, in *milvuspb.Alter
 	return &commonpb.Status{}, m.err
 }
 
+func (m *MockRootCoordClient) CreateCredential(ctx context.Context, in *internalpb.CredentialInfo, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.err
+}
+
+func (m *MockRootCoordClient) GetCredential(ctx context.Context, in *rootcoordpb.GetCredentialRequest, opts ...grpc.CallOption) (*rootcoordpb.GetCredentialResponse, error) {
+	return &rootcoordpb.GetCredentialResponse{}, m.err
+}
+
+func (m *MockRootCoordClient) UpdateCredential(ctx context.Context, in *internalpb.CredentialInfo, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.err
+}
+
+func (m *MockRootCoordClient) DeleteCredential(ctx context.Context, in *milvuspb.DeleteCredentialRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.err
+}
+
+func (m *MockRootCoordClient) ListCredUsers(ctx context.Context, in *milvuspb.ListCredUsersRequest, opts ...grpc.CallOption) (*milvuspb.ListCredUsersResponse, error) {
+	return &milvuspb.ListCredUsersResponse{}, m.err
+}
+
+func (m *MockRootCoordClient) CreateRole(ctx context.Context, in *milvuspb.CreateRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.err
+}
+
+func (m *MockRootCoordClient) DropRole(ctx context.Context, in *milvuspb.DropRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.err
+}
+
+func (m *MockRootCoordClient) OperateUserRole(ctx context.Context, in *milvuspb.OperateUserRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.err
+}
+
+func (m *MockRootCoordClient) SelectRole(ctx context.Context, in *milvuspb.SelectRoleRequest, opts ...grpc.CallOption) (*milvuspb.SelectRoleResponse, error) {
+	return &milvuspb.SelectRoleResponse{}, m.err
+}
+
+func (m *MockRootCoordClient) SelectUser(ctx context.Context, in *milvuspb.SelectUserRequest, opts ...grpc.CallOption) (*milvuspb.SelectUserResponse, error) {
+	return &milvuspb.SelectUserResponse{}, m.err
+}
+
+func (m *MockRootCoordClient) OperatePrivilege(ctx context.Context, in *milvuspb.OperatePrivilegeRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.err
+}
+
+func (m *MockRootCoordClient) SelectGrant(ctx context.Context, in *milvuspb.SelectGrantRequest, opts ...grpc.CallOption) (*milvuspb.SelectGrantResponse, error) {
+	return &milvuspb.SelectGrantResponse{}, m.err
+}
+
+func (m *MockRootCoordClient) ListPolicy(ctx context.Context, in *rootcoordpb.ListPolicyRequest, opts ...grpc.CallOption) (*rootcoordpb.ListPolicyResponse, error) {
+	return &rootcoordpb.ListPolicyResponse{}, m.err
+}
+
+func (m *MockRootCoordClient) ListMsgTopics(ctx context.Context, in *rootcoordpb.ListMsgTopicsRequest, opts ...grpc.CallOption) (*rootcoordpb.ListMsgTopicsResponse, error) {
+	return &rootcoordpb.ListMsgTopicsResponse{}, m.err
+}
+
+func (m *MockRootCoordClient) PurgeMsgTopics(ctx context.Context, in *rootcoordpb.PurgeMsgTopicsRequest, opts ...grpc.CallOption) (*rootcoordpb.PurgeMsgTopicsResponse, error) {
+	return &rootcoordpb.PurgeMsgTopicsResponse{}, m.err
+}
+
 func (m *MockRootCoordClient) ShowCollections(ctx context.Context, in *milvuspb.ShowCollectionsRequest, opts ...grpc.CallOption) (*milvuspb.ShowCollectionsResponse, error) {
 	return &milvuspb.ShowCollectionsResponse{}, m.err
 }
@@ -109,6 +169,10 @@ func (m *MockRootCoordClient) DropIndex(ctx context.Context, in *milvuspb.DropIn
 	return &commonpb.Status{}, m.err
 }
 
+func (m *MockRootCoordClient) AlterIndex(ctx context.Context, in *milvuspb.AlterIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.err
+}
+
 func (m *MockRootCoordClient) AllocTimestamp(ctx context.Context, in *rootcoordpb.AllocTimestampRequest, opts ...grpc.CallOption) (*rootcoordpb.AllocTimestampResponse, error) {
 	return &rootcoordpb.AllocTimestampResponse{}, m.err
 }
@@ -238,6 +302,51 @@ func Test_NewClient(t *testing.T) {
 
 		r26, err := client.AlterAlias(ctx, nil)
 		retCheck(retNotNil, r26, err)
+
+		r27, err := client.CreateCredential(ctx, nil)
+		retCheck(retNotNil, r27, err)
+
+		r28, err := client.GetCredential(ctx, nil)
+		retCheck(retNotNil, r28, err)
+
+		r29, err := client.UpdateCredential(ctx, nil)
+		retCheck(retNotNil, r29, err)
+
+		r30, err := client.DeleteCredential(ctx, nil)
+		retCheck(retNotNil, r30, err)
+
+		r31, err := client.ListCredUsers(ctx, nil)
+		retCheck(retNotNil, r31, err)
+
+		r32, err := client.CreateRole(ctx, nil)
+		retCheck(retNotNil, r32, err)
+
+		r33, err := client.DropRole(ctx, nil)
+		retCheck(retNotNil, r33, err)
+
+		r34, err := client.OperateUserRole(ctx, nil)
+		retCheck(retNotNil, r34, err)
+
+		r35, err := client.SelectRole(ctx, nil)
+		retCheck(retNotNil, r35, err)
+
+		r36, err := client.SelectUser(ctx, nil)
+		retCheck(retNotNil, r36, err)
+
+		r37, err := client.OperatePrivilege(ctx, nil)
+		retCheck(retNotNil, r37, err)
+
+		r38, err := client.SelectGrant(ctx, nil)
+		retCheck(retNotNil, r38, err)
+
+		r39, err := client.ListPolicy(ctx, nil)
+		retCheck(retNotNil, r39, err)
+
+		r40, err := client.ListMsgTopics(ctx, nil)
+		retCheck(retNotNil, r40, err)
+
+		r41, err := client.PurgeMsgTopics(ctx, nil)
+		retCheck(retNotNil, r41, err)
 	}
 
 	client.getGrpcClient = func() (rootcoordpb.RootCoordClient, error) {
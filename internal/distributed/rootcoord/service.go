@@ -38,6 +38,7 @@ import (
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
+	"github.com/milvus-io/milvus/internal/util/tlsutil"
 	"github.com/milvus-io/milvus/internal/util/trace"
 )
 
@@ -75,6 +76,81 @@ func (s *Server) AlterAlias(ctx context.Context, request *milvuspb.AlterAliasReq
 	return s.rootCoord.AlterAlias(ctx, request)
 }
 
+// CreateCredential create new user and password
+func (s *Server) CreateCredential(ctx context.Context, request *internalpb.CredentialInfo) (*commonpb.Status, error) {
+	return s.rootCoord.CreateCredential(ctx, request)
+}
+
+// GetCredential get credential by username
+func (s *Server) GetCredential(ctx context.Context, request *rootcoordpb.GetCredentialRequest) (*rootcoordpb.GetCredentialResponse, error) {
+	return s.rootCoord.GetCredential(ctx, request)
+}
+
+// UpdateCredential update password for a user
+func (s *Server) UpdateCredential(ctx context.Context, request *internalpb.CredentialInfo) (*commonpb.Status, error) {
+	return s.rootCoord.UpdateCredential(ctx, request)
+}
+
+// DeleteCredential delete a user
+func (s *Server) DeleteCredential(ctx context.Context, request *milvuspb.DeleteCredentialRequest) (*commonpb.Status, error) {
+	return s.rootCoord.DeleteCredential(ctx, request)
+}
+
+// ListCredUsers list all usernames
+func (s *Server) ListCredUsers(ctx context.Context, request *milvuspb.ListCredUsersRequest) (*milvuspb.ListCredUsersResponse, error) {
+	return s.rootCoord.ListCredUsers(ctx, request)
+}
+
+// CreateRole create a new role
+func (s *Server) CreateRole(ctx context.Context, request *milvuspb.CreateRoleRequest) (*commonpb.Status, error) {
+	return s.rootCoord.CreateRole(ctx, request)
+}
+
+// DropRole drop a role
+func (s *Server) DropRole(ctx context.Context, request *milvuspb.DropRoleRequest) (*commonpb.Status, error) {
+	return s.rootCoord.DropRole(ctx, request)
+}
+
+// OperateUserRole bind a user to a role, or unbind it
+func (s *Server) OperateUserRole(ctx context.Context, request *milvuspb.OperateUserRoleRequest) (*commonpb.Status, error) {
+	return s.rootCoord.OperateUserRole(ctx, request)
+}
+
+// SelectRole select roles with their users
+func (s *Server) SelectRole(ctx context.Context, request *milvuspb.SelectRoleRequest) (*milvuspb.SelectRoleResponse, error) {
+	return s.rootCoord.SelectRole(ctx, request)
+}
+
+// SelectUser select users with their roles
+func (s *Server) SelectUser(ctx context.Context, request *milvuspb.SelectUserRequest) (*milvuspb.SelectUserResponse, error) {
+	return s.rootCoord.SelectUser(ctx, request)
+}
+
+// OperatePrivilege grant a privilege to a role, or revoke it
+func (s *Server) OperatePrivilege(ctx context.Context, request *milvuspb.OperatePrivilegeRequest) (*commonpb.Status, error) {
+	return s.rootCoord.OperatePrivilege(ctx, request)
+}
+
+// SelectGrant select the grants of a role
+func (s *Server) SelectGrant(ctx context.Context, request *milvuspb.SelectGrantRequest) (*milvuspb.SelectGrantResponse, error) {
+	return s.rootCoord.SelectGrant(ctx, request)
+}
+
+// ListPolicy list all the grants and user-role bindings
+func (s *Server) ListPolicy(ctx context.Context, request *rootcoordpb.ListPolicyRequest) (*rootcoordpb.ListPolicyResponse, error) {
+	return s.rootCoord.ListPolicy(ctx, request)
+}
+
+// ListMsgTopics list the topics and subscriptions created by milvus in the message queue
+func (s *Server) ListMsgTopics(ctx context.Context, request *rootcoordpb.ListMsgTopicsRequest) (*rootcoordpb.ListMsgTopicsResponse, error) {
+	return s.rootCoord.ListMsgTopics(ctx, request)
+}
+
+// PurgeMsgTopics delete the topics and subscriptions no longer referenced
+func (s *Server) PurgeMsgTopics(ctx context.Context, request *rootcoordpb.PurgeMsgTopicsRequest) (*rootcoordpb.PurgeMsgTopicsResponse, error) {
+	return s.rootCoord.PurgeMsgTopics(ctx, request)
+}
+
 func NewServer(ctx context.Context, factory msgstream.Factory) (*Server, error) {
 	ctx1, cancel := context.WithCancel(ctx)
 	s := &Server{
@@ -216,8 +292,16 @@ func (s *Server) startGrpcLoop(grpcPort int) {
 	ctx, cancel := context.WithCancel(s.ctx)
 	defer cancel()
 
+	credsOpt, err := tlsutil.ServerOption()
+	if err != nil {
+		log.Error("failed to load tls credentials", zap.Error(err))
+		s.grpcErrChan <- err
+		return
+	}
+
 	opts := trace.GetInterceptorOpts()
 	s.grpcServer = grpc.NewServer(
+		credsOpt,
 		grpc.MaxRecvMsgSize(Params.ServerMaxRecvSize),
 		grpc.MaxSendMsgSize(Params.ServerMaxSendSize),
 		grpc.UnaryInterceptor(grpc_opentracing.UnaryServerInterceptor(opts...)),
@@ -337,6 +421,10 @@ func (s *Server) DropIndex(ctx context.Context, in *milvuspb.DropIndexRequest) (
 	return s.rootCoord.DropIndex(ctx, in)
 }
 
+func (s *Server) AlterIndex(ctx context.Context, in *milvuspb.AlterIndexRequest) (*commonpb.Status, error) {
+	return s.rootCoord.AlterIndex(ctx, in)
+}
+
 func (s *Server) DescribeIndex(ctx context.Context, in *milvuspb.DescribeIndexRequest) (*milvuspb.DescribeIndexResponse, error) {
 	return s.rootCoord.DescribeIndex(ctx, in)
 }
@@ -0,0 +1,239 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package indexcoord
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"strconv"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/util/indexparamcheck"
+)
+
+// A segment whose index build needs more memory than any IndexNode has is indexed in steps on different IndexNodes:
+// the index is trained on a sample of the segment, every shard of the segment is added to a copy of the trained
+// index, then the shard indexes are merged in segment order by the task of the segment itself. The train and shard
+// tasks are recorded as index builds whose parent is the build of the segment.
+
+// sortDataPaths sorts the binlog paths by the log ID in the last path element, which is the order of the rows in the
+// segment.
+func sortDataPaths(dataPaths []string) []string {
+	sorted := make([]string, len(dataPaths))
+	copy(sorted, dataPaths)
+	logID := func(p string) int64 {
+		id, err := strconv.ParseInt(path.Base(p), 10, 64)
+		if err != nil {
+			return -1
+		}
+		return id
+	}
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return logID(sorted[i]) < logID(sorted[j])
+	})
+	return sorted
+}
+
+// splitDataPaths splits the sorted binlog paths into shardNum contiguous shards, the rows of a shard are estimated
+// by its share of binlogs.
+func splitDataPaths(dataPaths []string, numRows int64, shardNum int) []*indexpb.ShardDataPaths {
+	shards := make([]*indexpb.ShardDataPaths, 0, shardNum)
+	for i := 0; i < shardNum; i++ {
+		begin, end := i*len(dataPaths)/shardNum, (i+1)*len(dataPaths)/shardNum
+		shards = append(shards, &indexpb.ShardDataPaths{
+			DataPaths: dataPaths[begin:end],
+			NumRows:   numRows * int64(end-begin) / int64(len(dataPaths)),
+		})
+	}
+	return shards
+}
+
+// sampleDataPaths picks one of every shardNum binlogs across the segment to train the index on.
+func sampleDataPaths(dataPaths []string, shardNum int) []string {
+	var sample []string
+	for i := shardNum / 2; i < len(dataPaths); i += shardNum {
+		sample = append(sample, dataPaths[i])
+	}
+	return sample
+}
+
+func childBuildRequest(req *indexpb.BuildIndexRequest, step indexpb.IndexBuildStep, dataPaths []string,
+	numRows int64) *indexpb.BuildIndexRequest {
+	return &indexpb.BuildIndexRequest{
+		IndexName:   req.IndexName,
+		IndexID:     req.IndexID,
+		DataPaths:   dataPaths,
+		TypeParams:  req.TypeParams,
+		IndexParams: req.IndexParams,
+		NumRows:     numRows,
+		Step:        step,
+	}
+}
+
+// planShards returns the fewest shards of the segment that fit in an IndexNode each, or nil if the build can't be
+// split by its index type. An error is returned if the build can be split, but the merged index or the shards don't
+// fit in any IndexNode. exceedsAllNodes tells whether the memory is more than any IndexNode has.
+func planShards(req *indexpb.BuildIndexRequest, exceedsAllNodes func(memory uint64) bool) ([]*indexpb.ShardDataPaths, error) {
+	indexType := flattenParams(req.GetIndexParams())[indexparamcheck.IndexTypeKey]
+	if req.GetStep() != indexpb.IndexBuildStep_BuildAll || req.GetNumRows() <= 0 ||
+		!indexparamcheck.IsDistributedBuildIndexType(indexType) {
+		return nil, nil
+	}
+	merge := childBuildRequest(req, indexpb.IndexBuildStep_Merge, nil, req.GetNumRows())
+	if memory := estimateIndexBuildMemory(merge); exceedsAllNodes(memory) {
+		return nil, fmt.Errorf("merging the index of %d rows needs %d bytes of memory, more than any IndexNode has",
+			req.GetNumRows(), memory)
+	}
+	dataPaths := sortDataPaths(req.GetDataPaths())
+	for shardNum := 2; shardNum <= len(dataPaths); shardNum++ {
+		shards := splitDataPaths(dataPaths, req.GetNumRows(), shardNum)
+		fits := true
+		for _, shard := range shards {
+			shardReq := childBuildRequest(req, indexpb.IndexBuildStep_AddShard, shard.DataPaths, shard.NumRows)
+			if exceedsAllNodes(estimateIndexBuildMemory(shardReq)) {
+				fits = false
+				break
+			}
+		}
+		if fits {
+			return shards, nil
+		}
+	}
+	return nil, fmt.Errorf("adding a shard of one binlog of the %d binlogs needs more memory than any IndexNode has",
+		len(dataPaths))
+}
+
+// splitIndexBuild splits the build of a segment which is too large for any IndexNode, returns false if the build
+// can't be split now. An error is returned if the build can't be split to fit in the IndexNodes.
+func (i *IndexCoord) splitIndexBuild(meta Meta) (bool, error) {
+	indexBuildID := meta.indexMeta.IndexBuildID
+	if meta.indexMeta.ParentBuildID != 0 || meta.indexMeta.DistributedBuild != nil {
+		return false, nil
+	}
+	shards, err := planShards(meta.indexMeta.Req, i.nodeManager.ExceedsAllNodes)
+	if shards == nil {
+		return false, err
+	}
+	trainBuildID, err := i.idAllocator.AllocOne()
+	if err != nil {
+		log.Warn("IndexCoord splitIndexBuild alloc ID failed", zap.Int64("indexBuildID", indexBuildID), zap.Error(err))
+		return false, nil
+	}
+	dataPaths := sortDataPaths(meta.indexMeta.Req.GetDataPaths())
+	sample := sampleDataPaths(dataPaths, len(shards))
+	numRows := meta.indexMeta.Req.GetNumRows() * int64(len(sample)) / int64(len(dataPaths))
+	trainReq := childBuildRequest(meta.indexMeta.Req, indexpb.IndexBuildStep_Train, sample, numRows)
+	if err = i.metaTable.SplitIndexBuild(indexBuildID, trainBuildID, trainReq, shards); err != nil {
+		log.Warn("IndexCoord splitIndexBuild failed", zap.Int64("indexBuildID", indexBuildID), zap.Error(err))
+		return false, nil
+	}
+	log.Debug("IndexCoord split the index build across IndexNodes", zap.Int64("indexBuildID", indexBuildID),
+		zap.Int64("trainBuildID", trainBuildID), zap.Int("shards", len(shards)))
+	return true, nil
+}
+
+// advanceDistributedBuilds starts the next step of the builds split across IndexNodes when the current one is done.
+func (i *IndexCoord) advanceDistributedBuilds() {
+	for _, meta := range i.metaTable.GetDistributedBuilds() {
+		indexBuildID := meta.indexMeta.IndexBuildID
+		build := meta.indexMeta.DistributedBuild
+		if meta.indexMeta.MarkDeleted || meta.indexMeta.State == commonpb.IndexState_Finished ||
+			meta.indexMeta.State == commonpb.IndexState_Failed {
+			if err := i.metaTable.ReleaseDistributedBuild(indexBuildID); err != nil {
+				log.Warn("IndexCoord ReleaseDistributedBuild failed", zap.Int64("indexBuildID", indexBuildID), zap.Error(err))
+			}
+			continue
+		}
+		var err error
+		switch build.Step {
+		case indexpb.IndexBuildStep_Train:
+			err = i.advanceTrainStep(meta)
+		case indexpb.IndexBuildStep_AddShard:
+			err = i.advanceAddShardStep(meta)
+		}
+		if err != nil {
+			log.Warn("IndexCoord advanceDistributedBuilds failed", zap.Int64("indexBuildID", indexBuildID),
+				zap.String("step", build.Step.String()), zap.Error(err))
+		}
+	}
+}
+
+func (i *IndexCoord) advanceTrainStep(meta Meta) error {
+	indexBuildID := meta.indexMeta.IndexBuildID
+	build := meta.indexMeta.DistributedBuild
+	train := i.metaTable.GetIndexMetaByIndexBuildID(build.TrainBuildID)
+	if train == nil {
+		return i.metaTable.MarkIndexAsFailed(indexBuildID, fmt.Sprintf("the train task %d is lost", build.TrainBuildID))
+	}
+	switch train.State {
+	case commonpb.IndexState_Failed:
+		return i.metaTable.MarkIndexAsFailed(indexBuildID, "training the index failed: "+train.FailReason)
+	case commonpb.IndexState_Finished:
+		start, _, err := i.idAllocator.Alloc(uint32(len(build.Shards)))
+		if err != nil {
+			return err
+		}
+		trained := &indexpb.IndexFilePathInfo{
+			IndexBuildID:   build.TrainBuildID,
+			IndexFilePaths: train.IndexFilePaths,
+		}
+		shardBuildIDs := make([]UniqueID, 0, len(build.Shards))
+		shardReqs := make([]*indexpb.BuildIndexRequest, 0, len(build.Shards))
+		for idx, shard := range build.Shards {
+			shardReq := childBuildRequest(meta.indexMeta.Req, indexpb.IndexBuildStep_AddShard, shard.DataPaths, shard.NumRows)
+			shardReq.InputIndexes = []*indexpb.IndexFilePathInfo{trained}
+			shardBuildIDs = append(shardBuildIDs, start+UniqueID(idx))
+			shardReqs = append(shardReqs, shardReq)
+		}
+		return i.metaTable.AddShardBuilds(indexBuildID, shardBuildIDs, shardReqs)
+	}
+	return nil
+}
+
+func (i *IndexCoord) advanceAddShardStep(meta Meta) error {
+	indexBuildID := meta.indexMeta.IndexBuildID
+	build := meta.indexMeta.DistributedBuild
+	var indexedRows, totalRows int64
+	shardIndexes := make([]*indexpb.IndexFilePathInfo, 0, len(build.ShardBuildIDs))
+	for idx, shardBuildID := range build.ShardBuildIDs {
+		shard := i.metaTable.GetIndexMetaByIndexBuildID(shardBuildID)
+		if shard == nil {
+			return i.metaTable.MarkIndexAsFailed(indexBuildID, fmt.Sprintf("the shard task %d is lost", shardBuildID))
+		}
+		if shard.State == commonpb.IndexState_Failed {
+			return i.metaTable.MarkIndexAsFailed(indexBuildID,
+				fmt.Sprintf("adding shard %d to the index failed: %s", idx, shard.FailReason))
+		}
+		indexedRows += shard.IndexedRows
+		if shard.TotalRows > 0 {
+			totalRows += shard.TotalRows
+		} else {
+			totalRows += build.Shards[idx].NumRows
+		}
+		if shard.State == commonpb.IndexState_Finished {
+			shardIndexes = append(shardIndexes, &indexpb.IndexFilePathInfo{
+				IndexBuildID:   shardBuildID,
+				IndexFilePaths: shard.IndexFilePaths,
+			})
+		}
+	}
+	if len(shardIndexes) < len(build.ShardBuildIDs) {
+		return i.metaTable.UpdateIndexProgress(indexBuildID, indexedRows, totalRows)
+	}
+	log.Debug("IndexCoord all shards are added, merge the shard indexes", zap.Int64("indexBuildID", indexBuildID))
+	return i.metaTable.MergeShardBuilds(indexBuildID, shardIndexes)
+}
@@ -0,0 +1,99 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package indexcoord
+
+import (
+	"testing"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSortDataPaths(t *testing.T) {
+	dataPaths := []string{"insert_log/1/2/3/100/12", "insert_log/1/2/3/100/3", "insert_log/1/2/3/100/100"}
+	assert.Equal(t, []string{"insert_log/1/2/3/100/3", "insert_log/1/2/3/100/12", "insert_log/1/2/3/100/100"},
+		sortDataPaths(dataPaths))
+	assert.Equal(t, "insert_log/1/2/3/100/12", dataPaths[0])
+}
+
+func TestSplitDataPaths(t *testing.T) {
+	dataPaths := []string{"a/1", "a/2", "a/3", "a/4", "a/5"}
+	shards := splitDataPaths(dataPaths, 500, 2)
+	assert.Equal(t, 2, len(shards))
+	assert.Equal(t, []string{"a/1", "a/2"}, shards[0].DataPaths)
+	assert.Equal(t, int64(200), shards[0].NumRows)
+	assert.Equal(t, []string{"a/3", "a/4", "a/5"}, shards[1].DataPaths)
+	assert.Equal(t, int64(300), shards[1].NumRows)
+
+	assert.Equal(t, []string{"a/2", "a/4"}, sampleDataPaths(dataPaths, 2))
+	assert.Equal(t, []string{"a/3"}, sampleDataPaths(dataPaths, 5))
+}
+
+func TestPlanShards(t *testing.T) {
+	newReq := func(indexType string) *indexpb.BuildIndexRequest {
+		return &indexpb.BuildIndexRequest{
+			DataPaths:   []string{"a/4", "a/3", "a/2", "a/1"},
+			NumRows:     4000,
+			TypeParams:  []*commonpb.KeyValuePair{{Key: "dim", Value: "128"}},
+			IndexParams: []*commonpb.KeyValuePair{{Key: "index_type", Value: indexType}, {Key: "nlist", Value: "10"}},
+		}
+	}
+	limitedTo := func(limit uint64) func(uint64) bool {
+		return func(memory uint64) bool {
+			return memory > limit
+		}
+	}
+	shardMemory := func(req *indexpb.BuildIndexRequest, numRows int64) uint64 {
+		return estimateIndexBuildMemory(childBuildRequest(req, indexpb.IndexBuildStep_AddShard, nil, numRows))
+	}
+
+	t.Run("fewest shards", func(t *testing.T) {
+		req := newReq("IVF_SQ8")
+		shards, err := planShards(req, limitedTo(shardMemory(req, 2000)))
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(shards))
+		assert.Equal(t, []string{"a/1", "a/2"}, shards[0].DataPaths)
+		assert.Equal(t, []string{"a/3", "a/4"}, shards[1].DataPaths)
+
+		shards, err = planShards(req, limitedTo(shardMemory(req, 1000)))
+		assert.Nil(t, err)
+		assert.Equal(t, 4, len(shards))
+	})
+
+	t.Run("can't split", func(t *testing.T) {
+		shards, err := planShards(newReq("HNSW"), limitedTo(0))
+		assert.Nil(t, err)
+		assert.Nil(t, shards)
+
+		req := newReq("IVF_SQ8")
+		req.NumRows = 0
+		shards, err = planShards(req, limitedTo(0))
+		assert.Nil(t, err)
+		assert.Nil(t, shards)
+	})
+
+	t.Run("shard doesn't fit", func(t *testing.T) {
+		req := newReq("IVF_SQ8")
+		shards, err := planShards(req, limitedTo(shardMemory(req, 999)))
+		assert.NotNil(t, err)
+		assert.Nil(t, shards)
+	})
+
+	t.Run("merged index doesn't fit", func(t *testing.T) {
+		req := newReq("IVF_PQ")
+		merge := childBuildRequest(req, indexpb.IndexBuildStep_Merge, nil, req.NumRows)
+		shards, err := planShards(req, limitedTo(estimateIndexBuildMemory(merge)-1))
+		assert.NotNil(t, err)
+		assert.Nil(t, shards)
+	})
+}
@@ -14,6 +14,7 @@ package indexcoord
 import (
 	"context"
 	"errors"
+	"fmt"
 	"math/rand"
 	"sort"
 	"strconv"
@@ -21,7 +22,6 @@ import (
 	"sync/atomic"
 	"time"
 
-	"go.etcd.io/etcd/api/v3/mvccpb"
 	"go.uber.org/zap"
 
 	"github.com/golang/protobuf/proto"
@@ -37,6 +37,7 @@ import (
 	"github.com/milvus-io/milvus/internal/tso"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util/metricsinfo"
+	"github.com/milvus-io/milvus/internal/util/migration"
 	"github.com/milvus-io/milvus/internal/util/retry"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
 	"github.com/milvus-io/milvus/internal/util/trace"
@@ -69,6 +70,7 @@ type IndexCoord struct {
 
 	metaTable   *metaTable
 	nodeManager *NodeManager
+	recallJobs  *recallJobScheduler
 
 	metricsCacheManager *metricsinfo.MetricsCacheManager
 
@@ -77,10 +79,11 @@ type IndexCoord struct {
 	initOnce  sync.Once
 	startOnce sync.Once
 
-	reqTimeoutInterval time.Duration
-	durationInterval   time.Duration
-	assignTaskInterval time.Duration
-	taskLimit          int
+	reqTimeoutInterval      time.Duration
+	durationInterval        time.Duration
+	assignTaskInterval      time.Duration
+	refreshResourceInterval time.Duration
+	taskLimit               int
 
 	// Add callback functions at different stages
 	startCallbacks []func()
@@ -95,12 +98,13 @@ func NewIndexCoord(ctx context.Context) (*IndexCoord, error) {
 	rand.Seed(time.Now().UnixNano())
 	ctx1, cancel := context.WithCancel(ctx)
 	i := &IndexCoord{
-		loopCtx:            ctx1,
-		loopCancel:         cancel,
-		reqTimeoutInterval: time.Second * 10,
-		durationInterval:   time.Second * 10,
-		assignTaskInterval: time.Second * 3,
-		taskLimit:          20,
+		loopCtx:                 ctx1,
+		loopCancel:              cancel,
+		reqTimeoutInterval:      time.Second * 10,
+		durationInterval:        time.Second * 10,
+		assignTaskInterval:      time.Second * 3,
+		refreshResourceInterval: time.Second * 10,
+		taskLimit:               20,
 	}
 	i.UpdateStateCode(internalpb.StateCode_Abnormal)
 	return i, nil
@@ -125,10 +129,13 @@ func (i *IndexCoord) Init() error {
 		i.UpdateStateCode(internalpb.StateCode_Initializing)
 
 		connectEtcdFn := func() error {
-			etcdKV, err := etcdkv.NewEtcdKV(Params.EtcdEndpoints, Params.MetaRootPath)
+			etcdKV, err := etcdkv.NewMetaKv(Params.EtcdEndpoints, Params.MetaRootPath)
 			if err != nil {
 				return err
 			}
+			if err = migration.CoordinatorSchema(typeutil.IndexCoordRole).Check(etcdKV); err != nil {
+				return err
+			}
 			metakv, err := NewMetaTable(etcdKV)
 			if err != nil {
 				return err
@@ -145,6 +152,7 @@ func (i *IndexCoord) Init() error {
 		}
 		log.Debug("IndexCoord try to connect etcd success")
 		i.nodeManager = NewNodeManager()
+		i.recallJobs = newRecallJobScheduler(i.loopCtx, i.nodeManager, Params.MaxRunningRecallJobs)
 
 		sessions, revision, err := i.session.GetSessions(typeutil.IndexNodeRole)
 		log.Debug("IndexCoord", zap.Int("session number", len(sessions)), zap.Int64("revision", revision))
@@ -238,6 +246,9 @@ func (i *IndexCoord) Start() error {
 		i.loopWg.Add(1)
 		go i.assignTaskLoop()
 
+		i.loopWg.Add(1)
+		go i.refreshResourceLoop()
+
 		i.loopWg.Add(1)
 		go i.watchNodeLoop()
 
@@ -249,6 +260,7 @@ func (i *IndexCoord) Start() error {
 		})
 
 		startErr = i.sched.Start()
+		i.recallJobs.Start()
 
 		i.UpdateStateCode(internalpb.StateCode_Healthy)
 	})
@@ -270,6 +282,7 @@ func (i *IndexCoord) Start() error {
 func (i *IndexCoord) Stop() error {
 	i.loopCancel()
 	i.sched.Close()
+	i.recallJobs.Close()
 	i.loopWg.Wait()
 	for _, cb := range i.closeCallbacks {
 		cb()
@@ -466,6 +479,9 @@ func (i *IndexCoord) DropIndex(ctx context.Context, req *indexpb.DropIndexReques
 			for _, indexBuildID := range unissuedIndexBuildIDs {
 				i.metaTable.DeleteIndex(indexBuildID)
 			}
+			for _, meta := range i.metaTable.GetInProgressTasks(req.IndexID) {
+				i.cancelIndexBuild(meta, fmt.Sprintf("index %d has been dropped", req.IndexID))
+			}
 		}()
 	}()
 
@@ -500,6 +516,76 @@ func (i *IndexCoord) GetIndexFilePaths(ctx context.Context, req *indexpb.GetInde
 	return ret, nil
 }
 
+// EstimateRecall queues a job asking an IndexNode to estimate the recall of a finished index build, the IndexNode
+// loads the index files and the raw data of the segment, then compares the search results of the index with brute
+// force search. Returns the ID of the job, whose result is polled through GetRecallEstimations.
+func (i *IndexCoord) EstimateRecall(ctx context.Context, req *indexpb.EstimateRecallRequest) (*indexpb.EstimateRecallResponse, error) {
+	log.Debug("IndexCoord EstimateRecall", zap.Int64("IndexBuildID", req.IndexBuildID),
+		zap.Int64("nq", req.Nq), zap.Int64("topk", req.Topk))
+	sp, _ := trace.StartSpanFromContextWithOperationName(ctx, "IndexCoord-EstimateRecall")
+	defer sp.Finish()
+
+	ret := &indexpb.EstimateRecallResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+		},
+		IndexBuildID: req.IndexBuildID,
+	}
+	if !i.isHealthy() {
+		ret.Status.Reason = msgIndexCoordIsUnhealthy(i.ID)
+		return ret, nil
+	}
+	indexMeta := i.metaTable.GetIndexMetaByIndexBuildID(req.IndexBuildID)
+	if indexMeta == nil || indexMeta.MarkDeleted {
+		ret.Status.Reason = fmt.Sprintf("index not exists with ID = %d", req.IndexBuildID)
+		return ret, nil
+	}
+	indexMeta = proto.Clone(indexMeta).(*indexpb.IndexMeta)
+	if indexMeta.State != commonpb.IndexState_Finished {
+		ret.Status.Reason = fmt.Sprintf("index %d is %s, the recall can only be estimated on a finished index",
+			req.IndexBuildID, indexMeta.State.String())
+		return ret, nil
+	}
+
+	jobID, err := i.idAllocator.AllocOne()
+	if err != nil {
+		ret.Status.Reason = err.Error()
+		return ret, nil
+	}
+	// the IndexNode holds the raw data and the index like building the index
+	ret = i.recallJobs.enqueue(jobID, &indexpb.EstimateRecallRequest{
+		IndexBuildID:   req.IndexBuildID,
+		Nq:             req.Nq,
+		Topk:           req.Topk,
+		SearchParams:   req.SearchParams,
+		IndexFilePaths: indexMeta.IndexFilePaths,
+		DataPaths:      indexMeta.Req.DataPaths,
+		TypeParams:     indexMeta.Req.TypeParams,
+		IndexParams:    indexMeta.Req.IndexParams,
+	}, estimateIndexBuildMemory(indexMeta.Req))
+	log.Debug("IndexCoord EstimateRecall job queued", zap.Int64("IndexBuildID", req.IndexBuildID), zap.Int64("jobID", jobID))
+	return ret, nil
+}
+
+// GetRecallEstimations gets the states of the recall estimation jobs, and the results of the finished jobs.
+func (i *IndexCoord) GetRecallEstimations(ctx context.Context, req *indexpb.GetRecallEstimationsRequest) (*indexpb.GetRecallEstimationsResponse, error) {
+	ret := &indexpb.GetRecallEstimationsResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+	}
+	if !i.isHealthy() {
+		ret.Status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+		ret.Status.Reason = msgIndexCoordIsUnhealthy(i.ID)
+		return ret, nil
+	}
+	ret.Results = make([]*indexpb.EstimateRecallResponse, 0, len(req.JobIDs))
+	for _, jobID := range req.JobIDs {
+		ret.Results = append(ret.Results, i.recallJobs.get(jobID))
+	}
+	return ret, nil
+}
+
 // GetMetrics gets the metrics info of IndexCoord.
 func (i *IndexCoord) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
 	log.Debug("IndexCoord.GetMetrics",
@@ -597,6 +683,28 @@ func (i *IndexCoord) tsLoop() {
 	}
 }
 
+// refreshResourceLoop collects the resources of the IndexNodes periodically, which the task assignment reads.
+func (i *IndexCoord) refreshResourceLoop() {
+	ctx, cancel := context.WithCancel(i.loopCtx)
+
+	defer cancel()
+	defer i.loopWg.Done()
+
+	timeTicker := time.NewTicker(i.refreshResourceInterval)
+	defer timeTicker.Stop()
+	log.Debug("IndexCoord start refreshResource loop")
+
+	i.nodeManager.RefreshResources(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timeTicker.C:
+			i.nodeManager.RefreshResources(ctx)
+		}
+	}
+}
+
 // recycleUnusedIndexFiles is used to delete useless index files, including lower version index files and index files
 // corresponding to the deleted index.
 func (i *IndexCoord) recycleUnusedIndexFiles() {
@@ -709,17 +817,21 @@ func (i *IndexCoord) watchMetaLoop() {
 				log.Debug("IndexCoord watchMetaLoop", zap.Any("event.Key", event.Kv.Key),
 					zap.Any("event.V", indexMeta), zap.Int64("IndexBuildID", indexBuildID), zap.Error(err))
 				switch event.Type {
-				case mvccpb.PUT:
+				case kv.EventTypePut:
 					reload := i.metaTable.LoadMetaFromETCD(indexBuildID, eventRevision)
 					log.Debug("IndexCoord watchMetaLoop PUT", zap.Int64("IndexBuildID", indexBuildID), zap.Bool("reload", reload))
-					if reload {
+					// IndexNode also reports the build progress through the meta, only a finished or failed
+					// task releases the IndexNode.
+					if reload && (indexMeta.State == commonpb.IndexState_Finished || indexMeta.State == commonpb.IndexState_Failed) {
 						log.Debug("This task has finished", zap.Int64("indexBuildID", indexBuildID),
 							zap.Int64("Finish by IndexNode", indexMeta.NodeID),
 							zap.Int64("The version of the task", indexMeta.Version))
 						i.nodeManager.pq.IncPriority(indexMeta.NodeID, -1)
+						i.nodeManager.ReleaseTask(indexBuildID)
 					}
-				case mvccpb.DELETE:
+				case kv.EventTypeDelete:
 					log.Debug("IndexCoord watchMetaLoop DELETE", zap.Int64("The meta has been deleted of indexBuildID", indexBuildID))
+					i.nodeManager.ReleaseTask(indexBuildID)
 				}
 			}
 		}
@@ -742,7 +854,38 @@ func (i *IndexCoord) assignTask(builderClient types.IndexNode, req *indexpb.Crea
 	return true
 }
 
+// cancelIndexBuild asks the IndexNode executing the task to abort it.
+func (i *IndexCoord) cancelIndexBuild(meta Meta, reason string) {
+	builderClient, ok := i.nodeManager.GetClientByID(meta.indexMeta.NodeID)
+	if !ok {
+		log.Debug("IndexCoord cancelIndexBuild IndexNode is offline", zap.Int64("indexBuildID", meta.indexMeta.IndexBuildID),
+			zap.Int64("nodeID", meta.indexMeta.NodeID))
+		return
+	}
+	ctx, cancel := context.WithTimeout(i.loopCtx, i.reqTimeoutInterval)
+	defer cancel()
+	resp, err := builderClient.CancelIndexBuild(ctx, &indexpb.CancelIndexBuildRequest{
+		IndexBuildID: meta.indexMeta.IndexBuildID,
+		Version:      meta.indexMeta.Version,
+		Reason:       reason,
+	})
+	if err != nil {
+		log.Warn("IndexCoord cancelIndexBuild failed", zap.Int64("indexBuildID", meta.indexMeta.IndexBuildID), zap.Error(err))
+		return
+	}
+	if resp.ErrorCode != commonpb.ErrorCode_Success {
+		log.Warn("IndexCoord cancelIndexBuild failed", zap.Int64("indexBuildID", meta.indexMeta.IndexBuildID),
+			zap.String("Reason", resp.Reason))
+		return
+	}
+	log.Debug("IndexCoord cancelIndexBuild success", zap.Int64("indexBuildID", meta.indexMeta.IndexBuildID),
+		zap.Int64("nodeID", meta.indexMeta.NodeID), zap.String("reason", reason))
+}
+
 // assignTaskLoop is used to assign index construction tasks.
+// A task that is lost with its IndexNode or can not be dispatched is reassigned at most Params.MaxTaskRetry times,
+// after that it is marked as failed. A task needing more memory than any IndexNode has is split into steps executed
+// by different IndexNodes if the index type allows.
 func (i *IndexCoord) assignTaskLoop() {
 	ctx, cancel := context.WithCancel(i.loopCtx)
 
@@ -758,6 +901,7 @@ func (i *IndexCoord) assignTaskLoop() {
 			log.Debug("IndexCoord assignTaskLoop ctx Done")
 			return
 		case <-timeTicker.C:
+			i.advanceDistributedBuilds()
 			sessions, _, err := i.session.GetSessions(typeutil.IndexNodeRole)
 			if err != nil {
 				log.Error("IndexCoord assignTaskLoop", zap.Any("GetSessions error", err))
@@ -778,17 +922,52 @@ func (i *IndexCoord) assignTaskLoop() {
 			log.Debug("IndexCoord assignTaskLoop", zap.Int("Unassigned tasks number", len(metas)))
 			for index, meta := range metas {
 				indexBuildID := meta.indexMeta.IndexBuildID
-				if err = i.metaTable.UpdateVersion(indexBuildID); err != nil {
-					log.Warn("IndexCoord assignmentTasksLoop metaTable.UpdateVersion failed", zap.Error(err))
+				if meta.indexMeta.Version > 0 && meta.indexMeta.RetryCount >= Params.MaxTaskRetry {
+					reason := fmt.Sprintf("index build task has been retried %d times, the last attempt was on IndexNode %d",
+						meta.indexMeta.RetryCount, meta.indexMeta.NodeID)
+					log.Warn("IndexCoord assignTaskLoop retry budget exhausted", zap.Int64("indexBuildID", indexBuildID),
+						zap.String("reason", reason))
+					if err = i.metaTable.MarkIndexAsFailed(indexBuildID, reason); err != nil {
+						log.Warn("IndexCoord assignmentTasksLoop metaTable.MarkIndexAsFailed failed", zap.Error(err))
+					}
 					continue
 				}
-				log.Debug("The version of the task has been updated", zap.Int64("indexBuildID", indexBuildID))
-				nodeID, builderClient := i.nodeManager.PeekClient()
+				memory := estimateIndexBuildMemory(meta.indexMeta.Req)
+				nodeID, builderClient := i.nodeManager.PeekClient(indexBuildID, memory)
 				if builderClient == nil {
-					log.Warn("IndexCoord assignmentTasksLoop can not find available IndexNode")
-					break
+					// smaller tasks may still fit, the task is kept in the queue until an IndexNode has enough memory
+					if i.nodeManager.ExceedsAllNodes(memory) {
+						if Params.DistributedBuildEnabled {
+							split, err := i.splitIndexBuild(meta)
+							if err != nil {
+								// the build fails as it can't be done by the IndexNodes even in steps
+								log.Warn("IndexCoord assignmentTasksLoop the task can't be split across IndexNodes",
+									zap.Int64("indexBuildID", indexBuildID), zap.Error(err))
+								if err = i.metaTable.MarkIndexAsFailed(indexBuildID, err.Error()); err != nil {
+									log.Warn("IndexCoord assignmentTasksLoop MarkIndexAsFailed failed",
+										zap.Int64("indexBuildID", indexBuildID), zap.Error(err))
+								}
+								continue
+							}
+							if split {
+								continue
+							}
+						}
+						log.Warn("IndexCoord assignmentTasksLoop the task needs more memory than any IndexNode has",
+							zap.Int64("indexBuildID", indexBuildID), zap.Uint64("memory", memory))
+					} else {
+						log.Debug("IndexCoord assignmentTasksLoop can not find IndexNode with enough memory",
+							zap.Int64("indexBuildID", indexBuildID), zap.Uint64("memory", memory))
+					}
+					continue
 				}
 				log.Debug("IndexCoord PeekClient success", zap.Int64("nodeID", nodeID))
+				if err = i.metaTable.UpdateVersion(indexBuildID); err != nil {
+					log.Warn("IndexCoord assignmentTasksLoop metaTable.UpdateVersion failed", zap.Error(err))
+					i.nodeManager.ReleaseTask(indexBuildID)
+					continue
+				}
+				log.Debug("The version of the task has been updated", zap.Int64("indexBuildID", indexBuildID))
 				req := &indexpb.CreateIndexRequest{
 					IndexBuildID: indexBuildID,
 					IndexName:    meta.indexMeta.Req.IndexName,
@@ -798,9 +977,13 @@ func (i *IndexCoord) assignTaskLoop() {
 					DataPaths:    meta.indexMeta.Req.DataPaths,
 					TypeParams:   meta.indexMeta.Req.TypeParams,
 					IndexParams:  meta.indexMeta.Req.IndexParams,
+					Step:         meta.indexMeta.Req.Step,
+					InputIndexes: meta.indexMeta.Req.InputIndexes,
+					NumRows:      meta.indexMeta.Req.NumRows,
 				}
 				if !i.assignTask(builderClient, req) {
 					log.Warn("IndexCoord assignTask assign task to IndexNode failed")
+					i.nodeManager.ReleaseTask(indexBuildID)
 					continue
 				}
 				if err = i.metaTable.BuildIndex(indexBuildID, nodeID); err != nil {
@@ -16,6 +16,7 @@ import (
 	"errors"
 	"strconv"
 
+	"github.com/milvus-io/milvus/internal/kv"
 	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
@@ -27,7 +28,7 @@ import (
 
 // Mock is an alternative to IndexCoord, it will return specific results based on specific parameters.
 type Mock struct {
-	etcdKV *etcdkv.EtcdKV
+	etcdKV kv.MetaKv
 
 	Failure bool
 }
@@ -62,7 +63,7 @@ func (icm *Mock) Register() error {
 	if icm.Failure {
 		return errors.New("IndexCoordinate register failed")
 	}
-	icm.etcdKV, _ = etcdkv.NewEtcdKV(Params.EtcdEndpoints, Params.MetaRootPath)
+	icm.etcdKV, _ = etcdkv.NewMetaKv(Params.EtcdEndpoints, Params.MetaRootPath)
 	err := icm.etcdKV.RemoveWithPrefix("session/" + typeutil.IndexCoordRole)
 	session := sessionutil.NewSession(context.Background(), Params.MetaRootPath, Params.EtcdEndpoints)
 	session.Init(typeutil.IndexCoordRole, Params.Address, true)
@@ -209,6 +210,56 @@ func (icm *Mock) GetIndexFilePaths(ctx context.Context, req *indexpb.GetIndexFil
 	}, nil
 }
 
+// EstimateRecall queues a job with the same ID as the index build, if Param `Failure` is true, it will return an
+// error.
+func (icm *Mock) EstimateRecall(ctx context.Context, req *indexpb.EstimateRecallRequest) (*indexpb.EstimateRecallResponse, error) {
+	if icm.Failure {
+		return &indexpb.EstimateRecallResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			},
+		}, errors.New("IndexCoordinate EstimateRecall failed")
+	}
+	return &indexpb.EstimateRecallResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		IndexBuildID: req.IndexBuildID,
+		JobID:        req.IndexBuildID,
+		State:        commonpb.IndexState_InProgress,
+	}, nil
+}
+
+// GetRecallEstimations returns a full recall of every job, if Param `Failure` is true, it will return an error.
+func (icm *Mock) GetRecallEstimations(ctx context.Context, req *indexpb.GetRecallEstimationsRequest) (*indexpb.GetRecallEstimationsResponse, error) {
+	if icm.Failure {
+		return &indexpb.GetRecallEstimationsResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			},
+		}, errors.New("IndexCoordinate GetRecallEstimations failed")
+	}
+	results := make([]*indexpb.EstimateRecallResponse, 0, len(req.JobIDs))
+	for _, jobID := range req.JobIDs {
+		results = append(results, &indexpb.EstimateRecallResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_Success,
+			},
+			IndexBuildID: jobID,
+			JobID:        jobID,
+			State:        commonpb.IndexState_Finished,
+			Nq:           10,
+			Recall:       1,
+		})
+	}
+	return &indexpb.GetRecallEstimationsResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		Results: results,
+	}, nil
+}
+
 // GetMetrics gets the metrics of mocked IndexCoord, if Param `Failure` is true, it will return an error.
 func (icm *Mock) GetMetrics(ctx context.Context, request *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
 	if icm.Failure {
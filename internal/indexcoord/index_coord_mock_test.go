@@ -95,6 +95,21 @@ func TestIndexCoordMock(t *testing.T) {
 		assert.Equal(t, len(req.IndexBuildIDs), len(resp.FilePaths))
 	})
 
+	t.Run("EstimateRecall", func(t *testing.T) {
+		resp, err := icm.EstimateRecall(ctx, &indexpb.EstimateRecallRequest{IndexBuildID: 1, Nq: 10, Topk: 10})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+		assert.Equal(t, int64(1), resp.JobID)
+	})
+
+	t.Run("GetRecallEstimations", func(t *testing.T) {
+		resp, err := icm.GetRecallEstimations(ctx, &indexpb.GetRecallEstimationsRequest{JobIDs: []int64{1}})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+		assert.Equal(t, commonpb.IndexState_Finished, resp.Results[0].State)
+		assert.Equal(t, float32(1), resp.Results[0].Recall)
+	})
+
 	t.Run("GetMetrics", func(t *testing.T) {
 		req := &milvuspb.GetMetricsRequest{
 			Request: "",
@@ -179,6 +194,18 @@ func TestIndexCoordMockError(t *testing.T) {
 		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.Status.ErrorCode)
 	})
 
+	t.Run("EstimateRecall", func(t *testing.T) {
+		resp, err := icm.EstimateRecall(ctx, &indexpb.EstimateRecallRequest{IndexBuildID: 1})
+		assert.NotNil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.Status.ErrorCode)
+	})
+
+	t.Run("GetRecallEstimations", func(t *testing.T) {
+		resp, err := icm.GetRecallEstimations(ctx, &indexpb.GetRecallEstimationsRequest{JobIDs: []int64{1}})
+		assert.NotNil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.Status.ErrorCode)
+	})
+
 	t.Run("GetMetrics", func(t *testing.T) {
 		req := &milvuspb.GetMetricsRequest{
 			Request: "",
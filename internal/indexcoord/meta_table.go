@@ -23,7 +23,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/golang/protobuf/proto"
-	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
+	"github.com/milvus-io/milvus/internal/kv"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
@@ -38,14 +38,14 @@ type Meta struct {
 }
 
 type metaTable struct {
-	client            *etcdkv.EtcdKV    // client of a reliable kv service, i.e. etcd client
+	client            kv.MetaKv         // client of a reliable kv service, i.e. etcd client
 	indexBuildID2Meta map[UniqueID]Meta // index build id to index meta
 
 	lock sync.RWMutex
 }
 
 // NewMetaTable is used to create a new meta table.
-func NewMetaTable(kv *etcdkv.EtcdKV) (*metaTable, error) {
+func NewMetaTable(kv kv.MetaKv) (*metaTable, error) {
 	mt := &metaTable{
 		client: kv,
 		lock:   sync.RWMutex{},
@@ -103,6 +103,53 @@ func (mt *metaTable) saveIndexMeta(meta *Meta) error {
 	return nil
 }
 
+// saveIndexMetas saves the metas in one transaction, so that the child builds are never saved without the update of
+// their parent build which refers to them.
+// metaTable.lock.Lock() before call this function
+func (mt *metaTable) saveIndexMetas(metas []*Meta) error {
+	kvs := make(map[string]string, len(metas))
+	for _, meta := range metas {
+		value, err := proto.Marshal(meta.indexMeta)
+		if err != nil {
+			return err
+		}
+		kvs["indexes/"+strconv.FormatInt(meta.indexMeta.IndexBuildID, 10)] = string(value)
+	}
+	err := mt.client.MultiSave(kvs)
+	log.Debug("IndexCoord metaTable saveIndexMetas", zap.Int("metas", len(metas)), zap.Error(err))
+	if err != nil {
+		return err
+	}
+	for _, meta := range metas {
+		meta.revision = meta.revision + 1
+		mt.indexBuildID2Meta[meta.indexMeta.IndexBuildID] = *meta
+	}
+	return nil
+}
+
+// updateIndexMeta applies update to the meta of indexBuildID and saves it, the meta is reloaded and updated again if
+// it has been changed in etcd.
+// metaTable.lock.Lock() before call this function
+func (mt *metaTable) updateIndexMeta(indexBuildID UniqueID, update func(indexMeta *indexpb.IndexMeta)) error {
+	meta, ok := mt.indexBuildID2Meta[indexBuildID]
+	if !ok {
+		return fmt.Errorf("index not exists with ID = %d", indexBuildID)
+	}
+	update(meta.indexMeta)
+	if err := mt.saveIndexMeta(&meta); err != nil {
+		fn := func() error {
+			m, err := mt.reloadMeta(indexBuildID)
+			if m == nil {
+				return err
+			}
+			update(m.indexMeta)
+			return mt.saveIndexMeta(m)
+		}
+		return retry.Do(context.TODO(), fn, retry.Attempts(5))
+	}
+	return nil
+}
+
 func (mt *metaTable) reloadMeta(indexBuildID UniqueID) (*Meta, error) {
 	key := "indexes/" + strconv.FormatInt(indexBuildID, 10)
 
@@ -153,6 +200,177 @@ func (mt *metaTable) AddIndex(indexBuildID UniqueID, req *indexpb.BuildIndexRequ
 	return mt.saveIndexMeta(meta)
 }
 
+// SplitIndexBuild records that the build of indexBuildID is split into steps executed by different IndexNodes, the
+// first step trains the index on a sample of the segment.
+func (mt *metaTable) SplitIndexBuild(indexBuildID UniqueID, trainBuildID UniqueID, trainReq *indexpb.BuildIndexRequest,
+	shards []*indexpb.ShardDataPaths) error {
+	mt.lock.Lock()
+	defer mt.lock.Unlock()
+
+	log.Debug("IndexCoord metaTable SplitIndexBuild", zap.Int64("indexBuildID", indexBuildID),
+		zap.Int64("trainBuildID", trainBuildID), zap.Int("shards", len(shards)))
+	meta, ok := mt.indexBuildID2Meta[indexBuildID]
+	if !ok {
+		return fmt.Errorf("index not exists with ID = %d", indexBuildID)
+	}
+	if meta.indexMeta.DistributedBuild != nil {
+		return fmt.Errorf("index with ID = %d has already been split", indexBuildID)
+	}
+	train, err := mt.newChildIndexMeta(indexBuildID, trainBuildID, trainReq)
+	if err != nil {
+		return err
+	}
+	parent := &Meta{
+		indexMeta: proto.Clone(meta.indexMeta).(*indexpb.IndexMeta),
+		revision:  meta.revision,
+	}
+	parent.indexMeta.State = commonpb.IndexState_InProgress
+	parent.indexMeta.NodeID = 0
+	parent.indexMeta.IndexedRows = 0
+	parent.indexMeta.TotalRows = parent.indexMeta.Req.GetNumRows()
+	parent.indexMeta.DistributedBuild = &indexpb.DistributedBuild{
+		Step:         indexpb.IndexBuildStep_Train,
+		TrainBuildID: trainBuildID,
+		Shards:       shards,
+	}
+	return mt.saveIndexMetas([]*Meta{train, parent})
+}
+
+// AddShardBuilds adds the tasks which add the shards of the segment to the trained index.
+func (mt *metaTable) AddShardBuilds(indexBuildID UniqueID, shardBuildIDs []UniqueID, shardReqs []*indexpb.BuildIndexRequest) error {
+	mt.lock.Lock()
+	defer mt.lock.Unlock()
+
+	log.Debug("IndexCoord metaTable AddShardBuilds", zap.Int64("indexBuildID", indexBuildID),
+		zap.Int64s("shardBuildIDs", shardBuildIDs))
+	meta, ok := mt.indexBuildID2Meta[indexBuildID]
+	if !ok {
+		return fmt.Errorf("index not exists with ID = %d", indexBuildID)
+	}
+	if meta.indexMeta.DistributedBuild == nil || meta.indexMeta.DistributedBuild.Step != indexpb.IndexBuildStep_Train {
+		return fmt.Errorf("index with ID = %d isn't being trained", indexBuildID)
+	}
+	metas := make([]*Meta, 0, len(shardBuildIDs)+1)
+	for idx, shardBuildID := range shardBuildIDs {
+		shard, err := mt.newChildIndexMeta(indexBuildID, shardBuildID, shardReqs[idx])
+		if err != nil {
+			return err
+		}
+		metas = append(metas, shard)
+	}
+	parent := &Meta{
+		indexMeta: proto.Clone(meta.indexMeta).(*indexpb.IndexMeta),
+		revision:  meta.revision,
+	}
+	parent.indexMeta.DistributedBuild.Step = indexpb.IndexBuildStep_AddShard
+	parent.indexMeta.DistributedBuild.ShardBuildIDs = shardBuildIDs
+	return mt.saveIndexMetas(append(metas, parent))
+}
+
+// MergeShardBuilds turns the build of indexBuildID into merging the shard indexes, it is assigned to an IndexNode as
+// an unissued task.
+func (mt *metaTable) MergeShardBuilds(indexBuildID UniqueID, shardIndexes []*indexpb.IndexFilePathInfo) error {
+	mt.lock.Lock()
+	defer mt.lock.Unlock()
+
+	log.Debug("IndexCoord metaTable MergeShardBuilds", zap.Int64("indexBuildID", indexBuildID))
+	return mt.updateIndexMeta(indexBuildID, func(indexMeta *indexpb.IndexMeta) {
+		indexMeta.State = commonpb.IndexState_Unissued
+		indexMeta.Req.Step = indexpb.IndexBuildStep_Merge
+		indexMeta.Req.InputIndexes = shardIndexes
+		indexMeta.DistributedBuild.Step = indexpb.IndexBuildStep_Merge
+	})
+}
+
+// UpdateIndexProgress records the progress of a build which is split across IndexNodes.
+func (mt *metaTable) UpdateIndexProgress(indexBuildID UniqueID, indexedRows, totalRows int64) error {
+	mt.lock.Lock()
+	defer mt.lock.Unlock()
+
+	meta, ok := mt.indexBuildID2Meta[indexBuildID]
+	if !ok {
+		return fmt.Errorf("index not exists with ID = %d", indexBuildID)
+	}
+	if meta.indexMeta.IndexedRows == indexedRows && meta.indexMeta.TotalRows == totalRows {
+		return nil
+	}
+	return mt.updateIndexMeta(indexBuildID, func(indexMeta *indexpb.IndexMeta) {
+		indexMeta.IndexedRows = indexedRows
+		indexMeta.TotalRows = totalRows
+	})
+}
+
+// ReleaseDistributedBuild marks the train and shard tasks of a finished, failed or deleted distributed build as
+// deleted, so that their index files are recycled.
+func (mt *metaTable) ReleaseDistributedBuild(indexBuildID UniqueID) error {
+	mt.lock.Lock()
+	defer mt.lock.Unlock()
+
+	meta, ok := mt.indexBuildID2Meta[indexBuildID]
+	if !ok {
+		return fmt.Errorf("index not exists with ID = %d", indexBuildID)
+	}
+	build := meta.indexMeta.DistributedBuild
+	log.Debug("IndexCoord metaTable ReleaseDistributedBuild", zap.Int64("indexBuildID", indexBuildID),
+		zap.Int64("trainBuildID", build.TrainBuildID), zap.Int64s("shardBuildIDs", build.ShardBuildIDs))
+	var childBuildTimeMs int64
+	for _, childBuildID := range append([]UniqueID{build.TrainBuildID}, build.ShardBuildIDs...) {
+		child, ok := mt.indexBuildID2Meta[childBuildID]
+		if !ok {
+			continue
+		}
+		childBuildTimeMs += child.indexMeta.BuildTimeMs
+		err := mt.updateIndexMeta(childBuildID, func(indexMeta *indexpb.IndexMeta) {
+			indexMeta.MarkDeleted = true
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return mt.updateIndexMeta(indexBuildID, func(indexMeta *indexpb.IndexMeta) {
+		indexMeta.DistributedBuild.Done = true
+		// the build time of a distributed build is the total time of its steps
+		if indexMeta.State == commonpb.IndexState_Finished && !indexMeta.MarkDeleted {
+			indexMeta.BuildTimeMs += childBuildTimeMs
+		}
+		// a deleted build is finished like IndexNode does for a deleted task, so that its meta is recycled
+		if indexMeta.MarkDeleted && indexMeta.State != commonpb.IndexState_Failed {
+			indexMeta.State = commonpb.IndexState_Finished
+		}
+	})
+}
+
+// GetDistributedBuilds returns the builds which are split across IndexNodes and not released yet.
+func (mt *metaTable) GetDistributedBuilds() []Meta {
+	mt.lock.RLock()
+	defer mt.lock.RUnlock()
+
+	var metas []Meta
+	for _, meta := range mt.indexBuildID2Meta {
+		if meta.indexMeta.DistributedBuild != nil && !meta.indexMeta.DistributedBuild.Done {
+			metas = append(metas, meta)
+		}
+	}
+	return metas
+}
+
+// metaTable.lock.Lock() before call this function
+func (mt *metaTable) newChildIndexMeta(parentBuildID, indexBuildID UniqueID, req *indexpb.BuildIndexRequest) (*Meta, error) {
+	if _, ok := mt.indexBuildID2Meta[indexBuildID]; ok {
+		return nil, fmt.Errorf("index already exists with ID = %d", indexBuildID)
+	}
+	req.IndexBuildID = indexBuildID
+	return &Meta{
+		indexMeta: &indexpb.IndexMeta{
+			State:         commonpb.IndexState_Unissued,
+			IndexBuildID:  indexBuildID,
+			Req:           req,
+			ParentBuildID: parentBuildID,
+		},
+		revision: 0,
+	}, nil
+}
+
 func (mt *metaTable) BuildIndex(indexBuildID UniqueID, nodeID int64) error {
 	mt.lock.Lock()
 	defer mt.lock.Unlock()
@@ -179,6 +397,10 @@ func (mt *metaTable) BuildIndex(indexBuildID UniqueID, nodeID int64) error {
 				return err
 			}
 			m.indexMeta.NodeID = nodeID
+			// IndexNode may have reported progress before the task is recorded as in progress.
+			if m.indexMeta.State == commonpb.IndexState_Unissued {
+				m.indexMeta.State = commonpb.IndexState_InProgress
+			}
 			return mt.saveIndexMeta(m)
 		}
 		err2 := retry.Do(context.TODO(), fn, retry.Attempts(5))
@@ -204,9 +426,14 @@ func (mt *metaTable) UpdateVersion(indexBuildID UniqueID) error {
 	//	return fmt.Errorf("can not set lease key, index with ID = %d state is %d", indexBuildID, meta.indexMeta.State)
 	//}
 
+	if meta.indexMeta.Version > 0 {
+		meta.indexMeta.RetryCount++
+	}
 	meta.indexMeta.Version = meta.indexMeta.Version + 1
+	meta.indexMeta.IndexedRows = 0
+	meta.indexMeta.TotalRows = 0
 	log.Debug("IndexCoord metaTable update UpdateVersion", zap.Any("IndexBuildId", indexBuildID),
-		zap.Any("Version", meta.indexMeta.Version))
+		zap.Any("Version", meta.indexMeta.Version), zap.Int64("RetryCount", meta.indexMeta.RetryCount))
 
 	err := mt.saveIndexMeta(&meta)
 	if err != nil {
@@ -215,7 +442,12 @@ func (mt *metaTable) UpdateVersion(indexBuildID UniqueID) error {
 			if m == nil {
 				return err
 			}
+			if m.indexMeta.Version > 0 {
+				m.indexMeta.RetryCount++
+			}
 			m.indexMeta.Version = m.indexMeta.Version + 1
+			m.indexMeta.IndexedRows = 0
+			m.indexMeta.TotalRows = 0
 
 			return mt.saveIndexMeta(m)
 		}
@@ -226,6 +458,39 @@ func (mt *metaTable) UpdateVersion(indexBuildID UniqueID) error {
 	return nil
 }
 
+// MarkIndexAsFailed marks the index build task as failed, a failed task will not be assigned again.
+func (mt *metaTable) MarkIndexAsFailed(indexBuildID UniqueID, reason string) error {
+	mt.lock.Lock()
+	defer mt.lock.Unlock()
+
+	log.Debug("IndexCoord metaTable MarkIndexAsFailed", zap.Int64("indexBuildID", indexBuildID), zap.String("reason", reason))
+	meta, ok := mt.indexBuildID2Meta[indexBuildID]
+	if !ok {
+		return fmt.Errorf("index not exists with ID = %d", indexBuildID)
+	}
+
+	meta.indexMeta.State = commonpb.IndexState_Failed
+	meta.indexMeta.FailReason = reason
+	if err := mt.saveIndexMeta(&meta); err != nil {
+		fn := func() error {
+			m, err := mt.reloadMeta(meta.indexMeta.IndexBuildID)
+			if m == nil {
+				return err
+			}
+
+			m.indexMeta.State = commonpb.IndexState_Failed
+			m.indexMeta.FailReason = reason
+			return mt.saveIndexMeta(m)
+		}
+		err2 := retry.Do(context.TODO(), fn, retry.Attempts(5))
+		if err2 != nil {
+			return err2
+		}
+	}
+
+	return nil
+}
+
 func (mt *metaTable) MarkIndexAsDeleted(indexID UniqueID) error {
 	mt.lock.Lock()
 	defer mt.lock.Unlock()
@@ -277,6 +542,12 @@ func (mt *metaTable) GetIndexStates(indexBuildIDs []UniqueID) []*indexpb.IndexIn
 			state.IndexID = meta.indexMeta.Req.IndexID
 			state.IndexName = meta.indexMeta.Req.IndexName
 			state.Reason = meta.indexMeta.FailReason
+			state.IndexedRows = meta.indexMeta.IndexedRows
+			state.TotalRows = meta.indexMeta.TotalRows
+			state.RetryCount = meta.indexMeta.RetryCount
+			state.NodeID = meta.indexMeta.NodeID
+			state.SerializedSize = meta.indexMeta.SerializedSize
+			state.BuildTimeMs = meta.indexMeta.BuildTimeMs
 		}
 		indexStates = append(indexStates, state)
 	}
@@ -372,6 +643,10 @@ func (mt *metaTable) GetUnassignedTasks(onlineNodeIDs []int64) []Meta {
 	var metas []Meta
 
 	for _, meta := range mt.indexBuildID2Meta {
+		// a build split across IndexNodes is assigned only when its shard indexes are to be merged
+		if build := meta.indexMeta.DistributedBuild; build != nil && build.Step != indexpb.IndexBuildStep_Merge {
+			continue
+		}
 		if meta.indexMeta.State == commonpb.IndexState_Unissued {
 			metas = append(metas, meta)
 			continue
@@ -394,11 +669,28 @@ func (mt *metaTable) GetUnassignedTasks(onlineNodeIDs []int64) []Meta {
 	return metas
 }
 
+// GetInProgressTasks returns the index build tasks of indexID that are being executed by IndexNodes.
+func (mt *metaTable) GetInProgressTasks(indexID UniqueID) []Meta {
+	mt.lock.RLock()
+	defer mt.lock.RUnlock()
+
+	var metas []Meta
+	for _, meta := range mt.indexBuildID2Meta {
+		if meta.indexMeta.Req.IndexID == indexID && meta.indexMeta.State == commonpb.IndexState_InProgress {
+			metas = append(metas, meta)
+		}
+	}
+	return metas
+}
+
 func (mt *metaTable) HasSameReq(req *indexpb.BuildIndexRequest) (bool, UniqueID) {
 	mt.lock.Lock()
 	defer mt.lock.Unlock()
 
 	for _, meta := range mt.indexBuildID2Meta {
+		if meta.indexMeta.ParentBuildID != 0 {
+			continue
+		}
 		if meta.indexMeta.Req.IndexID != req.IndexID {
 			continue
 		}
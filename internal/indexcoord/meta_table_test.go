@@ -12,11 +12,15 @@
 package indexcoord
 
 import (
+	"errors"
 	"strconv"
 	"testing"
 
 	"github.com/golang/protobuf/proto"
+	"github.com/milvus-io/milvus/internal/allocator"
+	"github.com/milvus-io/milvus/internal/kv"
 	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
+	memkv "github.com/milvus-io/milvus/internal/kv/mem"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
 	"github.com/stretchr/testify/assert"
@@ -278,6 +282,46 @@ func TestMetaTable(t *testing.T) {
 		assert.Equal(t, 1, priorities[4])
 	})
 
+	t.Run("RetryAndFail", func(t *testing.T) {
+		req6 := &indexpb.BuildIndexRequest{
+			IndexBuildID: 10,
+			IndexName:    "test_index",
+			IndexID:      6,
+			DataPaths:    []string{"DataPath-1-1", "DataPath-1-2"},
+		}
+		err = metaTable.AddIndex(req6.IndexBuildID, req6)
+		assert.Nil(t, err)
+
+		err = metaTable.UpdateVersion(req6.IndexBuildID)
+		assert.Nil(t, err)
+		assert.Equal(t, int64(0), metaTable.GetIndexMetaByIndexBuildID(req6.IndexBuildID).RetryCount)
+		err = metaTable.BuildIndex(req6.IndexBuildID, 5)
+		assert.Nil(t, err)
+
+		metas := metaTable.GetInProgressTasks(req6.IndexID)
+		assert.Equal(t, 1, len(metas))
+		assert.Equal(t, int64(5), metas[0].indexMeta.NodeID)
+
+		err = metaTable.UpdateVersion(req6.IndexBuildID)
+		assert.Nil(t, err)
+		assert.Equal(t, int64(1), metaTable.GetIndexMetaByIndexBuildID(req6.IndexBuildID).RetryCount)
+
+		err = metaTable.MarkIndexAsFailed(req6.IndexBuildID, "retry budget exhausted")
+		assert.Nil(t, err)
+		indexInfos := metaTable.GetIndexStates([]UniqueID{req6.IndexBuildID})
+		assert.Equal(t, commonpb.IndexState_Failed, indexInfos[0].State)
+		assert.Equal(t, "retry budget exhausted", indexInfos[0].Reason)
+		assert.Equal(t, int64(1), indexInfos[0].RetryCount)
+		assert.Equal(t, int64(5), indexInfos[0].NodeID)
+		assert.Equal(t, 0, len(metaTable.GetInProgressTasks(req6.IndexID)))
+		for _, meta := range metaTable.GetUnassignedTasks([]int64{}) {
+			assert.NotEqual(t, req6.IndexBuildID, meta.indexMeta.IndexBuildID)
+		}
+
+		err = metaTable.MarkIndexAsFailed(11, "not exists")
+		assert.NotNil(t, err)
+	})
+
 	err = etcdKV.RemoveWithPrefix("indexes/")
 	assert.Nil(t, err)
 }
@@ -302,3 +346,279 @@ func TestMetaTable_Error(t *testing.T) {
 	err = etcdKV.RemoveWithPrefix("indexes/")
 	assert.Nil(t, err)
 }
+
+// failingMultiSaveKV fails MultiSave to check the metas are saved all or none
+type failingMultiSaveKV struct {
+	kv.MetaKv
+}
+
+func (kv *failingMultiSaveKV) MultiSave(kvs map[string]string) error {
+	return errors.New("mock MultiSave failure")
+}
+
+func TestMetaTable_DistributedBuild(t *testing.T) {
+	store, err := memkv.NewMetaStore(nil)
+	assert.Nil(t, err)
+	defer store.Close()
+	metaKV := store.NewMetaKV("indexcoord")
+	metaTable, err := NewMetaTable(metaKV)
+	assert.Nil(t, err)
+	idAllocator := allocator.NewGlobalIDAllocator("idTimestamp", memkv.NewMemoryKV())
+	err = idAllocator.Initialize()
+	assert.Nil(t, err)
+	ic := &IndexCoord{
+		metaTable:   metaTable,
+		idAllocator: idAllocator,
+	}
+
+	var indexBuildID UniqueID = 100
+	req := &indexpb.BuildIndexRequest{
+		IndexBuildID: indexBuildID,
+		IndexName:    "test_index",
+		DataPaths:    []string{"1", "2", "3", "4"},
+		NumRows:      400,
+	}
+	shards := splitDataPaths(req.DataPaths, req.NumRows, 2)
+	newTrainReq := func() *indexpb.BuildIndexRequest {
+		return childBuildRequest(req, indexpb.IndexBuildStep_Train, sampleDataPaths(req.DataPaths, 2), 200)
+	}
+	finish := func(indexBuildID UniqueID, indexedRows int64) {
+		metaTable.lock.Lock()
+		defer metaTable.lock.Unlock()
+		err := metaTable.updateIndexMeta(indexBuildID, func(indexMeta *indexpb.IndexMeta) {
+			indexMeta.State = commonpb.IndexState_Finished
+			indexMeta.IndexedRows = indexedRows
+			indexMeta.TotalRows = indexedRows
+			indexMeta.IndexFilePaths = []string{"index-" + strconv.FormatInt(indexBuildID, 10)}
+			indexMeta.BuildTimeMs = 10
+		})
+		assert.Nil(t, err)
+	}
+	checkSaved := func(indexBuildID UniqueID) *indexpb.IndexMeta {
+		value, err := metaKV.Load("indexes/" + strconv.FormatInt(indexBuildID, 10))
+		assert.Nil(t, err)
+		indexMeta := &indexpb.IndexMeta{}
+		assert.Nil(t, proto.Unmarshal([]byte(value), indexMeta))
+		assert.True(t, proto.Equal(indexMeta, metaTable.GetIndexMetaByIndexBuildID(indexBuildID)))
+		return indexMeta
+	}
+
+	err = metaTable.SplitIndexBuild(indexBuildID, 101, newTrainReq(), shards)
+	assert.NotNil(t, err)
+	err = metaTable.AddIndex(indexBuildID, req)
+	assert.Nil(t, err)
+
+	t.Run("split failed", func(t *testing.T) {
+		metaTable.client = &failingMultiSaveKV{MetaKv: metaKV}
+		defer func() {
+			metaTable.client = metaKV
+		}()
+		err := metaTable.SplitIndexBuild(indexBuildID, 101, newTrainReq(), shards)
+		assert.NotNil(t, err)
+		assert.Nil(t, metaTable.GetIndexMetaByIndexBuildID(101))
+		assert.Nil(t, metaTable.GetIndexMetaByIndexBuildID(indexBuildID).DistributedBuild)
+		assert.Empty(t, metaTable.GetDistributedBuilds())
+	})
+
+	t.Run("split", func(t *testing.T) {
+		err := metaTable.SplitIndexBuild(indexBuildID, 101, newTrainReq(), shards)
+		assert.Nil(t, err)
+		parent := checkSaved(indexBuildID)
+		assert.Equal(t, commonpb.IndexState_InProgress, parent.State)
+		assert.Equal(t, indexpb.IndexBuildStep_Train, parent.DistributedBuild.Step)
+		assert.Equal(t, UniqueID(101), parent.DistributedBuild.TrainBuildID)
+		assert.Equal(t, req.NumRows, parent.TotalRows)
+		train := checkSaved(101)
+		assert.Equal(t, commonpb.IndexState_Unissued, train.State)
+		assert.Equal(t, indexBuildID, train.ParentBuildID)
+		assert.Equal(t, indexpb.IndexBuildStep_Train, train.Req.Step)
+
+		// the saved metas are reloaded
+		reloaded, err := NewMetaTable(metaKV)
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(reloaded.GetDistributedBuilds()))
+		assert.NotNil(t, reloaded.GetIndexMetaByIndexBuildID(101))
+
+		// split once only
+		err = metaTable.SplitIndexBuild(indexBuildID, 102, newTrainReq(), shards)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("train in progress", func(t *testing.T) {
+		err := ic.advanceTrainStep(metaTable.GetDistributedBuilds()[0])
+		assert.Nil(t, err)
+		assert.Equal(t, indexpb.IndexBuildStep_Train, metaTable.GetIndexMetaByIndexBuildID(indexBuildID).DistributedBuild.Step)
+	})
+
+	var shardBuildIDs []UniqueID
+	t.Run("add shards", func(t *testing.T) {
+		finish(101, 200)
+		metaTable.client = &failingMultiSaveKV{MetaKv: metaKV}
+		err := ic.advanceTrainStep(metaTable.GetDistributedBuilds()[0])
+		assert.NotNil(t, err)
+		metaTable.client = metaKV
+		assert.Equal(t, indexpb.IndexBuildStep_Train, metaTable.GetIndexMetaByIndexBuildID(indexBuildID).DistributedBuild.Step)
+
+		err = ic.advanceTrainStep(metaTable.GetDistributedBuilds()[0])
+		assert.Nil(t, err)
+		parent := checkSaved(indexBuildID)
+		assert.Equal(t, indexpb.IndexBuildStep_AddShard, parent.DistributedBuild.Step)
+		shardBuildIDs = parent.DistributedBuild.ShardBuildIDs
+		assert.Equal(t, len(shards), len(shardBuildIDs))
+		for idx, shardBuildID := range shardBuildIDs {
+			shard := checkSaved(shardBuildID)
+			assert.Equal(t, indexBuildID, shard.ParentBuildID)
+			assert.Equal(t, indexpb.IndexBuildStep_AddShard, shard.Req.Step)
+			assert.Equal(t, shards[idx].DataPaths, shard.Req.DataPaths)
+			assert.Equal(t, UniqueID(101), shard.Req.InputIndexes[0].IndexBuildID)
+		}
+
+		// the shards are added once only
+		err = metaTable.AddShardBuilds(indexBuildID, []UniqueID{200}, []*indexpb.BuildIndexRequest{{}})
+		assert.NotNil(t, err)
+	})
+
+	t.Run("merge", func(t *testing.T) {
+		finish(shardBuildIDs[0], 200)
+		err := ic.advanceAddShardStep(metaTable.GetDistributedBuilds()[0])
+		assert.Nil(t, err)
+		parent := checkSaved(indexBuildID)
+		assert.Equal(t, indexpb.IndexBuildStep_AddShard, parent.DistributedBuild.Step)
+		assert.Equal(t, int64(200), parent.IndexedRows)
+		assert.Equal(t, req.NumRows, parent.TotalRows)
+
+		finish(shardBuildIDs[1], 200)
+		err = ic.advanceAddShardStep(metaTable.GetDistributedBuilds()[0])
+		assert.Nil(t, err)
+		parent = checkSaved(indexBuildID)
+		assert.Equal(t, commonpb.IndexState_Unissued, parent.State)
+		assert.Equal(t, indexpb.IndexBuildStep_Merge, parent.DistributedBuild.Step)
+		assert.Equal(t, indexpb.IndexBuildStep_Merge, parent.Req.Step)
+		assert.Equal(t, len(shardBuildIDs), len(parent.Req.InputIndexes))
+		for idx, input := range parent.Req.InputIndexes {
+			assert.Equal(t, shardBuildIDs[idx], input.IndexBuildID)
+		}
+	})
+
+	t.Run("release", func(t *testing.T) {
+		finish(indexBuildID, req.NumRows)
+		ic.advanceDistributedBuilds()
+		assert.Empty(t, metaTable.GetDistributedBuilds())
+		parent := checkSaved(indexBuildID)
+		assert.True(t, parent.DistributedBuild.Done)
+		assert.Equal(t, commonpb.IndexState_Finished, parent.State)
+		// the build time of the train and shard tasks is added
+		assert.Equal(t, int64(40), parent.BuildTimeMs)
+		for _, childBuildID := range append([]UniqueID{101}, shardBuildIDs...) {
+			assert.True(t, checkSaved(childBuildID).MarkDeleted)
+		}
+	})
+}
+
+func TestMetaTable_DistributedBuildFailed(t *testing.T) {
+	store, err := memkv.NewMetaStore(nil)
+	assert.Nil(t, err)
+	defer store.Close()
+	metaTable, err := NewMetaTable(store.NewMetaKV("indexcoord"))
+	assert.Nil(t, err)
+	idAllocator := allocator.NewGlobalIDAllocator("idTimestamp", memkv.NewMemoryKV())
+	err = idAllocator.Initialize()
+	assert.Nil(t, err)
+	ic := &IndexCoord{
+		metaTable:   metaTable,
+		idAllocator: idAllocator,
+	}
+
+	var nextID UniqueID = 100
+	split := func() UniqueID {
+		indexBuildID, trainBuildID := nextID, nextID+1
+		nextID += 10
+		req := &indexpb.BuildIndexRequest{
+			IndexBuildID: indexBuildID,
+			DataPaths:    []string{"1", "2"},
+			NumRows:      200,
+		}
+		err := metaTable.AddIndex(indexBuildID, req)
+		assert.Nil(t, err)
+		trainReq := childBuildRequest(req, indexpb.IndexBuildStep_Train, req.DataPaths[:1], 100)
+		err = metaTable.SplitIndexBuild(indexBuildID, trainBuildID, trainReq, splitDataPaths(req.DataPaths, req.NumRows, 2))
+		assert.Nil(t, err)
+		return indexBuildID
+	}
+	getMeta := func(indexBuildID UniqueID) Meta {
+		for _, meta := range metaTable.GetDistributedBuilds() {
+			if meta.indexMeta.IndexBuildID == indexBuildID {
+				return meta
+			}
+		}
+		return Meta{indexMeta: metaTable.GetIndexMetaByIndexBuildID(indexBuildID)}
+	}
+	checkFailed := func(indexBuildID UniqueID) {
+		indexMeta := metaTable.GetIndexMetaByIndexBuildID(indexBuildID)
+		assert.Equal(t, commonpb.IndexState_Failed, indexMeta.State)
+		assert.NotEmpty(t, indexMeta.FailReason)
+	}
+
+	t.Run("train failed", func(t *testing.T) {
+		indexBuildID := split()
+		err := metaTable.MarkIndexAsFailed(indexBuildID+1, "mock failure")
+		assert.Nil(t, err)
+		err = ic.advanceTrainStep(getMeta(indexBuildID))
+		assert.Nil(t, err)
+		checkFailed(indexBuildID)
+	})
+
+	t.Run("train lost", func(t *testing.T) {
+		indexBuildID := split()
+		metaTable.DeleteIndex(indexBuildID + 1)
+		err := ic.advanceTrainStep(getMeta(indexBuildID))
+		assert.Nil(t, err)
+		checkFailed(indexBuildID)
+	})
+
+	addShards := func(indexBuildID UniqueID) []UniqueID {
+		metaTable.lock.Lock()
+		err := metaTable.updateIndexMeta(indexBuildID+1, func(indexMeta *indexpb.IndexMeta) {
+			indexMeta.State = commonpb.IndexState_Finished
+		})
+		metaTable.lock.Unlock()
+		assert.Nil(t, err)
+		err = ic.advanceTrainStep(getMeta(indexBuildID))
+		assert.Nil(t, err)
+		return metaTable.GetIndexMetaByIndexBuildID(indexBuildID).DistributedBuild.ShardBuildIDs
+	}
+
+	t.Run("shard failed", func(t *testing.T) {
+		indexBuildID := split()
+		shardBuildIDs := addShards(indexBuildID)
+		err := metaTable.MarkIndexAsFailed(shardBuildIDs[1], "mock failure")
+		assert.Nil(t, err)
+		err = ic.advanceAddShardStep(getMeta(indexBuildID))
+		assert.Nil(t, err)
+		checkFailed(indexBuildID)
+	})
+
+	t.Run("shard lost", func(t *testing.T) {
+		indexBuildID := split()
+		shardBuildIDs := addShards(indexBuildID)
+		metaTable.DeleteIndex(shardBuildIDs[0])
+		err := ic.advanceAddShardStep(getMeta(indexBuildID))
+		assert.Nil(t, err)
+		checkFailed(indexBuildID)
+	})
+
+	t.Run("release failed builds", func(t *testing.T) {
+		ic.advanceDistributedBuilds()
+		assert.Empty(t, metaTable.GetDistributedBuilds())
+		for indexBuildID := UniqueID(100); indexBuildID < nextID; indexBuildID += 10 {
+			indexMeta := metaTable.GetIndexMetaByIndexBuildID(indexBuildID)
+			assert.Equal(t, commonpb.IndexState_Failed, indexMeta.State)
+			assert.True(t, indexMeta.DistributedBuild.Done)
+		}
+	})
+
+	t.Run("release unknown build", func(t *testing.T) {
+		err := metaTable.ReleaseDistributedBuild(nextID)
+		assert.NotNil(t, err)
+	})
+}
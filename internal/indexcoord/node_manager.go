@@ -13,9 +13,14 @@ package indexcoord
 
 import (
 	"context"
+	"errors"
+	"sort"
 	"sync"
+	"time"
 
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/util/metricsinfo"
 
 	grpcindexnodeclient "github.com/milvus-io/milvus/internal/distributed/indexnode/client"
 	"github.com/milvus-io/milvus/internal/log"
@@ -23,10 +28,30 @@ import (
 	"go.uber.org/zap"
 )
 
+// refreshResourcesTimeout is the timeout of GetMetrics when collecting the resources of an IndexNode.
+const refreshResourcesTimeout = 3 * time.Second
+
+// nodeResource records the hardware resources an IndexNode reported through GetMetrics.
+type nodeResource struct {
+	totalMemory uint64
+	freeCores   float64
+}
+
+// memoryReservation is the estimated memory of a task reserved on the IndexNode it is assigned to.
+type memoryReservation struct {
+	nodeID UniqueID
+	memory uint64
+}
+
 // NodeManager is used by IndexCoord to manage the client of IndexNode.
 type NodeManager struct {
 	nodeClients map[UniqueID]types.IndexNode
-	pq          *PriorityQueue
+	resources   map[UniqueID]*nodeResource
+	// the memory of a task is reserved until the task finishes or fails, so the tasks assigned to an IndexNode
+	// never exceed its free memory even if they haven't allocated their memory yet
+	reservations map[UniqueID]memoryReservation
+	reserved     map[UniqueID]uint64
+	pq           *PriorityQueue
 
 	lock sync.RWMutex
 }
@@ -34,10 +59,38 @@ type NodeManager struct {
 // NewNodeManager is used to create a new NodeManager.
 func NewNodeManager() *NodeManager {
 	return &NodeManager{
-		nodeClients: make(map[UniqueID]types.IndexNode),
-		pq:          &PriorityQueue{},
-		lock:        sync.RWMutex{},
+		nodeClients:  make(map[UniqueID]types.IndexNode),
+		resources:    make(map[UniqueID]*nodeResource),
+		reservations: make(map[UniqueID]memoryReservation),
+		reserved:     make(map[UniqueID]uint64),
+		pq:           &PriorityQueue{},
+		lock:         sync.RWMutex{},
+	}
+}
+
+// availableMemoryLocked returns the total memory of the IndexNode minus the memory reserved by its tasks. The free
+// memory reported by the IndexNode isn't used, since the memory allocated by the running tasks is already counted in
+// their reservations.
+func (nm *NodeManager) availableMemoryLocked(nodeID UniqueID, res *nodeResource) uint64 {
+	reserved := nm.reserved[nodeID]
+	if res.totalMemory <= reserved {
+		return 0
+	}
+	return res.totalMemory - reserved
+}
+
+// releaseLocked releases the memory reserved by the task.
+func (nm *NodeManager) releaseLocked(taskID UniqueID) {
+	r, ok := nm.reservations[taskID]
+	if !ok {
+		return
+	}
+	delete(nm.reservations, taskID)
+	if nm.reserved[r.nodeID] <= r.memory {
+		delete(nm.reserved, r.nodeID)
+		return
 	}
+	nm.reserved[r.nodeID] -= r.memory
 }
 
 func (nm *NodeManager) setClient(nodeID UniqueID, client types.IndexNode) {
@@ -61,6 +114,14 @@ func (nm *NodeManager) RemoveNode(nodeID UniqueID) {
 
 	log.Debug("IndexCoord", zap.Any("Remove node with ID", nodeID))
 	delete(nm.nodeClients, nodeID)
+	delete(nm.resources, nodeID)
+	// the tasks on the node are reassigned with new reservations
+	for taskID, r := range nm.reservations {
+		if r.nodeID == nodeID {
+			delete(nm.reservations, taskID)
+		}
+	}
+	delete(nm.reserved, nodeID)
 	nm.pq.Remove(nodeID)
 }
 
@@ -86,20 +147,149 @@ func (nm *NodeManager) AddNode(nodeID UniqueID, address string) error {
 	return nil
 }
 
-// PeekClient peeks the client with the least load.
-func (nm *NodeManager) PeekClient() (UniqueID, types.IndexNode) {
+// PeekClient peeks the client with the least load among the IndexNodes that have enough memory for the task which
+// is estimated to need memory bytes, nodes with the same load are ordered by their free cores. The memory is reserved
+// for the task on the picked IndexNode until ReleaseTask is called, a previous reservation of the task is replaced.
+// An IndexNode that hasn't reported its resources is considered to fit any task. Returns a nil client if no IndexNode
+// can hold the task for now.
+func (nm *NodeManager) PeekClient(taskID UniqueID, memory uint64) (UniqueID, types.IndexNode) {
 	nm.lock.Lock()
 	defer nm.lock.Unlock()
 
-	log.Debug("IndexCoord NodeManager PeekClient")
+	log.Debug("IndexCoord NodeManager PeekClient", zap.Int64("taskID", taskID), zap.Uint64("memory", memory))
+	nm.releaseLocked(taskID)
 
-	nodeID := nm.pq.Peek()
-	client, ok := nm.nodeClients[nodeID]
-	if !ok {
-		log.Error("IndexCoord NodeManager PeekClient", zap.Any("There is no IndexNode client corresponding to NodeID", nodeID))
-		return nodeID, nil
+	items := nm.pq.Items()
+	freeCores := func(nodeID UniqueID) float64 {
+		if res, ok := nm.resources[nodeID]; ok {
+			return res.freeCores
+		}
+		return 0
+	}
+	sort.SliceStable(items, func(i, j int) bool {
+		if items[i].priority != items[j].priority {
+			return items[i].priority < items[j].priority
+		}
+		return freeCores(items[i].key) > freeCores(items[j].key)
+	})
+
+	for _, item := range items {
+		nodeID := item.key
+		client, ok := nm.nodeClients[nodeID]
+		if !ok {
+			log.Error("IndexCoord NodeManager PeekClient", zap.Any("There is no IndexNode client corresponding to NodeID", nodeID))
+			continue
+		}
+		if res, ok := nm.resources[nodeID]; ok && memory > nm.availableMemoryLocked(nodeID, res) {
+			continue
+		}
+		nm.reservations[taskID] = memoryReservation{nodeID: nodeID, memory: memory}
+		nm.reserved[nodeID] += memory
+		return nodeID, client
+	}
+	return UniqueID(-1), nil
+}
+
+// ReleaseTask releases the memory reserved by PeekClient once the task finishes or fails.
+func (nm *NodeManager) ReleaseTask(taskID UniqueID) {
+	nm.lock.Lock()
+	defer nm.lock.Unlock()
+
+	nm.releaseLocked(taskID)
+}
+
+// ExceedsAllNodes returns true if the memory exceeds the total memory of every IndexNode that has reported its
+// resources, such a task can never be assigned.
+func (nm *NodeManager) ExceedsAllNodes(memory uint64) bool {
+	nm.lock.RLock()
+	defer nm.lock.RUnlock()
+
+	if len(nm.nodeClients) == 0 {
+		return false
+	}
+	for nodeID := range nm.nodeClients {
+		res, ok := nm.resources[nodeID]
+		if !ok || memory <= res.totalMemory {
+			return false
+		}
+	}
+	return true
+}
+
+// RefreshResources collects the total memory and free cores of the IndexNodes through GetMetrics concurrently, each
+// IndexNode has refreshResourcesTimeout to report. The previous report is kept if an IndexNode fails to report.
+// It's called periodically apart from the task assignment, which only reads the reports collected.
+func (nm *NodeManager) RefreshResources(ctx context.Context) {
+	req, err := metricsinfo.ConstructRequestByMetricType(metricsinfo.SystemInfoMetrics)
+	if err != nil {
+		log.Warn("IndexCoord NodeManager RefreshResources construct request failed", zap.Error(err))
+		return
+	}
+
+	nm.lock.RLock()
+	clients := make(map[UniqueID]types.IndexNode, len(nm.nodeClients))
+	for nodeID, client := range nm.nodeClients {
+		clients[nodeID] = client
+	}
+	nm.lock.RUnlock()
+
+	var wg sync.WaitGroup
+	for nodeID, client := range clients {
+		wg.Add(1)
+		go func(nodeID UniqueID, client types.IndexNode) {
+			defer wg.Done()
+			res, err := fetchNodeResource(ctx, client, req)
+			if err != nil {
+				log.Warn("IndexCoord NodeManager RefreshResources failed", zap.Int64("nodeID", nodeID), zap.Error(err))
+				return
+			}
+			if res == nil {
+				return
+			}
+			nm.lock.Lock()
+			if _, ok := nm.nodeClients[nodeID]; ok {
+				nm.resources[nodeID] = res
+			}
+			nm.lock.Unlock()
+			log.Debug("IndexCoord NodeManager RefreshResources", zap.Int64("nodeID", nodeID),
+				zap.Uint64("totalMemory", res.totalMemory), zap.Float64("freeCores", res.freeCores))
+		}(nodeID, client)
 	}
-	return nodeID, client
+	wg.Wait()
+}
+
+// fetchNodeResource gets the hardware resources of the IndexNode, returns nil if the IndexNode doesn't report them.
+func fetchNodeResource(ctx context.Context, client types.IndexNode, req *milvuspb.GetMetricsRequest) (*nodeResource, error) {
+	ctx, cancel := context.WithTimeout(ctx, refreshResourcesTimeout)
+	defer cancel()
+	resp, err := client.GetMetrics(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Status.ErrorCode != commonpb.ErrorCode_Success {
+		return nil, errors.New(resp.Status.Reason)
+	}
+	infos := metricsinfo.IndexNodeInfos{}
+	if err = metricsinfo.UnmarshalComponentInfos(resp.Response, &infos); err != nil {
+		return nil, err
+	}
+	hardware := infos.HardwareInfos
+	if hardware.Memory == 0 {
+		return nil, nil
+	}
+	return &nodeResource{
+		totalMemory: hardware.Memory,
+		freeCores:   float64(hardware.CPUCoreCount) * (100 - hardware.CPUCoreUsage) / 100,
+	}, nil
+}
+
+// GetClientByID returns the client of the IndexNode with nodeID.
+func (nm *NodeManager) GetClientByID(nodeID UniqueID) (types.IndexNode, bool) {
+	nm.lock.RLock()
+	defer nm.lock.RUnlock()
+
+	client, ok := nm.nodeClients[nodeID]
+	return client, ok
 }
 
 type indexNodeGetMetricsResponse struct {
@@ -12,11 +12,146 @@
 package indexcoord
 
 import (
+	"context"
 	"testing"
+	"time"
 
+	"github.com/milvus-io/milvus/internal/indexnode"
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/metricsinfo"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestNodeManager_getMetrics(t *testing.T) {
 	log.Info("TestNodeManager_getMetrics, todo")
 }
+
+func TestNodeManager_PeekClient(t *testing.T) {
+	nm := NewNodeManager()
+	nodeID, client := nm.PeekClient(100, 0)
+	assert.Equal(t, UniqueID(-1), nodeID)
+	assert.Nil(t, client)
+	assert.False(t, nm.ExceedsAllNodes(1024))
+
+	nm.setClient(1, &indexnode.Mock{})
+	nm.setClient(2, &indexnode.Mock{})
+	nm.setClient(3, &indexnode.Mock{})
+	nm.pq.UpdatePriority(1, 0)
+	nm.pq.UpdatePriority(2, 0)
+	nm.pq.UpdatePriority(3, 1)
+	nm.resources[1] = &nodeResource{totalMemory: 100, freeCores: 1}
+	nm.resources[2] = &nodeResource{totalMemory: 500, freeCores: 4}
+
+	t.Run("prefer more free cores", func(t *testing.T) {
+		nodeID, client = nm.PeekClient(101, 50)
+		assert.Equal(t, UniqueID(2), nodeID)
+		assert.NotNil(t, client)
+		assert.Equal(t, uint64(50), nm.reserved[2])
+	})
+
+	t.Run("skip nodes without enough memory", func(t *testing.T) {
+		nodeID, client = nm.PeekClient(102, 400)
+		assert.Equal(t, UniqueID(2), nodeID)
+		assert.NotNil(t, client)
+
+		// node 2 has reserved all its memory, node 3 hasn't reported its resources
+		nodeID, client = nm.PeekClient(103, 400)
+		assert.Equal(t, UniqueID(3), nodeID)
+		assert.NotNil(t, client)
+		assert.Equal(t, uint64(400), nm.reserved[3])
+	})
+
+	t.Run("no node fits", func(t *testing.T) {
+		nm.resources[3] = &nodeResource{totalMemory: 600}
+		nodeID, client = nm.PeekClient(104, 300)
+		assert.Equal(t, UniqueID(-1), nodeID)
+		assert.Nil(t, client)
+		assert.False(t, nm.ExceedsAllNodes(600))
+		assert.True(t, nm.ExceedsAllNodes(700))
+	})
+
+	t.Run("ReleaseTask", func(t *testing.T) {
+		nm.ReleaseTask(103)
+		assert.Equal(t, uint64(0), nm.reserved[3])
+		nodeID, client = nm.PeekClient(104, 300)
+		assert.Equal(t, UniqueID(3), nodeID)
+		assert.NotNil(t, client)
+
+		// a reassigned task replaces its reservation
+		nodeID, _ = nm.PeekClient(101, 100)
+		assert.Equal(t, UniqueID(2), nodeID)
+		assert.Equal(t, uint64(500), nm.reserved[2])
+		nm.ReleaseTask(101)
+		nm.ReleaseTask(101)
+		assert.Equal(t, uint64(400), nm.reserved[2])
+	})
+
+	t.Run("RemoveNode", func(t *testing.T) {
+		nm.RemoveNode(3)
+		_, ok := nm.resources[3]
+		assert.False(t, ok)
+		_, ok = nm.reservations[104]
+		assert.False(t, ok)
+		_, ok = nm.reserved[3]
+		assert.False(t, ok)
+	})
+}
+
+// metricsIndexNode reports the hardware metrics, and blocks GetMetrics if block is true.
+type metricsIndexNode struct {
+	types.IndexNode
+	hardware metricsinfo.HardwareMetrics
+	block    bool
+}
+
+func (n *metricsIndexNode) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
+	if n.block {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}
+	resp, err := metricsinfo.MarshalComponentInfos(metricsinfo.IndexNodeInfos{
+		BaseComponentInfos: metricsinfo.BaseComponentInfos{HardwareInfos: n.hardware},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &milvuspb.GetMetricsResponse{
+		Status:   &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		Response: resp,
+	}, nil
+}
+
+func TestNodeManager_RefreshResources(t *testing.T) {
+	nm := NewNodeManager()
+	nm.setClient(1, &metricsIndexNode{hardware: metricsinfo.HardwareMetrics{
+		CPUCoreCount: 4, CPUCoreUsage: 50, Memory: 1000, MemoryUsage: 400,
+	}})
+	nm.setClient(2, &metricsIndexNode{block: true})
+	nm.setClient(3, &indexnode.Mock{Err: true})
+	nm.resources[2] = &nodeResource{totalMemory: 100}
+	nm.pq.UpdatePriority(3, 1)
+
+	nodeID, client := nm.PeekClient(100, 500)
+	assert.Equal(t, UniqueID(1), nodeID)
+	assert.NotNil(t, client)
+
+	start := time.Now()
+	nm.RefreshResources(context.Background())
+	// the blocked node doesn't delay the others more than its timeout
+	assert.Less(t, int64(time.Since(start)), int64(2*refreshResourcesTimeout))
+
+	assert.Equal(t, uint64(1000), nm.resources[1].totalMemory)
+	assert.Equal(t, float64(2), nm.resources[1].freeCores)
+	// the memory used by the running task is only counted in its reservation
+	assert.Equal(t, uint64(500), nm.availableMemoryLocked(1, nm.resources[1]))
+	// the previous report is kept
+	assert.Equal(t, uint64(100), nm.resources[2].totalMemory)
+	_, ok := nm.resources[3]
+	assert.False(t, ok)
+
+	nm.ReleaseTask(100)
+	assert.Equal(t, uint64(1000), nm.availableMemoryLocked(1, nm.resources[1]))
+}
@@ -12,6 +12,7 @@
 package indexcoord
 
 import (
+	"fmt"
 	"path"
 	"strconv"
 	"strings"
@@ -39,6 +40,13 @@ type ParamTable struct {
 	MinIOUseSSL          bool
 	MinioBucketName      string
 
+	MaxTaskRetry int64
+
+	DistributedBuildEnabled bool
+
+	// the max number of recall estimation jobs running at a time
+	MaxRunningRecallJobs int
+
 	CreatedTime time.Time
 	UpdatedTime time.Time
 }
@@ -66,6 +74,9 @@ func (pt *ParamTable) Init() {
 	pt.initMinIOUseSSL()
 	pt.initMinioBucketName()
 	pt.initIndexRootPath()
+	pt.initMaxTaskRetry()
+	pt.initDistributedBuildEnabled()
+	pt.initMaxRunningRecallJobs()
 }
 
 // InitOnce is used to initialize configuration items, and it will only be called once.
@@ -158,6 +169,42 @@ func (pt *ParamTable) initIndexRootPath() {
 	pt.IndexRootPath = path.Join(rootPath, "index_files")
 }
 
+func (pt *ParamTable) initMaxTaskRetry() {
+	ret, err := pt.LoadWithDefault("indexCoord.maxTaskRetry", "3")
+	if err != nil {
+		panic(err)
+	}
+	pt.MaxTaskRetry, err = strconv.ParseInt(ret, 10, 64)
+	if err != nil {
+		panic(err)
+	}
+}
+
+func (pt *ParamTable) initDistributedBuildEnabled() {
+	ret, err := pt.LoadWithDefault("indexCoord.distributedBuild.enabled", "true")
+	if err != nil {
+		panic(err)
+	}
+	pt.DistributedBuildEnabled, err = strconv.ParseBool(ret)
+	if err != nil {
+		panic(err)
+	}
+}
+
+func (pt *ParamTable) initMaxRunningRecallJobs() {
+	ret, err := pt.LoadWithDefault("indexCoord.recallEstimation.maxRunningJobs", "2")
+	if err != nil {
+		panic(err)
+	}
+	pt.MaxRunningRecallJobs, err = strconv.Atoi(ret)
+	if err != nil {
+		panic(err)
+	}
+	if pt.MaxRunningRecallJobs <= 0 {
+		panic(fmt.Sprintf("indexCoord.recallEstimation.maxRunningJobs should be positive, but got %d", pt.MaxRunningRecallJobs))
+	}
+}
+
 func (pt *ParamTable) initLogCfg() {
 	pt.InitLogCfg("indexcoord", 0)
 }
@@ -72,6 +72,14 @@ func TestParamTable(t *testing.T) {
 	t.Run("initIndexRootPath", func(t *testing.T) {
 		t.Logf("IndexRootPath: %v", Params.IndexRootPath)
 	})
+
+	t.Run("initMaxTaskRetry", func(t *testing.T) {
+		t.Logf("MaxTaskRetry: %v", Params.MaxTaskRetry)
+	})
+
+	t.Run("initDistributedBuildEnabled", func(t *testing.T) {
+		t.Logf("DistributedBuildEnabled: %v", Params.DistributedBuildEnabled)
+	})
 }
 
 //TODO: Params Load should be return error when key does not exist.
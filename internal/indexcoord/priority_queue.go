@@ -137,6 +137,18 @@ func (pq *PriorityQueue) Peek() UniqueID {
 	return pq.items[0].key
 }
 
+// Items returns a copy of all the items.
+func (pq *PriorityQueue) Items() []PQItem {
+	pq.lock.RLock()
+	defer pq.lock.RUnlock()
+
+	ret := make([]PQItem, 0, len(pq.items))
+	for _, item := range pq.items {
+		ret = append(ret, *item)
+	}
+	return ret
+}
+
 // PeekAll return the key of all the items.
 func (pq *PriorityQueue) PeekAll() []UniqueID {
 	pq.lock.RLock()
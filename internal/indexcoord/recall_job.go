@@ -0,0 +1,240 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package indexcoord
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/types"
+)
+
+const (
+	// recallJobRetention is how long the result of a finished recall estimation job is kept for the callers to poll,
+	// a job which can't be started within it fails.
+	recallJobRetention = 10 * time.Minute
+	// recallJobScheduleInterval is the interval to retry the pending jobs when no IndexNode has enough memory.
+	recallJobScheduleInterval = 3 * time.Second
+)
+
+// recallJob is a recall estimation executed by an IndexNode.
+type recallJob struct {
+	req        *indexpb.EstimateRecallRequest
+	memory     uint64
+	result     *indexpb.EstimateRecallResponse
+	createTime time.Time
+	finishTime time.Time
+}
+
+// recallJobScheduler runs the recall estimation jobs on IndexNodes in the order they are queued, at most maxRunning
+// jobs at a time. The memory of a job is reserved on its IndexNode like an index build task until the job finishes.
+type recallJobScheduler struct {
+	ctx         context.Context
+	nodeManager *NodeManager
+	maxRunning  int
+
+	lock    sync.Mutex
+	jobs    map[UniqueID]*recallJob
+	pending []UniqueID
+	running int
+
+	notifyChan chan struct{}
+	wg         sync.WaitGroup
+}
+
+func newRecallJobScheduler(ctx context.Context, nodeManager *NodeManager, maxRunning int) *recallJobScheduler {
+	return &recallJobScheduler{
+		ctx:         ctx,
+		nodeManager: nodeManager,
+		maxRunning:  maxRunning,
+		jobs:        make(map[UniqueID]*recallJob),
+		notifyChan:  make(chan struct{}, 1),
+	}
+}
+
+func (s *recallJobScheduler) Start() {
+	s.wg.Add(1)
+	go s.scheduleLoop()
+}
+
+func (s *recallJobScheduler) Close() {
+	s.wg.Wait()
+}
+
+// enqueue queues the job estimating the recall of req, which is expected to need memory bytes on the IndexNode.
+func (s *recallJobScheduler) enqueue(jobID UniqueID, req *indexpb.EstimateRecallRequest, memory uint64) *indexpb.EstimateRecallResponse {
+	job := &recallJob{
+		req:    req,
+		memory: memory,
+		result: &indexpb.EstimateRecallResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_Success,
+			},
+			IndexBuildID: req.IndexBuildID,
+			JobID:        jobID,
+			State:        commonpb.IndexState_Unissued,
+		},
+		createTime: time.Now(),
+	}
+	s.lock.Lock()
+	s.jobs[jobID] = job
+	s.pending = append(s.pending, jobID)
+	ret := proto.Clone(job.result).(*indexpb.EstimateRecallResponse)
+	s.lock.Unlock()
+
+	s.notify()
+	return ret
+}
+
+// get returns the state of the job, and the result once it is finished.
+func (s *recallJobScheduler) get(jobID UniqueID) *indexpb.EstimateRecallResponse {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	job, ok := s.jobs[jobID]
+	if !ok {
+		return &indexpb.EstimateRecallResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    fmt.Sprintf("recall estimation job %d not exists or expired", jobID),
+			},
+			JobID: jobID,
+			State: commonpb.IndexState_Failed,
+		}
+	}
+	return proto.Clone(job.result).(*indexpb.EstimateRecallResponse)
+}
+
+func (s *recallJobScheduler) notify() {
+	select {
+	case s.notifyChan <- struct{}{}:
+	default:
+	}
+}
+
+func (s *recallJobScheduler) scheduleLoop() {
+	defer s.wg.Done()
+	ticker := time.NewTicker(recallJobScheduleInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.ctx.Done():
+			log.Debug("IndexCoord recallJobScheduler ctx done")
+			return
+		case <-s.notifyChan:
+		case <-ticker.C:
+			s.expire(time.Now())
+		}
+		s.schedule()
+	}
+}
+
+// schedule starts the pending jobs in order while less than maxRunning jobs are running, and there is an IndexNode
+// with enough memory for the first pending job.
+func (s *recallJobScheduler) schedule() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	for len(s.pending) > 0 && s.running < s.maxRunning {
+		jobID := s.pending[0]
+		job := s.jobs[jobID]
+		if job == nil {
+			s.pending = s.pending[1:]
+			continue
+		}
+		if s.nodeManager.ExceedsAllNodes(job.memory) {
+			s.pending = s.pending[1:]
+			s.finishLocked(job, fmt.Errorf("the recall estimation needs %d bytes of memory, more than any IndexNode has", job.memory))
+			continue
+		}
+		nodeID, client := s.nodeManager.PeekClient(jobID, job.memory)
+		if client == nil {
+			log.Debug("IndexCoord recallJobScheduler can not find IndexNode with enough memory",
+				zap.Int64("jobID", jobID), zap.Uint64("memory", job.memory))
+			return
+		}
+		s.pending = s.pending[1:]
+		s.running++
+		job.result.State = commonpb.IndexState_InProgress
+		s.nodeManager.pq.IncPriority(nodeID, 1)
+		log.Debug("IndexCoord recallJobScheduler start job", zap.Int64("jobID", jobID),
+			zap.Int64("IndexBuildID", job.req.IndexBuildID), zap.Int64("nodeID", nodeID))
+		s.wg.Add(1)
+		go s.run(jobID, job, nodeID, client)
+	}
+}
+
+func (s *recallJobScheduler) run(jobID UniqueID, job *recallJob, nodeID UniqueID, client types.IndexNode) {
+	defer s.wg.Done()
+	resp, err := client.EstimateRecall(s.ctx, job.req)
+	if err == nil && resp.Status.ErrorCode != commonpb.ErrorCode_Success {
+		err = fmt.Errorf("IndexNode %d failed to estimate the recall, reason: %s", nodeID, resp.Status.Reason)
+	}
+	s.nodeManager.ReleaseTask(jobID)
+	s.nodeManager.pq.IncPriority(nodeID, -1)
+
+	s.lock.Lock()
+	s.running--
+	if err == nil {
+		job.result.Nq = resp.Nq
+		job.result.Recall = resp.Recall
+	}
+	s.finishLocked(job, err)
+	s.lock.Unlock()
+	s.notify()
+
+	if err != nil {
+		log.Warn("IndexCoord recall estimation job failed", zap.Int64("jobID", jobID),
+			zap.Int64("IndexBuildID", job.req.IndexBuildID), zap.Int64("nodeID", nodeID), zap.Error(err))
+		return
+	}
+	log.Debug("IndexCoord recall estimation job done", zap.Int64("jobID", jobID),
+		zap.Int64("IndexBuildID", job.req.IndexBuildID), zap.Int64("nodeID", nodeID), zap.Float32("recall", resp.Recall))
+}
+
+func (s *recallJobScheduler) finishLocked(job *recallJob, err error) {
+	job.finishTime = time.Now()
+	if err != nil {
+		job.result.State = commonpb.IndexState_Failed
+		job.result.Status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+		job.result.Status.Reason = err.Error()
+		return
+	}
+	job.result.State = commonpb.IndexState_Finished
+}
+
+// expire removes the jobs finished for recallJobRetention, and fails the jobs pending for recallJobRetention.
+func (s *recallJobScheduler) expire(now time.Time) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	pending := s.pending[:0]
+	for _, jobID := range s.pending {
+		job := s.jobs[jobID]
+		if job != nil && now.Sub(job.createTime) >= recallJobRetention {
+			s.finishLocked(job, fmt.Errorf("no IndexNode could estimate the recall in %v", recallJobRetention))
+			continue
+		}
+		pending = append(pending, jobID)
+	}
+	s.pending = pending
+	for jobID, job := range s.jobs {
+		if !job.finishTime.IsZero() && now.Sub(job.finishTime) >= recallJobRetention {
+			delete(s.jobs, jobID)
+		}
+	}
+}
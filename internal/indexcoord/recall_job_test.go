@@ -0,0 +1,139 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package indexcoord
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/indexnode"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/types"
+)
+
+// blockingRecallNode blocks the recall estimations until release is closed.
+type blockingRecallNode struct {
+	types.IndexNode
+	started chan UniqueID
+	release chan struct{}
+}
+
+func (n *blockingRecallNode) EstimateRecall(ctx context.Context, req *indexpb.EstimateRecallRequest) (*indexpb.EstimateRecallResponse, error) {
+	n.started <- req.IndexBuildID
+	<-n.release
+	return &indexpb.EstimateRecallResponse{
+		Status:       &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		IndexBuildID: req.IndexBuildID,
+		Nq:           req.Nq,
+		Recall:       0.9,
+	}, nil
+}
+
+func waitRecallJob(t *testing.T, s *recallJobScheduler, jobID UniqueID) *indexpb.EstimateRecallResponse {
+	for i := 0; i < 100; i++ {
+		result := s.get(jobID)
+		if result.State == commonpb.IndexState_Finished || result.State == commonpb.IndexState_Failed {
+			return result
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatalf("recall estimation job %d is not done", jobID)
+	return nil
+}
+
+func TestRecallJobScheduler(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	nm := NewNodeManager()
+	node := &blockingRecallNode{started: make(chan UniqueID, 10), release: make(chan struct{})}
+	nm.setClient(1, node)
+	nm.resources[1] = &nodeResource{totalMemory: 1000}
+	s := newRecallJobScheduler(ctx, nm, 1)
+	s.Start()
+	defer func() {
+		cancel()
+		s.Close()
+	}()
+
+	t.Run("bounded concurrency", func(t *testing.T) {
+		result := s.enqueue(10, &indexpb.EstimateRecallRequest{IndexBuildID: 100, Nq: 5}, 100)
+		assert.Equal(t, commonpb.ErrorCode_Success, result.Status.ErrorCode)
+		assert.Equal(t, int64(10), result.JobID)
+		s.enqueue(11, &indexpb.EstimateRecallRequest{IndexBuildID: 101, Nq: 5}, 100)
+
+		assert.Equal(t, UniqueID(100), <-node.started)
+		assert.Equal(t, commonpb.IndexState_InProgress, s.get(10).State)
+		// the second job waits for the first one
+		time.Sleep(50 * time.Millisecond)
+		assert.Equal(t, commonpb.IndexState_Unissued, s.get(11).State)
+		nm.lock.RLock()
+		assert.Equal(t, uint64(100), nm.reserved[1])
+		nm.lock.RUnlock()
+
+		close(node.release)
+		result = waitRecallJob(t, s, 10)
+		assert.Equal(t, commonpb.IndexState_Finished, result.State)
+		assert.Equal(t, int64(5), result.Nq)
+		assert.Equal(t, float32(0.9), result.Recall)
+		assert.Equal(t, UniqueID(101), <-node.started)
+		assert.Equal(t, commonpb.IndexState_Finished, waitRecallJob(t, s, 11).State)
+
+		// the reserved memory is released
+		nm.lock.RLock()
+		assert.Equal(t, uint64(0), nm.reserved[1])
+		nm.lock.RUnlock()
+	})
+
+	t.Run("too large", func(t *testing.T) {
+		s.enqueue(12, &indexpb.EstimateRecallRequest{IndexBuildID: 102}, 2000)
+		result := waitRecallJob(t, s, 12)
+		assert.Equal(t, commonpb.IndexState_Failed, result.State)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, result.Status.ErrorCode)
+	})
+
+	t.Run("IndexNode failed", func(t *testing.T) {
+		nm.RemoveNode(1)
+		nm.setClient(2, &indexnode.Mock{Err: true})
+		s.enqueue(13, &indexpb.EstimateRecallRequest{IndexBuildID: 103}, 100)
+		result := waitRecallJob(t, s, 13)
+		assert.Equal(t, commonpb.IndexState_Failed, result.State)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, result.Status.ErrorCode)
+	})
+
+	t.Run("not exist", func(t *testing.T) {
+		result := s.get(14)
+		assert.Equal(t, commonpb.IndexState_Failed, result.State)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, result.Status.ErrorCode)
+	})
+}
+
+func TestRecallJobScheduler_expire(t *testing.T) {
+	s := newRecallJobScheduler(context.Background(), NewNodeManager(), 1)
+	s.enqueue(1, &indexpb.EstimateRecallRequest{IndexBuildID: 100}, 100)
+	s.enqueue(2, &indexpb.EstimateRecallRequest{IndexBuildID: 101}, 100)
+	s.jobs[1].createTime = time.Now().Add(-recallJobRetention)
+
+	// the job pending too long fails, and its result is kept for polling
+	s.expire(time.Now())
+	assert.Equal(t, []UniqueID{2}, s.pending)
+	assert.Equal(t, commonpb.IndexState_Failed, s.get(1).State)
+	assert.Equal(t, commonpb.IndexState_Unissued, s.get(2).State)
+
+	s.expire(time.Now().Add(recallJobRetention))
+	_, ok := s.jobs[1]
+	assert.False(t, ok)
+	assert.Equal(t, commonpb.IndexState_Failed, s.get(2).State)
+}
@@ -0,0 +1,129 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package indexcoord
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/indexparamcheck"
+)
+
+const (
+	// scalarKeySize is the estimated size of a key and its row offsets in a scalar index.
+	scalarKeySize = 64
+	// hnswDefaultM is the default number of links per node in a HNSW graph.
+	hnswDefaultM = 16
+	// nsgDefaultOutDegree is the default out degree of a NSG graph.
+	nsgDefaultOutDegree = 32
+)
+
+// flattenParams converts the key value pairs into a map, the params nested in the json of key "params" are expanded.
+func flattenParams(kvs []*commonpb.KeyValuePair) map[string]string {
+	params := make(map[string]string)
+	for _, kv := range kvs {
+		if kv.Key == "params" {
+			nested, err := funcutil.ParseIndexParamsMap(kv.Value)
+			if err != nil {
+				continue
+			}
+			for k, v := range nested {
+				params[k] = v
+			}
+			continue
+		}
+		params[kv.Key] = kv.Value
+	}
+	return params
+}
+
+func getIntParam(params map[string]string, key string, defaultValue int64) int64 {
+	value, ok := params[key]
+	if !ok {
+		return defaultValue
+	}
+	ret, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || ret <= 0 {
+		return defaultValue
+	}
+	return ret
+}
+
+// estimateIndexBuildMemory estimates the peak memory in bytes IndexNode needs to build the index of the request.
+// IndexNode holds the binlogs and the deserialized field data while building, and the index together with its
+// serialized copy when it is done. Returns 0 if the row number is unknown.
+func estimateIndexBuildMemory(req *indexpb.BuildIndexRequest) uint64 {
+	rows := req.GetNumRows()
+	if rows <= 0 {
+		return 0
+	}
+	typeParams := flattenParams(req.GetTypeParams())
+	indexParams := flattenParams(req.GetIndexParams())
+	indexType := indexParams[indexparamcheck.IndexTypeKey]
+
+	if indexparamcheck.IsScalarIndexType(indexType) {
+		raw := rows * scalarKeySize
+		return uint64(4 * raw)
+	}
+
+	dim := getIntParam(typeParams, indexparamcheck.DIM, 0)
+	if dim == 0 {
+		return 0
+	}
+	var raw int64
+	if strings.HasPrefix(indexType, "BIN_") {
+		raw = rows * ((dim + 7) / 8)
+	} else {
+		raw = rows * dim * 4
+	}
+	nlist := getIntParam(indexParams, indexparamcheck.NLIST, 0)
+	centroids := nlist * dim * 4
+
+	var index, trainingCopy int64
+	switch indexType {
+	case indexparamcheck.IndexFaissIDMap, indexparamcheck.IndexFaissBinIDMap,
+		indexparamcheck.IndexFaissIvfFlat, indexparamcheck.IndexFaissBinIvfFlat:
+		index = raw + centroids
+	case indexparamcheck.IndexFaissIvfSQ8, indexparamcheck.IndexFaissIvfSQ8H:
+		index = raw/4 + centroids
+	case indexparamcheck.IndexFaissIvfPQ:
+		m := getIntParam(indexParams, indexparamcheck.IVFM, dim)
+		nbits := getIntParam(indexParams, indexparamcheck.NBITS, indexparamcheck.DefaultNBits)
+		// the training of the product quantizer keeps a copy of the raw vectors
+		trainingCopy = raw
+		index = trainingCopy + rows*((m*nbits+7)/8) + centroids
+	case indexparamcheck.IndexHNSW, indexparamcheck.IndexRHNSWFlat:
+		m := getIntParam(indexParams, indexparamcheck.HNSWM, hnswDefaultM)
+		index = raw + rows*m*2*4
+	case indexparamcheck.IndexRHNSWSQ:
+		m := getIntParam(indexParams, indexparamcheck.HNSWM, hnswDefaultM)
+		index = raw/4 + rows*m*2*4
+	case indexparamcheck.IndexRHNSWPQ:
+		m := getIntParam(indexParams, indexparamcheck.HNSWM, hnswDefaultM)
+		pqm := getIntParam(indexParams, indexparamcheck.PQM, dim)
+		index = raw + rows*pqm + rows*m*2*4
+	case indexparamcheck.IndexNSG:
+		outDegree := getIntParam(indexParams, indexparamcheck.OutDegree, nsgDefaultOutDegree)
+		index = raw + rows*outDegree*4
+	default:
+		// ANNOY, NGT and unknown index types
+		index = 2 * raw
+	}
+	if req.GetStep() == indexpb.IndexBuildStep_Merge {
+		// the shard indexes are merged without loading the raw vectors
+		return uint64(2 * (index - trainingCopy))
+	}
+	return uint64(2*raw + 2*index)
+}
@@ -0,0 +1,101 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package indexcoord
+
+import (
+	"testing"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEstimateIndexBuildMemory(t *testing.T) {
+	newReq := func(numRows int64, dim string, indexParams ...*commonpb.KeyValuePair) *indexpb.BuildIndexRequest {
+		return &indexpb.BuildIndexRequest{
+			NumRows:     numRows,
+			TypeParams:  []*commonpb.KeyValuePair{{Key: "dim", Value: dim}},
+			IndexParams: indexParams,
+		}
+	}
+
+	t.Run("unknown rows", func(t *testing.T) {
+		req := newReq(0, "128", &commonpb.KeyValuePair{Key: "index_type", Value: "IVF_FLAT"})
+		assert.Equal(t, uint64(0), estimateIndexBuildMemory(req))
+	})
+
+	t.Run("unknown dim", func(t *testing.T) {
+		req := newReq(1000, "abc", &commonpb.KeyValuePair{Key: "index_type", Value: "IVF_FLAT"})
+		assert.Equal(t, uint64(0), estimateIndexBuildMemory(req))
+	})
+
+	t.Run("IVF_FLAT", func(t *testing.T) {
+		req := newReq(1000, "128",
+			&commonpb.KeyValuePair{Key: "index_type", Value: "IVF_FLAT"},
+			&commonpb.KeyValuePair{Key: "nlist", Value: "10"})
+		raw := uint64(1000 * 128 * 4)
+		assert.Equal(t, 2*raw+2*(raw+10*128*4), estimateIndexBuildMemory(req))
+	})
+
+	t.Run("nested params", func(t *testing.T) {
+		req := newReq(1000, "128",
+			&commonpb.KeyValuePair{Key: "params", Value: `{"index_type": "IVF_FLAT", "nlist": 10}`})
+		raw := uint64(1000 * 128 * 4)
+		assert.Equal(t, 2*raw+2*(raw+10*128*4), estimateIndexBuildMemory(req))
+	})
+
+	t.Run("binary", func(t *testing.T) {
+		req := newReq(1000, "128", &commonpb.KeyValuePair{Key: "index_type", Value: "BIN_FLAT"})
+		raw := uint64(1000 * 16)
+		assert.Equal(t, 4*raw, estimateIndexBuildMemory(req))
+	})
+
+	t.Run("index types", func(t *testing.T) {
+		sq8 := estimateIndexBuildMemory(newReq(1000, "128", &commonpb.KeyValuePair{Key: "index_type", Value: "IVF_SQ8"}))
+		flat := estimateIndexBuildMemory(newReq(1000, "128", &commonpb.KeyValuePair{Key: "index_type", Value: "IVF_FLAT"}))
+		pq := estimateIndexBuildMemory(newReq(1000, "128",
+			&commonpb.KeyValuePair{Key: "index_type", Value: "IVF_PQ"},
+			&commonpb.KeyValuePair{Key: "m", Value: "8"}))
+		hnsw := estimateIndexBuildMemory(newReq(1000, "128", &commonpb.KeyValuePair{Key: "index_type", Value: "HNSW"}))
+		assert.True(t, sq8 < flat)
+		assert.True(t, flat < pq)
+		assert.True(t, flat < hnsw)
+
+		for _, indexType := range []string{"RHNSW_SQ", "RHNSW_PQ", "NSG", "ANNOY"} {
+			req := newReq(1000, "128", &commonpb.KeyValuePair{Key: "index_type", Value: indexType})
+			assert.True(t, estimateIndexBuildMemory(req) > 0)
+		}
+	})
+
+	t.Run("merge", func(t *testing.T) {
+		req := newReq(1000, "128",
+			&commonpb.KeyValuePair{Key: "index_type", Value: "IVF_SQ8"},
+			&commonpb.KeyValuePair{Key: "nlist", Value: "10"})
+		req.Step = indexpb.IndexBuildStep_Merge
+		raw := uint64(1000 * 128 * 4)
+		assert.Equal(t, 2*(raw/4+10*128*4), estimateIndexBuildMemory(req))
+
+		req = newReq(1000, "128",
+			&commonpb.KeyValuePair{Key: "index_type", Value: "IVF_PQ"},
+			&commonpb.KeyValuePair{Key: "m", Value: "8"})
+		req.Step = indexpb.IndexBuildStep_Merge
+		assert.Equal(t, uint64(2*1000*8), estimateIndexBuildMemory(req))
+	})
+
+	t.Run("scalar", func(t *testing.T) {
+		req := &indexpb.BuildIndexRequest{
+			NumRows:     1000,
+			IndexParams: []*commonpb.KeyValuePair{{Key: "index_type", Value: "SORTED"}},
+		}
+		assert.Equal(t, uint64(4*1000*scalarKeySize), estimateIndexBuildMemory(req))
+	})
+}
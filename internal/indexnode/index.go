@@ -48,6 +48,24 @@ type Index interface {
 	Delete() error
 }
 
+// DistributedIndex is an Index that can be built in steps on different IndexNodes: trained on a sample of the
+// vectors, the shards of the vectors added to copies of the trained index, and the shard indexes merged in order.
+type DistributedIndex interface {
+	Index
+	TrainFloatVecIndex(vectors []float32) error
+	AddFloatVecIndexWithoutIds(vectors []float32) error
+	// Merge appends the vectors of the index in blobs, the loaded index must be trained with the same centroids.
+	Merge(blobs []*Blob) error
+}
+
+// QueryableIndex is an Index that can be searched, it is used to estimate the recall of a built index.
+type QueryableIndex interface {
+	Index
+	// QueryFloatVecIndexWithParam searches the topk nearest vectors of the queries, the ids and distances of the
+	// i-th query are at [i*topk, (i+1)*topk).
+	QueryFloatVecIndexWithParam(vectors []float32, searchParams map[string]string) (ids []int64, distances []float32, topk int64, err error)
+}
+
 type CIndex struct {
 	indexPtr C.CIndex
 }
@@ -86,13 +104,16 @@ func (index *CIndex) Serialize() ([]*Blob, error) {
 	return ret, nil
 }
 
-func (index *CIndex) Load(blobs []*Blob) error {
+func marshalBinarySet(blobs []*Blob) ([]byte, error) {
 	binarySet := &indexcgopb.BinarySet{Datas: make([]*indexcgopb.Binary, 0)}
 	for _, blob := range blobs {
 		binarySet.Datas = append(binarySet.Datas, &indexcgopb.Binary{Key: blob.Key, Value: blob.Value})
 	}
+	return proto.Marshal(binarySet)
+}
 
-	datas, err2 := proto.Marshal(binarySet)
+func (index *CIndex) Load(blobs []*Blob) error {
+	datas, err2 := marshalBinarySet(blobs)
 	if err2 != nil {
 		return err2
 	}
@@ -143,6 +164,95 @@ func (index *CIndex) BuildBinaryVecIndexWithoutIds(vectors []byte) error {
 	return nil
 }
 
+func (index *CIndex) TrainFloatVecIndex(vectors []float32) error {
+	/*
+		CStatus
+		TrainFloatVecIndex(CIndex index, int64_t float_value_num, const float* vectors);
+	*/
+	status := C.TrainFloatVecIndex(index.indexPtr, (C.int64_t)(len(vectors)), (*C.float)(&vectors[0]))
+	errorCode := status.error_code
+	if errorCode != 0 {
+		errorMsg := C.GoString(status.error_msg)
+		defer C.free(unsafe.Pointer(status.error_msg))
+		return fmt.Errorf("TrainFloatVecIndex failed, C runtime error detected, error code = %d, err msg = %s", errorCode, errorMsg)
+	}
+	return nil
+}
+
+func (index *CIndex) AddFloatVecIndexWithoutIds(vectors []float32) error {
+	/*
+		CStatus
+		AddFloatVecIndexWithoutIds(CIndex index, int64_t float_value_num, const float* vectors);
+	*/
+	status := C.AddFloatVecIndexWithoutIds(index.indexPtr, (C.int64_t)(len(vectors)), (*C.float)(&vectors[0]))
+	errorCode := status.error_code
+	if errorCode != 0 {
+		errorMsg := C.GoString(status.error_msg)
+		defer C.free(unsafe.Pointer(status.error_msg))
+		return fmt.Errorf("AddFloatVecIndexWithoutIds failed, C runtime error detected, error code = %d, err msg = %s", errorCode, errorMsg)
+	}
+	return nil
+}
+
+func (index *CIndex) Merge(blobs []*Blob) error {
+	datas, err := marshalBinarySet(blobs)
+	if err != nil {
+		return err
+	}
+
+	/*
+		CStatus
+		MergeFromSlicedBuffer(CIndex index, const char* serialized_sliced_blob_buffer, int32_t size);
+	*/
+	status := C.MergeFromSlicedBuffer(index.indexPtr, (*C.char)(unsafe.Pointer(&datas[0])), (C.int32_t)(len(datas)))
+	errorCode := status.error_code
+	if errorCode != 0 {
+		errorMsg := C.GoString(status.error_msg)
+		defer C.free(unsafe.Pointer(status.error_msg))
+		return fmt.Errorf("MergeFromSlicedBuffer failed, C runtime error detected, error code = %d, err msg = %s", errorCode, errorMsg)
+	}
+	return nil
+}
+
+func (index *CIndex) QueryFloatVecIndexWithParam(vectors []float32, searchParams map[string]string) ([]int64, []float32, int64, error) {
+	protoSearchParams := &indexcgopb.MapParams{
+		Params: make([]*commonpb.KeyValuePair, 0),
+	}
+	for key, value := range searchParams {
+		protoSearchParams.Params = append(protoSearchParams.Params, &commonpb.KeyValuePair{Key: key, Value: value})
+	}
+	searchParamsPointer := C.CString(proto.MarshalTextString(protoSearchParams))
+	defer C.free(unsafe.Pointer(searchParamsPointer))
+
+	/*
+		CStatus
+		QueryOnFloatVecIndexWithParam(CIndex index,
+									  int64_t float_value_num,
+									  const float* vectors,
+									  const char* serialized_search_params,
+									  CIndexQueryResult* res);
+	*/
+	var cRes C.CIndexQueryResult
+	status := C.QueryOnFloatVecIndexWithParam(index.indexPtr, (C.int64_t)(len(vectors)), (*C.float)(&vectors[0]), searchParamsPointer, &cRes)
+	errorCode := status.error_code
+	if errorCode != 0 {
+		errorMsg := C.GoString(status.error_msg)
+		defer C.free(unsafe.Pointer(status.error_msg))
+		return nil, nil, 0, fmt.Errorf("QueryOnFloatVecIndexWithParam failed, C runtime error detected, error code = %d, err msg = %s", errorCode, errorMsg)
+	}
+	defer C.DeleteIndexQueryResult(cRes)
+
+	nq := int64(C.NqOfQueryResult(cRes))
+	topk := int64(C.TopkOfQueryResult(cRes))
+	ids := make([]int64, nq*topk)
+	distances := make([]float32, nq*topk)
+	if nq*topk > 0 {
+		C.GetIdsOfQueryResult(cRes, (*C.int64_t)(&ids[0]))
+		C.GetDistancesOfQueryResult(cRes, (*C.float)(&distances[0]))
+	}
+	return ids, distances, topk, nil
+}
+
 func (index *CIndex) Delete() error {
 	/*
 		void
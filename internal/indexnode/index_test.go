@@ -308,6 +308,81 @@ func TestCIndex_Codec(t *testing.T) {
 	}
 }
 
+func TestCIndex_TrainAddAndMerge(t *testing.T) {
+	for _, indexType := range []string{IndexFaissIVFSQ8, IndexFaissIVFPQ} {
+		typeParams, indexParams := generateParams(indexType, L2)
+		vectors := generateFloatVectors()
+		shards := [][]float32{vectors[:nb/2*dim], vectors[nb/2*dim:]}
+
+		trained, err := NewCIndex(typeParams, indexParams)
+		assert.Nil(t, err)
+		err = trained.(DistributedIndex).TrainFloatVecIndex(shards[0])
+		assert.Nil(t, err)
+		trainedBlobs, err := trained.Serialize()
+		assert.Nil(t, err)
+		assert.Nil(t, trained.Delete())
+
+		shardBlobs := make([][]*Blob, 0, len(shards))
+		for _, shard := range shards {
+			shardIndex, err := NewCIndex(typeParams, indexParams)
+			assert.Nil(t, err)
+			assert.Nil(t, shardIndex.Load(trainedBlobs))
+			assert.Nil(t, shardIndex.(DistributedIndex).AddFloatVecIndexWithoutIds(shard))
+			blobs, err := shardIndex.Serialize()
+			assert.Nil(t, err)
+			shardBlobs = append(shardBlobs, blobs)
+			assert.Nil(t, shardIndex.Delete())
+		}
+
+		merged, err := NewCIndex(typeParams, indexParams)
+		assert.Nil(t, err)
+		assert.Nil(t, merged.Load(shardBlobs[0]))
+		assert.Nil(t, merged.(DistributedIndex).Merge(shardBlobs[1]))
+		_, err = merged.Serialize()
+		assert.Nil(t, err)
+		assert.Nil(t, merged.Delete())
+	}
+
+	t.Run("not distributable", func(t *testing.T) {
+		typeParams, indexParams := generateParams(IndexFaissIVFFlat, L2)
+		index, err := NewCIndex(typeParams, indexParams)
+		assert.Nil(t, err)
+		err = index.(DistributedIndex).TrainFloatVecIndex(generateFloatVectors())
+		assert.NotNil(t, err)
+		assert.Nil(t, index.Delete())
+	})
+}
+
+func TestCIndex_QueryFloatVecIndexWithParam(t *testing.T) {
+	typeParams, indexParams := generateParams(IndexFaissIVFFlat, L2)
+	vectors := generateFloatVectors()
+	built, err := NewCIndex(typeParams, indexParams)
+	assert.Nil(t, err)
+	assert.Nil(t, built.BuildFloatVecIndexWithoutIds(vectors))
+	blobs, err := built.Serialize()
+	assert.Nil(t, err)
+	assert.Nil(t, built.Delete())
+
+	index, err := NewCIndex(typeParams, indexParams)
+	assert.Nil(t, err)
+	assert.Nil(t, index.Load(blobs))
+	searchParams := map[string]string{
+		"nprobe":      strconv.Itoa(nlist),
+		"k":           "5",
+		"metric_type": L2,
+	}
+	queries := vectors[:2*dim]
+	ids, distances, topk, err := index.(QueryableIndex).QueryFloatVecIndexWithParam(queries, searchParams)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(5), topk)
+	assert.Equal(t, 10, len(ids))
+	assert.Equal(t, 10, len(distances))
+	// every query is a vector of the index
+	assert.Equal(t, int64(0), ids[0])
+	assert.Equal(t, int64(1), ids[topk])
+	assert.Nil(t, index.Delete())
+}
+
 func TestCIndex_Delete(t *testing.T) {
 	for _, c := range generateTestCases() {
 		typeParams, indexParams := generateParams(c.indexType, c.metricType)
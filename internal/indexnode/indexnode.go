@@ -25,6 +25,7 @@ import "C"
 import (
 	"context"
 	"errors"
+	"fmt"
 	"io"
 	"math/rand"
 	"strconv"
@@ -45,6 +46,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/util/retry"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
 	"github.com/milvus-io/milvus/internal/util/trace"
@@ -64,15 +66,15 @@ type IndexNode struct {
 
 	once sync.Once
 
-	kv      kv.BaseKV
-	session *sessionutil.Session
-	liveCh  <-chan bool
+	chunkManager storage.ChunkManager
+	session      *sessionutil.Session
+	liveCh       <-chan bool
 
 	// Add callback functions at different stages
 	startCallbacks []func()
 	closeCallbacks []func()
 
-	etcdKV        *etcdkv.EtcdKV
+	etcdKV        kv.MetaKv
 	finishedTasks map[UniqueID]commonpb.IndexState
 
 	closer io.Closer
@@ -89,7 +91,7 @@ func NewIndexNode(ctx context.Context) (*IndexNode, error) {
 		loopCancel: cancel,
 	}
 	b.UpdateStateCode(internalpb.StateCode_Abnormal)
-	sc, err := NewTaskScheduler(b.loopCtx, b.kv)
+	sc, err := NewTaskScheduler(b.loopCtx, b.chunkManager)
 	if err != nil {
 		return nil, err
 	}
@@ -129,7 +131,7 @@ func (i *IndexNode) Init() error {
 		i.UpdateStateCode(internalpb.StateCode_Initializing)
 		log.Debug("IndexNode", zap.Any("State", internalpb.StateCode_Initializing))
 		connectEtcdFn := func() error {
-			etcdKV, err := etcdkv.NewEtcdKV(Params.EtcdEndpoints, Params.MetaRootPath)
+			etcdKV, err := etcdkv.NewMetaKv(Params.EtcdEndpoints, Params.MetaRootPath)
 			i.etcdKV = etcdKV
 			return err
 		}
@@ -149,16 +151,16 @@ func (i *IndexNode) Init() error {
 			BucketName:        Params.MinioBucketName,
 			CreateBucket:      true,
 		}
-		kv, err := miniokv.NewMinIOKV(i.loopCtx, option)
+		chunkManager, err := storage.NewMinioChunkManager(i.loopCtx, option)
 		if err != nil {
-			log.Error("IndexNode NewMinIOKV failed", zap.Error(err))
+			log.Error("IndexNode NewMinioChunkManager failed", zap.Error(err))
 			initErr = err
 			return
 		}
 
-		i.kv = kv
+		i.chunkManager = chunkManager
 
-		log.Debug("IndexNode NewMinIOKV success")
+		log.Debug("IndexNode NewMinioChunkManager success")
 		i.closer = trace.InitTracing("index_node")
 
 		i.initKnowhere()
@@ -244,10 +246,10 @@ func (i *IndexNode) CreateIndex(ctx context.Context, request *indexpb.CreateInde
 			ctx:  ctx,
 			done: make(chan error),
 		},
-		req:    request,
-		kv:     i.kv,
-		etcdKV: i.etcdKV,
-		nodeID: Params.NodeID,
+		req:          request,
+		chunkManager: i.chunkManager,
+		etcdKV:       i.etcdKV,
+		nodeID:       Params.NodeID,
 	}
 
 	ret := &commonpb.Status{
@@ -265,6 +267,76 @@ func (i *IndexNode) CreateIndex(ctx context.Context, request *indexpb.CreateInde
 	return ret, nil
 }
 
+// CancelIndexBuild aborts the index building task with the IndexBuildID in the request. The task stops at its next
+// checkpoint and records the reason in its IndexMeta.
+func (i *IndexNode) CancelIndexBuild(ctx context.Context, request *indexpb.CancelIndexBuildRequest) (*commonpb.Status, error) {
+	if i.stateCode.Load().(internalpb.StateCode) != internalpb.StateCode_Healthy {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    "state code is not healthy",
+		}, nil
+	}
+	log.Debug("IndexNode cancel index build",
+		zap.Int64("IndexBuildID", request.IndexBuildID),
+		zap.Int64("Version", request.Version),
+		zap.String("Reason", request.Reason))
+
+	ret := &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}
+	t, ok := i.sched.IndexBuildQueue.GetTaskByID(request.IndexBuildID).(*IndexBuildTask)
+	if !ok {
+		ret.Reason = fmt.Sprintf("index build task %d is not running on IndexNode", request.IndexBuildID)
+		return ret, nil
+	}
+	if request.Version != 0 && t.req.Version != request.Version {
+		ret.Reason = fmt.Sprintf("index build task %d with version %d is not running on IndexNode",
+			request.IndexBuildID, request.Version)
+		return ret, nil
+	}
+	t.Cancel(request.Reason)
+	log.Debug("IndexNode index build cancelled", zap.Int64("IndexBuildID", request.IndexBuildID))
+
+	return ret, nil
+}
+
+// EstimateRecall searches vectors sampled from the raw data of a segment on its built index, and returns the recall
+// of the results against brute force search.
+func (i *IndexNode) EstimateRecall(ctx context.Context, request *indexpb.EstimateRecallRequest) (*indexpb.EstimateRecallResponse, error) {
+	ret := &indexpb.EstimateRecallResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		IndexBuildID: request.IndexBuildID,
+	}
+	if i.stateCode.Load().(internalpb.StateCode) != internalpb.StateCode_Healthy {
+		ret.Status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+		ret.Status.Reason = "state code is not healthy"
+		return ret, nil
+	}
+	log.Debug("IndexNode estimate recall",
+		zap.Int64("IndexBuildID", request.IndexBuildID),
+		zap.Int64("nq", request.Nq),
+		zap.Int64("topk", request.Topk),
+		zap.Any("SearchParams", request.SearchParams))
+
+	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "IndexNode-EstimateRecall")
+	defer sp.Finish()
+
+	nq, recall, err := estimateRecall(ctx, i.chunkManager, request)
+	if err != nil {
+		log.Warn("IndexNode estimate recall failed", zap.Int64("IndexBuildID", request.IndexBuildID), zap.Error(err))
+		ret.Status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+		ret.Status.Reason = err.Error()
+		return ret, nil
+	}
+	ret.Nq = nq
+	ret.Recall = recall
+	log.Debug("IndexNode estimate recall done", zap.Int64("IndexBuildID", request.IndexBuildID),
+		zap.Int64("nq", nq), zap.Float32("recall", recall))
+	return ret, nil
+}
+
 func (i *IndexNode) GetComponentStates(ctx context.Context) (*internalpb.ComponentStates, error) {
 	log.Debug("get IndexNode components states ...")
 	stateInfo := &internalpb.ComponentInfo{
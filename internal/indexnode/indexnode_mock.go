@@ -19,6 +19,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/golang/protobuf/proto"
+	"github.com/milvus-io/milvus/internal/kv"
 	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
@@ -40,7 +41,7 @@ type Mock struct {
 	cancel context.CancelFunc
 	wg     sync.WaitGroup
 
-	etcdKV *etcdkv.EtcdKV
+	etcdKV kv.MetaKv
 
 	buildIndex chan *indexpb.CreateIndexRequest
 }
@@ -170,7 +171,7 @@ func (inm *Mock) Register() error {
 		return errors.New("IndexNode register failed")
 	}
 	Params.Init()
-	inm.etcdKV, _ = etcdkv.NewEtcdKV(Params.EtcdEndpoints, Params.MetaRootPath)
+	inm.etcdKV, _ = etcdkv.NewMetaKv(Params.EtcdEndpoints, Params.MetaRootPath)
 	inm.etcdKV.RemoveWithPrefix("session/" + typeutil.IndexNodeRole)
 	session := sessionutil.NewSession(context.Background(), Params.MetaRootPath, Params.EtcdEndpoints)
 	session.Init(typeutil.IndexNodeRole, "localhost:21121", false)
@@ -246,6 +247,37 @@ func (inm *Mock) CreateIndex(ctx context.Context, req *indexpb.CreateIndexReques
 	}, nil
 }
 
+func (inm *Mock) CancelIndexBuild(ctx context.Context, req *indexpb.CancelIndexBuildRequest) (*commonpb.Status, error) {
+	if inm.Err {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+		}, errors.New("IndexNode CancelIndexBuild failed")
+	}
+
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}, nil
+}
+
+func (inm *Mock) EstimateRecall(ctx context.Context, req *indexpb.EstimateRecallRequest) (*indexpb.EstimateRecallResponse, error) {
+	if inm.Err {
+		return &indexpb.EstimateRecallResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			},
+		}, errors.New("IndexNode EstimateRecall failed")
+	}
+
+	return &indexpb.EstimateRecallResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		IndexBuildID: req.IndexBuildID,
+		Nq:           req.Nq,
+		Recall:       1,
+	}, nil
+}
+
 func (inm *Mock) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
 	if inm.Err {
 		return &milvuspb.GetMetricsResponse{
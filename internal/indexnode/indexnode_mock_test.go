@@ -67,6 +67,19 @@ func TestIndexNodeMock(t *testing.T) {
 		assert.Equal(t, commonpb.ErrorCode_Success, resp.ErrorCode)
 	})
 
+	t.Run("CancelIndexBuild", func(t *testing.T) {
+		resp, err := inm.CancelIndexBuild(ctx, &indexpb.CancelIndexBuildRequest{IndexBuildID: 0})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+	})
+
+	t.Run("EstimateRecall", func(t *testing.T) {
+		resp, err := inm.EstimateRecall(ctx, &indexpb.EstimateRecallRequest{IndexBuildID: 1, Nq: 10, Topk: 10})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+		assert.Equal(t, int64(10), resp.Nq)
+	})
+
 	t.Run("GetMetrics", func(t *testing.T) {
 		req := &milvuspb.GetMetricsRequest{
 			Request: "",
@@ -121,6 +134,18 @@ func TestIndexNodeMockError(t *testing.T) {
 		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.ErrorCode)
 	})
 
+	t.Run("CancelIndexBuild error", func(t *testing.T) {
+		resp, err := inm.CancelIndexBuild(ctx, &indexpb.CancelIndexBuildRequest{})
+		assert.NotNil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.ErrorCode)
+	})
+
+	t.Run("EstimateRecall error", func(t *testing.T) {
+		resp, err := inm.EstimateRecall(ctx, &indexpb.EstimateRecallRequest{})
+		assert.NotNil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.Status.ErrorCode)
+	})
+
 	t.Run("GetMetrics error", func(t *testing.T) {
 		req := &milvuspb.GetMetricsRequest{}
 		resp, err := inm.GetMetrics(ctx, req)
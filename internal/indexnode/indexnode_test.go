@@ -112,14 +112,14 @@ func TestIndexNode(t *testing.T) {
 		}
 		binLogs, _, err := insertCodec.Serialize(999, 888, &insertData)
 		assert.Nil(t, err)
-		kvs := make(map[string]string, len(binLogs))
+		kvs := make(map[string][]byte, len(binLogs))
 		paths := make([]string, 0, len(binLogs))
 		for i, blob := range binLogs {
 			key := path.Join(floatVectorBinlogPath, strconv.Itoa(i))
 			paths = append(paths, key)
-			kvs[key] = string(blob.Value[:])
+			kvs[key] = blob.Value[:]
 		}
-		err = in.kv.MultiSave(kvs)
+		err = in.chunkManager.MultiWrite(kvs)
 		assert.Nil(t, err)
 
 		indexMeta := &indexpb.IndexMeta{
@@ -177,10 +177,10 @@ func TestIndexNode(t *testing.T) {
 			err = proto.Unmarshal([]byte(strValue), &indexMetaTmp)
 			assert.Nil(t, err)
 		}
-		defer in.kv.MultiRemove(indexMetaTmp.IndexFilePaths)
+		defer in.chunkManager.MultiRemove(indexMetaTmp.IndexFilePaths)
 		defer func() {
 			for k := range kvs {
-				in.kv.Remove(k)
+				in.chunkManager.Remove(k)
 			}
 		}()
 
@@ -227,14 +227,14 @@ func TestIndexNode(t *testing.T) {
 		}
 		binLogs, _, err := insertCodec.Serialize(999, 888, &insertData)
 		assert.Nil(t, err)
-		kvs := make(map[string]string, len(binLogs))
+		kvs := make(map[string][]byte, len(binLogs))
 		paths := make([]string, 0, len(binLogs))
 		for i, blob := range binLogs {
 			key := path.Join(binaryVectorBinlogPath, strconv.Itoa(i))
 			paths = append(paths, key)
-			kvs[key] = string(blob.Value[:])
+			kvs[key] = blob.Value[:]
 		}
-		err = in.kv.MultiSave(kvs)
+		err = in.chunkManager.MultiWrite(kvs)
 		assert.Nil(t, err)
 
 		indexMeta := &indexpb.IndexMeta{
@@ -288,10 +288,10 @@ func TestIndexNode(t *testing.T) {
 			err = proto.Unmarshal([]byte(strValue), &indexMetaTmp)
 			assert.Nil(t, err)
 		}
-		defer in.kv.MultiRemove(indexMetaTmp.IndexFilePaths)
+		defer in.chunkManager.MultiRemove(indexMetaTmp.IndexFilePaths)
 		defer func() {
 			for k := range kvs {
-				in.kv.Remove(k)
+				in.chunkManager.Remove(k)
 			}
 		}()
 
@@ -339,14 +339,14 @@ func TestIndexNode(t *testing.T) {
 		}
 		binLogs, _, err := insertCodec.Serialize(999, 888, &insertData)
 		assert.Nil(t, err)
-		kvs := make(map[string]string, len(binLogs))
+		kvs := make(map[string][]byte, len(binLogs))
 		paths := make([]string, 0, len(binLogs))
 		for i, blob := range binLogs {
 			key := path.Join(floatVectorBinlogPath, strconv.Itoa(i))
 			paths = append(paths, key)
-			kvs[key] = string(blob.Value[:])
+			kvs[key] = blob.Value[:]
 		}
-		err = in.kv.MultiSave(kvs)
+		err = in.chunkManager.MultiWrite(kvs)
 		assert.Nil(t, err)
 
 		indexMeta := &indexpb.IndexMeta{
@@ -405,10 +405,10 @@ func TestIndexNode(t *testing.T) {
 			err = proto.Unmarshal([]byte(strValue), &indexMetaTmp)
 			assert.Nil(t, err)
 		}
-		defer in.kv.MultiRemove(indexMetaTmp.IndexFilePaths)
+		defer in.chunkManager.MultiRemove(indexMetaTmp.IndexFilePaths)
 		defer func() {
 			for k := range kvs {
-				in.kv.Remove(k)
+				in.chunkManager.Remove(k)
 			}
 		}()
 
@@ -515,14 +515,14 @@ func TestCreateIndexFailed(t *testing.T) {
 		}
 		binLogs, _, err := insertCodec.Serialize(999, 888, &insertData)
 		assert.Nil(t, err)
-		kvs := make(map[string]string, len(binLogs))
+		kvs := make(map[string][]byte, len(binLogs))
 		paths := make([]string, 0, len(binLogs))
 		for i, blob := range binLogs {
 			key := path.Join(floatVectorBinlogPath, strconv.Itoa(i))
 			paths = append(paths, key)
-			kvs[key] = string(blob.Value[:])
+			kvs[key] = blob.Value[:]
 		}
-		err = in.kv.MultiSave(kvs)
+		err = in.chunkManager.MultiWrite(kvs)
 		assert.Nil(t, err)
 
 		indexMeta := &indexpb.IndexMeta{
@@ -584,10 +584,10 @@ func TestCreateIndexFailed(t *testing.T) {
 			err = proto.Unmarshal([]byte(strValue), &indexMetaTmp)
 			assert.Nil(t, err)
 		}
-		defer in.kv.MultiRemove(indexMetaTmp.IndexFilePaths)
+		defer in.chunkManager.MultiRemove(indexMetaTmp.IndexFilePaths)
 		defer func() {
 			for k := range kvs {
-				in.kv.Remove(k)
+				in.chunkManager.Remove(k)
 			}
 		}()
 	})
@@ -633,14 +633,14 @@ func TestCreateIndexFailed(t *testing.T) {
 		}
 		binLogs, _, err := insertCodec.Serialize(999, 888, &insertData)
 		assert.Nil(t, err)
-		kvs := make(map[string]string, len(binLogs))
+		kvs := make(map[string][]byte, len(binLogs))
 		paths := make([]string, 0, len(binLogs))
 		for i, blob := range binLogs {
 			key := path.Join(floatVectorBinlogPath, strconv.Itoa(i))
 			paths = append(paths, key)
-			kvs[key] = string(blob.Value[:])
+			kvs[key] = blob.Value[:]
 		}
-		err = in.kv.MultiSave(kvs)
+		err = in.chunkManager.MultiWrite(kvs)
 		assert.Nil(t, err)
 
 		indexMeta2 := &indexpb.IndexMeta{
@@ -703,10 +703,10 @@ func TestCreateIndexFailed(t *testing.T) {
 			err = proto.Unmarshal([]byte(strValue), &indexMetaTmp)
 			assert.Nil(t, err)
 		}
-		defer in.kv.MultiRemove(indexMetaTmp.IndexFilePaths)
+		defer in.chunkManager.MultiRemove(indexMetaTmp.IndexFilePaths)
 		defer func() {
 			for k := range kvs {
-				in.kv.Remove(k)
+				in.chunkManager.Remove(k)
 			}
 		}()
 	})
@@ -0,0 +1,277 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package indexnode
+
+import (
+	"container/heap"
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/distance"
+	"github.com/milvus-io/milvus/internal/util/indexparamcheck"
+	"github.com/milvus-io/milvus/internal/util/timerecord"
+)
+
+const (
+	// topkKey is the key of topk in the search params of knowhere.
+	topkKey = "k"
+	// bruteForceBatchSize is the max number of distances computed at once by the brute force search.
+	bruteForceBatchSize = 1 << 24
+)
+
+// estimateRecall searches nq vectors sampled from the raw data of a segment on the built index, and compares the
+// results with the exact topk found by brute force search. Returns the number of queries and the recall@topk.
+// A sampled vector is always the nearest to itself, so the row of the query is excluded from both results, otherwise
+// the recall would be biased upward.
+func estimateRecall(ctx context.Context, chunkManager storage.ChunkManager, req *indexpb.EstimateRecallRequest) (int64, float32, error) {
+	tr := timerecord.NewTimeRecorder(fmt.Sprintf("EstimateRecall %d", req.GetIndexBuildID()))
+	if req.GetNq() <= 0 || req.GetTopk() <= 0 {
+		return 0, 0, fmt.Errorf("invalid nq %d or topk %d", req.GetNq(), req.GetTopk())
+	}
+	typeParams, err := parseParams(req.GetTypeParams(), "type")
+	if err != nil {
+		return 0, 0, err
+	}
+	indexParams, err := parseParams(req.GetIndexParams(), "index")
+	if err != nil {
+		return 0, 0, err
+	}
+	searchParams, err := parseParams(req.GetSearchParams(), "search")
+	if err != nil {
+		return 0, 0, err
+	}
+	metricType := strings.ToUpper(indexParams[indexparamcheck.Metric])
+	if metricType != distance.L2 && metricType != distance.IP {
+		return 0, 0, fmt.Errorf("recall can't be estimated with metric type %s", indexParams[indexparamcheck.Metric])
+	}
+	check := func() error {
+		return ctx.Err()
+	}
+
+	dataBlobs, err := readBlobs(chunkManager, req.GetDataPaths(), check)
+	if err != nil {
+		return 0, 0, err
+	}
+	var insertCodec storage.InsertCodec
+	defer insertCodec.Close()
+	_, _, _, insertData, err := insertCodec.DeserializeAll(dataBlobs)
+	if err != nil {
+		return 0, 0, err
+	}
+	if len(insertData.Data) != 1 {
+		return 0, 0, errors.New("we expect only one field in deserialized insert data")
+	}
+	var vectors *storage.FloatVectorFieldData
+	for _, value := range insertData.Data {
+		vectors, _ = value.(*storage.FloatVectorFieldData)
+	}
+	if vectors == nil || vectors.Dim <= 0 || len(vectors.Data) == 0 {
+		return 0, 0, errors.New("recall can only be estimated on the index of float vectors")
+	}
+	tr.Record("load raw data done")
+
+	_, _, _, _, indexBlobs, err := loadIndexFiles(chunkManager, req.GetIndexFilePaths(), check)
+	if err != nil {
+		return 0, 0, err
+	}
+	index, err := NewCIndex(typeParams, indexParams)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer func() {
+		if err := index.Delete(); err != nil {
+			log.Warn("IndexNode EstimateRecall delete index failed", zap.Error(err))
+		}
+	}()
+	queryableIndex, ok := index.(QueryableIndex)
+	if !ok {
+		return 0, 0, errors.New("the index can't be searched")
+	}
+	if err = index.Load(indexBlobs); err != nil {
+		return 0, 0, err
+	}
+	tr.Record("load index done")
+
+	dim := int64(vectors.Dim)
+	rows := int64(len(vectors.Data)) / dim
+	if rows < 2 {
+		return 0, 0, fmt.Errorf("recall can't be estimated on a segment of %d rows", rows)
+	}
+	topk := req.GetTopk()
+	if topk > rows-1 {
+		topk = rows - 1
+	}
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+	queries, offsets := sampleQueries(vectors.Data, dim, req.GetNq(), r)
+	nq := int64(len(offsets))
+
+	// one more is searched since the query itself is found
+	searchParams[indexparamcheck.Metric] = metricType
+	searchParams[topkKey] = strconv.FormatInt(topk+1, 10)
+	ids, _, stride, err := queryableIndex.QueryFloatVecIndexWithParam(queries, searchParams)
+	if err != nil {
+		return 0, 0, err
+	}
+	tr.Record("search index done")
+
+	exact, err := bruteForceSearch(ctx, vectors.Data, queries, offsets, dim, topk, metricType)
+	if err != nil {
+		return 0, 0, err
+	}
+	tr.Elapse("brute force search done")
+	return nq, computeRecall(ids, stride, topk, offsets, exact), nil
+}
+
+// sampleQueries picks at most nq distinct vectors from data as queries, returns the queries and their row offsets.
+func sampleQueries(data []float32, dim, nq int64, r *rand.Rand) ([]float32, []int64) {
+	rows := int64(len(data)) / dim
+	if nq > rows {
+		nq = rows
+	}
+	queries := make([]float32, 0, nq*dim)
+	offsets := make([]int64, 0, nq)
+	for _, offset := range r.Perm(int(rows))[:nq] {
+		queries = append(queries, data[int64(offset)*dim:int64(offset+1)*dim]...)
+		offsets = append(offsets, int64(offset))
+	}
+	return queries, offsets
+}
+
+// bruteForceSearch returns the row offsets of the exact topk nearest vectors in data of every query except the row
+// of the query itself in excluded, the queries are computed in batches to bound the memory of the distances.
+func bruteForceSearch(ctx context.Context, data, queries []float32, excluded []int64, dim, topk int64, metricType string) ([][]int64, error) {
+	rows := int64(len(data)) / dim
+	nq := int64(len(queries)) / dim
+	batch := bruteForceBatchSize / rows
+	if batch <= 0 {
+		batch = 1
+	}
+	ret := make([][]int64, 0, nq)
+	for from := int64(0); from < nq; from += batch {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		to := from + batch
+		if to > nq {
+			to = nq
+		}
+		distances, err := distance.CalcFloatDistance(dim, queries[from*dim:to*dim], data, metricType)
+		if err != nil {
+			return nil, err
+		}
+		for i := int64(0); i < to-from; i++ {
+			ret = append(ret, selectTopK(distances[i*rows:(i+1)*rows], topk, metricType == distance.IP, excluded[from+i]))
+		}
+	}
+	return ret, nil
+}
+
+type distanceItem struct {
+	offset   int64
+	distance float32
+}
+
+// distanceHeap keeps the farthest of the selected vectors on the top.
+type distanceHeap struct {
+	items         []distanceItem
+	largerIsClose bool
+}
+
+func (h *distanceHeap) Len() int {
+	return len(h.items)
+}
+
+func (h *distanceHeap) Less(i, j int) bool {
+	if h.largerIsClose {
+		return h.items[i].distance < h.items[j].distance
+	}
+	return h.items[i].distance > h.items[j].distance
+}
+
+func (h *distanceHeap) Swap(i, j int) {
+	h.items[i], h.items[j] = h.items[j], h.items[i]
+}
+
+func (h *distanceHeap) Push(x interface{}) {
+	h.items = append(h.items, x.(distanceItem))
+}
+
+func (h *distanceHeap) Pop() interface{} {
+	n := len(h.items) - 1
+	item := h.items[n]
+	h.items = h.items[:n]
+	return item
+}
+
+// selectTopK returns the offsets of the topk closest distances except the excluded offset, the larger the closer
+// for IP.
+func selectTopK(distances []float32, topk int64, largerIsClose bool, excluded int64) []int64 {
+	h := &distanceHeap{largerIsClose: largerIsClose}
+	for offset, dist := range distances {
+		if int64(offset) == excluded {
+			continue
+		}
+		item := distanceItem{offset: int64(offset), distance: dist}
+		if int64(h.Len()) < topk {
+			heap.Push(h, item)
+			continue
+		}
+		if (largerIsClose && dist > h.items[0].distance) || (!largerIsClose && dist < h.items[0].distance) {
+			h.items[0] = item
+			heap.Fix(h, 0)
+		}
+	}
+	offsets := make([]int64, 0, len(h.items))
+	for _, item := range h.items {
+		offsets = append(offsets, item.offset)
+	}
+	return offsets
+}
+
+// computeRecall returns the fraction of the exact topk found in the topk search results, the ids of the i-th query
+// are at [i*stride, (i+1)*stride) of ids ordered by distance, and the row of the query at excluded[i] is skipped.
+func computeRecall(ids []int64, stride, topk int64, excluded []int64, exact [][]int64) float32 {
+	var hits, total int64
+	for i, expected := range exact {
+		total += int64(len(expected))
+		found := make(map[int64]struct{}, topk)
+		from, to := int64(i)*stride, int64(i+1)*stride
+		if to > int64(len(ids)) {
+			to = int64(len(ids))
+		}
+		for ; from < to && int64(len(found)) < topk; from++ {
+			if ids[from] == excluded[i] {
+				continue
+			}
+			found[ids[from]] = struct{}{}
+		}
+		for _, id := range expected {
+			if _, ok := found[id]; ok {
+				hits++
+			}
+		}
+	}
+	if total == 0 {
+		return 0
+	}
+	return float32(hits) / float32(total)
+}
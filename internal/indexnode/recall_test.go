@@ -0,0 +1,93 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/util/distance"
+)
+
+func sortedOffsets(offsets []int64) []int64 {
+	sort.Slice(offsets, func(i, j int) bool { return offsets[i] < offsets[j] })
+	return offsets
+}
+
+func TestSelectTopK(t *testing.T) {
+	distances := []float32{0.5, 0.1, 0.9, 0.3, 0.7}
+	assert.Equal(t, []int64{1, 3}, sortedOffsets(selectTopK(distances, 2, false, -1)))
+	assert.Equal(t, []int64{2, 4}, sortedOffsets(selectTopK(distances, 2, true, -1)))
+	assert.Equal(t, 5, len(selectTopK(distances, 10, false, -1)))
+	// the excluded offset is never selected
+	assert.Equal(t, []int64{0, 3}, sortedOffsets(selectTopK(distances, 2, false, 1)))
+	assert.Equal(t, 4, len(selectTopK(distances, 10, false, 1)))
+}
+
+func TestSampleQueries(t *testing.T) {
+	data := []float32{0, 0, 1, 1, 2, 2}
+	r := rand.New(rand.NewSource(0))
+	queries, offsets := sampleQueries(data, 2, 2, r)
+	assert.Equal(t, 4, len(queries))
+	assert.Equal(t, 2, len(offsets))
+	assert.NotEqual(t, queries[0], queries[2])
+	for i, offset := range offsets {
+		assert.Equal(t, data[offset*2:offset*2+2], queries[i*2:i*2+2])
+	}
+
+	queries, offsets = sampleQueries(data, 2, 10, r)
+	assert.Equal(t, 6, len(queries))
+	assert.Equal(t, 3, len(offsets))
+}
+
+func TestBruteForceSearch(t *testing.T) {
+	data := []float32{0, 0, 1, 1, 2, 2, 3, 3}
+	queries := []float32{0, 0, 3, 3}
+	exact, err := bruteForceSearch(context.Background(), data, queries, []int64{-1, -1}, 2, 2, distance.L2)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(exact))
+	assert.Equal(t, []int64{0, 1}, sortedOffsets(exact[0]))
+	assert.Equal(t, []int64{2, 3}, sortedOffsets(exact[1]))
+
+	// the rows of the queries are excluded
+	exact, err = bruteForceSearch(context.Background(), data, queries, []int64{0, 3}, 2, 2, distance.L2)
+	assert.Nil(t, err)
+	assert.Equal(t, []int64{1, 2}, sortedOffsets(exact[0]))
+	assert.Equal(t, []int64{1, 2}, sortedOffsets(exact[1]))
+
+	exact, err = bruteForceSearch(context.Background(), data, []float32{1, 1, 3, 3}, []int64{-1, -1}, 2, 1, distance.IP)
+	assert.Nil(t, err)
+	assert.Equal(t, []int64{3}, exact[0])
+	assert.Equal(t, []int64{3}, exact[1])
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = bruteForceSearch(ctx, data, queries, []int64{-1, -1}, 2, 2, distance.L2)
+	assert.NotNil(t, err)
+}
+
+func TestComputeRecall(t *testing.T) {
+	exact := [][]int64{{0, 1}, {2, 3}}
+	none := []int64{-1, -1}
+	assert.Equal(t, float32(1), computeRecall([]int64{1, 0, 3, 2}, 2, 2, none, exact))
+	assert.Equal(t, float32(0.5), computeRecall([]int64{0, 5, 2, 6}, 2, 2, none, exact))
+	assert.Equal(t, float32(0.25), computeRecall([]int64{0, -1}, 2, 2, none, exact))
+	assert.Equal(t, float32(0), computeRecall(nil, 2, 2, nil, nil))
+
+	// the rows of the queries are skipped, and only the topk of the rest are compared
+	assert.Equal(t, float32(1), computeRecall([]int64{4, 1, 0, 5, 3, 2}, 3, 2, []int64{4, 5}, exact))
+	assert.Equal(t, float32(0.5), computeRecall([]int64{4, 1, 7, 5, 3, 2}, 3, 2, []int64{4, 3}, exact))
+}
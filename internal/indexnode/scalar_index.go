@@ -0,0 +1,149 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package indexnode
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/scalarindex"
+)
+
+// ScalarIndexBlobKey is the key of the blob holding the serialized scalar index
+const ScalarIndexBlobKey = "scalar_index"
+
+// ScalarIndex builds the indexes of scalar fields, which are implemented in go instead of knowhere
+type ScalarIndex struct {
+	indexParams map[string]string
+	index       scalarindex.Index
+}
+
+// NewScalarIndex creates the scalar index of the index type set in the index params
+func NewScalarIndex(indexParams map[string]string) (*ScalarIndex, error) {
+	// check the index params early, the data type is not known until the field data is loaded
+	if _, err := scalarindex.NewIndex(schemapb.DataType_Int64, indexParams); err != nil {
+		return nil, err
+	}
+	return &ScalarIndex{indexParams: indexParams}, nil
+}
+
+// Build builds the index on the data of the scalar field
+func (index *ScalarIndex) Build(data storage.FieldData) error {
+	dataType, keys, err := fieldDataKeys(data)
+	if err != nil {
+		return err
+	}
+	idx, err := scalarindex.NewIndex(dataType, index.indexParams)
+	if err != nil {
+		return err
+	}
+	if err := idx.Build(keys); err != nil {
+		return err
+	}
+	index.index = idx
+	return nil
+}
+
+func (index *ScalarIndex) Serialize() ([]*Blob, error) {
+	if index.index == nil {
+		return nil, errors.New("scalar index is not built")
+	}
+	data, err := index.index.Serialize()
+	if err != nil {
+		return nil, err
+	}
+	return []*Blob{{Key: ScalarIndexBlobKey, Value: data}}, nil
+}
+
+func (index *ScalarIndex) Load(blobs []*Blob) error {
+	for _, blob := range blobs {
+		if blob.Key == ScalarIndexBlobKey {
+			idx, err := scalarindex.Load(blob.Value)
+			if err != nil {
+				return err
+			}
+			index.index = idx
+			return nil
+		}
+	}
+	return errors.New("scalar index blob not found")
+}
+
+func (index *ScalarIndex) BuildFloatVecIndexWithoutIds(vectors []float32) error {
+	return errors.New("scalar index can't be built on float vectors")
+}
+
+func (index *ScalarIndex) BuildBinaryVecIndexWithoutIds(vectors []byte) error {
+	return errors.New("scalar index can't be built on binary vectors")
+}
+
+func (index *ScalarIndex) Delete() error {
+	index.index = nil
+	return nil
+}
+
+// fieldDataKeys encodes the values of the scalar field data into the keys of the scalar index
+func fieldDataKeys(data storage.FieldData) (schemapb.DataType, []string, error) {
+	switch fieldData := data.(type) {
+	case *storage.BoolFieldData:
+		keys := make([]string, len(fieldData.Data))
+		for i, v := range fieldData.Data {
+			keys[i] = scalarindex.EncodeBool(v)
+		}
+		return schemapb.DataType_Bool, keys, nil
+	case *storage.Int8FieldData:
+		keys := make([]string, len(fieldData.Data))
+		for i, v := range fieldData.Data {
+			keys[i] = scalarindex.EncodeInt64(int64(v))
+		}
+		return schemapb.DataType_Int8, keys, nil
+	case *storage.Int16FieldData:
+		keys := make([]string, len(fieldData.Data))
+		for i, v := range fieldData.Data {
+			keys[i] = scalarindex.EncodeInt64(int64(v))
+		}
+		return schemapb.DataType_Int16, keys, nil
+	case *storage.Int32FieldData:
+		keys := make([]string, len(fieldData.Data))
+		for i, v := range fieldData.Data {
+			keys[i] = scalarindex.EncodeInt64(int64(v))
+		}
+		return schemapb.DataType_Int32, keys, nil
+	case *storage.Int64FieldData:
+		keys := make([]string, len(fieldData.Data))
+		for i, v := range fieldData.Data {
+			keys[i] = scalarindex.EncodeInt64(v)
+		}
+		return schemapb.DataType_Int64, keys, nil
+	case *storage.FloatFieldData:
+		keys := make([]string, len(fieldData.Data))
+		for i, v := range fieldData.Data {
+			keys[i] = scalarindex.EncodeFloat64(float64(v))
+		}
+		return schemapb.DataType_Float, keys, nil
+	case *storage.DoubleFieldData:
+		keys := make([]string, len(fieldData.Data))
+		for i, v := range fieldData.Data {
+			keys[i] = scalarindex.EncodeFloat64(v)
+		}
+		return schemapb.DataType_Double, keys, nil
+	case *storage.StringFieldData:
+		keys := make([]string, len(fieldData.Data))
+		for i, v := range fieldData.Data {
+			keys[i] = scalarindex.EncodeString(v)
+		}
+		return schemapb.DataType_String, keys, nil
+	}
+	return schemapb.DataType_None, nil, fmt.Errorf("unsupported field data type %T for scalar index", data)
+}
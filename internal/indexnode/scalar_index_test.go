@@ -0,0 +1,77 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package indexnode
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/indexparamcheck"
+	"github.com/milvus-io/milvus/internal/util/scalarindex"
+)
+
+func TestScalarIndex(t *testing.T) {
+	_, err := NewScalarIndex(map[string]string{indexparamcheck.IndexTypeKey: indexparamcheck.IndexHNSW})
+	assert.Error(t, err)
+
+	index, err := NewScalarIndex(map[string]string{indexparamcheck.IndexTypeKey: indexparamcheck.IndexScalarInverted})
+	assert.NoError(t, err)
+	_, err = index.Serialize()
+	assert.Error(t, err)
+	assert.Error(t, index.BuildFloatVecIndexWithoutIds([]float32{1, 2}))
+	assert.Error(t, index.BuildBinaryVecIndexWithoutIds([]byte{1, 2}))
+	assert.Error(t, index.Build(&storage.FloatVectorFieldData{Data: []float32{1, 2}, Dim: 2}))
+
+	err = index.Build(&storage.Int32FieldData{Data: []int32{3, 1, 3, 2}})
+	assert.NoError(t, err)
+	blobs, err := index.Serialize()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(blobs))
+	assert.Equal(t, ScalarIndexBlobKey, blobs[0].Key)
+
+	loaded := &ScalarIndex{}
+	assert.Error(t, loaded.Load(nil))
+	assert.NoError(t, loaded.Load(blobs))
+	assert.Equal(t, schemapb.DataType_Int32, loaded.index.DataType())
+	assert.Equal(t, uint(2), loaded.index.In([]string{scalarindex.EncodeInt64(3)}).Count())
+	assert.NoError(t, loaded.Delete())
+}
+
+func TestFieldDataKeys(t *testing.T) {
+	cases := []struct {
+		data     storage.FieldData
+		dataType schemapb.DataType
+		keys     []string
+	}{
+		{&storage.BoolFieldData{Data: []bool{true, false}}, schemapb.DataType_Bool,
+			[]string{scalarindex.EncodeBool(true), scalarindex.EncodeBool(false)}},
+		{&storage.Int8FieldData{Data: []int8{-1}}, schemapb.DataType_Int8, []string{scalarindex.EncodeInt64(-1)}},
+		{&storage.Int16FieldData{Data: []int16{2}}, schemapb.DataType_Int16, []string{scalarindex.EncodeInt64(2)}},
+		{&storage.Int32FieldData{Data: []int32{3}}, schemapb.DataType_Int32, []string{scalarindex.EncodeInt64(3)}},
+		{&storage.Int64FieldData{Data: []int64{4}}, schemapb.DataType_Int64, []string{scalarindex.EncodeInt64(4)}},
+		{&storage.FloatFieldData{Data: []float32{1.5}}, schemapb.DataType_Float, []string{scalarindex.EncodeFloat64(1.5)}},
+		{&storage.DoubleFieldData{Data: []float64{2.5}}, schemapb.DataType_Double, []string{scalarindex.EncodeFloat64(2.5)}},
+		{&storage.StringFieldData{Data: []string{"a"}}, schemapb.DataType_String, []string{"a"}},
+	}
+	for _, c := range cases {
+		dataType, keys, err := fieldDataKeys(c.data)
+		assert.NoError(t, err)
+		assert.Equal(t, c.dataType, dataType)
+		assert.Equal(t, c.keys, keys)
+	}
+
+	_, _, err := fieldDataKeys(&storage.BinaryVectorFieldData{Data: []byte{1}, Dim: 8})
+	assert.Error(t, err)
+}
@@ -18,17 +18,18 @@ import (
 	"path"
 	"runtime"
 	"strconv"
+	"sync/atomic"
 
 	"github.com/golang/protobuf/proto"
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus/internal/kv"
-	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/indexparamcheck"
 	"github.com/milvus-io/milvus/internal/util/retry"
 	"github.com/milvus-io/milvus/internal/util/timerecord"
 	"github.com/milvus-io/milvus/internal/util/trace"
@@ -37,6 +38,9 @@ import (
 const (
 	paramsKeyToParse   = "params"
 	IndexBuildTaskName = "IndexBuildTask"
+	// addBatchRows is the number of vectors added to an index at a time, the progress is reported and the
+	// cancellation is checked between the batches
+	addBatchRows = 65536
 )
 
 type task interface {
@@ -87,12 +91,18 @@ func (bt *BaseTask) Notify(err error) {
 
 type IndexBuildTask struct {
 	BaseTask
-	index     Index
-	kv        kv.BaseKV
-	etcdKV    *etcdkv.EtcdKV
-	savePaths []string
-	req       *indexpb.CreateIndexRequest
-	nodeID    UniqueID
+	index        Index
+	chunkManager storage.ChunkManager
+	etcdKV       kv.MetaKv
+	savePaths    []string
+	req          *indexpb.CreateIndexRequest
+	nodeID       UniqueID
+	cancelReason atomic.Value // the reason string is stored once the task is cancelled
+	indexedRows  int64
+	totalRows    int64
+	// the total size of the saved index files and the time taken to build and save them
+	serializedSize int64
+	buildTimeMs    int64
 }
 
 func (it *IndexBuildTask) Ctx() context.Context {
@@ -117,6 +127,59 @@ func (it *IndexBuildTask) OnEnqueue() error {
 	return nil
 }
 
+// Cancel marks the task as cancelled, an executing task aborts at its next checkpoint: before each data or index
+// file is loaded or saved, and between the batches of vectors added to the index. A running build in segcore isn't
+// interrupted, the task aborts once it returns.
+func (it *IndexBuildTask) Cancel(reason string) {
+	it.cancelReason.Store(reason)
+}
+
+func (it *IndexBuildTask) checkCancelled() error {
+	if reason, ok := it.cancelReason.Load().(string); ok {
+		return retry.Unrecoverable(fmt.Errorf("index build task %d has been cancelled: %s", it.req.IndexBuildID, reason))
+	}
+	return nil
+}
+
+// reportProgress records the number of indexed rows in the index meta, failing to do so doesn't fail the task.
+// The float vector indexes of the incremental build types are trained and then added in batches of addBatchRows,
+// and their indexed rows are counted after each batch. The other indexes are built in one call, their progress is
+// per phase: the indexed rows go from 0 to all once the build returns.
+func (it *IndexBuildTask) reportProgress(ctx context.Context) {
+	fn := func() error {
+		_, values, versions, err := it.etcdKV.LoadWithPrefix2(it.req.MetaPath)
+		if err != nil {
+			return err
+		}
+		if len(values) == 0 {
+			return retry.Unrecoverable(fmt.Errorf("IndexNode reportProgress the indexMeta is empty"))
+		}
+		indexMeta := indexpb.IndexMeta{}
+		err = proto.Unmarshal([]byte(values[0]), &indexMeta)
+		if err != nil {
+			return err
+		}
+		if indexMeta.Version != it.req.Version || indexMeta.MarkDeleted ||
+			indexMeta.State == commonpb.IndexState_Finished || indexMeta.State == commonpb.IndexState_Failed {
+			return nil
+		}
+		indexMeta.IndexedRows = it.indexedRows
+		indexMeta.TotalRows = it.totalRows
+		metaValue, err := proto.Marshal(&indexMeta)
+		if err != nil {
+			return err
+		}
+		return it.etcdKV.CompareVersionAndSwap(it.req.MetaPath, versions[0], string(metaValue))
+	}
+	err := retry.Do(ctx, fn, retry.Attempts(3))
+	if err != nil {
+		log.Warn("IndexNode reportProgress failed", zap.Int64("indexBuildID", it.req.IndexBuildID), zap.Error(err))
+		return
+	}
+	log.Debug("IndexNode reportProgress", zap.Int64("indexBuildID", it.req.IndexBuildID),
+		zap.Int64("indexedRows", it.indexedRows), zap.Int64("totalRows", it.totalRows))
+}
+
 func (it *IndexBuildTask) checkIndexMeta(ctx context.Context, pre bool) error {
 	fn := func() error {
 		indexMeta := indexpb.IndexMeta{}
@@ -159,6 +222,10 @@ func (it *IndexBuildTask) checkIndexMeta(ctx context.Context, pre bool) error {
 		}
 		indexMeta.IndexFilePaths = it.savePaths
 		indexMeta.State = commonpb.IndexState_Finished
+		indexMeta.IndexedRows = it.indexedRows
+		indexMeta.TotalRows = it.totalRows
+		indexMeta.SerializedSize = it.serializedSize
+		indexMeta.BuildTimeMs = it.buildTimeMs
 		if it.err != nil {
 			log.Error("IndexNode CreateIndex Failed", zap.Int64("IndexBuildID", indexMeta.IndexBuildID), zap.Any("err", err))
 			indexMeta.State = commonpb.IndexState_Failed
@@ -199,58 +266,62 @@ func (it *IndexBuildTask) PostExecute(ctx context.Context) error {
 	return it.checkIndexMeta(ctx, false)
 }
 
-func (it *IndexBuildTask) Execute(ctx context.Context) error {
-	log.Debug("IndexNode IndexBuildTask Execute ...")
-	sp, _ := trace.StartSpanFromContextWithOperationName(ctx, "CreateIndex-Execute")
-	defer sp.Finish()
-	tr := timerecord.NewTimeRecorder(fmt.Sprintf("IndexBuildTask %d", it.req.IndexBuildID))
-	var err error
-
-	typeParams := make(map[string]string)
-	for _, kvPair := range it.req.GetTypeParams() {
+// parseParams converts the key value pairs into a map, the params in the json of key "params" are expanded.
+func parseParams(kvPairs []*commonpb.KeyValuePair, kind string) (map[string]string, error) {
+	ret := make(map[string]string)
+	for _, kvPair := range kvPairs {
 		key, value := kvPair.GetKey(), kvPair.GetValue()
-		_, ok := typeParams[key]
+		_, ok := ret[key]
 		if ok {
-			return errors.New("duplicated key in type params")
+			return nil, fmt.Errorf("duplicated key in %s params", kind)
 		}
 		if key == paramsKeyToParse {
 			params, err := funcutil.ParseIndexParamsMap(value)
 			if err != nil {
-				return err
+				return nil, err
 			}
 			for pk, pv := range params {
-				typeParams[pk] = pv
+				ret[pk] = pv
 			}
 		} else {
-			typeParams[key] = value
+			ret[key] = value
 		}
 	}
+	return ret, nil
+}
 
-	indexParams := make(map[string]string)
-	for _, kvPair := range it.req.GetIndexParams() {
-		key, value := kvPair.GetKey(), kvPair.GetValue()
-		_, ok := indexParams[key]
-		if ok {
-			return errors.New("duplicated key in index params")
-		}
-		if key == paramsKeyToParse {
-			params, err := funcutil.ParseIndexParamsMap(value)
-			if err != nil {
-				return err
-			}
-			for pk, pv := range params {
-				indexParams[pk] = pv
-			}
-		} else {
-			indexParams[key] = value
-		}
+func (it *IndexBuildTask) Execute(ctx context.Context) error {
+	log.Debug("IndexNode IndexBuildTask Execute ...")
+	sp, _ := trace.StartSpanFromContextWithOperationName(ctx, "CreateIndex-Execute")
+	defer sp.Finish()
+	tr := timerecord.NewTimeRecorder(fmt.Sprintf("IndexBuildTask %d", it.req.IndexBuildID))
+	var err error
+	if err = it.checkCancelled(); err != nil {
+		return err
 	}
 
-	it.index, err = NewCIndex(typeParams, indexParams)
+	typeParams, err := parseParams(it.req.GetTypeParams(), "type")
 	if err != nil {
-		log.Error("IndexNode IndexBuildTask Execute NewCIndex failed", zap.Error(err))
 		return err
 	}
+	indexParams, err := parseParams(it.req.GetIndexParams(), "index")
+	if err != nil {
+		return err
+	}
+
+	if indexparamcheck.IsScalarIndexType(indexParams[indexparamcheck.IndexTypeKey]) {
+		it.index, err = NewScalarIndex(indexParams)
+		if err != nil {
+			log.Error("IndexNode IndexBuildTask Execute NewScalarIndex failed", zap.Error(err))
+			return err
+		}
+	} else {
+		it.index, err = NewCIndex(typeParams, indexParams)
+		if err != nil {
+			log.Error("IndexNode IndexBuildTask Execute NewCIndex failed", zap.Error(err))
+			return err
+		}
+	}
 	defer func() {
 		err = it.index.Delete()
 		if err != nil {
@@ -258,13 +329,40 @@ func (it *IndexBuildTask) Execute(ctx context.Context) error {
 		}
 	}()
 
+	step := it.req.GetStep()
+	var distributedIndex DistributedIndex
+	if step != indexpb.IndexBuildStep_BuildAll {
+		var ok bool
+		distributedIndex, ok = it.index.(DistributedIndex)
+		if !ok || !indexparamcheck.IsDistributedBuildIndexType(indexParams[indexparamcheck.IndexTypeKey]) {
+			return fmt.Errorf("index type %s can't be built in steps", indexParams[indexparamcheck.IndexTypeKey])
+		}
+	}
+	switch step {
+	case indexpb.IndexBuildStep_Merge:
+		return it.mergeShardIndexes(ctx, tr, distributedIndex, indexParams)
+	case indexpb.IndexBuildStep_AddShard:
+		if len(it.req.GetInputIndexes()) != 1 {
+			return errors.New("adding a shard needs exactly one trained index")
+		}
+		_, _, _, _, trainedBlobs, err := loadIndexFiles(it.chunkManager, it.req.InputIndexes[0].IndexFilePaths, it.checkCancelled)
+		if err != nil {
+			return err
+		}
+		if err = it.index.Load(trainedBlobs); err != nil {
+			log.Error("IndexNode load trained index failed", zap.Error(err))
+			return err
+		}
+		tr.Record("load trained index done")
+	}
+
 	getKeyByPathNaive := func(path string) string {
 		// splitElements := strings.Split(path, "/")
 		// return splitElements[len(splitElements)-1]
 		return path
 	}
 	getValueByPath := func(path string) ([]byte, error) {
-		data, err := it.kv.Load(path)
+		data, err := it.chunkManager.Read(path)
 		if err != nil {
 			return nil, err
 		}
@@ -289,6 +387,9 @@ func (it *IndexBuildTask) Execute(ctx context.Context) error {
 	blobs := make([]*Blob, len(toLoadDataPaths))
 
 	loadKey := func(idx int) error {
+		if err := it.checkCancelled(); err != nil {
+			return err
+		}
 		keys[idx] = getKeyByPathNaive(toLoadDataPaths[idx])
 		blob, err := getBlobByPath(toLoadDataPaths[idx])
 		if err != nil {
@@ -319,18 +420,53 @@ func (it *IndexBuildTask) Execute(ctx context.Context) error {
 	tr.Record("deserialize storage blobs done")
 
 	for fieldID, value := range insertData.Data {
+		if err = it.checkCancelled(); err != nil {
+			return err
+		}
+		it.totalRows = fieldDataRowNum(value)
+		it.reportProgress(ctx)
+
+		scalarIndex, sOk := it.index.(*ScalarIndex)
+		if sOk {
+			err = scalarIndex.Build(value)
+			if err != nil {
+				log.Error("IndexNode build scalar index failed", zap.Error(err))
+				return err
+			}
+			tr.Record("build scalar index done")
+		}
+
 		// TODO: BinaryVectorFieldData
 		floatVectorFieldData, fOk := value.(*storage.FloatVectorFieldData)
 		if fOk {
-			err = it.index.BuildFloatVecIndexWithoutIds(floatVectorFieldData.Data)
+			switch step {
+			case indexpb.IndexBuildStep_Train:
+				err = distributedIndex.TrainFloatVecIndex(floatVectorFieldData.Data)
+			case indexpb.IndexBuildStep_AddShard:
+				err = it.addFloatVectors(ctx, distributedIndex, floatVectorFieldData)
+			default:
+				// the indexes that can be built incrementally are trained and then added in batches, which
+				// reports the progress of the adding
+				index, ok := it.index.(DistributedIndex)
+				if ok && indexparamcheck.IsIncrementalBuildIndexType(indexParams[indexparamcheck.IndexTypeKey]) {
+					if err = index.TrainFloatVecIndex(floatVectorFieldData.Data); err == nil {
+						err = it.addFloatVectors(ctx, index, floatVectorFieldData)
+					}
+				} else {
+					err = it.index.BuildFloatVecIndexWithoutIds(floatVectorFieldData.Data)
+				}
+			}
 			if err != nil {
-				log.Error("IndexNode BuildFloatVecIndexWithoutIds failed", zap.Error(err))
+				log.Error("IndexNode build float vector index failed", zap.Error(err), zap.String("step", step.String()))
 				return err
 			}
 			tr.Record("build float vector index done")
 		}
 
 		binaryVectorFieldData, bOk := value.(*storage.BinaryVectorFieldData)
+		if bOk && step != indexpb.IndexBuildStep_BuildAll {
+			return errors.New("binary vector index can't be built in steps")
+		}
 		if bOk {
 			err = it.index.BuildBinaryVecIndexWithoutIds(binaryVectorFieldData.Data)
 			if err != nil {
@@ -340,89 +476,259 @@ func (it *IndexBuildTask) Execute(ctx context.Context) error {
 			tr.Record("build binary vector index done")
 		}
 
-		if !fOk && !bOk {
+		if !fOk && !bOk && !sOk {
 			return errors.New("we expect FloatVectorFieldData or BinaryVectorFieldData")
 		}
-
-		indexBlobs, err := it.index.Serialize()
-		if err != nil {
-			log.Error("IndexNode index Serialize failed", zap.Error(err))
+		if err = it.checkCancelled(); err != nil {
 			return err
 		}
-		tr.Record("serialize index done")
-
-		codec := storage.NewIndexFileBinlogCodec()
-		serializedIndexBlobs, err := codec.Serialize(
-			it.req.IndexBuildID,
-			it.req.Version,
-			collectionID,
-			partitionID,
-			segmentID,
-			fieldID,
-			indexParams,
-			it.req.IndexName,
-			it.req.IndexID,
-			getStorageBlobs(indexBlobs),
-		)
-		if err != nil {
+		it.indexedRows = it.totalRows
+		it.reportProgress(ctx)
+
+		if err = it.saveIndex(ctx, tr, collectionID, partitionID, segmentID, fieldID, indexParams); err != nil {
 			return err
 		}
-		_ = codec.Close()
-		tr.Record("serialize index codec done")
-
-		getSavePathByKey := func(key string) string {
+	}
+	log.Debug("IndexNode CreateIndex finished")
+	tr.Elapse("all done")
+	return nil
+}
 
-			return path.Join(Params.IndexRootPath, strconv.Itoa(int(it.req.IndexBuildID)), strconv.Itoa(int(it.req.Version)),
-				strconv.Itoa(int(partitionID)), strconv.Itoa(int(segmentID)), key)
+// addFloatVectors adds the vectors to the trained index in batches of addBatchRows, the indexed rows are reported
+// after each batch.
+func (it *IndexBuildTask) addFloatVectors(ctx context.Context, index DistributedIndex, data *storage.FloatVectorFieldData) error {
+	if data.Dim <= 0 {
+		return fmt.Errorf("invalid dim %d of the float vectors", data.Dim)
+	}
+	batchSize := addBatchRows * data.Dim
+	for offset := 0; offset < len(data.Data); offset += batchSize {
+		if err := it.checkCancelled(); err != nil {
+			return err
+		}
+		end := offset + batchSize
+		if end > len(data.Data) {
+			end = len(data.Data)
+		}
+		if err := index.AddFloatVecIndexWithoutIds(data.Data[offset:end]); err != nil {
+			return err
 		}
-		saveBlob := func(path string, value []byte) error {
-			return it.kv.Save(path, string(value))
+		it.indexedRows = int64(end / data.Dim)
+		if end < len(data.Data) {
+			it.reportProgress(ctx)
 		}
+	}
+	return nil
+}
 
-		it.savePaths = make([]string, len(serializedIndexBlobs))
-		saveIndexFile := func(idx int) error {
-			blob := serializedIndexBlobs[idx]
-			key, value := blob.Key, blob.Value
+// saveIndex serializes the built index and writes the index files, the paths are recorded in savePaths.
+func (it *IndexBuildTask) saveIndex(ctx context.Context, tr *timerecord.TimeRecorder, collectionID, partitionID, segmentID,
+	fieldID UniqueID, indexParams map[string]string) error {
+	indexBlobs, err := it.index.Serialize()
+	if err != nil {
+		log.Error("IndexNode index Serialize failed", zap.Error(err))
+		return err
+	}
+	tr.Record("serialize index done")
+
+	codec := storage.NewIndexFileBinlogCodec()
+	serializedIndexBlobs, err := codec.Serialize(
+		it.req.IndexBuildID,
+		it.req.Version,
+		collectionID,
+		partitionID,
+		segmentID,
+		fieldID,
+		indexParams,
+		it.req.IndexName,
+		it.req.IndexID,
+		indexBlobs,
+	)
+	if err != nil {
+		return err
+	}
+	_ = codec.Close()
+	tr.Record("serialize index codec done")
 
-			savePath := getSavePathByKey(key)
+	getSavePathByKey := func(key string) string {
 
-			saveIndexFileFn := func() error {
-				v, err := it.etcdKV.Load(it.req.MetaPath)
-				if err != nil {
-					log.Error("IndexNode load meta failed", zap.Any("path", it.req.MetaPath), zap.Error(err))
-					return err
-				}
-				indexMeta := indexpb.IndexMeta{}
-				err = proto.Unmarshal([]byte(v), &indexMeta)
-				if err != nil {
-					log.Error("IndexNode Unmarshal indexMeta error ", zap.Error(err))
-					return err
-				}
-				//log.Debug("IndexNode Unmarshal indexMeta success ", zap.Any("meta", indexMeta))
-				if indexMeta.Version > it.req.Version {
-					log.Warn("IndexNode try saveIndexFile failed req.Version is low", zap.Any("req.Version", it.req.Version),
-						zap.Any("indexMeta.Version", indexMeta.Version))
-					return errors.New("This task has been reassigned ")
-				}
-				return saveBlob(savePath, value)
+		return path.Join(Params.IndexRootPath, strconv.Itoa(int(it.req.IndexBuildID)), strconv.Itoa(int(it.req.Version)),
+			strconv.Itoa(int(partitionID)), strconv.Itoa(int(segmentID)), key)
+	}
+	saveBlob := func(path string, value []byte) error {
+		return it.chunkManager.Write(path, value)
+	}
+
+	it.savePaths = make([]string, len(serializedIndexBlobs))
+	it.serializedSize = 0
+	for _, blob := range serializedIndexBlobs {
+		it.serializedSize += int64(len(blob.Value))
+	}
+	saveIndexFile := func(idx int) error {
+		blob := serializedIndexBlobs[idx]
+		key, value := blob.Key, blob.Value
+
+		savePath := getSavePathByKey(key)
+
+		saveIndexFileFn := func() error {
+			if err := it.checkCancelled(); err != nil {
+				return err
+			}
+			v, err := it.etcdKV.Load(it.req.MetaPath)
+			if err != nil {
+				log.Error("IndexNode load meta failed", zap.Any("path", it.req.MetaPath), zap.Error(err))
+				return err
 			}
-			err := retry.Do(ctx, saveIndexFileFn, retry.Attempts(5))
-			log.Debug("IndexNode try saveIndexFile final", zap.Error(err), zap.Any("savePath", savePath))
+			indexMeta := indexpb.IndexMeta{}
+			err = proto.Unmarshal([]byte(v), &indexMeta)
 			if err != nil {
+				log.Error("IndexNode Unmarshal indexMeta error ", zap.Error(err))
 				return err
 			}
+			//log.Debug("IndexNode Unmarshal indexMeta success ", zap.Any("meta", indexMeta))
+			if indexMeta.Version > it.req.Version {
+				log.Warn("IndexNode try saveIndexFile failed req.Version is low", zap.Any("req.Version", it.req.Version),
+					zap.Any("indexMeta.Version", indexMeta.Version))
+				return errors.New("This task has been reassigned ")
+			}
+			return saveBlob(savePath, value)
+		}
+		err := retry.Do(ctx, saveIndexFileFn, retry.Attempts(5))
+		log.Debug("IndexNode try saveIndexFile final", zap.Error(err), zap.Any("savePath", savePath))
+		if err != nil {
+			return err
+		}
 
-			it.savePaths[idx] = savePath
+		it.savePaths[idx] = savePath
 
-			return nil
+		return nil
+	}
+	err = funcutil.ProcessFuncParallel(len(serializedIndexBlobs), runtime.NumCPU(), saveIndexFile, "saveIndexFile")
+	if err != nil {
+		return err
+	}
+	tr.Record("save index file done")
+	it.buildTimeMs = tr.ElapseSpan().Milliseconds()
+	return nil
+}
+
+// readBlobs reads the files of paths in parallel, check is called before reading each file.
+func readBlobs(chunkManager storage.ChunkManager, paths []string, check func() error) ([]*Blob, error) {
+	blobs := make([]*Blob, len(paths))
+	readBlob := func(idx int) error {
+		if err := check(); err != nil {
+			return err
 		}
-		err = funcutil.ProcessFuncParallel(len(serializedIndexBlobs), runtime.NumCPU(), saveIndexFile, "saveIndexFile")
+		data, err := chunkManager.Read(paths[idx])
 		if err != nil {
 			return err
 		}
-		tr.Record("save index file done")
+		blobs[idx] = &Blob{
+			Key:   paths[idx],
+			Value: []byte(data),
+		}
+		return nil
 	}
-	log.Debug("IndexNode CreateIndex finished")
+	err := funcutil.ProcessFuncParallel(len(paths), runtime.NumCPU(), readBlob, "readBlob")
+	if err != nil {
+		return nil, err
+	}
+	return blobs, nil
+}
+
+// loadIndexFiles reads the index files written by an index build task.
+func loadIndexFiles(chunkManager storage.ChunkManager, paths []string, check func() error) (collectionID, partitionID,
+	segmentID, fieldID UniqueID, indexBlobs []*Blob, err error) {
+	blobs, err := readBlobs(chunkManager, paths, check)
+	if err != nil {
+		return 0, 0, 0, 0, nil, err
+	}
+	codec := storage.NewIndexFileBinlogCodec()
+	defer codec.Close()
+	_, _, collectionID, partitionID, segmentID, fieldID, _, _, _, indexBlobs, err = codec.DeserializeImpl(blobs)
+	if err != nil {
+		return 0, 0, 0, 0, nil, err
+	}
+	return collectionID, partitionID, segmentID, fieldID, indexBlobs, nil
+}
+
+// mergeShardIndexes loads the shard indexes of a segment in order and merges them into one index.
+func (it *IndexBuildTask) mergeShardIndexes(ctx context.Context, tr *timerecord.TimeRecorder, index DistributedIndex,
+	indexParams map[string]string) error {
+	inputs := it.req.GetInputIndexes()
+	if len(inputs) == 0 {
+		return errors.New("merging needs at least one shard index")
+	}
+	it.totalRows = it.req.GetNumRows()
+	it.reportProgress(ctx)
+
+	var collectionID, partitionID, segmentID, fieldID UniqueID
+	for idx, input := range inputs {
+		if err := it.checkCancelled(); err != nil {
+			return err
+		}
+		collID, partID, segID, fID, blobs, err := loadIndexFiles(it.chunkManager, input.IndexFilePaths, it.checkCancelled)
+		if err != nil {
+			log.Error("IndexNode load shard index failed", zap.Int64("shardBuildID", input.IndexBuildID), zap.Error(err))
+			return err
+		}
+		if idx == 0 {
+			collectionID, partitionID, segmentID, fieldID = collID, partID, segID, fID
+			err = index.Load(blobs)
+		} else {
+			if segID != segmentID || fID != fieldID {
+				return fmt.Errorf("shard index %d isn't built on segment %d field %d", input.IndexBuildID, segmentID, fieldID)
+			}
+			err = index.Merge(blobs)
+		}
+		if err != nil {
+			log.Error("IndexNode merge shard index failed", zap.Int64("shardBuildID", input.IndexBuildID), zap.Error(err))
+			return err
+		}
+	}
+	tr.Record("merge shard indexes done")
+	if err := it.checkCancelled(); err != nil {
+		return err
+	}
+	it.indexedRows = it.totalRows
+	it.reportProgress(ctx)
+
+	if err := it.saveIndex(ctx, tr, collectionID, partitionID, segmentID, fieldID, indexParams); err != nil {
+		return err
+	}
+	log.Debug("IndexNode merge shard indexes finished", zap.Int64("indexBuildID", it.req.IndexBuildID),
+		zap.Int("shards", len(inputs)))
 	tr.Elapse("all done")
 	return nil
 }
+
+// fieldDataRowNum returns the number of rows of the deserialized field data.
+func fieldDataRowNum(data storage.FieldData) int64 {
+	var numRows []int64
+	switch fieldData := data.(type) {
+	case *storage.BoolFieldData:
+		numRows = fieldData.NumRows
+	case *storage.Int8FieldData:
+		numRows = fieldData.NumRows
+	case *storage.Int16FieldData:
+		numRows = fieldData.NumRows
+	case *storage.Int32FieldData:
+		numRows = fieldData.NumRows
+	case *storage.Int64FieldData:
+		numRows = fieldData.NumRows
+	case *storage.FloatFieldData:
+		numRows = fieldData.NumRows
+	case *storage.DoubleFieldData:
+		numRows = fieldData.NumRows
+	case *storage.StringFieldData:
+		numRows = fieldData.NumRows
+	case *storage.BinaryVectorFieldData:
+		numRows = fieldData.NumRows
+	case *storage.FloatVectorFieldData:
+		numRows = fieldData.NumRows
+	}
+	var rowNum int64
+	for _, n := range numRows {
+		rowNum += n
+	}
+	return rowNum
+}
@@ -19,8 +19,8 @@ import (
 
 	"go.uber.org/zap"
 
-	"github.com/milvus-io/milvus/internal/kv"
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/util/trace"
 	"github.com/opentracing/opentracing-go"
 	oplog "github.com/opentracing/opentracing-go/log"
@@ -35,6 +35,7 @@ type TaskQueue interface {
 	PopUnissuedTask() task
 	AddActiveTask(t task)
 	PopActiveTask(tID UniqueID) task
+	GetTaskByID(tID UniqueID) task
 	Enqueue(t task) error
 	//tryToRemoveUselessIndexBuildTask(indexID UniqueID) []UniqueID
 }
@@ -129,6 +130,25 @@ func (queue *BaseTaskQueue) PopActiveTask(tID UniqueID) task {
 	return nil
 }
 
+// GetTaskByID returns the unissued or active task with tID, nil if it is not in the queue.
+func (queue *BaseTaskQueue) GetTaskByID(tID UniqueID) task {
+	queue.atLock.Lock()
+	t, ok := queue.activeTasks[tID]
+	queue.atLock.Unlock()
+	if ok {
+		return t
+	}
+
+	queue.utLock.Lock()
+	defer queue.utLock.Unlock()
+	for e := queue.unissuedTasks.Front(); e != nil; e = e.Next() {
+		if e.Value.(task).ID() == tID {
+			return e.Value.(task)
+		}
+	}
+	return nil
+}
+
 //func (queue *BaseTaskQueue) tryToRemoveUselessIndexBuildTask(indexID UniqueID) []UniqueID {
 //	queue.utLock.Lock()
 //	defer queue.utLock.Unlock()
@@ -178,17 +198,17 @@ type TaskScheduler struct {
 	IndexBuildQueue TaskQueue
 
 	buildParallel int
-	kv            kv.BaseKV
+	chunkManager  storage.ChunkManager
 	wg            sync.WaitGroup
 	ctx           context.Context
 	cancel        context.CancelFunc
 }
 
 func NewTaskScheduler(ctx context.Context,
-	kv kv.BaseKV) (*TaskScheduler, error) {
+	chunkManager storage.ChunkManager) (*TaskScheduler, error) {
 	ctx1, cancel := context.WithCancel(ctx)
 	s := &TaskScheduler{
-		kv:            kv,
+		chunkManager:  chunkManager,
 		ctx:           ctx1,
 		cancel:        cancel,
 		buildParallel: 1, // default value
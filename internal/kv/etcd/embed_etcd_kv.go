@@ -19,6 +19,7 @@ import (
 	"sync"
 	"time"
 
+	milvuskv "github.com/milvus-io/milvus/internal/kv"
 	clientv3 "go.etcd.io/etcd/client/v3"
 	"go.etcd.io/etcd/server/v3/embed"
 	"go.etcd.io/etcd/server/v3/etcdserver/api/v3client"
@@ -91,6 +92,18 @@ func (kv *EmbedEtcdKV) LoadWithPrefix(key string) ([]string, []string, error) {
 	return keys, values, nil
 }
 
+// HasPrefix checks whether any key has the prefix, the keys and values are not loaded.
+func (kv *EmbedEtcdKV) HasPrefix(prefix string) (bool, error) {
+	prefix = path.Join(kv.rootPath, prefix)
+	ctx, cancel := context.WithTimeout(context.TODO(), RequestTimeout)
+	defer cancel()
+	resp, err := kv.client.Get(ctx, prefix, clientv3.WithPrefix(), clientv3.WithKeysOnly(), clientv3.WithLimit(1))
+	if err != nil {
+		return false, err
+	}
+	return len(resp.Kvs) > 0, nil
+}
+
 func (kv *EmbedEtcdKV) LoadWithPrefix2(key string) ([]string, []string, []int64, error) {
 	key = path.Join(kv.rootPath, key)
 	log.Debug("LoadWithPrefix ", zap.String("prefix", key))
@@ -190,11 +203,11 @@ func (kv *EmbedEtcdKV) Save(key, value string) error {
 }
 
 // SaveWithLease is a function to put value in etcd with etcd lease options.
-func (kv *EmbedEtcdKV) SaveWithLease(key, value string, id clientv3.LeaseID) error {
+func (kv *EmbedEtcdKV) SaveWithLease(key, value string, id milvuskv.LeaseID) error {
 	key = path.Join(kv.rootPath, key)
 	ctx, cancel := context.WithTimeout(context.TODO(), RequestTimeout)
 	defer cancel()
-	_, err := kv.client.Put(ctx, key, value, clientv3.WithLease(id))
+	_, err := kv.client.Put(ctx, key, value, clientv3.WithLease(clientv3.LeaseID(id)))
 	return err
 }
 
@@ -260,22 +273,22 @@ func (kv *EmbedEtcdKV) MultiSaveAndRemove(saves map[string]string, removals []st
 	return err
 }
 
-func (kv *EmbedEtcdKV) Watch(key string) clientv3.WatchChan {
+func (kv *EmbedEtcdKV) Watch(key string) milvuskv.WatchChan {
 	key = path.Join(kv.rootPath, key)
 	rch := kv.client.Watch(context.Background(), key, clientv3.WithCreatedNotify())
-	return rch
+	return newWatchChan(rch)
 }
 
-func (kv *EmbedEtcdKV) WatchWithPrefix(key string) clientv3.WatchChan {
+func (kv *EmbedEtcdKV) WatchWithPrefix(key string) milvuskv.WatchChan {
 	key = path.Join(kv.rootPath, key)
 	rch := kv.client.Watch(context.Background(), key, clientv3.WithPrefix(), clientv3.WithCreatedNotify())
-	return rch
+	return newWatchChan(rch)
 }
 
-func (kv *EmbedEtcdKV) WatchWithRevision(key string, revision int64) clientv3.WatchChan {
+func (kv *EmbedEtcdKV) WatchWithRevision(key string, revision int64) milvuskv.WatchChan {
 	key = path.Join(kv.rootPath, key)
 	rch := kv.client.Watch(context.Background(), key, clientv3.WithPrefix(), clientv3.WithPrevKV(), clientv3.WithRev(revision))
-	return rch
+	return newWatchChan(rch)
 }
 
 func (kv *EmbedEtcdKV) MultiRemoveWithPrefix(keys []string) error {
@@ -311,24 +324,27 @@ func (kv *EmbedEtcdKV) MultiSaveAndRemoveWithPrefix(saves map[string]string, rem
 }
 
 // Grant creates a new lease implemented in etcd grant interface.
-func (kv *EmbedEtcdKV) Grant(ttl int64) (id clientv3.LeaseID, err error) {
+func (kv *EmbedEtcdKV) Grant(ttl int64) (id milvuskv.LeaseID, err error) {
 	resp, err := kv.client.Grant(context.Background(), ttl)
-	return resp.ID, err
+	if err != nil {
+		return 0, err
+	}
+	return milvuskv.LeaseID(resp.ID), nil
 }
 
 // KeepAlive keeps the lease alive forever with leaseID.
 // Implemented in etcd interface.
-func (kv *EmbedEtcdKV) KeepAlive(id clientv3.LeaseID) (<-chan *clientv3.LeaseKeepAliveResponse, error) {
-	ch, err := kv.client.KeepAlive(context.Background(), id)
+func (kv *EmbedEtcdKV) KeepAlive(id milvuskv.LeaseID) (<-chan *milvuskv.LeaseKeepAliveResponse, error) {
+	ch, err := kv.client.KeepAlive(context.Background(), clientv3.LeaseID(id))
 	if err != nil {
 		return nil, err
 	}
-	return ch, nil
+	return newKeepAliveChan(ch), nil
 }
 
 // CompareValueAndSwap compares the existing value with compare, and if they are
 // equal, the target is stored in etcd.
-func (kv *EmbedEtcdKV) CompareValueAndSwap(key, value, target string, opts ...clientv3.OpOption) error {
+func (kv *EmbedEtcdKV) CompareValueAndSwap(key, value, target string, opts ...milvuskv.SaveOption) error {
 	ctx, cancel := context.WithTimeout(context.TODO(), RequestTimeout)
 	defer cancel()
 	resp, err := kv.client.Txn(ctx).If(
@@ -336,7 +352,7 @@ func (kv *EmbedEtcdKV) CompareValueAndSwap(key, value, target string, opts ...cl
 			clientv3.Value(path.Join(kv.rootPath, key)),
 			"=",
 			value)).
-		Then(clientv3.OpPut(path.Join(kv.rootPath, key), target, opts...)).Commit()
+		Then(clientv3.OpPut(path.Join(kv.rootPath, key), target, etcdSaveOptions(opts...)...)).Commit()
 	if err != nil {
 		return err
 	}
@@ -349,7 +365,7 @@ func (kv *EmbedEtcdKV) CompareValueAndSwap(key, value, target string, opts ...cl
 
 // CompareVersionAndSwap compares the existing key-value's version with version, and if
 // they are equal, the target is stored in etcd.
-func (kv *EmbedEtcdKV) CompareVersionAndSwap(key string, version int64, target string, opts ...clientv3.OpOption) error {
+func (kv *EmbedEtcdKV) CompareVersionAndSwap(key string, version int64, target string, opts ...milvuskv.SaveOption) error {
 	ctx, cancel := context.WithTimeout(context.TODO(), RequestTimeout)
 	defer cancel()
 	resp, err := kv.client.Txn(ctx).If(
@@ -357,7 +373,7 @@ func (kv *EmbedEtcdKV) CompareVersionAndSwap(key string, version int64, target s
 			clientv3.Version(path.Join(kv.rootPath, key)),
 			"=",
 			version)).
-		Then(clientv3.OpPut(path.Join(kv.rootPath, key), target, opts...)).Commit()
+		Then(clientv3.OpPut(path.Join(kv.rootPath, key), target, etcdSaveOptions(opts...)...)).Commit()
 	if err != nil {
 		return err
 	}
@@ -17,11 +17,11 @@ import (
 
 	"github.com/milvus-io/milvus/internal/util/metricsinfo"
 
+	"github.com/milvus-io/milvus/internal/kv"
 	embed_etcd_kv "github.com/milvus-io/milvus/internal/kv/etcd"
 	"github.com/milvus-io/milvus/internal/util/paramtable"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
-	clientv3 "go.etcd.io/etcd/client/v3"
 )
 
 func TestEmbedEtcd(te *testing.T) {
@@ -146,6 +146,30 @@ func TestEmbedEtcd(te *testing.T) {
 		}
 	})
 
+	te.Run("EtcdKV HasPrefix", func(t *testing.T) {
+		rootPath := "/etcd/test/root/hasprefix"
+		metaKv, err := embed_etcd_kv.NewMetaKvFactory(rootPath, param)
+		require.NoError(t, err)
+		etcdKV := metaKv.(*embed_etcd_kv.EmbedEtcdKV)
+		defer etcdKV.Close()
+		defer etcdKV.RemoveWithPrefix("")
+
+		err = etcdKV.Save("prefix/a", "a")
+		assert.NoError(t, err)
+		err = etcdKV.Save("prefix/b", "b")
+		assert.NoError(t, err)
+
+		has, err := etcdKV.HasPrefix("prefix")
+		assert.NoError(t, err)
+		assert.True(t, has)
+		has, err = etcdKV.HasPrefix("prefix/a")
+		assert.NoError(t, err)
+		assert.True(t, has)
+		has, err = etcdKV.HasPrefix("other")
+		assert.NoError(t, err)
+		assert.False(t, has)
+	})
+
 	te.Run("EtcdKV LoadWithRevision", func(t *testing.T) {
 		rootPath := "/etcd/test/root/LoadWithRevision"
 		metaKv, err := embed_etcd_kv.NewMetaKvFactory(rootPath, param)
@@ -436,7 +460,7 @@ func TestEmbedEtcd(te *testing.T) {
 			resp := <-ch
 			assert.Equal(t, 1, len(resp.Events))
 			assert.Equal(t, test.secondValue, string(resp.Events[0].Kv.Value))
-			assert.Equal(t, revision+1, resp.Header.Revision)
+			assert.Equal(t, revision+1, resp.Revision)
 		}
 
 		err = metaKv.CompareVersionAndSwap("a/b/c", 0, "1")
@@ -479,7 +503,7 @@ func TestEmbedEtcd(te *testing.T) {
 			err = metaKv.SaveWithLease(k, v, leaseID)
 			assert.NoError(t, err)
 
-			err = metaKv.SaveWithLease(k, v, clientv3.LeaseID(999))
+			err = metaKv.SaveWithLease(k, v, kv.LeaseID(999))
 			assert.Error(t, err)
 		}
 
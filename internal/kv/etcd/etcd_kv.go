@@ -17,6 +17,7 @@ import (
 	"path"
 	"time"
 
+	milvuskv "github.com/milvus-io/milvus/internal/kv"
 	"github.com/milvus-io/milvus/internal/log"
 	clientv3 "go.etcd.io/etcd/client/v3"
 
@@ -79,6 +80,20 @@ func (kv *EtcdKV) LoadWithPrefix(key string) ([]string, []string, error) {
 	return keys, values, nil
 }
 
+// HasPrefix checks whether any key has the prefix, the keys and values are not loaded.
+func (kv *EtcdKV) HasPrefix(prefix string) (bool, error) {
+	start := time.Now()
+	prefix = path.Join(kv.rootPath, prefix)
+	ctx, cancel := context.WithTimeout(context.TODO(), RequestTimeout)
+	defer cancel()
+	resp, err := kv.client.Get(ctx, prefix, clientv3.WithPrefix(), clientv3.WithKeysOnly(), clientv3.WithLimit(1))
+	if err != nil {
+		return false, err
+	}
+	CheckElapseAndWarn(start, "Slow etcd operation has prefix")
+	return len(resp.Kvs) > 0, nil
+}
+
 func (kv *EtcdKV) LoadWithPrefix2(key string) ([]string, []string, []int64, error) {
 	start := time.Now()
 	key = path.Join(kv.rootPath, key)
@@ -182,12 +197,12 @@ func (kv *EtcdKV) Save(key, value string) error {
 }
 
 // SaveWithLease is a function to put value in etcd with etcd lease options.
-func (kv *EtcdKV) SaveWithLease(key, value string, id clientv3.LeaseID) error {
+func (kv *EtcdKV) SaveWithLease(key, value string, id milvuskv.LeaseID) error {
 	start := time.Now()
 	key = path.Join(kv.rootPath, key)
 	ctx, cancel := context.WithTimeout(context.TODO(), RequestTimeout)
 	defer cancel()
-	_, err := kv.client.Put(ctx, key, value, clientv3.WithLease(id))
+	_, err := kv.client.Put(ctx, key, value, clientv3.WithLease(clientv3.LeaseID(id)))
 	CheckElapseAndWarn(start, "Slow etcd operation save with lease")
 	return err
 }
@@ -263,28 +278,28 @@ func (kv *EtcdKV) MultiSaveAndRemove(saves map[string]string, removals []string)
 	return err
 }
 
-func (kv *EtcdKV) Watch(key string) clientv3.WatchChan {
+func (kv *EtcdKV) Watch(key string) milvuskv.WatchChan {
 	start := time.Now()
 	key = path.Join(kv.rootPath, key)
 	rch := kv.client.Watch(context.Background(), key, clientv3.WithCreatedNotify())
 	CheckElapseAndWarn(start, "Slow etcd operation watch")
-	return rch
+	return newWatchChan(rch)
 }
 
-func (kv *EtcdKV) WatchWithPrefix(key string) clientv3.WatchChan {
+func (kv *EtcdKV) WatchWithPrefix(key string) milvuskv.WatchChan {
 	start := time.Now()
 	key = path.Join(kv.rootPath, key)
 	rch := kv.client.Watch(context.Background(), key, clientv3.WithPrefix(), clientv3.WithCreatedNotify())
 	CheckElapseAndWarn(start, "Slow etcd operation watch with prefix")
-	return rch
+	return newWatchChan(rch)
 }
 
-func (kv *EtcdKV) WatchWithRevision(key string, revision int64) clientv3.WatchChan {
+func (kv *EtcdKV) WatchWithRevision(key string, revision int64) milvuskv.WatchChan {
 	start := time.Now()
 	key = path.Join(kv.rootPath, key)
 	rch := kv.client.Watch(context.Background(), key, clientv3.WithPrefix(), clientv3.WithPrevKV(), clientv3.WithRev(revision))
 	CheckElapseAndWarn(start, "Slow etcd operation watch with revision")
-	return rch
+	return newWatchChan(rch)
 }
 
 func (kv *EtcdKV) MultiRemoveWithPrefix(keys []string) error {
@@ -322,28 +337,31 @@ func (kv *EtcdKV) MultiSaveAndRemoveWithPrefix(saves map[string]string, removals
 }
 
 // Grant creates a new lease implemented in etcd grant interface.
-func (kv *EtcdKV) Grant(ttl int64) (id clientv3.LeaseID, err error) {
+func (kv *EtcdKV) Grant(ttl int64) (id milvuskv.LeaseID, err error) {
 	start := time.Now()
 	resp, err := kv.client.Grant(context.Background(), ttl)
+	if err != nil {
+		return 0, err
+	}
 	CheckElapseAndWarn(start, "Slow etcd operation grant")
-	return resp.ID, err
+	return milvuskv.LeaseID(resp.ID), nil
 }
 
 // KeepAlive keeps the lease alive forever with leaseID.
 // Implemented in etcd interface.
-func (kv *EtcdKV) KeepAlive(id clientv3.LeaseID) (<-chan *clientv3.LeaseKeepAliveResponse, error) {
+func (kv *EtcdKV) KeepAlive(id milvuskv.LeaseID) (<-chan *milvuskv.LeaseKeepAliveResponse, error) {
 	start := time.Now()
-	ch, err := kv.client.KeepAlive(context.Background(), id)
+	ch, err := kv.client.KeepAlive(context.Background(), clientv3.LeaseID(id))
 	if err != nil {
 		return nil, err
 	}
 	CheckElapseAndWarn(start, "Slow etcd operation keepAlive")
-	return ch, nil
+	return newKeepAliveChan(ch), nil
 }
 
 // CompareValueAndSwap compares the existing value with compare, and if they are
 // equal, the target is stored in etcd.
-func (kv *EtcdKV) CompareValueAndSwap(key, value, target string, opts ...clientv3.OpOption) error {
+func (kv *EtcdKV) CompareValueAndSwap(key, value, target string, opts ...milvuskv.SaveOption) error {
 	start := time.Now()
 	ctx, cancel := context.WithTimeout(context.TODO(), RequestTimeout)
 	defer cancel()
@@ -352,7 +370,7 @@ func (kv *EtcdKV) CompareValueAndSwap(key, value, target string, opts ...clientv
 			clientv3.Value(path.Join(kv.rootPath, key)),
 			"=",
 			value)).
-		Then(clientv3.OpPut(path.Join(kv.rootPath, key), target, opts...)).Commit()
+		Then(clientv3.OpPut(path.Join(kv.rootPath, key), target, etcdSaveOptions(opts...)...)).Commit()
 	if err != nil {
 		return err
 	}
@@ -365,7 +383,7 @@ func (kv *EtcdKV) CompareValueAndSwap(key, value, target string, opts ...clientv
 
 // CompareVersionAndSwap compares the existing key-value's version with version, and if
 // they are equal, the target is stored in etcd.
-func (kv *EtcdKV) CompareVersionAndSwap(key string, version int64, target string, opts ...clientv3.OpOption) error {
+func (kv *EtcdKV) CompareVersionAndSwap(key string, version int64, target string, opts ...milvuskv.SaveOption) error {
 	start := time.Now()
 	ctx, cancel := context.WithTimeout(context.TODO(), RequestTimeout)
 	defer cancel()
@@ -374,7 +392,7 @@ func (kv *EtcdKV) CompareVersionAndSwap(key string, version int64, target string
 			clientv3.Version(path.Join(kv.rootPath, key)),
 			"=",
 			version)).
-		Then(clientv3.OpPut(path.Join(kv.rootPath, key), target, opts...)).Commit()
+		Then(clientv3.OpPut(path.Join(kv.rootPath, key), target, etcdSaveOptions(opts...)...)).Commit()
 	if err != nil {
 		return err
 	}
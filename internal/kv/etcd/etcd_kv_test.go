@@ -17,11 +17,11 @@ import (
 	"testing"
 	"time"
 
+	"github.com/milvus-io/milvus/internal/kv"
 	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
 	"github.com/milvus-io/milvus/internal/util/paramtable"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
-	clientv3 "go.etcd.io/etcd/client/v3"
 )
 
 var Params paramtable.BaseTable
@@ -150,6 +150,29 @@ func TestEtcdKV_Load(te *testing.T) {
 		}
 	})
 
+	te.Run("EtcdKV HasPrefix", func(t *testing.T) {
+		rootPath := "/etcd/test/root/hasprefix"
+		etcdKV, err := etcdkv.NewEtcdKV(etcdEndPoints, rootPath)
+		require.NoError(t, err)
+		defer etcdKV.Close()
+		defer etcdKV.RemoveWithPrefix("")
+
+		err = etcdKV.Save("prefix/a", "a")
+		assert.NoError(t, err)
+		err = etcdKV.Save("prefix/b", "b")
+		assert.NoError(t, err)
+
+		has, err := etcdKV.HasPrefix("prefix")
+		assert.NoError(t, err)
+		assert.True(t, has)
+		has, err = etcdKV.HasPrefix("prefix/a")
+		assert.NoError(t, err)
+		assert.True(t, has)
+		has, err = etcdKV.HasPrefix("other")
+		assert.NoError(t, err)
+		assert.False(t, has)
+	})
+
 	te.Run("EtcdKV LoadWithRevision", func(t *testing.T) {
 		rootPath := "/etcd/test/root/LoadWithRevision"
 		etcdKV, err := etcdkv.NewEtcdKV(etcdEndPoints, rootPath)
@@ -440,7 +463,7 @@ func TestEtcdKV_Load(te *testing.T) {
 			resp := <-ch
 			assert.Equal(t, 1, len(resp.Events))
 			assert.Equal(t, test.secondValue, string(resp.Events[0].Kv.Value))
-			assert.Equal(t, revision+1, resp.Header.Revision)
+			assert.Equal(t, revision+1, resp.Revision)
 		}
 
 		err = etcdKV.CompareVersionAndSwap("a/b/c", 0, "1")
@@ -483,7 +506,7 @@ func TestEtcdKV_Load(te *testing.T) {
 			err = etcdKV.SaveWithLease(k, v, leaseID)
 			assert.NoError(t, err)
 
-			err = etcdKV.SaveWithLease(k, v, clientv3.LeaseID(999))
+			err = etcdKV.SaveWithLease(k, v, kv.LeaseID(999))
 			assert.Error(t, err)
 		}
 
@@ -0,0 +1,99 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package etcdkv
+
+import (
+	milvuskv "github.com/milvus-io/milvus/internal/kv"
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+// etcdSaveOptions converts the options of MetaKv into etcd put options.
+func etcdSaveOptions(opts ...milvuskv.SaveOption) []clientv3.OpOption {
+	saveOpts := &milvuskv.SaveOptions{}
+	for _, opt := range opts {
+		opt(saveOpts)
+	}
+	var etcdOpts []clientv3.OpOption
+	if saveOpts.Lease != 0 {
+		etcdOpts = append(etcdOpts, clientv3.WithLease(clientv3.LeaseID(saveOpts.Lease)))
+	}
+	return etcdOpts
+}
+
+func convertKeyValue(kv *mvccpb.KeyValue) *milvuskv.KeyValue {
+	if kv == nil {
+		return nil
+	}
+	return &milvuskv.KeyValue{
+		Key:            kv.Key,
+		Value:          kv.Value,
+		CreateRevision: kv.CreateRevision,
+		ModRevision:    kv.ModRevision,
+		Version:        kv.Version,
+		Lease:          milvuskv.LeaseID(kv.Lease),
+	}
+}
+
+func convertWatchResponse(resp clientv3.WatchResponse) milvuskv.WatchResponse {
+	events := make([]*milvuskv.WatchEvent, 0, len(resp.Events))
+	for _, ev := range resp.Events {
+		eventType := milvuskv.EventTypePut
+		if ev.Type == mvccpb.DELETE {
+			eventType = milvuskv.EventTypeDelete
+		}
+		events = append(events, &milvuskv.WatchEvent{
+			Type:   eventType,
+			Kv:     convertKeyValue(ev.Kv),
+			PrevKv: convertKeyValue(ev.PrevKv),
+		})
+	}
+	return milvuskv.WatchResponse{
+		Events:   events,
+		Revision: resp.Header.Revision,
+		Created:  resp.Created,
+		Canceled: resp.Canceled,
+		Err:      resp.Err(),
+	}
+}
+
+// newWatchChan forwards the responses of an etcd watch channel, the returned channel is
+// closed when the etcd one is closed.
+func newWatchChan(rch clientv3.WatchChan) milvuskv.WatchChan {
+	ch := make(chan milvuskv.WatchResponse)
+	go func() {
+		defer close(ch)
+		for resp := range rch {
+			ch <- convertWatchResponse(resp)
+		}
+	}()
+	return ch
+}
+
+// newKeepAliveChan forwards the responses of an etcd keep alive channel, the returned channel
+// is closed when the etcd one is closed.
+func newKeepAliveChan(kch <-chan *clientv3.LeaseKeepAliveResponse) <-chan *milvuskv.LeaseKeepAliveResponse {
+	ch := make(chan *milvuskv.LeaseKeepAliveResponse, cap(kch))
+	go func() {
+		defer close(ch)
+		for resp := range kch {
+			if resp == nil {
+				return
+			}
+			ch <- &milvuskv.LeaseKeepAliveResponse{
+				ID:  milvuskv.LeaseID(resp.ID),
+				TTL: resp.TTL,
+			}
+		}
+	}()
+	return ch
+}
@@ -12,14 +12,55 @@
 package etcdkv
 
 import (
+	"errors"
+	"sync"
+
 	"github.com/milvus-io/milvus/internal/kv"
+	memkv "github.com/milvus-io/milvus/internal/kv/mem"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/util/paramtable"
 	"go.etcd.io/etcd/server/v3/embed"
 	"go.uber.org/zap"
 )
 
+var (
+	localMetaStoreMu sync.RWMutex
+	localMetaStore   *memkv.MetaStore
+)
+
+// SetLocalMetaStore makes NewMetaKv and NewMetaKvFactory create MetaKv on the in-process store,
+// so that the components running in one process share the metadata without an etcd server.
+// Setting nil switches back to etcd.
+func SetLocalMetaStore(store *memkv.MetaStore) {
+	localMetaStoreMu.Lock()
+	defer localMetaStoreMu.Unlock()
+	localMetaStore = store
+}
+
+func getLocalMetaStore() *memkv.MetaStore {
+	localMetaStoreMu.RLock()
+	defer localMetaStoreMu.RUnlock()
+	return localMetaStore
+}
+
+// NewMetaKv creates a MetaKv with rootPath, on the local meta store if one is set, otherwise on
+// the etcd at etcdEndpoints.
+func NewMetaKv(etcdEndpoints []string, rootPath string) (kv.MetaKv, error) {
+	if store := getLocalMetaStore(); store != nil {
+		return store.NewMetaKV(rootPath), nil
+	}
+	return NewEtcdKV(etcdEndpoints, rootPath)
+}
+
 func NewMetaKvFactory(rootPath string, param *paramtable.BaseParamTable) (kv.MetaKv, error) {
+	if param.MetaStoreType == paramtable.MetaStoreTypeLocal {
+		log.Info("use local meta store with rootPath", zap.String("rootpath", rootPath))
+		store := getLocalMetaStore()
+		if store == nil {
+			return nil, errors.New("local meta store is not initialized")
+		}
+		return store.NewMetaKV(rootPath), nil
+	}
 	log.Info("start etcd with rootPath",
 		zap.String("rootpath", rootPath),
 		zap.Bool("isEmbed", param.UseEmbedEtcd))
@@ -13,7 +13,6 @@ package kv
 
 import (
 	"github.com/milvus-io/milvus/internal/util/typeutil"
-	clientv3 "go.etcd.io/etcd/client/v3"
 )
 
 // BaseKV contains base operations of kv. Include save, load and remove.
@@ -38,6 +37,72 @@ type TxnKV interface {
 	MultiSaveAndRemoveWithPrefix(saves map[string]string, removals []string) error
 }
 
+// LeaseID is the identifier of a lease granted by MetaKv.
+type LeaseID int64
+
+// LeaseKeepAliveResponse is sent by the channel of MetaKv.KeepAlive each time the lease is renewed.
+type LeaseKeepAliveResponse struct {
+	ID  LeaseID
+	TTL int64
+}
+
+// EventType is the type of a WatchEvent.
+type EventType int32
+
+const (
+	// EventTypePut means the key is created or updated.
+	EventTypePut EventType = iota
+	// EventTypeDelete means the key is removed, either explicitly or by the expiry of its lease.
+	EventTypeDelete
+)
+
+// KeyValue is a key-value pair carried by WatchEvent, Key is the full path of the key.
+type KeyValue struct {
+	Key            []byte
+	Value          []byte
+	CreateRevision int64
+	ModRevision    int64
+	Version        int64
+	Lease          LeaseID
+}
+
+// WatchEvent is a change of a watched key.
+// PrevKv is only filled by WatchWithRevision.
+type WatchEvent struct {
+	Type   EventType
+	Kv     *KeyValue
+	PrevKv *KeyValue
+}
+
+// WatchResponse contains the events happened at the same revision.
+// Watch and WatchWithPrefix send a response with Created set once the watch is established.
+// If Canceled is true, the watch is aborted and Err tells the reason.
+type WatchResponse struct {
+	Events   []*WatchEvent
+	Revision int64
+	Created  bool
+	Canceled bool
+	Err      error
+}
+
+// WatchChan is the channel returned by the watch operations of MetaKv.
+type WatchChan <-chan WatchResponse
+
+// SaveOptions are the options of the conditional saves of MetaKv.
+type SaveOptions struct {
+	Lease LeaseID
+}
+
+// SaveOption sets a field of SaveOptions.
+type SaveOption func(*SaveOptions)
+
+// WithLease attaches the saved key to a lease, the key is removed when the lease expires.
+func WithLease(id LeaseID) SaveOption {
+	return func(opts *SaveOptions) {
+		opts.Lease = id
+	}
+}
+
 // MetaKv is TxnKV for meta data. It should save data with lease.
 type MetaKv interface {
 	TxnKV
@@ -45,14 +110,14 @@ type MetaKv interface {
 	LoadWithPrefix(key string) ([]string, []string, error)
 	LoadWithPrefix2(key string) ([]string, []string, []int64, error)
 	LoadWithRevision(key string) ([]string, []string, int64, error)
-	Watch(key string) clientv3.WatchChan
-	WatchWithPrefix(key string) clientv3.WatchChan
-	WatchWithRevision(key string, revision int64) clientv3.WatchChan
-	SaveWithLease(key, value string, id clientv3.LeaseID) error
-	Grant(ttl int64) (id clientv3.LeaseID, err error)
-	KeepAlive(id clientv3.LeaseID) (<-chan *clientv3.LeaseKeepAliveResponse, error)
-	CompareValueAndSwap(key, value, target string, opts ...clientv3.OpOption) error
-	CompareVersionAndSwap(key string, version int64, target string, opts ...clientv3.OpOption) error
+	Watch(key string) WatchChan
+	WatchWithPrefix(key string) WatchChan
+	WatchWithRevision(key string, revision int64) WatchChan
+	SaveWithLease(key, value string, id LeaseID) error
+	Grant(ttl int64) (id LeaseID, err error)
+	KeepAlive(id LeaseID) (<-chan *LeaseKeepAliveResponse, error)
+	CompareValueAndSwap(key, value, target string, opts ...SaveOption) error
+	CompareVersionAndSwap(key string, version int64, target string, opts ...SaveOption) error
 }
 
 // SnapShotKV is TxnKV for snapshot data. It must save timestamp.
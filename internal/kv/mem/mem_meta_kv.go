@@ -0,0 +1,718 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package memkv
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/btree"
+	milvuskv "github.com/milvus-io/milvus/internal/kv"
+	"github.com/milvus-io/milvus/internal/log"
+	"go.uber.org/zap"
+)
+
+const (
+	// metaStorePrefix is the prefix of the keys persisted in the backend of MetaStore
+	metaStorePrefix = "metastore/"
+	// metaStoreHistorySize is the number of revisions kept for WatchWithRevision
+	metaStoreHistorySize = 1000
+)
+
+// leaseCheckInterval is the interval to remove the keys of expired leases
+var leaseCheckInterval = 500 * time.Millisecond
+
+// ErrCompacted is sent by WatchWithRevision when the required revision is no longer kept.
+var ErrCompacted = errors.New("required revision has been compacted")
+
+type metaItem struct {
+	key            string
+	value          string
+	createRevision int64
+	modRevision    int64
+	version        int64
+	lease          milvuskv.LeaseID
+}
+
+func (item *metaItem) Less(than btree.Item) bool {
+	return item.key < than.(*metaItem).key
+}
+
+func (item *metaItem) keyValue() *milvuskv.KeyValue {
+	return &milvuskv.KeyValue{
+		Key:            []byte(item.key),
+		Value:          []byte(item.value),
+		CreateRevision: item.createRevision,
+		ModRevision:    item.modRevision,
+		Version:        item.version,
+		Lease:          item.lease,
+	}
+}
+
+type metaLease struct {
+	ttl      int64
+	expireAt time.Time
+	keys     map[string]struct{}
+}
+
+// metaOp is a put or a delete of a key, all the ops of a commit share one revision.
+type metaOp struct {
+	key    string
+	value  string
+	lease  milvuskv.LeaseID
+	delete bool
+}
+
+type metaWatcher struct {
+	key    string
+	prefix bool
+	prevKV bool
+
+	ch     chan milvuskv.WatchResponse
+	mu     sync.Mutex
+	queue  []milvuskv.WatchResponse
+	notify chan struct{}
+	done   chan struct{}
+	once   sync.Once
+}
+
+func newMetaWatcher(key string, prefix, prevKV bool) *metaWatcher {
+	w := &metaWatcher{
+		key:    key,
+		prefix: prefix,
+		prevKV: prevKV,
+		ch:     make(chan milvuskv.WatchResponse),
+		notify: make(chan struct{}, 1),
+		done:   make(chan struct{}),
+	}
+	go w.run()
+	return w
+}
+
+// run forwards the queued responses to the watch channel, so that a slow reader never blocks the store.
+func (w *metaWatcher) run() {
+	defer close(w.ch)
+	for {
+		select {
+		case <-w.notify:
+		case <-w.done:
+			return
+		}
+		w.mu.Lock()
+		queue := w.queue
+		w.queue = nil
+		w.mu.Unlock()
+		for _, resp := range queue {
+			select {
+			case w.ch <- resp:
+			case <-w.done:
+				return
+			}
+			if resp.Canceled {
+				return
+			}
+		}
+	}
+}
+
+func (w *metaWatcher) push(resp milvuskv.WatchResponse) {
+	w.mu.Lock()
+	w.queue = append(w.queue, resp)
+	w.mu.Unlock()
+	select {
+	case w.notify <- struct{}{}:
+	default:
+	}
+}
+
+func (w *metaWatcher) close() {
+	w.once.Do(func() {
+		close(w.done)
+	})
+}
+
+// filter returns the events of the watched keys, nil if there is none.
+func (w *metaWatcher) filter(events []*milvuskv.WatchEvent) []*milvuskv.WatchEvent {
+	var ret []*milvuskv.WatchEvent
+	for _, ev := range events {
+		key := string(ev.Kv.Key)
+		if (w.prefix && strings.HasPrefix(key, w.key)) || (!w.prefix && key == w.key) {
+			if !w.prevKV && ev.PrevKv != nil {
+				ev = &milvuskv.WatchEvent{Type: ev.Type, Kv: ev.Kv}
+			}
+			ret = append(ret, ev)
+		}
+	}
+	return ret
+}
+
+type metaRevision struct {
+	revision int64
+	events   []*milvuskv.WatchEvent
+}
+
+// MetaStore is an in-process metadata store with revisions, watches and leases, which serves
+// kv.MetaKv without an etcd server. All the MetaKV created from one MetaStore share its data.
+// If a backend is provided, the keys without lease are persisted into it and loaded back when
+// the MetaStore is created again; revisions and versions restart from 1 after reloading.
+type MetaStore struct {
+	mu        sync.Mutex
+	tree      *btree.BTree
+	revision  int64
+	history   []*metaRevision
+	leases    map[milvuskv.LeaseID]*metaLease
+	nextLease milvuskv.LeaseID
+	watchers  map[*metaWatcher]struct{}
+	backend   milvuskv.TxnKV
+
+	ctx       context.Context
+	cancel    context.CancelFunc
+	wg        sync.WaitGroup
+	closeOnce sync.Once
+}
+
+// NewMetaStore creates a MetaStore, backend can be nil to keep the data in memory only.
+func NewMetaStore(backend milvuskv.TxnKV) (*MetaStore, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	s := &MetaStore{
+		tree:     btree.New(2),
+		leases:   make(map[milvuskv.LeaseID]*metaLease),
+		watchers: make(map[*metaWatcher]struct{}),
+		backend:  backend,
+		ctx:      ctx,
+		cancel:   cancel,
+	}
+	if backend != nil {
+		keys, values, err := backend.LoadWithPrefix(metaStorePrefix)
+		if err != nil {
+			cancel()
+			return nil, err
+		}
+		if len(keys) > 0 {
+			s.revision = 1
+		}
+		for i, key := range keys {
+			s.tree.ReplaceOrInsert(&metaItem{
+				key:            strings.TrimPrefix(key, metaStorePrefix),
+				value:          values[i],
+				createRevision: s.revision,
+				modRevision:    s.revision,
+				version:        1,
+			})
+		}
+		log.Debug("MetaStore load from backend", zap.Int("keys", len(keys)))
+	}
+
+	s.wg.Add(1)
+	go s.leaseLoop()
+	return s, nil
+}
+
+// NewMetaKV returns a MetaKV on the store whose keys are relative to rootPath.
+func (s *MetaStore) NewMetaKV(rootPath string) *MetaKV {
+	ctx, cancel := context.WithCancel(s.ctx)
+	return &MetaKV{
+		store:    s,
+		rootPath: rootPath,
+		ctx:      ctx,
+		cancel:   cancel,
+		watchers: make(map[*metaWatcher]struct{}),
+	}
+}
+
+// Close stops all the watches and keep alives, and closes the backend.
+func (s *MetaStore) Close() {
+	s.closeOnce.Do(func() {
+		s.cancel()
+		s.wg.Wait()
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		for w := range s.watchers {
+			w.close()
+		}
+		s.watchers = make(map[*metaWatcher]struct{})
+		if s.backend != nil {
+			s.backend.Close()
+		}
+	})
+}
+
+func (s *MetaStore) get(key string) *metaItem {
+	item := s.tree.Get(&metaItem{key: key})
+	if item == nil {
+		return nil
+	}
+	return item.(*metaItem)
+}
+
+func (s *MetaStore) rangePrefix(prefix string, fn func(item *metaItem)) {
+	s.tree.AscendGreaterOrEqual(&metaItem{key: prefix}, func(i btree.Item) bool {
+		item := i.(*metaItem)
+		if !strings.HasPrefix(item.key, prefix) {
+			return false
+		}
+		fn(item)
+		return true
+	})
+}
+
+// deleteOps returns the ops to delete all the keys with the prefix, the caller must hold the lock.
+func (s *MetaStore) deleteOps(prefix string) []metaOp {
+	var ops []metaOp
+	s.rangePrefix(prefix, func(item *metaItem) {
+		ops = append(ops, metaOp{key: item.key, delete: true})
+	})
+	return ops
+}
+
+// commit applies ops at a new revision and notifies the watchers, the caller must hold the lock.
+// Nothing is changed if persisting into the backend fails.
+func (s *MetaStore) commit(ops []metaOp) error {
+	for _, op := range ops {
+		if !op.delete && op.lease != 0 {
+			if _, ok := s.leases[op.lease]; !ok {
+				return fmt.Errorf("lease %d not found", op.lease)
+			}
+		}
+	}
+
+	if s.backend != nil {
+		saves := make(map[string]string)
+		removals := make([]string, 0)
+		for _, op := range ops {
+			if op.delete || op.lease != 0 {
+				delete(saves, metaStorePrefix+op.key)
+				removals = append(removals, metaStorePrefix+op.key)
+			} else {
+				saves[metaStorePrefix+op.key] = op.value
+			}
+		}
+		if err := s.backend.MultiSaveAndRemove(saves, removals); err != nil {
+			return err
+		}
+	}
+
+	revision := s.revision + 1
+	events := make([]*milvuskv.WatchEvent, 0, len(ops))
+	for _, op := range ops {
+		prev := s.get(op.key)
+		if prev != nil && prev.lease != 0 {
+			if lease, ok := s.leases[prev.lease]; ok {
+				delete(lease.keys, op.key)
+			}
+		}
+		if op.delete {
+			if prev == nil {
+				continue
+			}
+			s.tree.Delete(prev)
+			events = append(events, &milvuskv.WatchEvent{
+				Type:   milvuskv.EventTypeDelete,
+				Kv:     &milvuskv.KeyValue{Key: []byte(op.key), ModRevision: revision},
+				PrevKv: prev.keyValue(),
+			})
+			continue
+		}
+		item := &metaItem{
+			key:            op.key,
+			value:          op.value,
+			createRevision: revision,
+			modRevision:    revision,
+			version:        1,
+			lease:          op.lease,
+		}
+		var prevKv *milvuskv.KeyValue
+		if prev != nil {
+			item.createRevision = prev.createRevision
+			item.version = prev.version + 1
+			prevKv = prev.keyValue()
+		}
+		s.tree.ReplaceOrInsert(item)
+		if op.lease != 0 {
+			s.leases[op.lease].keys[op.key] = struct{}{}
+		}
+		events = append(events, &milvuskv.WatchEvent{
+			Type:   milvuskv.EventTypePut,
+			Kv:     item.keyValue(),
+			PrevKv: prevKv,
+		})
+	}
+	if len(events) == 0 {
+		return nil
+	}
+
+	s.revision = revision
+	s.history = append(s.history, &metaRevision{revision: revision, events: events})
+	if len(s.history) > metaStoreHistorySize {
+		s.history = s.history[len(s.history)-metaStoreHistorySize:]
+	}
+	for w := range s.watchers {
+		if evs := w.filter(events); len(evs) > 0 {
+			w.push(milvuskv.WatchResponse{Events: evs, Revision: revision})
+		}
+	}
+	return nil
+}
+
+// watch registers a watcher, the events since revision are replayed if revision is positive.
+func (s *MetaStore) watch(key string, prefix bool, revision int64) *metaWatcher {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	w := newMetaWatcher(key, prefix, revision > 0)
+	select {
+	case <-s.ctx.Done():
+		w.close()
+		return w
+	default:
+	}
+	if revision <= 0 {
+		w.push(milvuskv.WatchResponse{Revision: s.revision, Created: true})
+		s.watchers[w] = struct{}{}
+		return w
+	}
+	if revision <= s.revision && (len(s.history) == 0 || revision < s.history[0].revision) {
+		w.push(milvuskv.WatchResponse{Revision: s.revision, Canceled: true, Err: ErrCompacted})
+		return w
+	}
+	for _, rev := range s.history {
+		if rev.revision < revision {
+			continue
+		}
+		if evs := w.filter(rev.events); len(evs) > 0 {
+			w.push(milvuskv.WatchResponse{Events: evs, Revision: rev.revision})
+		}
+	}
+	s.watchers[w] = struct{}{}
+	return w
+}
+
+func (s *MetaStore) unwatch(w *metaWatcher) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.watchers, w)
+	w.close()
+}
+
+func (s *MetaStore) grant(ttl int64) (milvuskv.LeaseID, error) {
+	if ttl <= 0 {
+		return 0, fmt.Errorf("invalid lease ttl %d", ttl)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.nextLease++
+	s.leases[s.nextLease] = &metaLease{
+		ttl:      ttl,
+		expireAt: time.Now().Add(time.Duration(ttl) * time.Second),
+		keys:     make(map[string]struct{}),
+	}
+	return s.nextLease, nil
+}
+
+// renew refreshes the expiry of the lease, false is returned if the lease does not exist.
+func (s *MetaStore) renew(id milvuskv.LeaseID) (int64, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	lease, ok := s.leases[id]
+	if !ok {
+		return 0, false
+	}
+	lease.expireAt = time.Now().Add(time.Duration(lease.ttl) * time.Second)
+	return lease.ttl, true
+}
+
+// leaseLoop removes the expired leases and the keys attached to them.
+func (s *MetaStore) leaseLoop() {
+	defer s.wg.Done()
+	ticker := time.NewTicker(leaseCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case now := <-ticker.C:
+			s.expireLeases(now)
+		}
+	}
+}
+
+func (s *MetaStore) expireLeases(now time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for id, lease := range s.leases {
+		if now.Before(lease.expireAt) {
+			continue
+		}
+		ops := make([]metaOp, 0, len(lease.keys))
+		for key := range lease.keys {
+			ops = append(ops, metaOp{key: key, delete: true})
+		}
+		if err := s.commit(ops); err != nil {
+			log.Warn("MetaStore failed to remove keys of expired lease", zap.Int64("lease", int64(id)), zap.Error(err))
+			continue
+		}
+		delete(s.leases, id)
+		log.Debug("MetaStore lease expired", zap.Int64("lease", int64(id)), zap.Int("keys", len(ops)))
+	}
+}
+
+// MetaKV implements kv.MetaKv on a MetaStore, keys are relative to its root path.
+type MetaKV struct {
+	store    *MetaStore
+	rootPath string
+
+	ctx      context.Context
+	cancel   context.CancelFunc
+	mu       sync.Mutex
+	watchers map[*metaWatcher]struct{}
+}
+
+var _ milvuskv.MetaKv = (*MetaKV)(nil)
+
+// Close stops the watches and keep alives started by this MetaKV, the store is left open.
+func (kv *MetaKV) Close() {
+	kv.cancel()
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+	for w := range kv.watchers {
+		kv.store.unwatch(w)
+	}
+	kv.watchers = make(map[*metaWatcher]struct{})
+}
+
+func (kv *MetaKV) GetPath(key string) string {
+	return path.Join(kv.rootPath, key)
+}
+
+func (kv *MetaKV) Load(key string) (string, error) {
+	key = path.Join(kv.rootPath, key)
+	kv.store.mu.Lock()
+	defer kv.store.mu.Unlock()
+	item := kv.store.get(key)
+	if item == nil {
+		return "", fmt.Errorf("there is no value on key = %s", key)
+	}
+	return item.value, nil
+}
+
+func (kv *MetaKV) MultiLoad(keys []string) ([]string, error) {
+	kv.store.mu.Lock()
+	defer kv.store.mu.Unlock()
+	result := make([]string, 0, len(keys))
+	invalid := make([]string, 0, len(keys))
+	for _, key := range keys {
+		item := kv.store.get(path.Join(kv.rootPath, key))
+		if item == nil {
+			invalid = append(invalid, key)
+			result = append(result, "")
+			continue
+		}
+		result = append(result, item.value)
+	}
+	if len(invalid) != 0 {
+		return result, fmt.Errorf("there are invalid keys: %s", invalid)
+	}
+	return result, nil
+}
+
+func (kv *MetaKV) LoadWithPrefix(key string) ([]string, []string, error) {
+	keys, values, _, _, err := kv.loadWithPrefix(key)
+	return keys, values, err
+}
+
+// LoadWithPrefix2 returns the versions of the keys besides the keys and values.
+func (kv *MetaKV) LoadWithPrefix2(key string) ([]string, []string, []int64, error) {
+	keys, values, versions, _, err := kv.loadWithPrefix(key)
+	return keys, values, versions, err
+}
+
+// LoadWithRevision returns the current revision of the store besides the keys and values.
+func (kv *MetaKV) LoadWithRevision(key string) ([]string, []string, int64, error) {
+	keys, values, _, revision, err := kv.loadWithPrefix(key)
+	return keys, values, revision, err
+}
+
+func (kv *MetaKV) loadWithPrefix(key string) ([]string, []string, []int64, int64, error) {
+	key = path.Join(kv.rootPath, key)
+	kv.store.mu.Lock()
+	defer kv.store.mu.Unlock()
+	keys := make([]string, 0)
+	values := make([]string, 0)
+	versions := make([]int64, 0)
+	kv.store.rangePrefix(key, func(item *metaItem) {
+		keys = append(keys, item.key)
+		values = append(values, item.value)
+		versions = append(versions, item.version)
+	})
+	return keys, values, versions, kv.store.revision, nil
+}
+
+func (kv *MetaKV) Save(key, value string) error {
+	return kv.SaveWithLease(key, value, 0)
+}
+
+// SaveWithLease saves the key-value with a lease, the key is removed when the lease expires.
+func (kv *MetaKV) SaveWithLease(key, value string, id milvuskv.LeaseID) error {
+	kv.store.mu.Lock()
+	defer kv.store.mu.Unlock()
+	return kv.store.commit([]metaOp{{key: path.Join(kv.rootPath, key), value: value, lease: id}})
+}
+
+func (kv *MetaKV) MultiSave(kvs map[string]string) error {
+	return kv.MultiSaveAndRemove(kvs, nil)
+}
+
+func (kv *MetaKV) Remove(key string) error {
+	return kv.MultiSaveAndRemove(nil, []string{key})
+}
+
+func (kv *MetaKV) MultiRemove(keys []string) error {
+	return kv.MultiSaveAndRemove(nil, keys)
+}
+
+func (kv *MetaKV) RemoveWithPrefix(prefix string) error {
+	return kv.MultiSaveAndRemoveWithPrefix(nil, []string{prefix})
+}
+
+func (kv *MetaKV) MultiRemoveWithPrefix(keys []string) error {
+	return kv.MultiSaveAndRemoveWithPrefix(nil, keys)
+}
+
+func (kv *MetaKV) MultiSaveAndRemove(saves map[string]string, removals []string) error {
+	ops := make([]metaOp, 0, len(saves)+len(removals))
+	for key, value := range saves {
+		ops = append(ops, metaOp{key: path.Join(kv.rootPath, key), value: value})
+	}
+	for _, key := range removals {
+		ops = append(ops, metaOp{key: path.Join(kv.rootPath, key), delete: true})
+	}
+	kv.store.mu.Lock()
+	defer kv.store.mu.Unlock()
+	return kv.store.commit(ops)
+}
+
+func (kv *MetaKV) MultiSaveAndRemoveWithPrefix(saves map[string]string, removals []string) error {
+	kv.store.mu.Lock()
+	defer kv.store.mu.Unlock()
+	ops := make([]metaOp, 0, len(saves))
+	for key, value := range saves {
+		ops = append(ops, metaOp{key: path.Join(kv.rootPath, key), value: value})
+	}
+	for _, prefix := range removals {
+		ops = append(ops, kv.store.deleteOps(path.Join(kv.rootPath, prefix))...)
+	}
+	return kv.store.commit(ops)
+}
+
+func (kv *MetaKV) Watch(key string) milvuskv.WatchChan {
+	return kv.watch(path.Join(kv.rootPath, key), false, 0)
+}
+
+func (kv *MetaKV) WatchWithPrefix(key string) milvuskv.WatchChan {
+	return kv.watch(path.Join(kv.rootPath, key), true, 0)
+}
+
+// WatchWithRevision watches the keys with the prefix from revision, and the events carry the previous key-values.
+func (kv *MetaKV) WatchWithRevision(key string, revision int64) milvuskv.WatchChan {
+	if revision <= 0 {
+		revision = 1
+	}
+	return kv.watch(path.Join(kv.rootPath, key), true, revision)
+}
+
+func (kv *MetaKV) watch(key string, prefix bool, revision int64) milvuskv.WatchChan {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+	w := kv.store.watch(key, prefix, revision)
+	select {
+	case <-kv.ctx.Done():
+		kv.store.unwatch(w)
+	default:
+		kv.watchers[w] = struct{}{}
+	}
+	return w.ch
+}
+
+// Grant creates a new lease which expires in ttl seconds unless it is kept alive.
+func (kv *MetaKV) Grant(ttl int64) (id milvuskv.LeaseID, err error) {
+	return kv.store.grant(ttl)
+}
+
+// KeepAlive renews the lease every third of its ttl until the MetaKV is closed.
+// The returned channel is closed if the lease is not found.
+func (kv *MetaKV) KeepAlive(id milvuskv.LeaseID) (<-chan *milvuskv.LeaseKeepAliveResponse, error) {
+	ttl, ok := kv.store.renew(id)
+	if !ok {
+		return nil, fmt.Errorf("lease %d not found", id)
+	}
+	ch := make(chan *milvuskv.LeaseKeepAliveResponse, 1)
+	ch <- &milvuskv.LeaseKeepAliveResponse{ID: id, TTL: ttl}
+	interval := time.Duration(ttl) * time.Second / 3
+	go func() {
+		defer close(ch)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-kv.ctx.Done():
+				return
+			case <-ticker.C:
+				ttl, ok := kv.store.renew(id)
+				if !ok {
+					return
+				}
+				select {
+				case ch <- &milvuskv.LeaseKeepAliveResponse{ID: id, TTL: ttl}:
+				default:
+				}
+			}
+		}
+	}()
+	return ch, nil
+}
+
+// CompareValueAndSwap saves target if the existing value of key equals value.
+func (kv *MetaKV) CompareValueAndSwap(key, value, target string, opts ...milvuskv.SaveOption) error {
+	return kv.compareAndSwap(key, target, func(item *metaItem) bool {
+		return item != nil && item.value == value
+	}, opts...)
+}
+
+// CompareVersionAndSwap saves target if the version of key equals version, the version of
+// a key which does not exist is 0.
+func (kv *MetaKV) CompareVersionAndSwap(key string, version int64, target string, opts ...milvuskv.SaveOption) error {
+	return kv.compareAndSwap(key, target, func(item *metaItem) bool {
+		if item == nil {
+			return version == 0
+		}
+		return item.version == version
+	}, opts...)
+}
+
+func (kv *MetaKV) compareAndSwap(key, target string, cmp func(item *metaItem) bool, opts ...milvuskv.SaveOption) error {
+	saveOpts := &milvuskv.SaveOptions{}
+	for _, opt := range opts {
+		opt(saveOpts)
+	}
+	fullKey := path.Join(kv.rootPath, key)
+	kv.store.mu.Lock()
+	defer kv.store.mu.Unlock()
+	if !cmp(kv.store.get(fullKey)) {
+		return fmt.Errorf("function CompareAndSwap error for compare is false for key: %s", key)
+	}
+	return kv.store.commit([]metaOp{{key: fullKey, value: target, lease: saveOpts.Lease}})
+}
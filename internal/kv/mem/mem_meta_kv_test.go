@@ -0,0 +1,234 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package memkv
+
+import (
+	"testing"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/kv"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func receiveWatchResponse(t *testing.T, ch kv.WatchChan) kv.WatchResponse {
+	select {
+	case resp, ok := <-ch:
+		require.True(t, ok)
+		return resp
+	case <-time.After(5 * time.Second):
+		t.Fatal("no watch response received")
+	}
+	return kv.WatchResponse{}
+}
+
+func TestMetaKV_SaveLoad(t *testing.T) {
+	store, err := NewMetaStore(nil)
+	require.NoError(t, err)
+	defer store.Close()
+	metaKV := store.NewMetaKV("/test/root")
+	defer metaKV.Close()
+
+	assert.Equal(t, "/test/root/a", metaKV.GetPath("a"))
+
+	err = metaKV.MultiSave(map[string]string{"a/b": "v1", "a/c": "v2", "b": "v3"})
+	assert.NoError(t, err)
+
+	value, err := metaKV.Load("a/b")
+	assert.NoError(t, err)
+	assert.Equal(t, "v1", value)
+	_, err = metaKV.Load("a")
+	assert.Error(t, err)
+
+	values, err := metaKV.MultiLoad([]string{"a/b", "b"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"v1", "v3"}, values)
+	_, err = metaKV.MultiLoad([]string{"a/b", "c"})
+	assert.Error(t, err)
+
+	keys, values, versions, err := metaKV.LoadWithPrefix2("a")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"/test/root/a/b", "/test/root/a/c"}, keys)
+	assert.Equal(t, []string{"v1", "v2"}, values)
+	assert.Equal(t, []int64{1, 1}, versions)
+
+	err = metaKV.Save("a/b", "v4")
+	assert.NoError(t, err)
+	_, _, versions, err = metaKV.LoadWithPrefix2("a/b")
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{2}, versions)
+
+	err = metaKV.MultiSaveAndRemove(map[string]string{"c": "v5"}, []string{"b"})
+	assert.NoError(t, err)
+	keys, _, err = metaKV.LoadWithPrefix("")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"/test/root/a/b", "/test/root/a/c", "/test/root/c"}, keys)
+
+	err = metaKV.RemoveWithPrefix("a")
+	assert.NoError(t, err)
+	keys, _, _, err = metaKV.LoadWithRevision("")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"/test/root/c"}, keys)
+
+	// another MetaKV shares the data of the store
+	otherKV := store.NewMetaKV("/test")
+	defer otherKV.Close()
+	value, err = otherKV.Load("root/c")
+	assert.NoError(t, err)
+	assert.Equal(t, "v5", value)
+}
+
+func TestMetaKV_CompareAndSwap(t *testing.T) {
+	store, err := NewMetaStore(nil)
+	require.NoError(t, err)
+	defer store.Close()
+	metaKV := store.NewMetaKV("/test/root")
+	defer metaKV.Close()
+
+	err = metaKV.CompareVersionAndSwap("a/b/c", 0, "1")
+	assert.NoError(t, err)
+	value, err := metaKV.Load("a/b/c")
+	assert.NoError(t, err)
+	assert.Equal(t, "1", value)
+
+	err = metaKV.CompareVersionAndSwap("a/b/c", 0, "1")
+	assert.Error(t, err)
+	err = metaKV.CompareVersionAndSwap("a/b/c", 1, "2")
+	assert.NoError(t, err)
+
+	err = metaKV.CompareValueAndSwap("a/b/c", "2", "3")
+	assert.NoError(t, err)
+	err = metaKV.CompareValueAndSwap("a/b/c", "2", "3")
+	assert.Error(t, err)
+	err = metaKV.CompareValueAndSwap("x", "", "3")
+	assert.Error(t, err)
+}
+
+func TestMetaKV_Watch(t *testing.T) {
+	store, err := NewMetaStore(nil)
+	require.NoError(t, err)
+	defer store.Close()
+	metaKV := store.NewMetaKV("/test/root")
+
+	ch := metaKV.WatchWithPrefix("x")
+	resp := receiveWatchResponse(t, ch)
+	assert.True(t, resp.Created)
+	keyCh := metaKV.Watch("x/a")
+	resp = receiveWatchResponse(t, keyCh)
+	assert.True(t, resp.Created)
+
+	err = metaKV.MultiSave(map[string]string{"x/a": "1", "x/b": "2", "y": "3"})
+	assert.NoError(t, err)
+	resp = receiveWatchResponse(t, ch)
+	assert.Equal(t, 2, len(resp.Events))
+	revision := resp.Revision
+	resp = receiveWatchResponse(t, keyCh)
+	assert.Equal(t, 1, len(resp.Events))
+	assert.Equal(t, kv.EventTypePut, resp.Events[0].Type)
+	assert.Equal(t, "/test/root/x/a", string(resp.Events[0].Kv.Key))
+	assert.Equal(t, "1", string(resp.Events[0].Kv.Value))
+
+	err = metaKV.Remove("x/a")
+	assert.NoError(t, err)
+	resp = receiveWatchResponse(t, ch)
+	assert.Equal(t, 1, len(resp.Events))
+	assert.Equal(t, kv.EventTypeDelete, resp.Events[0].Type)
+	assert.Nil(t, resp.Events[0].PrevKv)
+
+	// events since revision are replayed with the previous key-values
+	revCh := metaKV.WatchWithRevision("x", revision)
+	resp = receiveWatchResponse(t, revCh)
+	assert.Equal(t, revision, resp.Revision)
+	assert.Equal(t, 2, len(resp.Events))
+	resp = receiveWatchResponse(t, revCh)
+	assert.Equal(t, kv.EventTypeDelete, resp.Events[0].Type)
+	assert.Equal(t, "1", string(resp.Events[0].PrevKv.Value))
+
+	metaKV.Close()
+	_, ok := <-ch
+	assert.False(t, ok)
+	_, ok = <-revCh
+	assert.False(t, ok)
+}
+
+func TestMetaKV_Lease(t *testing.T) {
+	interval := leaseCheckInterval
+	leaseCheckInterval = 10 * time.Millisecond
+	defer func() { leaseCheckInterval = interval }()
+
+	store, err := NewMetaStore(nil)
+	require.NoError(t, err)
+	defer store.Close()
+	metaKV := store.NewMetaKV("/test/root")
+	defer metaKV.Close()
+
+	_, err = metaKV.Grant(0)
+	assert.Error(t, err)
+	err = metaKV.SaveWithLease("a", "1", kv.LeaseID(999))
+	assert.Error(t, err)
+	_, err = metaKV.KeepAlive(kv.LeaseID(999))
+	assert.Error(t, err)
+
+	kept, err := metaKV.Grant(1)
+	assert.NoError(t, err)
+	keepAliveCh, err := metaKV.KeepAlive(kept)
+	assert.NoError(t, err)
+	resp := <-keepAliveCh
+	assert.Equal(t, kept, resp.ID)
+	err = metaKV.SaveWithLease("kept", "1", kept)
+	assert.NoError(t, err)
+
+	expired, err := metaKV.Grant(1)
+	assert.NoError(t, err)
+	err = metaKV.CompareVersionAndSwap("expired", 0, "1", kv.WithLease(expired))
+	assert.NoError(t, err)
+
+	ch := metaKV.WatchWithPrefix("")
+	receiveWatchResponse(t, ch)
+	watchResp := receiveWatchResponse(t, ch)
+	assert.Equal(t, 1, len(watchResp.Events))
+	assert.Equal(t, kv.EventTypeDelete, watchResp.Events[0].Type)
+	assert.Equal(t, "/test/root/expired", string(watchResp.Events[0].Kv.Key))
+
+	_, err = metaKV.Load("kept")
+	assert.NoError(t, err)
+	err = metaKV.SaveWithLease("b", "1", expired)
+	assert.Error(t, err)
+}
+
+func TestMetaStore_Backend(t *testing.T) {
+	backend := NewMemoryKV()
+	store, err := NewMetaStore(backend)
+	require.NoError(t, err)
+	metaKV := store.NewMetaKV("/test/root")
+
+	err = metaKV.MultiSave(map[string]string{"a": "1", "b": "2"})
+	assert.NoError(t, err)
+	leaseID, err := metaKV.Grant(10)
+	assert.NoError(t, err)
+	err = metaKV.SaveWithLease("session", "1", leaseID)
+	assert.NoError(t, err)
+	err = metaKV.Remove("b")
+	assert.NoError(t, err)
+	metaKV.Close()
+
+	// the keys without lease are loaded back
+	store, err = NewMetaStore(backend)
+	require.NoError(t, err)
+	defer store.Close()
+	metaKV = store.NewMetaKV("/test/root")
+	defer metaKV.Close()
+	keys, values, err := metaKV.LoadWithPrefix("")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"/test/root/a"}, keys)
+	assert.Equal(t, []string{"1"}, values)
+}
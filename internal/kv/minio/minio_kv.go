@@ -43,6 +43,23 @@ type Option struct {
 
 // NewMinIOKV creates MinIOKV to save and load object to MinIOKV.
 func NewMinIOKV(ctx context.Context, option *Option) (*MinIOKV, error) {
+	minIOClient, err := NewMinIOClient(ctx, option)
+	if err != nil {
+		return nil, err
+	}
+
+	kv := &MinIOKV{
+		ctx:         ctx,
+		minioClient: minIOClient,
+		bucketName:  option.BucketName,
+	}
+	log.Debug("MinioKV new MinioKV success.")
+
+	return kv, nil
+}
+
+// NewMinIOClient creates a client of the S3 compatible storage, and makes sure the bucket exists.
+func NewMinIOClient(ctx context.Context, option *Option) (*minio.Client, error) {
 	var minIOClient *minio.Client
 	var err error
 	log.Debug("MinioKV NewMinioKV", zap.Any("option", option))
@@ -75,15 +92,7 @@ func NewMinIOKV(ctx context.Context, option *Option) (*MinIOKV, error) {
 	if err != nil {
 		return nil, err
 	}
-
-	kv := &MinIOKV{
-		ctx:         ctx,
-		minioClient: minIOClient,
-		bucketName:  option.BucketName,
-	}
-	log.Debug("MinioKV new MinioKV success.")
-
-	return kv, nil
+	return minIOClient, nil
 }
 
 // Exist check whether a key exists in MinIO.
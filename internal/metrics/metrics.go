@@ -16,6 +16,8 @@ const (
 	subSystemDataCoord = "dataCoord"
 	subSystemDataNode  = "dataNode"
 	subSystemProxy     = "proxy"
+	subSystemQueryNode = "queryNode"
+	subSystemRocksMQ   = "rocksmq"
 )
 
 var (
@@ -130,6 +132,15 @@ var (
 			Help:      "Counter of drop index",
 		}, []string{"client_id", "type"})
 
+	// RootCoordAlterIndexCounter used to count the num of calls of AlterIndex
+	RootCoordAlterIndexCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemRootCoord,
+			Name:      "alter_index_total",
+			Help:      "Counter of alter index",
+		}, []string{"client_id", "type"})
+
 	// RootCoordDescribeIndexCounter used to count the num of calls of DescribeIndex
 	RootCoordDescribeIndexCounter = prometheus.NewCounterVec(
 		prometheus.CounterOpts{
@@ -177,6 +188,15 @@ var (
 			Name:      "dd_channel_time_tick",
 			Help:      "Time tick of dd Channel in 24H",
 		})
+
+	// RootCoordQuotaFactor used to show the throttling factors computed by the quota center
+	RootCoordQuotaFactor = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemRootCoord,
+			Name:      "quota_factor",
+			Help:      "Throttling factors pushed to proxies, 1 means no throttling and 0 means denied",
+		}, []string{"type"})
 )
 
 //RegisterRootCoord register RootCoord metrics
@@ -195,6 +215,7 @@ func RegisterRootCoord() {
 	prometheus.MustRegister(RootCoordShowPartitionsCounter)
 	prometheus.MustRegister(RootCoordCreateIndexCounter)
 	prometheus.MustRegister(RootCoordDropIndexCounter)
+	prometheus.MustRegister(RootCoordAlterIndexCounter)
 	prometheus.MustRegister(RootCoordDescribeIndexCounter)
 	prometheus.MustRegister(RootCoordDescribeSegmentCounter)
 	prometheus.MustRegister(RootCoordShowSegmentsCounter)
@@ -202,6 +223,9 @@ func RegisterRootCoord() {
 	// for time tick
 	prometheus.MustRegister(RootCoordInsertChannelTimeTick)
 	prometheus.MustRegister(RootCoordDDChannelTimeTick)
+
+	// for quota center
+	prometheus.MustRegister(RootCoordQuotaFactor)
 	//prometheus.MustRegister(PanicCounter)
 }
 
@@ -520,6 +544,24 @@ var (
 			Name:      "dml_channels_time_tick",
 			Help:      "Time tick of dml channels",
 		}, []string{"pchan"})
+
+	// ProxyRateLimitedCounter used to count the num of requests rejected by the rate limiter
+	ProxyRateLimitedCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemProxy,
+			Name:      "rate_limited_total",
+			Help:      "Counter of requests rejected by rate limit",
+		}, []string{"rate_type", "collection"})
+
+	// ProxyRateLimitGauge used to show the current rate limits, a negative value means no limit
+	ProxyRateLimitGauge = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemProxy,
+			Name:      "rate_limit",
+			Help:      "Current rate limits",
+		}, []string{"rate_type", "scope"})
 )
 
 //RegisterProxy register Proxy metrics
@@ -569,6 +611,9 @@ func RegisterProxy() {
 	prometheus.MustRegister(ProxyReleaseDQLMessageStreamCounter)
 
 	prometheus.MustRegister(ProxyDmlChannelTimeTick)
+
+	prometheus.MustRegister(ProxyRateLimitedCounter)
+	prometheus.MustRegister(ProxyRateLimitGauge)
 }
 
 //RegisterQueryCoord register QueryCoord metrics
@@ -576,9 +621,40 @@ func RegisterQueryCoord() {
 
 }
 
+var (
+	// QueryNodeDiskCacheAccessCounter counts the reads of the disk cache of remote chunks, by hit or miss
+	QueryNodeDiskCacheAccessCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemQueryNode,
+			Name:      "disk_cache_access_total",
+			Help:      "Counter of disk cache reads",
+		}, []string{"result"})
+
+	// QueryNodeDiskCacheEvictedCounter counts the chunks evicted from the disk cache
+	QueryNodeDiskCacheEvictedCounter = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemQueryNode,
+			Name:      "disk_cache_evicted_total",
+			Help:      "Counter of chunks evicted from disk cache",
+		})
+
+	// QueryNodeDiskCacheUsedSize records the total size of the chunks in the disk cache
+	QueryNodeDiskCacheUsedSize = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemQueryNode,
+			Name:      "disk_cache_used_bytes",
+			Help:      "Size of chunks in disk cache",
+		})
+)
+
 //RegisterQueryNode register QueryNode metrics
 func RegisterQueryNode() {
-
+	prometheus.MustRegister(QueryNodeDiskCacheAccessCounter)
+	prometheus.MustRegister(QueryNodeDiskCacheEvictedCounter)
+	prometheus.MustRegister(QueryNodeDiskCacheUsedSize)
 }
 
 var (
@@ -639,6 +715,52 @@ func RegisterMsgStreamCoord() {
 
 }
 
+var (
+	// RocksmqRetentionCleanupCounter counts the retention expired checks of each topic
+	RocksmqRetentionCleanupCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemRocksMQ,
+			Name:      "retention_cleanup_total",
+			Help:      "Counter of retention expired checks",
+		}, []string{"topic", "status"})
+
+	// RocksmqRetentionDeletedSize counts the size of acked messages removed by retention
+	RocksmqRetentionDeletedSize = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemRocksMQ,
+			Name:      "retention_deleted_bytes_total",
+			Help:      "Size of acked messages removed by retention",
+		}, []string{"topic"})
+
+	// RocksmqAckedSize records the size of acked messages which are still retained
+	RocksmqAckedSize = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemRocksMQ,
+			Name:      "acked_bytes",
+			Help:      "Size of acked messages retained in rocksmq",
+		}, []string{"topic"})
+
+	// RocksmqLastRetentionTime records the last time that retention removed messages of topic
+	RocksmqLastRetentionTime = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemRocksMQ,
+			Name:      "last_retention_timestamp_seconds",
+			Help:      "Last time that retention removed messages",
+		}, []string{"topic"})
+)
+
+//RegisterRocksMQ register RocksMQ metrics
+func RegisterRocksMQ() {
+	prometheus.MustRegister(RocksmqRetentionCleanupCounter)
+	prometheus.MustRegister(RocksmqRetentionDeletedSize)
+	prometheus.MustRegister(RocksmqAckedSize)
+	prometheus.MustRegister(RocksmqLastRetentionTime)
+}
+
 //ServeHTTP serve prometheus http service
 func ServeHTTP() {
 	http.Handle("/metrics", promhttp.Handler())
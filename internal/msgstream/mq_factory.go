@@ -13,6 +13,7 @@ package msgstream
 
 import (
 	"context"
+	"errors"
 
 	"github.com/apache/pulsar-client-go/pulsar"
 	"github.com/mitchellh/mapstructure"
@@ -26,9 +27,10 @@ import (
 type PmsFactory struct {
 	dispatcherFactory ProtoUDFactory
 	// the following members must be public, so that mapstructure.Decode() can access them
-	PulsarAddress  string
-	ReceiveBufSize int64
-	PulsarBufSize  int64
+	PulsarAddress    string
+	PulsarWebAddress string
+	ReceiveBufSize   int64
+	PulsarBufSize    int64
 }
 
 // SetParams is used to set parameters for PmsFactory
@@ -63,6 +65,14 @@ func (f *PmsFactory) NewQueryMsgStream(ctx context.Context) (MsgStream, error) {
 	return f.NewMsgStream(ctx)
 }
 
+// NewAdmin is used to generate an admin managing pulsar topics through the pulsar web service
+func (f *PmsFactory) NewAdmin() (mqclient.Admin, error) {
+	if f.PulsarWebAddress == "" {
+		return nil, errors.New("PulsarWebAddress is empty")
+	}
+	return mqclient.NewPulsarAdmin(f.PulsarWebAddress), nil
+}
+
 // NewPmsFactory is used to generate a new PmsFactory object
 func NewPmsFactory() Factory {
 	f := &PmsFactory{
@@ -92,7 +102,7 @@ func (f *RmsFactory) SetParams(params map[string]interface{}) error {
 
 // NewMsgStream is used to generate a new Msgstream object
 func (f *RmsFactory) NewMsgStream(ctx context.Context) (MsgStream, error) {
-	rmqClient, err := mqclient.NewRmqClient(rocksmq.ClientOptions{Server: rocksmqserver.Rmq})
+	rmqClient, err := mqclient.NewRmqClient(rocksmq.ClientOptions{Server: rocksmqserver.Rmq, TopicRetentions: rocksmqserver.TopicRetentions})
 	if err != nil {
 		return nil, err
 	}
@@ -101,7 +111,7 @@ func (f *RmsFactory) NewMsgStream(ctx context.Context) (MsgStream, error) {
 
 // NewTtMsgStream is used to generate a new TtMsgstream object
 func (f *RmsFactory) NewTtMsgStream(ctx context.Context) (MsgStream, error) {
-	rmqClient, err := mqclient.NewRmqClient(rocksmq.ClientOptions{Server: rocksmqserver.Rmq})
+	rmqClient, err := mqclient.NewRmqClient(rocksmq.ClientOptions{Server: rocksmqserver.Rmq, TopicRetentions: rocksmqserver.TopicRetentions})
 	if err != nil {
 		return nil, err
 	}
@@ -110,13 +120,18 @@ func (f *RmsFactory) NewTtMsgStream(ctx context.Context) (MsgStream, error) {
 
 // NewQueryMsgStream is used to generate a new QueryMsgstream object
 func (f *RmsFactory) NewQueryMsgStream(ctx context.Context) (MsgStream, error) {
-	rmqClient, err := mqclient.NewRmqClient(rocksmq.ClientOptions{Server: rocksmqserver.Rmq})
+	rmqClient, err := mqclient.NewRmqClient(rocksmq.ClientOptions{Server: rocksmqserver.Rmq, TopicRetentions: rocksmqserver.TopicRetentions})
 	if err != nil {
 		return nil, err
 	}
 	return NewMqMsgStream(ctx, f.ReceiveBufSize, f.RmqBufSize, rmqClient, f.dispatcherFactory.NewUnmarshalDispatcher())
 }
 
+// NewAdmin is used to generate an admin managing rocksmq topics
+func (f *RmsFactory) NewAdmin() (mqclient.Admin, error) {
+	return mqclient.NewRmqAdmin(rocksmqserver.Rmq), nil
+}
+
 // NewRmsFactory is used to generate a new RmsFactory object
 func NewRmsFactory() Factory {
 	f := &RmsFactory{
@@ -128,3 +143,54 @@ func NewRmsFactory() Factory {
 	rocksmqserver.InitRocksMQ()
 	return f
 }
+
+// KmsFactory is a kafka msgstream factory that implemented Factory interface(msgstream.go)
+type KmsFactory struct {
+	dispatcherFactory ProtoUDFactory
+	// the following members must be public, so that mapstructure.Decode() can access them
+	KafkaBrokerList string
+	ReceiveBufSize  int64
+	KafkaBufSize    int64
+}
+
+// SetParams is used to set parameters for KmsFactory
+func (f *KmsFactory) SetParams(params map[string]interface{}) error {
+	err := mapstructure.Decode(params, f)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// NewMsgStream is used to generate a new Msgstream object
+func (f *KmsFactory) NewMsgStream(ctx context.Context) (MsgStream, error) {
+	kafkaClient := mqclient.NewKafkaClientInstance(f.KafkaBrokerList)
+	return NewMqMsgStream(ctx, f.ReceiveBufSize, f.KafkaBufSize, kafkaClient, f.dispatcherFactory.NewUnmarshalDispatcher())
+}
+
+// NewTtMsgStream is used to generate a new TtMsgstream object
+func (f *KmsFactory) NewTtMsgStream(ctx context.Context) (MsgStream, error) {
+	kafkaClient := mqclient.NewKafkaClientInstance(f.KafkaBrokerList)
+	return NewMqTtMsgStream(ctx, f.ReceiveBufSize, f.KafkaBufSize, kafkaClient, f.dispatcherFactory.NewUnmarshalDispatcher())
+}
+
+// NewQueryMsgStream is used to generate a new QueryMsgstream object
+func (f *KmsFactory) NewQueryMsgStream(ctx context.Context) (MsgStream, error) {
+	return f.NewMsgStream(ctx)
+}
+
+// NewAdmin is used to generate an admin managing kafka topics
+func (f *KmsFactory) NewAdmin() (mqclient.Admin, error) {
+	return mqclient.NewKafkaAdmin(f.KafkaBrokerList)
+}
+
+// NewKmsFactory is used to generate a new KmsFactory object connecting to the kafka brokers
+func NewKmsFactory(brokerList string) Factory {
+	f := &KmsFactory{
+		dispatcherFactory: ProtoUDFactory{},
+		KafkaBrokerList:   brokerList,
+		ReceiveBufSize:    64,
+		KafkaBufSize:      64,
+	}
+	return f
+}
@@ -87,3 +87,27 @@ func TestRmsFactory_SetParams(t *testing.T) {
 	err := rmsFactory.SetParams(m)
 	assert.NotNil(t, err)
 }
+
+func TestKmsFactory(t *testing.T) {
+	kafkaBrokerList, _ := Params.Load("_KafkaBrokerList")
+	kmsFactory := NewKmsFactory(kafkaBrokerList)
+
+	m := map[string]interface{}{
+		"ReceiveBufSize": 1024,
+		"KafkaBufSize":   1024,
+	}
+	err := kmsFactory.SetParams(m)
+	assert.Nil(t, err)
+	assert.Equal(t, kafkaBrokerList, kmsFactory.(*KmsFactory).KafkaBrokerList)
+	assert.Equal(t, int64(1024), kmsFactory.(*KmsFactory).KafkaBufSize)
+
+	ctx := context.Background()
+	_, err = kmsFactory.NewMsgStream(ctx)
+	assert.Nil(t, err)
+
+	_, err = kmsFactory.NewTtMsgStream(ctx)
+	assert.Nil(t, err)
+
+	_, err = kmsFactory.NewQueryMsgStream(ctx)
+	assert.Nil(t, err)
+}
@@ -70,3 +70,8 @@ type Factory interface {
 	NewTtMsgStream(ctx context.Context) (MsgStream, error)
 	NewQueryMsgStream(ctx context.Context) (MsgStream, error)
 }
+
+// AdminFactory is implemented by the Factory whose message queue supports managing topics and subscriptions
+type AdminFactory interface {
+	NewAdmin() (mqclient.Admin, error)
+}
@@ -23,32 +23,46 @@ const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 type ErrorCode int32
 
 const (
-	ErrorCode_Success               ErrorCode = 0
-	ErrorCode_UnexpectedError       ErrorCode = 1
-	ErrorCode_ConnectFailed         ErrorCode = 2
-	ErrorCode_PermissionDenied      ErrorCode = 3
-	ErrorCode_CollectionNotExists   ErrorCode = 4
-	ErrorCode_IllegalArgument       ErrorCode = 5
-	ErrorCode_IllegalDimension      ErrorCode = 7
-	ErrorCode_IllegalIndexType      ErrorCode = 8
-	ErrorCode_IllegalCollectionName ErrorCode = 9
-	ErrorCode_IllegalTOPK           ErrorCode = 10
-	ErrorCode_IllegalRowRecord      ErrorCode = 11
-	ErrorCode_IllegalVectorID       ErrorCode = 12
-	ErrorCode_IllegalSearchResult   ErrorCode = 13
-	ErrorCode_FileNotFound          ErrorCode = 14
-	ErrorCode_MetaFailed            ErrorCode = 15
-	ErrorCode_CacheFailed           ErrorCode = 16
-	ErrorCode_CannotCreateFolder    ErrorCode = 17
-	ErrorCode_CannotCreateFile      ErrorCode = 18
-	ErrorCode_CannotDeleteFolder    ErrorCode = 19
-	ErrorCode_CannotDeleteFile      ErrorCode = 20
-	ErrorCode_BuildIndexError       ErrorCode = 21
-	ErrorCode_IllegalNLIST          ErrorCode = 22
-	ErrorCode_IllegalMetricType     ErrorCode = 23
-	ErrorCode_OutOfMemory           ErrorCode = 24
-	ErrorCode_IndexNotExist         ErrorCode = 25
-	ErrorCode_EmptyCollection       ErrorCode = 26
+	ErrorCode_Success                 ErrorCode = 0
+	ErrorCode_UnexpectedError         ErrorCode = 1
+	ErrorCode_ConnectFailed           ErrorCode = 2
+	ErrorCode_PermissionDenied        ErrorCode = 3
+	ErrorCode_CollectionNotExists     ErrorCode = 4
+	ErrorCode_IllegalArgument         ErrorCode = 5
+	ErrorCode_IllegalDimension        ErrorCode = 7
+	ErrorCode_IllegalIndexType        ErrorCode = 8
+	ErrorCode_IllegalCollectionName   ErrorCode = 9
+	ErrorCode_IllegalTOPK             ErrorCode = 10
+	ErrorCode_IllegalRowRecord        ErrorCode = 11
+	ErrorCode_IllegalVectorID         ErrorCode = 12
+	ErrorCode_IllegalSearchResult     ErrorCode = 13
+	ErrorCode_FileNotFound            ErrorCode = 14
+	ErrorCode_MetaFailed              ErrorCode = 15
+	ErrorCode_CacheFailed             ErrorCode = 16
+	ErrorCode_CannotCreateFolder      ErrorCode = 17
+	ErrorCode_CannotCreateFile        ErrorCode = 18
+	ErrorCode_CannotDeleteFolder      ErrorCode = 19
+	ErrorCode_CannotDeleteFile        ErrorCode = 20
+	ErrorCode_BuildIndexError         ErrorCode = 21
+	ErrorCode_IllegalNLIST            ErrorCode = 22
+	ErrorCode_IllegalMetricType       ErrorCode = 23
+	ErrorCode_OutOfMemory             ErrorCode = 24
+	ErrorCode_IndexNotExist           ErrorCode = 25
+	ErrorCode_EmptyCollection         ErrorCode = 26
+	ErrorCode_CreateCredentialFailure ErrorCode = 27
+	ErrorCode_GetCredentialFailure    ErrorCode = 28
+	ErrorCode_DeleteCredentialFailure ErrorCode = 29
+	ErrorCode_UpdateCredentialFailure ErrorCode = 30
+	ErrorCode_ListCredUsersFailure    ErrorCode = 31
+	ErrorCode_CreateRoleFailure       ErrorCode = 32
+	ErrorCode_DropRoleFailure         ErrorCode = 33
+	ErrorCode_OperateUserRoleFailure  ErrorCode = 34
+	ErrorCode_SelectRoleFailure       ErrorCode = 35
+	ErrorCode_SelectUserFailure       ErrorCode = 36
+	ErrorCode_OperatePrivilegeFailure ErrorCode = 37
+	ErrorCode_SelectGrantFailure      ErrorCode = 38
+	ErrorCode_RateLimit               ErrorCode = 39
+	ErrorCode_QuotaExceeded           ErrorCode = 40
 	// internal error code.
 	ErrorCode_DDRequestRace ErrorCode = 1000
 )
@@ -80,37 +94,65 @@ var ErrorCode_name = map[int32]string{
 	24:   "OutOfMemory",
 	25:   "IndexNotExist",
 	26:   "EmptyCollection",
+	27:   "CreateCredentialFailure",
+	28:   "GetCredentialFailure",
+	29:   "DeleteCredentialFailure",
+	30:   "UpdateCredentialFailure",
+	31:   "ListCredUsersFailure",
+	32:   "CreateRoleFailure",
+	33:   "DropRoleFailure",
+	34:   "OperateUserRoleFailure",
+	35:   "SelectRoleFailure",
+	36:   "SelectUserFailure",
+	37:   "OperatePrivilegeFailure",
+	38:   "SelectGrantFailure",
+	39:   "RateLimit",
+	40:   "QuotaExceeded",
 	1000: "DDRequestRace",
 }
 
 var ErrorCode_value = map[string]int32{
-	"Success":               0,
-	"UnexpectedError":       1,
-	"ConnectFailed":         2,
-	"PermissionDenied":      3,
-	"CollectionNotExists":   4,
-	"IllegalArgument":       5,
-	"IllegalDimension":      7,
-	"IllegalIndexType":      8,
-	"IllegalCollectionName": 9,
-	"IllegalTOPK":           10,
-	"IllegalRowRecord":      11,
-	"IllegalVectorID":       12,
-	"IllegalSearchResult":   13,
-	"FileNotFound":          14,
-	"MetaFailed":            15,
-	"CacheFailed":           16,
-	"CannotCreateFolder":    17,
-	"CannotCreateFile":      18,
-	"CannotDeleteFolder":    19,
-	"CannotDeleteFile":      20,
-	"BuildIndexError":       21,
-	"IllegalNLIST":          22,
-	"IllegalMetricType":     23,
-	"OutOfMemory":           24,
-	"IndexNotExist":         25,
-	"EmptyCollection":       26,
-	"DDRequestRace":         1000,
+	"Success":                 0,
+	"UnexpectedError":         1,
+	"ConnectFailed":           2,
+	"PermissionDenied":        3,
+	"CollectionNotExists":     4,
+	"IllegalArgument":         5,
+	"IllegalDimension":        7,
+	"IllegalIndexType":        8,
+	"IllegalCollectionName":   9,
+	"IllegalTOPK":             10,
+	"IllegalRowRecord":        11,
+	"IllegalVectorID":         12,
+	"IllegalSearchResult":     13,
+	"FileNotFound":            14,
+	"MetaFailed":              15,
+	"CacheFailed":             16,
+	"CannotCreateFolder":      17,
+	"CannotCreateFile":        18,
+	"CannotDeleteFolder":      19,
+	"CannotDeleteFile":        20,
+	"BuildIndexError":         21,
+	"IllegalNLIST":            22,
+	"IllegalMetricType":       23,
+	"OutOfMemory":             24,
+	"IndexNotExist":           25,
+	"EmptyCollection":         26,
+	"CreateCredentialFailure": 27,
+	"GetCredentialFailure":    28,
+	"DeleteCredentialFailure": 29,
+	"UpdateCredentialFailure": 30,
+	"ListCredUsersFailure":    31,
+	"CreateRoleFailure":       32,
+	"DropRoleFailure":         33,
+	"OperateUserRoleFailure":  34,
+	"SelectRoleFailure":       35,
+	"SelectUserFailure":       36,
+	"OperatePrivilegeFailure": 37,
+	"SelectGrantFailure":      38,
+	"RateLimit":               39,
+	"QuotaExceeded":           40,
+	"DDRequestRace":           1000,
 }
 
 func (x ErrorCode) String() string {
@@ -227,6 +269,7 @@ const (
 	MsgType_CreateIndex   MsgType = 300
 	MsgType_DescribeIndex MsgType = 301
 	MsgType_DropIndex     MsgType = 302
+	MsgType_AlterIndex    MsgType = 303
 	// MANIPULATION REQUESTS
 	MsgType_Insert MsgType = 400
 	MsgType_Delete MsgType = 401
@@ -244,6 +287,8 @@ const (
 	MsgType_RemoveDmChannels        MsgType = 509
 	MsgType_WatchQueryChannels      MsgType = 510
 	MsgType_RemoveQueryChannels     MsgType = 511
+	MsgType_GetIndexStatistics      MsgType = 512
+	MsgType_EstimateIndexRecall     MsgType = 513
 	// DATA SERVICE
 	MsgType_SegmentInfo MsgType = 600
 	// SYSTEM CONTROL
@@ -256,6 +301,23 @@ const (
 	MsgType_SegmentStatistics MsgType = 1206
 	MsgType_SegmentFlushDone  MsgType = 1207
 	MsgType_DataNodeTt        MsgType = 1208
+	// Credential
+	MsgType_CreateCredential  MsgType = 1500
+	MsgType_GetCredential     MsgType = 1501
+	MsgType_DeleteCredential  MsgType = 1502
+	MsgType_UpdateCredential  MsgType = 1503
+	MsgType_ListCredUsernames MsgType = 1504
+	// RBAC
+	MsgType_CreateRole       MsgType = 1600
+	MsgType_DropRole         MsgType = 1601
+	MsgType_OperateUserRole  MsgType = 1602
+	MsgType_SelectRole       MsgType = 1603
+	MsgType_SelectUser       MsgType = 1604
+	MsgType_OperatePrivilege MsgType = 1605
+	MsgType_SelectGrant      MsgType = 1606
+	MsgType_ListPolicy       MsgType = 1607
+	// Quota
+	MsgType_SetQuotaFactors MsgType = 1700
 )
 
 var MsgType_name = map[int32]string{
@@ -287,6 +349,7 @@ var MsgType_name = map[int32]string{
 	300:  "CreateIndex",
 	301:  "DescribeIndex",
 	302:  "DropIndex",
+	303:  "AlterIndex",
 	400:  "Insert",
 	401:  "Delete",
 	402:  "Flush",
@@ -302,6 +365,8 @@ var MsgType_name = map[int32]string{
 	509:  "RemoveDmChannels",
 	510:  "WatchQueryChannels",
 	511:  "RemoveQueryChannels",
+	512:  "GetIndexStatistics",
+	513:  "EstimateIndexRecall",
 	600:  "SegmentInfo",
 	1200: "TimeTick",
 	1201: "QueryNodeStats",
@@ -312,6 +377,20 @@ var MsgType_name = map[int32]string{
 	1206: "SegmentStatistics",
 	1207: "SegmentFlushDone",
 	1208: "DataNodeTt",
+	1500: "CreateCredential",
+	1501: "GetCredential",
+	1502: "DeleteCredential",
+	1503: "UpdateCredential",
+	1504: "ListCredUsernames",
+	1600: "CreateRole",
+	1601: "DropRole",
+	1602: "OperateUserRole",
+	1603: "SelectRole",
+	1604: "SelectUser",
+	1605: "OperatePrivilege",
+	1606: "SelectGrant",
+	1607: "ListPolicy",
+	1700: "SetQuotaFactors",
 }
 
 var MsgType_value = map[string]int32{
@@ -343,6 +422,7 @@ var MsgType_value = map[string]int32{
 	"CreateIndex":             300,
 	"DescribeIndex":           301,
 	"DropIndex":               302,
+	"AlterIndex":              303,
 	"Insert":                  400,
 	"Delete":                  401,
 	"Flush":                   402,
@@ -358,6 +438,8 @@ var MsgType_value = map[string]int32{
 	"RemoveDmChannels":        509,
 	"WatchQueryChannels":      510,
 	"RemoveQueryChannels":     511,
+	"GetIndexStatistics":      512,
+	"EstimateIndexRecall":     513,
 	"SegmentInfo":             600,
 	"TimeTick":                1200,
 	"QueryNodeStats":          1201,
@@ -368,6 +450,20 @@ var MsgType_value = map[string]int32{
 	"SegmentStatistics":       1206,
 	"SegmentFlushDone":        1207,
 	"DataNodeTt":              1208,
+	"CreateCredential":        1500,
+	"GetCredential":           1501,
+	"DeleteCredential":        1502,
+	"UpdateCredential":        1503,
+	"ListCredUsernames":       1504,
+	"CreateRole":              1600,
+	"DropRole":                1601,
+	"OperateUserRole":         1602,
+	"SelectRole":              1603,
+	"SelectUser":              1604,
+	"OperatePrivilege":        1605,
+	"SelectGrant":             1606,
+	"ListPolicy":              1607,
+	"SetQuotaFactors":         1700,
 }
 
 func (x MsgType) String() string {
@@ -378,6 +474,131 @@ func (MsgType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_555bd8c177793206, []int{3}
 }
 
+// ObjectType is the type of object a privilege is granted on
+type ObjectType int32
+
+const (
+	ObjectType_Collection ObjectType = 0
+	ObjectType_Global     ObjectType = 1
+	ObjectType_User       ObjectType = 2
+)
+
+var ObjectType_name = map[int32]string{
+	0: "Collection",
+	1: "Global",
+	2: "User",
+}
+
+var ObjectType_value = map[string]int32{
+	"Collection": 0,
+	"Global":     1,
+	"User":       2,
+}
+
+func (x ObjectType) String() string {
+	return proto.EnumName(ObjectType_name, int32(x))
+}
+
+func (ObjectType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_555bd8c177793206, []int{4}
+}
+
+// ObjectPrivilege is the privilege required by each MilvusService method,
+// PrivilegeAll matches any privilege of the object type
+type ObjectPrivilege int32
+
+const (
+	ObjectPrivilege_PrivilegeAll                ObjectPrivilege = 0
+	ObjectPrivilege_PrivilegeCreateCollection   ObjectPrivilege = 1
+	ObjectPrivilege_PrivilegeDropCollection     ObjectPrivilege = 2
+	ObjectPrivilege_PrivilegeDescribeCollection ObjectPrivilege = 3
+	ObjectPrivilege_PrivilegeShowCollections    ObjectPrivilege = 4
+	ObjectPrivilege_PrivilegeLoad               ObjectPrivilege = 5
+	ObjectPrivilege_PrivilegeRelease            ObjectPrivilege = 6
+	ObjectPrivilege_PrivilegeGetStatistics      ObjectPrivilege = 7
+	ObjectPrivilege_PrivilegeCreatePartition    ObjectPrivilege = 8
+	ObjectPrivilege_PrivilegeDropPartition      ObjectPrivilege = 9
+	ObjectPrivilege_PrivilegeShowPartitions     ObjectPrivilege = 10
+	ObjectPrivilege_PrivilegeCreateIndex        ObjectPrivilege = 11
+	ObjectPrivilege_PrivilegeIndexDetail        ObjectPrivilege = 12
+	ObjectPrivilege_PrivilegeDropIndex          ObjectPrivilege = 13
+	ObjectPrivilege_PrivilegeInsert             ObjectPrivilege = 14
+	ObjectPrivilege_PrivilegeDelete             ObjectPrivilege = 15
+	ObjectPrivilege_PrivilegeSearch             ObjectPrivilege = 16
+	ObjectPrivilege_PrivilegeQuery              ObjectPrivilege = 17
+	ObjectPrivilege_PrivilegeFlush              ObjectPrivilege = 18
+	ObjectPrivilege_PrivilegeManageAlias        ObjectPrivilege = 19
+	ObjectPrivilege_PrivilegeCreateOwnership    ObjectPrivilege = 20
+	ObjectPrivilege_PrivilegeDropOwnership      ObjectPrivilege = 21
+	ObjectPrivilege_PrivilegeUpdateUser         ObjectPrivilege = 22
+	ObjectPrivilege_PrivilegeSelectOwnership    ObjectPrivilege = 23
+	ObjectPrivilege_PrivilegeManageOwnership    ObjectPrivilege = 24
+)
+
+var ObjectPrivilege_name = map[int32]string{
+	0:  "PrivilegeAll",
+	1:  "PrivilegeCreateCollection",
+	2:  "PrivilegeDropCollection",
+	3:  "PrivilegeDescribeCollection",
+	4:  "PrivilegeShowCollections",
+	5:  "PrivilegeLoad",
+	6:  "PrivilegeRelease",
+	7:  "PrivilegeGetStatistics",
+	8:  "PrivilegeCreatePartition",
+	9:  "PrivilegeDropPartition",
+	10: "PrivilegeShowPartitions",
+	11: "PrivilegeCreateIndex",
+	12: "PrivilegeIndexDetail",
+	13: "PrivilegeDropIndex",
+	14: "PrivilegeInsert",
+	15: "PrivilegeDelete",
+	16: "PrivilegeSearch",
+	17: "PrivilegeQuery",
+	18: "PrivilegeFlush",
+	19: "PrivilegeManageAlias",
+	20: "PrivilegeCreateOwnership",
+	21: "PrivilegeDropOwnership",
+	22: "PrivilegeUpdateUser",
+	23: "PrivilegeSelectOwnership",
+	24: "PrivilegeManageOwnership",
+}
+
+var ObjectPrivilege_value = map[string]int32{
+	"PrivilegeAll":                0,
+	"PrivilegeCreateCollection":   1,
+	"PrivilegeDropCollection":     2,
+	"PrivilegeDescribeCollection": 3,
+	"PrivilegeShowCollections":    4,
+	"PrivilegeLoad":               5,
+	"PrivilegeRelease":            6,
+	"PrivilegeGetStatistics":      7,
+	"PrivilegeCreatePartition":    8,
+	"PrivilegeDropPartition":      9,
+	"PrivilegeShowPartitions":     10,
+	"PrivilegeCreateIndex":        11,
+	"PrivilegeIndexDetail":        12,
+	"PrivilegeDropIndex":          13,
+	"PrivilegeInsert":             14,
+	"PrivilegeDelete":             15,
+	"PrivilegeSearch":             16,
+	"PrivilegeQuery":              17,
+	"PrivilegeFlush":              18,
+	"PrivilegeManageAlias":        19,
+	"PrivilegeCreateOwnership":    20,
+	"PrivilegeDropOwnership":      21,
+	"PrivilegeUpdateUser":         22,
+	"PrivilegeSelectOwnership":    23,
+	"PrivilegeManageOwnership":    24,
+}
+
+func (x ObjectPrivilege) String() string {
+	return proto.EnumName(ObjectPrivilege_name, int32(x))
+}
+
+func (ObjectPrivilege) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_555bd8c177793206, []int{5}
+}
+
 type DslType int32
 
 const (
@@ -400,7 +621,7 @@ func (x DslType) String() string {
 }
 
 func (DslType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_555bd8c177793206, []int{4}
+	return fileDescriptor_555bd8c177793206, []int{6}
 }
 
 type Status struct {
@@ -786,6 +1007,8 @@ func init() {
 	proto.RegisterEnum("milvus.proto.common.IndexState", IndexState_name, IndexState_value)
 	proto.RegisterEnum("milvus.proto.common.SegmentState", SegmentState_name, SegmentState_value)
 	proto.RegisterEnum("milvus.proto.common.MsgType", MsgType_name, MsgType_value)
+	proto.RegisterEnum("milvus.proto.common.ObjectType", ObjectType_name, ObjectType_value)
+	proto.RegisterEnum("milvus.proto.common.ObjectPrivilege", ObjectPrivilege_name, ObjectPrivilege_value)
 	proto.RegisterEnum("milvus.proto.common.DslType", DslType_name, DslType_value)
 	proto.RegisterType((*Status)(nil), "milvus.proto.common.Status")
 	proto.RegisterType((*KeyValuePair)(nil), "milvus.proto.common.KeyValuePair")
@@ -800,90 +1023,122 @@ func init() {
 func init() { proto.RegisterFile("common.proto", fileDescriptor_555bd8c177793206) }
 
 var fileDescriptor_555bd8c177793206 = []byte{
-	// 1353 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xd9, 0x6e, 0x1b, 0x37,
-	0x17, 0xf6, 0x68, 0x64, 0xcb, 0xa2, 0x65, 0x9b, 0xa6, 0x97, 0x38, 0xf9, 0x8d, 0x1f, 0x81, 0xae,
-	0x02, 0x03, 0xb1, 0xff, 0xbf, 0x41, 0xdb, 0xab, 0x5c, 0xd8, 0x1a, 0x2f, 0x42, 0xe2, 0xa5, 0x23,
-	0x27, 0x2d, 0x7a, 0xd1, 0x80, 0x9e, 0x39, 0x92, 0xd8, 0xcc, 0x90, 0x2a, 0x49, 0x39, 0xd6, 0x5b,
-	0xb4, 0x79, 0x8e, 0xb6, 0xe8, 0x92, 0x2e, 0xe8, 0x13, 0x74, 0xbf, 0xee, 0x23, 0xf4, 0x01, 0xba,
-	0x66, 0x2d, 0x0e, 0x67, 0x24, 0x4d, 0x80, 0xe4, 0xaa, 0x77, 0x3c, 0x1f, 0x0f, 0x3f, 0x7e, 0xfc,
-	0xce, 0x21, 0x67, 0x48, 0x2d, 0x52, 0x69, 0xaa, 0xe4, 0x46, 0x4f, 0x2b, 0xab, 0xd8, 0x62, 0x2a,
-	0x92, 0xb3, 0xbe, 0xc9, 0xa2, 0x8d, 0x6c, 0xaa, 0x7e, 0x87, 0x4c, 0xb5, 0x2c, 0xb7, 0x7d, 0xc3,
-	0xae, 0x13, 0x02, 0x5a, 0x2b, 0x7d, 0x27, 0x52, 0x31, 0xac, 0x7a, 0x97, 0xbd, 0x2b, 0x73, 0xaf,
-	0xfc, 0x77, 0xe3, 0x05, 0x6b, 0x36, 0x76, 0x30, 0xad, 0xa1, 0x62, 0x08, 0xab, 0x30, 0x1c, 0xb2,
-	0x15, 0x32, 0xa5, 0x81, 0x1b, 0x25, 0x57, 0x4b, 0x97, 0xbd, 0x2b, 0xd5, 0x30, 0x8f, 0xea, 0xaf,
-	0x91, 0xda, 0x0d, 0x18, 0xdc, 0xe6, 0x49, 0x1f, 0x8e, 0xb9, 0xd0, 0x8c, 0x12, 0xff, 0x2e, 0x0c,
-	0x1c, 0x7f, 0x35, 0xc4, 0x21, 0x5b, 0x22, 0x93, 0x67, 0x38, 0x9d, 0x2f, 0xcc, 0x82, 0xfa, 0x35,
-	0x32, 0x73, 0x03, 0x06, 0x01, 0xb7, 0xfc, 0x25, 0xcb, 0x18, 0x29, 0xc7, 0xdc, 0x72, 0xb7, 0xaa,
-	0x16, 0xba, 0x71, 0x7d, 0x8d, 0x94, 0xb7, 0x13, 0x75, 0x3a, 0xa6, 0xf4, 0xdc, 0x64, 0x4e, 0x79,
-	0x95, 0x54, 0xb6, 0xe2, 0x58, 0x83, 0x31, 0x6c, 0x8e, 0x94, 0x44, 0x2f, 0x67, 0x2b, 0x89, 0x1e,
-	0x92, 0xf5, 0x94, 0xb6, 0x8e, 0xcc, 0x0f, 0xdd, 0xb8, 0x7e, 0xdf, 0x23, 0x95, 0x03, 0xd3, 0xd9,
-	0xe6, 0x06, 0xd8, 0xeb, 0x64, 0x3a, 0x35, 0x9d, 0x3b, 0x76, 0xd0, 0x1b, 0x5a, 0xb3, 0xf6, 0x42,
-	0x6b, 0x0e, 0x4c, 0xe7, 0x64, 0xd0, 0x83, 0xb0, 0x92, 0x66, 0x03, 0x54, 0x92, 0x9a, 0x4e, 0x33,
-	0xc8, 0x99, 0xb3, 0x80, 0xad, 0x91, 0xaa, 0x15, 0x29, 0x18, 0xcb, 0xd3, 0xde, 0xaa, 0x7f, 0xd9,
-	0xbb, 0x52, 0x0e, 0xc7, 0x00, 0xbb, 0x44, 0xa6, 0x8d, 0xea, 0xeb, 0x08, 0x9a, 0xc1, 0x6a, 0xd9,
-	0x2d, 0x1b, 0xc5, 0xf5, 0xeb, 0xa4, 0x7a, 0x60, 0x3a, 0xfb, 0xc0, 0x63, 0xd0, 0xec, 0x7f, 0xa4,
-	0x7c, 0xca, 0x4d, 0xa6, 0x68, 0xe6, 0xe5, 0x8a, 0xf0, 0x04, 0xa1, 0xcb, 0xac, 0xbf, 0x43, 0x6a,
-	0xc1, 0xc1, 0xcd, 0x7f, 0xc1, 0x80, 0xd2, 0x4d, 0x97, 0xeb, 0xf8, 0x90, 0xa7, 0xc3, 0x8a, 0x8d,
-	0x81, 0xf5, 0x6f, 0xca, 0xa4, 0x3a, 0x6a, 0x0f, 0x36, 0x43, 0x2a, 0xad, 0x7e, 0x14, 0x81, 0x31,
-	0x74, 0x82, 0x2d, 0x92, 0xf9, 0x5b, 0x12, 0xce, 0x7b, 0x10, 0x59, 0x88, 0x5d, 0x0e, 0xf5, 0xd8,
-	0x02, 0x99, 0x6d, 0x28, 0x29, 0x21, 0xb2, 0xbb, 0x5c, 0x24, 0x10, 0xd3, 0x12, 0x5b, 0x22, 0xf4,
-	0x18, 0x74, 0x2a, 0x8c, 0x11, 0x4a, 0x06, 0x20, 0x05, 0xc4, 0xd4, 0x67, 0x17, 0xc8, 0x62, 0x43,
-	0x25, 0x09, 0x44, 0x56, 0x28, 0x79, 0xa8, 0xec, 0xce, 0xb9, 0x30, 0xd6, 0xd0, 0x32, 0xd2, 0x36,
-	0x93, 0x04, 0x3a, 0x3c, 0xd9, 0xd2, 0x9d, 0x7e, 0x0a, 0xd2, 0xd2, 0x49, 0xe4, 0xc8, 0xc1, 0x40,
-	0xa4, 0x20, 0x91, 0x89, 0x56, 0x0a, 0x68, 0x53, 0xc6, 0x70, 0x8e, 0xf5, 0xa1, 0xd3, 0xec, 0x22,
-	0x59, 0xce, 0xd1, 0xc2, 0x06, 0x3c, 0x05, 0x5a, 0x65, 0xf3, 0x64, 0x26, 0x9f, 0x3a, 0x39, 0x3a,
-	0xbe, 0x41, 0x49, 0x81, 0x21, 0x54, 0xf7, 0x42, 0x88, 0x94, 0x8e, 0xe9, 0x4c, 0x41, 0xc2, 0x6d,
-	0x88, 0xac, 0xd2, 0xcd, 0x80, 0xd6, 0x50, 0x70, 0x0e, 0xb6, 0x80, 0xeb, 0xa8, 0x1b, 0x82, 0xe9,
-	0x27, 0x96, 0xce, 0x32, 0x4a, 0x6a, 0xbb, 0x22, 0x81, 0x43, 0x65, 0x77, 0x55, 0x5f, 0xc6, 0x74,
-	0x8e, 0xcd, 0x11, 0x72, 0x00, 0x96, 0xe7, 0x0e, 0xcc, 0xe3, 0xb6, 0x0d, 0x1e, 0x75, 0x21, 0x07,
-	0x28, 0x5b, 0x21, 0xac, 0xc1, 0xa5, 0x54, 0xb6, 0xa1, 0x81, 0x5b, 0xd8, 0x55, 0x49, 0x0c, 0x9a,
-	0x2e, 0xa0, 0x9c, 0xe7, 0x70, 0x91, 0x00, 0x65, 0xe3, 0xec, 0x00, 0x12, 0x18, 0x65, 0x2f, 0x8e,
-	0xb3, 0x73, 0x1c, 0xb3, 0x97, 0x50, 0xfc, 0x76, 0x5f, 0x24, 0xb1, 0xb3, 0x24, 0x2b, 0xcb, 0x32,
-	0x6a, 0xcc, 0xc5, 0x1f, 0xde, 0x6c, 0xb6, 0x4e, 0xe8, 0x0a, 0x5b, 0x26, 0x0b, 0x39, 0x72, 0x00,
-	0x56, 0x8b, 0xc8, 0x99, 0x77, 0x01, 0xa5, 0x1e, 0xf5, 0xed, 0x51, 0xfb, 0x00, 0x52, 0xa5, 0x07,
-	0x74, 0x15, 0x0b, 0xea, 0x98, 0x86, 0x25, 0xa2, 0x17, 0x71, 0x87, 0x9d, 0xb4, 0x67, 0x07, 0x63,
-	0x7b, 0xe9, 0x25, 0xc6, 0xc8, 0x6c, 0x10, 0x84, 0xf0, 0x5e, 0x1f, 0x8c, 0x0d, 0x79, 0x04, 0xf4,
-	0xd7, 0xca, 0xfa, 0x5b, 0x84, 0xb8, 0xb5, 0xf8, 0x20, 0x01, 0x63, 0x64, 0x6e, 0x1c, 0x1d, 0x2a,
-	0x09, 0x74, 0x82, 0xd5, 0xc8, 0xf4, 0x2d, 0x29, 0x8c, 0xe9, 0x43, 0x4c, 0x3d, 0xf4, 0xad, 0x29,
-	0x8f, 0xb5, 0xea, 0xe0, 0x95, 0xa6, 0x25, 0x9c, 0xdd, 0x15, 0x52, 0x98, 0xae, 0xeb, 0x18, 0x42,
-	0xa6, 0x72, 0x03, 0xcb, 0xeb, 0x6d, 0x52, 0x6b, 0x41, 0x07, 0x9b, 0x23, 0xe3, 0x5e, 0x22, 0xb4,
-	0x18, 0x8f, 0xd9, 0x47, 0xb2, 0x3d, 0x6c, 0xde, 0x3d, 0xad, 0xee, 0x09, 0xd9, 0xa1, 0x25, 0x24,
-	0x6b, 0x01, 0x4f, 0x1c, 0xf1, 0x0c, 0xa9, 0xec, 0x26, 0x7d, 0xb7, 0x4b, 0xd9, 0xed, 0x89, 0x01,
-	0xa6, 0x4d, 0xae, 0x3f, 0x98, 0x76, 0x4f, 0x86, 0xbb, 0xf9, 0xb3, 0xa4, 0x7a, 0x4b, 0xc6, 0xd0,
-	0x16, 0x12, 0x62, 0x3a, 0xe1, 0xdc, 0x77, 0x55, 0x2a, 0xd8, 0x10, 0xe3, 0x21, 0x03, 0xad, 0x7a,
-	0x05, 0x0c, 0xd0, 0xc2, 0x7d, 0x6e, 0x0a, 0x50, 0x1b, 0x4b, 0x1a, 0x80, 0x89, 0xb4, 0x38, 0x2d,
-	0x2e, 0xef, 0xa0, 0xb5, 0xad, 0xae, 0xba, 0x37, 0xc6, 0x0c, 0xed, 0xe2, 0x4e, 0x7b, 0x60, 0x5b,
-	0x03, 0x63, 0x21, 0x6d, 0x28, 0xd9, 0x16, 0x1d, 0x43, 0x05, 0xee, 0x74, 0x53, 0xf1, 0xb8, 0xb0,
-	0xfc, 0x5d, 0x2c, 0x6a, 0x08, 0x09, 0x70, 0x53, 0x64, 0xbd, 0xeb, 0xfa, 0xcf, 0x49, 0xdd, 0x4a,
-	0x04, 0x37, 0x34, 0xc1, 0xa3, 0xa0, 0xca, 0x2c, 0x4c, 0xd1, 0xf7, 0xad, 0xc4, 0x82, 0xce, 0x62,
-	0xc9, 0x96, 0xc8, 0x7c, 0x96, 0x7f, 0xcc, 0xb5, 0x15, 0x8e, 0xe4, 0x5b, 0xcf, 0x55, 0x58, 0xab,
-	0xde, 0x18, 0xfb, 0x0e, 0xaf, 0x7b, 0x6d, 0x9f, 0x9b, 0x31, 0xf4, 0xbd, 0xc7, 0x56, 0xc8, 0xc2,
-	0xf0, 0x68, 0x63, 0xfc, 0x07, 0x8f, 0x2d, 0x92, 0x39, 0x3c, 0xda, 0x08, 0x33, 0xf4, 0x47, 0x07,
-	0xe2, 0x21, 0x0a, 0xe0, 0x4f, 0x8e, 0x21, 0x3f, 0x45, 0x01, 0xff, 0xd9, 0x6d, 0x86, 0x0c, 0x79,
-	0xa1, 0x0d, 0x7d, 0xe8, 0xa1, 0xd2, 0xe1, 0x66, 0x39, 0x4c, 0x1f, 0xb9, 0x44, 0x64, 0x1d, 0x25,
-	0x3e, 0x76, 0x89, 0x39, 0xe7, 0x08, 0x7d, 0xe2, 0xd0, 0x7d, 0x2e, 0x63, 0xd5, 0x6e, 0x8f, 0xd0,
-	0xa7, 0x1e, 0x5b, 0x25, 0x8b, 0xb8, 0x7c, 0x9b, 0x27, 0x5c, 0x46, 0xe3, 0xfc, 0x67, 0x1e, 0xa3,
-	0x43, 0x23, 0x5d, 0x23, 0xd3, 0x0f, 0x4b, 0xce, 0x94, 0x5c, 0x40, 0x86, 0x7d, 0x54, 0x62, 0x73,
-	0x99, 0xbb, 0x59, 0xfc, 0x71, 0x89, 0xcd, 0x90, 0xa9, 0xa6, 0x34, 0xa0, 0x2d, 0x7d, 0x1f, 0x9b,
-	0x6d, 0x2a, 0xbb, 0xae, 0xf4, 0x03, 0x6c, 0xe9, 0x49, 0xd7, 0x6c, 0xf4, 0xbe, 0x9b, 0xc8, 0x1e,
-	0x16, 0xfa, 0x9b, 0xef, 0x8e, 0x5a, 0x7c, 0x65, 0x7e, 0xf7, 0x71, 0xa7, 0x3d, 0xb0, 0xe3, 0x1b,
-	0x44, 0xff, 0xf0, 0xd9, 0x25, 0xb2, 0x3c, 0xc4, 0xdc, 0x9d, 0x1f, 0xdd, 0x9d, 0x3f, 0x7d, 0xb6,
-	0x46, 0x2e, 0xec, 0x81, 0x1d, 0xf7, 0x01, 0x2e, 0x12, 0xc6, 0x8a, 0xc8, 0xd0, 0xbf, 0x7c, 0xf6,
-	0x1f, 0xb2, 0xb2, 0x07, 0x76, 0xe4, 0x6f, 0x61, 0xf2, 0x6f, 0x9f, 0xcd, 0x92, 0xe9, 0x10, 0x1f,
-	0x05, 0x38, 0x03, 0xfa, 0xd0, 0xc7, 0x22, 0x0d, 0xc3, 0x5c, 0xce, 0x23, 0x1f, 0xad, 0x7b, 0x93,
-	0xdb, 0xa8, 0x1b, 0xa4, 0x8d, 0x2e, 0x97, 0x12, 0x12, 0x43, 0x1f, 0xfb, 0x6c, 0x99, 0xd0, 0x10,
-	0x52, 0x75, 0x06, 0x05, 0xf8, 0x09, 0x3e, 0xf6, 0xcc, 0x25, 0xbf, 0xd1, 0x07, 0x3d, 0x18, 0x4d,
-	0x3c, 0xf5, 0xd1, 0xea, 0x2c, 0xff, 0xf9, 0x99, 0x67, 0x3e, 0x5a, 0x9d, 0x3b, 0xdf, 0x94, 0x6d,
-	0x45, 0x7f, 0x29, 0xa3, 0xaa, 0x13, 0x91, 0xc2, 0x89, 0x88, 0xee, 0xd2, 0x4f, 0xaa, 0xa8, 0xca,
-	0x2d, 0x3a, 0x54, 0x31, 0xa0, 0x7c, 0x43, 0x3f, 0xad, 0xa2, 0xf5, 0x58, 0xba, 0xcc, 0xfa, 0xcf,
-	0x5c, 0x9c, 0xbf, 0x49, 0xcd, 0x80, 0x7e, 0x8e, 0x1f, 0x00, 0x92, 0xc7, 0x27, 0xad, 0x23, 0xfa,
-	0xa0, 0x8a, 0xc7, 0xd8, 0x4a, 0x12, 0x15, 0x71, 0x3b, 0x6a, 0xa0, 0x2f, 0xaa, 0xd8, 0x81, 0x85,
-	0xe7, 0x24, 0x37, 0xe6, 0xcb, 0x2a, 0x1e, 0x2f, 0xc7, 0x5d, 0xd9, 0x02, 0x7c, 0x66, 0xbe, 0x72,
-	0xac, 0xf8, 0x5f, 0x83, 0x4a, 0x4e, 0x2c, 0xfd, 0xba, 0xba, 0x5e, 0x27, 0x95, 0xc0, 0x24, 0xee,
-	0xd5, 0xa8, 0x10, 0x3f, 0x30, 0x09, 0x9d, 0xc0, 0x4b, 0xb6, 0xad, 0x54, 0xb2, 0x73, 0xde, 0xd3,
-	0xb7, 0xff, 0x4f, 0xbd, 0xed, 0x57, 0xdf, 0xbe, 0xd6, 0x11, 0xb6, 0xdb, 0x3f, 0xc5, 0xcf, 0xf2,
-	0x66, 0xf6, 0x9d, 0xbe, 0x2a, 0x54, 0x3e, 0xda, 0x14, 0xd2, 0x82, 0x96, 0x3c, 0xd9, 0x74, 0x9f,
-	0xee, 0xcd, 0xec, 0xd3, 0xdd, 0x3b, 0x3d, 0x9d, 0x72, 0xf1, 0xb5, 0x7f, 0x02, 0x00, 0x00, 0xff,
-	0xff, 0x70, 0xc4, 0x92, 0xd3, 0x0b, 0x0a, 0x00, 0x00,
+	// 1863 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x57, 0x47, 0x77, 0x1b, 0xc9,
+	0x11, 0x26, 0x02, 0x09, 0xa2, 0x01, 0x82, 0xc5, 0x66, 0x94, 0xc4, 0xdd, 0x95, 0xe9, 0xa4, 0xc7,
+	0xf7, 0x56, 0xb2, 0x57, 0xcf, 0xf6, 0x69, 0x0f, 0x24, 0x41, 0x52, 0x78, 0x62, 0x5a, 0x80, 0x94,
+	0xfd, 0x7c, 0xb0, 0x5e, 0x73, 0xa6, 0x08, 0xf4, 0xaa, 0x67, 0x1a, 0x9e, 0x6e, 0x50, 0xc4, 0xcd,
+	0xfe, 0x07, 0x5e, 0x1d, 0xfc, 0x0b, 0x7c, 0x74, 0xce, 0x47, 0xe7, 0x6c, 0x9f, 0x7d, 0x70, 0x3a,
+	0xfa, 0x07, 0x38, 0x6e, 0xf4, 0xab, 0x9e, 0xc1, 0xcc, 0x00, 0xd4, 0x9e, 0x7c, 0x9b, 0xfa, 0x2a,
+	0x74, 0xa5, 0xae, 0xea, 0x61, 0x75, 0x4f, 0x07, 0x81, 0x0e, 0xef, 0xf6, 0x23, 0x6d, 0x35, 0x5f,
+	0x0c, 0xa4, 0xba, 0x1c, 0x98, 0x98, 0xba, 0x1b, 0xb3, 0x36, 0x1e, 0xb3, 0x99, 0x8e, 0x15, 0x76,
+	0x60, 0xf8, 0xab, 0x8c, 0x61, 0x14, 0xe9, 0xe8, 0xb1, 0xa7, 0x7d, 0x5c, 0x2b, 0xdc, 0x2e, 0xdc,
+	0x69, 0xbc, 0xf2, 0xe2, 0xdd, 0xe7, 0xe8, 0xdc, 0xdd, 0x25, 0xb1, 0x1d, 0xed, 0x63, 0xbb, 0x8a,
+	0xa3, 0x4f, 0xbe, 0xc2, 0x66, 0x22, 0x14, 0x46, 0x87, 0x6b, 0xc5, 0xdb, 0x85, 0x3b, 0xd5, 0x76,
+	0x42, 0x6d, 0x7c, 0x92, 0xd5, 0x1f, 0xe2, 0xf0, 0x91, 0x50, 0x03, 0x3c, 0x11, 0x32, 0xe2, 0xc0,
+	0x4a, 0x4f, 0x70, 0xe8, 0xec, 0x57, 0xdb, 0xf4, 0xc9, 0x97, 0xd8, 0xf4, 0x25, 0xb1, 0x13, 0xc5,
+	0x98, 0xd8, 0xb8, 0xcf, 0x6a, 0x0f, 0x71, 0xd8, 0x14, 0x56, 0xbc, 0x8f, 0x1a, 0x67, 0x65, 0x5f,
+	0x58, 0xe1, 0xb4, 0xea, 0x6d, 0xf7, 0xbd, 0xb1, 0xce, 0xca, 0xdb, 0x4a, 0x9f, 0x67, 0x26, 0x0b,
+	0x8e, 0x99, 0x98, 0x7c, 0x99, 0x55, 0xb6, 0x7c, 0x3f, 0x42, 0x63, 0x78, 0x83, 0x15, 0x65, 0x3f,
+	0xb1, 0x56, 0x94, 0x7d, 0x32, 0xd6, 0xd7, 0x91, 0x75, 0xc6, 0x4a, 0x6d, 0xf7, 0xbd, 0xf1, 0xac,
+	0xc0, 0x2a, 0x87, 0xa6, 0xbb, 0x2d, 0x0c, 0xf2, 0x4f, 0xb1, 0xd9, 0xc0, 0x74, 0x1f, 0xdb, 0x61,
+	0x7f, 0x94, 0x9a, 0xf5, 0xe7, 0xa6, 0xe6, 0xd0, 0x74, 0x4f, 0x87, 0x7d, 0x6c, 0x57, 0x82, 0xf8,
+	0x83, 0x3c, 0x09, 0x4c, 0xb7, 0xd5, 0x4c, 0x2c, 0xc7, 0x04, 0x5f, 0x67, 0x55, 0x2b, 0x03, 0x34,
+	0x56, 0x04, 0xfd, 0xb5, 0xd2, 0xed, 0xc2, 0x9d, 0x72, 0x3b, 0x03, 0xf8, 0x4d, 0x36, 0x6b, 0xf4,
+	0x20, 0xf2, 0xb0, 0xd5, 0x5c, 0x2b, 0x3b, 0xb5, 0x94, 0xde, 0x78, 0x95, 0x55, 0x0f, 0x4d, 0xf7,
+	0x01, 0x0a, 0x1f, 0x23, 0xfe, 0x31, 0x56, 0x3e, 0x17, 0x26, 0xf6, 0xa8, 0xf6, 0xfe, 0x1e, 0x51,
+	0x04, 0x6d, 0x27, 0xb9, 0xf1, 0x39, 0x56, 0x6f, 0x1e, 0x1e, 0xfc, 0x1f, 0x16, 0xc8, 0x75, 0xd3,
+	0x13, 0x91, 0x7f, 0x24, 0x82, 0x51, 0xc5, 0x32, 0x60, 0xf3, 0x59, 0x85, 0x55, 0xd3, 0xf6, 0xe0,
+	0x35, 0x56, 0xe9, 0x0c, 0x3c, 0x0f, 0x8d, 0x81, 0x29, 0xbe, 0xc8, 0xe6, 0xcf, 0x42, 0xbc, 0xea,
+	0xa3, 0x67, 0xd1, 0x77, 0x32, 0x50, 0xe0, 0x0b, 0x6c, 0x6e, 0x47, 0x87, 0x21, 0x7a, 0x76, 0x4f,
+	0x48, 0x85, 0x3e, 0x14, 0xf9, 0x12, 0x83, 0x13, 0x8c, 0x02, 0x69, 0x8c, 0xd4, 0x61, 0x13, 0x43,
+	0x89, 0x3e, 0x94, 0xf8, 0x2a, 0x5b, 0xdc, 0xd1, 0x4a, 0xa1, 0x67, 0xa5, 0x0e, 0x8f, 0xb4, 0xdd,
+	0xbd, 0x92, 0xc6, 0x1a, 0x28, 0x93, 0xd9, 0x96, 0x52, 0xd8, 0x15, 0x6a, 0x2b, 0xea, 0x0e, 0x02,
+	0x0c, 0x2d, 0x4c, 0x93, 0x8d, 0x04, 0x6c, 0xca, 0x00, 0x43, 0xb2, 0x04, 0x95, 0x1c, 0xda, 0x0a,
+	0x7d, 0xbc, 0xa2, 0xfa, 0xc0, 0x2c, 0xbf, 0xc1, 0x96, 0x13, 0x34, 0x77, 0x80, 0x08, 0x10, 0xaa,
+	0x7c, 0x9e, 0xd5, 0x12, 0xd6, 0xe9, 0xf1, 0xc9, 0x43, 0x60, 0x39, 0x0b, 0x6d, 0xfd, 0xb4, 0x8d,
+	0x9e, 0x8e, 0x7c, 0xa8, 0xe5, 0x5c, 0x78, 0x84, 0x9e, 0xd5, 0x51, 0xab, 0x09, 0x75, 0x72, 0x38,
+	0x01, 0x3b, 0x28, 0x22, 0xaf, 0xd7, 0x46, 0x33, 0x50, 0x16, 0xe6, 0x38, 0xb0, 0xfa, 0x9e, 0x54,
+	0x78, 0xa4, 0xed, 0x9e, 0x1e, 0x84, 0x3e, 0x34, 0x78, 0x83, 0xb1, 0x43, 0xb4, 0x22, 0xc9, 0xc0,
+	0x3c, 0x1d, 0xbb, 0x23, 0xbc, 0x1e, 0x26, 0x00, 0xf0, 0x15, 0xc6, 0x77, 0x44, 0x18, 0x6a, 0xbb,
+	0x13, 0xa1, 0xb0, 0xb8, 0xa7, 0x95, 0x8f, 0x11, 0x2c, 0x90, 0x3b, 0x63, 0xb8, 0x54, 0x08, 0x3c,
+	0x93, 0x6e, 0xa2, 0xc2, 0x54, 0x7a, 0x31, 0x93, 0x4e, 0x70, 0x92, 0x5e, 0x22, 0xe7, 0xb7, 0x07,
+	0x52, 0xf9, 0x2e, 0x25, 0x71, 0x59, 0x96, 0xc9, 0xc7, 0xc4, 0xf9, 0xa3, 0x83, 0x56, 0xe7, 0x14,
+	0x56, 0xf8, 0x32, 0x5b, 0x48, 0x90, 0x43, 0xb4, 0x91, 0xf4, 0x5c, 0xf2, 0x56, 0xc9, 0xd5, 0xe3,
+	0x81, 0x3d, 0xbe, 0x38, 0xc4, 0x40, 0x47, 0x43, 0x58, 0xa3, 0x82, 0x3a, 0x4b, 0xa3, 0x12, 0xc1,
+	0x0d, 0x3a, 0x61, 0x37, 0xe8, 0xdb, 0x61, 0x96, 0x5e, 0xb8, 0xc9, 0x6f, 0xb1, 0xd5, 0xd8, 0xe9,
+	0x9d, 0x08, 0x7d, 0x0c, 0xad, 0x14, 0x8a, 0xc2, 0x1d, 0x44, 0x08, 0xb7, 0xf8, 0x1a, 0x5b, 0xda,
+	0x47, 0x7b, 0x9d, 0xb3, 0x4e, 0x6a, 0xb1, 0xf7, 0xd7, 0x99, 0x2f, 0x10, 0xf3, 0xac, 0xef, 0x3f,
+	0xd7, 0xe6, 0x8b, 0x64, 0xf3, 0x40, 0x1a, 0x67, 0xf4, 0xcc, 0x60, 0x64, 0x46, 0x9c, 0x97, 0x28,
+	0xb4, 0xd8, 0x95, 0xb6, 0x56, 0x38, 0x82, 0x6f, 0x93, 0xdb, 0xcd, 0x48, 0xf7, 0xf3, 0xe0, 0x07,
+	0xf8, 0x4d, 0xb6, 0x72, 0xdc, 0xc7, 0x48, 0x58, 0x24, 0x23, 0x79, 0xde, 0x06, 0xd9, 0xe9, 0x20,
+	0x45, 0x98, 0x87, 0x3f, 0x98, 0xc1, 0xa4, 0x31, 0x82, 0x3f, 0x44, 0xce, 0x26, 0x96, 0x4e, 0x22,
+	0x79, 0x29, 0x15, 0x76, 0x53, 0x9d, 0x0f, 0x53, 0x09, 0x63, 0x9d, 0xfd, 0x48, 0x84, 0x76, 0x84,
+	0x7f, 0x84, 0xcf, 0xb1, 0x6a, 0x5b, 0x58, 0x3c, 0x90, 0x81, 0xb4, 0xf0, 0x51, 0x4a, 0xf6, 0x6b,
+	0x03, 0x6d, 0xc5, 0xee, 0x95, 0x87, 0xe8, 0xa3, 0x0f, 0x77, 0x38, 0x67, 0x73, 0xcd, 0x66, 0x1b,
+	0x3f, 0x3f, 0x40, 0x63, 0xdb, 0xc2, 0x43, 0xf8, 0x7b, 0x65, 0xf3, 0x33, 0x8c, 0xb9, 0x9a, 0xd0,
+	0xa0, 0x47, 0xce, 0x59, 0x23, 0xa3, 0x8e, 0x74, 0x88, 0x30, 0xc5, 0xeb, 0x6c, 0xf6, 0x2c, 0x94,
+	0xc6, 0x0c, 0xd0, 0x87, 0x02, 0xf5, 0x63, 0x2b, 0x3c, 0x89, 0x74, 0x97, 0x46, 0x25, 0x14, 0x89,
+	0xbb, 0x27, 0x43, 0x69, 0x7a, 0xee, 0x26, 0x32, 0x36, 0x93, 0x34, 0x66, 0x79, 0xf3, 0x82, 0xd5,
+	0x3b, 0xd8, 0xa5, 0x4b, 0x17, 0xdb, 0x5e, 0x62, 0x90, 0xa7, 0x33, 0xeb, 0x69, 0x3b, 0x14, 0x68,
+	0x28, 0xec, 0x47, 0xfa, 0xa9, 0x0c, 0xbb, 0x50, 0x24, 0x63, 0x1d, 0x14, 0xca, 0x19, 0xae, 0xb1,
+	0xca, 0x9e, 0x1a, 0xb8, 0x53, 0xca, 0xee, 0x4c, 0x22, 0x48, 0x6c, 0x7a, 0xf3, 0x8d, 0x9a, 0x1b,
+	0xc5, 0x6e, 0xa2, 0xce, 0xb1, 0xea, 0x59, 0xe8, 0xe3, 0x85, 0x0c, 0xd1, 0x87, 0x29, 0xd7, 0xd5,
+	0x71, 0x23, 0x65, 0xed, 0xe5, 0x53, 0x90, 0x54, 0xbc, 0x1c, 0x86, 0x94, 0xad, 0x07, 0xc2, 0xe4,
+	0xa0, 0x0b, 0xca, 0x73, 0x13, 0x8d, 0x17, 0xc9, 0xf3, 0xbc, 0x7a, 0x97, 0x6a, 0xdf, 0xe9, 0xe9,
+	0xa7, 0x19, 0x66, 0xa0, 0x47, 0x27, 0xed, 0xa3, 0xed, 0x0c, 0x8d, 0xc5, 0x60, 0x47, 0x87, 0x17,
+	0xb2, 0x6b, 0x40, 0xd2, 0x49, 0x07, 0x5a, 0xf8, 0x39, 0xf5, 0xd7, 0xa9, 0xe4, 0x6d, 0x54, 0x28,
+	0x4c, 0xde, 0xea, 0x13, 0x77, 0xaf, 0x9d, 0xab, 0x5b, 0x4a, 0x0a, 0x03, 0x8a, 0x42, 0x21, 0x2f,
+	0x63, 0x32, 0xa0, 0xbc, 0x6f, 0x29, 0x8b, 0x51, 0x4c, 0x87, 0x7c, 0x89, 0xcd, 0xc7, 0xf2, 0x27,
+	0x22, 0xb2, 0xd2, 0x19, 0xf9, 0x55, 0xc1, 0x55, 0x38, 0xd2, 0xfd, 0x0c, 0xfb, 0x35, 0x8d, 0xd1,
+	0xfa, 0x03, 0x61, 0x32, 0xe8, 0x37, 0x05, 0xbe, 0xc2, 0x16, 0x46, 0xa1, 0x65, 0xf8, 0x6f, 0x0b,
+	0x7c, 0x91, 0x35, 0x28, 0xb4, 0x14, 0x33, 0xf0, 0x3b, 0x07, 0x52, 0x10, 0x39, 0xf0, 0xf7, 0xce,
+	0x42, 0x12, 0x45, 0x0e, 0xff, 0x83, 0x3b, 0x8c, 0x2c, 0x24, 0x85, 0x36, 0xf0, 0x66, 0x81, 0x3c,
+	0x1d, 0x1d, 0x96, 0xc0, 0xf0, 0x96, 0x13, 0x24, 0xab, 0xa9, 0xe0, 0xdb, 0x4e, 0x30, 0xb1, 0x99,
+	0xa2, 0xef, 0x38, 0xf4, 0x81, 0x08, 0x7d, 0x7d, 0x71, 0x91, 0xa2, 0xef, 0x16, 0xf8, 0x1a, 0x5b,
+	0x24, 0xf5, 0x6d, 0xa1, 0x44, 0xe8, 0x65, 0xf2, 0xef, 0x15, 0x38, 0x8c, 0x12, 0xe9, 0x1a, 0x19,
+	0xbe, 0x5a, 0x74, 0x49, 0x49, 0x1c, 0x88, 0xb1, 0xaf, 0x15, 0x79, 0x23, 0xce, 0x6e, 0x4c, 0x7f,
+	0xbd, 0xc8, 0xe7, 0x93, 0xf4, 0xc6, 0xc0, 0x37, 0x8a, 0xbc, 0xc6, 0x66, 0x5a, 0xa1, 0xc1, 0xc8,
+	0xc2, 0x97, 0xa8, 0xfb, 0x66, 0xe2, 0xc9, 0x02, 0x6f, 0x50, 0x8f, 0x4f, 0xbb, 0xee, 0x83, 0x67,
+	0x8e, 0x11, 0x4f, 0x70, 0xf8, 0x47, 0xc9, 0xc5, 0x9e, 0x1f, 0xe7, 0xff, 0x2c, 0xd1, 0xd1, 0xfb,
+	0x68, 0xb3, 0x2b, 0x05, 0xff, 0x2a, 0xf1, 0x9b, 0x6c, 0x79, 0x84, 0xb9, 0xe1, 0x9a, 0x5e, 0xa6,
+	0x7f, 0x97, 0xf8, 0x3a, 0x5b, 0xa5, 0xe1, 0x96, 0x36, 0x06, 0x29, 0x49, 0x63, 0xa5, 0x67, 0xe0,
+	0x3f, 0x25, 0x7e, 0x8b, 0xad, 0xec, 0xa3, 0x4d, 0x13, 0x9e, 0x63, 0xfe, 0xb7, 0xc4, 0xe7, 0xd8,
+	0x6c, 0x1b, 0x6d, 0x24, 0xf1, 0x12, 0xe1, 0xcd, 0x12, 0x55, 0x6d, 0x44, 0x26, 0xee, 0xbc, 0x55,
+	0xa2, 0x5c, 0x7e, 0x5a, 0x58, 0xaf, 0xd7, 0x0c, 0x76, 0x7a, 0x22, 0x0c, 0x51, 0x19, 0x78, 0xbb,
+	0xc4, 0x97, 0x19, 0xb4, 0x31, 0xd0, 0x97, 0x98, 0x83, 0xdf, 0xa1, 0xad, 0xca, 0x9d, 0xf0, 0x6b,
+	0x03, 0x8c, 0x86, 0x29, 0xe3, 0xdd, 0x12, 0xe5, 0x3e, 0x96, 0x1f, 0xe7, 0xbc, 0xe7, 0x54, 0xf2,
+	0xe1, 0x26, 0xce, 0x7d, 0xa1, 0x4c, 0x2a, 0xbb, 0xc6, 0xca, 0x60, 0x54, 0x96, 0x36, 0x7a, 0x42,
+	0x29, 0xf8, 0x62, 0x99, 0xca, 0x95, 0x54, 0xaf, 0x15, 0x5e, 0x68, 0xf8, 0x63, 0x99, 0x02, 0x39,
+	0x95, 0x01, 0x9e, 0x4a, 0xef, 0x09, 0x7c, 0xb3, 0x4a, 0x81, 0xb8, 0x73, 0x8e, 0xb4, 0x8f, 0x64,
+	0xd4, 0xc0, 0xb7, 0xaa, 0x54, 0x3e, 0x2a, 0x7f, 0x5c, 0xad, 0x6f, 0x3b, 0x3a, 0x99, 0x6b, 0xad,
+	0x26, 0x7c, 0x87, 0x96, 0x33, 0x4b, 0xe8, 0xd3, 0xce, 0x31, 0x7c, 0xb7, 0x4a, 0x91, 0x6f, 0x29,
+	0xa5, 0x3d, 0x61, 0xd3, 0x26, 0xfc, 0x5e, 0x95, 0xba, 0x38, 0x37, 0x92, 0x12, 0x77, 0xbf, 0x5f,
+	0xa5, 0x8c, 0x24, 0xb8, 0xab, 0x74, 0x93, 0x46, 0xd5, 0x0f, 0x9c, 0x55, 0x7a, 0x73, 0x92, 0x27,
+	0xa7, 0x16, 0x7e, 0xe8, 0xe4, 0x26, 0x17, 0x15, 0xfc, 0xa9, 0x96, 0x54, 0x3d, 0x87, 0xfd, 0xb9,
+	0x46, 0xa2, 0x93, 0xcb, 0x09, 0xfe, 0xe2, 0xe0, 0xc9, 0xb5, 0x04, 0x7f, 0xad, 0x91, 0x63, 0xf9,
+	0x85, 0x14, 0x8a, 0x00, 0x0d, 0xfc, 0xad, 0x46, 0x1e, 0x64, 0xeb, 0x08, 0x7e, 0x54, 0xa7, 0x64,
+	0x8d, 0x16, 0x11, 0xfc, 0xb8, 0x4e, 0x61, 0x4e, 0xac, 0x20, 0xf8, 0x49, 0x9d, 0xb4, 0xb2, 0xe5,
+	0x03, 0x3f, 0xcd, 0x01, 0x24, 0x05, 0x3f, 0xab, 0x93, 0x1b, 0x93, 0x0b, 0x07, 0x7e, 0x5e, 0x8f,
+	0x8b, 0x93, 0xae, 0x1a, 0xf8, 0x85, 0xd3, 0x24, 0xc7, 0x4e, 0xb4, 0x92, 0xde, 0x10, 0x7e, 0xe9,
+	0x4e, 0xec, 0xa0, 0x75, 0x9b, 0x66, 0x4f, 0xd0, 0x03, 0xc7, 0xc0, 0x57, 0xe6, 0x36, 0x5f, 0x61,
+	0xec, 0xf8, 0xfc, 0x75, 0xf4, 0xac, 0x9b, 0xca, 0x0d, 0xc6, 0x72, 0xb3, 0x6e, 0x8a, 0x06, 0xfb,
+	0xbe, 0xd2, 0xe7, 0x42, 0x41, 0x81, 0xcf, 0xb2, 0xb2, 0x73, 0xa2, 0xb8, 0xf9, 0xe5, 0x69, 0x36,
+	0x1f, 0x2b, 0xa5, 0x3e, 0xd0, 0x5b, 0x23, 0x25, 0xb6, 0x94, 0x82, 0x29, 0xfe, 0x02, 0xbb, 0x91,
+	0x22, 0xd7, 0x66, 0x7b, 0x81, 0x36, 0x67, 0xca, 0x9e, 0x18, 0xf2, 0x45, 0xfe, 0x12, 0xbb, 0x95,
+	0x31, 0xaf, 0x8f, 0x76, 0xba, 0x7e, 0x6b, 0xa9, 0xc0, 0xe4, 0x8c, 0x2f, 0xd3, 0x8e, 0x48, 0xb9,
+	0xd4, 0x7d, 0xf1, 0x5b, 0x32, 0x85, 0x92, 0xd9, 0x05, 0x33, 0xf4, 0x10, 0x48, 0xd1, 0x7d, 0xcc,
+	0xf7, 0x56, 0x65, 0xec, 0x88, 0xc9, 0x01, 0x3e, 0x3b, 0xa6, 0x39, 0x3e, 0xc8, 0xab, 0x63, 0xa1,
+	0x4d, 0x4c, 0x69, 0x46, 0x2f, 0x98, 0x09, 0xb3, 0xf1, 0xdd, 0xa8, 0x8d, 0x71, 0x1c, 0xd6, 0x44,
+	0x2b, 0xa4, 0x82, 0x3a, 0x2d, 0xb8, 0xb1, 0xc3, 0x62, 0x8d, 0x39, 0x5a, 0x70, 0x39, 0x0d, 0x37,
+	0x03, 0x1b, 0x63, 0x60, 0x32, 0x0b, 0xe7, 0xc7, 0xc0, 0x64, 0x0e, 0x02, 0x2d, 0xbd, 0x14, 0x74,
+	0x37, 0x17, 0x16, 0xc6, 0xb0, 0x78, 0x78, 0xf2, 0x31, 0xc7, 0x0e, 0x45, 0x28, 0xba, 0xc9, 0xea,
+	0x5b, 0x7c, 0x4e, 0x8e, 0x8e, 0x9f, 0x86, 0x18, 0x99, 0x9e, 0xec, 0xc3, 0xd2, 0xb5, 0x1c, 0x65,
+	0xbc, 0x65, 0x7a, 0x58, 0xa7, 0xbc, 0xf8, 0x5e, 0xb9, 0xe6, 0x5a, 0x19, 0xaf, 0xac, 0x6b, 0xe9,
+	0x4c, 0x6d, 0x75, 0x8c, 0x1b, 0xbb, 0x92, 0x71, 0xd7, 0x36, 0x37, 0x58, 0xa5, 0x69, 0x94, 0xeb,
+	0xe4, 0x0a, 0x2b, 0x35, 0x0d, 0xb5, 0x61, 0x83, 0xb1, 0x6d, 0xad, 0xd5, 0xee, 0x55, 0x3f, 0x7a,
+	0xf4, 0x71, 0x28, 0x6c, 0x7f, 0xe2, 0xb3, 0xf7, 0xbb, 0xd2, 0xf6, 0x06, 0xe7, 0xf4, 0x63, 0x74,
+	0x2f, 0xfe, 0x53, 0x7a, 0x59, 0xea, 0xe4, 0xeb, 0x9e, 0x0c, 0x2d, 0xdd, 0x62, 0x75, 0xcf, 0xfd,
+	0x3c, 0xdd, 0x8b, 0x7f, 0x9e, 0xfa, 0xe7, 0xe7, 0x33, 0x8e, 0xbe, 0xff, 0xbf, 0x01, 0x00, 0x3a,
+	0x0a, 0xa1, 0x68, 0x8d, 0x0f, 0x00, 0x00,
 }
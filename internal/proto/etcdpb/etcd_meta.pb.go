@@ -144,6 +144,7 @@ type IndexInfo struct {
 	IndexName            string                   `protobuf:"bytes,1,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
 	IndexID              int64                    `protobuf:"varint,2,opt,name=indexID,proto3" json:"indexID,omitempty"`
 	IndexParams          []*commonpb.KeyValuePair `protobuf:"bytes,3,rep,name=index_params,json=indexParams,proto3" json:"index_params,omitempty"`
+	ReplacedIndexID      int64                    `protobuf:"varint,4,opt,name=replaced_indexID,json=replacedIndexID,proto3" json:"replaced_indexID,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
 	XXX_unrecognized     []byte                   `json:"-"`
 	XXX_sizecache        int32                    `json:"-"`
@@ -195,6 +196,13 @@ func (m *IndexInfo) GetIndexParams() []*commonpb.KeyValuePair {
 	return nil
 }
 
+func (m *IndexInfo) GetReplacedIndexID() int64 {
+	if m != nil {
+		return m.ReplacedIndexID
+	}
+	return 0
+}
+
 type FieldIndexInfo struct {
 	FiledID              int64    `protobuf:"varint,1,opt,name=filedID,proto3" json:"filedID,omitempty"`
 	IndexID              int64    `protobuf:"varint,2,opt,name=indexID,proto3" json:"indexID,omitempty"`
@@ -540,52 +548,53 @@ func init() {
 func init() { proto.RegisterFile("etcd_meta.proto", fileDescriptor_975d306d62b73e88) }
 
 var fileDescriptor_975d306d62b73e88 = []byte{
-	// 745 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xcd, 0x6e, 0xe3, 0x36,
-	0x10, 0x86, 0x22, 0xc7, 0x8e, 0xc6, 0x8e, 0x9d, 0xb0, 0x3f, 0x20, 0x82, 0xb4, 0x55, 0x04, 0x24,
-	0x15, 0x50, 0xd4, 0x46, 0x93, 0xa2, 0xb7, 0x02, 0x6d, 0x23, 0x04, 0x30, 0x8a, 0x06, 0xae, 0x62,
-	0xf4, 0xd0, 0x8b, 0x40, 0x4b, 0xb4, 0x4d, 0x40, 0xa4, 0xbc, 0x22, 0x15, 0xc4, 0xb7, 0x3d, 0xef,
-	0x23, 0xec, 0x23, 0xec, 0x8b, 0xed, 0x61, 0x5f, 0x62, 0x21, 0x52, 0x92, 0xed, 0xc4, 0x39, 0xee,
-	0x4d, 0xf3, 0xcd, 0x0c, 0xf9, 0xcd, 0xc7, 0x6f, 0x04, 0x03, 0xaa, 0xe2, 0x24, 0xe2, 0x54, 0x91,
-	0xe1, 0x2a, 0xcf, 0x54, 0x86, 0x4e, 0x39, 0x4b, 0x1f, 0x0b, 0x69, 0xa2, 0x61, 0x99, 0x3d, 0xeb,
-	0xc5, 0x19, 0xe7, 0x99, 0x30, 0xd0, 0x59, 0x4f, 0xc6, 0x4b, 0xca, 0xab, 0x72, 0xef, 0xbd, 0x05,
-	0x30, 0xa5, 0x82, 0x08, 0xf5, 0x0f, 0x55, 0x04, 0xf5, 0xe1, 0x60, 0x1c, 0x60, 0xcb, 0xb5, 0x7c,
-	0x3b, 0x3c, 0x18, 0x07, 0xe8, 0x0a, 0x06, 0xa2, 0xe0, 0xd1, 0x9b, 0x82, 0xe6, 0xeb, 0x48, 0x64,
-	0x09, 0x95, 0xf8, 0x40, 0x27, 0x8f, 0x45, 0xc1, 0xff, 0x2d, 0xd1, 0xfb, 0x12, 0x44, 0x3f, 0xc1,
-	0x29, 0x13, 0x92, 0xe6, 0x2a, 0x8a, 0x97, 0x44, 0x08, 0x9a, 0x8e, 0x03, 0x89, 0x6d, 0xd7, 0xf6,
-	0x9d, 0xf0, 0xc4, 0x24, 0x6e, 0x1b, 0x1c, 0xfd, 0x08, 0x03, 0x73, 0x60, 0x53, 0x8b, 0x5b, 0xae,
-	0xe5, 0x3b, 0x61, 0x5f, 0xc3, 0x4d, 0xa5, 0xf7, 0xd6, 0x02, 0x67, 0x92, 0x67, 0x4f, 0xeb, 0xbd,
-	0xdc, 0x7e, 0x83, 0x0e, 0x49, 0x92, 0x9c, 0x4a, 0xc3, 0xa9, 0x7b, 0x7d, 0x3e, 0xdc, 0x99, 0xbd,
-	0x9a, 0xfa, 0x4f, 0x53, 0x13, 0xd6, 0xc5, 0x25, 0xd7, 0x9c, 0xca, 0x22, 0xdd, 0xc7, 0xd5, 0x24,
-	0x36, 0x5c, 0xbd, 0x77, 0x16, 0x38, 0x63, 0x91, 0xd0, 0xa7, 0xb1, 0x98, 0x67, 0xe8, 0x3b, 0x00,
-	0x56, 0x06, 0x91, 0x20, 0x9c, 0x6a, 0x2a, 0x4e, 0xe8, 0x68, 0xe4, 0x9e, 0x70, 0x8a, 0x30, 0x74,
-	0x74, 0x30, 0x0e, 0x2a, 0x95, 0xea, 0x10, 0x05, 0xd0, 0x33, 0x8d, 0x2b, 0x92, 0x13, 0x6e, 0xae,
-	0xeb, 0x5e, 0x5f, 0xec, 0x25, 0xfc, 0x37, 0x5d, 0xff, 0x47, 0xd2, 0x82, 0x4e, 0x08, 0xcb, 0xc3,
-	0xae, 0x6e, 0x9b, 0xe8, 0x2e, 0x2f, 0x80, 0xfe, 0x1d, 0xa3, 0x69, 0xb2, 0x21, 0x84, 0xa1, 0x33,
-	0x67, 0x29, 0x4d, 0x1a, 0x61, 0xea, 0xf0, 0x75, 0x2e, 0xde, 0x87, 0x16, 0xf4, 0x6f, 0xb3, 0x34,
-	0xa5, 0xb1, 0x62, 0x99, 0xd0, 0xc7, 0x3c, 0x97, 0xf6, 0x77, 0x68, 0x1b, 0x97, 0x54, 0xca, 0x5e,
-	0xee, 0x12, 0xad, 0x1c, 0xb4, 0x39, 0xe4, 0x41, 0x03, 0x61, 0xd5, 0x84, 0x7e, 0x80, 0x6e, 0x9c,
-	0x53, 0xa2, 0x68, 0xa4, 0x18, 0xa7, 0xd8, 0x76, 0x2d, 0xbf, 0x15, 0x82, 0x81, 0xa6, 0x8c, 0x53,
-	0xe4, 0x41, 0x6f, 0x45, 0x72, 0xc5, 0x34, 0x81, 0x40, 0xe2, 0x96, 0x6b, 0xfb, 0x76, 0xb8, 0x83,
-	0xa1, 0x2b, 0xe8, 0x37, 0x71, 0xa9, 0xae, 0xc4, 0x87, 0xfa, 0x8d, 0x9e, 0xa1, 0xe8, 0x0e, 0x8e,
-	0xe7, 0xa5, 0x28, 0x91, 0x9e, 0x8f, 0x4a, 0xdc, 0xde, 0xa7, 0x6d, 0xb9, 0x08, 0xc3, 0x5d, 0xf1,
-	0xc2, 0xde, 0xbc, 0x89, 0xa9, 0x44, 0xd7, 0xf0, 0xcd, 0x23, 0xcb, 0x55, 0x41, 0xd2, 0xda, 0x17,
-	0xfa, 0x95, 0x25, 0xee, 0xe8, 0x6b, 0xbf, 0xaa, 0x92, 0x95, 0x37, 0xcc, 0xdd, 0xbf, 0xc2, 0xb7,
-	0xab, 0xe5, 0x5a, 0xb2, 0xf8, 0x45, 0xd3, 0x91, 0x6e, 0xfa, 0xba, 0xce, 0xee, 0x74, 0xfd, 0x01,
-	0xe7, 0xcd, 0x0c, 0x91, 0x51, 0x25, 0xd1, 0x4a, 0x49, 0x45, 0xf8, 0x4a, 0x62, 0xc7, 0xb5, 0xfd,
-	0x56, 0x78, 0xd6, 0xd4, 0xdc, 0x9a, 0x92, 0x69, 0x53, 0x51, 0xfa, 0x50, 0x2e, 0x49, 0x9e, 0xc8,
-	0x48, 0x14, 0x1c, 0x83, 0x6b, 0xf9, 0x87, 0xa1, 0x63, 0x90, 0xfb, 0x82, 0xa3, 0x31, 0x0c, 0xa4,
-	0x22, 0xb9, 0x8a, 0x56, 0x99, 0xd4, 0x27, 0x48, 0xdc, 0xd5, 0xa2, 0xb8, 0xaf, 0x19, 0x2e, 0x20,
-	0x8a, 0x68, 0xbf, 0xf5, 0x75, 0xe3, 0xa4, 0xee, 0xf3, 0x3e, 0x5a, 0x70, 0xf2, 0x40, 0x17, 0x9c,
-	0x0a, 0xb5, 0x71, 0x9d, 0x07, 0xbd, 0x78, 0x63, 0xa0, 0xda, 0x38, 0x3b, 0x18, 0x72, 0xa1, 0xbb,
-	0xf5, 0x9c, 0x95, 0x07, 0xb7, 0x21, 0x74, 0x0e, 0x8e, 0xac, 0x4e, 0x0e, 0xb4, 0x47, 0xec, 0x70,
-	0x03, 0x18, 0x67, 0x97, 0xcf, 0x63, 0x7e, 0x0e, 0xda, 0xd9, 0x3a, 0xdc, 0x76, 0xf6, 0xe1, 0xee,
-	0x96, 0x61, 0xe8, 0xcc, 0x0a, 0xa6, 0x7b, 0xda, 0x26, 0x53, 0x85, 0xe8, 0x02, 0x7a, 0x54, 0x90,
-	0x59, 0x4a, 0x8d, 0x4b, 0x70, 0xc7, 0xb5, 0xfc, 0xa3, 0xb0, 0x6b, 0x30, 0x3d, 0x98, 0xf7, 0xc9,
-	0xda, 0x5e, 0x8b, 0xbd, 0x7f, 0x9c, 0x2f, 0xbd, 0x16, 0xdf, 0x03, 0x34, 0x02, 0xd4, 0x4b, 0xb1,
-	0x85, 0xa0, 0xcb, 0xad, 0x95, 0x88, 0x14, 0x59, 0xd4, 0x2b, 0x71, 0xdc, 0xa0, 0x53, 0xb2, 0x90,
-	0x2f, 0xb6, 0xab, 0xfd, 0x72, 0xbb, 0xfe, 0xba, 0xf9, 0xff, 0x97, 0x05, 0x53, 0xcb, 0x62, 0x56,
-	0x9a, 0x60, 0x64, 0xc6, 0xf8, 0x99, 0x65, 0xd5, 0xd7, 0x88, 0x09, 0x45, 0x73, 0x41, 0xd2, 0x91,
-	0x9e, 0x6c, 0x54, 0x6e, 0xcf, 0x6a, 0x36, 0x6b, 0xeb, 0xe8, 0xe6, 0x73, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0x9e, 0xc5, 0x2c, 0x1a, 0x75, 0x06, 0x00, 0x00,
+	// 758 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xcd, 0x8e, 0xe3, 0x44,
+	0x10, 0x96, 0xc7, 0x99, 0x64, 0x5d, 0xc9, 0x24, 0xb3, 0xcd, 0x8f, 0x5a, 0xa3, 0x01, 0xbc, 0x96,
+	0x76, 0x31, 0x42, 0xcc, 0x88, 0x59, 0xc4, 0x0d, 0x09, 0x18, 0x6b, 0xa5, 0x08, 0x31, 0x0a, 0xde,
+	0x88, 0x03, 0x17, 0xab, 0x63, 0x57, 0x92, 0x96, 0xdc, 0x6d, 0xe3, 0x6e, 0xaf, 0x36, 0x37, 0x9e,
+	0x83, 0x47, 0xe0, 0xce, 0x33, 0x71, 0xe0, 0x25, 0x90, 0xbb, 0x6d, 0x27, 0xd9, 0xc9, 0x1e, 0xb9,
+	0xb9, 0xbe, 0xaa, 0xea, 0xfa, 0xfb, 0x3e, 0xc3, 0x0c, 0x75, 0x9a, 0x25, 0x02, 0x35, 0xbb, 0x29,
+	0xab, 0x42, 0x17, 0xe4, 0xa9, 0xe0, 0xf9, 0x9b, 0x5a, 0x59, 0xeb, 0xa6, 0xf1, 0x5e, 0x4d, 0xd2,
+	0x42, 0x88, 0x42, 0x5a, 0xe8, 0x6a, 0xa2, 0xd2, 0x2d, 0x8a, 0x36, 0x3c, 0xf8, 0xd3, 0x01, 0x58,
+	0xa2, 0x64, 0x52, 0xff, 0x8c, 0x9a, 0x91, 0x29, 0x9c, 0xcd, 0x23, 0xea, 0xf8, 0x4e, 0xe8, 0xc6,
+	0x67, 0xf3, 0x88, 0xbc, 0x80, 0x99, 0xac, 0x45, 0xf2, 0x7b, 0x8d, 0xd5, 0x2e, 0x91, 0x45, 0x86,
+	0x8a, 0x9e, 0x19, 0xe7, 0x85, 0xac, 0xc5, 0x2f, 0x0d, 0xfa, 0xd0, 0x80, 0xe4, 0x4b, 0x78, 0xca,
+	0xa5, 0xc2, 0x4a, 0x27, 0xe9, 0x96, 0x49, 0x89, 0xf9, 0x3c, 0x52, 0xd4, 0xf5, 0xdd, 0xd0, 0x8b,
+	0x2f, 0xad, 0xe3, 0xbe, 0xc7, 0xc9, 0xe7, 0x30, 0xb3, 0x0f, 0xf6, 0xb1, 0x74, 0xe0, 0x3b, 0xa1,
+	0x17, 0x4f, 0x0d, 0xdc, 0x47, 0x06, 0x7f, 0x38, 0xe0, 0x2d, 0xaa, 0xe2, 0xed, 0xee, 0x64, 0x6f,
+	0xdf, 0xc2, 0x88, 0x65, 0x59, 0x85, 0xca, 0xf6, 0x34, 0xbe, 0xbb, 0xbe, 0x39, 0x9a, 0xbd, 0x9d,
+	0xfa, 0x07, 0x1b, 0x13, 0x77, 0xc1, 0x4d, 0xaf, 0x15, 0xaa, 0x3a, 0x3f, 0xd5, 0xab, 0x75, 0xec,
+	0x7b, 0x0d, 0xfe, 0x76, 0xc0, 0x9b, 0xcb, 0x0c, 0xdf, 0xce, 0xe5, 0xba, 0x20, 0x9f, 0x00, 0xf0,
+	0xc6, 0x48, 0x24, 0x13, 0x68, 0x5a, 0xf1, 0x62, 0xcf, 0x20, 0x0f, 0x4c, 0x20, 0xa1, 0x30, 0x32,
+	0xc6, 0x3c, 0x6a, 0xb7, 0xd4, 0x99, 0x24, 0x82, 0x89, 0x4d, 0x2c, 0x59, 0xc5, 0x84, 0x2d, 0x37,
+	0xbe, 0x7b, 0x76, 0xb2, 0xe1, 0x9f, 0x70, 0xf7, 0x2b, 0xcb, 0x6b, 0x5c, 0x30, 0x5e, 0xc5, 0x63,
+	0x93, 0xb6, 0x30, 0x59, 0xe4, 0x0b, 0xb8, 0xac, 0xb0, 0xcc, 0x59, 0x8a, 0x59, 0xd2, 0x15, 0x1a,
+	0x98, 0x42, 0xb3, 0x0e, 0xb7, 0xbd, 0x46, 0x41, 0x04, 0xd3, 0x57, 0x1c, 0xf3, 0x6c, 0xdf, 0x3b,
+	0x85, 0xd1, 0x9a, 0xe7, 0x98, 0xf5, 0x3b, 0xec, 0xcc, 0xf7, 0xb7, 0x1d, 0xfc, 0x35, 0x80, 0xe9,
+	0x7d, 0x91, 0xe7, 0x98, 0x6a, 0x5e, 0x48, 0xf3, 0xcc, 0xbb, 0x57, 0xf8, 0x0e, 0x86, 0x96, 0x50,
+	0xed, 0x11, 0x9e, 0x1f, 0xcf, 0xd4, 0x92, 0x6d, 0xff, 0xc8, 0x6b, 0x03, 0xc4, 0x6d, 0x12, 0xf9,
+	0x0c, 0xc6, 0x69, 0x85, 0x4c, 0x63, 0xa2, 0xb9, 0x40, 0xea, 0xfa, 0x4e, 0x38, 0x88, 0xc1, 0x42,
+	0x4b, 0x2e, 0x90, 0x04, 0x30, 0x29, 0x59, 0xa5, 0xb9, 0x69, 0x20, 0x52, 0x74, 0xe0, 0xbb, 0xa1,
+	0x1b, 0x1f, 0x61, 0xe4, 0x05, 0x4c, 0x7b, 0xbb, 0x39, 0x84, 0xa2, 0xe7, 0xe6, 0x9c, 0xef, 0xa0,
+	0xe4, 0x15, 0x5c, 0xac, 0x9b, 0xa5, 0xd8, 0xe5, 0xa1, 0xa2, 0xc3, 0x53, 0x67, 0x68, 0x34, 0x73,
+	0x73, 0xbc, 0xbc, 0x78, 0xb2, 0xee, 0x6d, 0x54, 0xe4, 0x0e, 0x3e, 0x7a, 0xc3, 0x2b, 0x5d, 0xb3,
+	0xbc, 0xa3, 0x90, 0x21, 0x84, 0xa2, 0x23, 0x53, 0xf6, 0x83, 0xd6, 0xd9, 0xd2, 0xc8, 0xd6, 0xfe,
+	0x06, 0x3e, 0x2e, 0xb7, 0x3b, 0xc5, 0xd3, 0x47, 0x49, 0x4f, 0x4c, 0xd2, 0x87, 0x9d, 0xf7, 0x28,
+	0xeb, 0x7b, 0xb8, 0xee, 0x67, 0x48, 0xec, 0x56, 0x32, 0xb3, 0x29, 0xa5, 0x99, 0x28, 0x15, 0xf5,
+	0x7c, 0x37, 0x1c, 0xc4, 0x57, 0x7d, 0xcc, 0xbd, 0x0d, 0x59, 0xf6, 0x11, 0x0d, 0x65, 0xd5, 0x96,
+	0x55, 0x99, 0x4a, 0x64, 0x2d, 0x28, 0xf8, 0x4e, 0x78, 0x1e, 0x7b, 0x16, 0x79, 0xa8, 0x05, 0x99,
+	0xc3, 0x4c, 0x69, 0x56, 0xe9, 0xa4, 0x2c, 0x94, 0x79, 0x41, 0xd1, 0xb1, 0x59, 0x8a, 0xff, 0x3e,
+	0x6e, 0x46, 0x4c, 0x33, 0x43, 0xcd, 0xa9, 0x49, 0x5c, 0x74, 0x79, 0xc1, 0x3f, 0x0e, 0x5c, 0xbe,
+	0xc6, 0x8d, 0x40, 0xa9, 0xf7, 0xac, 0x0b, 0x60, 0x92, 0xee, 0x09, 0xd4, 0x11, 0xe7, 0x08, 0x23,
+	0x3e, 0x8c, 0x0f, 0xce, 0xd9, 0x72, 0xf0, 0x10, 0x22, 0xd7, 0xe0, 0xa9, 0xf6, 0xe5, 0xc8, 0x70,
+	0xc4, 0x8d, 0xf7, 0x80, 0x65, 0x76, 0x73, 0x9e, 0x4e, 0x0d, 0x9d, 0x79, 0xc8, 0xec, 0xf3, 0x63,
+	0x41, 0x52, 0x18, 0xad, 0x6a, 0x6e, 0x72, 0x86, 0xd6, 0xd3, 0x9a, 0xe4, 0x19, 0x4c, 0x50, 0xb2,
+	0x55, 0x8e, 0x96, 0x25, 0x74, 0xe4, 0x3b, 0xe1, 0x93, 0x78, 0x6c, 0x31, 0x33, 0x58, 0xf0, 0xaf,
+	0x73, 0x28, 0x8b, 0x93, 0x3f, 0xa7, 0xff, 0x5b, 0x16, 0x9f, 0x02, 0xf4, 0x0b, 0xe8, 0x44, 0x71,
+	0x80, 0x90, 0xe7, 0x07, 0x92, 0x48, 0x34, 0xdb, 0x74, 0x92, 0xb8, 0xe8, 0xd1, 0x25, 0xdb, 0xa8,
+	0x47, 0xea, 0x1a, 0x3e, 0x56, 0xd7, 0x8f, 0x2f, 0x7f, 0xfb, 0x7a, 0xc3, 0xf5, 0xb6, 0x5e, 0x35,
+	0x24, 0xb8, 0xb5, 0x63, 0x7c, 0xc5, 0x8b, 0xf6, 0xeb, 0x96, 0x4b, 0x8d, 0x95, 0x64, 0xf9, 0xad,
+	0x99, 0xec, 0xb6, 0x51, 0x4f, 0xb9, 0x5a, 0x0d, 0x8d, 0xf5, 0xf2, 0xbf, 0x01, 0x00, 0xed, 0x32,
+	0xed, 0x38, 0xa0, 0x06, 0x00, 0x00,
 }
@@ -27,6 +27,40 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
+// IndexBuildStep is the part of an index build an IndexNode executes. A huge segment is indexed in several steps
+// on different IndexNodes: the index is trained on a sample of the segment, the shards of the segment are added to
+// copies of the trained index, then the shard indexes are merged in order.
+type IndexBuildStep int32
+
+const (
+	IndexBuildStep_BuildAll IndexBuildStep = 0
+	IndexBuildStep_Train    IndexBuildStep = 1
+	IndexBuildStep_AddShard IndexBuildStep = 2
+	IndexBuildStep_Merge    IndexBuildStep = 3
+)
+
+var IndexBuildStep_name = map[int32]string{
+	0: "BuildAll",
+	1: "Train",
+	2: "AddShard",
+	3: "Merge",
+}
+
+var IndexBuildStep_value = map[string]int32{
+	"BuildAll": 0,
+	"Train":    1,
+	"AddShard": 2,
+	"Merge":    3,
+}
+
+func (x IndexBuildStep) String() string {
+	return proto.EnumName(IndexBuildStep_name, int32(x))
+}
+
+func (IndexBuildStep) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f9e019eb3fda53c2, []int{0}
+}
+
 type RegisterNodeRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	Address              *commonpb.Address `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
@@ -174,6 +208,12 @@ type IndexInfo struct {
 	IndexID              int64               `protobuf:"varint,3,opt,name=indexID,proto3" json:"indexID,omitempty"`
 	IndexName            string              `protobuf:"bytes,4,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
 	Reason               string              `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	IndexedRows          int64               `protobuf:"varint,6,opt,name=indexed_rows,json=indexedRows,proto3" json:"indexed_rows,omitempty"`
+	TotalRows            int64               `protobuf:"varint,7,opt,name=total_rows,json=totalRows,proto3" json:"total_rows,omitempty"`
+	RetryCount           int64               `protobuf:"varint,8,opt,name=retry_count,json=retryCount,proto3" json:"retry_count,omitempty"`
+	NodeID               int64               `protobuf:"varint,9,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	SerializedSize       int64               `protobuf:"varint,10,opt,name=serialized_size,json=serializedSize,proto3" json:"serialized_size,omitempty"`
+	BuildTimeMs          int64               `protobuf:"varint,11,opt,name=build_time_ms,json=buildTimeMs,proto3" json:"build_time_ms,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
 	XXX_unrecognized     []byte              `json:"-"`
 	XXX_sizecache        int32               `json:"-"`
@@ -239,6 +279,48 @@ func (m *IndexInfo) GetReason() string {
 	return ""
 }
 
+func (m *IndexInfo) GetIndexedRows() int64 {
+	if m != nil {
+		return m.IndexedRows
+	}
+	return 0
+}
+
+func (m *IndexInfo) GetTotalRows() int64 {
+	if m != nil {
+		return m.TotalRows
+	}
+	return 0
+}
+
+func (m *IndexInfo) GetRetryCount() int64 {
+	if m != nil {
+		return m.RetryCount
+	}
+	return 0
+}
+
+func (m *IndexInfo) GetNodeID() int64 {
+	if m != nil {
+		return m.NodeID
+	}
+	return 0
+}
+
+func (m *IndexInfo) GetSerializedSize() int64 {
+	if m != nil {
+		return m.SerializedSize
+	}
+	return 0
+}
+
+func (m *IndexInfo) GetBuildTimeMs() int64 {
+	if m != nil {
+		return m.BuildTimeMs
+	}
+	return 0
+}
+
 type GetIndexStatesResponse struct {
 	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 	States               []*IndexInfo     `protobuf:"bytes,2,rep,name=states,proto3" json:"states,omitempty"`
@@ -287,17 +369,21 @@ func (m *GetIndexStatesResponse) GetStates() []*IndexInfo {
 }
 
 type CreateIndexRequest struct {
-	IndexBuildID         int64                    `protobuf:"varint,1,opt,name=indexBuildID,proto3" json:"indexBuildID,omitempty"`
-	IndexName            string                   `protobuf:"bytes,2,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
-	IndexID              int64                    `protobuf:"varint,3,opt,name=indexID,proto3" json:"indexID,omitempty"`
-	Version              int64                    `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
-	MetaPath             string                   `protobuf:"bytes,5,opt,name=meta_path,json=metaPath,proto3" json:"meta_path,omitempty"`
-	DataPaths            []string                 `protobuf:"bytes,6,rep,name=data_paths,json=dataPaths,proto3" json:"data_paths,omitempty"`
-	TypeParams           []*commonpb.KeyValuePair `protobuf:"bytes,7,rep,name=type_params,json=typeParams,proto3" json:"type_params,omitempty"`
-	IndexParams          []*commonpb.KeyValuePair `protobuf:"bytes,8,rep,name=index_params,json=indexParams,proto3" json:"index_params,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
-	XXX_unrecognized     []byte                   `json:"-"`
-	XXX_sizecache        int32                    `json:"-"`
+	IndexBuildID int64                    `protobuf:"varint,1,opt,name=indexBuildID,proto3" json:"indexBuildID,omitempty"`
+	IndexName    string                   `protobuf:"bytes,2,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
+	IndexID      int64                    `protobuf:"varint,3,opt,name=indexID,proto3" json:"indexID,omitempty"`
+	Version      int64                    `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
+	MetaPath     string                   `protobuf:"bytes,5,opt,name=meta_path,json=metaPath,proto3" json:"meta_path,omitempty"`
+	DataPaths    []string                 `protobuf:"bytes,6,rep,name=data_paths,json=dataPaths,proto3" json:"data_paths,omitempty"`
+	TypeParams   []*commonpb.KeyValuePair `protobuf:"bytes,7,rep,name=type_params,json=typeParams,proto3" json:"type_params,omitempty"`
+	IndexParams  []*commonpb.KeyValuePair `protobuf:"bytes,8,rep,name=index_params,json=indexParams,proto3" json:"index_params,omitempty"`
+	Step         IndexBuildStep           `protobuf:"varint,9,opt,name=step,proto3,enum=milvus.proto.index.IndexBuildStep" json:"step,omitempty"`
+	// the trained index for AddShard, the shard indexes in segment order for Merge
+	InputIndexes         []*IndexFilePathInfo `protobuf:"bytes,10,rep,name=input_indexes,json=inputIndexes,proto3" json:"input_indexes,omitempty"`
+	NumRows              int64                `protobuf:"varint,11,opt,name=num_rows,json=numRows,proto3" json:"num_rows,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
 func (m *CreateIndexRequest) Reset()         { *m = CreateIndexRequest{} }
@@ -381,6 +467,27 @@ func (m *CreateIndexRequest) GetIndexParams() []*commonpb.KeyValuePair {
 	return nil
 }
 
+func (m *CreateIndexRequest) GetStep() IndexBuildStep {
+	if m != nil {
+		return m.Step
+	}
+	return IndexBuildStep_BuildAll
+}
+
+func (m *CreateIndexRequest) GetInputIndexes() []*IndexFilePathInfo {
+	if m != nil {
+		return m.InputIndexes
+	}
+	return nil
+}
+
+func (m *CreateIndexRequest) GetNumRows() int64 {
+	if m != nil {
+		return m.NumRows
+	}
+	return 0
+}
+
 type BuildIndexRequest struct {
 	IndexBuildID         int64                    `protobuf:"varint,1,opt,name=indexBuildID,proto3" json:"indexBuildID,omitempty"`
 	IndexName            string                   `protobuf:"bytes,2,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
@@ -388,6 +495,9 @@ type BuildIndexRequest struct {
 	DataPaths            []string                 `protobuf:"bytes,5,rep,name=data_paths,json=dataPaths,proto3" json:"data_paths,omitempty"`
 	TypeParams           []*commonpb.KeyValuePair `protobuf:"bytes,6,rep,name=type_params,json=typeParams,proto3" json:"type_params,omitempty"`
 	IndexParams          []*commonpb.KeyValuePair `protobuf:"bytes,7,rep,name=index_params,json=indexParams,proto3" json:"index_params,omitempty"`
+	NumRows              int64                    `protobuf:"varint,8,opt,name=num_rows,json=numRows,proto3" json:"num_rows,omitempty"`
+	Step                 IndexBuildStep           `protobuf:"varint,9,opt,name=step,proto3,enum=milvus.proto.index.IndexBuildStep" json:"step,omitempty"`
+	InputIndexes         []*IndexFilePathInfo     `protobuf:"bytes,10,rep,name=input_indexes,json=inputIndexes,proto3" json:"input_indexes,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
 	XXX_unrecognized     []byte                   `json:"-"`
 	XXX_sizecache        int32                    `json:"-"`
@@ -460,6 +570,27 @@ func (m *BuildIndexRequest) GetIndexParams() []*commonpb.KeyValuePair {
 	return nil
 }
 
+func (m *BuildIndexRequest) GetNumRows() int64 {
+	if m != nil {
+		return m.NumRows
+	}
+	return 0
+}
+
+func (m *BuildIndexRequest) GetStep() IndexBuildStep {
+	if m != nil {
+		return m.Step
+	}
+	return IndexBuildStep_BuildAll
+}
+
+func (m *BuildIndexRequest) GetInputIndexes() []*IndexFilePathInfo {
+	if m != nil {
+		return m.InputIndexes
+	}
+	return nil
+}
+
 type BuildIndexResponse struct {
 	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 	IndexBuildID         int64            `protobuf:"varint,2,opt,name=indexBuildID,proto3" json:"indexBuildID,omitempty"`
@@ -649,18 +780,27 @@ func (m *GetIndexFilePathsResponse) GetFilePaths() []*IndexFilePathInfo {
 }
 
 type IndexMeta struct {
-	IndexBuildID         int64               `protobuf:"varint,1,opt,name=indexBuildID,proto3" json:"indexBuildID,omitempty"`
-	State                commonpb.IndexState `protobuf:"varint,2,opt,name=state,proto3,enum=milvus.proto.common.IndexState" json:"state,omitempty"`
-	FailReason           string              `protobuf:"bytes,3,opt,name=fail_reason,json=failReason,proto3" json:"fail_reason,omitempty"`
-	Req                  *BuildIndexRequest  `protobuf:"bytes,4,opt,name=req,proto3" json:"req,omitempty"`
-	IndexFilePaths       []string            `protobuf:"bytes,5,rep,name=index_file_paths,json=indexFilePaths,proto3" json:"index_file_paths,omitempty"`
-	MarkDeleted          bool                `protobuf:"varint,6,opt,name=mark_deleted,json=markDeleted,proto3" json:"mark_deleted,omitempty"`
-	NodeID               int64               `protobuf:"varint,7,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
-	Version              int64               `protobuf:"varint,8,opt,name=version,proto3" json:"version,omitempty"`
-	Recycled             bool                `protobuf:"varint,9,opt,name=recycled,proto3" json:"recycled,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
-	XXX_unrecognized     []byte              `json:"-"`
-	XXX_sizecache        int32               `json:"-"`
+	IndexBuildID   int64               `protobuf:"varint,1,opt,name=indexBuildID,proto3" json:"indexBuildID,omitempty"`
+	State          commonpb.IndexState `protobuf:"varint,2,opt,name=state,proto3,enum=milvus.proto.common.IndexState" json:"state,omitempty"`
+	FailReason     string              `protobuf:"bytes,3,opt,name=fail_reason,json=failReason,proto3" json:"fail_reason,omitempty"`
+	Req            *BuildIndexRequest  `protobuf:"bytes,4,opt,name=req,proto3" json:"req,omitempty"`
+	IndexFilePaths []string            `protobuf:"bytes,5,rep,name=index_file_paths,json=indexFilePaths,proto3" json:"index_file_paths,omitempty"`
+	MarkDeleted    bool                `protobuf:"varint,6,opt,name=mark_deleted,json=markDeleted,proto3" json:"mark_deleted,omitempty"`
+	NodeID         int64               `protobuf:"varint,7,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	Version        int64               `protobuf:"varint,8,opt,name=version,proto3" json:"version,omitempty"`
+	Recycled       bool                `protobuf:"varint,9,opt,name=recycled,proto3" json:"recycled,omitempty"`
+	RetryCount     int64               `protobuf:"varint,10,opt,name=retry_count,json=retryCount,proto3" json:"retry_count,omitempty"`
+	IndexedRows    int64               `protobuf:"varint,11,opt,name=indexed_rows,json=indexedRows,proto3" json:"indexed_rows,omitempty"`
+	TotalRows      int64               `protobuf:"varint,12,opt,name=total_rows,json=totalRows,proto3" json:"total_rows,omitempty"`
+	// the index build this task is a step of, 0 for a task requested by RootCoord
+	ParentBuildID    int64             `protobuf:"varint,13,opt,name=parent_buildID,json=parentBuildID,proto3" json:"parent_buildID,omitempty"`
+	DistributedBuild *DistributedBuild `protobuf:"bytes,14,opt,name=distributed_build,json=distributedBuild,proto3" json:"distributed_build,omitempty"`
+	// the total size in bytes of the index files
+	SerializedSize       int64    `protobuf:"varint,15,opt,name=serialized_size,json=serializedSize,proto3" json:"serialized_size,omitempty"`
+	BuildTimeMs          int64    `protobuf:"varint,16,opt,name=build_time_ms,json=buildTimeMs,proto3" json:"build_time_ms,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *IndexMeta) Reset()         { *m = IndexMeta{} }
@@ -751,6 +891,494 @@ func (m *IndexMeta) GetRecycled() bool {
 	return false
 }
 
+func (m *IndexMeta) GetRetryCount() int64 {
+	if m != nil {
+		return m.RetryCount
+	}
+	return 0
+}
+
+func (m *IndexMeta) GetIndexedRows() int64 {
+	if m != nil {
+		return m.IndexedRows
+	}
+	return 0
+}
+
+func (m *IndexMeta) GetTotalRows() int64 {
+	if m != nil {
+		return m.TotalRows
+	}
+	return 0
+}
+
+func (m *IndexMeta) GetParentBuildID() int64 {
+	if m != nil {
+		return m.ParentBuildID
+	}
+	return 0
+}
+
+func (m *IndexMeta) GetDistributedBuild() *DistributedBuild {
+	if m != nil {
+		return m.DistributedBuild
+	}
+	return nil
+}
+
+func (m *IndexMeta) GetSerializedSize() int64 {
+	if m != nil {
+		return m.SerializedSize
+	}
+	return 0
+}
+
+func (m *IndexMeta) GetBuildTimeMs() int64 {
+	if m != nil {
+		return m.BuildTimeMs
+	}
+	return 0
+}
+
+// EstimateRecallRequest asks for the recall of a built index, nq vectors sampled from the raw data of the segment
+// are searched on the index and compared with the exact topk. IndexCoord fills the paths and params for IndexNode.
+type EstimateRecallRequest struct {
+	IndexBuildID         int64                    `protobuf:"varint,1,opt,name=indexBuildID,proto3" json:"indexBuildID,omitempty"`
+	Nq                   int64                    `protobuf:"varint,2,opt,name=nq,proto3" json:"nq,omitempty"`
+	Topk                 int64                    `protobuf:"varint,3,opt,name=topk,proto3" json:"topk,omitempty"`
+	SearchParams         []*commonpb.KeyValuePair `protobuf:"bytes,4,rep,name=search_params,json=searchParams,proto3" json:"search_params,omitempty"`
+	IndexFilePaths       []string                 `protobuf:"bytes,5,rep,name=index_file_paths,json=indexFilePaths,proto3" json:"index_file_paths,omitempty"`
+	DataPaths            []string                 `protobuf:"bytes,6,rep,name=data_paths,json=dataPaths,proto3" json:"data_paths,omitempty"`
+	TypeParams           []*commonpb.KeyValuePair `protobuf:"bytes,7,rep,name=type_params,json=typeParams,proto3" json:"type_params,omitempty"`
+	IndexParams          []*commonpb.KeyValuePair `protobuf:"bytes,8,rep,name=index_params,json=indexParams,proto3" json:"index_params,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *EstimateRecallRequest) Reset()         { *m = EstimateRecallRequest{} }
+func (m *EstimateRecallRequest) String() string { return proto.CompactTextString(m) }
+func (*EstimateRecallRequest) ProtoMessage()    {}
+func (*EstimateRecallRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9e019eb3fda53c2, []int{12}
+}
+
+func (m *EstimateRecallRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EstimateRecallRequest.Unmarshal(m, b)
+}
+func (m *EstimateRecallRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EstimateRecallRequest.Marshal(b, m, deterministic)
+}
+func (m *EstimateRecallRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EstimateRecallRequest.Merge(m, src)
+}
+func (m *EstimateRecallRequest) XXX_Size() int {
+	return xxx_messageInfo_EstimateRecallRequest.Size(m)
+}
+func (m *EstimateRecallRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EstimateRecallRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EstimateRecallRequest proto.InternalMessageInfo
+
+func (m *EstimateRecallRequest) GetIndexBuildID() int64 {
+	if m != nil {
+		return m.IndexBuildID
+	}
+	return 0
+}
+
+func (m *EstimateRecallRequest) GetNq() int64 {
+	if m != nil {
+		return m.Nq
+	}
+	return 0
+}
+
+func (m *EstimateRecallRequest) GetTopk() int64 {
+	if m != nil {
+		return m.Topk
+	}
+	return 0
+}
+
+func (m *EstimateRecallRequest) GetSearchParams() []*commonpb.KeyValuePair {
+	if m != nil {
+		return m.SearchParams
+	}
+	return nil
+}
+
+func (m *EstimateRecallRequest) GetIndexFilePaths() []string {
+	if m != nil {
+		return m.IndexFilePaths
+	}
+	return nil
+}
+
+func (m *EstimateRecallRequest) GetDataPaths() []string {
+	if m != nil {
+		return m.DataPaths
+	}
+	return nil
+}
+
+func (m *EstimateRecallRequest) GetTypeParams() []*commonpb.KeyValuePair {
+	if m != nil {
+		return m.TypeParams
+	}
+	return nil
+}
+
+func (m *EstimateRecallRequest) GetIndexParams() []*commonpb.KeyValuePair {
+	if m != nil {
+		return m.IndexParams
+	}
+	return nil
+}
+
+// EstimateRecallResponse is the result of an estimation. IndexCoord returns the ID of the estimation job once it is
+// queued, the result is polled through GetRecallEstimations until the state is finished or failed.
+type EstimateRecallResponse struct {
+	Status               *commonpb.Status    `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	IndexBuildID         int64               `protobuf:"varint,2,opt,name=indexBuildID,proto3" json:"indexBuildID,omitempty"`
+	Nq                   int64               `protobuf:"varint,3,opt,name=nq,proto3" json:"nq,omitempty"`
+	Recall               float32             `protobuf:"fixed32,4,opt,name=recall,proto3" json:"recall,omitempty"`
+	JobID                int64               `protobuf:"varint,5,opt,name=jobID,proto3" json:"jobID,omitempty"`
+	State                commonpb.IndexState `protobuf:"varint,6,opt,name=state,proto3,enum=milvus.proto.common.IndexState" json:"state,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *EstimateRecallResponse) Reset()         { *m = EstimateRecallResponse{} }
+func (m *EstimateRecallResponse) String() string { return proto.CompactTextString(m) }
+func (*EstimateRecallResponse) ProtoMessage()    {}
+func (*EstimateRecallResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9e019eb3fda53c2, []int{13}
+}
+
+func (m *EstimateRecallResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EstimateRecallResponse.Unmarshal(m, b)
+}
+func (m *EstimateRecallResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EstimateRecallResponse.Marshal(b, m, deterministic)
+}
+func (m *EstimateRecallResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EstimateRecallResponse.Merge(m, src)
+}
+func (m *EstimateRecallResponse) XXX_Size() int {
+	return xxx_messageInfo_EstimateRecallResponse.Size(m)
+}
+func (m *EstimateRecallResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EstimateRecallResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EstimateRecallResponse proto.InternalMessageInfo
+
+func (m *EstimateRecallResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *EstimateRecallResponse) GetIndexBuildID() int64 {
+	if m != nil {
+		return m.IndexBuildID
+	}
+	return 0
+}
+
+func (m *EstimateRecallResponse) GetNq() int64 {
+	if m != nil {
+		return m.Nq
+	}
+	return 0
+}
+
+func (m *EstimateRecallResponse) GetRecall() float32 {
+	if m != nil {
+		return m.Recall
+	}
+	return 0
+}
+
+func (m *EstimateRecallResponse) GetJobID() int64 {
+	if m != nil {
+		return m.JobID
+	}
+	return 0
+}
+
+func (m *EstimateRecallResponse) GetState() commonpb.IndexState {
+	if m != nil {
+		return m.State
+	}
+	return commonpb.IndexState_IndexStateNone
+}
+
+type GetRecallEstimationsRequest struct {
+	JobIDs               []int64  `protobuf:"varint,1,rep,packed,name=jobIDs,proto3" json:"jobIDs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetRecallEstimationsRequest) Reset()         { *m = GetRecallEstimationsRequest{} }
+func (m *GetRecallEstimationsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetRecallEstimationsRequest) ProtoMessage()    {}
+func (*GetRecallEstimationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9e019eb3fda53c2, []int{14}
+}
+
+func (m *GetRecallEstimationsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetRecallEstimationsRequest.Unmarshal(m, b)
+}
+func (m *GetRecallEstimationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetRecallEstimationsRequest.Marshal(b, m, deterministic)
+}
+func (m *GetRecallEstimationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRecallEstimationsRequest.Merge(m, src)
+}
+func (m *GetRecallEstimationsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetRecallEstimationsRequest.Size(m)
+}
+func (m *GetRecallEstimationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetRecallEstimationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetRecallEstimationsRequest proto.InternalMessageInfo
+
+func (m *GetRecallEstimationsRequest) GetJobIDs() []int64 {
+	if m != nil {
+		return m.JobIDs
+	}
+	return nil
+}
+
+type GetRecallEstimationsResponse struct {
+	Status               *commonpb.Status          `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Results              []*EstimateRecallResponse `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *GetRecallEstimationsResponse) Reset()         { *m = GetRecallEstimationsResponse{} }
+func (m *GetRecallEstimationsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetRecallEstimationsResponse) ProtoMessage()    {}
+func (*GetRecallEstimationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9e019eb3fda53c2, []int{15}
+}
+
+func (m *GetRecallEstimationsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetRecallEstimationsResponse.Unmarshal(m, b)
+}
+func (m *GetRecallEstimationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetRecallEstimationsResponse.Marshal(b, m, deterministic)
+}
+func (m *GetRecallEstimationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRecallEstimationsResponse.Merge(m, src)
+}
+func (m *GetRecallEstimationsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetRecallEstimationsResponse.Size(m)
+}
+func (m *GetRecallEstimationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetRecallEstimationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetRecallEstimationsResponse proto.InternalMessageInfo
+
+func (m *GetRecallEstimationsResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetRecallEstimationsResponse) GetResults() []*EstimateRecallResponse {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+// DistributedBuild records the steps of an index build that is split across IndexNodes.
+type DistributedBuild struct {
+	Step          IndexBuildStep `protobuf:"varint,1,opt,name=step,proto3,enum=milvus.proto.index.IndexBuildStep" json:"step,omitempty"`
+	TrainBuildID  int64          `protobuf:"varint,2,opt,name=train_buildID,json=trainBuildID,proto3" json:"train_buildID,omitempty"`
+	ShardBuildIDs []int64        `protobuf:"varint,3,rep,packed,name=shard_buildIDs,json=shardBuildIDs,proto3" json:"shard_buildIDs,omitempty"`
+	// the data paths of every shard, in segment order
+	Shards               []*ShardDataPaths `protobuf:"bytes,4,rep,name=shards,proto3" json:"shards,omitempty"`
+	Done                 bool              `protobuf:"varint,5,opt,name=done,proto3" json:"done,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *DistributedBuild) Reset()         { *m = DistributedBuild{} }
+func (m *DistributedBuild) String() string { return proto.CompactTextString(m) }
+func (*DistributedBuild) ProtoMessage()    {}
+func (*DistributedBuild) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9e019eb3fda53c2, []int{16}
+}
+
+func (m *DistributedBuild) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DistributedBuild.Unmarshal(m, b)
+}
+func (m *DistributedBuild) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DistributedBuild.Marshal(b, m, deterministic)
+}
+func (m *DistributedBuild) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DistributedBuild.Merge(m, src)
+}
+func (m *DistributedBuild) XXX_Size() int {
+	return xxx_messageInfo_DistributedBuild.Size(m)
+}
+func (m *DistributedBuild) XXX_DiscardUnknown() {
+	xxx_messageInfo_DistributedBuild.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DistributedBuild proto.InternalMessageInfo
+
+func (m *DistributedBuild) GetStep() IndexBuildStep {
+	if m != nil {
+		return m.Step
+	}
+	return IndexBuildStep_BuildAll
+}
+
+func (m *DistributedBuild) GetTrainBuildID() int64 {
+	if m != nil {
+		return m.TrainBuildID
+	}
+	return 0
+}
+
+func (m *DistributedBuild) GetShardBuildIDs() []int64 {
+	if m != nil {
+		return m.ShardBuildIDs
+	}
+	return nil
+}
+
+func (m *DistributedBuild) GetShards() []*ShardDataPaths {
+	if m != nil {
+		return m.Shards
+	}
+	return nil
+}
+
+func (m *DistributedBuild) GetDone() bool {
+	if m != nil {
+		return m.Done
+	}
+	return false
+}
+
+type ShardDataPaths struct {
+	DataPaths            []string `protobuf:"bytes,1,rep,name=data_paths,json=dataPaths,proto3" json:"data_paths,omitempty"`
+	NumRows              int64    `protobuf:"varint,2,opt,name=num_rows,json=numRows,proto3" json:"num_rows,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ShardDataPaths) Reset()         { *m = ShardDataPaths{} }
+func (m *ShardDataPaths) String() string { return proto.CompactTextString(m) }
+func (*ShardDataPaths) ProtoMessage()    {}
+func (*ShardDataPaths) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9e019eb3fda53c2, []int{17}
+}
+
+func (m *ShardDataPaths) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ShardDataPaths.Unmarshal(m, b)
+}
+func (m *ShardDataPaths) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ShardDataPaths.Marshal(b, m, deterministic)
+}
+func (m *ShardDataPaths) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShardDataPaths.Merge(m, src)
+}
+func (m *ShardDataPaths) XXX_Size() int {
+	return xxx_messageInfo_ShardDataPaths.Size(m)
+}
+func (m *ShardDataPaths) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShardDataPaths.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShardDataPaths proto.InternalMessageInfo
+
+func (m *ShardDataPaths) GetDataPaths() []string {
+	if m != nil {
+		return m.DataPaths
+	}
+	return nil
+}
+
+func (m *ShardDataPaths) GetNumRows() int64 {
+	if m != nil {
+		return m.NumRows
+	}
+	return 0
+}
+
+type CancelIndexBuildRequest struct {
+	IndexBuildID         int64    `protobuf:"varint,1,opt,name=indexBuildID,proto3" json:"indexBuildID,omitempty"`
+	Version              int64    `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	Reason               string   `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CancelIndexBuildRequest) Reset()         { *m = CancelIndexBuildRequest{} }
+func (m *CancelIndexBuildRequest) String() string { return proto.CompactTextString(m) }
+func (*CancelIndexBuildRequest) ProtoMessage()    {}
+func (*CancelIndexBuildRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9e019eb3fda53c2, []int{18}
+}
+
+func (m *CancelIndexBuildRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CancelIndexBuildRequest.Unmarshal(m, b)
+}
+func (m *CancelIndexBuildRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CancelIndexBuildRequest.Marshal(b, m, deterministic)
+}
+func (m *CancelIndexBuildRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelIndexBuildRequest.Merge(m, src)
+}
+func (m *CancelIndexBuildRequest) XXX_Size() int {
+	return xxx_messageInfo_CancelIndexBuildRequest.Size(m)
+}
+func (m *CancelIndexBuildRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelIndexBuildRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelIndexBuildRequest proto.InternalMessageInfo
+
+func (m *CancelIndexBuildRequest) GetIndexBuildID() int64 {
+	if m != nil {
+		return m.IndexBuildID
+	}
+	return 0
+}
+
+func (m *CancelIndexBuildRequest) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *CancelIndexBuildRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
 type DropIndexRequest struct {
 	IndexID              int64    `protobuf:"varint,1,opt,name=indexID,proto3" json:"indexID,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -762,7 +1390,7 @@ func (m *DropIndexRequest) Reset()         { *m = DropIndexRequest{} }
 func (m *DropIndexRequest) String() string { return proto.CompactTextString(m) }
 func (*DropIndexRequest) ProtoMessage()    {}
 func (*DropIndexRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9e019eb3fda53c2, []int{12}
+	return fileDescriptor_f9e019eb3fda53c2, []int{19}
 }
 
 func (m *DropIndexRequest) XXX_Unmarshal(b []byte) error {
@@ -791,6 +1419,7 @@ func (m *DropIndexRequest) GetIndexID() int64 {
 }
 
 func init() {
+	proto.RegisterEnum("milvus.proto.index.IndexBuildStep", IndexBuildStep_name, IndexBuildStep_value)
 	proto.RegisterType((*RegisterNodeRequest)(nil), "milvus.proto.index.RegisterNodeRequest")
 	proto.RegisterType((*RegisterNodeResponse)(nil), "milvus.proto.index.RegisterNodeResponse")
 	proto.RegisterType((*GetIndexStatesRequest)(nil), "milvus.proto.index.GetIndexStatesRequest")
@@ -803,74 +1432,119 @@ func init() {
 	proto.RegisterType((*IndexFilePathInfo)(nil), "milvus.proto.index.IndexFilePathInfo")
 	proto.RegisterType((*GetIndexFilePathsResponse)(nil), "milvus.proto.index.GetIndexFilePathsResponse")
 	proto.RegisterType((*IndexMeta)(nil), "milvus.proto.index.IndexMeta")
+	proto.RegisterType((*EstimateRecallRequest)(nil), "milvus.proto.index.EstimateRecallRequest")
+	proto.RegisterType((*EstimateRecallResponse)(nil), "milvus.proto.index.EstimateRecallResponse")
+	proto.RegisterType((*GetRecallEstimationsRequest)(nil), "milvus.proto.index.GetRecallEstimationsRequest")
+	proto.RegisterType((*GetRecallEstimationsResponse)(nil), "milvus.proto.index.GetRecallEstimationsResponse")
+	proto.RegisterType((*DistributedBuild)(nil), "milvus.proto.index.DistributedBuild")
+	proto.RegisterType((*ShardDataPaths)(nil), "milvus.proto.index.ShardDataPaths")
+	proto.RegisterType((*CancelIndexBuildRequest)(nil), "milvus.proto.index.CancelIndexBuildRequest")
 	proto.RegisterType((*DropIndexRequest)(nil), "milvus.proto.index.DropIndexRequest")
 }
 
 func init() { proto.RegisterFile("index_coord.proto", fileDescriptor_f9e019eb3fda53c2) }
 
 var fileDescriptor_f9e019eb3fda53c2 = []byte{
-	// 975 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0x5f, 0x6f, 0x1b, 0x45,
-	0x10, 0xcf, 0xf9, 0x12, 0x3b, 0x1e, 0x87, 0xa8, 0x59, 0x4a, 0x75, 0xb8, 0x54, 0x75, 0x8e, 0x52,
-	0x0c, 0x6a, 0x9d, 0xca, 0xa5, 0xf0, 0x84, 0x04, 0x89, 0x45, 0x64, 0xa1, 0x54, 0xd1, 0x36, 0xe2,
-	0x01, 0x09, 0x59, 0x1b, 0xdf, 0x24, 0x59, 0xf5, 0xfe, 0xe5, 0x76, 0x5d, 0x91, 0x77, 0xde, 0x79,
-	0x2b, 0xe2, 0x93, 0xf0, 0x39, 0xfa, 0xcc, 0x97, 0x41, 0xbb, 0xb7, 0x77, 0xb9, 0x3b, 0x9f, 0x53,
-	0x87, 0x50, 0x78, 0xe1, 0xed, 0x66, 0xf6, 0x37, 0x33, 0x3b, 0xbf, 0x9d, 0xfd, 0xdd, 0xc2, 0x16,
-	0x0f, 0x3d, 0xfc, 0x79, 0x32, 0x8d, 0xa2, 0xc4, 0x1b, 0xc4, 0x49, 0x24, 0x23, 0x42, 0x02, 0xee,
-	0xbf, 0x9a, 0x89, 0xd4, 0x1a, 0xe8, 0xf5, 0xee, 0xc6, 0x34, 0x0a, 0x82, 0x28, 0x4c, 0x7d, 0xdd,
-	0x4d, 0x1e, 0x4a, 0x4c, 0x42, 0xe6, 0x1b, 0x7b, 0xa3, 0x18, 0xe1, 0xfe, 0x66, 0xc1, 0xfb, 0x14,
-	0x4f, 0xb9, 0x90, 0x98, 0x3c, 0x8f, 0x3c, 0xa4, 0x78, 0x3e, 0x43, 0x21, 0xc9, 0x13, 0x58, 0x3d,
-	0x66, 0x02, 0x1d, 0xab, 0x67, 0xf5, 0x3b, 0xc3, 0x8f, 0x06, 0xa5, 0x32, 0x26, 0xff, 0x81, 0x38,
-	0xdd, 0x65, 0x02, 0xa9, 0x46, 0x92, 0x2f, 0xa1, 0xc5, 0x3c, 0x2f, 0x41, 0x21, 0x9c, 0xc6, 0x15,
-	0x41, 0xdf, 0xa6, 0x18, 0x9a, 0x81, 0xc9, 0x1d, 0x68, 0x86, 0x91, 0x87, 0xe3, 0x91, 0x63, 0xf7,
-	0xac, 0xbe, 0x4d, 0x8d, 0xe5, 0xfe, 0x6a, 0xc1, 0xed, 0xf2, 0xce, 0x44, 0x1c, 0x85, 0x02, 0xc9,
-	0x53, 0x68, 0x0a, 0xc9, 0xe4, 0x4c, 0x98, 0xcd, 0xdd, 0xad, 0xad, 0xf3, 0x42, 0x43, 0xa8, 0x81,
-	0x92, 0x5d, 0xe8, 0xf0, 0x90, 0xcb, 0x49, 0xcc, 0x12, 0x16, 0x64, 0x3b, 0xdc, 0x1e, 0x54, 0xd8,
-	0x33, 0x44, 0x8d, 0x43, 0x2e, 0x0f, 0x35, 0x90, 0x02, 0xcf, 0xbf, 0xdd, 0xaf, 0xe1, 0x83, 0x7d,
-	0x94, 0x63, 0xc5, 0xb1, 0xca, 0x8e, 0x22, 0x23, 0xeb, 0x01, 0xbc, 0xa7, 0x99, 0xdf, 0x9d, 0x71,
-	0xdf, 0x1b, 0x8f, 0xd4, 0xc6, 0xec, 0xbe, 0x4d, 0xcb, 0x4e, 0xf7, 0x0f, 0x0b, 0xda, 0x3a, 0x78,
-	0x1c, 0x9e, 0x44, 0xe4, 0x19, 0xac, 0xa9, 0xad, 0xa5, 0x0c, 0x6f, 0x0e, 0xef, 0xd7, 0x36, 0x71,
-	0x59, 0x8b, 0xa6, 0x68, 0xe2, 0xc2, 0x46, 0x31, 0xab, 0x6e, 0xc4, 0xa6, 0x25, 0x1f, 0x71, 0xa0,
-	0xa5, 0xed, 0x9c, 0xd2, 0xcc, 0x24, 0xf7, 0x00, 0xd2, 0x11, 0x0a, 0x59, 0x80, 0xce, 0x6a, 0xcf,
-	0xea, 0xb7, 0x69, 0x5b, 0x7b, 0x9e, 0xb3, 0x00, 0xd5, 0x51, 0x24, 0xc8, 0x44, 0x14, 0x3a, 0x6b,
-	0x7a, 0xc9, 0x58, 0xee, 0x2f, 0x16, 0xdc, 0xa9, 0x76, 0x7e, 0x93, 0xc3, 0x78, 0x96, 0x06, 0xa1,
-	0x3a, 0x07, 0xbb, 0xdf, 0x19, 0xde, 0x1b, 0xcc, 0x4f, 0xf1, 0x20, 0xa7, 0x8a, 0x1a, 0xb0, 0xfb,
-	0xa6, 0x01, 0x64, 0x2f, 0x41, 0x26, 0x51, 0xaf, 0x65, 0xec, 0x57, 0x29, 0xb1, 0x6a, 0x28, 0x29,
-	0x37, 0xde, 0xa8, 0x36, 0xbe, 0x98, 0x31, 0x07, 0x5a, 0xaf, 0x30, 0x11, 0x3c, 0x0a, 0x35, 0x5d,
-	0x36, 0xcd, 0x4c, 0x72, 0x17, 0xda, 0x01, 0x4a, 0x36, 0x89, 0x99, 0x3c, 0x33, 0x7c, 0xad, 0x2b,
-	0xc7, 0x21, 0x93, 0x67, 0xaa, 0x9e, 0xc7, 0xcc, 0xa2, 0x70, 0x9a, 0x3d, 0x5b, 0xd5, 0x53, 0x1e,
-	0xb5, 0xaa, 0xa7, 0x51, 0x5e, 0xc4, 0x98, 0x4d, 0x63, 0x4b, 0xb3, 0xb0, 0x5d, 0x4b, 0xdd, 0xf7,
-	0x78, 0xf1, 0x03, 0xf3, 0x67, 0x78, 0xc8, 0x78, 0x42, 0x41, 0x45, 0xa5, 0xd3, 0x48, 0x46, 0xa6,
-	0xed, 0x2c, 0xc9, 0xfa, 0xb2, 0x49, 0x3a, 0x3a, 0xcc, 0xcc, 0xf4, 0xef, 0x0d, 0xd8, 0x4a, 0x49,
-	0xfa, 0xd7, 0x28, 0x2d, 0x73, 0xb3, 0xf6, 0x16, 0x6e, 0x9a, 0xff, 0x04, 0x37, 0xad, 0xbf, 0xc5,
-	0x4d, 0x00, 0xa4, 0x48, 0xcd, 0x4d, 0x26, 0x7e, 0x89, 0x6b, 0xeb, 0x7e, 0x03, 0x4e, 0x76, 0xc9,
-	0xbe, 0xe3, 0x3e, 0x6a, 0x36, 0xae, 0xa7, 0x30, 0xaf, 0x2d, 0xd8, 0x2a, 0xc5, 0x6b, 0xa5, 0x79,
-	0x57, 0x1b, 0x26, 0x7d, 0xb8, 0x95, 0xb2, 0x7c, 0xc2, 0x7d, 0x34, 0xc7, 0x69, 0xeb, 0xe3, 0xdc,
-	0xe4, 0xa5, 0x2e, 0xd4, 0xc6, 0x3e, 0xac, 0xe9, 0xed, 0x26, 0x8c, 0x8e, 0x00, 0x0a, 0x65, 0x53,
-	0x1d, 0xf9, 0x64, 0xa1, 0x8e, 0x14, 0x09, 0xa1, 0xed, 0x93, 0x7c, 0x63, 0x7f, 0x36, 0x8c, 0x26,
-	0x1f, 0xa0, 0x64, 0x4b, 0x8d, 0x7d, 0xae, 0xdb, 0x8d, 0x6b, 0xe9, 0xf6, 0x7d, 0xe8, 0x9c, 0x30,
-	0xee, 0x4f, 0x8c, 0xbe, 0xda, 0xfa, 0xba, 0x80, 0x72, 0x51, 0xed, 0x21, 0x5f, 0x81, 0x9d, 0xe0,
-	0xb9, 0x16, 0x99, 0x05, 0x8d, 0xcc, 0x5d, 0x53, 0xaa, 0x22, 0x6a, 0x4f, 0x61, 0xad, 0xee, 0x14,
-	0xc8, 0x36, 0x6c, 0x04, 0x2c, 0x79, 0x39, 0xf1, 0xd0, 0x47, 0x89, 0x9e, 0xd3, 0xec, 0x59, 0xfd,
-	0x75, 0xda, 0x51, 0xbe, 0x51, 0xea, 0x2a, 0xfc, 0x8c, 0x5b, 0xc5, 0x9f, 0x71, 0x51, 0x06, 0xd7,
-	0xcb, 0x32, 0xd8, 0x85, 0xf5, 0x04, 0xa7, 0x17, 0x53, 0x1f, 0x3d, 0xa7, 0xad, 0x13, 0xe6, 0xb6,
-	0xfb, 0x08, 0x6e, 0x8d, 0x92, 0x28, 0x2e, 0x49, 0x4b, 0x41, 0x17, 0xac, 0x92, 0x2e, 0x0c, 0xdf,
-	0x34, 0x01, 0x34, 0x74, 0x4f, 0xbd, 0x6f, 0x48, 0x0c, 0x64, 0x1f, 0xe5, 0x5e, 0x14, 0xc4, 0x51,
-	0x88, 0xa1, 0x4c, 0xff, 0x3b, 0xe4, 0xc9, 0x82, 0x5f, 0xf6, 0x3c, 0xd4, 0x14, 0xec, 0x3e, 0x5c,
-	0x10, 0x51, 0x81, 0xbb, 0x2b, 0x24, 0xd0, 0x15, 0x8f, 0x78, 0x80, 0x47, 0x7c, 0xfa, 0x72, 0xef,
-	0x8c, 0x85, 0x21, 0xfa, 0x57, 0x55, 0xac, 0x40, 0xb3, 0x8a, 0x1f, 0x97, 0x23, 0x8c, 0xf1, 0x42,
-	0x26, 0x3c, 0x3c, 0xcd, 0x86, 0xde, 0x5d, 0x21, 0xe7, 0x70, 0x7b, 0x1f, 0x75, 0x75, 0x2e, 0x24,
-	0x9f, 0x8a, 0xac, 0xe0, 0x70, 0x71, 0xc1, 0x39, 0xf0, 0x35, 0x4b, 0xfe, 0x04, 0x70, 0x39, 0x45,
-	0x64, 0xb9, 0x29, 0x9b, 0x27, 0xb0, 0x0a, 0xcb, 0xd3, 0x73, 0xd8, 0x2c, 0x3f, 0x13, 0xc8, 0x67,
-	0x75, 0xb1, 0xb5, 0x8f, 0xa8, 0xee, 0xe7, 0xcb, 0x40, 0xf3, 0x52, 0x09, 0x6c, 0xcd, 0x09, 0x0a,
-	0x79, 0x74, 0x55, 0x8a, 0xaa, 0xa6, 0x76, 0x1f, 0x2f, 0x89, 0xce, 0x6b, 0x1e, 0x42, 0x3b, 0x1f,
-	0x67, 0xf2, 0xa0, 0x2e, 0xba, 0x3a, 0xed, 0xdd, 0xab, 0xa4, 0xcc, 0x5d, 0x21, 0x13, 0x80, 0x7d,
-	0x94, 0x07, 0x28, 0x13, 0x3e, 0x15, 0xe4, 0x61, 0xed, 0x21, 0x5e, 0x02, 0xb2, 0xa4, 0x9f, 0xbe,
-	0x15, 0x97, 0x6d, 0x79, 0xf8, 0x7a, 0xd5, 0xe8, 0x9b, 0x7a, 0x41, 0xff, 0x7f, 0xa5, 0xde, 0xc1,
-	0x95, 0x3a, 0x82, 0x4e, 0xe1, 0x4d, 0x4a, 0x6a, 0x2f, 0xcb, 0xfc, 0xa3, 0xf5, 0xbf, 0x1e, 0x8c,
-	0xdd, 0x2f, 0x7e, 0x1c, 0x9e, 0x72, 0x79, 0x36, 0x3b, 0x56, 0xa5, 0x77, 0x52, 0xe4, 0x63, 0x1e,
-	0x99, 0xaf, 0x9d, 0x8c, 0xa1, 0x1d, 0x9d, 0x69, 0x47, 0xb7, 0x11, 0x1f, 0x1f, 0x37, 0xb5, 0xf9,
-	0xf4, 0xaf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x75, 0x9d, 0x20, 0xf1, 0x89, 0x0e, 0x00, 0x00,
+	// 1572 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0xdb, 0x6f, 0x1b, 0x45,
+	0x17, 0xcf, 0x7a, 0x13, 0x5f, 0x8e, 0x13, 0xd7, 0x99, 0xaf, 0xcd, 0xe7, 0xba, 0xad, 0x9a, 0x6e,
+	0x6f, 0xf9, 0xfa, 0xb5, 0x49, 0xe5, 0xd2, 0x22, 0x21, 0x21, 0xd1, 0xc4, 0x34, 0x4a, 0x51, 0xaa,
+	0xb0, 0x89, 0x78, 0x40, 0x02, 0x6b, 0xec, 0x3d, 0x49, 0x86, 0xee, 0xc5, 0xd9, 0x19, 0xb7, 0xa4,
+	0xbc, 0xc2, 0x33, 0x6f, 0x20, 0xfe, 0x13, 0xf8, 0x73, 0x78, 0xe1, 0x99, 0x67, 0x5e, 0x40, 0x33,
+	0x3b, 0x6b, 0x7b, 0xd7, 0xeb, 0xc4, 0x26, 0x04, 0x15, 0x89, 0x37, 0xcf, 0x99, 0xdf, 0x9c, 0x33,
+	0x73, 0x7e, 0xe7, 0xe6, 0x85, 0x45, 0xe6, 0x3b, 0xf8, 0x65, 0xab, 0x13, 0x04, 0xa1, 0xb3, 0xda,
+	0x0d, 0x03, 0x11, 0x10, 0xe2, 0x31, 0xf7, 0x55, 0x8f, 0x47, 0xab, 0x55, 0xb5, 0x5f, 0x9f, 0xef,
+	0x04, 0x9e, 0x17, 0xf8, 0x91, 0xac, 0x5e, 0x61, 0xbe, 0xc0, 0xd0, 0xa7, 0xae, 0x5e, 0xcf, 0x0f,
+	0x9f, 0xb0, 0xbe, 0x37, 0xe0, 0x3f, 0x36, 0x1e, 0x30, 0x2e, 0x30, 0x7c, 0x11, 0x38, 0x68, 0xe3,
+	0x51, 0x0f, 0xb9, 0x20, 0x0f, 0x61, 0xb6, 0x4d, 0x39, 0xd6, 0x8c, 0x65, 0x63, 0xa5, 0xdc, 0xb8,
+	0xba, 0x9a, 0x30, 0xa3, 0xf5, 0x6f, 0xf3, 0x83, 0x75, 0xca, 0xd1, 0x56, 0x48, 0xf2, 0x04, 0x0a,
+	0xd4, 0x71, 0x42, 0xe4, 0xbc, 0x96, 0x3b, 0xe1, 0xd0, 0xd3, 0x08, 0x63, 0xc7, 0x60, 0xb2, 0x04,
+	0x79, 0x3f, 0x70, 0x70, 0xab, 0x59, 0x33, 0x97, 0x8d, 0x15, 0xd3, 0xd6, 0x2b, 0xeb, 0x5b, 0x03,
+	0x2e, 0x26, 0x6f, 0xc6, 0xbb, 0x81, 0xcf, 0x91, 0x3c, 0x82, 0x3c, 0x17, 0x54, 0xf4, 0xb8, 0xbe,
+	0xdc, 0x95, 0x4c, 0x3b, 0xbb, 0x0a, 0x62, 0x6b, 0x28, 0x59, 0x87, 0x32, 0xf3, 0x99, 0x68, 0x75,
+	0x69, 0x48, 0xbd, 0xf8, 0x86, 0x37, 0x56, 0x53, 0xde, 0xd3, 0x8e, 0xda, 0xf2, 0x99, 0xd8, 0x51,
+	0x40, 0x1b, 0x58, 0xff, 0xb7, 0xf5, 0x3e, 0x5c, 0xda, 0x44, 0xb1, 0x25, 0x7d, 0x2c, 0xb5, 0x23,
+	0x8f, 0x9d, 0x75, 0x0b, 0x16, 0x94, 0xe7, 0xd7, 0x7b, 0xcc, 0x75, 0xb6, 0x9a, 0xf2, 0x62, 0xe6,
+	0x8a, 0x69, 0x27, 0x85, 0xd6, 0xef, 0x39, 0x28, 0xa9, 0xc3, 0x5b, 0xfe, 0x7e, 0x40, 0x1e, 0xc3,
+	0x9c, 0xbc, 0x5a, 0xe4, 0xe1, 0x4a, 0xe3, 0x7a, 0xe6, 0x23, 0x06, 0xb6, 0xec, 0x08, 0x4d, 0x2c,
+	0x98, 0x1f, 0xd6, 0xaa, 0x1e, 0x62, 0xda, 0x09, 0x19, 0xa9, 0x41, 0x41, 0xad, 0xfb, 0x2e, 0x8d,
+	0x97, 0xe4, 0x1a, 0x40, 0x14, 0x42, 0x3e, 0xf5, 0xb0, 0x36, 0xbb, 0x6c, 0xac, 0x94, 0xec, 0x92,
+	0x92, 0xbc, 0xa0, 0x1e, 0x4a, 0x2a, 0x42, 0xa4, 0x3c, 0xf0, 0x6b, 0x73, 0x6a, 0x4b, 0xaf, 0xc8,
+	0x0d, 0x6d, 0x14, 0x9d, 0x56, 0x18, 0xbc, 0xe6, 0xb5, 0xbc, 0xd2, 0x5a, 0xd6, 0x32, 0x3b, 0x78,
+	0xcd, 0xa5, 0x66, 0x11, 0x08, 0xea, 0x46, 0x80, 0x82, 0x02, 0x94, 0x94, 0x44, 0x6d, 0x5f, 0x87,
+	0x72, 0x88, 0x22, 0x3c, 0x6e, 0x75, 0x82, 0x9e, 0x2f, 0x6a, 0x45, 0xb5, 0x0f, 0x4a, 0xb4, 0x21,
+	0x25, 0x43, 0x51, 0x50, 0x1a, 0x8e, 0x02, 0x72, 0x17, 0x2e, 0x70, 0x0c, 0x19, 0x75, 0xd9, 0x1b,
+	0x74, 0x5a, 0x9c, 0xbd, 0xc1, 0x1a, 0x28, 0x40, 0x65, 0x20, 0xde, 0x65, 0x6f, 0xa4, 0x63, 0x16,
+	0xda, 0xf2, 0xfd, 0x2d, 0xc1, 0x3c, 0x6c, 0x79, 0xbc, 0x56, 0x8e, 0x2e, 0xa9, 0x84, 0x7b, 0xcc,
+	0xc3, 0x6d, 0x6e, 0x7d, 0x6d, 0xc0, 0x52, 0x9a, 0xc1, 0xb3, 0x04, 0xd5, 0xe3, 0xe8, 0x10, 0xca,
+	0x78, 0x32, 0x57, 0xca, 0x8d, 0x6b, 0xab, 0xa3, 0xd9, 0xb8, 0xda, 0xa7, 0xdc, 0xd6, 0x60, 0xeb,
+	0x57, 0x13, 0xc8, 0x46, 0x88, 0x54, 0xa0, 0xda, 0x8b, 0xa3, 0x28, 0x4d, 0xad, 0x91, 0x41, 0x6d,
+	0x92, 0xc0, 0x5c, 0x9a, 0xc0, 0xf1, 0xcc, 0xd7, 0xa0, 0xf0, 0x0a, 0x43, 0xce, 0x02, 0x5f, 0xd1,
+	0x6e, 0xda, 0xf1, 0x92, 0x5c, 0x81, 0x92, 0x87, 0x82, 0xb6, 0xba, 0x54, 0x1c, 0x6a, 0xde, 0x8b,
+	0x52, 0xb0, 0x43, 0xc5, 0xa1, 0xb4, 0xe7, 0x50, 0xbd, 0x29, 0x79, 0x37, 0xa5, 0x3d, 0x87, 0x46,
+	0xbb, 0x2a, 0xab, 0xc4, 0x71, 0x17, 0xe3, 0xac, 0x2a, 0x2c, 0x9b, 0xa3, 0x59, 0xa5, 0x5d, 0xf7,
+	0x11, 0x1e, 0x7f, 0x42, 0xdd, 0x1e, 0xee, 0x50, 0x16, 0xda, 0x20, 0x4f, 0x45, 0x59, 0x45, 0x9a,
+	0xfa, 0xd9, 0xb1, 0x92, 0xe2, 0xa4, 0x4a, 0xa2, 0xf8, 0xd3, 0x5a, 0x9e, 0xc0, 0x2c, 0x17, 0xd8,
+	0x55, 0xd1, 0x53, 0x69, 0x58, 0x63, 0x89, 0x50, 0x8e, 0xdc, 0x15, 0xd8, 0xb5, 0x15, 0x9e, 0x3c,
+	0x97, 0xa9, 0xdb, 0xed, 0x89, 0x96, 0xc2, 0x20, 0xaf, 0x81, 0x32, 0x7f, 0x7b, 0xac, 0x82, 0x67,
+	0xcc, 0x45, 0xf9, 0x78, 0xc5, 0xe8, 0xbc, 0x3a, 0xbb, 0x15, 0x1d, 0x25, 0x97, 0xa1, 0xe8, 0xf7,
+	0xbc, 0x28, 0x03, 0xa2, 0xe8, 0x2b, 0xf8, 0x3d, 0x4f, 0xc6, 0xbf, 0xf5, 0xa3, 0x09, 0x8b, 0x11,
+	0x87, 0x7f, 0x1b, 0xe3, 0x49, 0xea, 0xe6, 0x4e, 0xa1, 0x2e, 0xff, 0x57, 0x50, 0x57, 0xf8, 0x53,
+	0xd4, 0x0d, 0xbb, 0xad, 0x98, 0x70, 0xdb, 0xdb, 0xc0, 0xaa, 0xe5, 0x01, 0x19, 0x66, 0xee, 0x2c,
+	0xf5, 0x62, 0x82, 0xe2, 0x6d, 0x7d, 0x00, 0xb5, 0xb8, 0x44, 0xc5, 0x97, 0x9a, 0xb2, 0xcf, 0x7c,
+	0x67, 0xc0, 0xe2, 0xc8, 0xa3, 0xce, 0xed, 0xc2, 0x64, 0x05, 0xaa, 0x51, 0x10, 0xec, 0x33, 0x17,
+	0x75, 0xb4, 0x99, 0x2a, 0xda, 0x2a, 0x2c, 0xf1, 0x0a, 0x79, 0xb1, 0xcb, 0x19, 0x6f, 0x3b, 0x8b,
+	0x47, 0x9b, 0x00, 0x43, 0x66, 0x73, 0xd3, 0xb0, 0x5c, 0xda, 0xef, 0x5f, 0xec, 0x9b, 0x39, 0xdd,
+	0x99, 0xb7, 0x51, 0xd0, 0x89, 0xb2, 0xb2, 0xdf, 0xbd, 0x73, 0x53, 0x75, 0xef, 0xeb, 0x50, 0xde,
+	0xa7, 0xcc, 0x6d, 0xe9, 0x2e, 0x6b, 0xaa, 0x6c, 0x06, 0x29, 0xb2, 0x95, 0x84, 0xbc, 0x0b, 0x66,
+	0x88, 0x47, 0xaa, 0x44, 0x8f, 0x79, 0xc8, 0x48, 0x15, 0xb1, 0xe5, 0x89, 0x4c, 0x16, 0xe6, 0xb2,
+	0x58, 0x90, 0xcd, 0xdc, 0xa3, 0xe1, 0xcb, 0x96, 0x83, 0x2e, 0x0a, 0x74, 0x54, 0x33, 0x2f, 0xda,
+	0x65, 0x29, 0x6b, 0x46, 0xa2, 0xa1, 0x66, 0x5c, 0x48, 0x34, 0xe3, 0xa1, 0x26, 0x52, 0x4c, 0x36,
+	0x91, 0x3a, 0x14, 0x43, 0xec, 0x1c, 0x77, 0x5c, 0x74, 0x54, 0xb2, 0x16, 0xed, 0xfe, 0x3a, 0xdd,
+	0xfb, 0x61, 0xa4, 0xf7, 0xa7, 0xc7, 0x8b, 0xf2, 0x69, 0xe3, 0xc5, 0x7c, 0x7a, 0xbc, 0xb8, 0x0d,
+	0x95, 0x2e, 0x0d, 0xd1, 0x17, 0xad, 0xb6, 0x26, 0x6d, 0x41, 0x41, 0x16, 0x22, 0x69, 0xcc, 0xda,
+	0xc7, 0xb0, 0xe8, 0x30, 0x2e, 0x42, 0xd6, 0xee, 0x09, 0x74, 0x22, 0x6c, 0xad, 0xa2, 0x7c, 0x7d,
+	0x2b, 0xcb, 0xd7, 0xcd, 0x01, 0x58, 0xa9, 0xb0, 0xab, 0x4e, 0x4a, 0x92, 0x35, 0x9f, 0x5c, 0x98,
+	0x6c, 0x3e, 0xa9, 0x8e, 0xce, 0x27, 0xbf, 0xe5, 0xe0, 0xd2, 0x87, 0x5c, 0x30, 0x4f, 0x86, 0x0c,
+	0x76, 0xa8, 0xeb, 0x4e, 0xd3, 0x29, 0x2a, 0x90, 0xf3, 0x8f, 0x74, 0x8a, 0xe6, 0xfc, 0x23, 0x42,
+	0x60, 0x56, 0x04, 0xdd, 0x97, 0xba, 0x2f, 0xa8, 0xdf, 0xe4, 0x19, 0x2c, 0x70, 0xa4, 0x61, 0xe7,
+	0x30, 0x2e, 0xd9, 0xb3, 0x93, 0x96, 0xec, 0xf9, 0xe8, 0x9c, 0xae, 0xd9, 0x93, 0x87, 0xdb, 0x3f,
+	0x65, 0x82, 0xb0, 0x7e, 0x31, 0x60, 0x29, 0xed, 0xfc, 0x73, 0x2e, 0xf6, 0x9a, 0x32, 0xb3, 0x4f,
+	0x99, 0x1a, 0xc0, 0xa5, 0x69, 0x55, 0x01, 0x72, 0xb6, 0x5e, 0x91, 0x8b, 0x30, 0xf7, 0x45, 0xd0,
+	0xde, 0x6a, 0xaa, 0xf9, 0xcc, 0xb4, 0xa3, 0xc5, 0xa0, 0x08, 0xe5, 0xa7, 0x29, 0x42, 0xd6, 0x63,
+	0xb8, 0xb2, 0x89, 0x22, 0x7a, 0xa2, 0x7e, 0x30, 0x0b, 0xfc, 0x7e, 0x93, 0x59, 0x82, 0xbc, 0x52,
+	0x1f, 0x77, 0x17, 0xbd, 0xb2, 0x7e, 0x30, 0xe0, 0x6a, 0xf6, 0xb9, 0xb3, 0x15, 0xf0, 0x42, 0x88,
+	0xbc, 0xe7, 0x8a, 0xb8, 0x7a, 0xdf, 0xcb, 0x4a, 0xc4, 0x6c, 0x5e, 0xec, 0xf8, 0xa8, 0xe4, 0xae,
+	0x9a, 0x4e, 0xd6, 0xfe, 0xf0, 0x60, 0x4c, 0x39, 0x3c, 0xdc, 0x84, 0x05, 0x11, 0x52, 0xe6, 0xb7,
+	0xda, 0x49, 0xe6, 0x94, 0x30, 0x66, 0xee, 0x36, 0x54, 0xf8, 0x21, 0x0d, 0x9d, 0x18, 0x14, 0xf5,
+	0x3c, 0xd3, 0x5e, 0x50, 0x52, 0x8d, 0xe2, 0xe4, 0x3d, 0xc8, 0x2b, 0x41, 0x9c, 0x68, 0x99, 0xb7,
+	0xd8, 0x95, 0x88, 0x66, 0x9c, 0x12, 0xb6, 0x3e, 0x21, 0xf3, 0xd7, 0x09, 0x7c, 0x54, 0x9c, 0x17,
+	0x6d, 0xf5, 0xdb, 0x7a, 0x0e, 0x95, 0x24, 0x3a, 0x95, 0x5f, 0x46, 0x3a, 0xbf, 0x86, 0x87, 0xab,
+	0x5c, 0x72, 0x26, 0x0d, 0xe0, 0xbf, 0x1b, 0xd4, 0xef, 0xa0, 0x3b, 0xf0, 0xc2, 0x34, 0xe5, 0x66,
+	0xa8, 0x19, 0xe4, 0x92, 0xcd, 0x60, 0xf0, 0x37, 0xd2, 0x1c, 0xfe, 0x1b, 0x69, 0xdd, 0x87, 0x6a,
+	0x33, 0x0c, 0xba, 0x89, 0x11, 0x78, 0x68, 0x7e, 0x35, 0x12, 0xf3, 0xeb, 0xbd, 0x75, 0xa8, 0x24,
+	0xe9, 0x21, 0xf3, 0x50, 0x54, 0x8b, 0xa7, 0xae, 0x5b, 0x9d, 0x21, 0x25, 0x98, 0xdb, 0x93, 0x8c,
+	0x54, 0x0d, 0xb9, 0xf1, 0xd4, 0x71, 0x94, 0x63, 0xaa, 0x39, 0xb9, 0xb1, 0x8d, 0xe1, 0x01, 0x56,
+	0xcd, 0xc6, 0x4f, 0x45, 0x00, 0xa5, 0x64, 0x23, 0x08, 0x42, 0x87, 0x74, 0x81, 0x6c, 0xa2, 0xd8,
+	0x08, 0xbc, 0x6e, 0xe0, 0xa3, 0x2f, 0xa2, 0xbf, 0x80, 0xe4, 0xe1, 0x98, 0xaf, 0x00, 0xa3, 0x50,
+	0x7d, 0xe9, 0xfa, 0x9d, 0x31, 0x27, 0x52, 0x70, 0x6b, 0x86, 0x78, 0xca, 0xa2, 0x2c, 0xef, 0x7b,
+	0xac, 0xf3, 0x72, 0xe3, 0x90, 0xfa, 0x3e, 0xba, 0x27, 0x59, 0x4c, 0x41, 0x63, 0x8b, 0x37, 0x93,
+	0x27, 0xf4, 0x62, 0x57, 0x84, 0xcc, 0x3f, 0x88, 0xd3, 0xc1, 0x9a, 0x21, 0x47, 0x70, 0x71, 0x13,
+	0x95, 0x75, 0xc6, 0x05, 0xeb, 0xf0, 0xd8, 0x60, 0x63, 0xbc, 0xc1, 0x11, 0xf0, 0x94, 0x26, 0x3f,
+	0x03, 0x18, 0x8c, 0x24, 0x64, 0xb2, 0x91, 0xa5, 0x7e, 0xe7, 0x34, 0x58, 0x5f, 0x3d, 0x83, 0x4a,
+	0xf2, 0x1f, 0x3b, 0xf9, 0x5f, 0xd6, 0xd9, 0xcc, 0xef, 0x32, 0xf5, 0x7b, 0x93, 0x40, 0xfb, 0xa6,
+	0x42, 0x58, 0x1c, 0x99, 0x4e, 0xc9, 0xfd, 0x93, 0x54, 0xa4, 0x07, 0xf4, 0xfa, 0x83, 0x09, 0xd1,
+	0x7d, 0x9b, 0x3b, 0x50, 0xea, 0xa7, 0x04, 0xc9, 0x9e, 0x41, 0x52, 0x19, 0x53, 0x3f, 0xa9, 0xac,
+	0x46, 0x0e, 0x4b, 0x56, 0xcb, 0x6c, 0x87, 0x65, 0x8e, 0x19, 0xf5, 0x29, 0x8a, 0xaf, 0x35, 0x43,
+	0xbe, 0x52, 0xd1, 0x36, 0xd2, 0x10, 0xc8, 0xda, 0x18, 0x2f, 0x8c, 0x6b, 0x39, 0xf5, 0x87, 0x93,
+	0x1f, 0xe8, 0x1b, 0x6f, 0x01, 0x6c, 0xa2, 0xd8, 0x46, 0x11, 0xb2, 0x0e, 0x27, 0x77, 0x32, 0x83,
+	0x75, 0x00, 0x88, 0x2d, 0xdd, 0x3d, 0x15, 0x17, 0x1b, 0x68, 0xfc, 0x1c, 0xff, 0x29, 0x90, 0x1f,
+	0x1f, 0xff, 0x2d, 0x1d, 0xe7, 0x50, 0x3a, 0xf6, 0xa0, 0x3c, 0xf4, 0x19, 0x8c, 0x64, 0x16, 0x85,
+	0xd1, 0xef, 0x64, 0xa7, 0x25, 0xc0, 0xe7, 0x50, 0x4d, 0xb7, 0x35, 0xf2, 0xff, 0x4c, 0xd5, 0xd9,
+	0xcd, 0xef, 0x2d, 0x4a, 0xb0, 0xf3, 0x8e, 0xf1, 0xf5, 0x77, 0x3e, 0x6d, 0x1c, 0x30, 0x71, 0xd8,
+	0x6b, 0xcb, 0x57, 0xae, 0x45, 0xc8, 0x07, 0x2c, 0xd0, 0xbf, 0xd6, 0x62, 0xb2, 0xd7, 0x94, 0xa6,
+	0x35, 0x75, 0xdb, 0x6e, 0xbb, 0x9d, 0x57, 0xcb, 0x47, 0x7f, 0x0c, 0x00, 0x8e, 0x4c, 0xbb, 0x42,
+	0x8f, 0x18, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -892,6 +1566,8 @@ type IndexCoordClient interface {
 	GetIndexStates(ctx context.Context, in *GetIndexStatesRequest, opts ...grpc.CallOption) (*GetIndexStatesResponse, error)
 	GetIndexFilePaths(ctx context.Context, in *GetIndexFilePathsRequest, opts ...grpc.CallOption) (*GetIndexFilePathsResponse, error)
 	DropIndex(ctx context.Context, in *DropIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	EstimateRecall(ctx context.Context, in *EstimateRecallRequest, opts ...grpc.CallOption) (*EstimateRecallResponse, error)
+	GetRecallEstimations(ctx context.Context, in *GetRecallEstimationsRequest, opts ...grpc.CallOption) (*GetRecallEstimationsResponse, error)
 	// https://wiki.lfaidata.foundation/display/MIL/MEP+8+--+Add+metrics+for+proxy
 	GetMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error)
 }
@@ -967,6 +1643,24 @@ func (c *indexCoordClient) DropIndex(ctx context.Context, in *DropIndexRequest,
 	return out, nil
 }
 
+func (c *indexCoordClient) EstimateRecall(ctx context.Context, in *EstimateRecallRequest, opts ...grpc.CallOption) (*EstimateRecallResponse, error) {
+	out := new(EstimateRecallResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.index.IndexCoord/EstimateRecall", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexCoordClient) GetRecallEstimations(ctx context.Context, in *GetRecallEstimationsRequest, opts ...grpc.CallOption) (*GetRecallEstimationsResponse, error) {
+	out := new(GetRecallEstimationsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.index.IndexCoord/GetRecallEstimations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *indexCoordClient) GetMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error) {
 	out := new(milvuspb.GetMetricsResponse)
 	err := c.cc.Invoke(ctx, "/milvus.proto.index.IndexCoord/GetMetrics", in, out, opts...)
@@ -985,6 +1679,8 @@ type IndexCoordServer interface {
 	GetIndexStates(context.Context, *GetIndexStatesRequest) (*GetIndexStatesResponse, error)
 	GetIndexFilePaths(context.Context, *GetIndexFilePathsRequest) (*GetIndexFilePathsResponse, error)
 	DropIndex(context.Context, *DropIndexRequest) (*commonpb.Status, error)
+	EstimateRecall(context.Context, *EstimateRecallRequest) (*EstimateRecallResponse, error)
+	GetRecallEstimations(context.Context, *GetRecallEstimationsRequest) (*GetRecallEstimationsResponse, error)
 	// https://wiki.lfaidata.foundation/display/MIL/MEP+8+--+Add+metrics+for+proxy
 	GetMetrics(context.Context, *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error)
 }
@@ -1014,6 +1710,12 @@ func (*UnimplementedIndexCoordServer) GetIndexFilePaths(ctx context.Context, req
 func (*UnimplementedIndexCoordServer) DropIndex(ctx context.Context, req *DropIndexRequest) (*commonpb.Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DropIndex not implemented")
 }
+func (*UnimplementedIndexCoordServer) EstimateRecall(ctx context.Context, req *EstimateRecallRequest) (*EstimateRecallResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateRecall not implemented")
+}
+func (*UnimplementedIndexCoordServer) GetRecallEstimations(ctx context.Context, req *GetRecallEstimationsRequest) (*GetRecallEstimationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRecallEstimations not implemented")
+}
 func (*UnimplementedIndexCoordServer) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMetrics not implemented")
 }
@@ -1148,6 +1850,42 @@ func _IndexCoord_DropIndex_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _IndexCoord_EstimateRecall_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EstimateRecallRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexCoordServer).EstimateRecall(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.index.IndexCoord/EstimateRecall",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexCoordServer).EstimateRecall(ctx, req.(*EstimateRecallRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IndexCoord_GetRecallEstimations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRecallEstimationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexCoordServer).GetRecallEstimations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.index.IndexCoord/GetRecallEstimations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexCoordServer).GetRecallEstimations(ctx, req.(*GetRecallEstimationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _IndexCoord_GetMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(milvuspb.GetMetricsRequest)
 	if err := dec(in); err != nil {
@@ -1198,6 +1936,14 @@ var _IndexCoord_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DropIndex",
 			Handler:    _IndexCoord_DropIndex_Handler,
 		},
+		{
+			MethodName: "EstimateRecall",
+			Handler:    _IndexCoord_EstimateRecall_Handler,
+		},
+		{
+			MethodName: "GetRecallEstimations",
+			Handler:    _IndexCoord_GetRecallEstimations_Handler,
+		},
 		{
 			MethodName: "GetMetrics",
 			Handler:    _IndexCoord_GetMetrics_Handler,
@@ -1215,6 +1961,8 @@ type IndexNodeClient interface {
 	GetTimeTickChannel(ctx context.Context, in *internalpb.GetTimeTickChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error)
 	GetStatisticsChannel(ctx context.Context, in *internalpb.GetStatisticsChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error)
 	CreateIndex(ctx context.Context, in *CreateIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	CancelIndexBuild(ctx context.Context, in *CancelIndexBuildRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	EstimateRecall(ctx context.Context, in *EstimateRecallRequest, opts ...grpc.CallOption) (*EstimateRecallResponse, error)
 	// https://wiki.lfaidata.foundation/display/MIL/MEP+8+--+Add+metrics+for+proxy
 	GetMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error)
 }
@@ -1263,6 +2011,24 @@ func (c *indexNodeClient) CreateIndex(ctx context.Context, in *CreateIndexReques
 	return out, nil
 }
 
+func (c *indexNodeClient) CancelIndexBuild(ctx context.Context, in *CancelIndexBuildRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.index.IndexNode/CancelIndexBuild", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexNodeClient) EstimateRecall(ctx context.Context, in *EstimateRecallRequest, opts ...grpc.CallOption) (*EstimateRecallResponse, error) {
+	out := new(EstimateRecallResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.index.IndexNode/EstimateRecall", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *indexNodeClient) GetMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error) {
 	out := new(milvuspb.GetMetricsResponse)
 	err := c.cc.Invoke(ctx, "/milvus.proto.index.IndexNode/GetMetrics", in, out, opts...)
@@ -1278,6 +2044,8 @@ type IndexNodeServer interface {
 	GetTimeTickChannel(context.Context, *internalpb.GetTimeTickChannelRequest) (*milvuspb.StringResponse, error)
 	GetStatisticsChannel(context.Context, *internalpb.GetStatisticsChannelRequest) (*milvuspb.StringResponse, error)
 	CreateIndex(context.Context, *CreateIndexRequest) (*commonpb.Status, error)
+	CancelIndexBuild(context.Context, *CancelIndexBuildRequest) (*commonpb.Status, error)
+	EstimateRecall(context.Context, *EstimateRecallRequest) (*EstimateRecallResponse, error)
 	// https://wiki.lfaidata.foundation/display/MIL/MEP+8+--+Add+metrics+for+proxy
 	GetMetrics(context.Context, *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error)
 }
@@ -1298,6 +2066,12 @@ func (*UnimplementedIndexNodeServer) GetStatisticsChannel(ctx context.Context, r
 func (*UnimplementedIndexNodeServer) CreateIndex(ctx context.Context, req *CreateIndexRequest) (*commonpb.Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateIndex not implemented")
 }
+func (*UnimplementedIndexNodeServer) CancelIndexBuild(ctx context.Context, req *CancelIndexBuildRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelIndexBuild not implemented")
+}
+func (*UnimplementedIndexNodeServer) EstimateRecall(ctx context.Context, req *EstimateRecallRequest) (*EstimateRecallResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateRecall not implemented")
+}
 func (*UnimplementedIndexNodeServer) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMetrics not implemented")
 }
@@ -1378,6 +2152,42 @@ func _IndexNode_CreateIndex_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _IndexNode_CancelIndexBuild_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelIndexBuildRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexNodeServer).CancelIndexBuild(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.index.IndexNode/CancelIndexBuild",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexNodeServer).CancelIndexBuild(ctx, req.(*CancelIndexBuildRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IndexNode_EstimateRecall_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EstimateRecallRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexNodeServer).EstimateRecall(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.index.IndexNode/EstimateRecall",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexNodeServer).EstimateRecall(ctx, req.(*EstimateRecallRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _IndexNode_GetMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(milvuspb.GetMetricsRequest)
 	if err := dec(in); err != nil {
@@ -1416,6 +2226,14 @@ var _IndexNode_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CreateIndex",
 			Handler:    _IndexNode_CreateIndex_Handler,
 		},
+		{
+			MethodName: "CancelIndexBuild",
+			Handler:    _IndexNode_CancelIndexBuild_Handler,
+		},
+		{
+			MethodName: "EstimateRecall",
+			Handler:    _IndexNode_EstimateRecall_Handler,
+		},
 		{
 			MethodName: "GetMetrics",
 			Handler:    _IndexNode_GetMetrics_Handler,
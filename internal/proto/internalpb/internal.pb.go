@@ -1222,6 +1222,7 @@ type SearchRequest struct {
 	OutputFieldsId       []int64          `protobuf:"varint,10,rep,packed,name=output_fields_id,json=outputFieldsId,proto3" json:"output_fields_id,omitempty"`
 	TravelTimestamp      uint64           `protobuf:"varint,11,opt,name=travel_timestamp,json=travelTimestamp,proto3" json:"travel_timestamp,omitempty"`
 	GuaranteeTimestamp   uint64           `protobuf:"varint,12,opt,name=guarantee_timestamp,json=guaranteeTimestamp,proto3" json:"guarantee_timestamp,omitempty"`
+	IndexName            string           `protobuf:"bytes,13,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
 	XXX_unrecognized     []byte           `json:"-"`
 	XXX_sizecache        int32            `json:"-"`
@@ -1336,6 +1337,13 @@ func (m *SearchRequest) GetGuaranteeTimestamp() uint64 {
 	return 0
 }
 
+func (m *SearchRequest) GetIndexName() string {
+	if m != nil {
+		return m.IndexName
+	}
+	return ""
+}
+
 type SearchResults struct {
 	Base                     *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	Status                   *commonpb.Status  `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
@@ -2253,21 +2261,71 @@ func (m *QueryNodeStats) GetFieldStats() []*FieldStats {
 	return nil
 }
 
-type MsgPosition struct {
-	ChannelName          string   `protobuf:"bytes,1,opt,name=channel_name,json=channelName,proto3" json:"channel_name,omitempty"`
-	MsgID                []byte   `protobuf:"bytes,2,opt,name=msgID,proto3" json:"msgID,omitempty"`
-	MsgGroup             string   `protobuf:"bytes,3,opt,name=msgGroup,proto3" json:"msgGroup,omitempty"`
-	Timestamp            uint64   `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+// MsgIdentity is the identity of a dml message assigned by its producer
+type MsgIdentity struct {
+	SourceID             int64    `protobuf:"varint,1,opt,name=sourceID,proto3" json:"sourceID,omitempty"`
+	MsgID                int64    `protobuf:"varint,2,opt,name=msgID,proto3" json:"msgID,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
+func (m *MsgIdentity) Reset()         { *m = MsgIdentity{} }
+func (m *MsgIdentity) String() string { return proto.CompactTextString(m) }
+func (*MsgIdentity) ProtoMessage()    {}
+func (*MsgIdentity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41f4a519b878ee3b, []int{32}
+}
+
+func (m *MsgIdentity) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MsgIdentity.Unmarshal(m, b)
+}
+func (m *MsgIdentity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MsgIdentity.Marshal(b, m, deterministic)
+}
+func (m *MsgIdentity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgIdentity.Merge(m, src)
+}
+func (m *MsgIdentity) XXX_Size() int {
+	return xxx_messageInfo_MsgIdentity.Size(m)
+}
+func (m *MsgIdentity) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgIdentity.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgIdentity proto.InternalMessageInfo
+
+func (m *MsgIdentity) GetSourceID() int64 {
+	if m != nil {
+		return m.SourceID
+	}
+	return 0
+}
+
+func (m *MsgIdentity) GetMsgID() int64 {
+	if m != nil {
+		return m.MsgID
+	}
+	return 0
+}
+
+type MsgPosition struct {
+	ChannelName string `protobuf:"bytes,1,opt,name=channel_name,json=channelName,proto3" json:"channel_name,omitempty"`
+	MsgID       []byte `protobuf:"bytes,2,opt,name=msgID,proto3" json:"msgID,omitempty"`
+	MsgGroup    string `protobuf:"bytes,3,opt,name=msgGroup,proto3" json:"msgGroup,omitempty"`
+	Timestamp   uint64 `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// the last dml message of each producer before the position, which are dropped if replayed after seeking to it
+	DedupMsgs            []*MsgIdentity `protobuf:"bytes,5,rep,name=dedup_msgs,json=dedupMsgs,proto3" json:"dedup_msgs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
 func (m *MsgPosition) Reset()         { *m = MsgPosition{} }
 func (m *MsgPosition) String() string { return proto.CompactTextString(m) }
 func (*MsgPosition) ProtoMessage()    {}
 func (*MsgPosition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{32}
+	return fileDescriptor_41f4a519b878ee3b, []int{33}
 }
 
 func (m *MsgPosition) XXX_Unmarshal(b []byte) error {
@@ -2316,6 +2374,13 @@ func (m *MsgPosition) GetTimestamp() uint64 {
 	return 0
 }
 
+func (m *MsgPosition) GetDedupMsgs() []*MsgIdentity {
+	if m != nil {
+		return m.DedupMsgs
+	}
+	return nil
+}
+
 type ChannelTimeTickMsg struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	ChannelNames         []string          `protobuf:"bytes,2,rep,name=channelNames,proto3" json:"channelNames,omitempty"`
@@ -2330,7 +2395,7 @@ func (m *ChannelTimeTickMsg) Reset()         { *m = ChannelTimeTickMsg{} }
 func (m *ChannelTimeTickMsg) String() string { return proto.CompactTextString(m) }
 func (*ChannelTimeTickMsg) ProtoMessage()    {}
 func (*ChannelTimeTickMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{33}
+	return fileDescriptor_41f4a519b878ee3b, []int{34}
 }
 
 func (m *ChannelTimeTickMsg) XXX_Unmarshal(b []byte) error {
@@ -2379,6 +2444,54 @@ func (m *ChannelTimeTickMsg) GetDefaultTimestamp() uint64 {
 	return 0
 }
 
+type CredentialInfo struct {
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	// encrypted by bcrypt (for higher security level)
+	EncryptedPassword    string   `protobuf:"bytes,2,opt,name=encrypted_password,json=encryptedPassword,proto3" json:"encrypted_password,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CredentialInfo) Reset()         { *m = CredentialInfo{} }
+func (m *CredentialInfo) String() string { return proto.CompactTextString(m) }
+func (*CredentialInfo) ProtoMessage()    {}
+func (*CredentialInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41f4a519b878ee3b, []int{35}
+}
+
+func (m *CredentialInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CredentialInfo.Unmarshal(m, b)
+}
+func (m *CredentialInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CredentialInfo.Marshal(b, m, deterministic)
+}
+func (m *CredentialInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CredentialInfo.Merge(m, src)
+}
+func (m *CredentialInfo) XXX_Size() int {
+	return xxx_messageInfo_CredentialInfo.Size(m)
+}
+func (m *CredentialInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_CredentialInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CredentialInfo proto.InternalMessageInfo
+
+func (m *CredentialInfo) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *CredentialInfo) GetEncryptedPassword() string {
+	if m != nil {
+		return m.EncryptedPassword
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("milvus.proto.internal.StateCode", StateCode_name, StateCode_value)
 	proto.RegisterType((*ComponentInfo)(nil), "milvus.proto.internal.ComponentInfo")
@@ -2413,139 +2526,147 @@ func init() {
 	proto.RegisterType((*FieldStats)(nil), "milvus.proto.internal.FieldStats")
 	proto.RegisterType((*SegmentStats)(nil), "milvus.proto.internal.SegmentStats")
 	proto.RegisterType((*QueryNodeStats)(nil), "milvus.proto.internal.QueryNodeStats")
+	proto.RegisterType((*MsgIdentity)(nil), "milvus.proto.internal.MsgIdentity")
 	proto.RegisterType((*MsgPosition)(nil), "milvus.proto.internal.MsgPosition")
 	proto.RegisterType((*ChannelTimeTickMsg)(nil), "milvus.proto.internal.ChannelTimeTickMsg")
+	proto.RegisterType((*CredentialInfo)(nil), "milvus.proto.internal.CredentialInfo")
 }
 
 func init() { proto.RegisterFile("internal.proto", fileDescriptor_41f4a519b878ee3b) }
 
 var fileDescriptor_41f4a519b878ee3b = []byte{
-	// 2027 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0x5f, 0x6f, 0x1c, 0x49,
-	0x11, 0x67, 0x76, 0x76, 0xbd, 0xbb, 0xb5, 0x6b, 0x7b, 0xdd, 0x76, 0x72, 0x63, 0x27, 0x97, 0xf8,
-	0xe6, 0x0e, 0x30, 0x17, 0x11, 0x07, 0x1f, 0x70, 0x27, 0x84, 0xc8, 0xc5, 0xde, 0x23, 0xac, 0x72,
-	0x36, 0x66, 0x9c, 0x3b, 0x89, 0x7b, 0x19, 0xf5, 0xee, 0xb4, 0xd7, 0x43, 0xe6, 0xdf, 0x4d, 0xf7,
-	0x38, 0xd9, 0x7b, 0x42, 0x08, 0x5e, 0x40, 0x20, 0x81, 0xc4, 0xd7, 0xe0, 0x95, 0x27, 0xfe, 0x88,
-	0x27, 0xbe, 0x02, 0xcf, 0x7c, 0x09, 0xc4, 0x13, 0xea, 0xea, 0x9e, 0x3f, 0xbb, 0x5e, 0x3b, 0x8e,
-	0x23, 0xb8, 0x43, 0xca, 0xdb, 0x76, 0x55, 0x75, 0x4f, 0xd7, 0xaf, 0x7e, 0xd5, 0x55, 0xdd, 0x0b,
-	0x4b, 0x7e, 0x24, 0x58, 0x1a, 0xd1, 0xe0, 0x6e, 0x92, 0xc6, 0x22, 0x26, 0xd7, 0x42, 0x3f, 0x38,
-	0xcd, 0xb8, 0x1a, 0xdd, 0xcd, 0x95, 0x1b, 0xdd, 0x51, 0x1c, 0x86, 0x71, 0xa4, 0xc4, 0x1b, 0x5d,
-	0x3e, 0x3a, 0x61, 0x21, 0x55, 0x23, 0xfb, 0xcf, 0x06, 0x2c, 0xee, 0xc5, 0x61, 0x12, 0x47, 0x2c,
-	0x12, 0x83, 0xe8, 0x38, 0x26, 0xd7, 0x61, 0x21, 0x8a, 0x3d, 0x36, 0xe8, 0x5b, 0xc6, 0xa6, 0xb1,
-	0x65, 0x3a, 0x7a, 0x44, 0x08, 0xd4, 0xd3, 0x38, 0x60, 0x56, 0x6d, 0xd3, 0xd8, 0x6a, 0x3b, 0xf8,
-	0x9b, 0xdc, 0x07, 0xe0, 0x82, 0x0a, 0xe6, 0x8e, 0x62, 0x8f, 0x59, 0xe6, 0xa6, 0xb1, 0xb5, 0xb4,
-	0xb3, 0x79, 0x77, 0xee, 0x2e, 0xee, 0x1e, 0x49, 0xc3, 0xbd, 0xd8, 0x63, 0x4e, 0x9b, 0xe7, 0x3f,
-	0xc9, 0xfb, 0x00, 0xec, 0x99, 0x48, 0xa9, 0xeb, 0x47, 0xc7, 0xb1, 0x55, 0xdf, 0x34, 0xb7, 0x3a,
-	0x3b, 0x6f, 0x4c, 0x2f, 0xa0, 0x37, 0xff, 0x88, 0x4d, 0x3e, 0xa6, 0x41, 0xc6, 0x0e, 0xa9, 0x9f,
-	0x3a, 0x6d, 0x9c, 0x24, 0xb7, 0x6b, 0xff, 0xc3, 0x80, 0xe5, 0xc2, 0x01, 0xfc, 0x06, 0x27, 0xdf,
-	0x81, 0x06, 0x7e, 0x02, 0x3d, 0xe8, 0xec, 0xbc, 0x75, 0xce, 0x8e, 0xa6, 0xfc, 0x76, 0xd4, 0x14,
-	0xf2, 0x11, 0xac, 0xf2, 0x6c, 0x38, 0xca, 0x55, 0x2e, 0x4a, 0xb9, 0x55, 0xc3, 0xad, 0x5d, 0x6e,
-	0x25, 0x52, 0x5d, 0x40, 0x6f, 0xe9, 0x1d, 0x58, 0x90, 0x2b, 0x65, 0x1c, 0x51, 0xea, 0xec, 0xdc,
-	0x98, 0xeb, 0xe4, 0x11, 0x9a, 0x38, 0xda, 0xd4, 0xbe, 0x01, 0xeb, 0x0f, 0x99, 0x98, 0xf1, 0xce,
-	0x61, 0x9f, 0x66, 0x8c, 0x0b, 0xad, 0x7c, 0xec, 0x87, 0xec, 0xb1, 0x3f, 0x7a, 0xb2, 0x77, 0x42,
-	0xa3, 0x88, 0x05, 0xb9, 0xf2, 0x75, 0xb8, 0xf1, 0x90, 0xe1, 0x04, 0x9f, 0x0b, 0x7f, 0xc4, 0x67,
-	0xd4, 0xd7, 0x60, 0xf5, 0x21, 0x13, 0x7d, 0x6f, 0x46, 0xfc, 0x31, 0xb4, 0x0e, 0x64, 0xb0, 0x25,
-	0x0d, 0xbe, 0x0d, 0x4d, 0xea, 0x79, 0x29, 0xe3, 0x5c, 0xa3, 0x78, 0x73, 0xee, 0x8e, 0x1f, 0x28,
-	0x1b, 0x27, 0x37, 0x9e, 0x47, 0x13, 0xfb, 0x27, 0x00, 0x83, 0xc8, 0x17, 0x87, 0x34, 0xa5, 0x21,
-	0x3f, 0x97, 0x60, 0x7d, 0xe8, 0x72, 0x41, 0x53, 0xe1, 0x26, 0x68, 0xa7, 0x21, 0xbf, 0x04, 0x1b,
-	0x3a, 0x38, 0x4d, 0xad, 0x6e, 0xff, 0x18, 0xe0, 0x48, 0xa4, 0x7e, 0x34, 0xfe, 0xd0, 0xe7, 0x42,
-	0x7e, 0xeb, 0x54, 0xda, 0x49, 0x27, 0xcc, 0xad, 0xb6, 0xa3, 0x47, 0x95, 0x70, 0xd4, 0x2e, 0x1f,
-	0x8e, 0xfb, 0xd0, 0xc9, 0xe1, 0xde, 0xe7, 0x63, 0x72, 0x0f, 0xea, 0x43, 0xca, 0xd9, 0x85, 0xf0,
-	0xec, 0xf3, 0xf1, 0x2e, 0xe5, 0xcc, 0x41, 0x4b, 0xfb, 0x97, 0x26, 0xbc, 0xb6, 0x97, 0x32, 0x24,
-	0x7f, 0x10, 0xb0, 0x91, 0xf0, 0xe3, 0x48, 0x63, 0xff, 0xe2, 0xab, 0x91, 0xd7, 0xa0, 0xe9, 0x0d,
-	0xdd, 0x88, 0x86, 0x39, 0xd8, 0x0b, 0xde, 0xf0, 0x80, 0x86, 0x8c, 0x7c, 0x05, 0x96, 0x46, 0xc5,
-	0xfa, 0x52, 0x82, 0x9c, 0x6b, 0x3b, 0x33, 0x52, 0xf2, 0x16, 0x2c, 0x26, 0x34, 0x15, 0x7e, 0x61,
-	0x56, 0x47, 0xb3, 0x69, 0xa1, 0x0c, 0xa8, 0x37, 0x1c, 0xf4, 0xad, 0x06, 0x06, 0x0b, 0x7f, 0x13,
-	0x1b, 0xba, 0xe5, 0x5a, 0x83, 0xbe, 0xb5, 0x80, 0xba, 0x29, 0x19, 0xd9, 0x84, 0x4e, 0xb1, 0xd0,
-	0xa0, 0x6f, 0x35, 0xd1, 0xa4, 0x2a, 0x92, 0xc1, 0x51, 0x67, 0x91, 0xd5, 0xda, 0x34, 0xb6, 0xba,
-	0x8e, 0x1e, 0x91, 0x7b, 0xb0, 0x7a, 0xea, 0xa7, 0x22, 0xa3, 0x81, 0xe6, 0xa7, 0xdc, 0x07, 0xb7,
-	0xda, 0x18, 0xc1, 0x79, 0x2a, 0xb2, 0x03, 0x6b, 0xc9, 0xc9, 0x84, 0xfb, 0xa3, 0x99, 0x29, 0x80,
-	0x53, 0xe6, 0xea, 0xec, 0xbf, 0x19, 0x70, 0xad, 0x9f, 0xc6, 0xc9, 0x17, 0x22, 0x14, 0x39, 0xc8,
-	0xf5, 0x0b, 0x40, 0x6e, 0x9c, 0x05, 0xd9, 0xfe, 0x75, 0x0d, 0xae, 0x2b, 0x46, 0x1d, 0xe6, 0xc0,
-	0xfe, 0x17, 0xbc, 0xf8, 0x2a, 0x2c, 0x97, 0x5f, 0x55, 0x06, 0xf3, 0xdd, 0xf8, 0x32, 0x2c, 0x15,
-	0x01, 0x56, 0x76, 0xff, 0x5b, 0x4a, 0xd9, 0xbf, 0xaa, 0xc1, 0x9a, 0x0c, 0xea, 0x2b, 0x34, 0x24,
-	0x1a, 0xbf, 0x30, 0x80, 0x28, 0x76, 0x3c, 0x08, 0x7c, 0xca, 0xaf, 0x8e, 0xc5, 0x1c, 0x97, 0x6b,
-	0x73, 0x5d, 0x5e, 0x83, 0x06, 0x95, 0x9f, 0xd2, 0x88, 0xa8, 0x81, 0xfd, 0x09, 0xf4, 0x64, 0x50,
-	0x5e, 0x72, 0x13, 0xc5, 0xda, 0xb5, 0xea, 0xda, 0x3f, 0x37, 0x60, 0xe5, 0x41, 0x20, 0x58, 0xfa,
-	0xf9, 0xba, 0xf8, 0x97, 0x5a, 0x0e, 0xf5, 0x20, 0xf2, 0xd8, 0xb3, 0xcf, 0x93, 0x76, 0xaf, 0x03,
-	0x1c, 0xfb, 0x2c, 0xf0, 0xaa, 0x94, 0x6b, 0xa3, 0xe4, 0xa5, 0xe8, 0x66, 0x41, 0x13, 0x17, 0x29,
-	0xa8, 0x96, 0x0f, 0x65, 0xe1, 0x56, 0x4d, 0x9c, 0x2e, 0xdc, 0xad, 0x4b, 0x17, 0x6e, 0x9c, 0xa6,
-	0x0b, 0xf7, 0x1f, 0x4c, 0x58, 0x1c, 0x44, 0x9c, 0xa5, 0xe2, 0xea, 0xe0, 0xdd, 0x84, 0x36, 0x3f,
-	0xa1, 0x29, 0x3a, 0xaa, 0xe1, 0x2b, 0x05, 0x55, 0x68, 0xcd, 0xe7, 0x41, 0x5b, 0xbf, 0x64, 0x46,
-	0x37, 0x2e, 0xca, 0xe8, 0x85, 0x0b, 0x20, 0x6e, 0x3e, 0x3f, 0xa3, 0x5b, 0x67, 0x4b, 0xa6, 0x74,
-	0x90, 0x8d, 0x43, 0xd9, 0x69, 0xf6, 0xad, 0x36, 0xea, 0x4b, 0x01, 0xb9, 0x05, 0x20, 0xfc, 0x90,
-	0x71, 0x41, 0xc3, 0x44, 0x15, 0xbf, 0xba, 0x53, 0x91, 0xc8, 0x82, 0x9b, 0xc6, 0x4f, 0x07, 0x7d,
-	0x6e, 0x75, 0x36, 0x4d, 0xd9, 0x79, 0xa9, 0x11, 0xf9, 0x26, 0xb4, 0xd2, 0xf8, 0xa9, 0xeb, 0x51,
-	0x41, 0xad, 0x2e, 0x06, 0x6f, 0x7d, 0x2e, 0xd8, 0xbb, 0x41, 0x3c, 0x74, 0x9a, 0x69, 0xfc, 0xb4,
-	0x4f, 0x05, 0xb5, 0xff, 0x65, 0xc2, 0xe2, 0x11, 0xa3, 0xe9, 0xe8, 0xe4, 0xea, 0x01, 0xfb, 0x1a,
-	0xf4, 0x52, 0xc6, 0xb3, 0x40, 0xb8, 0x23, 0x55, 0x9b, 0x07, 0x7d, 0x1d, 0xb7, 0x65, 0x25, 0xdf,
-	0xcb, 0xc5, 0x05, 0xa8, 0xe6, 0x05, 0xa0, 0xd6, 0xe7, 0x80, 0x6a, 0x43, 0xb7, 0x82, 0x20, 0xb7,
-	0x1a, 0xe8, 0xfa, 0x94, 0x8c, 0xf4, 0xc0, 0xf4, 0x78, 0x80, 0xf1, 0x6a, 0x3b, 0xf2, 0x27, 0xb9,
-	0x03, 0x2b, 0x49, 0x40, 0x47, 0xec, 0x24, 0x0e, 0x3c, 0x96, 0xba, 0xe3, 0x34, 0xce, 0x12, 0x8c,
-	0x59, 0xd7, 0xe9, 0x55, 0x14, 0x0f, 0xa5, 0x9c, 0xbc, 0x0b, 0x2d, 0x8f, 0x07, 0xae, 0x98, 0x24,
-	0x0c, 0x83, 0xb6, 0x74, 0x8e, 0xef, 0x7d, 0x1e, 0x3c, 0x9e, 0x24, 0xcc, 0x69, 0x7a, 0xea, 0x07,
-	0xb9, 0x07, 0x6b, 0x9c, 0xa5, 0x3e, 0x0d, 0xfc, 0xcf, 0x98, 0xe7, 0xb2, 0x67, 0x49, 0xea, 0x26,
-	0x01, 0x8d, 0x30, 0xb2, 0x5d, 0x87, 0x94, 0xba, 0x0f, 0x9e, 0x25, 0xe9, 0x61, 0x40, 0x23, 0xb2,
-	0x05, 0xbd, 0x38, 0x13, 0x49, 0x26, 0x5c, 0xcc, 0x3e, 0xee, 0xfa, 0x1e, 0x06, 0xda, 0x74, 0x96,
-	0x94, 0xfc, 0xfb, 0x28, 0x1e, 0x78, 0x12, 0x5a, 0x91, 0xd2, 0x53, 0x16, 0xb8, 0x05, 0x03, 0xac,
-	0xce, 0xa6, 0xb1, 0x55, 0x77, 0x96, 0x95, 0xfc, 0x71, 0x2e, 0x26, 0xdb, 0xb0, 0x3a, 0xce, 0x68,
-	0x4a, 0x23, 0xc1, 0x58, 0xc5, 0xba, 0x8b, 0xd6, 0xa4, 0x50, 0x15, 0x13, 0xec, 0xdf, 0xd6, 0xcb,
-	0xd0, 0xcb, 0x28, 0xf1, 0x2b, 0x84, 0xfe, 0x2a, 0x2d, 0xf8, 0x5c, 0xbe, 0x98, 0xf3, 0xf9, 0x72,
-	0x1b, 0x3a, 0x21, 0x13, 0xa9, 0x3f, 0x52, 0x71, 0x51, 0x09, 0x0d, 0x4a, 0x84, 0xe0, 0xdf, 0x86,
-	0x4e, 0x94, 0x85, 0xee, 0xa7, 0x19, 0x4b, 0x7d, 0xc6, 0xf5, 0x79, 0x08, 0x51, 0x16, 0xfe, 0x48,
-	0x49, 0xc8, 0x2a, 0x34, 0x44, 0x9c, 0xb8, 0x4f, 0xf2, 0x3c, 0x16, 0x71, 0xf2, 0x88, 0x7c, 0x17,
-	0x36, 0x38, 0xa3, 0x01, 0xf3, 0xdc, 0x22, 0xef, 0xb8, 0xcb, 0x11, 0x0b, 0xe6, 0x59, 0x4d, 0x0c,
-	0x85, 0xa5, 0x2c, 0x8e, 0x0a, 0x83, 0x23, 0xad, 0x97, 0x48, 0x17, 0x1b, 0xaf, 0x4c, 0x6b, 0x61,
-	0x9f, 0x4a, 0x4a, 0x55, 0x31, 0xe1, 0x3d, 0xb0, 0xc6, 0x41, 0x3c, 0xa4, 0x81, 0x7b, 0xe6, 0xab,
-	0xd8, 0x10, 0x9b, 0xce, 0x75, 0xa5, 0x3f, 0x9a, 0xf9, 0xa4, 0x74, 0x8f, 0x07, 0xfe, 0x88, 0x79,
-	0xee, 0x30, 0x88, 0x87, 0x16, 0x20, 0xa5, 0x40, 0x89, 0x64, 0x22, 0x4b, 0x2a, 0x69, 0x03, 0x09,
-	0xc3, 0x28, 0xce, 0x22, 0x81, 0x04, 0x31, 0x9d, 0x25, 0x25, 0x3f, 0xc8, 0xc2, 0x3d, 0x29, 0x25,
-	0x6f, 0xc2, 0xa2, 0xb6, 0x8c, 0x8f, 0x8f, 0x39, 0x13, 0xc8, 0x0c, 0xd3, 0xe9, 0x2a, 0xe1, 0x0f,
-	0x51, 0x66, 0xff, 0xcc, 0x84, 0x65, 0x47, 0xa2, 0xcb, 0x4e, 0xd9, 0xff, 0xfd, 0x81, 0x70, 0x5e,
-	0x62, 0x2e, 0xbc, 0x50, 0x62, 0x36, 0x2f, 0x9d, 0x98, 0xad, 0x17, 0x4a, 0xcc, 0xf6, 0xb9, 0x89,
-	0xf9, 0xa7, 0xa9, 0x20, 0x7c, 0x51, 0x53, 0xf3, 0x6d, 0x30, 0x7d, 0x8f, 0x63, 0x70, 0x3a, 0x3b,
-	0xd6, 0xf4, 0xe2, 0xfa, 0x75, 0x6a, 0xd0, 0xe7, 0x8e, 0x34, 0x22, 0xf7, 0xa1, 0xa3, 0x01, 0xc5,
-	0xf2, 0xd4, 0xc0, 0xf2, 0x74, 0x6b, 0xee, 0x1c, 0x44, 0x58, 0x96, 0x26, 0x47, 0x35, 0x40, 0x5c,
-	0xfe, 0x26, 0xdf, 0x83, 0x1b, 0x67, 0x13, 0x36, 0xd5, 0x18, 0x79, 0xd6, 0x02, 0xc6, 0x68, 0x7d,
-	0x36, 0x63, 0x73, 0x10, 0x3d, 0xf2, 0x0d, 0x58, 0xab, 0xa4, 0x6c, 0x39, 0xb1, 0xa9, 0xae, 0xa3,
-	0xa5, 0xae, 0x9c, 0x72, 0x51, 0xd2, 0xb6, 0x2e, 0x4a, 0x5a, 0xfb, 0x9f, 0x35, 0x58, 0xec, 0xb3,
-	0x80, 0x09, 0xf6, 0xaa, 0x09, 0x3a, 0xb7, 0x09, 0x7a, 0x03, 0xba, 0x49, 0xea, 0x87, 0x34, 0x9d,
-	0xb8, 0x4f, 0xd8, 0x24, 0x3f, 0x07, 0x3b, 0x5a, 0xf6, 0x88, 0x4d, 0xb8, 0xc4, 0xa0, 0x4c, 0x17,
-	0xc0, 0x74, 0x29, 0x05, 0x76, 0x04, 0x1b, 0x1f, 0xc6, 0xd4, 0xdb, 0xa5, 0x01, 0x8d, 0x46, 0x4c,
-	0xc3, 0xff, 0x12, 0x77, 0x87, 0x5b, 0x00, 0x95, 0x08, 0xd7, 0x70, 0x3b, 0x15, 0x89, 0xfd, 0x6f,
-	0x03, 0xda, 0xf2, 0x83, 0x78, 0x35, 0xb8, 0x62, 0x44, 0x8b, 0xae, 0xaf, 0x36, 0xdb, 0xf5, 0xdd,
-	0x84, 0xb2, 0xbb, 0xd7, 0x31, 0xad, 0xb4, 0xfb, 0x95, 0xb6, 0xbd, 0x3e, 0xdd, 0xb6, 0xdf, 0x86,
-	0x8e, 0x2f, 0x37, 0xe4, 0x26, 0x54, 0x9c, 0xa8, 0x63, 0xb0, 0xed, 0x00, 0x8a, 0x0e, 0xa5, 0x44,
-	0xf6, 0xf5, 0xb9, 0x01, 0xf6, 0xf5, 0x0b, 0x97, 0xee, 0xeb, 0xf5, 0x22, 0xd8, 0xd7, 0xff, 0xb5,
-	0x06, 0x96, 0x86, 0xb8, 0x7c, 0x8f, 0xfc, 0x28, 0xf1, 0xf0, 0x59, 0xf4, 0x26, 0xb4, 0x0b, 0xf6,
-	0xeb, 0xe7, 0xc0, 0x52, 0x20, 0x71, 0xdd, 0x67, 0x61, 0x9c, 0x4e, 0x8e, 0xfc, 0xcf, 0x98, 0x76,
-	0xbc, 0x22, 0x91, 0xbe, 0x1d, 0x64, 0xa1, 0x13, 0x3f, 0xe5, 0xba, 0x08, 0xe4, 0x43, 0xe9, 0xdb,
-	0x08, 0x6f, 0x63, 0x78, 0x6a, 0xa2, 0xe7, 0x75, 0x07, 0x94, 0x48, 0x9e, 0x96, 0x64, 0x1d, 0x5a,
-	0x2c, 0xf2, 0x94, 0xb6, 0x81, 0xda, 0x26, 0x8b, 0x3c, 0x54, 0x0d, 0x60, 0x49, 0xbf, 0x43, 0xc6,
-	0x1c, 0x29, 0x87, 0x14, 0xee, 0xec, 0xd8, 0xe7, 0x3c, 0xfe, 0xee, 0xf3, 0xf1, 0xa1, 0xb6, 0x74,
-	0x16, 0xd5, 0x53, 0xa4, 0x1e, 0x92, 0x0f, 0xa0, 0x2b, 0xbf, 0x52, 0x2c, 0xd4, 0xbc, 0xf4, 0x42,
-	0x1d, 0x16, 0x79, 0xf9, 0xc0, 0xfe, 0x9d, 0x01, 0x2b, 0x67, 0x20, 0xbc, 0x02, 0x8f, 0x1e, 0x41,
-	0xeb, 0x88, 0x8d, 0xe5, 0x12, 0xf9, 0xeb, 0xea, 0xf6, 0x79, 0x8f, 0xf5, 0xe7, 0x04, 0xcc, 0x29,
-	0x16, 0x90, 0x17, 0x6f, 0x40, 0x42, 0xe3, 0xf0, 0x0c, 0x59, 0x8c, 0xab, 0x90, 0x45, 0xd6, 0x5d,
-	0xd9, 0x8c, 0xa4, 0x2c, 0xa0, 0xa2, 0x3c, 0x37, 0xb9, 0x8e, 0x3d, 0x89, 0xb2, 0xd0, 0x51, 0xaa,
-	0x3c, 0x69, 0xed, 0xdf, 0x18, 0x00, 0x78, 0xf0, 0xab, 0x6d, 0xcc, 0x9e, 0x30, 0xc6, 0xc5, 0x37,
-	0xd9, 0xda, 0x74, 0x4a, 0xec, 0xe6, 0x29, 0xc1, 0x11, 0x23, 0x73, 0x9e, 0x0f, 0x05, 0x46, 0xa5,
-	0xf3, 0x3a, 0x6b, 0x14, 0x2e, 0xbf, 0x37, 0xa0, 0x5b, 0x81, 0x8f, 0x4f, 0x67, 0xaf, 0x31, 0x9b,
-	0xbd, 0xd8, 0xa6, 0x4a, 0x46, 0xbb, 0xbc, 0x42, 0xf2, 0xb0, 0x24, 0xf9, 0x3a, 0xb4, 0x10, 0x92,
-	0x0a, 0xcb, 0x23, 0xcd, 0xf2, 0x3b, 0xb0, 0x92, 0xb2, 0x11, 0x8b, 0x44, 0x30, 0x71, 0xc3, 0xd8,
-	0xf3, 0x8f, 0x7d, 0xe6, 0x21, 0xd7, 0x5b, 0x4e, 0x2f, 0x57, 0xec, 0x6b, 0xb9, 0xfd, 0x77, 0x03,
-	0x96, 0x64, 0x67, 0x3b, 0x39, 0x88, 0x3d, 0xa6, 0x76, 0xf6, 0xe2, 0x0c, 0x7a, 0x1f, 0x7d, 0xd1,
-	0xf0, 0x28, 0x0a, 0xbd, 0xf9, 0x7c, 0x0a, 0x71, 0xa7, 0xc5, 0x35, 0x6d, 0x24, 0xc4, 0xea, 0x75,
-	0xe2, 0x32, 0x10, 0x97, 0x81, 0xd5, 0x25, 0x5d, 0x41, 0xfc, 0x53, 0x03, 0x3a, 0x95, 0x64, 0x91,
-	0x05, 0x41, 0x97, 0x61, 0x55, 0x8f, 0x0c, 0x3c, 0x04, 0x3b, 0xa3, 0xf2, 0xb9, 0x97, 0xac, 0x41,
-	0x23, 0xe4, 0x63, 0x1d, 0xf1, 0xae, 0xa3, 0x06, 0x64, 0x03, 0x5a, 0x21, 0x1f, 0xe3, 0x25, 0x4e,
-	0x9f, 0x9c, 0xc5, 0x78, 0xba, 0x84, 0xd4, 0x67, 0x4b, 0xc8, 0x1f, 0x0d, 0x20, 0xba, 0xa1, 0x79,
-	0xa9, 0xff, 0x04, 0x90, 0xb0, 0xd5, 0x27, 0xeb, 0x1a, 0x1e, 0xc3, 0x53, 0xb2, 0x99, 0x7b, 0xbd,
-	0x79, 0xe6, 0x5e, 0x7f, 0x07, 0x56, 0x3c, 0x76, 0x4c, 0x65, 0xef, 0x35, 0xbb, 0xe5, 0x9e, 0x56,
-	0x14, 0x2d, 0xe2, 0xdb, 0xef, 0x41, 0xbb, 0xf8, 0x2b, 0x8e, 0xf4, 0xa0, 0x3b, 0x88, 0x7c, 0x81,
-	0xcd, 0xac, 0x1f, 0x8d, 0x7b, 0x5f, 0x22, 0x1d, 0x68, 0xfe, 0x80, 0xd1, 0x40, 0x9c, 0x4c, 0x7a,
-	0x06, 0xe9, 0x42, 0xeb, 0xc1, 0x30, 0x8a, 0xd3, 0x90, 0x06, 0xbd, 0xda, 0xee, 0xbb, 0x9f, 0x7c,
-	0x6b, 0xec, 0x8b, 0x93, 0x6c, 0x28, 0x3d, 0xd9, 0x56, 0xae, 0x7d, 0xdd, 0x8f, 0xf5, 0xaf, 0xed,
-	0x3c, 0x6a, 0xdb, 0xe8, 0x6d, 0x31, 0x4c, 0x86, 0xc3, 0x05, 0x94, 0xbc, 0xf3, 0x9f, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0xc8, 0x45, 0x1a, 0x6a, 0xb0, 0x1c, 0x00, 0x00,
+	// 2128 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0xdd, 0x6f, 0x1c, 0x49,
+	0x11, 0x67, 0x76, 0xd7, 0xde, 0xdd, 0xda, 0xb5, 0xb3, 0xee, 0x7c, 0xdc, 0xc4, 0xf9, 0x72, 0xe6,
+	0x0e, 0x30, 0x17, 0x5d, 0x12, 0x7c, 0xc0, 0x9d, 0x10, 0x22, 0x97, 0x78, 0x8f, 0xb0, 0xca, 0x39,
+	0x98, 0x71, 0xee, 0x24, 0x8e, 0x87, 0x51, 0xef, 0x4c, 0x7b, 0x3d, 0x64, 0xbe, 0xae, 0xbb, 0x27,
+	0xce, 0xde, 0x23, 0x82, 0x17, 0x10, 0x48, 0x20, 0x21, 0xfe, 0x0b, 0x5e, 0x79, 0xe2, 0x43, 0xf0,
+	0xc2, 0xbf, 0xc0, 0x33, 0xff, 0x05, 0x4f, 0xa8, 0xab, 0x7b, 0x3e, 0x76, 0xbd, 0x76, 0x1c, 0x47,
+	0x70, 0x87, 0x74, 0x6f, 0xd3, 0x55, 0xdd, 0x35, 0x5d, 0xbf, 0xfa, 0x55, 0x57, 0x4d, 0x0f, 0xac,
+	0x86, 0x89, 0x64, 0x3c, 0xa1, 0xd1, 0xed, 0x8c, 0xa7, 0x32, 0x25, 0x17, 0xe3, 0x30, 0x7a, 0x96,
+	0x0b, 0x3d, 0xba, 0x5d, 0x28, 0xd7, 0xfb, 0x7e, 0x1a, 0xc7, 0x69, 0xa2, 0xc5, 0xeb, 0x7d, 0xe1,
+	0x1f, 0xb0, 0x98, 0xea, 0x91, 0xf3, 0x67, 0x0b, 0x56, 0xb6, 0xd3, 0x38, 0x4b, 0x13, 0x96, 0xc8,
+	0x51, 0xb2, 0x9f, 0x92, 0x4b, 0xb0, 0x9c, 0xa4, 0x01, 0x1b, 0x0d, 0x6d, 0x6b, 0xc3, 0xda, 0x6c,
+	0xba, 0x66, 0x44, 0x08, 0xb4, 0x78, 0x1a, 0x31, 0xbb, 0xb1, 0x61, 0x6d, 0x76, 0x5d, 0x7c, 0x26,
+	0xf7, 0x00, 0x84, 0xa4, 0x92, 0x79, 0x7e, 0x1a, 0x30, 0xbb, 0xb9, 0x61, 0x6d, 0xae, 0x6e, 0x6d,
+	0xdc, 0x5e, 0xb8, 0x8b, 0xdb, 0x7b, 0x6a, 0xe2, 0x76, 0x1a, 0x30, 0xb7, 0x2b, 0x8a, 0x47, 0xf2,
+	0x1e, 0x00, 0x7b, 0x2e, 0x39, 0xf5, 0xc2, 0x64, 0x3f, 0xb5, 0x5b, 0x1b, 0xcd, 0xcd, 0xde, 0xd6,
+	0xcd, 0x59, 0x03, 0x66, 0xf3, 0x8f, 0xd8, 0xf4, 0x23, 0x1a, 0xe5, 0x6c, 0x97, 0x86, 0xdc, 0xed,
+	0xe2, 0x22, 0xb5, 0x5d, 0xe7, 0x9f, 0x16, 0x9c, 0x2b, 0x1d, 0xc0, 0x77, 0x08, 0xf2, 0x6d, 0x58,
+	0xc2, 0x57, 0xa0, 0x07, 0xbd, 0xad, 0x37, 0x8e, 0xd9, 0xd1, 0x8c, 0xdf, 0xae, 0x5e, 0x42, 0x3e,
+	0x84, 0xf3, 0x22, 0x1f, 0xfb, 0x85, 0xca, 0x43, 0xa9, 0xb0, 0x1b, 0x1b, 0xcd, 0x53, 0x5b, 0x22,
+	0x75, 0x03, 0x66, 0x4b, 0x6f, 0xc3, 0xb2, 0xb2, 0x94, 0x0b, 0x44, 0xa9, 0xb7, 0x75, 0x65, 0xa1,
+	0x93, 0x7b, 0x38, 0xc5, 0x35, 0x53, 0x9d, 0x2b, 0x70, 0xf9, 0x21, 0x93, 0x73, 0xde, 0xb9, 0xec,
+	0x93, 0x9c, 0x09, 0x69, 0x94, 0x4f, 0xc2, 0x98, 0x3d, 0x09, 0xfd, 0xa7, 0xdb, 0x07, 0x34, 0x49,
+	0x58, 0x54, 0x28, 0xaf, 0xc1, 0x95, 0x87, 0x0c, 0x17, 0x84, 0x42, 0x86, 0xbe, 0x98, 0x53, 0x5f,
+	0x84, 0xf3, 0x0f, 0x99, 0x1c, 0x06, 0x73, 0xe2, 0x8f, 0xa0, 0xf3, 0x58, 0x05, 0x5b, 0xd1, 0xe0,
+	0x5b, 0xd0, 0xa6, 0x41, 0xc0, 0x99, 0x10, 0x06, 0xc5, 0xab, 0x0b, 0x77, 0x7c, 0x5f, 0xcf, 0x71,
+	0x8b, 0xc9, 0x8b, 0x68, 0xe2, 0xfc, 0x04, 0x60, 0x94, 0x84, 0x72, 0x97, 0x72, 0x1a, 0x8b, 0x63,
+	0x09, 0x36, 0x84, 0xbe, 0x90, 0x94, 0x4b, 0x2f, 0xc3, 0x79, 0x76, 0xe3, 0xb4, 0x6c, 0xe8, 0xe1,
+	0x32, 0x6d, 0xdd, 0xf9, 0x11, 0xc0, 0x9e, 0xe4, 0x61, 0x32, 0xf9, 0x20, 0x14, 0x52, 0xbd, 0xeb,
+	0x99, 0x9a, 0xa7, 0x9c, 0x68, 0x6e, 0x76, 0x5d, 0x33, 0xaa, 0x85, 0xa3, 0x71, 0xfa, 0x70, 0xdc,
+	0x83, 0x5e, 0x01, 0xf7, 0x8e, 0x98, 0x90, 0xbb, 0xd0, 0x1a, 0x53, 0xc1, 0x4e, 0x84, 0x67, 0x47,
+	0x4c, 0x1e, 0x50, 0xc1, 0x5c, 0x9c, 0xe9, 0xfc, 0xa2, 0x09, 0xaf, 0x6d, 0x73, 0x86, 0xe4, 0x8f,
+	0x22, 0xe6, 0xcb, 0x30, 0x4d, 0x0c, 0xf6, 0x2f, 0x6f, 0x8d, 0xbc, 0x06, 0xed, 0x60, 0xec, 0x25,
+	0x34, 0x2e, 0xc0, 0x5e, 0x0e, 0xc6, 0x8f, 0x69, 0xcc, 0xc8, 0x57, 0x60, 0xd5, 0x2f, 0xed, 0x2b,
+	0x09, 0x72, 0xae, 0xeb, 0xce, 0x49, 0xc9, 0x1b, 0xb0, 0x92, 0x51, 0x2e, 0xc3, 0x72, 0x5a, 0x0b,
+	0xa7, 0xcd, 0x0a, 0x55, 0x40, 0x83, 0xf1, 0x68, 0x68, 0x2f, 0x61, 0xb0, 0xf0, 0x99, 0x38, 0xd0,
+	0xaf, 0x6c, 0x8d, 0x86, 0xf6, 0x32, 0xea, 0x66, 0x64, 0x64, 0x03, 0x7a, 0xa5, 0xa1, 0xd1, 0xd0,
+	0x6e, 0xe3, 0x94, 0xba, 0x48, 0x05, 0x47, 0x9f, 0x45, 0x76, 0x67, 0xc3, 0xda, 0xec, 0xbb, 0x66,
+	0x44, 0xee, 0xc2, 0xf9, 0x67, 0x21, 0x97, 0x39, 0x8d, 0x0c, 0x3f, 0xd5, 0x3e, 0x84, 0xdd, 0xc5,
+	0x08, 0x2e, 0x52, 0x91, 0x2d, 0xb8, 0x90, 0x1d, 0x4c, 0x45, 0xe8, 0xcf, 0x2d, 0x01, 0x5c, 0xb2,
+	0x50, 0xe7, 0xfc, 0xcd, 0x82, 0x8b, 0x43, 0x9e, 0x66, 0x9f, 0x8b, 0x50, 0x14, 0x20, 0xb7, 0x4e,
+	0x00, 0x79, 0xe9, 0x28, 0xc8, 0xce, 0xaf, 0x1a, 0x70, 0x49, 0x33, 0x6a, 0xb7, 0x00, 0xf6, 0xbf,
+	0xe0, 0xc5, 0x57, 0xe1, 0x5c, 0xf5, 0x56, 0x2f, 0x39, 0xde, 0x8d, 0x2f, 0xc3, 0x6a, 0x19, 0x60,
+	0x3d, 0xef, 0x7f, 0x4b, 0x29, 0xe7, 0x97, 0x0d, 0xb8, 0xa0, 0x82, 0xfa, 0x05, 0x1a, 0x0a, 0x8d,
+	0x9f, 0x5b, 0x40, 0x34, 0x3b, 0xee, 0x47, 0x21, 0x15, 0x67, 0xc7, 0x62, 0x81, 0xcb, 0x8d, 0x85,
+	0x2e, 0x5f, 0x80, 0x25, 0xaa, 0x5e, 0x65, 0x10, 0xd1, 0x03, 0xe7, 0x63, 0x18, 0xa8, 0xa0, 0xbc,
+	0xe2, 0x26, 0x4a, 0xdb, 0x8d, 0xba, 0xed, 0x9f, 0x59, 0xb0, 0x76, 0x3f, 0x92, 0x8c, 0x7f, 0xb6,
+	0x2e, 0xfe, 0xa5, 0x51, 0x40, 0x3d, 0x4a, 0x02, 0xf6, 0xfc, 0xb3, 0xa4, 0xdd, 0x35, 0x80, 0xfd,
+	0x90, 0x45, 0x41, 0x9d, 0x72, 0x5d, 0x94, 0xbc, 0x12, 0xdd, 0x6c, 0x68, 0xa3, 0x91, 0x92, 0x6a,
+	0xc5, 0x50, 0x15, 0x6e, 0xdd, 0xc4, 0x99, 0xc2, 0xdd, 0x39, 0x75, 0xe1, 0xc6, 0x65, 0xa6, 0x70,
+	0xff, 0xa1, 0x09, 0x2b, 0xa3, 0x44, 0x30, 0x2e, 0xcf, 0x0e, 0xde, 0x55, 0xe8, 0x8a, 0x03, 0xca,
+	0x83, 0xc7, 0x15, 0x7c, 0x95, 0xa0, 0x0e, 0x6d, 0xf3, 0x45, 0xd0, 0xb6, 0x4e, 0x99, 0xd1, 0x4b,
+	0x27, 0x65, 0xf4, 0xf2, 0x09, 0x10, 0xb7, 0x5f, 0x9c, 0xd1, 0x9d, 0xa3, 0x25, 0x53, 0x39, 0xc8,
+	0x26, 0xb1, 0xea, 0x34, 0x87, 0x76, 0x17, 0xf5, 0x95, 0x80, 0x5c, 0x07, 0x90, 0x61, 0xcc, 0x84,
+	0xa4, 0x71, 0xa6, 0x8b, 0x5f, 0xcb, 0xad, 0x49, 0x54, 0xc1, 0xe5, 0xe9, 0xe1, 0x68, 0x28, 0xec,
+	0xde, 0x46, 0x53, 0x75, 0x5e, 0x7a, 0x44, 0xbe, 0x01, 0x1d, 0x9e, 0x1e, 0x7a, 0x01, 0x95, 0xd4,
+	0xee, 0x63, 0xf0, 0x2e, 0x2f, 0x04, 0xfb, 0x41, 0x94, 0x8e, 0xdd, 0x36, 0x4f, 0x0f, 0x87, 0x54,
+	0x52, 0xe7, 0xf7, 0x2d, 0x58, 0xd9, 0x63, 0x94, 0xfb, 0x07, 0x67, 0x0f, 0xd8, 0xd7, 0x60, 0xc0,
+	0x99, 0xc8, 0x23, 0xe9, 0xf9, 0xba, 0x36, 0x8f, 0x86, 0x26, 0x6e, 0xe7, 0xb4, 0x7c, 0xbb, 0x10,
+	0x97, 0xa0, 0x36, 0x4f, 0x00, 0xb5, 0xb5, 0x00, 0x54, 0x07, 0xfa, 0x35, 0x04, 0x85, 0xbd, 0x84,
+	0xae, 0xcf, 0xc8, 0xc8, 0x00, 0x9a, 0x81, 0x88, 0x30, 0x5e, 0x5d, 0x57, 0x3d, 0x92, 0x5b, 0xb0,
+	0x96, 0x45, 0xd4, 0x67, 0x07, 0x69, 0x14, 0x30, 0xee, 0x4d, 0x78, 0x9a, 0x67, 0x18, 0xb3, 0xbe,
+	0x3b, 0xa8, 0x29, 0x1e, 0x2a, 0x39, 0x79, 0x07, 0x3a, 0x81, 0x88, 0x3c, 0x39, 0xcd, 0x18, 0x06,
+	0x6d, 0xf5, 0x18, 0xdf, 0x87, 0x22, 0x7a, 0x32, 0xcd, 0x98, 0xdb, 0x0e, 0xf4, 0x03, 0xb9, 0x0b,
+	0x17, 0x04, 0xe3, 0x21, 0x8d, 0xc2, 0x4f, 0x59, 0xe0, 0xb1, 0xe7, 0x19, 0xf7, 0xb2, 0x88, 0x26,
+	0x18, 0xd9, 0xbe, 0x4b, 0x2a, 0xdd, 0xfb, 0xcf, 0x33, 0xbe, 0x1b, 0xd1, 0x84, 0x6c, 0xc2, 0x20,
+	0xcd, 0x65, 0x96, 0x4b, 0x0f, 0xb3, 0x4f, 0x78, 0x61, 0x80, 0x81, 0x6e, 0xba, 0xab, 0x5a, 0xfe,
+	0x3d, 0x14, 0x8f, 0x02, 0x05, 0xad, 0xe4, 0xf4, 0x19, 0x8b, 0xbc, 0x92, 0x01, 0x76, 0x6f, 0xc3,
+	0xda, 0x6c, 0xb9, 0xe7, 0xb4, 0xfc, 0x49, 0x21, 0x26, 0x77, 0xe0, 0xfc, 0x24, 0xa7, 0x9c, 0x26,
+	0x92, 0xb1, 0xda, 0xec, 0x3e, 0xce, 0x26, 0xa5, 0xaa, 0x5a, 0x70, 0x0d, 0x20, 0x54, 0xc7, 0x9c,
+	0xce, 0x81, 0x15, 0x9d, 0x68, 0x28, 0x51, 0xfc, 0x77, 0x7e, 0x53, 0x63, 0x86, 0x0a, 0xa2, 0x38,
+	0x03, 0x33, 0xce, 0xd2, 0xa1, 0x2f, 0xa4, 0x53, 0x73, 0x31, 0x9d, 0x6e, 0x40, 0x2f, 0x66, 0x92,
+	0x87, 0xbe, 0x0e, 0x9b, 0xce, 0x77, 0xd0, 0x22, 0x8c, 0xcd, 0x0d, 0xe8, 0x25, 0x79, 0xec, 0x7d,
+	0x92, 0x33, 0x1e, 0x32, 0x61, 0x8e, 0x4b, 0x48, 0xf2, 0xf8, 0x87, 0x5a, 0x42, 0xce, 0xc3, 0x92,
+	0x4c, 0x33, 0xef, 0x69, 0x91, 0xe6, 0x32, 0xcd, 0x1e, 0x91, 0xef, 0xc0, 0xba, 0x60, 0x34, 0x62,
+	0x81, 0x57, 0xa6, 0xa5, 0xf0, 0x04, 0x62, 0xc1, 0x02, 0xbb, 0x8d, 0x91, 0xb2, 0xf5, 0x8c, 0xbd,
+	0x72, 0xc2, 0x9e, 0xd1, 0xab, 0x40, 0x94, 0x1b, 0xaf, 0x2d, 0xeb, 0x60, 0x1b, 0x4b, 0x2a, 0x55,
+	0xb9, 0xe0, 0x5d, 0xb0, 0x27, 0x51, 0x3a, 0xa6, 0x91, 0x77, 0xe4, 0xad, 0xd8, 0x2f, 0x37, 0xdd,
+	0x4b, 0x5a, 0xbf, 0x37, 0xf7, 0x4a, 0xe5, 0x9e, 0x88, 0x42, 0x9f, 0x05, 0xde, 0x38, 0x4a, 0xc7,
+	0x36, 0x20, 0xe3, 0x40, 0x8b, 0x54, 0x9e, 0x2b, 0xa6, 0x99, 0x09, 0x0a, 0x06, 0x3f, 0xcd, 0x13,
+	0x89, 0xfc, 0x69, 0xba, 0xab, 0x5a, 0xfe, 0x38, 0x8f, 0xb7, 0x95, 0x94, 0xbc, 0x0e, 0x2b, 0x66,
+	0x66, 0xba, 0xbf, 0x2f, 0x98, 0x44, 0xe2, 0x34, 0xdd, 0xbe, 0x16, 0xfe, 0x00, 0x65, 0xce, 0x4f,
+	0x9b, 0x70, 0xce, 0x55, 0xe8, 0xb2, 0x67, 0xec, 0xff, 0xfe, 0xbc, 0x38, 0x2e, 0x6f, 0x97, 0x5f,
+	0x2a, 0x6f, 0xdb, 0xa7, 0xce, 0xdb, 0xce, 0x4b, 0xe5, 0x6d, 0xf7, 0xb8, 0xbc, 0x75, 0xfe, 0x34,
+	0x13, 0x84, 0xcf, 0x6b, 0x6a, 0xbe, 0x09, 0xcd, 0x30, 0x10, 0x18, 0x9c, 0xde, 0x96, 0x3d, 0x6b,
+	0xdc, 0x5c, 0x5e, 0x8d, 0x86, 0xc2, 0x55, 0x93, 0xc8, 0x3d, 0xe8, 0x19, 0x40, 0xb1, 0x7a, 0x2d,
+	0x61, 0xf5, 0xba, 0xbe, 0x70, 0x0d, 0x22, 0xac, 0x2a, 0x97, 0xab, 0xfb, 0x23, 0xa1, 0x9e, 0xc9,
+	0x77, 0xe1, 0xca, 0xd1, 0x84, 0xe5, 0x06, 0xa3, 0xc0, 0x5e, 0xc6, 0x18, 0x5d, 0x9e, 0xcf, 0xd8,
+	0x02, 0xc4, 0x80, 0x7c, 0x1d, 0x2e, 0xd4, 0x52, 0xb6, 0x5a, 0xd8, 0xd6, 0x5f, 0xab, 0x95, 0xae,
+	0x5a, 0x72, 0x52, 0xd2, 0x76, 0x4e, 0x4a, 0x5a, 0xe7, 0x5f, 0x0d, 0x58, 0x19, 0xb2, 0x88, 0x49,
+	0xf6, 0x45, 0x8f, 0x74, 0x6c, 0x8f, 0x74, 0x13, 0xfa, 0x19, 0x0f, 0x63, 0xca, 0xa7, 0xde, 0x53,
+	0x36, 0x2d, 0xce, 0xc1, 0x9e, 0x91, 0x3d, 0x62, 0x53, 0xa1, 0x30, 0xa8, 0xd2, 0x05, 0x30, 0x5d,
+	0x2a, 0x81, 0x93, 0xc0, 0xfa, 0x07, 0x29, 0x0d, 0x1e, 0xd0, 0x88, 0x26, 0x3e, 0x33, 0xf0, 0xbf,
+	0xc2, 0xa7, 0xc5, 0x75, 0x80, 0x5a, 0x84, 0x1b, 0xb8, 0x9d, 0x9a, 0xc4, 0xf9, 0xb7, 0x05, 0x5d,
+	0xf5, 0x42, 0xfc, 0x72, 0x38, 0x63, 0x44, 0x0b, 0x6b, 0x76, 0x63, 0xbe, 0x29, 0xbc, 0x0a, 0x55,
+	0xf3, 0x6f, 0x62, 0x5a, 0x09, 0xea, 0x5d, 0x7d, 0x6b, 0xb6, 0xab, 0xbf, 0x01, 0x3d, 0x5d, 0xe3,
+	0x33, 0x2a, 0x0f, 0xf4, 0x31, 0xd8, 0x75, 0x75, 0xd9, 0xdf, 0x55, 0x12, 0xd5, 0xf6, 0x17, 0x13,
+	0xb0, 0xed, 0x5f, 0x3e, 0x75, 0xdb, 0x6f, 0x8c, 0x60, 0xdb, 0xff, 0xd7, 0x06, 0xd8, 0x06, 0xe2,
+	0xea, 0xba, 0xf2, 0xc3, 0x2c, 0xc0, 0x5b, 0xd3, 0xab, 0xd0, 0x2d, 0xd9, 0x6f, 0x6e, 0x0b, 0x2b,
+	0x81, 0xc2, 0x75, 0x87, 0xc5, 0x29, 0x9f, 0xee, 0x85, 0x9f, 0x32, 0xe3, 0x78, 0x4d, 0xa2, 0x7c,
+	0x7b, 0x9c, 0xc7, 0x6e, 0x7a, 0x28, 0x4c, 0x11, 0x28, 0x86, 0xca, 0x37, 0x1f, 0x3f, 0xd6, 0xf0,
+	0xd4, 0x44, 0xcf, 0x5b, 0x2e, 0x68, 0x91, 0x3a, 0x2d, 0xc9, 0x65, 0xe8, 0xb0, 0x24, 0xd0, 0xda,
+	0x25, 0xd4, 0xb6, 0x59, 0x12, 0xa0, 0x6a, 0x04, 0xab, 0xe6, 0x9a, 0x32, 0x15, 0x48, 0x39, 0xa4,
+	0x70, 0x6f, 0xcb, 0x39, 0xe6, 0x6e, 0x78, 0x47, 0x4c, 0x76, 0xcd, 0x4c, 0x77, 0x45, 0xdf, 0x54,
+	0x9a, 0x21, 0x79, 0x1f, 0xfa, 0xea, 0x2d, 0xa5, 0xa1, 0xf6, 0xa9, 0x0d, 0xf5, 0x58, 0x12, 0x14,
+	0x03, 0xe7, 0xb7, 0x16, 0xac, 0x1d, 0x81, 0xf0, 0x0c, 0x3c, 0x7a, 0x04, 0x9d, 0x3d, 0x36, 0x51,
+	0x26, 0x8a, 0xcb, 0xd7, 0x3b, 0xc7, 0xdd, 0xe5, 0x1f, 0x13, 0x30, 0xb7, 0x34, 0xa0, 0xbe, 0xcb,
+	0x01, 0x09, 0x8d, 0xc3, 0x23, 0x64, 0xb1, 0xce, 0x42, 0x16, 0x55, 0x77, 0x55, 0x33, 0xc2, 0x59,
+	0x44, 0x65, 0x75, 0x6e, 0x0a, 0x13, 0x7b, 0x92, 0xe4, 0xb1, 0xab, 0x55, 0x45, 0xd2, 0x3a, 0xbf,
+	0xb6, 0x00, 0xf0, 0xe0, 0xd7, 0xdb, 0x98, 0x3f, 0x61, 0xac, 0x93, 0x3f, 0x74, 0x1b, 0xb3, 0x29,
+	0xf1, 0xa0, 0x48, 0x09, 0x81, 0x18, 0x35, 0x17, 0xf9, 0x50, 0x62, 0x54, 0x39, 0x6f, 0xb2, 0x46,
+	0xe3, 0xf2, 0x3b, 0x0b, 0xfa, 0x35, 0xf8, 0xc4, 0x6c, 0xf6, 0x5a, 0xf3, 0xd9, 0x8b, 0x6d, 0xaa,
+	0x62, 0xb4, 0x27, 0x6a, 0x24, 0x8f, 0x2b, 0x92, 0x5f, 0x86, 0x0e, 0x42, 0x52, 0x63, 0x79, 0x62,
+	0x58, 0x7e, 0x0b, 0xd6, 0x38, 0xf3, 0x59, 0x22, 0xa3, 0xa9, 0x17, 0xa7, 0x41, 0xb8, 0x1f, 0xb2,
+	0x00, 0xb9, 0xde, 0x71, 0x07, 0x85, 0x62, 0xc7, 0xc8, 0x9d, 0x7f, 0x58, 0xb0, 0xaa, 0x3a, 0xdb,
+	0xa9, 0xfa, 0x03, 0xa0, 0x77, 0xf6, 0xf2, 0x0c, 0x7a, 0x0f, 0x7d, 0xf1, 0x44, 0x8d, 0x42, 0xaf,
+	0xbf, 0x98, 0x42, 0xc2, 0xed, 0x08, 0x43, 0x1b, 0x05, 0xb1, 0xbe, 0xbc, 0x38, 0x0d, 0xc4, 0x55,
+	0x60, 0x4d, 0x49, 0xd7, 0x10, 0xdf, 0x83, 0xde, 0x8e, 0x98, 0x8c, 0x02, 0x96, 0xc8, 0x50, 0x4e,
+	0xc9, 0x3a, 0x74, 0x44, 0x9a, 0x73, 0xbf, 0xfa, 0xe3, 0x50, 0x8e, 0xd5, 0x65, 0x4e, 0x2c, 0x26,
+	0x65, 0xa4, 0xf5, 0xc0, 0xf9, 0xbb, 0x85, 0x16, 0xca, 0x3c, 0xbd, 0x09, 0x7d, 0x53, 0xc7, 0x75,
+	0x41, 0xb3, 0xf0, 0x14, 0xed, 0xf9, 0xd5, 0x75, 0xf2, 0xac, 0xa1, 0xbe, 0x31, 0xa4, 0x5e, 0x1d,
+	0x8b, 0x09, 0x7e, 0x24, 0x9a, 0xa3, 0xb7, 0x1c, 0xcf, 0xd6, 0xa0, 0xd6, 0x5c, 0x0d, 0x22, 0xf7,
+	0x01, 0x02, 0x16, 0xe4, 0x99, 0x17, 0x8b, 0x89, 0x30, 0x6d, 0xcd, 0x09, 0x07, 0x43, 0xe1, 0xac,
+	0xdb, 0xc5, 0x55, 0x3b, 0x62, 0x22, 0x9c, 0x3f, 0xaa, 0xdb, 0x3f, 0xbd, 0xc5, 0x57, 0xfa, 0x6d,
+	0x81, 0x49, 0x53, 0xbf, 0x55, 0x6f, 0x60, 0x29, 0x98, 0x91, 0xcd, 0x5d, 0x3d, 0x34, 0x8f, 0x5c,
+	0x3d, 0xdc, 0x82, 0xb5, 0x80, 0xed, 0x53, 0xd5, 0xff, 0xcd, 0x7b, 0x3d, 0x30, 0x8a, 0xaa, 0x4d,
+	0xfd, 0x31, 0xac, 0x6e, 0x73, 0x86, 0x2e, 0xd1, 0x08, 0xff, 0x46, 0xad, 0x43, 0x27, 0x17, 0x8c,
+	0xd7, 0xd0, 0x2f, 0xc7, 0xe4, 0x2d, 0x20, 0x2c, 0xf1, 0xf9, 0x34, 0x53, 0x47, 0x42, 0x46, 0x85,
+	0x38, 0x4c, 0x79, 0x60, 0x3a, 0x9b, 0xb5, 0x52, 0xb3, 0x6b, 0x14, 0x6f, 0xbe, 0x0b, 0xdd, 0xf2,
+	0x57, 0x24, 0x19, 0x40, 0x5f, 0xfd, 0x99, 0xc2, 0x6e, 0x3d, 0x4c, 0x26, 0x83, 0x2f, 0x91, 0x1e,
+	0xb4, 0xbf, 0xcf, 0x68, 0x24, 0x0f, 0xa6, 0x03, 0x8b, 0xf4, 0xa1, 0x73, 0x7f, 0x9c, 0xa4, 0x3c,
+	0xa6, 0xd1, 0xa0, 0xf1, 0xe0, 0x9d, 0x8f, 0xbf, 0x39, 0x09, 0xe5, 0x41, 0x3e, 0x56, 0x30, 0xdd,
+	0xd1, 0xb8, 0xbd, 0x15, 0xa6, 0xe6, 0xe9, 0x4e, 0x11, 0x8f, 0x3b, 0x08, 0x65, 0x39, 0xcc, 0xc6,
+	0xe3, 0x65, 0x94, 0xbc, 0xfd, 0x9f, 0x01, 0x00, 0xff, 0xc9, 0xf0, 0xa5, 0xb0, 0x1d, 0x00, 0x00,
 }
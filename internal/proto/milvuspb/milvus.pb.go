@@ -83,6 +83,56 @@ func (PlaceholderType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_02345ba45cc0e303, []int{1}
 }
 
+type OperateUserRoleType int32
+
+const (
+	OperateUserRoleType_AddUserToRole      OperateUserRoleType = 0
+	OperateUserRoleType_RemoveUserFromRole OperateUserRoleType = 1
+)
+
+var OperateUserRoleType_name = map[int32]string{
+	0: "AddUserToRole",
+	1: "RemoveUserFromRole",
+}
+
+var OperateUserRoleType_value = map[string]int32{
+	"AddUserToRole":      0,
+	"RemoveUserFromRole": 1,
+}
+
+func (x OperateUserRoleType) String() string {
+	return proto.EnumName(OperateUserRoleType_name, int32(x))
+}
+
+func (OperateUserRoleType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{2}
+}
+
+type OperatePrivilegeType int32
+
+const (
+	OperatePrivilegeType_Grant  OperatePrivilegeType = 0
+	OperatePrivilegeType_Revoke OperatePrivilegeType = 1
+)
+
+var OperatePrivilegeType_name = map[int32]string{
+	0: "Grant",
+	1: "Revoke",
+}
+
+var OperatePrivilegeType_value = map[string]int32{
+	"Grant":  0,
+	"Revoke": 1,
+}
+
+func (x OperatePrivilegeType) String() string {
+	return proto.EnumName(OperatePrivilegeType_name, int32(x))
+}
+
+func (OperatePrivilegeType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{3}
+}
+
 type CreateAliasRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	CollectionName       string            `protobuf:"bytes,2,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
@@ -1758,6 +1808,8 @@ type DescribeSegmentRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	CollectionID         int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
 	SegmentID            int64             `protobuf:"varint,3,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
+	FieldID              int64             `protobuf:"varint,4,opt,name=fieldID,proto3" json:"fieldID,omitempty"`
+	IndexName            string            `protobuf:"bytes,5,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
@@ -1809,6 +1861,20 @@ func (m *DescribeSegmentRequest) GetSegmentID() int64 {
 	return 0
 }
 
+func (m *DescribeSegmentRequest) GetFieldID() int64 {
+	if m != nil {
+		return m.FieldID
+	}
+	return 0
+}
+
+func (m *DescribeSegmentRequest) GetIndexName() string {
+	if m != nil {
+		return m.IndexName
+	}
+	return ""
+}
+
 type DescribeSegmentResponse struct {
 	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 	IndexID              int64            `protobuf:"varint,2,opt,name=indexID,proto3" json:"indexID,omitempty"`
@@ -1980,6 +2046,7 @@ type CreateIndexRequest struct {
 	CollectionName       string                   `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
 	FieldName            string                   `protobuf:"bytes,4,opt,name=field_name,json=fieldName,proto3" json:"field_name,omitempty"`
 	ExtraParams          []*commonpb.KeyValuePair `protobuf:"bytes,5,rep,name=extra_params,json=extraParams,proto3" json:"extra_params,omitempty"`
+	IndexName            string                   `protobuf:"bytes,6,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
 	XXX_unrecognized     []byte                   `json:"-"`
 	XXX_sizecache        int32                    `json:"-"`
@@ -2045,6 +2112,13 @@ func (m *CreateIndexRequest) GetExtraParams() []*commonpb.KeyValuePair {
 	return nil
 }
 
+func (m *CreateIndexRequest) GetIndexName() string {
+	if m != nil {
+		return m.IndexName
+	}
+	return ""
+}
+
 type DescribeIndexRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
@@ -2121,6 +2195,7 @@ type IndexDescription struct {
 	IndexID              int64                    `protobuf:"varint,2,opt,name=indexID,proto3" json:"indexID,omitempty"`
 	Params               []*commonpb.KeyValuePair `protobuf:"bytes,3,rep,name=params,proto3" json:"params,omitempty"`
 	FieldName            string                   `protobuf:"bytes,4,opt,name=field_name,json=fieldName,proto3" json:"field_name,omitempty"`
+	ReplacedIndexID      int64                    `protobuf:"varint,5,opt,name=replaced_indexID,json=replacedIndexID,proto3" json:"replaced_indexID,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
 	XXX_unrecognized     []byte                   `json:"-"`
 	XXX_sizecache        int32                    `json:"-"`
@@ -2179,6 +2254,13 @@ func (m *IndexDescription) GetFieldName() string {
 	return ""
 }
 
+func (m *IndexDescription) GetReplacedIndexID() int64 {
+	if m != nil {
+		return m.ReplacedIndexID
+	}
+	return 0
+}
+
 type DescribeIndexResponse struct {
 	Status               *commonpb.Status    `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 	IndexDescriptions    []*IndexDescription `protobuf:"bytes,2,rep,name=index_descriptions,json=indexDescriptions,proto3" json:"index_descriptions,omitempty"`
@@ -2549,1755 +2631,3469 @@ func (m *DropIndexRequest) GetIndexName() string {
 	return ""
 }
 
-type InsertRequest struct {
-	Base                 *commonpb.MsgBase     `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	DbName               string                `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	CollectionName       string                `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
-	PartitionName        string                `protobuf:"bytes,4,opt,name=partition_name,json=partitionName,proto3" json:"partition_name,omitempty"`
-	FieldsData           []*schemapb.FieldData `protobuf:"bytes,5,rep,name=fields_data,json=fieldsData,proto3" json:"fields_data,omitempty"`
-	HashKeys             []uint32              `protobuf:"varint,6,rep,packed,name=hash_keys,json=hashKeys,proto3" json:"hash_keys,omitempty"`
-	NumRows              uint32                `protobuf:"varint,7,opt,name=num_rows,json=numRows,proto3" json:"num_rows,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
-	XXX_unrecognized     []byte                `json:"-"`
-	XXX_sizecache        int32                 `json:"-"`
+type AlterIndexRequest struct {
+	Base                 *commonpb.MsgBase        `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string                   `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName       string                   `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	FieldName            string                   `protobuf:"bytes,4,opt,name=field_name,json=fieldName,proto3" json:"field_name,omitempty"`
+	ExtraParams          []*commonpb.KeyValuePair `protobuf:"bytes,5,rep,name=extra_params,json=extraParams,proto3" json:"extra_params,omitempty"`
+	IndexName            string                   `protobuf:"bytes,6,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
 }
 
-func (m *InsertRequest) Reset()         { *m = InsertRequest{} }
-func (m *InsertRequest) String() string { return proto.CompactTextString(m) }
-func (*InsertRequest) ProtoMessage()    {}
-func (*InsertRequest) Descriptor() ([]byte, []int) {
+func (m *AlterIndexRequest) Reset()         { *m = AlterIndexRequest{} }
+func (m *AlterIndexRequest) String() string { return proto.CompactTextString(m) }
+func (*AlterIndexRequest) ProtoMessage()    {}
+func (*AlterIndexRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_02345ba45cc0e303, []int{38}
 }
 
-func (m *InsertRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_InsertRequest.Unmarshal(m, b)
+func (m *AlterIndexRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AlterIndexRequest.Unmarshal(m, b)
 }
-func (m *InsertRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_InsertRequest.Marshal(b, m, deterministic)
+func (m *AlterIndexRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AlterIndexRequest.Marshal(b, m, deterministic)
 }
-func (m *InsertRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_InsertRequest.Merge(m, src)
+func (m *AlterIndexRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AlterIndexRequest.Merge(m, src)
 }
-func (m *InsertRequest) XXX_Size() int {
-	return xxx_messageInfo_InsertRequest.Size(m)
+func (m *AlterIndexRequest) XXX_Size() int {
+	return xxx_messageInfo_AlterIndexRequest.Size(m)
 }
-func (m *InsertRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_InsertRequest.DiscardUnknown(m)
+func (m *AlterIndexRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AlterIndexRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_InsertRequest proto.InternalMessageInfo
+var xxx_messageInfo_AlterIndexRequest proto.InternalMessageInfo
 
-func (m *InsertRequest) GetBase() *commonpb.MsgBase {
+func (m *AlterIndexRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
 		return m.Base
 	}
 	return nil
 }
 
-func (m *InsertRequest) GetDbName() string {
+func (m *AlterIndexRequest) GetDbName() string {
 	if m != nil {
 		return m.DbName
 	}
 	return ""
 }
 
-func (m *InsertRequest) GetCollectionName() string {
+func (m *AlterIndexRequest) GetCollectionName() string {
 	if m != nil {
 		return m.CollectionName
 	}
 	return ""
 }
 
-func (m *InsertRequest) GetPartitionName() string {
+func (m *AlterIndexRequest) GetFieldName() string {
 	if m != nil {
-		return m.PartitionName
+		return m.FieldName
 	}
 	return ""
 }
 
-func (m *InsertRequest) GetFieldsData() []*schemapb.FieldData {
+func (m *AlterIndexRequest) GetExtraParams() []*commonpb.KeyValuePair {
 	if m != nil {
-		return m.FieldsData
+		return m.ExtraParams
 	}
 	return nil
 }
 
-func (m *InsertRequest) GetHashKeys() []uint32 {
+func (m *AlterIndexRequest) GetIndexName() string {
 	if m != nil {
-		return m.HashKeys
+		return m.IndexName
+	}
+	return ""
+}
+
+type GetIndexStatisticsRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName       string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	FieldName            string            `protobuf:"bytes,4,opt,name=field_name,json=fieldName,proto3" json:"field_name,omitempty"`
+	IndexName            string            `protobuf:"bytes,5,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetIndexStatisticsRequest) Reset()         { *m = GetIndexStatisticsRequest{} }
+func (m *GetIndexStatisticsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetIndexStatisticsRequest) ProtoMessage()    {}
+func (*GetIndexStatisticsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{39}
+}
+
+func (m *GetIndexStatisticsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetIndexStatisticsRequest.Unmarshal(m, b)
+}
+func (m *GetIndexStatisticsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetIndexStatisticsRequest.Marshal(b, m, deterministic)
+}
+func (m *GetIndexStatisticsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetIndexStatisticsRequest.Merge(m, src)
+}
+func (m *GetIndexStatisticsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetIndexStatisticsRequest.Size(m)
+}
+func (m *GetIndexStatisticsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetIndexStatisticsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetIndexStatisticsRequest proto.InternalMessageInfo
+
+func (m *GetIndexStatisticsRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
 	}
 	return nil
 }
 
-func (m *InsertRequest) GetNumRows() uint32 {
+func (m *GetIndexStatisticsRequest) GetDbName() string {
 	if m != nil {
-		return m.NumRows
+		return m.DbName
 	}
-	return 0
+	return ""
 }
 
-type MutationResult struct {
-	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	IDs                  *schemapb.IDs    `protobuf:"bytes,2,opt,name=IDs,proto3" json:"IDs,omitempty"`
-	SuccIndex            []uint32         `protobuf:"varint,3,rep,packed,name=succ_index,json=succIndex,proto3" json:"succ_index,omitempty"`
-	ErrIndex             []uint32         `protobuf:"varint,4,rep,packed,name=err_index,json=errIndex,proto3" json:"err_index,omitempty"`
-	Acknowledged         bool             `protobuf:"varint,5,opt,name=acknowledged,proto3" json:"acknowledged,omitempty"`
-	InsertCnt            int64            `protobuf:"varint,6,opt,name=insert_cnt,json=insertCnt,proto3" json:"insert_cnt,omitempty"`
-	DeleteCnt            int64            `protobuf:"varint,7,opt,name=delete_cnt,json=deleteCnt,proto3" json:"delete_cnt,omitempty"`
-	UpsertCnt            int64            `protobuf:"varint,8,opt,name=upsert_cnt,json=upsertCnt,proto3" json:"upsert_cnt,omitempty"`
-	Timestamp            uint64           `protobuf:"varint,9,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+func (m *GetIndexStatisticsRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
 }
 
-func (m *MutationResult) Reset()         { *m = MutationResult{} }
-func (m *MutationResult) String() string { return proto.CompactTextString(m) }
-func (*MutationResult) ProtoMessage()    {}
-func (*MutationResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{39}
+func (m *GetIndexStatisticsRequest) GetFieldName() string {
+	if m != nil {
+		return m.FieldName
+	}
+	return ""
 }
 
-func (m *MutationResult) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_MutationResult.Unmarshal(m, b)
+func (m *GetIndexStatisticsRequest) GetIndexName() string {
+	if m != nil {
+		return m.IndexName
+	}
+	return ""
 }
-func (m *MutationResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_MutationResult.Marshal(b, m, deterministic)
+
+type SegmentIndexStatistics struct {
+	SegmentID   int64                    `protobuf:"varint,1,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
+	BuildID     int64                    `protobuf:"varint,2,opt,name=buildID,proto3" json:"buildID,omitempty"`
+	State       commonpb.IndexState      `protobuf:"varint,3,opt,name=state,proto3,enum=milvus.proto.common.IndexState" json:"state,omitempty"`
+	IndexType   string                   `protobuf:"bytes,4,opt,name=index_type,json=indexType,proto3" json:"index_type,omitempty"`
+	Params      []*commonpb.KeyValuePair `protobuf:"bytes,5,rep,name=params,proto3" json:"params,omitempty"`
+	IndexedRows int64                    `protobuf:"varint,6,opt,name=indexed_rows,json=indexedRows,proto3" json:"indexed_rows,omitempty"`
+	TotalRows   int64                    `protobuf:"varint,7,opt,name=total_rows,json=totalRows,proto3" json:"total_rows,omitempty"`
+	// the total size in bytes of the index files
+	IndexFileSize int64 `protobuf:"varint,8,opt,name=index_file_size,json=indexFileSize,proto3" json:"index_file_size,omitempty"`
+	BuildTimeMs   int64 `protobuf:"varint,9,opt,name=build_time_ms,json=buildTimeMs,proto3" json:"build_time_ms,omitempty"`
+	// the IndexNode which built the index
+	NodeID               int64    `protobuf:"varint,10,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	FailReason           string   `protobuf:"bytes,11,opt,name=fail_reason,json=failReason,proto3" json:"fail_reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (m *MutationResult) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MutationResult.Merge(m, src)
+
+func (m *SegmentIndexStatistics) Reset()         { *m = SegmentIndexStatistics{} }
+func (m *SegmentIndexStatistics) String() string { return proto.CompactTextString(m) }
+func (*SegmentIndexStatistics) ProtoMessage()    {}
+func (*SegmentIndexStatistics) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{40}
 }
-func (m *MutationResult) XXX_Size() int {
-	return xxx_messageInfo_MutationResult.Size(m)
+
+func (m *SegmentIndexStatistics) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SegmentIndexStatistics.Unmarshal(m, b)
 }
-func (m *MutationResult) XXX_DiscardUnknown() {
-	xxx_messageInfo_MutationResult.DiscardUnknown(m)
+func (m *SegmentIndexStatistics) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SegmentIndexStatistics.Marshal(b, m, deterministic)
+}
+func (m *SegmentIndexStatistics) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SegmentIndexStatistics.Merge(m, src)
+}
+func (m *SegmentIndexStatistics) XXX_Size() int {
+	return xxx_messageInfo_SegmentIndexStatistics.Size(m)
+}
+func (m *SegmentIndexStatistics) XXX_DiscardUnknown() {
+	xxx_messageInfo_SegmentIndexStatistics.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MutationResult proto.InternalMessageInfo
+var xxx_messageInfo_SegmentIndexStatistics proto.InternalMessageInfo
 
-func (m *MutationResult) GetStatus() *commonpb.Status {
+func (m *SegmentIndexStatistics) GetSegmentID() int64 {
 	if m != nil {
-		return m.Status
+		return m.SegmentID
 	}
-	return nil
+	return 0
 }
 
-func (m *MutationResult) GetIDs() *schemapb.IDs {
+func (m *SegmentIndexStatistics) GetBuildID() int64 {
 	if m != nil {
-		return m.IDs
+		return m.BuildID
 	}
-	return nil
+	return 0
 }
 
-func (m *MutationResult) GetSuccIndex() []uint32 {
+func (m *SegmentIndexStatistics) GetState() commonpb.IndexState {
 	if m != nil {
-		return m.SuccIndex
+		return m.State
 	}
-	return nil
+	return commonpb.IndexState_IndexStateNone
 }
 
-func (m *MutationResult) GetErrIndex() []uint32 {
+func (m *SegmentIndexStatistics) GetIndexType() string {
 	if m != nil {
-		return m.ErrIndex
+		return m.IndexType
+	}
+	return ""
+}
+
+func (m *SegmentIndexStatistics) GetParams() []*commonpb.KeyValuePair {
+	if m != nil {
+		return m.Params
 	}
 	return nil
 }
 
-func (m *MutationResult) GetAcknowledged() bool {
+func (m *SegmentIndexStatistics) GetIndexedRows() int64 {
 	if m != nil {
-		return m.Acknowledged
+		return m.IndexedRows
 	}
-	return false
+	return 0
 }
 
-func (m *MutationResult) GetInsertCnt() int64 {
+func (m *SegmentIndexStatistics) GetTotalRows() int64 {
 	if m != nil {
-		return m.InsertCnt
+		return m.TotalRows
 	}
 	return 0
 }
 
-func (m *MutationResult) GetDeleteCnt() int64 {
+func (m *SegmentIndexStatistics) GetIndexFileSize() int64 {
 	if m != nil {
-		return m.DeleteCnt
+		return m.IndexFileSize
 	}
 	return 0
 }
 
-func (m *MutationResult) GetUpsertCnt() int64 {
+func (m *SegmentIndexStatistics) GetBuildTimeMs() int64 {
 	if m != nil {
-		return m.UpsertCnt
+		return m.BuildTimeMs
 	}
 	return 0
 }
 
-func (m *MutationResult) GetTimestamp() uint64 {
+func (m *SegmentIndexStatistics) GetNodeID() int64 {
 	if m != nil {
-		return m.Timestamp
+		return m.NodeID
 	}
 	return 0
 }
 
-type DeleteRequest struct {
-	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	CollectionName       string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
-	PartitionName        string            `protobuf:"bytes,4,opt,name=partition_name,json=partitionName,proto3" json:"partition_name,omitempty"`
-	Expr                 string            `protobuf:"bytes,5,opt,name=expr,proto3" json:"expr,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+func (m *SegmentIndexStatistics) GetFailReason() string {
+	if m != nil {
+		return m.FailReason
+	}
+	return ""
 }
 
-func (m *DeleteRequest) Reset()         { *m = DeleteRequest{} }
-func (m *DeleteRequest) String() string { return proto.CompactTextString(m) }
-func (*DeleteRequest) ProtoMessage()    {}
-func (*DeleteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{40}
+type GetIndexStatisticsResponse struct {
+	Status               *commonpb.Status          `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Segments             []*SegmentIndexStatistics `protobuf:"bytes,2,rep,name=segments,proto3" json:"segments,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
 }
 
-func (m *DeleteRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DeleteRequest.Unmarshal(m, b)
+func (m *GetIndexStatisticsResponse) Reset()         { *m = GetIndexStatisticsResponse{} }
+func (m *GetIndexStatisticsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetIndexStatisticsResponse) ProtoMessage()    {}
+func (*GetIndexStatisticsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{41}
 }
-func (m *DeleteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DeleteRequest.Marshal(b, m, deterministic)
+
+func (m *GetIndexStatisticsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetIndexStatisticsResponse.Unmarshal(m, b)
 }
-func (m *DeleteRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DeleteRequest.Merge(m, src)
+func (m *GetIndexStatisticsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetIndexStatisticsResponse.Marshal(b, m, deterministic)
 }
-func (m *DeleteRequest) XXX_Size() int {
-	return xxx_messageInfo_DeleteRequest.Size(m)
+func (m *GetIndexStatisticsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetIndexStatisticsResponse.Merge(m, src)
 }
-func (m *DeleteRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DeleteRequest.DiscardUnknown(m)
+func (m *GetIndexStatisticsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetIndexStatisticsResponse.Size(m)
+}
+func (m *GetIndexStatisticsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetIndexStatisticsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DeleteRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetIndexStatisticsResponse proto.InternalMessageInfo
 
-func (m *DeleteRequest) GetBase() *commonpb.MsgBase {
+func (m *GetIndexStatisticsResponse) GetStatus() *commonpb.Status {
 	if m != nil {
-		return m.Base
+		return m.Status
 	}
 	return nil
 }
 
-func (m *DeleteRequest) GetDbName() string {
+func (m *GetIndexStatisticsResponse) GetSegments() []*SegmentIndexStatistics {
 	if m != nil {
-		return m.DbName
+		return m.Segments
 	}
-	return ""
+	return nil
 }
 
-func (m *DeleteRequest) GetCollectionName() string {
-	if m != nil {
-		return m.CollectionName
-	}
-	return ""
-}
-
-func (m *DeleteRequest) GetPartitionName() string {
-	if m != nil {
-		return m.PartitionName
-	}
-	return ""
-}
-
-func (m *DeleteRequest) GetExpr() string {
-	if m != nil {
-		return m.Expr
-	}
-	return ""
-}
-
-type PlaceholderValue struct {
-	Tag  string          `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
-	Type PlaceholderType `protobuf:"varint,2,opt,name=type,proto3,enum=milvus.proto.milvus.PlaceholderType" json:"type,omitempty"`
-	// values is a 2d-array, every array contains a vector
-	Values               [][]byte `protobuf:"bytes,3,rep,name=values,proto3" json:"values,omitempty"`
+type EstimateIndexRecallRequest struct {
+	Base           *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName         string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	FieldName      string            `protobuf:"bytes,4,opt,name=field_name,json=fieldName,proto3" json:"field_name,omitempty"`
+	IndexName      string            `protobuf:"bytes,5,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
+	// the number of vectors sampled from each segment as queries
+	Nq   int64 `protobuf:"varint,6,opt,name=nq,proto3" json:"nq,omitempty"`
+	Topk int64 `protobuf:"varint,7,opt,name=topk,proto3" json:"topk,omitempty"`
+	// the search params of the index, such as nprobe or ef
+	SearchParams []*commonpb.KeyValuePair `protobuf:"bytes,8,rep,name=search_params,json=searchParams,proto3" json:"search_params,omitempty"`
+	// the max number of segments to estimate, 0 for all the indexed segments
+	MaxSegments          int64    `protobuf:"varint,9,opt,name=max_segments,json=maxSegments,proto3" json:"max_segments,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PlaceholderValue) Reset()         { *m = PlaceholderValue{} }
-func (m *PlaceholderValue) String() string { return proto.CompactTextString(m) }
-func (*PlaceholderValue) ProtoMessage()    {}
-func (*PlaceholderValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{41}
+func (m *EstimateIndexRecallRequest) Reset()         { *m = EstimateIndexRecallRequest{} }
+func (m *EstimateIndexRecallRequest) String() string { return proto.CompactTextString(m) }
+func (*EstimateIndexRecallRequest) ProtoMessage()    {}
+func (*EstimateIndexRecallRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{42}
 }
 
-func (m *PlaceholderValue) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PlaceholderValue.Unmarshal(m, b)
+func (m *EstimateIndexRecallRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EstimateIndexRecallRequest.Unmarshal(m, b)
 }
-func (m *PlaceholderValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PlaceholderValue.Marshal(b, m, deterministic)
+func (m *EstimateIndexRecallRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EstimateIndexRecallRequest.Marshal(b, m, deterministic)
 }
-func (m *PlaceholderValue) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PlaceholderValue.Merge(m, src)
+func (m *EstimateIndexRecallRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EstimateIndexRecallRequest.Merge(m, src)
 }
-func (m *PlaceholderValue) XXX_Size() int {
-	return xxx_messageInfo_PlaceholderValue.Size(m)
+func (m *EstimateIndexRecallRequest) XXX_Size() int {
+	return xxx_messageInfo_EstimateIndexRecallRequest.Size(m)
 }
-func (m *PlaceholderValue) XXX_DiscardUnknown() {
-	xxx_messageInfo_PlaceholderValue.DiscardUnknown(m)
+func (m *EstimateIndexRecallRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EstimateIndexRecallRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PlaceholderValue proto.InternalMessageInfo
+var xxx_messageInfo_EstimateIndexRecallRequest proto.InternalMessageInfo
 
-func (m *PlaceholderValue) GetTag() string {
+func (m *EstimateIndexRecallRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
-		return m.Tag
+		return m.Base
 	}
-	return ""
+	return nil
 }
 
-func (m *PlaceholderValue) GetType() PlaceholderType {
+func (m *EstimateIndexRecallRequest) GetDbName() string {
 	if m != nil {
-		return m.Type
+		return m.DbName
 	}
-	return PlaceholderType_None
+	return ""
 }
 
-func (m *PlaceholderValue) GetValues() [][]byte {
+func (m *EstimateIndexRecallRequest) GetCollectionName() string {
 	if m != nil {
-		return m.Values
+		return m.CollectionName
 	}
-	return nil
+	return ""
 }
 
-type PlaceholderGroup struct {
-	Placeholders         []*PlaceholderValue `protobuf:"bytes,1,rep,name=placeholders,proto3" json:"placeholders,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
-	XXX_unrecognized     []byte              `json:"-"`
-	XXX_sizecache        int32               `json:"-"`
+func (m *EstimateIndexRecallRequest) GetFieldName() string {
+	if m != nil {
+		return m.FieldName
+	}
+	return ""
 }
 
-func (m *PlaceholderGroup) Reset()         { *m = PlaceholderGroup{} }
-func (m *PlaceholderGroup) String() string { return proto.CompactTextString(m) }
-func (*PlaceholderGroup) ProtoMessage()    {}
-func (*PlaceholderGroup) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{42}
+func (m *EstimateIndexRecallRequest) GetIndexName() string {
+	if m != nil {
+		return m.IndexName
+	}
+	return ""
 }
 
-func (m *PlaceholderGroup) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PlaceholderGroup.Unmarshal(m, b)
-}
-func (m *PlaceholderGroup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PlaceholderGroup.Marshal(b, m, deterministic)
-}
-func (m *PlaceholderGroup) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PlaceholderGroup.Merge(m, src)
-}
-func (m *PlaceholderGroup) XXX_Size() int {
-	return xxx_messageInfo_PlaceholderGroup.Size(m)
-}
-func (m *PlaceholderGroup) XXX_DiscardUnknown() {
-	xxx_messageInfo_PlaceholderGroup.DiscardUnknown(m)
+func (m *EstimateIndexRecallRequest) GetNq() int64 {
+	if m != nil {
+		return m.Nq
+	}
+	return 0
 }
 
-var xxx_messageInfo_PlaceholderGroup proto.InternalMessageInfo
+func (m *EstimateIndexRecallRequest) GetTopk() int64 {
+	if m != nil {
+		return m.Topk
+	}
+	return 0
+}
 
-func (m *PlaceholderGroup) GetPlaceholders() []*PlaceholderValue {
+func (m *EstimateIndexRecallRequest) GetSearchParams() []*commonpb.KeyValuePair {
 	if m != nil {
-		return m.Placeholders
+		return m.SearchParams
 	}
 	return nil
 }
 
-type SearchRequest struct {
-	Base           *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	DbName         string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	CollectionName string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
-	PartitionNames []string          `protobuf:"bytes,4,rep,name=partition_names,json=partitionNames,proto3" json:"partition_names,omitempty"`
-	Dsl            string            `protobuf:"bytes,5,opt,name=dsl,proto3" json:"dsl,omitempty"`
-	// serialized `PlaceholderGroup`
-	PlaceholderGroup     []byte                   `protobuf:"bytes,6,opt,name=placeholder_group,json=placeholderGroup,proto3" json:"placeholder_group,omitempty"`
-	DslType              commonpb.DslType         `protobuf:"varint,7,opt,name=dsl_type,json=dslType,proto3,enum=milvus.proto.common.DslType" json:"dsl_type,omitempty"`
-	OutputFields         []string                 `protobuf:"bytes,8,rep,name=output_fields,json=outputFields,proto3" json:"output_fields,omitempty"`
-	SearchParams         []*commonpb.KeyValuePair `protobuf:"bytes,9,rep,name=search_params,json=searchParams,proto3" json:"search_params,omitempty"`
-	TravelTimestamp      uint64                   `protobuf:"varint,10,opt,name=travel_timestamp,json=travelTimestamp,proto3" json:"travel_timestamp,omitempty"`
-	GuaranteeTimestamp   uint64                   `protobuf:"varint,11,opt,name=guarantee_timestamp,json=guaranteeTimestamp,proto3" json:"guarantee_timestamp,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
-	XXX_unrecognized     []byte                   `json:"-"`
-	XXX_sizecache        int32                    `json:"-"`
+func (m *EstimateIndexRecallRequest) GetMaxSegments() int64 {
+	if m != nil {
+		return m.MaxSegments
+	}
+	return 0
 }
 
-func (m *SearchRequest) Reset()         { *m = SearchRequest{} }
-func (m *SearchRequest) String() string { return proto.CompactTextString(m) }
-func (*SearchRequest) ProtoMessage()    {}
-func (*SearchRequest) Descriptor() ([]byte, []int) {
+type SegmentIndexRecall struct {
+	SegmentID            int64    `protobuf:"varint,1,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
+	BuildID              int64    `protobuf:"varint,2,opt,name=buildID,proto3" json:"buildID,omitempty"`
+	Nq                   int64    `protobuf:"varint,3,opt,name=nq,proto3" json:"nq,omitempty"`
+	Recall               float32  `protobuf:"fixed32,4,opt,name=recall,proto3" json:"recall,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SegmentIndexRecall) Reset()         { *m = SegmentIndexRecall{} }
+func (m *SegmentIndexRecall) String() string { return proto.CompactTextString(m) }
+func (*SegmentIndexRecall) ProtoMessage()    {}
+func (*SegmentIndexRecall) Descriptor() ([]byte, []int) {
 	return fileDescriptor_02345ba45cc0e303, []int{43}
 }
 
-func (m *SearchRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SearchRequest.Unmarshal(m, b)
+func (m *SegmentIndexRecall) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SegmentIndexRecall.Unmarshal(m, b)
 }
-func (m *SearchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SearchRequest.Marshal(b, m, deterministic)
+func (m *SegmentIndexRecall) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SegmentIndexRecall.Marshal(b, m, deterministic)
 }
-func (m *SearchRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SearchRequest.Merge(m, src)
+func (m *SegmentIndexRecall) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SegmentIndexRecall.Merge(m, src)
 }
-func (m *SearchRequest) XXX_Size() int {
-	return xxx_messageInfo_SearchRequest.Size(m)
+func (m *SegmentIndexRecall) XXX_Size() int {
+	return xxx_messageInfo_SegmentIndexRecall.Size(m)
 }
-func (m *SearchRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_SearchRequest.DiscardUnknown(m)
+func (m *SegmentIndexRecall) XXX_DiscardUnknown() {
+	xxx_messageInfo_SegmentIndexRecall.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SearchRequest proto.InternalMessageInfo
+var xxx_messageInfo_SegmentIndexRecall proto.InternalMessageInfo
 
-func (m *SearchRequest) GetBase() *commonpb.MsgBase {
+func (m *SegmentIndexRecall) GetSegmentID() int64 {
 	if m != nil {
-		return m.Base
+		return m.SegmentID
 	}
-	return nil
+	return 0
 }
 
-func (m *SearchRequest) GetDbName() string {
+func (m *SegmentIndexRecall) GetBuildID() int64 {
 	if m != nil {
-		return m.DbName
+		return m.BuildID
 	}
-	return ""
+	return 0
 }
 
-func (m *SearchRequest) GetCollectionName() string {
+func (m *SegmentIndexRecall) GetNq() int64 {
 	if m != nil {
-		return m.CollectionName
+		return m.Nq
 	}
-	return ""
+	return 0
 }
 
-func (m *SearchRequest) GetPartitionNames() []string {
+func (m *SegmentIndexRecall) GetRecall() float32 {
 	if m != nil {
-		return m.PartitionNames
+		return m.Recall
 	}
-	return nil
+	return 0
 }
 
-func (m *SearchRequest) GetDsl() string {
-	if m != nil {
-		return m.Dsl
-	}
-	return ""
+type EstimateIndexRecallResponse struct {
+	Status *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Topk   int64            `protobuf:"varint,2,opt,name=topk,proto3" json:"topk,omitempty"`
+	// the recall@topk of all the sampled queries
+	Recall               float32               `protobuf:"fixed32,3,opt,name=recall,proto3" json:"recall,omitempty"`
+	Segments             []*SegmentIndexRecall `protobuf:"bytes,4,rep,name=segments,proto3" json:"segments,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
 
-func (m *SearchRequest) GetPlaceholderGroup() []byte {
-	if m != nil {
-		return m.PlaceholderGroup
-	}
-	return nil
+func (m *EstimateIndexRecallResponse) Reset()         { *m = EstimateIndexRecallResponse{} }
+func (m *EstimateIndexRecallResponse) String() string { return proto.CompactTextString(m) }
+func (*EstimateIndexRecallResponse) ProtoMessage()    {}
+func (*EstimateIndexRecallResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{44}
 }
 
-func (m *SearchRequest) GetDslType() commonpb.DslType {
-	if m != nil {
-		return m.DslType
-	}
-	return commonpb.DslType_Dsl
+func (m *EstimateIndexRecallResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EstimateIndexRecallResponse.Unmarshal(m, b)
+}
+func (m *EstimateIndexRecallResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EstimateIndexRecallResponse.Marshal(b, m, deterministic)
+}
+func (m *EstimateIndexRecallResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EstimateIndexRecallResponse.Merge(m, src)
+}
+func (m *EstimateIndexRecallResponse) XXX_Size() int {
+	return xxx_messageInfo_EstimateIndexRecallResponse.Size(m)
+}
+func (m *EstimateIndexRecallResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EstimateIndexRecallResponse.DiscardUnknown(m)
 }
 
-func (m *SearchRequest) GetOutputFields() []string {
+var xxx_messageInfo_EstimateIndexRecallResponse proto.InternalMessageInfo
+
+func (m *EstimateIndexRecallResponse) GetStatus() *commonpb.Status {
 	if m != nil {
-		return m.OutputFields
+		return m.Status
 	}
 	return nil
 }
 
-func (m *SearchRequest) GetSearchParams() []*commonpb.KeyValuePair {
+func (m *EstimateIndexRecallResponse) GetTopk() int64 {
 	if m != nil {
-		return m.SearchParams
+		return m.Topk
 	}
-	return nil
+	return 0
 }
 
-func (m *SearchRequest) GetTravelTimestamp() uint64 {
+func (m *EstimateIndexRecallResponse) GetRecall() float32 {
 	if m != nil {
-		return m.TravelTimestamp
+		return m.Recall
 	}
 	return 0
 }
 
-func (m *SearchRequest) GetGuaranteeTimestamp() uint64 {
+func (m *EstimateIndexRecallResponse) GetSegments() []*SegmentIndexRecall {
 	if m != nil {
-		return m.GuaranteeTimestamp
+		return m.Segments
 	}
-	return 0
+	return nil
 }
 
-type Hits struct {
-	IDs                  []int64   `protobuf:"varint,1,rep,packed,name=IDs,proto3" json:"IDs,omitempty"`
-	RowData              [][]byte  `protobuf:"bytes,2,rep,name=row_data,json=rowData,proto3" json:"row_data,omitempty"`
-	Scores               []float32 `protobuf:"fixed32,3,rep,packed,name=scores,proto3" json:"scores,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
-	XXX_unrecognized     []byte    `json:"-"`
-	XXX_sizecache        int32     `json:"-"`
+type InsertRequest struct {
+	Base                 *commonpb.MsgBase     `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string                `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName       string                `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	PartitionName        string                `protobuf:"bytes,4,opt,name=partition_name,json=partitionName,proto3" json:"partition_name,omitempty"`
+	FieldsData           []*schemapb.FieldData `protobuf:"bytes,5,rep,name=fields_data,json=fieldsData,proto3" json:"fields_data,omitempty"`
+	HashKeys             []uint32              `protobuf:"varint,6,rep,packed,name=hash_keys,json=hashKeys,proto3" json:"hash_keys,omitempty"`
+	NumRows              uint32                `protobuf:"varint,7,opt,name=num_rows,json=numRows,proto3" json:"num_rows,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
 
-func (m *Hits) Reset()         { *m = Hits{} }
-func (m *Hits) String() string { return proto.CompactTextString(m) }
-func (*Hits) ProtoMessage()    {}
-func (*Hits) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{44}
+func (m *InsertRequest) Reset()         { *m = InsertRequest{} }
+func (m *InsertRequest) String() string { return proto.CompactTextString(m) }
+func (*InsertRequest) ProtoMessage()    {}
+func (*InsertRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{45}
 }
 
-func (m *Hits) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Hits.Unmarshal(m, b)
+func (m *InsertRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InsertRequest.Unmarshal(m, b)
 }
-func (m *Hits) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Hits.Marshal(b, m, deterministic)
+func (m *InsertRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InsertRequest.Marshal(b, m, deterministic)
 }
-func (m *Hits) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Hits.Merge(m, src)
+func (m *InsertRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InsertRequest.Merge(m, src)
 }
-func (m *Hits) XXX_Size() int {
-	return xxx_messageInfo_Hits.Size(m)
+func (m *InsertRequest) XXX_Size() int {
+	return xxx_messageInfo_InsertRequest.Size(m)
 }
-func (m *Hits) XXX_DiscardUnknown() {
-	xxx_messageInfo_Hits.DiscardUnknown(m)
+func (m *InsertRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_InsertRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Hits proto.InternalMessageInfo
+var xxx_messageInfo_InsertRequest proto.InternalMessageInfo
 
-func (m *Hits) GetIDs() []int64 {
+func (m *InsertRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
-		return m.IDs
+		return m.Base
 	}
 	return nil
 }
 
-func (m *Hits) GetRowData() [][]byte {
+func (m *InsertRequest) GetDbName() string {
 	if m != nil {
-		return m.RowData
+		return m.DbName
 	}
-	return nil
+	return ""
+}
+
+func (m *InsertRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *InsertRequest) GetPartitionName() string {
+	if m != nil {
+		return m.PartitionName
+	}
+	return ""
+}
+
+func (m *InsertRequest) GetFieldsData() []*schemapb.FieldData {
+	if m != nil {
+		return m.FieldsData
+	}
+	return nil
+}
+
+func (m *InsertRequest) GetHashKeys() []uint32 {
+	if m != nil {
+		return m.HashKeys
+	}
+	return nil
+}
+
+func (m *InsertRequest) GetNumRows() uint32 {
+	if m != nil {
+		return m.NumRows
+	}
+	return 0
+}
+
+type MutationResult struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	IDs                  *schemapb.IDs    `protobuf:"bytes,2,opt,name=IDs,proto3" json:"IDs,omitempty"`
+	SuccIndex            []uint32         `protobuf:"varint,3,rep,packed,name=succ_index,json=succIndex,proto3" json:"succ_index,omitempty"`
+	ErrIndex             []uint32         `protobuf:"varint,4,rep,packed,name=err_index,json=errIndex,proto3" json:"err_index,omitempty"`
+	Acknowledged         bool             `protobuf:"varint,5,opt,name=acknowledged,proto3" json:"acknowledged,omitempty"`
+	InsertCnt            int64            `protobuf:"varint,6,opt,name=insert_cnt,json=insertCnt,proto3" json:"insert_cnt,omitempty"`
+	DeleteCnt            int64            `protobuf:"varint,7,opt,name=delete_cnt,json=deleteCnt,proto3" json:"delete_cnt,omitempty"`
+	UpsertCnt            int64            `protobuf:"varint,8,opt,name=upsert_cnt,json=upsertCnt,proto3" json:"upsert_cnt,omitempty"`
+	Timestamp            uint64           `protobuf:"varint,9,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *MutationResult) Reset()         { *m = MutationResult{} }
+func (m *MutationResult) String() string { return proto.CompactTextString(m) }
+func (*MutationResult) ProtoMessage()    {}
+func (*MutationResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{46}
+}
+
+func (m *MutationResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MutationResult.Unmarshal(m, b)
+}
+func (m *MutationResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MutationResult.Marshal(b, m, deterministic)
+}
+func (m *MutationResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MutationResult.Merge(m, src)
+}
+func (m *MutationResult) XXX_Size() int {
+	return xxx_messageInfo_MutationResult.Size(m)
+}
+func (m *MutationResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_MutationResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MutationResult proto.InternalMessageInfo
+
+func (m *MutationResult) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *MutationResult) GetIDs() *schemapb.IDs {
+	if m != nil {
+		return m.IDs
+	}
+	return nil
+}
+
+func (m *MutationResult) GetSuccIndex() []uint32 {
+	if m != nil {
+		return m.SuccIndex
+	}
+	return nil
+}
+
+func (m *MutationResult) GetErrIndex() []uint32 {
+	if m != nil {
+		return m.ErrIndex
+	}
+	return nil
+}
+
+func (m *MutationResult) GetAcknowledged() bool {
+	if m != nil {
+		return m.Acknowledged
+	}
+	return false
+}
+
+func (m *MutationResult) GetInsertCnt() int64 {
+	if m != nil {
+		return m.InsertCnt
+	}
+	return 0
+}
+
+func (m *MutationResult) GetDeleteCnt() int64 {
+	if m != nil {
+		return m.DeleteCnt
+	}
+	return 0
+}
+
+func (m *MutationResult) GetUpsertCnt() int64 {
+	if m != nil {
+		return m.UpsertCnt
+	}
+	return 0
+}
+
+func (m *MutationResult) GetTimestamp() uint64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+type DeleteRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName       string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	PartitionName        string            `protobuf:"bytes,4,opt,name=partition_name,json=partitionName,proto3" json:"partition_name,omitempty"`
+	Expr                 string            `protobuf:"bytes,5,opt,name=expr,proto3" json:"expr,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *DeleteRequest) Reset()         { *m = DeleteRequest{} }
+func (m *DeleteRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteRequest) ProtoMessage()    {}
+func (*DeleteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{47}
+}
+
+func (m *DeleteRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteRequest.Unmarshal(m, b)
+}
+func (m *DeleteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteRequest.Marshal(b, m, deterministic)
+}
+func (m *DeleteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteRequest.Merge(m, src)
+}
+func (m *DeleteRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteRequest.Size(m)
+}
+func (m *DeleteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteRequest proto.InternalMessageInfo
+
+func (m *DeleteRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *DeleteRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *DeleteRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *DeleteRequest) GetPartitionName() string {
+	if m != nil {
+		return m.PartitionName
+	}
+	return ""
+}
+
+func (m *DeleteRequest) GetExpr() string {
+	if m != nil {
+		return m.Expr
+	}
+	return ""
+}
+
+type PlaceholderValue struct {
+	Tag  string          `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	Type PlaceholderType `protobuf:"varint,2,opt,name=type,proto3,enum=milvus.proto.milvus.PlaceholderType" json:"type,omitempty"`
+	// values is a 2d-array, every array contains a vector
+	Values               [][]byte `protobuf:"bytes,3,rep,name=values,proto3" json:"values,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PlaceholderValue) Reset()         { *m = PlaceholderValue{} }
+func (m *PlaceholderValue) String() string { return proto.CompactTextString(m) }
+func (*PlaceholderValue) ProtoMessage()    {}
+func (*PlaceholderValue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{48}
+}
+
+func (m *PlaceholderValue) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PlaceholderValue.Unmarshal(m, b)
+}
+func (m *PlaceholderValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PlaceholderValue.Marshal(b, m, deterministic)
+}
+func (m *PlaceholderValue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlaceholderValue.Merge(m, src)
+}
+func (m *PlaceholderValue) XXX_Size() int {
+	return xxx_messageInfo_PlaceholderValue.Size(m)
+}
+func (m *PlaceholderValue) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlaceholderValue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PlaceholderValue proto.InternalMessageInfo
+
+func (m *PlaceholderValue) GetTag() string {
+	if m != nil {
+		return m.Tag
+	}
+	return ""
+}
+
+func (m *PlaceholderValue) GetType() PlaceholderType {
+	if m != nil {
+		return m.Type
+	}
+	return PlaceholderType_None
+}
+
+func (m *PlaceholderValue) GetValues() [][]byte {
+	if m != nil {
+		return m.Values
+	}
+	return nil
+}
+
+type PlaceholderGroup struct {
+	Placeholders         []*PlaceholderValue `protobuf:"bytes,1,rep,name=placeholders,proto3" json:"placeholders,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *PlaceholderGroup) Reset()         { *m = PlaceholderGroup{} }
+func (m *PlaceholderGroup) String() string { return proto.CompactTextString(m) }
+func (*PlaceholderGroup) ProtoMessage()    {}
+func (*PlaceholderGroup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{49}
+}
+
+func (m *PlaceholderGroup) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PlaceholderGroup.Unmarshal(m, b)
+}
+func (m *PlaceholderGroup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PlaceholderGroup.Marshal(b, m, deterministic)
+}
+func (m *PlaceholderGroup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlaceholderGroup.Merge(m, src)
+}
+func (m *PlaceholderGroup) XXX_Size() int {
+	return xxx_messageInfo_PlaceholderGroup.Size(m)
+}
+func (m *PlaceholderGroup) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlaceholderGroup.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PlaceholderGroup proto.InternalMessageInfo
+
+func (m *PlaceholderGroup) GetPlaceholders() []*PlaceholderValue {
+	if m != nil {
+		return m.Placeholders
+	}
+	return nil
+}
+
+type SearchRequest struct {
+	Base           *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName         string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	PartitionNames []string          `protobuf:"bytes,4,rep,name=partition_names,json=partitionNames,proto3" json:"partition_names,omitempty"`
+	Dsl            string            `protobuf:"bytes,5,opt,name=dsl,proto3" json:"dsl,omitempty"`
+	// serialized `PlaceholderGroup`
+	PlaceholderGroup     []byte                   `protobuf:"bytes,6,opt,name=placeholder_group,json=placeholderGroup,proto3" json:"placeholder_group,omitempty"`
+	DslType              commonpb.DslType         `protobuf:"varint,7,opt,name=dsl_type,json=dslType,proto3,enum=milvus.proto.common.DslType" json:"dsl_type,omitempty"`
+	OutputFields         []string                 `protobuf:"bytes,8,rep,name=output_fields,json=outputFields,proto3" json:"output_fields,omitempty"`
+	SearchParams         []*commonpb.KeyValuePair `protobuf:"bytes,9,rep,name=search_params,json=searchParams,proto3" json:"search_params,omitempty"`
+	TravelTimestamp      uint64                   `protobuf:"varint,10,opt,name=travel_timestamp,json=travelTimestamp,proto3" json:"travel_timestamp,omitempty"`
+	GuaranteeTimestamp   uint64                   `protobuf:"varint,11,opt,name=guarantee_timestamp,json=guaranteeTimestamp,proto3" json:"guarantee_timestamp,omitempty"`
+	IndexName            string                   `protobuf:"bytes,12,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *SearchRequest) Reset()         { *m = SearchRequest{} }
+func (m *SearchRequest) String() string { return proto.CompactTextString(m) }
+func (*SearchRequest) ProtoMessage()    {}
+func (*SearchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{50}
+}
+
+func (m *SearchRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SearchRequest.Unmarshal(m, b)
+}
+func (m *SearchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SearchRequest.Marshal(b, m, deterministic)
+}
+func (m *SearchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchRequest.Merge(m, src)
+}
+func (m *SearchRequest) XXX_Size() int {
+	return xxx_messageInfo_SearchRequest.Size(m)
+}
+func (m *SearchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SearchRequest proto.InternalMessageInfo
+
+func (m *SearchRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *SearchRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *SearchRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *SearchRequest) GetPartitionNames() []string {
+	if m != nil {
+		return m.PartitionNames
+	}
+	return nil
+}
+
+func (m *SearchRequest) GetDsl() string {
+	if m != nil {
+		return m.Dsl
+	}
+	return ""
+}
+
+func (m *SearchRequest) GetPlaceholderGroup() []byte {
+	if m != nil {
+		return m.PlaceholderGroup
+	}
+	return nil
+}
+
+func (m *SearchRequest) GetDslType() commonpb.DslType {
+	if m != nil {
+		return m.DslType
+	}
+	return commonpb.DslType_Dsl
+}
+
+func (m *SearchRequest) GetOutputFields() []string {
+	if m != nil {
+		return m.OutputFields
+	}
+	return nil
+}
+
+func (m *SearchRequest) GetSearchParams() []*commonpb.KeyValuePair {
+	if m != nil {
+		return m.SearchParams
+	}
+	return nil
+}
+
+func (m *SearchRequest) GetTravelTimestamp() uint64 {
+	if m != nil {
+		return m.TravelTimestamp
+	}
+	return 0
+}
+
+func (m *SearchRequest) GetGuaranteeTimestamp() uint64 {
+	if m != nil {
+		return m.GuaranteeTimestamp
+	}
+	return 0
+}
+
+func (m *SearchRequest) GetIndexName() string {
+	if m != nil {
+		return m.IndexName
+	}
+	return ""
+}
+
+type Hits struct {
+	IDs                  []int64   `protobuf:"varint,1,rep,packed,name=IDs,proto3" json:"IDs,omitempty"`
+	RowData              [][]byte  `protobuf:"bytes,2,rep,name=row_data,json=rowData,proto3" json:"row_data,omitempty"`
+	Scores               []float32 `protobuf:"fixed32,3,rep,packed,name=scores,proto3" json:"scores,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *Hits) Reset()         { *m = Hits{} }
+func (m *Hits) String() string { return proto.CompactTextString(m) }
+func (*Hits) ProtoMessage()    {}
+func (*Hits) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{51}
+}
+
+func (m *Hits) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Hits.Unmarshal(m, b)
+}
+func (m *Hits) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Hits.Marshal(b, m, deterministic)
+}
+func (m *Hits) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Hits.Merge(m, src)
+}
+func (m *Hits) XXX_Size() int {
+	return xxx_messageInfo_Hits.Size(m)
+}
+func (m *Hits) XXX_DiscardUnknown() {
+	xxx_messageInfo_Hits.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Hits proto.InternalMessageInfo
+
+func (m *Hits) GetIDs() []int64 {
+	if m != nil {
+		return m.IDs
+	}
+	return nil
+}
+
+func (m *Hits) GetRowData() [][]byte {
+	if m != nil {
+		return m.RowData
+	}
+	return nil
+}
+
+func (m *Hits) GetScores() []float32 {
+	if m != nil {
+		return m.Scores
+	}
+	return nil
+}
+
+type SearchResults struct {
+	Status               *commonpb.Status           `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Results              *schemapb.SearchResultData `protobuf:"bytes,2,opt,name=results,proto3" json:"results,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
+}
+
+func (m *SearchResults) Reset()         { *m = SearchResults{} }
+func (m *SearchResults) String() string { return proto.CompactTextString(m) }
+func (*SearchResults) ProtoMessage()    {}
+func (*SearchResults) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{52}
+}
+
+func (m *SearchResults) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SearchResults.Unmarshal(m, b)
+}
+func (m *SearchResults) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SearchResults.Marshal(b, m, deterministic)
+}
+func (m *SearchResults) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchResults.Merge(m, src)
+}
+func (m *SearchResults) XXX_Size() int {
+	return xxx_messageInfo_SearchResults.Size(m)
+}
+func (m *SearchResults) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchResults.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SearchResults proto.InternalMessageInfo
+
+func (m *SearchResults) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *SearchResults) GetResults() *schemapb.SearchResultData {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+type FlushRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionNames      []string          `protobuf:"bytes,3,rep,name=collection_names,json=collectionNames,proto3" json:"collection_names,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *FlushRequest) Reset()         { *m = FlushRequest{} }
+func (m *FlushRequest) String() string { return proto.CompactTextString(m) }
+func (*FlushRequest) ProtoMessage()    {}
+func (*FlushRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{53}
+}
+
+func (m *FlushRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FlushRequest.Unmarshal(m, b)
+}
+func (m *FlushRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FlushRequest.Marshal(b, m, deterministic)
+}
+func (m *FlushRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FlushRequest.Merge(m, src)
+}
+func (m *FlushRequest) XXX_Size() int {
+	return xxx_messageInfo_FlushRequest.Size(m)
+}
+func (m *FlushRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FlushRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FlushRequest proto.InternalMessageInfo
+
+func (m *FlushRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *FlushRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *FlushRequest) GetCollectionNames() []string {
+	if m != nil {
+		return m.CollectionNames
+	}
+	return nil
+}
+
+type FlushResponse struct {
+	Status               *commonpb.Status               `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	DbName               string                         `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollSegIDs           map[string]*schemapb.LongArray `protobuf:"bytes,3,rep,name=coll_segIDs,json=collSegIDs,proto3" json:"coll_segIDs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
+	XXX_unrecognized     []byte                         `json:"-"`
+	XXX_sizecache        int32                          `json:"-"`
+}
+
+func (m *FlushResponse) Reset()         { *m = FlushResponse{} }
+func (m *FlushResponse) String() string { return proto.CompactTextString(m) }
+func (*FlushResponse) ProtoMessage()    {}
+func (*FlushResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{54}
+}
+
+func (m *FlushResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FlushResponse.Unmarshal(m, b)
+}
+func (m *FlushResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FlushResponse.Marshal(b, m, deterministic)
+}
+func (m *FlushResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FlushResponse.Merge(m, src)
+}
+func (m *FlushResponse) XXX_Size() int {
+	return xxx_messageInfo_FlushResponse.Size(m)
+}
+func (m *FlushResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FlushResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FlushResponse proto.InternalMessageInfo
+
+func (m *FlushResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *FlushResponse) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *FlushResponse) GetCollSegIDs() map[string]*schemapb.LongArray {
+	if m != nil {
+		return m.CollSegIDs
+	}
+	return nil
+}
+
+type QueryRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName       string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	Expr                 string            `protobuf:"bytes,4,opt,name=expr,proto3" json:"expr,omitempty"`
+	OutputFields         []string          `protobuf:"bytes,5,rep,name=output_fields,json=outputFields,proto3" json:"output_fields,omitempty"`
+	PartitionNames       []string          `protobuf:"bytes,6,rep,name=partition_names,json=partitionNames,proto3" json:"partition_names,omitempty"`
+	TravelTimestamp      uint64            `protobuf:"varint,7,opt,name=travel_timestamp,json=travelTimestamp,proto3" json:"travel_timestamp,omitempty"`
+	GuaranteeTimestamp   uint64            `protobuf:"varint,8,opt,name=guarantee_timestamp,json=guaranteeTimestamp,proto3" json:"guarantee_timestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *QueryRequest) Reset()         { *m = QueryRequest{} }
+func (m *QueryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRequest) ProtoMessage()    {}
+func (*QueryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{55}
+}
+
+func (m *QueryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryRequest.Unmarshal(m, b)
+}
+func (m *QueryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryRequest.Marshal(b, m, deterministic)
+}
+func (m *QueryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRequest.Merge(m, src)
+}
+func (m *QueryRequest) XXX_Size() int {
+	return xxx_messageInfo_QueryRequest.Size(m)
+}
+func (m *QueryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRequest proto.InternalMessageInfo
+
+func (m *QueryRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *QueryRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *QueryRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *QueryRequest) GetExpr() string {
+	if m != nil {
+		return m.Expr
+	}
+	return ""
+}
+
+func (m *QueryRequest) GetOutputFields() []string {
+	if m != nil {
+		return m.OutputFields
+	}
+	return nil
+}
+
+func (m *QueryRequest) GetPartitionNames() []string {
+	if m != nil {
+		return m.PartitionNames
+	}
+	return nil
+}
+
+func (m *QueryRequest) GetTravelTimestamp() uint64 {
+	if m != nil {
+		return m.TravelTimestamp
+	}
+	return 0
+}
+
+func (m *QueryRequest) GetGuaranteeTimestamp() uint64 {
+	if m != nil {
+		return m.GuaranteeTimestamp
+	}
+	return 0
+}
+
+type QueryResults struct {
+	Status               *commonpb.Status      `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	FieldsData           []*schemapb.FieldData `protobuf:"bytes,2,rep,name=fields_data,json=fieldsData,proto3" json:"fields_data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *QueryResults) Reset()         { *m = QueryResults{} }
+func (m *QueryResults) String() string { return proto.CompactTextString(m) }
+func (*QueryResults) ProtoMessage()    {}
+func (*QueryResults) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{56}
+}
+
+func (m *QueryResults) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryResults.Unmarshal(m, b)
+}
+func (m *QueryResults) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryResults.Marshal(b, m, deterministic)
+}
+func (m *QueryResults) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryResults.Merge(m, src)
+}
+func (m *QueryResults) XXX_Size() int {
+	return xxx_messageInfo_QueryResults.Size(m)
+}
+func (m *QueryResults) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryResults.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryResults proto.InternalMessageInfo
+
+func (m *QueryResults) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *QueryResults) GetFieldsData() []*schemapb.FieldData {
+	if m != nil {
+		return m.FieldsData
+	}
+	return nil
+}
+
+type VectorIDs struct {
+	CollectionName       string        `protobuf:"bytes,1,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	FieldName            string        `protobuf:"bytes,2,opt,name=field_name,json=fieldName,proto3" json:"field_name,omitempty"`
+	IdArray              *schemapb.IDs `protobuf:"bytes,3,opt,name=id_array,json=idArray,proto3" json:"id_array,omitempty"`
+	PartitionNames       []string      `protobuf:"bytes,4,rep,name=partition_names,json=partitionNames,proto3" json:"partition_names,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *VectorIDs) Reset()         { *m = VectorIDs{} }
+func (m *VectorIDs) String() string { return proto.CompactTextString(m) }
+func (*VectorIDs) ProtoMessage()    {}
+func (*VectorIDs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{57}
+}
+
+func (m *VectorIDs) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VectorIDs.Unmarshal(m, b)
+}
+func (m *VectorIDs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VectorIDs.Marshal(b, m, deterministic)
+}
+func (m *VectorIDs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VectorIDs.Merge(m, src)
+}
+func (m *VectorIDs) XXX_Size() int {
+	return xxx_messageInfo_VectorIDs.Size(m)
+}
+func (m *VectorIDs) XXX_DiscardUnknown() {
+	xxx_messageInfo_VectorIDs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VectorIDs proto.InternalMessageInfo
+
+func (m *VectorIDs) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *VectorIDs) GetFieldName() string {
+	if m != nil {
+		return m.FieldName
+	}
+	return ""
+}
+
+func (m *VectorIDs) GetIdArray() *schemapb.IDs {
+	if m != nil {
+		return m.IdArray
+	}
+	return nil
+}
+
+func (m *VectorIDs) GetPartitionNames() []string {
+	if m != nil {
+		return m.PartitionNames
+	}
+	return nil
+}
+
+type VectorsArray struct {
+	// Types that are valid to be assigned to Array:
+	//	*VectorsArray_IdArray
+	//	*VectorsArray_DataArray
+	Array                isVectorsArray_Array `protobuf_oneof:"array"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *VectorsArray) Reset()         { *m = VectorsArray{} }
+func (m *VectorsArray) String() string { return proto.CompactTextString(m) }
+func (*VectorsArray) ProtoMessage()    {}
+func (*VectorsArray) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{58}
+}
+
+func (m *VectorsArray) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VectorsArray.Unmarshal(m, b)
+}
+func (m *VectorsArray) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VectorsArray.Marshal(b, m, deterministic)
+}
+func (m *VectorsArray) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VectorsArray.Merge(m, src)
+}
+func (m *VectorsArray) XXX_Size() int {
+	return xxx_messageInfo_VectorsArray.Size(m)
+}
+func (m *VectorsArray) XXX_DiscardUnknown() {
+	xxx_messageInfo_VectorsArray.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VectorsArray proto.InternalMessageInfo
+
+type isVectorsArray_Array interface {
+	isVectorsArray_Array()
+}
+
+type VectorsArray_IdArray struct {
+	IdArray *VectorIDs `protobuf:"bytes,1,opt,name=id_array,json=idArray,proto3,oneof"`
+}
+
+type VectorsArray_DataArray struct {
+	DataArray *schemapb.VectorField `protobuf:"bytes,2,opt,name=data_array,json=dataArray,proto3,oneof"`
+}
+
+func (*VectorsArray_IdArray) isVectorsArray_Array() {}
+
+func (*VectorsArray_DataArray) isVectorsArray_Array() {}
+
+func (m *VectorsArray) GetArray() isVectorsArray_Array {
+	if m != nil {
+		return m.Array
+	}
+	return nil
+}
+
+func (m *VectorsArray) GetIdArray() *VectorIDs {
+	if x, ok := m.GetArray().(*VectorsArray_IdArray); ok {
+		return x.IdArray
+	}
+	return nil
+}
+
+func (m *VectorsArray) GetDataArray() *schemapb.VectorField {
+	if x, ok := m.GetArray().(*VectorsArray_DataArray); ok {
+		return x.DataArray
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*VectorsArray) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*VectorsArray_IdArray)(nil),
+		(*VectorsArray_DataArray)(nil),
+	}
+}
+
+type CalcDistanceRequest struct {
+	Base                 *commonpb.MsgBase        `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	OpLeft               *VectorsArray            `protobuf:"bytes,2,opt,name=op_left,json=opLeft,proto3" json:"op_left,omitempty"`
+	OpRight              *VectorsArray            `protobuf:"bytes,3,opt,name=op_right,json=opRight,proto3" json:"op_right,omitempty"`
+	Params               []*commonpb.KeyValuePair `protobuf:"bytes,4,rep,name=params,proto3" json:"params,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *CalcDistanceRequest) Reset()         { *m = CalcDistanceRequest{} }
+func (m *CalcDistanceRequest) String() string { return proto.CompactTextString(m) }
+func (*CalcDistanceRequest) ProtoMessage()    {}
+func (*CalcDistanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{59}
+}
+
+func (m *CalcDistanceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CalcDistanceRequest.Unmarshal(m, b)
+}
+func (m *CalcDistanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CalcDistanceRequest.Marshal(b, m, deterministic)
+}
+func (m *CalcDistanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CalcDistanceRequest.Merge(m, src)
+}
+func (m *CalcDistanceRequest) XXX_Size() int {
+	return xxx_messageInfo_CalcDistanceRequest.Size(m)
+}
+func (m *CalcDistanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CalcDistanceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CalcDistanceRequest proto.InternalMessageInfo
+
+func (m *CalcDistanceRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *CalcDistanceRequest) GetOpLeft() *VectorsArray {
+	if m != nil {
+		return m.OpLeft
+	}
+	return nil
+}
+
+func (m *CalcDistanceRequest) GetOpRight() *VectorsArray {
+	if m != nil {
+		return m.OpRight
+	}
+	return nil
+}
+
+func (m *CalcDistanceRequest) GetParams() []*commonpb.KeyValuePair {
+	if m != nil {
+		return m.Params
+	}
+	return nil
+}
+
+type CalcDistanceResults struct {
+	Status *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// num(op_left)*num(op_right) distance values, "HAMMIN" return integer distance
+	//
+	// Types that are valid to be assigned to Array:
+	//	*CalcDistanceResults_IntDist
+	//	*CalcDistanceResults_FloatDist
+	Array                isCalcDistanceResults_Array `protobuf_oneof:"array"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
+}
+
+func (m *CalcDistanceResults) Reset()         { *m = CalcDistanceResults{} }
+func (m *CalcDistanceResults) String() string { return proto.CompactTextString(m) }
+func (*CalcDistanceResults) ProtoMessage()    {}
+func (*CalcDistanceResults) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{60}
+}
+
+func (m *CalcDistanceResults) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CalcDistanceResults.Unmarshal(m, b)
+}
+func (m *CalcDistanceResults) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CalcDistanceResults.Marshal(b, m, deterministic)
+}
+func (m *CalcDistanceResults) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CalcDistanceResults.Merge(m, src)
+}
+func (m *CalcDistanceResults) XXX_Size() int {
+	return xxx_messageInfo_CalcDistanceResults.Size(m)
+}
+func (m *CalcDistanceResults) XXX_DiscardUnknown() {
+	xxx_messageInfo_CalcDistanceResults.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CalcDistanceResults proto.InternalMessageInfo
+
+func (m *CalcDistanceResults) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+type isCalcDistanceResults_Array interface {
+	isCalcDistanceResults_Array()
+}
+
+type CalcDistanceResults_IntDist struct {
+	IntDist *schemapb.IntArray `protobuf:"bytes,2,opt,name=int_dist,json=intDist,proto3,oneof"`
+}
+
+type CalcDistanceResults_FloatDist struct {
+	FloatDist *schemapb.FloatArray `protobuf:"bytes,3,opt,name=float_dist,json=floatDist,proto3,oneof"`
+}
+
+func (*CalcDistanceResults_IntDist) isCalcDistanceResults_Array() {}
+
+func (*CalcDistanceResults_FloatDist) isCalcDistanceResults_Array() {}
+
+func (m *CalcDistanceResults) GetArray() isCalcDistanceResults_Array {
+	if m != nil {
+		return m.Array
+	}
+	return nil
+}
+
+func (m *CalcDistanceResults) GetIntDist() *schemapb.IntArray {
+	if x, ok := m.GetArray().(*CalcDistanceResults_IntDist); ok {
+		return x.IntDist
+	}
+	return nil
+}
+
+func (m *CalcDistanceResults) GetFloatDist() *schemapb.FloatArray {
+	if x, ok := m.GetArray().(*CalcDistanceResults_FloatDist); ok {
+		return x.FloatDist
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*CalcDistanceResults) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*CalcDistanceResults_IntDist)(nil),
+		(*CalcDistanceResults_FloatDist)(nil),
+	}
+}
+
+type PersistentSegmentInfo struct {
+	SegmentID            int64                 `protobuf:"varint,1,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
+	CollectionID         int64                 `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	PartitionID          int64                 `protobuf:"varint,3,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
+	NumRows              int64                 `protobuf:"varint,4,opt,name=num_rows,json=numRows,proto3" json:"num_rows,omitempty"`
+	State                commonpb.SegmentState `protobuf:"varint,5,opt,name=state,proto3,enum=milvus.proto.common.SegmentState" json:"state,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *PersistentSegmentInfo) Reset()         { *m = PersistentSegmentInfo{} }
+func (m *PersistentSegmentInfo) String() string { return proto.CompactTextString(m) }
+func (*PersistentSegmentInfo) ProtoMessage()    {}
+func (*PersistentSegmentInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{61}
+}
+
+func (m *PersistentSegmentInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PersistentSegmentInfo.Unmarshal(m, b)
+}
+func (m *PersistentSegmentInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PersistentSegmentInfo.Marshal(b, m, deterministic)
+}
+func (m *PersistentSegmentInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PersistentSegmentInfo.Merge(m, src)
+}
+func (m *PersistentSegmentInfo) XXX_Size() int {
+	return xxx_messageInfo_PersistentSegmentInfo.Size(m)
+}
+func (m *PersistentSegmentInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_PersistentSegmentInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PersistentSegmentInfo proto.InternalMessageInfo
+
+func (m *PersistentSegmentInfo) GetSegmentID() int64 {
+	if m != nil {
+		return m.SegmentID
+	}
+	return 0
+}
+
+func (m *PersistentSegmentInfo) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *PersistentSegmentInfo) GetPartitionID() int64 {
+	if m != nil {
+		return m.PartitionID
+	}
+	return 0
+}
+
+func (m *PersistentSegmentInfo) GetNumRows() int64 {
+	if m != nil {
+		return m.NumRows
+	}
+	return 0
+}
+
+func (m *PersistentSegmentInfo) GetState() commonpb.SegmentState {
+	if m != nil {
+		return m.State
+	}
+	return commonpb.SegmentState_SegmentStateNone
+}
+
+type GetPersistentSegmentInfoRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string            `protobuf:"bytes,2,opt,name=dbName,proto3" json:"dbName,omitempty"`
+	CollectionName       string            `protobuf:"bytes,3,opt,name=collectionName,proto3" json:"collectionName,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetPersistentSegmentInfoRequest) Reset()         { *m = GetPersistentSegmentInfoRequest{} }
+func (m *GetPersistentSegmentInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*GetPersistentSegmentInfoRequest) ProtoMessage()    {}
+func (*GetPersistentSegmentInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{62}
+}
+
+func (m *GetPersistentSegmentInfoRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPersistentSegmentInfoRequest.Unmarshal(m, b)
+}
+func (m *GetPersistentSegmentInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPersistentSegmentInfoRequest.Marshal(b, m, deterministic)
+}
+func (m *GetPersistentSegmentInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPersistentSegmentInfoRequest.Merge(m, src)
+}
+func (m *GetPersistentSegmentInfoRequest) XXX_Size() int {
+	return xxx_messageInfo_GetPersistentSegmentInfoRequest.Size(m)
+}
+func (m *GetPersistentSegmentInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPersistentSegmentInfoRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPersistentSegmentInfoRequest proto.InternalMessageInfo
+
+func (m *GetPersistentSegmentInfoRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *GetPersistentSegmentInfoRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *GetPersistentSegmentInfoRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+type GetPersistentSegmentInfoResponse struct {
+	Status               *commonpb.Status         `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Infos                []*PersistentSegmentInfo `protobuf:"bytes,2,rep,name=infos,proto3" json:"infos,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *GetPersistentSegmentInfoResponse) Reset()         { *m = GetPersistentSegmentInfoResponse{} }
+func (m *GetPersistentSegmentInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*GetPersistentSegmentInfoResponse) ProtoMessage()    {}
+func (*GetPersistentSegmentInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{63}
+}
+
+func (m *GetPersistentSegmentInfoResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPersistentSegmentInfoResponse.Unmarshal(m, b)
+}
+func (m *GetPersistentSegmentInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPersistentSegmentInfoResponse.Marshal(b, m, deterministic)
+}
+func (m *GetPersistentSegmentInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPersistentSegmentInfoResponse.Merge(m, src)
+}
+func (m *GetPersistentSegmentInfoResponse) XXX_Size() int {
+	return xxx_messageInfo_GetPersistentSegmentInfoResponse.Size(m)
+}
+func (m *GetPersistentSegmentInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPersistentSegmentInfoResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPersistentSegmentInfoResponse proto.InternalMessageInfo
+
+func (m *GetPersistentSegmentInfoResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetPersistentSegmentInfoResponse) GetInfos() []*PersistentSegmentInfo {
+	if m != nil {
+		return m.Infos
+	}
+	return nil
+}
+
+type QuerySegmentInfo struct {
+	SegmentID            int64    `protobuf:"varint,1,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
+	CollectionID         int64    `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	PartitionID          int64    `protobuf:"varint,3,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
+	MemSize              int64    `protobuf:"varint,4,opt,name=mem_size,json=memSize,proto3" json:"mem_size,omitempty"`
+	NumRows              int64    `protobuf:"varint,5,opt,name=num_rows,json=numRows,proto3" json:"num_rows,omitempty"`
+	IndexName            string   `protobuf:"bytes,6,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
+	IndexID              int64    `protobuf:"varint,7,opt,name=indexID,proto3" json:"indexID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QuerySegmentInfo) Reset()         { *m = QuerySegmentInfo{} }
+func (m *QuerySegmentInfo) String() string { return proto.CompactTextString(m) }
+func (*QuerySegmentInfo) ProtoMessage()    {}
+func (*QuerySegmentInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{64}
+}
+
+func (m *QuerySegmentInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QuerySegmentInfo.Unmarshal(m, b)
+}
+func (m *QuerySegmentInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QuerySegmentInfo.Marshal(b, m, deterministic)
+}
+func (m *QuerySegmentInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySegmentInfo.Merge(m, src)
+}
+func (m *QuerySegmentInfo) XXX_Size() int {
+	return xxx_messageInfo_QuerySegmentInfo.Size(m)
+}
+func (m *QuerySegmentInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySegmentInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySegmentInfo proto.InternalMessageInfo
+
+func (m *QuerySegmentInfo) GetSegmentID() int64 {
+	if m != nil {
+		return m.SegmentID
+	}
+	return 0
+}
+
+func (m *QuerySegmentInfo) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *QuerySegmentInfo) GetPartitionID() int64 {
+	if m != nil {
+		return m.PartitionID
+	}
+	return 0
+}
+
+func (m *QuerySegmentInfo) GetMemSize() int64 {
+	if m != nil {
+		return m.MemSize
+	}
+	return 0
+}
+
+func (m *QuerySegmentInfo) GetNumRows() int64 {
+	if m != nil {
+		return m.NumRows
+	}
+	return 0
+}
+
+func (m *QuerySegmentInfo) GetIndexName() string {
+	if m != nil {
+		return m.IndexName
+	}
+	return ""
+}
+
+func (m *QuerySegmentInfo) GetIndexID() int64 {
+	if m != nil {
+		return m.IndexID
+	}
+	return 0
 }
 
-func (m *Hits) GetScores() []float32 {
+type GetQuerySegmentInfoRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string            `protobuf:"bytes,2,opt,name=dbName,proto3" json:"dbName,omitempty"`
+	CollectionName       string            `protobuf:"bytes,3,opt,name=collectionName,proto3" json:"collectionName,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetQuerySegmentInfoRequest) Reset()         { *m = GetQuerySegmentInfoRequest{} }
+func (m *GetQuerySegmentInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*GetQuerySegmentInfoRequest) ProtoMessage()    {}
+func (*GetQuerySegmentInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{65}
+}
+
+func (m *GetQuerySegmentInfoRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetQuerySegmentInfoRequest.Unmarshal(m, b)
+}
+func (m *GetQuerySegmentInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetQuerySegmentInfoRequest.Marshal(b, m, deterministic)
+}
+func (m *GetQuerySegmentInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetQuerySegmentInfoRequest.Merge(m, src)
+}
+func (m *GetQuerySegmentInfoRequest) XXX_Size() int {
+	return xxx_messageInfo_GetQuerySegmentInfoRequest.Size(m)
+}
+func (m *GetQuerySegmentInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetQuerySegmentInfoRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetQuerySegmentInfoRequest proto.InternalMessageInfo
+
+func (m *GetQuerySegmentInfoRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
-		return m.Scores
+		return m.Base
 	}
 	return nil
 }
 
-type SearchResults struct {
-	Status               *commonpb.Status           `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Results              *schemapb.SearchResultData `protobuf:"bytes,2,opt,name=results,proto3" json:"results,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
-	XXX_unrecognized     []byte                     `json:"-"`
-	XXX_sizecache        int32                      `json:"-"`
+func (m *GetQuerySegmentInfoRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
 }
 
-func (m *SearchResults) Reset()         { *m = SearchResults{} }
-func (m *SearchResults) String() string { return proto.CompactTextString(m) }
-func (*SearchResults) ProtoMessage()    {}
-func (*SearchResults) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{45}
+func (m *GetQuerySegmentInfoRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
 }
 
-func (m *SearchResults) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SearchResults.Unmarshal(m, b)
+type GetQuerySegmentInfoResponse struct {
+	Status               *commonpb.Status    `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Infos                []*QuerySegmentInfo `protobuf:"bytes,2,rep,name=infos,proto3" json:"infos,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
-func (m *SearchResults) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SearchResults.Marshal(b, m, deterministic)
+
+func (m *GetQuerySegmentInfoResponse) Reset()         { *m = GetQuerySegmentInfoResponse{} }
+func (m *GetQuerySegmentInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*GetQuerySegmentInfoResponse) ProtoMessage()    {}
+func (*GetQuerySegmentInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{66}
 }
-func (m *SearchResults) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SearchResults.Merge(m, src)
+
+func (m *GetQuerySegmentInfoResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetQuerySegmentInfoResponse.Unmarshal(m, b)
 }
-func (m *SearchResults) XXX_Size() int {
-	return xxx_messageInfo_SearchResults.Size(m)
+func (m *GetQuerySegmentInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetQuerySegmentInfoResponse.Marshal(b, m, deterministic)
 }
-func (m *SearchResults) XXX_DiscardUnknown() {
-	xxx_messageInfo_SearchResults.DiscardUnknown(m)
+func (m *GetQuerySegmentInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetQuerySegmentInfoResponse.Merge(m, src)
+}
+func (m *GetQuerySegmentInfoResponse) XXX_Size() int {
+	return xxx_messageInfo_GetQuerySegmentInfoResponse.Size(m)
+}
+func (m *GetQuerySegmentInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetQuerySegmentInfoResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SearchResults proto.InternalMessageInfo
+var xxx_messageInfo_GetQuerySegmentInfoResponse proto.InternalMessageInfo
 
-func (m *SearchResults) GetStatus() *commonpb.Status {
+func (m *GetQuerySegmentInfoResponse) GetStatus() *commonpb.Status {
 	if m != nil {
 		return m.Status
 	}
 	return nil
 }
 
-func (m *SearchResults) GetResults() *schemapb.SearchResultData {
+func (m *GetQuerySegmentInfoResponse) GetInfos() []*QuerySegmentInfo {
 	if m != nil {
-		return m.Results
+		return m.Infos
 	}
 	return nil
 }
 
-type FlushRequest struct {
+type DummyRequest struct {
+	RequestType          string   `protobuf:"bytes,1,opt,name=request_type,json=requestType,proto3" json:"request_type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DummyRequest) Reset()         { *m = DummyRequest{} }
+func (m *DummyRequest) String() string { return proto.CompactTextString(m) }
+func (*DummyRequest) ProtoMessage()    {}
+func (*DummyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{67}
+}
+
+func (m *DummyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DummyRequest.Unmarshal(m, b)
+}
+func (m *DummyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DummyRequest.Marshal(b, m, deterministic)
+}
+func (m *DummyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DummyRequest.Merge(m, src)
+}
+func (m *DummyRequest) XXX_Size() int {
+	return xxx_messageInfo_DummyRequest.Size(m)
+}
+func (m *DummyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DummyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DummyRequest proto.InternalMessageInfo
+
+func (m *DummyRequest) GetRequestType() string {
+	if m != nil {
+		return m.RequestType
+	}
+	return ""
+}
+
+type DummyResponse struct {
+	Response             string   `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DummyResponse) Reset()         { *m = DummyResponse{} }
+func (m *DummyResponse) String() string { return proto.CompactTextString(m) }
+func (*DummyResponse) ProtoMessage()    {}
+func (*DummyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{68}
+}
+
+func (m *DummyResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DummyResponse.Unmarshal(m, b)
+}
+func (m *DummyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DummyResponse.Marshal(b, m, deterministic)
+}
+func (m *DummyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DummyResponse.Merge(m, src)
+}
+func (m *DummyResponse) XXX_Size() int {
+	return xxx_messageInfo_DummyResponse.Size(m)
+}
+func (m *DummyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DummyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DummyResponse proto.InternalMessageInfo
+
+func (m *DummyResponse) GetResponse() string {
+	if m != nil {
+		return m.Response
+	}
+	return ""
+}
+
+type RegisterLinkRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RegisterLinkRequest) Reset()         { *m = RegisterLinkRequest{} }
+func (m *RegisterLinkRequest) String() string { return proto.CompactTextString(m) }
+func (*RegisterLinkRequest) ProtoMessage()    {}
+func (*RegisterLinkRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{69}
+}
+
+func (m *RegisterLinkRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RegisterLinkRequest.Unmarshal(m, b)
+}
+func (m *RegisterLinkRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RegisterLinkRequest.Marshal(b, m, deterministic)
+}
+func (m *RegisterLinkRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisterLinkRequest.Merge(m, src)
+}
+func (m *RegisterLinkRequest) XXX_Size() int {
+	return xxx_messageInfo_RegisterLinkRequest.Size(m)
+}
+func (m *RegisterLinkRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisterLinkRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegisterLinkRequest proto.InternalMessageInfo
+
+type RegisterLinkResponse struct {
+	Address              *commonpb.Address `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Status               *commonpb.Status  `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *RegisterLinkResponse) Reset()         { *m = RegisterLinkResponse{} }
+func (m *RegisterLinkResponse) String() string { return proto.CompactTextString(m) }
+func (*RegisterLinkResponse) ProtoMessage()    {}
+func (*RegisterLinkResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{70}
+}
+
+func (m *RegisterLinkResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RegisterLinkResponse.Unmarshal(m, b)
+}
+func (m *RegisterLinkResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RegisterLinkResponse.Marshal(b, m, deterministic)
+}
+func (m *RegisterLinkResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisterLinkResponse.Merge(m, src)
+}
+func (m *RegisterLinkResponse) XXX_Size() int {
+	return xxx_messageInfo_RegisterLinkResponse.Size(m)
+}
+func (m *RegisterLinkResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisterLinkResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegisterLinkResponse proto.InternalMessageInfo
+
+func (m *RegisterLinkResponse) GetAddress() *commonpb.Address {
+	if m != nil {
+		return m.Address
+	}
+	return nil
+}
+
+func (m *RegisterLinkResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+type GetMetricsRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	CollectionNames      []string          `protobuf:"bytes,3,rep,name=collection_names,json=collectionNames,proto3" json:"collection_names,omitempty"`
+	Request              string            `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *FlushRequest) Reset()         { *m = FlushRequest{} }
-func (m *FlushRequest) String() string { return proto.CompactTextString(m) }
-func (*FlushRequest) ProtoMessage()    {}
-func (*FlushRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{46}
+func (m *GetMetricsRequest) Reset()         { *m = GetMetricsRequest{} }
+func (m *GetMetricsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetMetricsRequest) ProtoMessage()    {}
+func (*GetMetricsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{71}
 }
 
-func (m *FlushRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_FlushRequest.Unmarshal(m, b)
+func (m *GetMetricsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetMetricsRequest.Unmarshal(m, b)
 }
-func (m *FlushRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_FlushRequest.Marshal(b, m, deterministic)
+func (m *GetMetricsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetMetricsRequest.Marshal(b, m, deterministic)
 }
-func (m *FlushRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_FlushRequest.Merge(m, src)
+func (m *GetMetricsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetMetricsRequest.Merge(m, src)
 }
-func (m *FlushRequest) XXX_Size() int {
-	return xxx_messageInfo_FlushRequest.Size(m)
+func (m *GetMetricsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetMetricsRequest.Size(m)
 }
-func (m *FlushRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_FlushRequest.DiscardUnknown(m)
+func (m *GetMetricsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetMetricsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_FlushRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetMetricsRequest proto.InternalMessageInfo
 
-func (m *FlushRequest) GetBase() *commonpb.MsgBase {
+func (m *GetMetricsRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
 		return m.Base
 	}
 	return nil
 }
 
-func (m *FlushRequest) GetDbName() string {
+func (m *GetMetricsRequest) GetRequest() string {
 	if m != nil {
-		return m.DbName
+		return m.Request
 	}
 	return ""
 }
 
-func (m *FlushRequest) GetCollectionNames() []string {
-	if m != nil {
-		return m.CollectionNames
-	}
-	return nil
-}
-
-type FlushResponse struct {
-	Status               *commonpb.Status               `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	DbName               string                         `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	CollSegIDs           map[string]*schemapb.LongArray `protobuf:"bytes,3,rep,name=coll_segIDs,json=collSegIDs,proto3" json:"coll_segIDs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
-	XXX_unrecognized     []byte                         `json:"-"`
-	XXX_sizecache        int32                          `json:"-"`
+type GetMetricsResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Response             string           `protobuf:"bytes,2,opt,name=response,proto3" json:"response,omitempty"`
+	ComponentName        string           `protobuf:"bytes,3,opt,name=component_name,json=componentName,proto3" json:"component_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
-func (m *FlushResponse) Reset()         { *m = FlushResponse{} }
-func (m *FlushResponse) String() string { return proto.CompactTextString(m) }
-func (*FlushResponse) ProtoMessage()    {}
-func (*FlushResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{47}
+func (m *GetMetricsResponse) Reset()         { *m = GetMetricsResponse{} }
+func (m *GetMetricsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetMetricsResponse) ProtoMessage()    {}
+func (*GetMetricsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{72}
 }
 
-func (m *FlushResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_FlushResponse.Unmarshal(m, b)
+func (m *GetMetricsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetMetricsResponse.Unmarshal(m, b)
 }
-func (m *FlushResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_FlushResponse.Marshal(b, m, deterministic)
+func (m *GetMetricsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetMetricsResponse.Marshal(b, m, deterministic)
 }
-func (m *FlushResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_FlushResponse.Merge(m, src)
+func (m *GetMetricsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetMetricsResponse.Merge(m, src)
 }
-func (m *FlushResponse) XXX_Size() int {
-	return xxx_messageInfo_FlushResponse.Size(m)
+func (m *GetMetricsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetMetricsResponse.Size(m)
 }
-func (m *FlushResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_FlushResponse.DiscardUnknown(m)
+func (m *GetMetricsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetMetricsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_FlushResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetMetricsResponse proto.InternalMessageInfo
 
-func (m *FlushResponse) GetStatus() *commonpb.Status {
+func (m *GetMetricsResponse) GetStatus() *commonpb.Status {
 	if m != nil {
 		return m.Status
 	}
 	return nil
 }
 
-func (m *FlushResponse) GetDbName() string {
+func (m *GetMetricsResponse) GetResponse() string {
 	if m != nil {
-		return m.DbName
+		return m.Response
 	}
 	return ""
 }
 
-func (m *FlushResponse) GetCollSegIDs() map[string]*schemapb.LongArray {
+func (m *GetMetricsResponse) GetComponentName() string {
 	if m != nil {
-		return m.CollSegIDs
+		return m.ComponentName
 	}
-	return nil
+	return ""
 }
 
-type QueryRequest struct {
-	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	CollectionName       string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
-	Expr                 string            `protobuf:"bytes,4,opt,name=expr,proto3" json:"expr,omitempty"`
-	OutputFields         []string          `protobuf:"bytes,5,rep,name=output_fields,json=outputFields,proto3" json:"output_fields,omitempty"`
-	PartitionNames       []string          `protobuf:"bytes,6,rep,name=partition_names,json=partitionNames,proto3" json:"partition_names,omitempty"`
-	TravelTimestamp      uint64            `protobuf:"varint,7,opt,name=travel_timestamp,json=travelTimestamp,proto3" json:"travel_timestamp,omitempty"`
-	GuaranteeTimestamp   uint64            `protobuf:"varint,8,opt,name=guarantee_timestamp,json=guaranteeTimestamp,proto3" json:"guarantee_timestamp,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+type CreateCredentialRequest struct {
+	// Not useful for now
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// username
+	Username string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	// password, base64 encoded
+	Password string `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	// create time
+	CreatedUtcTimestamps uint64 `protobuf:"varint,4,opt,name=created_utc_timestamps,json=createdUtcTimestamps,proto3" json:"created_utc_timestamps,omitempty"`
+	// modify time
+	ModifiedUtcTimestamps uint64   `protobuf:"varint,5,opt,name=modified_utc_timestamps,json=modifiedUtcTimestamps,proto3" json:"modified_utc_timestamps,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{} `json:"-"`
+	XXX_unrecognized      []byte   `json:"-"`
+	XXX_sizecache         int32    `json:"-"`
 }
 
-func (m *QueryRequest) Reset()         { *m = QueryRequest{} }
-func (m *QueryRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryRequest) ProtoMessage()    {}
-func (*QueryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{48}
+func (m *CreateCredentialRequest) Reset()         { *m = CreateCredentialRequest{} }
+func (m *CreateCredentialRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateCredentialRequest) ProtoMessage()    {}
+func (*CreateCredentialRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{73}
 }
 
-func (m *QueryRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_QueryRequest.Unmarshal(m, b)
+func (m *CreateCredentialRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateCredentialRequest.Unmarshal(m, b)
 }
-func (m *QueryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_QueryRequest.Marshal(b, m, deterministic)
+func (m *CreateCredentialRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateCredentialRequest.Marshal(b, m, deterministic)
 }
-func (m *QueryRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryRequest.Merge(m, src)
+func (m *CreateCredentialRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateCredentialRequest.Merge(m, src)
 }
-func (m *QueryRequest) XXX_Size() int {
-	return xxx_messageInfo_QueryRequest.Size(m)
+func (m *CreateCredentialRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateCredentialRequest.Size(m)
 }
-func (m *QueryRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryRequest.DiscardUnknown(m)
+func (m *CreateCredentialRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateCredentialRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryRequest proto.InternalMessageInfo
+var xxx_messageInfo_CreateCredentialRequest proto.InternalMessageInfo
 
-func (m *QueryRequest) GetBase() *commonpb.MsgBase {
+func (m *CreateCredentialRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
 		return m.Base
 	}
 	return nil
 }
 
-func (m *QueryRequest) GetDbName() string {
-	if m != nil {
-		return m.DbName
-	}
-	return ""
-}
-
-func (m *QueryRequest) GetCollectionName() string {
+func (m *CreateCredentialRequest) GetUsername() string {
 	if m != nil {
-		return m.CollectionName
+		return m.Username
 	}
 	return ""
 }
 
-func (m *QueryRequest) GetExpr() string {
+func (m *CreateCredentialRequest) GetPassword() string {
 	if m != nil {
-		return m.Expr
+		return m.Password
 	}
 	return ""
 }
 
-func (m *QueryRequest) GetOutputFields() []string {
-	if m != nil {
-		return m.OutputFields
-	}
-	return nil
-}
-
-func (m *QueryRequest) GetPartitionNames() []string {
-	if m != nil {
-		return m.PartitionNames
-	}
-	return nil
-}
-
-func (m *QueryRequest) GetTravelTimestamp() uint64 {
+func (m *CreateCredentialRequest) GetCreatedUtcTimestamps() uint64 {
 	if m != nil {
-		return m.TravelTimestamp
+		return m.CreatedUtcTimestamps
 	}
 	return 0
 }
 
-func (m *QueryRequest) GetGuaranteeTimestamp() uint64 {
+func (m *CreateCredentialRequest) GetModifiedUtcTimestamps() uint64 {
 	if m != nil {
-		return m.GuaranteeTimestamp
+		return m.ModifiedUtcTimestamps
 	}
 	return 0
 }
 
-type QueryResults struct {
-	Status               *commonpb.Status      `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	FieldsData           []*schemapb.FieldData `protobuf:"bytes,2,rep,name=fields_data,json=fieldsData,proto3" json:"fields_data,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
-	XXX_unrecognized     []byte                `json:"-"`
-	XXX_sizecache        int32                 `json:"-"`
+type UpdateCredentialRequest struct {
+	// Not useful for now
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// username
+	Username string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	// old password, base64 encoded
+	OldPassword string `protobuf:"bytes,3,opt,name=oldPassword,proto3" json:"oldPassword,omitempty"`
+	// new password, base64 encoded
+	NewPassword string `protobuf:"bytes,4,opt,name=newPassword,proto3" json:"newPassword,omitempty"`
+	// create time
+	CreatedUtcTimestamps uint64 `protobuf:"varint,5,opt,name=created_utc_timestamps,json=createdUtcTimestamps,proto3" json:"created_utc_timestamps,omitempty"`
+	// modify time
+	ModifiedUtcTimestamps uint64   `protobuf:"varint,6,opt,name=modified_utc_timestamps,json=modifiedUtcTimestamps,proto3" json:"modified_utc_timestamps,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{} `json:"-"`
+	XXX_unrecognized      []byte   `json:"-"`
+	XXX_sizecache         int32    `json:"-"`
 }
 
-func (m *QueryResults) Reset()         { *m = QueryResults{} }
-func (m *QueryResults) String() string { return proto.CompactTextString(m) }
-func (*QueryResults) ProtoMessage()    {}
-func (*QueryResults) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{49}
+func (m *UpdateCredentialRequest) Reset()         { *m = UpdateCredentialRequest{} }
+func (m *UpdateCredentialRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateCredentialRequest) ProtoMessage()    {}
+func (*UpdateCredentialRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{74}
 }
 
-func (m *QueryResults) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_QueryResults.Unmarshal(m, b)
+func (m *UpdateCredentialRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateCredentialRequest.Unmarshal(m, b)
 }
-func (m *QueryResults) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_QueryResults.Marshal(b, m, deterministic)
+func (m *UpdateCredentialRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateCredentialRequest.Marshal(b, m, deterministic)
 }
-func (m *QueryResults) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryResults.Merge(m, src)
+func (m *UpdateCredentialRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateCredentialRequest.Merge(m, src)
 }
-func (m *QueryResults) XXX_Size() int {
-	return xxx_messageInfo_QueryResults.Size(m)
+func (m *UpdateCredentialRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateCredentialRequest.Size(m)
 }
-func (m *QueryResults) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryResults.DiscardUnknown(m)
+func (m *UpdateCredentialRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateCredentialRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryResults proto.InternalMessageInfo
+var xxx_messageInfo_UpdateCredentialRequest proto.InternalMessageInfo
 
-func (m *QueryResults) GetStatus() *commonpb.Status {
+func (m *UpdateCredentialRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
-		return m.Status
+		return m.Base
 	}
 	return nil
 }
 
-func (m *QueryResults) GetFieldsData() []*schemapb.FieldData {
+func (m *UpdateCredentialRequest) GetUsername() string {
 	if m != nil {
-		return m.FieldsData
+		return m.Username
 	}
-	return nil
-}
-
-type VectorIDs struct {
-	CollectionName       string        `protobuf:"bytes,1,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
-	FieldName            string        `protobuf:"bytes,2,opt,name=field_name,json=fieldName,proto3" json:"field_name,omitempty"`
-	IdArray              *schemapb.IDs `protobuf:"bytes,3,opt,name=id_array,json=idArray,proto3" json:"id_array,omitempty"`
-	PartitionNames       []string      `protobuf:"bytes,4,rep,name=partition_names,json=partitionNames,proto3" json:"partition_names,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
-}
-
-func (m *VectorIDs) Reset()         { *m = VectorIDs{} }
-func (m *VectorIDs) String() string { return proto.CompactTextString(m) }
-func (*VectorIDs) ProtoMessage()    {}
-func (*VectorIDs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{50}
-}
-
-func (m *VectorIDs) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_VectorIDs.Unmarshal(m, b)
-}
-func (m *VectorIDs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_VectorIDs.Marshal(b, m, deterministic)
-}
-func (m *VectorIDs) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_VectorIDs.Merge(m, src)
-}
-func (m *VectorIDs) XXX_Size() int {
-	return xxx_messageInfo_VectorIDs.Size(m)
-}
-func (m *VectorIDs) XXX_DiscardUnknown() {
-	xxx_messageInfo_VectorIDs.DiscardUnknown(m)
+	return ""
 }
 
-var xxx_messageInfo_VectorIDs proto.InternalMessageInfo
-
-func (m *VectorIDs) GetCollectionName() string {
+func (m *UpdateCredentialRequest) GetOldPassword() string {
 	if m != nil {
-		return m.CollectionName
+		return m.OldPassword
 	}
 	return ""
 }
 
-func (m *VectorIDs) GetFieldName() string {
+func (m *UpdateCredentialRequest) GetNewPassword() string {
 	if m != nil {
-		return m.FieldName
+		return m.NewPassword
 	}
 	return ""
 }
 
-func (m *VectorIDs) GetIdArray() *schemapb.IDs {
+func (m *UpdateCredentialRequest) GetCreatedUtcTimestamps() uint64 {
 	if m != nil {
-		return m.IdArray
+		return m.CreatedUtcTimestamps
 	}
-	return nil
+	return 0
 }
 
-func (m *VectorIDs) GetPartitionNames() []string {
+func (m *UpdateCredentialRequest) GetModifiedUtcTimestamps() uint64 {
 	if m != nil {
-		return m.PartitionNames
+		return m.ModifiedUtcTimestamps
 	}
-	return nil
+	return 0
 }
 
-type VectorsArray struct {
-	// Types that are valid to be assigned to Array:
-	//	*VectorsArray_IdArray
-	//	*VectorsArray_DataArray
-	Array                isVectorsArray_Array `protobuf_oneof:"array"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+type DeleteCredentialRequest struct {
+	// Not useful for now
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// username
+	Username             string   `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *VectorsArray) Reset()         { *m = VectorsArray{} }
-func (m *VectorsArray) String() string { return proto.CompactTextString(m) }
-func (*VectorsArray) ProtoMessage()    {}
-func (*VectorsArray) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{51}
+func (m *DeleteCredentialRequest) Reset()         { *m = DeleteCredentialRequest{} }
+func (m *DeleteCredentialRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteCredentialRequest) ProtoMessage()    {}
+func (*DeleteCredentialRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{75}
 }
 
-func (m *VectorsArray) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_VectorsArray.Unmarshal(m, b)
+func (m *DeleteCredentialRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteCredentialRequest.Unmarshal(m, b)
 }
-func (m *VectorsArray) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_VectorsArray.Marshal(b, m, deterministic)
+func (m *DeleteCredentialRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteCredentialRequest.Marshal(b, m, deterministic)
 }
-func (m *VectorsArray) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_VectorsArray.Merge(m, src)
+func (m *DeleteCredentialRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteCredentialRequest.Merge(m, src)
 }
-func (m *VectorsArray) XXX_Size() int {
-	return xxx_messageInfo_VectorsArray.Size(m)
+func (m *DeleteCredentialRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteCredentialRequest.Size(m)
 }
-func (m *VectorsArray) XXX_DiscardUnknown() {
-	xxx_messageInfo_VectorsArray.DiscardUnknown(m)
+func (m *DeleteCredentialRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteCredentialRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_VectorsArray proto.InternalMessageInfo
+var xxx_messageInfo_DeleteCredentialRequest proto.InternalMessageInfo
 
-type isVectorsArray_Array interface {
-	isVectorsArray_Array()
+func (m *DeleteCredentialRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
 }
 
-type VectorsArray_IdArray struct {
-	IdArray *VectorIDs `protobuf:"bytes,1,opt,name=id_array,json=idArray,proto3,oneof"`
+func (m *DeleteCredentialRequest) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
 }
 
-type VectorsArray_DataArray struct {
-	DataArray *schemapb.VectorField `protobuf:"bytes,2,opt,name=data_array,json=dataArray,proto3,oneof"`
+type ListCredUsersResponse struct {
+	// Contain error_code and reason
+	Status *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// username array
+	Usernames            []string `protobuf:"bytes,2,rep,name=usernames,proto3" json:"usernames,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (*VectorsArray_IdArray) isVectorsArray_Array() {}
-
-func (*VectorsArray_DataArray) isVectorsArray_Array() {}
-
-func (m *VectorsArray) GetArray() isVectorsArray_Array {
-	if m != nil {
-		return m.Array
-	}
-	return nil
+func (m *ListCredUsersResponse) Reset()         { *m = ListCredUsersResponse{} }
+func (m *ListCredUsersResponse) String() string { return proto.CompactTextString(m) }
+func (*ListCredUsersResponse) ProtoMessage()    {}
+func (*ListCredUsersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{76}
 }
 
-func (m *VectorsArray) GetIdArray() *VectorIDs {
-	if x, ok := m.GetArray().(*VectorsArray_IdArray); ok {
-		return x.IdArray
-	}
-	return nil
+func (m *ListCredUsersResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListCredUsersResponse.Unmarshal(m, b)
+}
+func (m *ListCredUsersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListCredUsersResponse.Marshal(b, m, deterministic)
+}
+func (m *ListCredUsersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListCredUsersResponse.Merge(m, src)
+}
+func (m *ListCredUsersResponse) XXX_Size() int {
+	return xxx_messageInfo_ListCredUsersResponse.Size(m)
+}
+func (m *ListCredUsersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListCredUsersResponse.DiscardUnknown(m)
 }
 
-func (m *VectorsArray) GetDataArray() *schemapb.VectorField {
-	if x, ok := m.GetArray().(*VectorsArray_DataArray); ok {
-		return x.DataArray
+var xxx_messageInfo_ListCredUsersResponse proto.InternalMessageInfo
+
+func (m *ListCredUsersResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
 	}
 	return nil
 }
 
-// XXX_OneofWrappers is for the internal use of the proto package.
-func (*VectorsArray) XXX_OneofWrappers() []interface{} {
-	return []interface{}{
-		(*VectorsArray_IdArray)(nil),
-		(*VectorsArray_DataArray)(nil),
+func (m *ListCredUsersResponse) GetUsernames() []string {
+	if m != nil {
+		return m.Usernames
 	}
+	return nil
 }
 
-type CalcDistanceRequest struct {
-	Base                 *commonpb.MsgBase        `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	OpLeft               *VectorsArray            `protobuf:"bytes,2,opt,name=op_left,json=opLeft,proto3" json:"op_left,omitempty"`
-	OpRight              *VectorsArray            `protobuf:"bytes,3,opt,name=op_right,json=opRight,proto3" json:"op_right,omitempty"`
-	Params               []*commonpb.KeyValuePair `protobuf:"bytes,4,rep,name=params,proto3" json:"params,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
-	XXX_unrecognized     []byte                   `json:"-"`
-	XXX_sizecache        int32                    `json:"-"`
+type ListCredUsersRequest struct {
+	// Not useful for now
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *CalcDistanceRequest) Reset()         { *m = CalcDistanceRequest{} }
-func (m *CalcDistanceRequest) String() string { return proto.CompactTextString(m) }
-func (*CalcDistanceRequest) ProtoMessage()    {}
-func (*CalcDistanceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{52}
+func (m *ListCredUsersRequest) Reset()         { *m = ListCredUsersRequest{} }
+func (m *ListCredUsersRequest) String() string { return proto.CompactTextString(m) }
+func (*ListCredUsersRequest) ProtoMessage()    {}
+func (*ListCredUsersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{77}
 }
 
-func (m *CalcDistanceRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CalcDistanceRequest.Unmarshal(m, b)
+func (m *ListCredUsersRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListCredUsersRequest.Unmarshal(m, b)
 }
-func (m *CalcDistanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CalcDistanceRequest.Marshal(b, m, deterministic)
+func (m *ListCredUsersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListCredUsersRequest.Marshal(b, m, deterministic)
 }
-func (m *CalcDistanceRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CalcDistanceRequest.Merge(m, src)
+func (m *ListCredUsersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListCredUsersRequest.Merge(m, src)
 }
-func (m *CalcDistanceRequest) XXX_Size() int {
-	return xxx_messageInfo_CalcDistanceRequest.Size(m)
+func (m *ListCredUsersRequest) XXX_Size() int {
+	return xxx_messageInfo_ListCredUsersRequest.Size(m)
 }
-func (m *CalcDistanceRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_CalcDistanceRequest.DiscardUnknown(m)
+func (m *ListCredUsersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListCredUsersRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CalcDistanceRequest proto.InternalMessageInfo
+var xxx_messageInfo_ListCredUsersRequest proto.InternalMessageInfo
 
-func (m *CalcDistanceRequest) GetBase() *commonpb.MsgBase {
+func (m *ListCredUsersRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
 		return m.Base
 	}
 	return nil
 }
 
-func (m *CalcDistanceRequest) GetOpLeft() *VectorsArray {
-	if m != nil {
-		return m.OpLeft
-	}
-	return nil
+type RoleEntity struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *CalcDistanceRequest) GetOpRight() *VectorsArray {
-	if m != nil {
-		return m.OpRight
-	}
-	return nil
+func (m *RoleEntity) Reset()         { *m = RoleEntity{} }
+func (m *RoleEntity) String() string { return proto.CompactTextString(m) }
+func (*RoleEntity) ProtoMessage()    {}
+func (*RoleEntity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{78}
 }
 
-func (m *CalcDistanceRequest) GetParams() []*commonpb.KeyValuePair {
+func (m *RoleEntity) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RoleEntity.Unmarshal(m, b)
+}
+func (m *RoleEntity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RoleEntity.Marshal(b, m, deterministic)
+}
+func (m *RoleEntity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoleEntity.Merge(m, src)
+}
+func (m *RoleEntity) XXX_Size() int {
+	return xxx_messageInfo_RoleEntity.Size(m)
+}
+func (m *RoleEntity) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoleEntity.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RoleEntity proto.InternalMessageInfo
+
+func (m *RoleEntity) GetName() string {
 	if m != nil {
-		return m.Params
+		return m.Name
 	}
-	return nil
+	return ""
 }
 
-type CalcDistanceResults struct {
-	Status *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	// num(op_left)*num(op_right) distance values, "HAMMIN" return integer distance
-	//
-	// Types that are valid to be assigned to Array:
-	//	*CalcDistanceResults_IntDist
-	//	*CalcDistanceResults_FloatDist
-	Array                isCalcDistanceResults_Array `protobuf_oneof:"array"`
-	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
-	XXX_unrecognized     []byte                      `json:"-"`
-	XXX_sizecache        int32                       `json:"-"`
+type UserEntity struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *CalcDistanceResults) Reset()         { *m = CalcDistanceResults{} }
-func (m *CalcDistanceResults) String() string { return proto.CompactTextString(m) }
-func (*CalcDistanceResults) ProtoMessage()    {}
-func (*CalcDistanceResults) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{53}
+func (m *UserEntity) Reset()         { *m = UserEntity{} }
+func (m *UserEntity) String() string { return proto.CompactTextString(m) }
+func (*UserEntity) ProtoMessage()    {}
+func (*UserEntity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{79}
 }
 
-func (m *CalcDistanceResults) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CalcDistanceResults.Unmarshal(m, b)
+func (m *UserEntity) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UserEntity.Unmarshal(m, b)
 }
-func (m *CalcDistanceResults) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CalcDistanceResults.Marshal(b, m, deterministic)
+func (m *UserEntity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UserEntity.Marshal(b, m, deterministic)
 }
-func (m *CalcDistanceResults) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CalcDistanceResults.Merge(m, src)
+func (m *UserEntity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserEntity.Merge(m, src)
 }
-func (m *CalcDistanceResults) XXX_Size() int {
-	return xxx_messageInfo_CalcDistanceResults.Size(m)
+func (m *UserEntity) XXX_Size() int {
+	return xxx_messageInfo_UserEntity.Size(m)
 }
-func (m *CalcDistanceResults) XXX_DiscardUnknown() {
-	xxx_messageInfo_CalcDistanceResults.DiscardUnknown(m)
+func (m *UserEntity) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserEntity.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CalcDistanceResults proto.InternalMessageInfo
+var xxx_messageInfo_UserEntity proto.InternalMessageInfo
 
-func (m *CalcDistanceResults) GetStatus() *commonpb.Status {
+func (m *UserEntity) GetName() string {
 	if m != nil {
-		return m.Status
+		return m.Name
 	}
-	return nil
+	return ""
 }
 
-type isCalcDistanceResults_Array interface {
-	isCalcDistanceResults_Array()
+type CreateRoleRequest struct {
+	// Not useful for now
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// role
+	Entity               *RoleEntity `protobuf:"bytes,2,opt,name=entity,proto3" json:"entity,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
-type CalcDistanceResults_IntDist struct {
-	IntDist *schemapb.IntArray `protobuf:"bytes,2,opt,name=int_dist,json=intDist,proto3,oneof"`
+func (m *CreateRoleRequest) Reset()         { *m = CreateRoleRequest{} }
+func (m *CreateRoleRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateRoleRequest) ProtoMessage()    {}
+func (*CreateRoleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{80}
 }
 
-type CalcDistanceResults_FloatDist struct {
-	FloatDist *schemapb.FloatArray `protobuf:"bytes,3,opt,name=float_dist,json=floatDist,proto3,oneof"`
+func (m *CreateRoleRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateRoleRequest.Unmarshal(m, b)
+}
+func (m *CreateRoleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateRoleRequest.Marshal(b, m, deterministic)
+}
+func (m *CreateRoleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateRoleRequest.Merge(m, src)
+}
+func (m *CreateRoleRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateRoleRequest.Size(m)
+}
+func (m *CreateRoleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateRoleRequest.DiscardUnknown(m)
 }
 
-func (*CalcDistanceResults_IntDist) isCalcDistanceResults_Array() {}
-
-func (*CalcDistanceResults_FloatDist) isCalcDistanceResults_Array() {}
+var xxx_messageInfo_CreateRoleRequest proto.InternalMessageInfo
 
-func (m *CalcDistanceResults) GetArray() isCalcDistanceResults_Array {
+func (m *CreateRoleRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
-		return m.Array
+		return m.Base
 	}
 	return nil
 }
 
-func (m *CalcDistanceResults) GetIntDist() *schemapb.IntArray {
-	if x, ok := m.GetArray().(*CalcDistanceResults_IntDist); ok {
-		return x.IntDist
+func (m *CreateRoleRequest) GetEntity() *RoleEntity {
+	if m != nil {
+		return m.Entity
 	}
 	return nil
 }
 
-func (m *CalcDistanceResults) GetFloatDist() *schemapb.FloatArray {
-	if x, ok := m.GetArray().(*CalcDistanceResults_FloatDist); ok {
-		return x.FloatDist
+type DropRoleRequest struct {
+	// Not useful for now
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// role name
+	RoleName             string   `protobuf:"bytes,2,opt,name=role_name,json=roleName,proto3" json:"role_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DropRoleRequest) Reset()         { *m = DropRoleRequest{} }
+func (m *DropRoleRequest) String() string { return proto.CompactTextString(m) }
+func (*DropRoleRequest) ProtoMessage()    {}
+func (*DropRoleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{81}
+}
+
+func (m *DropRoleRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DropRoleRequest.Unmarshal(m, b)
+}
+func (m *DropRoleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DropRoleRequest.Marshal(b, m, deterministic)
+}
+func (m *DropRoleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DropRoleRequest.Merge(m, src)
+}
+func (m *DropRoleRequest) XXX_Size() int {
+	return xxx_messageInfo_DropRoleRequest.Size(m)
+}
+func (m *DropRoleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DropRoleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DropRoleRequest proto.InternalMessageInfo
+
+func (m *DropRoleRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
 	}
 	return nil
 }
 
-// XXX_OneofWrappers is for the internal use of the proto package.
-func (*CalcDistanceResults) XXX_OneofWrappers() []interface{} {
-	return []interface{}{
-		(*CalcDistanceResults_IntDist)(nil),
-		(*CalcDistanceResults_FloatDist)(nil),
+func (m *DropRoleRequest) GetRoleName() string {
+	if m != nil {
+		return m.RoleName
 	}
+	return ""
 }
 
-type PersistentSegmentInfo struct {
-	SegmentID            int64                 `protobuf:"varint,1,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
-	CollectionID         int64                 `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
-	PartitionID          int64                 `protobuf:"varint,3,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
-	NumRows              int64                 `protobuf:"varint,4,opt,name=num_rows,json=numRows,proto3" json:"num_rows,omitempty"`
-	State                commonpb.SegmentState `protobuf:"varint,5,opt,name=state,proto3,enum=milvus.proto.common.SegmentState" json:"state,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
-	XXX_unrecognized     []byte                `json:"-"`
-	XXX_sizecache        int32                 `json:"-"`
+type OperateUserRoleRequest struct {
+	// Not useful for now
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// username
+	Username string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	// role name
+	RoleName string `protobuf:"bytes,3,opt,name=role_name,json=roleName,proto3" json:"role_name,omitempty"`
+	// operation type
+	Type                 OperateUserRoleType `protobuf:"varint,4,opt,name=type,proto3,enum=milvus.proto.milvus.OperateUserRoleType" json:"type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
 
-func (m *PersistentSegmentInfo) Reset()         { *m = PersistentSegmentInfo{} }
-func (m *PersistentSegmentInfo) String() string { return proto.CompactTextString(m) }
-func (*PersistentSegmentInfo) ProtoMessage()    {}
-func (*PersistentSegmentInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{54}
+func (m *OperateUserRoleRequest) Reset()         { *m = OperateUserRoleRequest{} }
+func (m *OperateUserRoleRequest) String() string { return proto.CompactTextString(m) }
+func (*OperateUserRoleRequest) ProtoMessage()    {}
+func (*OperateUserRoleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{82}
 }
 
-func (m *PersistentSegmentInfo) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PersistentSegmentInfo.Unmarshal(m, b)
+func (m *OperateUserRoleRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OperateUserRoleRequest.Unmarshal(m, b)
 }
-func (m *PersistentSegmentInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PersistentSegmentInfo.Marshal(b, m, deterministic)
+func (m *OperateUserRoleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OperateUserRoleRequest.Marshal(b, m, deterministic)
 }
-func (m *PersistentSegmentInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PersistentSegmentInfo.Merge(m, src)
+func (m *OperateUserRoleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OperateUserRoleRequest.Merge(m, src)
 }
-func (m *PersistentSegmentInfo) XXX_Size() int {
-	return xxx_messageInfo_PersistentSegmentInfo.Size(m)
+func (m *OperateUserRoleRequest) XXX_Size() int {
+	return xxx_messageInfo_OperateUserRoleRequest.Size(m)
 }
-func (m *PersistentSegmentInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_PersistentSegmentInfo.DiscardUnknown(m)
+func (m *OperateUserRoleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_OperateUserRoleRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PersistentSegmentInfo proto.InternalMessageInfo
-
-func (m *PersistentSegmentInfo) GetSegmentID() int64 {
-	if m != nil {
-		return m.SegmentID
-	}
-	return 0
-}
+var xxx_messageInfo_OperateUserRoleRequest proto.InternalMessageInfo
 
-func (m *PersistentSegmentInfo) GetCollectionID() int64 {
+func (m *OperateUserRoleRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
-		return m.CollectionID
+		return m.Base
 	}
-	return 0
+	return nil
 }
 
-func (m *PersistentSegmentInfo) GetPartitionID() int64 {
+func (m *OperateUserRoleRequest) GetUsername() string {
 	if m != nil {
-		return m.PartitionID
+		return m.Username
 	}
-	return 0
+	return ""
 }
 
-func (m *PersistentSegmentInfo) GetNumRows() int64 {
+func (m *OperateUserRoleRequest) GetRoleName() string {
 	if m != nil {
-		return m.NumRows
+		return m.RoleName
 	}
-	return 0
+	return ""
 }
 
-func (m *PersistentSegmentInfo) GetState() commonpb.SegmentState {
+func (m *OperateUserRoleRequest) GetType() OperateUserRoleType {
 	if m != nil {
-		return m.State
+		return m.Type
 	}
-	return commonpb.SegmentState_SegmentStateNone
+	return OperateUserRoleType_AddUserToRole
 }
 
-type GetPersistentSegmentInfoRequest struct {
-	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	DbName               string            `protobuf:"bytes,2,opt,name=dbName,proto3" json:"dbName,omitempty"`
-	CollectionName       string            `protobuf:"bytes,3,opt,name=collectionName,proto3" json:"collectionName,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+type SelectRoleRequest struct {
+	// Not useful for now
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// role, select all roles if it's empty
+	Role *RoleEntity `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	// include user info
+	IncludeUserInfo      bool     `protobuf:"varint,3,opt,name=include_user_info,json=includeUserInfo,proto3" json:"include_user_info,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetPersistentSegmentInfoRequest) Reset()         { *m = GetPersistentSegmentInfoRequest{} }
-func (m *GetPersistentSegmentInfoRequest) String() string { return proto.CompactTextString(m) }
-func (*GetPersistentSegmentInfoRequest) ProtoMessage()    {}
-func (*GetPersistentSegmentInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{55}
+func (m *SelectRoleRequest) Reset()         { *m = SelectRoleRequest{} }
+func (m *SelectRoleRequest) String() string { return proto.CompactTextString(m) }
+func (*SelectRoleRequest) ProtoMessage()    {}
+func (*SelectRoleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{83}
 }
 
-func (m *GetPersistentSegmentInfoRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetPersistentSegmentInfoRequest.Unmarshal(m, b)
+func (m *SelectRoleRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SelectRoleRequest.Unmarshal(m, b)
 }
-func (m *GetPersistentSegmentInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetPersistentSegmentInfoRequest.Marshal(b, m, deterministic)
+func (m *SelectRoleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SelectRoleRequest.Marshal(b, m, deterministic)
 }
-func (m *GetPersistentSegmentInfoRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetPersistentSegmentInfoRequest.Merge(m, src)
+func (m *SelectRoleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SelectRoleRequest.Merge(m, src)
 }
-func (m *GetPersistentSegmentInfoRequest) XXX_Size() int {
-	return xxx_messageInfo_GetPersistentSegmentInfoRequest.Size(m)
+func (m *SelectRoleRequest) XXX_Size() int {
+	return xxx_messageInfo_SelectRoleRequest.Size(m)
 }
-func (m *GetPersistentSegmentInfoRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetPersistentSegmentInfoRequest.DiscardUnknown(m)
+func (m *SelectRoleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SelectRoleRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetPersistentSegmentInfoRequest proto.InternalMessageInfo
+var xxx_messageInfo_SelectRoleRequest proto.InternalMessageInfo
 
-func (m *GetPersistentSegmentInfoRequest) GetBase() *commonpb.MsgBase {
+func (m *SelectRoleRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
 		return m.Base
 	}
 	return nil
 }
 
-func (m *GetPersistentSegmentInfoRequest) GetDbName() string {
+func (m *SelectRoleRequest) GetRole() *RoleEntity {
 	if m != nil {
-		return m.DbName
+		return m.Role
 	}
-	return ""
+	return nil
 }
 
-func (m *GetPersistentSegmentInfoRequest) GetCollectionName() string {
+func (m *SelectRoleRequest) GetIncludeUserInfo() bool {
 	if m != nil {
-		return m.CollectionName
+		return m.IncludeUserInfo
 	}
-	return ""
+	return false
 }
 
-type GetPersistentSegmentInfoResponse struct {
-	Status               *commonpb.Status         `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Infos                []*PersistentSegmentInfo `protobuf:"bytes,2,rep,name=infos,proto3" json:"infos,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
-	XXX_unrecognized     []byte                   `json:"-"`
-	XXX_sizecache        int32                    `json:"-"`
+type RoleResult struct {
+	Role                 *RoleEntity   `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	Users                []*UserEntity `protobuf:"bytes,2,rep,name=users,proto3" json:"users,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-func (m *GetPersistentSegmentInfoResponse) Reset()         { *m = GetPersistentSegmentInfoResponse{} }
-func (m *GetPersistentSegmentInfoResponse) String() string { return proto.CompactTextString(m) }
-func (*GetPersistentSegmentInfoResponse) ProtoMessage()    {}
-func (*GetPersistentSegmentInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{56}
+func (m *RoleResult) Reset()         { *m = RoleResult{} }
+func (m *RoleResult) String() string { return proto.CompactTextString(m) }
+func (*RoleResult) ProtoMessage()    {}
+func (*RoleResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{84}
 }
 
-func (m *GetPersistentSegmentInfoResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetPersistentSegmentInfoResponse.Unmarshal(m, b)
+func (m *RoleResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RoleResult.Unmarshal(m, b)
 }
-func (m *GetPersistentSegmentInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetPersistentSegmentInfoResponse.Marshal(b, m, deterministic)
+func (m *RoleResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RoleResult.Marshal(b, m, deterministic)
 }
-func (m *GetPersistentSegmentInfoResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetPersistentSegmentInfoResponse.Merge(m, src)
+func (m *RoleResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoleResult.Merge(m, src)
 }
-func (m *GetPersistentSegmentInfoResponse) XXX_Size() int {
-	return xxx_messageInfo_GetPersistentSegmentInfoResponse.Size(m)
+func (m *RoleResult) XXX_Size() int {
+	return xxx_messageInfo_RoleResult.Size(m)
 }
-func (m *GetPersistentSegmentInfoResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetPersistentSegmentInfoResponse.DiscardUnknown(m)
+func (m *RoleResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoleResult.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetPersistentSegmentInfoResponse proto.InternalMessageInfo
+var xxx_messageInfo_RoleResult proto.InternalMessageInfo
 
-func (m *GetPersistentSegmentInfoResponse) GetStatus() *commonpb.Status {
+func (m *RoleResult) GetRole() *RoleEntity {
 	if m != nil {
-		return m.Status
+		return m.Role
 	}
 	return nil
 }
 
-func (m *GetPersistentSegmentInfoResponse) GetInfos() []*PersistentSegmentInfo {
+func (m *RoleResult) GetUsers() []*UserEntity {
 	if m != nil {
-		return m.Infos
+		return m.Users
 	}
 	return nil
 }
 
-type QuerySegmentInfo struct {
-	SegmentID            int64    `protobuf:"varint,1,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
-	CollectionID         int64    `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
-	PartitionID          int64    `protobuf:"varint,3,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
-	MemSize              int64    `protobuf:"varint,4,opt,name=mem_size,json=memSize,proto3" json:"mem_size,omitempty"`
-	NumRows              int64    `protobuf:"varint,5,opt,name=num_rows,json=numRows,proto3" json:"num_rows,omitempty"`
-	IndexName            string   `protobuf:"bytes,6,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
-	IndexID              int64    `protobuf:"varint,7,opt,name=indexID,proto3" json:"indexID,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type SelectRoleResponse struct {
+	// Not useful for now
+	Status *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// role result array
+	Results              []*RoleResult `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-func (m *QuerySegmentInfo) Reset()         { *m = QuerySegmentInfo{} }
-func (m *QuerySegmentInfo) String() string { return proto.CompactTextString(m) }
-func (*QuerySegmentInfo) ProtoMessage()    {}
-func (*QuerySegmentInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{57}
+func (m *SelectRoleResponse) Reset()         { *m = SelectRoleResponse{} }
+func (m *SelectRoleResponse) String() string { return proto.CompactTextString(m) }
+func (*SelectRoleResponse) ProtoMessage()    {}
+func (*SelectRoleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{85}
 }
 
-func (m *QuerySegmentInfo) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_QuerySegmentInfo.Unmarshal(m, b)
+func (m *SelectRoleResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SelectRoleResponse.Unmarshal(m, b)
 }
-func (m *QuerySegmentInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_QuerySegmentInfo.Marshal(b, m, deterministic)
+func (m *SelectRoleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SelectRoleResponse.Marshal(b, m, deterministic)
 }
-func (m *QuerySegmentInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QuerySegmentInfo.Merge(m, src)
+func (m *SelectRoleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SelectRoleResponse.Merge(m, src)
 }
-func (m *QuerySegmentInfo) XXX_Size() int {
-	return xxx_messageInfo_QuerySegmentInfo.Size(m)
+func (m *SelectRoleResponse) XXX_Size() int {
+	return xxx_messageInfo_SelectRoleResponse.Size(m)
 }
-func (m *QuerySegmentInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_QuerySegmentInfo.DiscardUnknown(m)
+func (m *SelectRoleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SelectRoleResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QuerySegmentInfo proto.InternalMessageInfo
+var xxx_messageInfo_SelectRoleResponse proto.InternalMessageInfo
 
-func (m *QuerySegmentInfo) GetSegmentID() int64 {
+func (m *SelectRoleResponse) GetStatus() *commonpb.Status {
 	if m != nil {
-		return m.SegmentID
+		return m.Status
 	}
-	return 0
+	return nil
 }
 
-func (m *QuerySegmentInfo) GetCollectionID() int64 {
+func (m *SelectRoleResponse) GetResults() []*RoleResult {
 	if m != nil {
-		return m.CollectionID
+		return m.Results
 	}
-	return 0
+	return nil
 }
 
-func (m *QuerySegmentInfo) GetPartitionID() int64 {
-	if m != nil {
-		return m.PartitionID
-	}
-	return 0
+type SelectUserRequest struct {
+	// Not useful for now
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// user, select all users if it's empty
+	User *UserEntity `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	// include role info
+	IncludeRoleInfo      bool     `protobuf:"varint,3,opt,name=include_role_info,json=includeRoleInfo,proto3" json:"include_role_info,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *QuerySegmentInfo) GetMemSize() int64 {
-	if m != nil {
-		return m.MemSize
-	}
-	return 0
+func (m *SelectUserRequest) Reset()         { *m = SelectUserRequest{} }
+func (m *SelectUserRequest) String() string { return proto.CompactTextString(m) }
+func (*SelectUserRequest) ProtoMessage()    {}
+func (*SelectUserRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{86}
 }
 
-func (m *QuerySegmentInfo) GetNumRows() int64 {
+func (m *SelectUserRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SelectUserRequest.Unmarshal(m, b)
+}
+func (m *SelectUserRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SelectUserRequest.Marshal(b, m, deterministic)
+}
+func (m *SelectUserRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SelectUserRequest.Merge(m, src)
+}
+func (m *SelectUserRequest) XXX_Size() int {
+	return xxx_messageInfo_SelectUserRequest.Size(m)
+}
+func (m *SelectUserRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SelectUserRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SelectUserRequest proto.InternalMessageInfo
+
+func (m *SelectUserRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
-		return m.NumRows
+		return m.Base
 	}
-	return 0
+	return nil
 }
 
-func (m *QuerySegmentInfo) GetIndexName() string {
+func (m *SelectUserRequest) GetUser() *UserEntity {
 	if m != nil {
-		return m.IndexName
+		return m.User
 	}
-	return ""
+	return nil
 }
 
-func (m *QuerySegmentInfo) GetIndexID() int64 {
+func (m *SelectUserRequest) GetIncludeRoleInfo() bool {
 	if m != nil {
-		return m.IndexID
+		return m.IncludeRoleInfo
 	}
-	return 0
+	return false
 }
 
-type GetQuerySegmentInfoRequest struct {
-	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	DbName               string            `protobuf:"bytes,2,opt,name=dbName,proto3" json:"dbName,omitempty"`
-	CollectionName       string            `protobuf:"bytes,3,opt,name=collectionName,proto3" json:"collectionName,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+type UserResult struct {
+	User                 *UserEntity   `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Roles                []*RoleEntity `protobuf:"bytes,2,rep,name=roles,proto3" json:"roles,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-func (m *GetQuerySegmentInfoRequest) Reset()         { *m = GetQuerySegmentInfoRequest{} }
-func (m *GetQuerySegmentInfoRequest) String() string { return proto.CompactTextString(m) }
-func (*GetQuerySegmentInfoRequest) ProtoMessage()    {}
-func (*GetQuerySegmentInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{58}
+func (m *UserResult) Reset()         { *m = UserResult{} }
+func (m *UserResult) String() string { return proto.CompactTextString(m) }
+func (*UserResult) ProtoMessage()    {}
+func (*UserResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{87}
 }
 
-func (m *GetQuerySegmentInfoRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetQuerySegmentInfoRequest.Unmarshal(m, b)
+func (m *UserResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UserResult.Unmarshal(m, b)
 }
-func (m *GetQuerySegmentInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetQuerySegmentInfoRequest.Marshal(b, m, deterministic)
+func (m *UserResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UserResult.Marshal(b, m, deterministic)
 }
-func (m *GetQuerySegmentInfoRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetQuerySegmentInfoRequest.Merge(m, src)
+func (m *UserResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserResult.Merge(m, src)
 }
-func (m *GetQuerySegmentInfoRequest) XXX_Size() int {
-	return xxx_messageInfo_GetQuerySegmentInfoRequest.Size(m)
+func (m *UserResult) XXX_Size() int {
+	return xxx_messageInfo_UserResult.Size(m)
 }
-func (m *GetQuerySegmentInfoRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetQuerySegmentInfoRequest.DiscardUnknown(m)
+func (m *UserResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserResult.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetQuerySegmentInfoRequest proto.InternalMessageInfo
+var xxx_messageInfo_UserResult proto.InternalMessageInfo
 
-func (m *GetQuerySegmentInfoRequest) GetBase() *commonpb.MsgBase {
+func (m *UserResult) GetUser() *UserEntity {
 	if m != nil {
-		return m.Base
+		return m.User
 	}
 	return nil
 }
 
-func (m *GetQuerySegmentInfoRequest) GetDbName() string {
-	if m != nil {
-		return m.DbName
-	}
-	return ""
-}
-
-func (m *GetQuerySegmentInfoRequest) GetCollectionName() string {
+func (m *UserResult) GetRoles() []*RoleEntity {
 	if m != nil {
-		return m.CollectionName
+		return m.Roles
 	}
-	return ""
+	return nil
 }
 
-type GetQuerySegmentInfoResponse struct {
-	Status               *commonpb.Status    `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Infos                []*QuerySegmentInfo `protobuf:"bytes,2,rep,name=infos,proto3" json:"infos,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
-	XXX_unrecognized     []byte              `json:"-"`
-	XXX_sizecache        int32               `json:"-"`
+type SelectUserResponse struct {
+	// Not useful for now
+	Status *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// user result array
+	Results              []*UserResult `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-func (m *GetQuerySegmentInfoResponse) Reset()         { *m = GetQuerySegmentInfoResponse{} }
-func (m *GetQuerySegmentInfoResponse) String() string { return proto.CompactTextString(m) }
-func (*GetQuerySegmentInfoResponse) ProtoMessage()    {}
-func (*GetQuerySegmentInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{59}
+func (m *SelectUserResponse) Reset()         { *m = SelectUserResponse{} }
+func (m *SelectUserResponse) String() string { return proto.CompactTextString(m) }
+func (*SelectUserResponse) ProtoMessage()    {}
+func (*SelectUserResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{88}
 }
 
-func (m *GetQuerySegmentInfoResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetQuerySegmentInfoResponse.Unmarshal(m, b)
+func (m *SelectUserResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SelectUserResponse.Unmarshal(m, b)
 }
-func (m *GetQuerySegmentInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetQuerySegmentInfoResponse.Marshal(b, m, deterministic)
+func (m *SelectUserResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SelectUserResponse.Marshal(b, m, deterministic)
 }
-func (m *GetQuerySegmentInfoResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetQuerySegmentInfoResponse.Merge(m, src)
+func (m *SelectUserResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SelectUserResponse.Merge(m, src)
 }
-func (m *GetQuerySegmentInfoResponse) XXX_Size() int {
-	return xxx_messageInfo_GetQuerySegmentInfoResponse.Size(m)
+func (m *SelectUserResponse) XXX_Size() int {
+	return xxx_messageInfo_SelectUserResponse.Size(m)
 }
-func (m *GetQuerySegmentInfoResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetQuerySegmentInfoResponse.DiscardUnknown(m)
+func (m *SelectUserResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SelectUserResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetQuerySegmentInfoResponse proto.InternalMessageInfo
+var xxx_messageInfo_SelectUserResponse proto.InternalMessageInfo
 
-func (m *GetQuerySegmentInfoResponse) GetStatus() *commonpb.Status {
+func (m *SelectUserResponse) GetStatus() *commonpb.Status {
 	if m != nil {
 		return m.Status
 	}
 	return nil
 }
 
-func (m *GetQuerySegmentInfoResponse) GetInfos() []*QuerySegmentInfo {
+func (m *SelectUserResponse) GetResults() []*UserResult {
 	if m != nil {
-		return m.Infos
+		return m.Results
 	}
 	return nil
 }
 
-type DummyRequest struct {
-	RequestType          string   `protobuf:"bytes,1,opt,name=request_type,json=requestType,proto3" json:"request_type,omitempty"`
+type ObjectEntity struct {
+	// name of common.ObjectType
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DummyRequest) Reset()         { *m = DummyRequest{} }
-func (m *DummyRequest) String() string { return proto.CompactTextString(m) }
-func (*DummyRequest) ProtoMessage()    {}
-func (*DummyRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{60}
+func (m *ObjectEntity) Reset()         { *m = ObjectEntity{} }
+func (m *ObjectEntity) String() string { return proto.CompactTextString(m) }
+func (*ObjectEntity) ProtoMessage()    {}
+func (*ObjectEntity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{89}
 }
 
-func (m *DummyRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DummyRequest.Unmarshal(m, b)
+func (m *ObjectEntity) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObjectEntity.Unmarshal(m, b)
 }
-func (m *DummyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DummyRequest.Marshal(b, m, deterministic)
+func (m *ObjectEntity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObjectEntity.Marshal(b, m, deterministic)
 }
-func (m *DummyRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DummyRequest.Merge(m, src)
+func (m *ObjectEntity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectEntity.Merge(m, src)
 }
-func (m *DummyRequest) XXX_Size() int {
-	return xxx_messageInfo_DummyRequest.Size(m)
+func (m *ObjectEntity) XXX_Size() int {
+	return xxx_messageInfo_ObjectEntity.Size(m)
 }
-func (m *DummyRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DummyRequest.DiscardUnknown(m)
+func (m *ObjectEntity) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectEntity.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DummyRequest proto.InternalMessageInfo
+var xxx_messageInfo_ObjectEntity proto.InternalMessageInfo
 
-func (m *DummyRequest) GetRequestType() string {
+func (m *ObjectEntity) GetName() string {
 	if m != nil {
-		return m.RequestType
+		return m.Name
 	}
 	return ""
 }
 
-type DummyResponse struct {
-	Response             string   `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+type PrivilegeEntity struct {
+	// name of common.ObjectPrivilege
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DummyResponse) Reset()         { *m = DummyResponse{} }
-func (m *DummyResponse) String() string { return proto.CompactTextString(m) }
-func (*DummyResponse) ProtoMessage()    {}
-func (*DummyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{61}
+func (m *PrivilegeEntity) Reset()         { *m = PrivilegeEntity{} }
+func (m *PrivilegeEntity) String() string { return proto.CompactTextString(m) }
+func (*PrivilegeEntity) ProtoMessage()    {}
+func (*PrivilegeEntity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{90}
 }
 
-func (m *DummyResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DummyResponse.Unmarshal(m, b)
+func (m *PrivilegeEntity) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PrivilegeEntity.Unmarshal(m, b)
 }
-func (m *DummyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DummyResponse.Marshal(b, m, deterministic)
+func (m *PrivilegeEntity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PrivilegeEntity.Marshal(b, m, deterministic)
 }
-func (m *DummyResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DummyResponse.Merge(m, src)
+func (m *PrivilegeEntity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrivilegeEntity.Merge(m, src)
 }
-func (m *DummyResponse) XXX_Size() int {
-	return xxx_messageInfo_DummyResponse.Size(m)
+func (m *PrivilegeEntity) XXX_Size() int {
+	return xxx_messageInfo_PrivilegeEntity.Size(m)
 }
-func (m *DummyResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_DummyResponse.DiscardUnknown(m)
+func (m *PrivilegeEntity) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrivilegeEntity.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DummyResponse proto.InternalMessageInfo
+var xxx_messageInfo_PrivilegeEntity proto.InternalMessageInfo
 
-func (m *DummyResponse) GetResponse() string {
+func (m *PrivilegeEntity) GetName() string {
 	if m != nil {
-		return m.Response
+		return m.Name
 	}
 	return ""
 }
 
-type RegisterLinkRequest struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type GrantEntity struct {
+	// role
+	Role *RoleEntity `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	// object type
+	Object *ObjectEntity `protobuf:"bytes,2,opt,name=object,proto3" json:"object,omitempty"`
+	// object name, "*" means all objects of the type
+	ObjectName string `protobuf:"bytes,3,opt,name=object_name,json=objectName,proto3" json:"object_name,omitempty"`
+	// privilege
+	Privilege            *PrivilegeEntity `protobuf:"bytes,4,opt,name=privilege,proto3" json:"privilege,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
-func (m *RegisterLinkRequest) Reset()         { *m = RegisterLinkRequest{} }
-func (m *RegisterLinkRequest) String() string { return proto.CompactTextString(m) }
-func (*RegisterLinkRequest) ProtoMessage()    {}
-func (*RegisterLinkRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{62}
+func (m *GrantEntity) Reset()         { *m = GrantEntity{} }
+func (m *GrantEntity) String() string { return proto.CompactTextString(m) }
+func (*GrantEntity) ProtoMessage()    {}
+func (*GrantEntity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{91}
 }
 
-func (m *RegisterLinkRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_RegisterLinkRequest.Unmarshal(m, b)
+func (m *GrantEntity) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GrantEntity.Unmarshal(m, b)
 }
-func (m *RegisterLinkRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_RegisterLinkRequest.Marshal(b, m, deterministic)
+func (m *GrantEntity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GrantEntity.Marshal(b, m, deterministic)
 }
-func (m *RegisterLinkRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RegisterLinkRequest.Merge(m, src)
+func (m *GrantEntity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GrantEntity.Merge(m, src)
 }
-func (m *RegisterLinkRequest) XXX_Size() int {
-	return xxx_messageInfo_RegisterLinkRequest.Size(m)
+func (m *GrantEntity) XXX_Size() int {
+	return xxx_messageInfo_GrantEntity.Size(m)
 }
-func (m *RegisterLinkRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_RegisterLinkRequest.DiscardUnknown(m)
+func (m *GrantEntity) XXX_DiscardUnknown() {
+	xxx_messageInfo_GrantEntity.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RegisterLinkRequest proto.InternalMessageInfo
+var xxx_messageInfo_GrantEntity proto.InternalMessageInfo
 
-type RegisterLinkResponse struct {
-	Address              *commonpb.Address `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Status               *commonpb.Status  `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+func (m *GrantEntity) GetRole() *RoleEntity {
+	if m != nil {
+		return m.Role
+	}
+	return nil
 }
 
-func (m *RegisterLinkResponse) Reset()         { *m = RegisterLinkResponse{} }
-func (m *RegisterLinkResponse) String() string { return proto.CompactTextString(m) }
-func (*RegisterLinkResponse) ProtoMessage()    {}
-func (*RegisterLinkResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{63}
+func (m *GrantEntity) GetObject() *ObjectEntity {
+	if m != nil {
+		return m.Object
+	}
+	return nil
 }
 
-func (m *RegisterLinkResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_RegisterLinkResponse.Unmarshal(m, b)
+func (m *GrantEntity) GetObjectName() string {
+	if m != nil {
+		return m.ObjectName
+	}
+	return ""
 }
-func (m *RegisterLinkResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_RegisterLinkResponse.Marshal(b, m, deterministic)
+
+func (m *GrantEntity) GetPrivilege() *PrivilegeEntity {
+	if m != nil {
+		return m.Privilege
+	}
+	return nil
 }
-func (m *RegisterLinkResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RegisterLinkResponse.Merge(m, src)
+
+type SelectGrantRequest struct {
+	// Not useful for now
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// grant, role is required, object and object name are optional filters
+	Entity               *GrantEntity `protobuf:"bytes,2,opt,name=entity,proto3" json:"entity,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
-func (m *RegisterLinkResponse) XXX_Size() int {
-	return xxx_messageInfo_RegisterLinkResponse.Size(m)
+
+func (m *SelectGrantRequest) Reset()         { *m = SelectGrantRequest{} }
+func (m *SelectGrantRequest) String() string { return proto.CompactTextString(m) }
+func (*SelectGrantRequest) ProtoMessage()    {}
+func (*SelectGrantRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{92}
 }
-func (m *RegisterLinkResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_RegisterLinkResponse.DiscardUnknown(m)
+
+func (m *SelectGrantRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SelectGrantRequest.Unmarshal(m, b)
+}
+func (m *SelectGrantRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SelectGrantRequest.Marshal(b, m, deterministic)
+}
+func (m *SelectGrantRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SelectGrantRequest.Merge(m, src)
+}
+func (m *SelectGrantRequest) XXX_Size() int {
+	return xxx_messageInfo_SelectGrantRequest.Size(m)
+}
+func (m *SelectGrantRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SelectGrantRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RegisterLinkResponse proto.InternalMessageInfo
+var xxx_messageInfo_SelectGrantRequest proto.InternalMessageInfo
 
-func (m *RegisterLinkResponse) GetAddress() *commonpb.Address {
+func (m *SelectGrantRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
-		return m.Address
+		return m.Base
 	}
 	return nil
 }
 
-func (m *RegisterLinkResponse) GetStatus() *commonpb.Status {
+func (m *SelectGrantRequest) GetEntity() *GrantEntity {
 	if m != nil {
-		return m.Status
+		return m.Entity
 	}
 	return nil
 }
 
-type GetMetricsRequest struct {
-	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	Request              string            `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+type SelectGrantResponse struct {
+	// Not useful for now
+	Status *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// grant info array
+	Entities             []*GrantEntity `protobuf:"bytes,2,rep,name=entities,proto3" json:"entities,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
-func (m *GetMetricsRequest) Reset()         { *m = GetMetricsRequest{} }
-func (m *GetMetricsRequest) String() string { return proto.CompactTextString(m) }
-func (*GetMetricsRequest) ProtoMessage()    {}
-func (*GetMetricsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{64}
+func (m *SelectGrantResponse) Reset()         { *m = SelectGrantResponse{} }
+func (m *SelectGrantResponse) String() string { return proto.CompactTextString(m) }
+func (*SelectGrantResponse) ProtoMessage()    {}
+func (*SelectGrantResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{93}
 }
 
-func (m *GetMetricsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetMetricsRequest.Unmarshal(m, b)
+func (m *SelectGrantResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SelectGrantResponse.Unmarshal(m, b)
 }
-func (m *GetMetricsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetMetricsRequest.Marshal(b, m, deterministic)
+func (m *SelectGrantResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SelectGrantResponse.Marshal(b, m, deterministic)
 }
-func (m *GetMetricsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetMetricsRequest.Merge(m, src)
+func (m *SelectGrantResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SelectGrantResponse.Merge(m, src)
 }
-func (m *GetMetricsRequest) XXX_Size() int {
-	return xxx_messageInfo_GetMetricsRequest.Size(m)
+func (m *SelectGrantResponse) XXX_Size() int {
+	return xxx_messageInfo_SelectGrantResponse.Size(m)
 }
-func (m *GetMetricsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetMetricsRequest.DiscardUnknown(m)
+func (m *SelectGrantResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SelectGrantResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetMetricsRequest proto.InternalMessageInfo
+var xxx_messageInfo_SelectGrantResponse proto.InternalMessageInfo
 
-func (m *GetMetricsRequest) GetBase() *commonpb.MsgBase {
+func (m *SelectGrantResponse) GetStatus() *commonpb.Status {
 	if m != nil {
-		return m.Base
+		return m.Status
 	}
 	return nil
 }
 
-func (m *GetMetricsRequest) GetRequest() string {
+func (m *SelectGrantResponse) GetEntities() []*GrantEntity {
 	if m != nil {
-		return m.Request
+		return m.Entities
 	}
-	return ""
+	return nil
 }
 
-type GetMetricsResponse struct {
-	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Response             string           `protobuf:"bytes,2,opt,name=response,proto3" json:"response,omitempty"`
-	ComponentName        string           `protobuf:"bytes,3,opt,name=component_name,json=componentName,proto3" json:"component_name,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+type OperatePrivilegeRequest struct {
+	// Not useful for now
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// grant
+	Entity *GrantEntity `protobuf:"bytes,2,opt,name=entity,proto3" json:"entity,omitempty"`
+	// operation type
+	Type                 OperatePrivilegeType `protobuf:"varint,3,opt,name=type,proto3,enum=milvus.proto.milvus.OperatePrivilegeType" json:"type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
-func (m *GetMetricsResponse) Reset()         { *m = GetMetricsResponse{} }
-func (m *GetMetricsResponse) String() string { return proto.CompactTextString(m) }
-func (*GetMetricsResponse) ProtoMessage()    {}
-func (*GetMetricsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{65}
+func (m *OperatePrivilegeRequest) Reset()         { *m = OperatePrivilegeRequest{} }
+func (m *OperatePrivilegeRequest) String() string { return proto.CompactTextString(m) }
+func (*OperatePrivilegeRequest) ProtoMessage()    {}
+func (*OperatePrivilegeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{94}
 }
 
-func (m *GetMetricsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetMetricsResponse.Unmarshal(m, b)
+func (m *OperatePrivilegeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OperatePrivilegeRequest.Unmarshal(m, b)
 }
-func (m *GetMetricsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetMetricsResponse.Marshal(b, m, deterministic)
+func (m *OperatePrivilegeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OperatePrivilegeRequest.Marshal(b, m, deterministic)
 }
-func (m *GetMetricsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetMetricsResponse.Merge(m, src)
+func (m *OperatePrivilegeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OperatePrivilegeRequest.Merge(m, src)
 }
-func (m *GetMetricsResponse) XXX_Size() int {
-	return xxx_messageInfo_GetMetricsResponse.Size(m)
+func (m *OperatePrivilegeRequest) XXX_Size() int {
+	return xxx_messageInfo_OperatePrivilegeRequest.Size(m)
 }
-func (m *GetMetricsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetMetricsResponse.DiscardUnknown(m)
+func (m *OperatePrivilegeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_OperatePrivilegeRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetMetricsResponse proto.InternalMessageInfo
+var xxx_messageInfo_OperatePrivilegeRequest proto.InternalMessageInfo
 
-func (m *GetMetricsResponse) GetStatus() *commonpb.Status {
+func (m *OperatePrivilegeRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
-		return m.Status
+		return m.Base
 	}
 	return nil
 }
 
-func (m *GetMetricsResponse) GetResponse() string {
+func (m *OperatePrivilegeRequest) GetEntity() *GrantEntity {
 	if m != nil {
-		return m.Response
+		return m.Entity
 	}
-	return ""
+	return nil
 }
 
-func (m *GetMetricsResponse) GetComponentName() string {
+func (m *OperatePrivilegeRequest) GetType() OperatePrivilegeType {
 	if m != nil {
-		return m.ComponentName
+		return m.Type
 	}
-	return ""
+	return OperatePrivilegeType_Grant
 }
 
 func init() {
 	proto.RegisterEnum("milvus.proto.milvus.ShowType", ShowType_name, ShowType_value)
 	proto.RegisterEnum("milvus.proto.milvus.PlaceholderType", PlaceholderType_name, PlaceholderType_value)
+	proto.RegisterEnum("milvus.proto.milvus.OperateUserRoleType", OperateUserRoleType_name, OperateUserRoleType_value)
+	proto.RegisterEnum("milvus.proto.milvus.OperatePrivilegeType", OperatePrivilegeType_name, OperatePrivilegeType_value)
 	proto.RegisterType((*CreateAliasRequest)(nil), "milvus.proto.milvus.CreateAliasRequest")
 	proto.RegisterType((*DropAliasRequest)(nil), "milvus.proto.milvus.DropAliasRequest")
 	proto.RegisterType((*AlterAliasRequest)(nil), "milvus.proto.milvus.AlterAliasRequest")
@@ -4336,6 +6132,13 @@ func init() {
 	proto.RegisterType((*GetIndexStateRequest)(nil), "milvus.proto.milvus.GetIndexStateRequest")
 	proto.RegisterType((*GetIndexStateResponse)(nil), "milvus.proto.milvus.GetIndexStateResponse")
 	proto.RegisterType((*DropIndexRequest)(nil), "milvus.proto.milvus.DropIndexRequest")
+	proto.RegisterType((*AlterIndexRequest)(nil), "milvus.proto.milvus.AlterIndexRequest")
+	proto.RegisterType((*GetIndexStatisticsRequest)(nil), "milvus.proto.milvus.GetIndexStatisticsRequest")
+	proto.RegisterType((*SegmentIndexStatistics)(nil), "milvus.proto.milvus.SegmentIndexStatistics")
+	proto.RegisterType((*GetIndexStatisticsResponse)(nil), "milvus.proto.milvus.GetIndexStatisticsResponse")
+	proto.RegisterType((*EstimateIndexRecallRequest)(nil), "milvus.proto.milvus.EstimateIndexRecallRequest")
+	proto.RegisterType((*SegmentIndexRecall)(nil), "milvus.proto.milvus.SegmentIndexRecall")
+	proto.RegisterType((*EstimateIndexRecallResponse)(nil), "milvus.proto.milvus.EstimateIndexRecallResponse")
 	proto.RegisterType((*InsertRequest)(nil), "milvus.proto.milvus.InsertRequest")
 	proto.RegisterType((*MutationResult)(nil), "milvus.proto.milvus.MutationResult")
 	proto.RegisterType((*DeleteRequest)(nil), "milvus.proto.milvus.DeleteRequest")
@@ -4365,209 +6168,300 @@ func init() {
 	proto.RegisterType((*RegisterLinkResponse)(nil), "milvus.proto.milvus.RegisterLinkResponse")
 	proto.RegisterType((*GetMetricsRequest)(nil), "milvus.proto.milvus.GetMetricsRequest")
 	proto.RegisterType((*GetMetricsResponse)(nil), "milvus.proto.milvus.GetMetricsResponse")
+	proto.RegisterType((*CreateCredentialRequest)(nil), "milvus.proto.milvus.CreateCredentialRequest")
+	proto.RegisterType((*UpdateCredentialRequest)(nil), "milvus.proto.milvus.UpdateCredentialRequest")
+	proto.RegisterType((*DeleteCredentialRequest)(nil), "milvus.proto.milvus.DeleteCredentialRequest")
+	proto.RegisterType((*ListCredUsersResponse)(nil), "milvus.proto.milvus.ListCredUsersResponse")
+	proto.RegisterType((*ListCredUsersRequest)(nil), "milvus.proto.milvus.ListCredUsersRequest")
+	proto.RegisterType((*RoleEntity)(nil), "milvus.proto.milvus.RoleEntity")
+	proto.RegisterType((*UserEntity)(nil), "milvus.proto.milvus.UserEntity")
+	proto.RegisterType((*CreateRoleRequest)(nil), "milvus.proto.milvus.CreateRoleRequest")
+	proto.RegisterType((*DropRoleRequest)(nil), "milvus.proto.milvus.DropRoleRequest")
+	proto.RegisterType((*OperateUserRoleRequest)(nil), "milvus.proto.milvus.OperateUserRoleRequest")
+	proto.RegisterType((*SelectRoleRequest)(nil), "milvus.proto.milvus.SelectRoleRequest")
+	proto.RegisterType((*RoleResult)(nil), "milvus.proto.milvus.RoleResult")
+	proto.RegisterType((*SelectRoleResponse)(nil), "milvus.proto.milvus.SelectRoleResponse")
+	proto.RegisterType((*SelectUserRequest)(nil), "milvus.proto.milvus.SelectUserRequest")
+	proto.RegisterType((*UserResult)(nil), "milvus.proto.milvus.UserResult")
+	proto.RegisterType((*SelectUserResponse)(nil), "milvus.proto.milvus.SelectUserResponse")
+	proto.RegisterType((*ObjectEntity)(nil), "milvus.proto.milvus.ObjectEntity")
+	proto.RegisterType((*PrivilegeEntity)(nil), "milvus.proto.milvus.PrivilegeEntity")
+	proto.RegisterType((*GrantEntity)(nil), "milvus.proto.milvus.GrantEntity")
+	proto.RegisterType((*SelectGrantRequest)(nil), "milvus.proto.milvus.SelectGrantRequest")
+	proto.RegisterType((*SelectGrantResponse)(nil), "milvus.proto.milvus.SelectGrantResponse")
+	proto.RegisterType((*OperatePrivilegeRequest)(nil), "milvus.proto.milvus.OperatePrivilegeRequest")
 }
 
 func init() { proto.RegisterFile("milvus.proto", fileDescriptor_02345ba45cc0e303) }
 
 var fileDescriptor_02345ba45cc0e303 = []byte{
-	// 3150 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x1b, 0x4d, 0x6f, 0x1b, 0xc7,
-	0x55, 0xcb, 0x6f, 0x3e, 0x2e, 0x25, 0x6a, 0xf4, 0x61, 0x86, 0xb1, 0x63, 0x69, 0x53, 0x27, 0xb2,
-	0x9d, 0xd8, 0xb1, 0x9c, 0x34, 0x69, 0xd2, 0x36, 0xb1, 0xad, 0xc6, 0x16, 0x62, 0xa7, 0xca, 0x2a,
-	0x09, 0x90, 0x04, 0xc1, 0x62, 0xc5, 0x1d, 0x91, 0x0b, 0x2d, 0x77, 0xd9, 0x9d, 0xa1, 0x65, 0xe6,
-	0x54, 0x20, 0x49, 0x81, 0x22, 0x69, 0x82, 0xa2, 0x45, 0x8b, 0x5e, 0xdb, 0xe6, 0xd0, 0x5b, 0xd3,
-	0x16, 0x68, 0xd1, 0x43, 0xd1, 0x43, 0x0e, 0x3d, 0x14, 0xe8, 0x07, 0xd0, 0x7b, 0x2f, 0x3d, 0xe6,
-	0x1f, 0xf4, 0x50, 0xcc, 0xcc, 0xee, 0x72, 0x97, 0x9c, 0xa5, 0x28, 0x33, 0xa9, 0xa4, 0x1b, 0xf7,
-	0xcd, 0x7b, 0x6f, 0xde, 0xbc, 0x79, 0xf3, 0xe6, 0xcd, 0x7b, 0x8f, 0xa0, 0x76, 0x6c, 0xe7, 0x6e,
-	0x8f, 0x5c, 0xea, 0xfa, 0x1e, 0xf5, 0xd0, 0x42, 0xfc, 0xeb, 0x92, 0xf8, 0x68, 0xa8, 0x4d, 0xaf,
-	0xd3, 0xf1, 0x5c, 0x01, 0x6c, 0xa8, 0xa4, 0xd9, 0xc6, 0x1d, 0x53, 0x7c, 0x69, 0xef, 0x2b, 0x80,
-	0x6e, 0xf8, 0xd8, 0xa4, 0xf8, 0x9a, 0x63, 0x9b, 0x44, 0xc7, 0xdf, 0xe9, 0x61, 0x42, 0xd1, 0x13,
-	0x90, 0xdb, 0x31, 0x09, 0xae, 0x2b, 0x2b, 0xca, 0x5a, 0x65, 0xfd, 0xf4, 0xa5, 0x04, 0xdb, 0x80,
-	0xdd, 0x1d, 0xd2, 0xba, 0x6e, 0x12, 0xac, 0x73, 0x4c, 0xf4, 0x28, 0xcc, 0x35, 0x3d, 0xc7, 0xc1,
-	0x4d, 0x6a, 0x7b, 0xae, 0xe1, 0x9a, 0x1d, 0x5c, 0xcf, 0xac, 0x28, 0x6b, 0x65, 0x7d, 0x76, 0x00,
-	0x7e, 0xd9, 0xec, 0x60, 0xb4, 0x08, 0x79, 0x93, 0x4d, 0x55, 0xcf, 0xf2, 0x61, 0xf1, 0xa1, 0xbd,
-	0x09, 0xb5, 0x0d, 0xdf, 0xeb, 0x4e, 0x29, 0x44, 0xc4, 0x3b, 0x13, 0xe7, 0xfd, 0x9e, 0x02, 0xf3,
-	0xd7, 0x1c, 0x8a, 0xfd, 0xa3, 0x5d, 0xe2, 0x67, 0x0a, 0x9c, 0x12, 0xaa, 0xbe, 0x11, 0xa1, 0xdf,
-	0xbf, 0x30, 0xa7, 0xa0, 0x68, 0xed, 0xc4, 0x85, 0x28, 0x58, 0x3b, 0x7c, 0x72, 0x89, 0x94, 0x59,
-	0xa9, 0x94, 0xcb, 0x50, 0x10, 0xa6, 0x50, 0xcf, 0xad, 0x28, 0x6b, 0xaa, 0x1e, 0x7c, 0xa1, 0x33,
-	0x00, 0xa4, 0x6d, 0xfa, 0x16, 0x31, 0xdc, 0x5e, 0xa7, 0x9e, 0x5f, 0x51, 0xd6, 0xf2, 0x7a, 0x59,
-	0x40, 0x5e, 0xee, 0x75, 0xb4, 0x0f, 0x14, 0x58, 0x62, 0x5b, 0x75, 0x2c, 0x16, 0xa1, 0xfd, 0x4a,
-	0x81, 0xc5, 0x5b, 0x26, 0x39, 0x1e, 0x1a, 0x3d, 0x03, 0x40, 0xed, 0x0e, 0x36, 0x08, 0x35, 0x3b,
-	0x5d, 0xae, 0xd5, 0x9c, 0x5e, 0x66, 0x90, 0x6d, 0x06, 0xd0, 0xde, 0x00, 0xf5, 0xba, 0xe7, 0x39,
-	0x3a, 0x26, 0x5d, 0xcf, 0x25, 0x18, 0x5d, 0x85, 0x02, 0xa1, 0x26, 0xed, 0x91, 0x40, 0xc8, 0x07,
-	0xa5, 0x42, 0x6e, 0x73, 0x14, 0x3d, 0x40, 0x65, 0xb6, 0x75, 0xd7, 0x74, 0x7a, 0x42, 0xc6, 0x92,
-	0x2e, 0x3e, 0xb4, 0xb7, 0x60, 0x76, 0x9b, 0xfa, 0xb6, 0xdb, 0xfa, 0x02, 0x99, 0x97, 0x43, 0xe6,
-	0xff, 0x54, 0xe0, 0x81, 0x0d, 0x4c, 0x9a, 0xbe, 0xbd, 0x73, 0x4c, 0x4c, 0x57, 0x03, 0x75, 0x00,
-	0xd9, 0xdc, 0xe0, 0xaa, 0xce, 0xea, 0x09, 0xd8, 0xd0, 0x66, 0xe4, 0x87, 0x37, 0xe3, 0xdd, 0x1c,
-	0x34, 0x64, 0x8b, 0x9a, 0x46, 0x7d, 0xdf, 0x88, 0x4e, 0x54, 0x86, 0x13, 0x9d, 0x4b, 0x12, 0x05,
-	0x8e, 0x77, 0x30, 0xdb, 0x36, 0x07, 0x44, 0x07, 0x6f, 0x78, 0x55, 0x59, 0xc9, 0xaa, 0xd6, 0x61,
-	0xe9, 0xae, 0xed, 0xd3, 0x9e, 0xe9, 0x18, 0xcd, 0xb6, 0xe9, 0xba, 0xd8, 0xe1, 0x7a, 0x22, 0xf5,
-	0xdc, 0x4a, 0x76, 0xad, 0xac, 0x2f, 0x04, 0x83, 0x37, 0xc4, 0x18, 0x53, 0x16, 0x41, 0x4f, 0xc2,
-	0x72, 0xb7, 0xdd, 0x27, 0x76, 0x73, 0x84, 0x28, 0xcf, 0x89, 0x16, 0xc3, 0xd1, 0x04, 0xd5, 0x45,
-	0x98, 0x6f, 0x72, 0x6f, 0x65, 0x19, 0x4c, 0x6b, 0x42, 0x8d, 0x05, 0xae, 0xc6, 0x5a, 0x30, 0xf0,
-	0x6a, 0x08, 0x67, 0x62, 0x85, 0xc8, 0x3d, 0xda, 0x8c, 0x11, 0x14, 0x39, 0xc1, 0x42, 0x30, 0xf8,
-	0x1a, 0x6d, 0x0e, 0x68, 0x92, 0x7e, 0xa6, 0x34, 0xe4, 0x67, 0x50, 0x1d, 0x8a, 0xdc, 0x6f, 0x62,
-	0x52, 0x2f, 0x73, 0x31, 0xc3, 0x4f, 0xb4, 0x09, 0x73, 0x84, 0x9a, 0x3e, 0x35, 0xba, 0x1e, 0xb1,
-	0x99, 0x5e, 0x48, 0x1d, 0x56, 0xb2, 0x6b, 0x95, 0xf5, 0x15, 0xe9, 0x26, 0xbd, 0x84, 0xfb, 0x1b,
-	0x26, 0x35, 0xb7, 0x4c, 0xdb, 0xd7, 0x67, 0x39, 0xe1, 0x56, 0x48, 0xc7, 0x9d, 0xd9, 0x6d, 0xcf,
-	0xb4, 0x8e, 0x87, 0x33, 0xfb, 0x48, 0x81, 0xba, 0x8e, 0x1d, 0x6c, 0x92, 0xe3, 0x71, 0xce, 0xb4,
-	0x1f, 0x2b, 0xf0, 0xd0, 0x4d, 0x4c, 0x63, 0x16, 0x4b, 0x4d, 0x6a, 0x13, 0x6a, 0x37, 0xc9, 0x51,
-	0x8a, 0xf5, 0xb1, 0x02, 0x67, 0x53, 0xc5, 0x9a, 0xe6, 0x00, 0x3f, 0x0d, 0x79, 0xf6, 0x8b, 0xc5,
-	0x0f, 0xcc, 0x9e, 0x56, 0xd3, 0xec, 0xe9, 0x75, 0xe6, 0x17, 0xb9, 0x41, 0x09, 0x7c, 0xed, 0xdf,
-	0x0a, 0x2c, 0x6f, 0xb7, 0xbd, 0xfd, 0x81, 0x48, 0x5f, 0x86, 0x82, 0x92, 0x2e, 0x2d, 0x3b, 0xe4,
-	0xd2, 0xd0, 0x15, 0xc8, 0xd1, 0x7e, 0x17, 0x73, 0x6f, 0x38, 0xbb, 0x7e, 0xe6, 0x92, 0x24, 0x16,
-	0xbc, 0xc4, 0x84, 0x7c, 0xb5, 0xdf, 0xc5, 0x3a, 0x47, 0x45, 0xe7, 0xa1, 0x36, 0xa4, 0xf2, 0xd0,
-	0x29, 0xcc, 0x25, 0x75, 0x4e, 0xb4, 0x3f, 0x64, 0xe0, 0xd4, 0xc8, 0x12, 0xa7, 0x51, 0xb6, 0x6c,
-	0xee, 0x8c, 0x74, 0x6e, 0x74, 0x0e, 0x62, 0x26, 0x60, 0xd8, 0x16, 0x8b, 0xac, 0xb2, 0x6b, 0x59,
-	0xbd, 0x1a, 0xf3, 0x8d, 0x16, 0x41, 0x8f, 0x03, 0x1a, 0x71, 0x59, 0xc2, 0x33, 0xe6, 0xf4, 0xf9,
-	0x61, 0x9f, 0xc5, 0xfd, 0xa2, 0xd4, 0x69, 0x09, 0x15, 0xe4, 0xf4, 0x45, 0x89, 0xd7, 0x22, 0xe8,
-	0x0a, 0x2c, 0xda, 0xee, 0x1d, 0xdc, 0xf1, 0xfc, 0xbe, 0xd1, 0xc5, 0x7e, 0x13, 0xbb, 0xd4, 0x6c,
-	0x61, 0x52, 0x2f, 0x70, 0x89, 0x16, 0xc2, 0xb1, 0xad, 0xc1, 0x90, 0xf6, 0x5b, 0x05, 0x96, 0x45,
-	0xe4, 0xb7, 0x65, 0xfa, 0xd4, 0x3e, 0xea, 0xdb, 0xf3, 0x1c, 0xcc, 0x76, 0x43, 0x39, 0x04, 0x5e,
-	0x8e, 0xe3, 0x55, 0x23, 0x28, 0x3f, 0x65, 0x9f, 0x2a, 0xb0, 0xc8, 0x02, 0xbd, 0x93, 0x24, 0xf3,
-	0xaf, 0x15, 0x58, 0xb8, 0x65, 0x92, 0x93, 0x24, 0xf2, 0xef, 0x82, 0x2b, 0x28, 0x92, 0xf9, 0x28,
-	0x5d, 0x2b, 0x43, 0x4c, 0x0a, 0x1d, 0x46, 0x16, 0xb3, 0x09, 0xa9, 0x89, 0xf6, 0xfb, 0xc1, 0x5d,
-	0x75, 0xc2, 0x24, 0xff, 0xa3, 0x02, 0x67, 0x6e, 0x62, 0x1a, 0x49, 0x7d, 0x2c, 0xee, 0xb4, 0x49,
-	0xad, 0xe5, 0x23, 0x71, 0x23, 0x4b, 0x85, 0x3f, 0x92, 0x9b, 0xef, 0x83, 0x0c, 0x2c, 0xb1, 0x6b,
-	0xe1, 0x78, 0x18, 0xc1, 0x24, 0x0f, 0x03, 0x89, 0xa1, 0xe4, 0x65, 0x86, 0x12, 0xdd, 0xa7, 0x85,
-	0x89, 0xef, 0x53, 0xed, 0x37, 0x19, 0x11, 0x07, 0xc4, 0xb5, 0x31, 0xcd, 0xb6, 0x48, 0x64, 0xcd,
-	0x48, 0x65, 0xd5, 0x40, 0x8d, 0x20, 0x9b, 0x1b, 0xe1, 0xfd, 0x98, 0x80, 0x1d, 0xdb, 0xeb, 0xf1,
-	0x43, 0x05, 0x96, 0xc3, 0xa7, 0xd8, 0x36, 0x6e, 0x75, 0xb0, 0x4b, 0xef, 0xdf, 0x86, 0x86, 0x2d,
-	0x20, 0x23, 0xb1, 0x80, 0xd3, 0x50, 0x26, 0x62, 0x9e, 0xe8, 0x95, 0x35, 0x00, 0x68, 0x9f, 0x28,
-	0x70, 0x6a, 0x44, 0x9c, 0x69, 0x36, 0xb1, 0x0e, 0x45, 0xdb, 0xb5, 0xf0, 0xbd, 0x48, 0x9a, 0xf0,
-	0x93, 0x8d, 0xec, 0xf4, 0x6c, 0xc7, 0x8a, 0xc4, 0x08, 0x3f, 0xd1, 0x2a, 0xa8, 0xd8, 0x35, 0x77,
-	0x1c, 0x6c, 0x70, 0x5c, 0x6e, 0xc8, 0x25, 0xbd, 0x22, 0x60, 0x9b, 0x0c, 0xa4, 0xfd, 0x40, 0x81,
-	0x05, 0x66, 0x6b, 0x81, 0x8c, 0xe4, 0xcb, 0xd5, 0xd9, 0x0a, 0x54, 0x62, 0xc6, 0x14, 0x88, 0x1b,
-	0x07, 0x69, 0x7b, 0xb0, 0x98, 0x14, 0x67, 0x1a, 0x9d, 0x3d, 0x04, 0x10, 0xed, 0x88, 0xb0, 0xf9,
-	0xac, 0x1e, 0x83, 0x68, 0x9f, 0x47, 0x79, 0x4b, 0xae, 0x8c, 0x23, 0xce, 0xfa, 0xec, 0xda, 0xd8,
-	0xb1, 0xe2, 0x5e, 0xbb, 0xcc, 0x21, 0x7c, 0x78, 0x03, 0x54, 0x7c, 0x8f, 0xfa, 0xa6, 0xd1, 0x35,
-	0x7d, 0xb3, 0x23, 0x0e, 0xcf, 0x44, 0x0e, 0xb6, 0xc2, 0xc9, 0xb6, 0x38, 0x95, 0xf6, 0x17, 0x16,
-	0x8c, 0x05, 0x46, 0x79, 0xdc, 0x57, 0x7c, 0x06, 0x80, 0x1b, 0xad, 0x18, 0xce, 0x8b, 0x61, 0x0e,
-	0xe1, 0x57, 0xd8, 0x27, 0x0a, 0xd4, 0xf8, 0x12, 0xc4, 0x7a, 0xba, 0x8c, 0xed, 0x10, 0x8d, 0x32,
-	0x44, 0x33, 0xe6, 0x08, 0x7d, 0x0d, 0x0a, 0x81, 0x62, 0xb3, 0x93, 0x2a, 0x36, 0x20, 0x38, 0x60,
-	0x19, 0xda, 0xcf, 0x15, 0x58, 0x1a, 0x52, 0xf9, 0x34, 0x16, 0xfd, 0x2a, 0x20, 0xb1, 0x42, 0x6b,
-	0xb0, 0xec, 0xf0, 0xba, 0x3d, 0x27, 0xbd, 0x5b, 0x86, 0x95, 0xa4, 0xcf, 0xdb, 0x43, 0x10, 0xa2,
-	0xfd, 0x5d, 0x81, 0xd3, 0x37, 0x31, 0xe5, 0xa8, 0xd7, 0x99, 0xef, 0xd8, 0xf2, 0xbd, 0x96, 0x8f,
-	0x09, 0x39, 0xb9, 0xf6, 0xf1, 0x13, 0x11, 0x9f, 0xc9, 0x96, 0x34, 0x8d, 0xfe, 0x57, 0x41, 0xe5,
-	0x73, 0x60, 0xcb, 0xf0, 0xbd, 0x7d, 0x12, 0xd8, 0x51, 0x25, 0x80, 0xe9, 0xde, 0x3e, 0x37, 0x08,
-	0xea, 0x51, 0xd3, 0x11, 0x08, 0xc1, 0xc5, 0xc0, 0x21, 0x6c, 0x98, 0x9f, 0xc1, 0x50, 0x30, 0xc6,
-	0x1c, 0x9f, 0x5c, 0x1d, 0xff, 0x52, 0x81, 0xa5, 0xa1, 0xa5, 0x4c, 0xa3, 0xdb, 0xa7, 0x44, 0xf4,
-	0x28, 0x16, 0x33, 0xbb, 0x7e, 0x56, 0x4a, 0x13, 0x9b, 0x4c, 0x60, 0xa3, 0xb3, 0x50, 0xd9, 0x35,
-	0x6d, 0xc7, 0xf0, 0xb1, 0x49, 0x3c, 0x37, 0x58, 0x28, 0x30, 0x90, 0xce, 0x21, 0xda, 0x67, 0x8a,
-	0x28, 0x0b, 0x9d, 0x70, 0x8f, 0xf7, 0x8b, 0x0c, 0x54, 0x37, 0x5d, 0x82, 0x7d, 0x7a, 0xfc, 0x5f,
-	0x18, 0xe8, 0x79, 0xa8, 0xf0, 0x85, 0x11, 0xc3, 0x32, 0xa9, 0x19, 0x5c, 0x57, 0x0f, 0x49, 0x33,
-	0xd9, 0x2f, 0x32, 0xbc, 0x0d, 0x93, 0x9a, 0xba, 0xd0, 0x0e, 0x61, 0xbf, 0xd1, 0x83, 0x50, 0x6e,
-	0x9b, 0xa4, 0x6d, 0xec, 0xe1, 0xbe, 0x08, 0xfb, 0xaa, 0x7a, 0x89, 0x01, 0x5e, 0xc2, 0x7d, 0x82,
-	0x1e, 0x80, 0x92, 0xdb, 0xeb, 0x88, 0x03, 0x56, 0x5c, 0x51, 0xd6, 0xaa, 0x7a, 0xd1, 0xed, 0x75,
-	0xf8, 0xf1, 0xfa, 0x6b, 0x06, 0x66, 0xef, 0xf4, 0xd8, 0x7b, 0x86, 0xe7, 0xe1, 0x7b, 0x0e, 0xbd,
-	0x3f, 0x63, 0xbc, 0x00, 0x59, 0x11, 0x33, 0x30, 0x8a, 0xba, 0x54, 0xf0, 0xcd, 0x0d, 0xa2, 0x33,
-	0x24, 0x9e, 0x83, 0xee, 0x35, 0x9b, 0x41, 0x90, 0x95, 0xe5, 0xc2, 0x96, 0x19, 0x84, 0x5b, 0x1c,
-	0x5b, 0x0a, 0xf6, 0xfd, 0x28, 0x04, 0xe3, 0x4b, 0xc1, 0xbe, 0x2f, 0x06, 0x35, 0x50, 0xcd, 0xe6,
-	0x9e, 0xeb, 0xed, 0x3b, 0xd8, 0x6a, 0x61, 0x8b, 0x6f, 0x7b, 0x49, 0x4f, 0xc0, 0x84, 0x61, 0xb0,
-	0x8d, 0x37, 0x9a, 0x2e, 0xe5, 0x0f, 0x89, 0x2c, 0x33, 0x0c, 0x06, 0xb9, 0xe1, 0x52, 0x36, 0x6c,
-	0x61, 0x07, 0x53, 0xcc, 0x87, 0x8b, 0x62, 0x58, 0x40, 0x82, 0xe1, 0x5e, 0x37, 0xa2, 0x2e, 0x89,
-	0x61, 0x01, 0x61, 0xc3, 0xa7, 0xa1, 0x3c, 0x48, 0xb4, 0x97, 0x07, 0xd9, 0x40, 0x0e, 0xd0, 0xfe,
-	0xa4, 0x40, 0x75, 0x83, 0xb3, 0x3a, 0x01, 0x46, 0x87, 0x20, 0x87, 0xef, 0x75, 0xfd, 0xe0, 0xe8,
-	0xf0, 0xdf, 0xda, 0x5d, 0xa8, 0x6d, 0x39, 0x66, 0x13, 0xb7, 0x3d, 0xc7, 0xc2, 0x3e, 0xbf, 0xbe,
-	0x51, 0x0d, 0xb2, 0xd4, 0x6c, 0x05, 0xf1, 0x01, 0xfb, 0x89, 0x9e, 0x09, 0x1e, 0x69, 0xc2, 0xf3,
-	0x7c, 0x45, 0x7a, 0x91, 0xc6, 0xd8, 0xc4, 0x72, 0x9f, 0xcb, 0x50, 0xe0, 0xf5, 0x2d, 0x11, 0x39,
-	0xa8, 0x7a, 0xf0, 0xa5, 0xbd, 0x9d, 0x98, 0xf7, 0xa6, 0xef, 0xf5, 0xba, 0x68, 0x13, 0xd4, 0xee,
-	0x00, 0xc6, 0xcc, 0x31, 0xfd, 0xda, 0x1e, 0x16, 0x5a, 0x4f, 0x90, 0x6a, 0x9f, 0x67, 0xa1, 0xba,
-	0x8d, 0x4d, 0xbf, 0xd9, 0x3e, 0x09, 0xd9, 0x12, 0xa6, 0x71, 0x8b, 0x38, 0xc1, 0xc6, 0xb0, 0x9f,
-	0xe8, 0x22, 0xcc, 0xc7, 0x16, 0x64, 0xb4, 0x98, 0x82, 0xb8, 0x69, 0xab, 0x7a, 0xad, 0x3b, 0xac,
-	0xb8, 0xa7, 0xa1, 0x64, 0x11, 0xc7, 0xe0, 0x5b, 0x54, 0xe4, 0x5b, 0x24, 0x5f, 0xdf, 0x06, 0x71,
-	0xf8, 0xd6, 0x14, 0x2d, 0xf1, 0x03, 0x3d, 0x0c, 0x55, 0xaf, 0x47, 0xbb, 0x3d, 0x6a, 0x08, 0xd7,
-	0x52, 0x2f, 0x71, 0xf1, 0x54, 0x01, 0xe4, 0x9e, 0x87, 0xa0, 0x17, 0xa1, 0x4a, 0xb8, 0x2a, 0xc3,
-	0xe0, 0xba, 0x3c, 0x69, 0x0c, 0xa8, 0x0a, 0x3a, 0x11, 0x5d, 0xa3, 0xf3, 0x50, 0xa3, 0xbe, 0x79,
-	0x17, 0x3b, 0xb1, 0xca, 0x15, 0xf0, 0x03, 0x35, 0x27, 0xe0, 0x83, 0xaa, 0xd5, 0x65, 0x58, 0x68,
-	0xf5, 0x4c, 0xdf, 0x74, 0x29, 0xc6, 0x31, 0xec, 0x0a, 0xc7, 0x46, 0xd1, 0x50, 0x44, 0xa0, 0xbd,
-	0x04, 0xb9, 0x5b, 0x36, 0xe5, 0x8a, 0x64, 0x6e, 0x49, 0xe1, 0x4f, 0x19, 0xee, 0x7c, 0x1e, 0x80,
-	0x92, 0xef, 0xed, 0x0b, 0x37, 0x9b, 0xe1, 0x26, 0x58, 0xf4, 0xbd, 0x7d, 0xee, 0x43, 0x79, 0x6d,
-	0xde, 0xf3, 0x03, 0xdb, 0xcc, 0xe8, 0xc1, 0x97, 0xf6, 0xbe, 0x32, 0x30, 0x1e, 0xe6, 0x21, 0xc9,
-	0xfd, 0xb9, 0xc8, 0xe7, 0xa1, 0xe8, 0x0b, 0xfa, 0xb1, 0x95, 0xca, 0xf8, 0x4c, 0xdc, 0xcd, 0x87,
-	0x54, 0xda, 0x7b, 0x0a, 0xa8, 0x2f, 0x3a, 0x3d, 0xf2, 0x65, 0xd8, 0xb0, 0xac, 0x2e, 0x90, 0x95,
-	0xd7, 0x24, 0x7e, 0x98, 0x81, 0x6a, 0x20, 0xc6, 0x34, 0xe1, 0x4b, 0xaa, 0x28, 0xdb, 0x50, 0x61,
-	0x53, 0x1a, 0x04, 0xb7, 0xc2, 0xa4, 0x4a, 0x65, 0x7d, 0x5d, 0x7a, 0xea, 0x13, 0x62, 0xf0, 0x1a,
-	0xef, 0x36, 0x27, 0xfa, 0x96, 0x4b, 0xfd, 0xbe, 0x0e, 0xcd, 0x08, 0xd0, 0x78, 0x1b, 0xe6, 0x86,
-	0x86, 0x99, 0x6d, 0xec, 0xe1, 0x7e, 0xe8, 0xd6, 0xf6, 0x70, 0x1f, 0x3d, 0x19, 0xaf, 0xc4, 0xa7,
-	0xdd, 0xbf, 0xb7, 0x3d, 0xb7, 0x75, 0xcd, 0xf7, 0xcd, 0x7e, 0x50, 0xa9, 0x7f, 0x36, 0xf3, 0x8c,
-	0xa2, 0xfd, 0x39, 0x03, 0xea, 0x2b, 0x3d, 0xec, 0xf7, 0x8f, 0xd2, 0xbd, 0x84, 0xfe, 0x3c, 0x37,
-	0xf0, 0xe7, 0xa3, 0x27, 0x3a, 0x2f, 0x39, 0xd1, 0x12, 0xbf, 0x54, 0x90, 0xfa, 0x25, 0xd9, 0x91,
-	0x2d, 0x1e, 0xea, 0xc8, 0x96, 0x52, 0x8f, 0x2c, 0xb3, 0xee, 0x40, 0x85, 0x53, 0x1d, 0xb2, 0x44,
-	0x20, 0x95, 0x39, 0x6c, 0x20, 0xa5, 0x7d, 0xaa, 0x40, 0xf9, 0x75, 0xdc, 0xa4, 0x9e, 0xcf, 0xbc,
-	0x85, 0x44, 0xf7, 0xca, 0x04, 0xb1, 0x6a, 0x66, 0x38, 0x56, 0xbd, 0x0a, 0x25, 0xdb, 0x32, 0x4c,
-	0x66, 0x36, 0x7c, 0xf3, 0xc6, 0xc5, 0x48, 0x45, 0xdb, 0xe2, 0xf6, 0x35, 0x79, 0x72, 0xfd, 0xa7,
-	0x0a, 0xa8, 0x42, 0x66, 0x22, 0x28, 0x9f, 0x8b, 0x4d, 0xa7, 0xc8, 0x6c, 0x39, 0xf8, 0x88, 0x16,
-	0x7a, 0x6b, 0x66, 0x30, 0xed, 0x35, 0x00, 0xa6, 0xbb, 0x80, 0x5c, 0x1c, 0x85, 0x15, 0xa9, 0xb4,
-	0x82, 0x9c, 0xeb, 0xf1, 0xd6, 0x8c, 0x5e, 0x66, 0x54, 0x9c, 0xc5, 0xf5, 0x22, 0xe4, 0x39, 0xb5,
-	0xf6, 0x5f, 0x05, 0x16, 0x6e, 0x98, 0x4e, 0x73, 0xc3, 0x26, 0xd4, 0x74, 0x9b, 0x53, 0x44, 0x45,
-	0xcf, 0x42, 0xd1, 0xeb, 0x1a, 0x0e, 0xde, 0xa5, 0x81, 0x48, 0xab, 0x63, 0x56, 0x24, 0xd4, 0xa0,
-	0x17, 0xbc, 0xee, 0x6d, 0xbc, 0x4b, 0xd1, 0xd7, 0xa1, 0xe4, 0x75, 0x0d, 0xdf, 0x6e, 0xb5, 0x69,
-	0xa0, 0xfd, 0x09, 0x88, 0x8b, 0x5e, 0x57, 0x67, 0x14, 0xb1, 0x64, 0x47, 0xee, 0x90, 0xc9, 0x0e,
-	0xed, 0x1f, 0x23, 0xcb, 0x9f, 0xc2, 0xb4, 0x9f, 0x85, 0x92, 0xed, 0x52, 0xc3, 0xb2, 0x49, 0xa8,
-	0x82, 0x33, 0x72, 0x1b, 0x72, 0x29, 0x5f, 0x01, 0xdf, 0x53, 0x97, 0xb2, 0xb9, 0xd1, 0x0b, 0x00,
-	0xbb, 0x8e, 0x67, 0x06, 0xd4, 0x42, 0x07, 0x67, 0xe5, 0xa7, 0x82, 0xa1, 0x85, 0xf4, 0x65, 0x4e,
-	0xc4, 0x38, 0x0c, 0xb6, 0xf4, 0x6f, 0x0a, 0x2c, 0x6d, 0x61, 0x9f, 0xd8, 0x84, 0x62, 0x97, 0x06,
-	0x89, 0xc7, 0x4d, 0x77, 0xd7, 0x4b, 0x66, 0x78, 0x95, 0xa1, 0x0c, 0xef, 0x17, 0x93, 0xef, 0x4c,
-	0x3c, 0x65, 0x44, 0x9d, 0x21, 0x7c, 0xca, 0x84, 0xd5, 0x14, 0xf1, 0x14, 0x9c, 0x4d, 0xd9, 0xa6,
-	0x40, 0xde, 0xf8, 0x8b, 0x58, 0xfb, 0x91, 0xe8, 0x6c, 0x90, 0x2e, 0xea, 0xfe, 0x0d, 0x76, 0x19,
-	0x02, 0x07, 0x3e, 0xe4, 0xce, 0x1f, 0x81, 0x21, 0xdf, 0x91, 0xd2, 0x6f, 0xf1, 0x33, 0x05, 0x56,
-	0xd2, 0xa5, 0x9a, 0xe6, 0xe6, 0x7d, 0x01, 0xf2, 0xb6, 0xbb, 0xeb, 0x85, 0x79, 0xb0, 0x0b, 0xf2,
-	0x80, 0x5a, 0x3a, 0xaf, 0x20, 0xd4, 0xfe, 0xa3, 0x40, 0x8d, 0xfb, 0xea, 0x23, 0xd8, 0xfe, 0x0e,
-	0xee, 0x18, 0xc4, 0x7e, 0x07, 0x87, 0xdb, 0xdf, 0xc1, 0x9d, 0x6d, 0xfb, 0x1d, 0x9c, 0xb0, 0x8c,
-	0x7c, 0xd2, 0x32, 0x92, 0x99, 0x82, 0xc2, 0x98, 0x3c, 0x67, 0x31, 0x91, 0xe7, 0xd4, 0x3e, 0x52,
-	0xa0, 0x71, 0x13, 0xd3, 0xe1, 0xa5, 0x1e, 0x9d, 0x51, 0x7c, 0xac, 0xc0, 0x83, 0x52, 0x81, 0xa6,
-	0xb1, 0x87, 0xe7, 0x92, 0xf6, 0x20, 0x7f, 0x60, 0x8d, 0x4c, 0x19, 0x98, 0xc2, 0x15, 0x50, 0x37,
-	0x7a, 0x9d, 0x4e, 0x14, 0xf8, 0xac, 0x82, 0xea, 0x8b, 0x9f, 0xe2, 0xfd, 0x21, 0xae, 0xcb, 0x4a,
-	0x00, 0x63, 0xaf, 0x0c, 0xed, 0x22, 0x54, 0x03, 0x92, 0x40, 0xea, 0x06, 0x94, 0xfc, 0xe0, 0x77,
-	0x80, 0x1f, 0x7d, 0x6b, 0x4b, 0xb0, 0xa0, 0xe3, 0x16, 0xb3, 0x44, 0xff, 0xb6, 0xed, 0xee, 0x05,
-	0xd3, 0x68, 0xef, 0x2a, 0xb0, 0x98, 0x84, 0x07, 0xbc, 0xbe, 0x0a, 0x45, 0xd3, 0xb2, 0x7c, 0x4c,
-	0xc8, 0xd8, 0x6d, 0xb9, 0x26, 0x70, 0xf4, 0x10, 0x39, 0xa6, 0xb9, 0xcc, 0xc4, 0x9a, 0xd3, 0x0c,
-	0x98, 0xbf, 0x89, 0xe9, 0x1d, 0x4c, 0xfd, 0xa9, 0x0a, 0xd9, 0x75, 0xf6, 0x32, 0xe0, 0xc4, 0x81,
-	0x59, 0x84, 0x9f, 0xda, 0x87, 0x0a, 0xa0, 0xf8, 0x0c, 0xd3, 0x6c, 0x73, 0x5c, 0xcb, 0x99, 0xa4,
-	0x96, 0x45, 0xaf, 0x4f, 0xa7, 0xeb, 0xb9, 0xd8, 0xa5, 0xf1, 0x10, 0xb3, 0x1a, 0x41, 0x99, 0xf9,
-	0x5d, 0x58, 0x85, 0x52, 0x58, 0x7b, 0x45, 0x45, 0xc8, 0x5e, 0x73, 0x9c, 0xda, 0x0c, 0x52, 0xa1,
-	0xb4, 0x19, 0x14, 0x18, 0x6b, 0xca, 0x85, 0x6f, 0xc2, 0xdc, 0xd0, 0xcb, 0x1f, 0x95, 0x20, 0xf7,
-	0xb2, 0xe7, 0xe2, 0xda, 0x0c, 0xaa, 0x81, 0x7a, 0xdd, 0x76, 0x4d, 0xbf, 0x2f, 0x6e, 0xda, 0x9a,
-	0x85, 0xe6, 0xa0, 0xc2, 0x6f, 0x9c, 0x00, 0x80, 0xd7, 0xff, 0xd5, 0x80, 0xea, 0x1d, 0xbe, 0x98,
-	0x6d, 0xec, 0xdf, 0xb5, 0x9b, 0x18, 0x19, 0x50, 0x1b, 0xee, 0xe0, 0x46, 0x8f, 0x49, 0x6d, 0x34,
-	0xa5, 0xd1, 0xbb, 0x31, 0x4e, 0x3d, 0xda, 0x0c, 0x7a, 0x0b, 0x66, 0x93, 0xbd, 0xd5, 0x48, 0xee,
-	0x12, 0xa5, 0x0d, 0xd8, 0x07, 0x31, 0x37, 0xa0, 0x9a, 0x68, 0x95, 0x46, 0xe7, 0xa5, 0xbc, 0x65,
-	0xed, 0xd4, 0x0d, 0x79, 0x94, 0x12, 0x6f, 0x67, 0x16, 0xd2, 0x27, 0x9b, 0x29, 0x53, 0xa4, 0x97,
-	0x76, 0x5c, 0x1e, 0x24, 0xbd, 0x09, 0xf3, 0x23, 0xbd, 0x91, 0xe8, 0x71, 0x29, 0xff, 0xb4, 0x1e,
-	0xca, 0x83, 0xa6, 0xd8, 0x07, 0x34, 0xda, 0x12, 0x8c, 0x2e, 0xc9, 0x77, 0x20, 0xad, 0x21, 0xba,
-	0x71, 0x79, 0x62, 0xfc, 0x48, 0x71, 0xdf, 0x53, 0xe0, 0x54, 0x4a, 0x43, 0x23, 0xba, 0x2a, 0x65,
-	0x37, 0xbe, 0x2b, 0xb3, 0xf1, 0xe4, 0xe1, 0x88, 0x22, 0x41, 0x5c, 0x98, 0x1b, 0xea, 0xf1, 0x43,
-	0x17, 0x53, 0xfb, 0x1e, 0x46, 0x9b, 0x1d, 0x1b, 0x8f, 0x4d, 0x86, 0x1c, 0xcd, 0xc7, 0xde, 0xc2,
-	0xc9, 0xc6, 0xb8, 0x94, 0xf9, 0xe4, 0xed, 0x73, 0x07, 0x6d, 0xe8, 0x1b, 0x50, 0x4d, 0x74, 0xb0,
-	0xa5, 0x58, 0xbc, 0xac, 0xcb, 0xed, 0x20, 0xd6, 0x6f, 0x83, 0x1a, 0x6f, 0x34, 0x43, 0x6b, 0x69,
-	0x67, 0x69, 0x84, 0xf1, 0x61, 0x8e, 0xd2, 0xa0, 0x8f, 0x64, 0xcc, 0x51, 0x1a, 0x69, 0xbd, 0x99,
-	0xfc, 0x28, 0xc5, 0xf8, 0x8f, 0x3d, 0x4a, 0x87, 0x9e, 0xe2, 0x5d, 0x05, 0x96, 0xe5, 0x7d, 0x4a,
-	0x68, 0x3d, 0xcd, 0x36, 0xd3, 0x3b, 0xb2, 0x1a, 0x57, 0x0f, 0x45, 0x13, 0x69, 0x71, 0x0f, 0x66,
-	0x93, 0xdd, 0x38, 0x29, 0x5a, 0x94, 0x36, 0x30, 0x35, 0x2e, 0x4e, 0x84, 0x1b, 0x4d, 0xf6, 0x1a,
-	0x54, 0x62, 0xff, 0xa4, 0x42, 0x8f, 0x8e, 0xb1, 0xe3, 0xf8, 0x1f, 0x91, 0x0e, 0xd2, 0xe4, 0x2b,
-	0x50, 0x8e, 0xfe, 0x19, 0x85, 0xce, 0xa5, 0xda, 0xef, 0x61, 0x58, 0x6e, 0x03, 0x0c, 0xfe, 0x0f,
-	0x85, 0x1e, 0x91, 0xf2, 0x1c, 0xf9, 0xc3, 0xd4, 0x41, 0x4c, 0xa3, 0xe5, 0x8b, 0xea, 0xc8, 0xb8,
-	0xe5, 0xc7, 0xcb, 0x79, 0x07, 0xb1, 0x6d, 0x43, 0x35, 0x51, 0x84, 0x4f, 0x3b, 0xc2, 0x92, 0xde,
-	0x88, 0xc6, 0x85, 0x49, 0x50, 0xa3, 0xfd, 0x6b, 0x43, 0x35, 0x51, 0x12, 0x4d, 0x99, 0x49, 0x56,
-	0x01, 0x4e, 0x99, 0x49, 0x5a, 0x61, 0xd5, 0x66, 0xd0, 0x77, 0x63, 0xd5, 0xd7, 0x44, 0x85, 0x1b,
-	0x5d, 0x19, 0xcb, 0x47, 0x56, 0xe0, 0x6f, 0xac, 0x1f, 0x86, 0x24, 0x12, 0x21, 0xb0, 0x2a, 0xa1,
-	0xd2, 0x74, 0xab, 0x3a, 0xcc, 0x4e, 0x6d, 0x43, 0x41, 0x14, 0x39, 0x91, 0x96, 0xd2, 0xce, 0x10,
-	0xab, 0x80, 0x36, 0x1e, 0x96, 0xe2, 0x24, 0xeb, 0x7f, 0x82, 0xa9, 0x28, 0x62, 0xa5, 0x30, 0x4d,
-	0x54, 0xb8, 0x26, 0x65, 0xaa, 0x43, 0x41, 0xa4, 0xb6, 0x53, 0x98, 0x26, 0xca, 0x33, 0x8d, 0xf1,
-	0x38, 0x22, 0x1f, 0x3e, 0x83, 0xb6, 0x20, 0xcf, 0x53, 0xc0, 0x68, 0x75, 0x5c, 0x7a, 0x78, 0x1c,
-	0xc7, 0x44, 0x06, 0x59, 0x9b, 0x41, 0xdf, 0x86, 0x3c, 0x7f, 0xe9, 0xa4, 0x70, 0x8c, 0xe7, 0x78,
-	0x1b, 0x63, 0x51, 0x42, 0x11, 0x2d, 0x50, 0xe3, 0x19, 0xa0, 0x94, 0x2b, 0x4b, 0x92, 0x23, 0x6b,
-	0x4c, 0x82, 0x19, 0xce, 0xf2, 0x7d, 0x05, 0xea, 0x69, 0xc9, 0x02, 0x94, 0x1a, 0x97, 0x8c, 0xcb,
-	0x78, 0x34, 0x9e, 0x3a, 0x24, 0x55, 0xa4, 0xc2, 0x77, 0x60, 0x41, 0xf2, 0x44, 0x45, 0x97, 0xd3,
-	0xf8, 0xa5, 0xbc, 0xae, 0x1b, 0x4f, 0x4c, 0x4e, 0x10, 0xcd, 0xbd, 0x05, 0x79, 0xfe, 0xb4, 0x4c,
-	0xd9, 0xbe, 0xf8, 0x4b, 0x35, 0xc5, 0x20, 0x12, 0x2f, 0x53, 0x6d, 0x06, 0x61, 0x50, 0xe3, 0xef,
-	0xcc, 0x94, 0xfd, 0x93, 0x3c, 0x51, 0x1b, 0xe7, 0x27, 0xc0, 0x8c, 0xa6, 0x31, 0x00, 0x06, 0xef,
-	0xbc, 0x94, 0xdb, 0x61, 0xe4, 0xa9, 0xd9, 0x78, 0xf4, 0x40, 0xbc, 0x70, 0x82, 0xf5, 0x1e, 0xa8,
-	0x5b, 0xbe, 0x77, 0xaf, 0x1f, 0xbe, 0xaa, 0xfe, 0x3f, 0xeb, 0xba, 0xfe, 0xd4, 0x9b, 0x57, 0x5b,
-	0x36, 0x6d, 0xf7, 0x76, 0x98, 0xe7, 0xba, 0x2c, 0x70, 0x1f, 0xb7, 0xbd, 0xe0, 0xd7, 0x65, 0xdb,
-	0xa5, 0xd8, 0x77, 0x4d, 0xe7, 0x32, 0xe7, 0x15, 0x40, 0xbb, 0x3b, 0x3b, 0x05, 0xfe, 0x7d, 0xf5,
-	0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x48, 0x0d, 0x28, 0x79, 0x69, 0x3d, 0x00, 0x00,
+	// 4244 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3c, 0x5b, 0x6f, 0xdc, 0x56,
+	0x7a, 0xe2, 0xdc, 0xe7, 0x9b, 0x19, 0x69, 0x74, 0x24, 0xcb, 0x93, 0x89, 0x9d, 0xc8, 0xdc, 0x75,
+	0x2c, 0xcb, 0x1b, 0x3b, 0x91, 0xe3, 0xcd, 0x65, 0xb3, 0xdd, 0xd8, 0x56, 0x6c, 0x0b, 0xb1, 0x13,
+	0x2d, 0x95, 0x2c, 0xb0, 0xbb, 0x08, 0xa6, 0xd4, 0xf0, 0x48, 0xe2, 0x8a, 0x43, 0x4e, 0x78, 0x38,
+	0x92, 0x95, 0x87, 0x62, 0x81, 0xb4, 0x45, 0x8b, 0xa4, 0x59, 0x14, 0x2d, 0x5a, 0x6c, 0x1f, 0x7b,
+	0x43, 0xfb, 0xd6, 0x1b, 0xd0, 0x45, 0x1f, 0x8a, 0x16, 0x6d, 0x81, 0x3e, 0xb4, 0xe8, 0xe5, 0xbd,
+	0x40, 0x51, 0xa0, 0x3f, 0xa0, 0xef, 0x7d, 0x28, 0xce, 0x85, 0xe4, 0x21, 0xe7, 0x70, 0x66, 0xa4,
+	0x59, 0xaf, 0x24, 0xa0, 0x6f, 0xe4, 0xc7, 0xef, 0x3b, 0xdf, 0xf5, 0x7c, 0xe7, 0xf2, 0x9d, 0x43,
+	0xa8, 0xf7, 0x6c, 0xe7, 0x60, 0x40, 0x6e, 0xf6, 0x7d, 0x2f, 0xf0, 0xd0, 0x82, 0xfc, 0x76, 0x93,
+	0xbf, 0xb4, 0xeb, 0x5d, 0xaf, 0xd7, 0xf3, 0x5c, 0x0e, 0x6c, 0xd7, 0x49, 0x77, 0x0f, 0xf7, 0x4c,
+	0xfe, 0xa6, 0xff, 0x92, 0x06, 0xe8, 0xbe, 0x8f, 0xcd, 0x00, 0xdf, 0x75, 0x6c, 0x93, 0x18, 0xf8,
+	0x93, 0x01, 0x26, 0x01, 0x7a, 0x05, 0x0a, 0xdb, 0x26, 0xc1, 0x2d, 0x6d, 0x59, 0x5b, 0xa9, 0xad,
+	0x5d, 0xba, 0x99, 0x68, 0x56, 0x34, 0xf7, 0x84, 0xec, 0xde, 0x33, 0x09, 0x36, 0x18, 0x26, 0xba,
+	0x06, 0x73, 0x5d, 0xcf, 0x71, 0x70, 0x37, 0xb0, 0x3d, 0xb7, 0xe3, 0x9a, 0x3d, 0xdc, 0xca, 0x2d,
+	0x6b, 0x2b, 0x55, 0x63, 0x36, 0x06, 0xbf, 0x6f, 0xf6, 0x30, 0x5a, 0x84, 0xa2, 0x49, 0x59, 0xb5,
+	0xf2, 0xec, 0x33, 0x7f, 0xd1, 0xbf, 0x07, 0xcd, 0x75, 0xdf, 0xeb, 0x4f, 0x29, 0x44, 0xd4, 0x76,
+	0x4e, 0x6e, 0xfb, 0x17, 0x35, 0x98, 0xbf, 0xeb, 0x04, 0xd8, 0x3f, 0x5d, 0x15, 0xff, 0x5e, 0x83,
+	0x8b, 0xdc, 0xd4, 0xf7, 0x23, 0xf4, 0x93, 0x0b, 0x73, 0x11, 0xca, 0xd6, 0xb6, 0x2c, 0x44, 0xc9,
+	0xda, 0x66, 0xcc, 0x15, 0x52, 0xe6, 0x95, 0x52, 0x2e, 0x41, 0x89, 0x87, 0x42, 0xab, 0xb0, 0xac,
+	0xad, 0xd4, 0x0d, 0xf1, 0x86, 0x2e, 0x03, 0x90, 0x3d, 0xd3, 0xb7, 0x48, 0xc7, 0x1d, 0xf4, 0x5a,
+	0xc5, 0x65, 0x6d, 0xa5, 0x68, 0x54, 0x39, 0xe4, 0xfd, 0x41, 0x4f, 0xff, 0x5c, 0x83, 0x0b, 0xd4,
+	0x55, 0x67, 0x42, 0x09, 0xfd, 0x8f, 0x35, 0x58, 0x7c, 0x64, 0x92, 0xb3, 0x61, 0xd1, 0xcb, 0x00,
+	0x81, 0xdd, 0xc3, 0x1d, 0x12, 0x98, 0xbd, 0x3e, 0xb3, 0x6a, 0xc1, 0xa8, 0x52, 0xc8, 0x16, 0x05,
+	0xe8, 0xdf, 0x85, 0xfa, 0x3d, 0xcf, 0x73, 0x0c, 0x4c, 0xfa, 0x9e, 0x4b, 0x30, 0xba, 0x0d, 0x25,
+	0x12, 0x98, 0xc1, 0x80, 0x08, 0x21, 0x9f, 0x57, 0x0a, 0xb9, 0xc5, 0x50, 0x0c, 0x81, 0x4a, 0x63,
+	0xeb, 0xc0, 0x74, 0x06, 0x5c, 0xc6, 0x8a, 0xc1, 0x5f, 0xf4, 0xef, 0xc3, 0xec, 0x56, 0xe0, 0xdb,
+	0xee, 0xee, 0x4f, 0xb1, 0xf1, 0x6a, 0xd8, 0xf8, 0xbf, 0x6b, 0xf0, 0xdc, 0x3a, 0x26, 0x5d, 0xdf,
+	0xde, 0x3e, 0x23, 0xa1, 0xab, 0x43, 0x3d, 0x86, 0x6c, 0xac, 0x33, 0x53, 0xe7, 0x8d, 0x04, 0x2c,
+	0xe5, 0x8c, 0x62, 0xda, 0x19, 0x9f, 0x15, 0xa0, 0xad, 0x52, 0x6a, 0x1a, 0xf3, 0x7d, 0x33, 0xea,
+	0x51, 0x39, 0x46, 0x74, 0x35, 0x49, 0xc4, 0xbf, 0xdd, 0x8c, 0xb9, 0x6d, 0x31, 0x40, 0xd4, 0xf1,
+	0xd2, 0x5a, 0xe5, 0x15, 0x5a, 0xad, 0xc1, 0x85, 0x03, 0xdb, 0x0f, 0x06, 0xa6, 0xd3, 0xe9, 0xee,
+	0x99, 0xae, 0x8b, 0x1d, 0x66, 0x27, 0xd2, 0x2a, 0x2c, 0xe7, 0x57, 0xaa, 0xc6, 0x82, 0xf8, 0x78,
+	0x9f, 0x7f, 0xa3, 0xc6, 0x22, 0xe8, 0x35, 0x58, 0xea, 0xef, 0x1d, 0x11, 0xbb, 0x3b, 0x44, 0x54,
+	0x64, 0x44, 0x8b, 0xe1, 0xd7, 0x04, 0xd5, 0x0d, 0x98, 0xef, 0xb2, 0x6c, 0x65, 0x75, 0xa8, 0xd5,
+	0xb8, 0x19, 0x4b, 0xcc, 0x8c, 0x4d, 0xf1, 0xe1, 0xc3, 0x10, 0x4e, 0xc5, 0x0a, 0x91, 0x07, 0x41,
+	0x57, 0x22, 0x28, 0x33, 0x82, 0x05, 0xf1, 0xf1, 0xa3, 0xa0, 0x1b, 0xd3, 0x24, 0xf3, 0x4c, 0x25,
+	0x95, 0x67, 0x50, 0x0b, 0xca, 0x2c, 0x6f, 0x62, 0xd2, 0xaa, 0x32, 0x31, 0xc3, 0x57, 0xb4, 0x01,
+	0x73, 0x24, 0x30, 0xfd, 0xa0, 0xd3, 0xf7, 0x88, 0x4d, 0xed, 0x42, 0x5a, 0xb0, 0x9c, 0x5f, 0xa9,
+	0xad, 0x2d, 0x2b, 0x9d, 0xf4, 0x1e, 0x3e, 0x5a, 0x37, 0x03, 0x73, 0xd3, 0xb4, 0x7d, 0x63, 0x96,
+	0x11, 0x6e, 0x86, 0x74, 0x2c, 0x99, 0x3d, 0xf6, 0x4c, 0xeb, 0x6c, 0x24, 0xb3, 0x2f, 0x35, 0x68,
+	0x19, 0xd8, 0xc1, 0x26, 0x39, 0x1b, 0xfd, 0x4c, 0xff, 0x4d, 0x0d, 0x5e, 0x78, 0x88, 0x03, 0x29,
+	0x62, 0x03, 0x33, 0xb0, 0x49, 0x60, 0x77, 0xc9, 0x69, 0x8a, 0xf5, 0x23, 0x0d, 0x5e, 0xcc, 0x14,
+	0x6b, 0x9a, 0x0e, 0xfc, 0x3a, 0x14, 0xe9, 0x13, 0x9d, 0x3f, 0xd0, 0x78, 0xba, 0x92, 0x15, 0x4f,
+	0xdf, 0xa1, 0x79, 0x91, 0x05, 0x14, 0xc7, 0xd7, 0xff, 0x53, 0x83, 0xa5, 0xad, 0x3d, 0xef, 0x30,
+	0x16, 0xe9, 0x59, 0x18, 0x28, 0x99, 0xd2, 0xf2, 0xa9, 0x94, 0x86, 0x5e, 0x85, 0x42, 0x70, 0xd4,
+	0xc7, 0x2c, 0x1b, 0xce, 0xae, 0x5d, 0xbe, 0xa9, 0x98, 0x0b, 0xde, 0xa4, 0x42, 0x7e, 0x78, 0xd4,
+	0xc7, 0x06, 0x43, 0x45, 0xd7, 0xa1, 0x99, 0x32, 0x79, 0x98, 0x14, 0xe6, 0x92, 0x36, 0x27, 0xfa,
+	0x4f, 0x72, 0x70, 0x71, 0x48, 0xc5, 0x69, 0x8c, 0xad, 0xe2, 0x9d, 0x53, 0xf2, 0x46, 0x57, 0x41,
+	0x0a, 0x81, 0x8e, 0x6d, 0xd1, 0x99, 0x55, 0x7e, 0x25, 0x6f, 0x34, 0x62, 0xe8, 0x86, 0x45, 0xd0,
+	0xcb, 0x80, 0x86, 0x52, 0x16, 0xcf, 0x8c, 0x05, 0x63, 0x3e, 0x9d, 0xb3, 0x58, 0x5e, 0x54, 0x26,
+	0x2d, 0x6e, 0x82, 0x82, 0xb1, 0xa8, 0xc8, 0x5a, 0x04, 0xbd, 0x0a, 0x8b, 0xb6, 0xfb, 0x04, 0xf7,
+	0x3c, 0xff, 0xa8, 0xd3, 0xc7, 0x7e, 0x17, 0xbb, 0x81, 0xb9, 0x8b, 0x49, 0xab, 0xc4, 0x24, 0x5a,
+	0x08, 0xbf, 0x6d, 0xc6, 0x9f, 0xf4, 0x3f, 0xd7, 0x60, 0x89, 0xcf, 0xfc, 0x36, 0x4d, 0x3f, 0xb0,
+	0x4f, 0x7b, 0xf4, 0xbc, 0x0a, 0xb3, 0xfd, 0x50, 0x0e, 0x8e, 0x57, 0x60, 0x78, 0x8d, 0x08, 0xca,
+	0x7a, 0xd9, 0x9f, 0x6a, 0xb0, 0x48, 0x27, 0x7a, 0xe7, 0x49, 0xe6, 0x3f, 0xd1, 0x60, 0xe1, 0x91,
+	0x49, 0xce, 0x93, 0xc8, 0x7f, 0x21, 0x86, 0xa0, 0x48, 0xe6, 0xd3, 0x4c, 0xad, 0x14, 0x31, 0x29,
+	0x74, 0x38, 0xb3, 0x98, 0x4d, 0x48, 0x4d, 0xf4, 0xbf, 0x8c, 0xc7, 0xaa, 0x73, 0x26, 0xf9, 0x5f,
+	0x69, 0x70, 0xf9, 0x21, 0x0e, 0x22, 0xa9, 0xcf, 0xc4, 0x98, 0x36, 0x69, 0xb4, 0x7c, 0xc9, 0x47,
+	0x64, 0xa5, 0xf0, 0xa7, 0x32, 0xf2, 0x7d, 0x9e, 0x83, 0x0b, 0x74, 0x58, 0x38, 0x1b, 0x41, 0x30,
+	0xc9, 0xc2, 0x40, 0x11, 0x28, 0x45, 0x55, 0xa0, 0x44, 0xe3, 0x69, 0x69, 0xe2, 0xf1, 0x54, 0xff,
+	0xb3, 0x1c, 0x2c, 0xa5, 0xad, 0x31, 0x8d, 0x5b, 0x14, 0xb2, 0xe6, 0x94, 0xb2, 0xea, 0x50, 0x8f,
+	0x20, 0x1b, 0xeb, 0xe1, 0xf8, 0x98, 0x80, 0x9d, 0xd9, 0xe1, 0xf1, 0x1f, 0x34, 0x58, 0x0a, 0x97,
+	0x62, 0x5b, 0x78, 0xb7, 0x87, 0xdd, 0xe0, 0xe4, 0x31, 0x94, 0x8e, 0x80, 0x9c, 0x22, 0x02, 0x2e,
+	0x41, 0x95, 0x70, 0x3e, 0xd1, 0x2a, 0x2b, 0x06, 0xd0, 0x85, 0xc7, 0x8e, 0x8d, 0x1d, 0x2b, 0x0a,
+	0x9f, 0xf0, 0x95, 0xce, 0xbf, 0x6c, 0xd7, 0xc2, 0x4f, 0x79, 0x04, 0x16, 0x59, 0x04, 0x56, 0x19,
+	0x84, 0xf5, 0xcd, 0x3f, 0xd0, 0xe0, 0xe2, 0x90, 0x1e, 0xd3, 0x78, 0xbf, 0x05, 0x65, 0xd6, 0x7a,
+	0xa4, 0x46, 0xf8, 0x4a, 0xbf, 0x6c, 0x0f, 0x6c, 0xc7, 0x8a, 0xe4, 0x0f, 0x5f, 0xd1, 0x15, 0xa8,
+	0x63, 0xd7, 0xdc, 0x76, 0x70, 0x87, 0xe1, 0x32, 0x15, 0x2a, 0x46, 0x8d, 0xc3, 0x36, 0x28, 0x48,
+	0xff, 0x35, 0x0d, 0x16, 0x68, 0x90, 0x0a, 0x19, 0xc9, 0xb3, 0x35, 0xf6, 0x32, 0xd4, 0xa4, 0x28,
+	0x14, 0xe2, 0xca, 0x20, 0x7d, 0x1f, 0x16, 0x93, 0xe2, 0x4c, 0x63, 0xb3, 0x17, 0x00, 0x22, 0x57,
+	0xf2, 0xce, 0x92, 0x37, 0x24, 0x88, 0xfe, 0x45, 0x2e, 0xdc, 0xf0, 0x64, 0xc6, 0x38, 0xe5, 0xed,
+	0x22, 0x16, 0x59, 0x72, 0xba, 0xaf, 0x32, 0x08, 0xfb, 0xbc, 0x0e, 0x75, 0xfc, 0x34, 0xf0, 0xcd,
+	0x4e, 0xdf, 0xf4, 0xcd, 0x1e, 0xef, 0x75, 0x13, 0x65, 0xe6, 0x1a, 0x23, 0xdb, 0x64, 0x54, 0xa9,
+	0x98, 0x2d, 0xa5, 0x63, 0xf6, 0x1f, 0xe9, 0x24, 0x4f, 0xc4, 0xec, 0x59, 0x37, 0xc8, 0x98, 0xee,
+	0xf7, 0xaf, 0x1a, 0x34, 0x99, 0x0a, 0x5c, 0x9f, 0x3e, 0x6d, 0x36, 0x45, 0xa3, 0xa5, 0x68, 0x46,
+	0xf4, 0xb0, 0x37, 0xa1, 0x24, 0xec, 0x9e, 0x9f, 0xd4, 0xee, 0x82, 0x60, 0x9c, 0x1a, 0xd7, 0xa1,
+	0xe9, 0xe3, 0xbe, 0x63, 0x76, 0xb1, 0xd5, 0x09, 0x99, 0x17, 0x19, 0xf3, 0xb9, 0x10, 0xbe, 0xc1,
+	0xc1, 0xfa, 0xef, 0xd2, 0xbd, 0xd6, 0xa4, 0x77, 0xa6, 0xe9, 0x1b, 0x1f, 0x02, 0xe2, 0xc6, 0xb0,
+	0x62, 0x0b, 0x85, 0x23, 0xfe, 0x55, 0xe5, 0xf0, 0x96, 0xb6, 0xa7, 0x31, 0x6f, 0xa7, 0x20, 0x84,
+	0xda, 0xfd, 0xd2, 0x43, 0x1c, 0x30, 0xd4, 0x7b, 0x34, 0x0b, 0x6d, 0xfa, 0xde, 0xae, 0x8f, 0x09,
+	0x39, 0xbf, 0xa1, 0xf4, 0x5b, 0x7c, 0x8a, 0xa8, 0x52, 0x69, 0x1a, 0xfb, 0x5f, 0x81, 0x3a, 0xe3,
+	0x81, 0xad, 0x8e, 0xef, 0x1d, 0x12, 0x11, 0x72, 0x35, 0x01, 0x33, 0xbc, 0x43, 0x16, 0x3b, 0x81,
+	0x17, 0x98, 0x0e, 0x47, 0x10, 0x63, 0x13, 0x83, 0xd0, 0xcf, 0xac, 0xbb, 0x86, 0x82, 0xd1, 0xc6,
+	0xf1, 0xf9, 0xb5, 0xf1, 0xef, 0x6b, 0x70, 0x21, 0xa5, 0xca, 0x34, 0xb6, 0xbd, 0xc3, 0x27, 0xb0,
+	0x5c, 0x99, 0xd9, 0xb5, 0x17, 0x95, 0x34, 0x12, 0x33, 0x8e, 0x8d, 0x5e, 0x84, 0xda, 0x8e, 0x69,
+	0x3b, 0x1d, 0x1f, 0x9b, 0xc4, 0x73, 0x85, 0xa2, 0x40, 0x41, 0x06, 0x83, 0xd0, 0xaa, 0x0d, 0xab,
+	0x4c, 0x9d, 0xf3, 0xe4, 0xf8, 0x79, 0x4e, 0xd4, 0xc0, 0xfe, 0x7f, 0xd4, 0xeb, 0x61, 0xfd, 0x9f,
+	0x35, 0x78, 0x4e, 0x8e, 0xbd, 0xd3, 0x5f, 0xfe, 0x4d, 0xe7, 0xdd, 0x3f, 0xca, 0xc3, 0x92, 0x98,
+	0x3e, 0xa5, 0x74, 0x4a, 0xce, 0x75, 0x35, 0xc5, 0x5c, 0x37, 0x9c, 0x47, 0xe6, 0x92, 0xf3, 0xc8,
+	0xa8, 0x3f, 0xe5, 0x8f, 0xd5, 0x9f, 0x22, 0x41, 0xa3, 0x9d, 0xc8, 0x50, 0x50, 0xba, 0x4a, 0x92,
+	0x46, 0xd5, 0xe2, 0x71, 0x47, 0xd5, 0x74, 0xf2, 0x2c, 0x8d, 0x4b, 0x9e, 0xe5, 0x54, 0xf2, 0x44,
+	0x2f, 0xc1, 0x1c, 0x97, 0x6d, 0xc7, 0x76, 0x70, 0x87, 0xd8, 0x9f, 0x62, 0x56, 0x75, 0xc8, 0x1b,
+	0x0d, 0x06, 0x7e, 0x60, 0x3b, 0x78, 0xcb, 0xfe, 0x94, 0xce, 0x6a, 0x1b, 0xcc, 0x0a, 0x6c, 0xc9,
+	0xd3, 0xe9, 0xd1, 0xfa, 0x03, 0x63, 0xc5, 0x80, 0x74, 0xa9, 0xf3, 0x84, 0xd0, 0xe2, 0xa9, 0xeb,
+	0x59, 0x78, 0x63, 0xbd, 0x05, 0xec, 0xa3, 0x78, 0x4b, 0xe7, 0x93, 0xda, 0x50, 0x3e, 0xf9, 0x1d,
+	0x0d, 0xda, 0xaa, 0xd0, 0x9b, 0x26, 0xf7, 0x3d, 0x84, 0x8a, 0x70, 0x69, 0x38, 0x9a, 0xdf, 0x50,
+	0x2f, 0x56, 0x95, 0x21, 0x62, 0x44, 0xc4, 0xfa, 0x7f, 0xe4, 0xa0, 0xfd, 0x2e, 0x09, 0xec, 0x5e,
+	0x3c, 0x3d, 0xee, 0x9a, 0x8e, 0x73, 0x6e, 0x3b, 0x06, 0x9a, 0x85, 0x9c, 0xfb, 0x89, 0x08, 0x95,
+	0x9c, 0xfb, 0x09, 0x42, 0x50, 0x08, 0xbc, 0xfe, 0xbe, 0x88, 0x0d, 0xf6, 0x8c, 0x1e, 0x40, 0x83,
+	0x60, 0xd3, 0xef, 0xee, 0x85, 0x29, 0xa7, 0x32, 0x69, 0x68, 0xd6, 0x39, 0xdd, 0x66, 0x14, 0xa0,
+	0x3d, 0xf3, 0x69, 0x27, 0xf2, 0x84, 0x88, 0x9a, 0x9e, 0xf9, 0x34, 0x5c, 0xd9, 0xe8, 0x01, 0x20,
+	0xd9, 0x07, 0xdc, 0xba, 0x27, 0xee, 0xa2, 0x5c, 0xb9, 0x7c, 0xa4, 0xdc, 0x12, 0x94, 0x7c, 0xd6,
+	0x22, 0x33, 0x53, 0xce, 0x10, 0x6f, 0xfa, 0xdf, 0x69, 0xf0, 0xbc, 0xd2, 0xab, 0xd3, 0xc4, 0x5c,
+	0x68, 0xc9, 0x9c, 0x64, 0xc9, 0x58, 0x80, 0xbc, 0x2c, 0x00, 0xba, 0x2f, 0xc5, 0x67, 0x81, 0x19,
+	0xf7, 0xda, 0xd8, 0xf8, 0x14, 0x32, 0xc6, 0xb1, 0xf9, 0x7b, 0x39, 0x68, 0x6c, 0xb8, 0x04, 0xfb,
+	0xc1, 0xd9, 0xdf, 0xa6, 0x43, 0xdf, 0x82, 0x1a, 0x8b, 0x51, 0xd2, 0xb1, 0xcc, 0xc0, 0x14, 0xc9,
+	0xee, 0x05, 0x65, 0x39, 0xf8, 0x01, 0xc5, 0xa3, 0x05, 0x4a, 0x83, 0x07, 0x3a, 0xa1, 0xcf, 0xe8,
+	0x79, 0xa8, 0xee, 0x99, 0x64, 0xaf, 0xb3, 0x8f, 0x8f, 0xf8, 0xde, 0x49, 0xc3, 0xa8, 0x50, 0xc0,
+	0x7b, 0xf8, 0x88, 0xa0, 0xe7, 0xa0, 0xe2, 0x0e, 0x7a, 0x71, 0x96, 0x6b, 0x18, 0x65, 0x77, 0xd0,
+	0x63, 0x13, 0xc4, 0x7f, 0xca, 0xc1, 0xec, 0x93, 0x41, 0x60, 0xf2, 0xcd, 0x6f, 0x32, 0x70, 0x82,
+	0x93, 0xb9, 0x77, 0x15, 0xf2, 0x7c, 0xfd, 0x4c, 0x29, 0x5a, 0x4a, 0xc1, 0x37, 0xd6, 0x89, 0x41,
+	0x91, 0x58, 0x21, 0x77, 0xd0, 0xed, 0x8a, 0x0d, 0x87, 0x3c, 0x13, 0xb6, 0x4a, 0x21, 0xcc, 0x91,
+	0x54, 0x15, 0xec, 0xfb, 0xd1, 0x76, 0x04, 0x53, 0x05, 0xfb, 0x7c, 0x22, 0x42, 0x77, 0x10, 0xcc,
+	0xee, 0xbe, 0xeb, 0x1d, 0x3a, 0xd8, 0xda, 0xc5, 0x16, 0xeb, 0xc1, 0x15, 0x23, 0x01, 0xe3, 0x7d,
+	0x9c, 0x3a, 0xbe, 0xd3, 0x75, 0x03, 0xd1, 0x99, 0xab, 0x1c, 0x72, 0xdf, 0x0d, 0xe8, 0x67, 0x0b,
+	0x3b, 0x38, 0xc0, 0xec, 0xb3, 0xc8, 0xfa, 0x1c, 0x22, 0x3e, 0x0f, 0xfa, 0x11, 0x35, 0x4f, 0xf8,
+	0x55, 0x0e, 0xa1, 0x9f, 0x2f, 0x41, 0x35, 0xae, 0x56, 0x57, 0xe3, 0x92, 0x1a, 0x03, 0xe8, 0x7f,
+	0xad, 0x41, 0x63, 0x9d, 0x35, 0x75, 0x0e, 0x82, 0x0e, 0x41, 0x01, 0x3f, 0xed, 0xfb, 0x22, 0x0b,
+	0xb2, 0x67, 0xfd, 0x00, 0x9a, 0x9b, 0x74, 0x49, 0xb9, 0xe7, 0x39, 0x16, 0xf6, 0x59, 0xea, 0x42,
+	0x4d, 0xc8, 0x07, 0xe6, 0xae, 0x58, 0x0c, 0xd3, 0x47, 0xf4, 0x86, 0xd8, 0xe9, 0xe4, 0x73, 0xe7,
+	0xaf, 0x2a, 0x3b, 0xa7, 0xd4, 0x8c, 0x54, 0x40, 0x5c, 0x82, 0x12, 0x3b, 0x24, 0xc2, 0x97, 0xc9,
+	0x75, 0x43, 0xbc, 0xe9, 0x1f, 0x27, 0xf8, 0x3e, 0xf4, 0xbd, 0x41, 0x1f, 0x6d, 0x40, 0xbd, 0x1f,
+	0xc3, 0x68, 0x38, 0x66, 0x2f, 0x3c, 0xd3, 0x42, 0x1b, 0x09, 0x52, 0xfd, 0x8b, 0x02, 0x34, 0xb6,
+	0x58, 0xf2, 0x3d, 0x0f, 0x25, 0x07, 0x6a, 0x71, 0x8b, 0x38, 0xc2, 0x31, 0xf4, 0x91, 0x9e, 0xae,
+	0x90, 0x14, 0xea, 0xec, 0x52, 0x03, 0xb1, 0xd0, 0xae, 0x1b, 0xcd, 0x7e, 0xda, 0x70, 0xaf, 0x43,
+	0xc5, 0x22, 0x0e, 0x9f, 0x52, 0x95, 0x99, 0x8b, 0xd4, 0xfa, 0xad, 0x13, 0x87, 0xb9, 0xa6, 0x6c,
+	0xf1, 0x07, 0xf4, 0x15, 0x68, 0x78, 0x83, 0xa0, 0x3f, 0x08, 0x3a, 0x3c, 0xb5, 0xb0, 0xa1, 0xad,
+	0x6a, 0xd4, 0x39, 0x90, 0x65, 0x1e, 0x32, 0x3c, 0xfe, 0x55, 0x4f, 0x36, 0xfe, 0x5d, 0x87, 0x66,
+	0xe0, 0x9b, 0x07, 0xd8, 0x91, 0x8e, 0x7f, 0x00, 0xeb, 0x50, 0x73, 0x1c, 0x1e, 0x1f, 0xfd, 0xb8,
+	0x05, 0x0b, 0xbb, 0x03, 0xd3, 0x37, 0xdd, 0x00, 0x63, 0x09, 0xbb, 0xc6, 0xb0, 0x51, 0xf4, 0x29,
+	0x71, 0x56, 0x44, 0x1a, 0xe6, 0xeb, 0xe9, 0xf9, 0xef, 0x7b, 0x50, 0x78, 0x64, 0x07, 0xcc, 0xce,
+	0x1b, 0xeb, 0x3c, 0xb0, 0xf2, 0x3c, 0x37, 0x3d, 0x07, 0x15, 0xdf, 0x3b, 0xe4, 0x59, 0x38, 0xc7,
+	0x22, 0xb4, 0xec, 0x7b, 0x87, 0x2c, 0xc5, 0xb2, 0xf3, 0x6f, 0x9e, 0x2f, 0x42, 0x37, 0x67, 0x88,
+	0x37, 0x7a, 0x24, 0x32, 0x8a, 0x2d, 0x9a, 0x40, 0xc9, 0xc9, 0x32, 0xe8, 0xb7, 0xa0, 0xec, 0x73,
+	0xfa, 0x91, 0xa7, 0x81, 0x64, 0x4e, 0x6c, 0x14, 0x08, 0xa9, 0xe8, 0xb1, 0xc5, 0xfa, 0x03, 0x67,
+	0x40, 0x9e, 0x45, 0x88, 0xab, 0x6a, 0xef, 0x79, 0x75, 0xdd, 0xff, 0xd7, 0x73, 0xd0, 0x10, 0x62,
+	0x4c, 0x33, 0x5f, 0xc8, 0x14, 0x65, 0x0b, 0x6a, 0x94, 0x25, 0x9d, 0x37, 0x85, 0x85, 0x8b, 0xda,
+	0xda, 0x9a, 0x32, 0x29, 0x24, 0xc4, 0x60, 0xe7, 0xa8, 0xb6, 0x18, 0xd1, 0xbb, 0x6e, 0xe0, 0x1f,
+	0x19, 0xd0, 0x8d, 0x00, 0xed, 0x8f, 0x61, 0x2e, 0xf5, 0x99, 0xc6, 0xc6, 0x3e, 0x3e, 0x0a, 0xb3,
+	0xde, 0x3e, 0x3e, 0x42, 0xaf, 0xc9, 0xa7, 0xdd, 0xb2, 0x86, 0xe7, 0xc7, 0x9e, 0xbb, 0x7b, 0xd7,
+	0xf7, 0xcd, 0x23, 0x71, 0x1a, 0xee, 0xad, 0xdc, 0x1b, 0x9a, 0xfe, 0x37, 0x39, 0xa8, 0x7f, 0x7b,
+	0x80, 0xfd, 0xa3, 0xd3, 0xcc, 0x3e, 0x61, 0xba, 0x2f, 0xc4, 0xe9, 0x7e, 0xb8, 0xc3, 0x17, 0x15,
+	0x1d, 0x5e, 0x91, 0xb6, 0x4a, 0xca, 0xb4, 0xa5, 0xea, 0xd1, 0xe5, 0x63, 0xf5, 0xe8, 0x4a, 0x56,
+	0x8f, 0x66, 0xd1, 0x2d, 0x4c, 0x38, 0x55, 0x27, 0x4b, 0xcc, 0xb3, 0x72, 0xc7, 0x9d, 0x67, 0xd1,
+	0x43, 0x0e, 0xd5, 0xef, 0xe0, 0x6e, 0xe0, 0xf9, 0x34, 0x5b, 0x28, 0x6c, 0xaf, 0x4d, 0xb0, 0x2a,
+	0xc9, 0xa5, 0x57, 0x25, 0xb7, 0xa1, 0x62, 0x5b, 0x1d, 0x93, 0x86, 0x4d, 0x2b, 0x3f, 0x66, 0x0a,
+	0x55, 0xb6, 0x2d, 0x16, 0x5f, 0x93, 0x17, 0xb0, 0x7f, 0x5b, 0x83, 0x3a, 0x97, 0x99, 0x70, 0xca,
+	0x6f, 0x48, 0xec, 0x34, 0x55, 0x2c, 0x8b, 0x97, 0x48, 0xd1, 0x47, 0x33, 0x31, 0xdb, 0xbb, 0x00,
+	0xd4, 0x76, 0x82, 0x9c, 0x77, 0x85, 0x65, 0xa5, 0xb4, 0x9c, 0x9c, 0xd9, 0xf1, 0xd1, 0x8c, 0x51,
+	0xa5, 0x54, 0xac, 0x89, 0x7b, 0x65, 0x28, 0x32, 0x6a, 0xfd, 0x7f, 0x35, 0x58, 0xb8, 0x6f, 0x3a,
+	0xdd, 0x75, 0x9b, 0x04, 0xa6, 0xdb, 0x9d, 0x62, 0xd2, 0xf4, 0x16, 0x94, 0xbd, 0x7e, 0xc7, 0xc1,
+	0x3b, 0x81, 0x10, 0xe9, 0xca, 0x08, 0x8d, 0xb8, 0x19, 0x8c, 0x92, 0xd7, 0x7f, 0x8c, 0x77, 0x02,
+	0xf4, 0x36, 0x54, 0xbc, 0x7e, 0xc7, 0xb7, 0x77, 0xf7, 0x82, 0x56, 0x7e, 0x52, 0xe2, 0xb2, 0xd7,
+	0x37, 0x28, 0x85, 0xb4, 0x45, 0x51, 0x38, 0xe6, 0x16, 0x85, 0xfe, 0x6f, 0x43, 0xea, 0x4f, 0x11,
+	0xda, 0x6f, 0x41, 0xc5, 0x76, 0x83, 0x8e, 0x65, 0x93, 0xd0, 0x04, 0x97, 0xd5, 0x31, 0xe4, 0x06,
+	0x4c, 0x03, 0xe6, 0x53, 0x37, 0xa0, 0xbc, 0xd1, 0x3b, 0x00, 0x3b, 0x8e, 0x67, 0x0a, 0x6a, 0x6e,
+	0x83, 0x17, 0xd5, 0xbd, 0x82, 0xa2, 0x85, 0xf4, 0x55, 0x46, 0x44, 0x5b, 0x88, 0x5d, 0xfa, 0x2f,
+	0x1a, 0x5c, 0xd8, 0xc4, 0x3e, 0xb1, 0x49, 0x80, 0xdd, 0x20, 0x5a, 0xa1, 0xed, 0x78, 0x63, 0x96,
+	0xad, 0x3f, 0x95, 0xd2, 0x60, 0x62, 0xa5, 0x23, 0x8a, 0xb1, 0x62, 0xa5, 0x13, 0x9e, 0x58, 0xe0,
+	0x8b, 0xfe, 0xd9, 0x0c, 0x37, 0x09, 0x79, 0xe5, 0x2d, 0x2a, 0xfd, 0x37, 0xf8, 0xe9, 0x41, 0xa5,
+	0x52, 0x27, 0x0f, 0xd8, 0x25, 0x10, 0x09, 0x3c, 0x95, 0xce, 0x5f, 0x82, 0x54, 0xee, 0xc8, 0x38,
+	0xd3, 0xf8, 0x63, 0x0d, 0x96, 0xb3, 0xa5, 0x9a, 0x66, 0xe4, 0x7d, 0x07, 0x8a, 0xb6, 0xbb, 0xe3,
+	0x85, 0x5b, 0x43, 0xab, 0xea, 0xf9, 0xb6, 0x92, 0x2f, 0x27, 0xd4, 0xff, 0x5b, 0x83, 0x26, 0xcb,
+	0xd5, 0xa7, 0xe0, 0xfe, 0x1e, 0xee, 0xf1, 0xad, 0x3a, 0xe1, 0xfe, 0x1e, 0xee, 0xb1, 0x4d, 0x3a,
+	0x39, 0x32, 0x8a, 0xc9, 0xc8, 0x18, 0xbd, 0xf9, 0x2b, 0xd7, 0xfc, 0xca, 0x89, 0x9a, 0x9f, 0xfe,
+	0x25, 0xdf, 0x9b, 0x4b, 0xab, 0x7a, 0x7a, 0x41, 0xf1, 0x23, 0x0d, 0x9e, 0x57, 0x0a, 0x34, 0x4d,
+	0x3c, 0x7c, 0x23, 0x19, 0x0f, 0xea, 0xf5, 0xd7, 0x10, 0x4b, 0x11, 0x0a, 0xaf, 0x42, 0x7d, 0x7d,
+	0xd0, 0xeb, 0x45, 0x13, 0x9f, 0x2b, 0x50, 0xf7, 0xf9, 0x23, 0x5f, 0x9e, 0xf0, 0xe1, 0xb2, 0x26,
+	0x60, 0x74, 0x11, 0xa2, 0xdf, 0x80, 0x86, 0x20, 0x11, 0x52, 0xb7, 0xa1, 0xe2, 0x8b, 0x67, 0x81,
+	0x1f, 0xbd, 0xeb, 0x17, 0x60, 0xc1, 0xc0, 0xbb, 0x34, 0x12, 0xfd, 0xc7, 0xb6, 0xbb, 0x2f, 0xd8,
+	0xe8, 0x9f, 0x69, 0xb0, 0x98, 0x84, 0x8b, 0xb6, 0xbe, 0x0e, 0x65, 0xd3, 0xb2, 0x7c, 0x4c, 0xc8,
+	0x48, 0xb7, 0xdc, 0xe5, 0x38, 0x46, 0x88, 0x2c, 0x59, 0x2e, 0x37, 0xb1, 0xe5, 0xf4, 0x0e, 0xcc,
+	0x3f, 0xc4, 0xc1, 0x13, 0x1c, 0xf8, 0x53, 0x55, 0x0b, 0x5a, 0x74, 0x65, 0xc0, 0x88, 0x45, 0x58,
+	0x84, 0xaf, 0xfa, 0x17, 0x1a, 0x20, 0x99, 0xc3, 0x34, 0x6e, 0x96, 0xad, 0x9c, 0x4b, 0x5a, 0x99,
+	0x9f, 0xa7, 0xed, 0xf5, 0x3d, 0x17, 0xbb, 0x81, 0x3c, 0xc5, 0x6c, 0x44, 0x50, 0x16, 0x7e, 0xff,
+	0x13, 0xdf, 0x58, 0xf2, 0xb1, 0x85, 0xdd, 0xc0, 0x36, 0xa7, 0xd8, 0x0b, 0x6e, 0x43, 0x65, 0x40,
+	0xb0, 0x2f, 0xcd, 0x98, 0xa2, 0x77, 0xfa, 0xad, 0x6f, 0x12, 0x72, 0xe8, 0xf9, 0x96, 0x10, 0x25,
+	0x7a, 0x1f, 0x71, 0x0e, 0x89, 0xdf, 0xb0, 0x51, 0x9f, 0x43, 0xfa, 0x3a, 0x5c, 0xec, 0x79, 0x96,
+	0xbd, 0x63, 0xab, 0x8e, 0x2f, 0x51, 0xb2, 0x0b, 0xe1, 0xe7, 0x04, 0x9d, 0xfe, 0xe3, 0x1c, 0x5c,
+	0xfc, 0xa8, 0x6f, 0xfd, 0x0c, 0x74, 0x5e, 0x86, 0x9a, 0xe7, 0x58, 0x9b, 0x49, 0xb5, 0x65, 0x10,
+	0xc5, 0x70, 0xf1, 0x61, 0x84, 0xc1, 0x27, 0xfa, 0x32, 0x68, 0xe4, 0x19, 0xad, 0x13, 0xd9, 0xa6,
+	0x34, 0xca, 0x36, 0xbb, 0x70, 0x91, 0x6f, 0x86, 0x3d, 0x63, 0xd3, 0xe8, 0x3f, 0x80, 0x0b, 0x8f,
+	0x6d, 0x12, 0x50, 0x36, 0x1f, 0x11, 0xec, 0x4f, 0xd9, 0x13, 0x2e, 0x41, 0x35, 0x6c, 0x39, 0x3c,
+	0x3e, 0x17, 0x03, 0xf4, 0x47, 0xb0, 0x98, 0xe2, 0x75, 0x42, 0x8d, 0xf4, 0x65, 0x00, 0xc3, 0x73,
+	0xf0, 0xbb, 0x6e, 0x60, 0x07, 0x47, 0x74, 0x79, 0x26, 0x2d, 0x20, 0xd8, 0x33, 0xc5, 0xa0, 0x3c,
+	0x46, 0x60, 0xfc, 0x02, 0xcc, 0xf3, 0x1e, 0x47, 0x5b, 0x3a, 0xb9, 0x71, 0x5f, 0x87, 0x12, 0x66,
+	0x4c, 0x5a, 0x39, 0xd5, 0xe4, 0x4f, 0xbc, 0xc4, 0xd2, 0x1a, 0x02, 0x5d, 0xff, 0x79, 0x98, 0xa3,
+	0xd5, 0xee, 0xe9, 0xb8, 0x3f, 0x0f, 0x55, 0xdf, 0x73, 0xb0, 0xbc, 0x38, 0xaa, 0x50, 0x00, 0x4b,
+	0x2a, 0x7f, 0xab, 0xc1, 0xd2, 0x07, 0x7d, 0xec, 0x9b, 0x01, 0xa6, 0xb6, 0x98, 0x8e, 0xd3, 0xa8,
+	0xfe, 0x95, 0x90, 0x22, 0x9f, 0x94, 0x02, 0xbd, 0x9d, 0xb8, 0x2b, 0xb1, 0xa2, 0x34, 0x4f, 0x4a,
+	0x4a, 0xe9, 0x98, 0xe7, 0x1f, 0x6a, 0x30, 0xbf, 0x85, 0xe9, 0x48, 0x3d, 0x9d, 0xf8, 0xb7, 0xa1,
+	0x40, 0x25, 0x9a, 0xd4, 0x49, 0x0c, 0x19, 0xad, 0xc2, 0xbc, 0xed, 0x76, 0x9d, 0x81, 0x85, 0x3b,
+	0x54, 0xd7, 0x0e, 0x1d, 0x98, 0x99, 0x7e, 0x15, 0x63, 0x4e, 0x7c, 0xa0, 0x22, 0xd3, 0x51, 0x5b,
+	0x7f, 0xca, 0x43, 0x32, 0xaa, 0x04, 0x70, 0x76, 0xda, 0x71, 0xd8, 0xdd, 0x81, 0x22, 0x65, 0x13,
+	0x4e, 0x17, 0xd4, 0x54, 0x71, 0x54, 0x1b, 0x1c, 0x9b, 0xae, 0xef, 0x91, 0x6c, 0xa2, 0x69, 0x3a,
+	0xf0, 0x9b, 0xf2, 0x56, 0x5a, 0x7e, 0xa4, 0xe8, 0x5c, 0xd3, 0x78, 0x13, 0x2d, 0xf6, 0x14, 0x73,
+	0xe3, 0x34, 0x9e, 0xa2, 0x7a, 0x8d, 0xf4, 0x94, 0x64, 0x04, 0x86, 0x2c, 0x7b, 0x8a, 0x45, 0xa2,
+	0xc2, 0x53, 0x54, 0xe6, 0xd0, 0x53, 0x5c, 0xc2, 0xd0, 0x53, 0x8c, 0x9d, 0x76, 0x1c, 0x76, 0x77,
+	0xa0, 0x48, 0xd9, 0x8c, 0x37, 0x52, 0xe8, 0x29, 0x86, 0x2d, 0x79, 0x4a, 0x08, 0xf0, 0xec, 0x3d,
+	0x15, 0x6b, 0x1a, 0x7b, 0x4a, 0x87, 0xfa, 0x07, 0xdb, 0x3f, 0xc0, 0xdd, 0x60, 0x44, 0x76, 0xbc,
+	0x0a, 0x73, 0x9b, 0xbe, 0x7d, 0x60, 0x3b, 0x78, 0x77, 0x54, 0x9a, 0xfd, 0x2f, 0x0d, 0x6a, 0x0f,
+	0x7d, 0xd3, 0x0d, 0x9b, 0x3a, 0x51, 0xdc, 0xbf, 0x09, 0x25, 0x8f, 0xc9, 0x33, 0x72, 0x03, 0x42,
+	0x16, 0xd9, 0x10, 0x04, 0xf4, 0x10, 0x00, 0x7f, 0x92, 0x73, 0x0f, 0x70, 0x10, 0xcb, 0x3e, 0xf7,
+	0xa0, 0xda, 0x0f, 0xf5, 0x60, 0x29, 0xa8, 0x96, 0x55, 0x74, 0x49, 0x6a, 0x6b, 0xc4, 0x64, 0xfa,
+	0x0f, 0x23, 0xb7, 0x31, 0x55, 0x4f, 0x1e, 0xda, 0x6f, 0xa4, 0xc6, 0x8a, 0x65, 0xa5, 0x24, 0x92,
+	0x3d, 0xa3, 0xc1, 0xe2, 0x57, 0xe8, 0x41, 0x62, 0x59, 0x84, 0x69, 0x42, 0xe7, 0x6d, 0xa8, 0xb0,
+	0x66, 0xed, 0x28, 0x80, 0xc7, 0x0b, 0x12, 0x51, 0xb0, 0xcb, 0xf5, 0x22, 0x5f, 0x47, 0x36, 0x3b,
+	0x05, 0x93, 0xa0, 0x6f, 0x8a, 0x71, 0x85, 0x9f, 0x9a, 0xb9, 0x3e, 0x6a, 0x5c, 0x89, 0xe4, 0x8c,
+	0x07, 0x96, 0xd5, 0x2b, 0x50, 0x09, 0x6f, 0x14, 0xa0, 0x32, 0xe4, 0xef, 0x3a, 0x4e, 0x73, 0x06,
+	0xd5, 0xa1, 0xb2, 0x21, 0x8e, 0xcd, 0x37, 0xb5, 0xd5, 0x9f, 0x83, 0xb9, 0x54, 0x29, 0x0e, 0x55,
+	0xa0, 0xf0, 0xbe, 0xe7, 0xe2, 0xe6, 0x0c, 0x6a, 0x42, 0xfd, 0x9e, 0xed, 0x9a, 0xfe, 0x11, 0xdf,
+	0xdb, 0x6a, 0x5a, 0x68, 0x0e, 0x6a, 0x6c, 0x8f, 0x47, 0x00, 0xf0, 0xea, 0x3b, 0xb0, 0xa0, 0x18,
+	0xd8, 0xd0, 0x3c, 0x34, 0xee, 0x5a, 0x6c, 0x06, 0xf4, 0xa1, 0x47, 0x81, 0xcd, 0x19, 0xb4, 0x04,
+	0xc8, 0xc0, 0x3d, 0xef, 0x80, 0x21, 0x3e, 0xf0, 0xbd, 0x1e, 0x83, 0x6b, 0xab, 0x2f, 0xc3, 0xa2,
+	0x4a, 0x05, 0x54, 0x85, 0x22, 0x33, 0x49, 0x73, 0x06, 0x01, 0x94, 0x0c, 0x7c, 0xe0, 0xed, 0xe3,
+	0xa6, 0xb6, 0xf6, 0x93, 0xab, 0xd0, 0x78, 0xc2, 0x34, 0xdf, 0xc2, 0xfe, 0x81, 0xdd, 0xc5, 0xa8,
+	0x03, 0xcd, 0xf4, 0x8f, 0x10, 0xd0, 0xd7, 0x94, 0xa6, 0xca, 0xf8, 0x5f, 0x42, 0x7b, 0x54, 0x44,
+	0xe9, 0x33, 0xe8, 0xfb, 0x30, 0x9b, 0xfc, 0x45, 0x01, 0x52, 0xef, 0x7a, 0x28, 0xff, 0x63, 0x30,
+	0xae, 0xf1, 0x0e, 0x34, 0x12, 0x7f, 0x1c, 0x40, 0x6a, 0x2f, 0xab, 0xfe, 0x4a, 0xd0, 0x56, 0x27,
+	0x11, 0xf9, 0xaf, 0x00, 0x5c, 0xfa, 0xe4, 0x9d, 0xe4, 0x0c, 0xe9, 0x95, 0x17, 0x97, 0xc7, 0x49,
+	0x6f, 0xc2, 0xfc, 0xd0, 0x15, 0x63, 0xf4, 0xb2, 0x3a, 0x25, 0x66, 0x5c, 0x45, 0x1e, 0xc7, 0xe2,
+	0x10, 0xd0, 0xf0, 0xcd, 0x7a, 0x74, 0x53, 0xed, 0x81, 0xac, 0xff, 0x0a, 0xb4, 0x6f, 0x4d, 0x8c,
+	0x1f, 0x19, 0xee, 0x97, 0x35, 0xb8, 0x98, 0x71, 0x2f, 0x18, 0xdd, 0x56, 0x77, 0xe1, 0x91, 0x97,
+	0x9b, 0xdb, 0xaf, 0x1d, 0x8f, 0x28, 0x12, 0xc4, 0x85, 0xb9, 0xd4, 0x55, 0x59, 0x74, 0x23, 0xf3,
+	0xfa, 0xd0, 0xf0, 0x9d, 0xe1, 0xf6, 0xd7, 0x26, 0x43, 0x8e, 0xf8, 0xd1, 0x72, 0x57, 0xf2, 0x7e,
+	0x69, 0x06, 0x3f, 0xf5, 0x2d, 0xd4, 0x71, 0x0e, 0xfd, 0x2e, 0x34, 0x12, 0x17, 0x41, 0x33, 0x22,
+	0x5e, 0x75, 0x59, 0x74, 0x5c, 0xd3, 0x1f, 0x43, 0x5d, 0xbe, 0xaf, 0x89, 0x56, 0xb2, 0xfa, 0xd2,
+	0x50, 0xc3, 0xc7, 0xe9, 0x4a, 0x11, 0x31, 0x19, 0xd1, 0x95, 0x86, 0x6e, 0xb0, 0x4d, 0xde, 0x95,
+	0xa4, 0xf6, 0x47, 0x76, 0xa5, 0x63, 0xb3, 0xf8, 0x4c, 0x83, 0x25, 0xf5, 0x75, 0x3f, 0xb4, 0x96,
+	0x15, 0x9b, 0xd9, 0x17, 0x1b, 0xdb, 0xb7, 0x8f, 0x45, 0x13, 0x59, 0x71, 0x1f, 0x66, 0x93, 0x97,
+	0xda, 0x32, 0xac, 0xa8, 0xbc, 0x07, 0xd8, 0xbe, 0x31, 0x11, 0x6e, 0xc4, 0xec, 0x23, 0xa8, 0x49,
+	0x3f, 0x24, 0x42, 0xd7, 0x46, 0xc4, 0xb1, 0xfc, 0x3f, 0x9f, 0x71, 0x96, 0xfc, 0x36, 0x54, 0xa3,
+	0x1f, 0x0c, 0xa1, 0xab, 0x99, 0xf1, 0x7b, 0x9c, 0x26, 0xb7, 0x00, 0xe2, 0xdf, 0x0a, 0xa1, 0x97,
+	0x94, 0x6d, 0x0e, 0xfd, 0x77, 0x68, 0x5c, 0xa3, 0x91, 0xfa, 0xfc, 0x7c, 0xd4, 0x28, 0xf5, 0xe5,
+	0xa3, 0xdc, 0xe3, 0x9a, 0xdd, 0x83, 0x46, 0x98, 0x3a, 0x79, 0xc3, 0xd7, 0x47, 0xa6, 0xd7, 0x44,
+	0xd3, 0xab, 0x93, 0xa0, 0x46, 0xfe, 0xdb, 0x83, 0x46, 0xe2, 0x58, 0x7f, 0x06, 0x27, 0xd5, 0x2d,
+	0x86, 0xf6, 0xea, 0x24, 0xa8, 0x11, 0xa7, 0x1f, 0x4a, 0x37, 0x08, 0x12, 0xb7, 0x34, 0xd0, 0xab,
+	0x23, 0xdb, 0x51, 0x5d, 0x52, 0x69, 0xaf, 0x1d, 0x87, 0x24, 0x12, 0x41, 0x44, 0x15, 0x37, 0x69,
+	0x76, 0x54, 0x1d, 0xc7, 0x53, 0x61, 0x54, 0xf1, 0x36, 0x47, 0x44, 0xd5, 0x71, 0x1a, 0x3d, 0x64,
+	0xfb, 0xca, 0xe9, 0xb3, 0xe1, 0x37, 0xc7, 0x9a, 0x3b, 0x99, 0x3e, 0x6e, 0x4d, 0x8c, 0x1f, 0x19,
+	0xe8, 0x53, 0x58, 0x50, 0x1c, 0x3d, 0x45, 0xea, 0x96, 0xb2, 0x8f, 0x1e, 0xb7, 0x5f, 0x99, 0x9c,
+	0x20, 0xe2, 0xbd, 0x05, 0x25, 0x7e, 0x60, 0x14, 0xe9, 0x19, 0x97, 0x9b, 0xa4, 0xd3, 0xa4, 0xed,
+	0xaf, 0x28, 0x71, 0x92, 0x67, 0x29, 0x79, 0xa3, 0x7c, 0x0f, 0x34, 0xa3, 0xd1, 0xc4, 0x69, 0xc1,
+	0x49, 0x1b, 0x35, 0xa0, 0xc4, 0xcf, 0x01, 0x65, 0x34, 0x9a, 0x38, 0xea, 0xd6, 0x1e, 0x8d, 0xc3,
+	0x57, 0xd3, 0x33, 0x68, 0x13, 0x8a, 0xec, 0xbc, 0x0c, 0xba, 0x32, 0xea, 0x2c, 0xcd, 0xa8, 0x16,
+	0x13, 0xc7, 0x6d, 0xf4, 0x19, 0xf4, 0x01, 0x14, 0x59, 0x59, 0x28, 0xa3, 0x45, 0xf9, 0x40, 0x4c,
+	0x7b, 0x24, 0x4a, 0x28, 0xa2, 0x05, 0x75, 0xb9, 0x5c, 0x9e, 0x31, 0xf8, 0x2b, 0x0e, 0x14, 0xb4,
+	0x27, 0xc1, 0x0c, 0xb9, 0xfc, 0xaa, 0x06, 0xad, 0xac, 0xca, 0x2a, 0xca, 0x9c, 0xe1, 0x8d, 0x2a,
+	0x0f, 0xb7, 0xef, 0x1c, 0x93, 0x4a, 0xee, 0x0e, 0x8a, 0x7a, 0x1e, 0xca, 0xec, 0x58, 0x19, 0xa5,
+	0xc8, 0xf6, 0x2b, 0x93, 0x13, 0x44, 0xbc, 0x37, 0xa1, 0xc8, 0xea, 0x70, 0x19, 0xee, 0x93, 0xcb,
+	0x7a, 0x6d, 0x7d, 0x14, 0x4a, 0xd4, 0x22, 0x86, 0xba, 0x5c, 0x94, 0xcb, 0xf0, 0x9f, 0xa2, 0x9e,
+	0xd7, 0xbe, 0x3e, 0x01, 0x66, 0xc4, 0xa6, 0x03, 0x10, 0x17, 0xc5, 0x32, 0x32, 0xe2, 0x50, 0x5d,
+	0xae, 0x7d, 0x6d, 0x2c, 0x9e, 0xc4, 0xa0, 0x99, 0x2e, 0x73, 0x8d, 0x5e, 0x8f, 0xa6, 0xcb, 0x1f,
+	0xe3, 0x97, 0x8c, 0xcd, 0x74, 0x4d, 0x29, 0x83, 0x41, 0x46, 0xe9, 0x69, 0x02, 0x06, 0xe9, 0xca,
+	0x4c, 0x06, 0x83, 0x8c, 0x02, 0xce, 0x04, 0xf3, 0x87, 0x44, 0x95, 0x24, 0x63, 0x54, 0x57, 0x55,
+	0x52, 0xda, 0xab, 0x93, 0xa0, 0x4a, 0x59, 0x1b, 0xe2, 0x0a, 0x48, 0x86, 0xb7, 0x87, 0x4a, 0x24,
+	0xe3, 0xc4, 0xff, 0x00, 0x2a, 0x61, 0x59, 0x03, 0x7d, 0x35, 0x73, 0x98, 0x3e, 0x46, 0x83, 0x1f,
+	0xc3, 0x5c, 0x6a, 0x17, 0x25, 0x63, 0xc5, 0xa5, 0x2e, 0x75, 0x8c, 0xf7, 0x27, 0xc4, 0x9b, 0xe7,
+	0x19, 0x46, 0x18, 0x2a, 0x40, 0xb4, 0xaf, 0x8d, 0xc5, 0x93, 0xfb, 0x54, 0xbc, 0xe7, 0x3b, 0x92,
+	0x81, 0xb4, 0x6f, 0xde, 0xbe, 0x36, 0x16, 0x4f, 0xee, 0x53, 0xe9, 0x4d, 0xa2, 0x8c, 0x88, 0xcc,
+	0xd8, 0xb6, 0x1b, 0x67, 0xa2, 0x6d, 0xa8, 0x49, 0x7b, 0x8f, 0x68, 0x94, 0x68, 0xf2, 0x06, 0x69,
+	0x7b, 0x65, 0x3c, 0x62, 0xa8, 0xc4, 0xda, 0x00, 0xea, 0x9b, 0xbe, 0xf7, 0xf4, 0x28, 0xdc, 0xb8,
+	0xfa, 0xd9, 0x24, 0xbc, 0x7b, 0x77, 0xbe, 0x77, 0x7b, 0xd7, 0x0e, 0xf6, 0x06, 0xdb, 0x54, 0xe9,
+	0x5b, 0x1c, 0xf7, 0x65, 0xdb, 0x13, 0x4f, 0xb7, 0x6c, 0x37, 0xc0, 0xbe, 0x6b, 0x3a, 0xb7, 0x58,
+	0x5b, 0x02, 0xda, 0xdf, 0xde, 0x2e, 0xb1, 0xf7, 0xdb, 0xff, 0x37, 0x00, 0x67, 0xcf, 0xfa, 0x85,
+	0x13, 0x56, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -4605,6 +6499,9 @@ type MilvusServiceClient interface {
 	GetIndexState(ctx context.Context, in *GetIndexStateRequest, opts ...grpc.CallOption) (*GetIndexStateResponse, error)
 	GetIndexBuildProgress(ctx context.Context, in *GetIndexBuildProgressRequest, opts ...grpc.CallOption) (*GetIndexBuildProgressResponse, error)
 	DropIndex(ctx context.Context, in *DropIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	AlterIndex(ctx context.Context, in *AlterIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	GetIndexStatistics(ctx context.Context, in *GetIndexStatisticsRequest, opts ...grpc.CallOption) (*GetIndexStatisticsResponse, error)
+	EstimateIndexRecall(ctx context.Context, in *EstimateIndexRecallRequest, opts ...grpc.CallOption) (*EstimateIndexRecallResponse, error)
 	Insert(ctx context.Context, in *InsertRequest, opts ...grpc.CallOption) (*MutationResult, error)
 	Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*MutationResult, error)
 	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResults, error)
@@ -4618,6 +6515,18 @@ type MilvusServiceClient interface {
 	RegisterLink(ctx context.Context, in *RegisterLinkRequest, opts ...grpc.CallOption) (*RegisterLinkResponse, error)
 	// https://wiki.lfaidata.foundation/display/MIL/MEP+8+--+Add+metrics+for+proxy
 	GetMetrics(ctx context.Context, in *GetMetricsRequest, opts ...grpc.CallOption) (*GetMetricsResponse, error)
+	CreateCredential(ctx context.Context, in *CreateCredentialRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	UpdateCredential(ctx context.Context, in *UpdateCredentialRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	DeleteCredential(ctx context.Context, in *DeleteCredentialRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	ListCredUsers(ctx context.Context, in *ListCredUsersRequest, opts ...grpc.CallOption) (*ListCredUsersResponse, error)
+	// role based access control
+	CreateRole(ctx context.Context, in *CreateRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	DropRole(ctx context.Context, in *DropRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	OperateUserRole(ctx context.Context, in *OperateUserRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	SelectRole(ctx context.Context, in *SelectRoleRequest, opts ...grpc.CallOption) (*SelectRoleResponse, error)
+	SelectUser(ctx context.Context, in *SelectUserRequest, opts ...grpc.CallOption) (*SelectUserResponse, error)
+	OperatePrivilege(ctx context.Context, in *OperatePrivilegeRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	SelectGrant(ctx context.Context, in *SelectGrantRequest, opts ...grpc.CallOption) (*SelectGrantResponse, error)
 }
 
 type milvusServiceClient struct {
@@ -4835,6 +6744,33 @@ func (c *milvusServiceClient) DropIndex(ctx context.Context, in *DropIndexReques
 	return out, nil
 }
 
+func (c *milvusServiceClient) AlterIndex(ctx context.Context, in *AlterIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/AlterIndex", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) GetIndexStatistics(ctx context.Context, in *GetIndexStatisticsRequest, opts ...grpc.CallOption) (*GetIndexStatisticsResponse, error) {
+	out := new(GetIndexStatisticsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/GetIndexStatistics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) EstimateIndexRecall(ctx context.Context, in *EstimateIndexRecallRequest, opts ...grpc.CallOption) (*EstimateIndexRecallResponse, error) {
+	out := new(EstimateIndexRecallResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/EstimateIndexRecall", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *milvusServiceClient) Insert(ctx context.Context, in *InsertRequest, opts ...grpc.CallOption) (*MutationResult, error) {
 	out := new(MutationResult)
 	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/Insert", in, out, opts...)
@@ -4853,81 +6789,180 @@ func (c *milvusServiceClient) Delete(ctx context.Context, in *DeleteRequest, opt
 	return out, nil
 }
 
-func (c *milvusServiceClient) Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResults, error) {
-	out := new(SearchResults)
-	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/Search", in, out, opts...)
+func (c *milvusServiceClient) Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResults, error) {
+	out := new(SearchResults)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/Search", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) Flush(ctx context.Context, in *FlushRequest, opts ...grpc.CallOption) (*FlushResponse, error) {
+	out := new(FlushResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/Flush", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) Query(ctx context.Context, in *QueryRequest, opts ...grpc.CallOption) (*QueryResults, error) {
+	out := new(QueryResults)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/Query", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) CalcDistance(ctx context.Context, in *CalcDistanceRequest, opts ...grpc.CallOption) (*CalcDistanceResults, error) {
+	out := new(CalcDistanceResults)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/CalcDistance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) GetPersistentSegmentInfo(ctx context.Context, in *GetPersistentSegmentInfoRequest, opts ...grpc.CallOption) (*GetPersistentSegmentInfoResponse, error) {
+	out := new(GetPersistentSegmentInfoResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/GetPersistentSegmentInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) GetQuerySegmentInfo(ctx context.Context, in *GetQuerySegmentInfoRequest, opts ...grpc.CallOption) (*GetQuerySegmentInfoResponse, error) {
+	out := new(GetQuerySegmentInfoResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/GetQuerySegmentInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) Dummy(ctx context.Context, in *DummyRequest, opts ...grpc.CallOption) (*DummyResponse, error) {
+	out := new(DummyResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/Dummy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) RegisterLink(ctx context.Context, in *RegisterLinkRequest, opts ...grpc.CallOption) (*RegisterLinkResponse, error) {
+	out := new(RegisterLinkResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/RegisterLink", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) GetMetrics(ctx context.Context, in *GetMetricsRequest, opts ...grpc.CallOption) (*GetMetricsResponse, error) {
+	out := new(GetMetricsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/GetMetrics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) CreateCredential(ctx context.Context, in *CreateCredentialRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/CreateCredential", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) UpdateCredential(ctx context.Context, in *UpdateCredentialRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/UpdateCredential", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) DeleteCredential(ctx context.Context, in *DeleteCredentialRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/DeleteCredential", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *milvusServiceClient) Flush(ctx context.Context, in *FlushRequest, opts ...grpc.CallOption) (*FlushResponse, error) {
-	out := new(FlushResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/Flush", in, out, opts...)
+func (c *milvusServiceClient) ListCredUsers(ctx context.Context, in *ListCredUsersRequest, opts ...grpc.CallOption) (*ListCredUsersResponse, error) {
+	out := new(ListCredUsersResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/ListCredUsers", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *milvusServiceClient) Query(ctx context.Context, in *QueryRequest, opts ...grpc.CallOption) (*QueryResults, error) {
-	out := new(QueryResults)
-	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/Query", in, out, opts...)
+func (c *milvusServiceClient) CreateRole(ctx context.Context, in *CreateRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/CreateRole", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *milvusServiceClient) CalcDistance(ctx context.Context, in *CalcDistanceRequest, opts ...grpc.CallOption) (*CalcDistanceResults, error) {
-	out := new(CalcDistanceResults)
-	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/CalcDistance", in, out, opts...)
+func (c *milvusServiceClient) DropRole(ctx context.Context, in *DropRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/DropRole", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *milvusServiceClient) GetPersistentSegmentInfo(ctx context.Context, in *GetPersistentSegmentInfoRequest, opts ...grpc.CallOption) (*GetPersistentSegmentInfoResponse, error) {
-	out := new(GetPersistentSegmentInfoResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/GetPersistentSegmentInfo", in, out, opts...)
+func (c *milvusServiceClient) OperateUserRole(ctx context.Context, in *OperateUserRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/OperateUserRole", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *milvusServiceClient) GetQuerySegmentInfo(ctx context.Context, in *GetQuerySegmentInfoRequest, opts ...grpc.CallOption) (*GetQuerySegmentInfoResponse, error) {
-	out := new(GetQuerySegmentInfoResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/GetQuerySegmentInfo", in, out, opts...)
+func (c *milvusServiceClient) SelectRole(ctx context.Context, in *SelectRoleRequest, opts ...grpc.CallOption) (*SelectRoleResponse, error) {
+	out := new(SelectRoleResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/SelectRole", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *milvusServiceClient) Dummy(ctx context.Context, in *DummyRequest, opts ...grpc.CallOption) (*DummyResponse, error) {
-	out := new(DummyResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/Dummy", in, out, opts...)
+func (c *milvusServiceClient) SelectUser(ctx context.Context, in *SelectUserRequest, opts ...grpc.CallOption) (*SelectUserResponse, error) {
+	out := new(SelectUserResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/SelectUser", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *milvusServiceClient) RegisterLink(ctx context.Context, in *RegisterLinkRequest, opts ...grpc.CallOption) (*RegisterLinkResponse, error) {
-	out := new(RegisterLinkResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/RegisterLink", in, out, opts...)
+func (c *milvusServiceClient) OperatePrivilege(ctx context.Context, in *OperatePrivilegeRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/OperatePrivilege", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *milvusServiceClient) GetMetrics(ctx context.Context, in *GetMetricsRequest, opts ...grpc.CallOption) (*GetMetricsResponse, error) {
-	out := new(GetMetricsResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/GetMetrics", in, out, opts...)
+func (c *milvusServiceClient) SelectGrant(ctx context.Context, in *SelectGrantRequest, opts ...grpc.CallOption) (*SelectGrantResponse, error) {
+	out := new(SelectGrantResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/SelectGrant", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -4959,6 +6994,9 @@ type MilvusServiceServer interface {
 	GetIndexState(context.Context, *GetIndexStateRequest) (*GetIndexStateResponse, error)
 	GetIndexBuildProgress(context.Context, *GetIndexBuildProgressRequest) (*GetIndexBuildProgressResponse, error)
 	DropIndex(context.Context, *DropIndexRequest) (*commonpb.Status, error)
+	AlterIndex(context.Context, *AlterIndexRequest) (*commonpb.Status, error)
+	GetIndexStatistics(context.Context, *GetIndexStatisticsRequest) (*GetIndexStatisticsResponse, error)
+	EstimateIndexRecall(context.Context, *EstimateIndexRecallRequest) (*EstimateIndexRecallResponse, error)
 	Insert(context.Context, *InsertRequest) (*MutationResult, error)
 	Delete(context.Context, *DeleteRequest) (*MutationResult, error)
 	Search(context.Context, *SearchRequest) (*SearchResults, error)
@@ -4972,6 +7010,18 @@ type MilvusServiceServer interface {
 	RegisterLink(context.Context, *RegisterLinkRequest) (*RegisterLinkResponse, error)
 	// https://wiki.lfaidata.foundation/display/MIL/MEP+8+--+Add+metrics+for+proxy
 	GetMetrics(context.Context, *GetMetricsRequest) (*GetMetricsResponse, error)
+	CreateCredential(context.Context, *CreateCredentialRequest) (*commonpb.Status, error)
+	UpdateCredential(context.Context, *UpdateCredentialRequest) (*commonpb.Status, error)
+	DeleteCredential(context.Context, *DeleteCredentialRequest) (*commonpb.Status, error)
+	ListCredUsers(context.Context, *ListCredUsersRequest) (*ListCredUsersResponse, error)
+	// role based access control
+	CreateRole(context.Context, *CreateRoleRequest) (*commonpb.Status, error)
+	DropRole(context.Context, *DropRoleRequest) (*commonpb.Status, error)
+	OperateUserRole(context.Context, *OperateUserRoleRequest) (*commonpb.Status, error)
+	SelectRole(context.Context, *SelectRoleRequest) (*SelectRoleResponse, error)
+	SelectUser(context.Context, *SelectUserRequest) (*SelectUserResponse, error)
+	OperatePrivilege(context.Context, *OperatePrivilegeRequest) (*commonpb.Status, error)
+	SelectGrant(context.Context, *SelectGrantRequest) (*SelectGrantResponse, error)
 }
 
 // UnimplementedMilvusServiceServer can be embedded to have forward compatible implementations.
@@ -5047,6 +7097,15 @@ func (*UnimplementedMilvusServiceServer) GetIndexBuildProgress(ctx context.Conte
 func (*UnimplementedMilvusServiceServer) DropIndex(ctx context.Context, req *DropIndexRequest) (*commonpb.Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DropIndex not implemented")
 }
+func (*UnimplementedMilvusServiceServer) AlterIndex(ctx context.Context, req *AlterIndexRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AlterIndex not implemented")
+}
+func (*UnimplementedMilvusServiceServer) GetIndexStatistics(ctx context.Context, req *GetIndexStatisticsRequest) (*GetIndexStatisticsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIndexStatistics not implemented")
+}
+func (*UnimplementedMilvusServiceServer) EstimateIndexRecall(ctx context.Context, req *EstimateIndexRecallRequest) (*EstimateIndexRecallResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateIndexRecall not implemented")
+}
 func (*UnimplementedMilvusServiceServer) Insert(ctx context.Context, req *InsertRequest) (*MutationResult, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Insert not implemented")
 }
@@ -5080,6 +7139,39 @@ func (*UnimplementedMilvusServiceServer) RegisterLink(ctx context.Context, req *
 func (*UnimplementedMilvusServiceServer) GetMetrics(ctx context.Context, req *GetMetricsRequest) (*GetMetricsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMetrics not implemented")
 }
+func (*UnimplementedMilvusServiceServer) CreateCredential(ctx context.Context, req *CreateCredentialRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCredential not implemented")
+}
+func (*UnimplementedMilvusServiceServer) UpdateCredential(ctx context.Context, req *UpdateCredentialRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCredential not implemented")
+}
+func (*UnimplementedMilvusServiceServer) DeleteCredential(ctx context.Context, req *DeleteCredentialRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCredential not implemented")
+}
+func (*UnimplementedMilvusServiceServer) ListCredUsers(ctx context.Context, req *ListCredUsersRequest) (*ListCredUsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCredUsers not implemented")
+}
+func (*UnimplementedMilvusServiceServer) CreateRole(ctx context.Context, req *CreateRoleRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRole not implemented")
+}
+func (*UnimplementedMilvusServiceServer) DropRole(ctx context.Context, req *DropRoleRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DropRole not implemented")
+}
+func (*UnimplementedMilvusServiceServer) OperateUserRole(ctx context.Context, req *OperateUserRoleRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OperateUserRole not implemented")
+}
+func (*UnimplementedMilvusServiceServer) SelectRole(ctx context.Context, req *SelectRoleRequest) (*SelectRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SelectRole not implemented")
+}
+func (*UnimplementedMilvusServiceServer) SelectUser(ctx context.Context, req *SelectUserRequest) (*SelectUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SelectUser not implemented")
+}
+func (*UnimplementedMilvusServiceServer) OperatePrivilege(ctx context.Context, req *OperatePrivilegeRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OperatePrivilege not implemented")
+}
+func (*UnimplementedMilvusServiceServer) SelectGrant(ctx context.Context, req *SelectGrantRequest) (*SelectGrantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SelectGrant not implemented")
+}
 
 func RegisterMilvusServiceServer(s *grpc.Server, srv MilvusServiceServer) {
 	s.RegisterService(&_MilvusService_serviceDesc, srv)
@@ -5499,6 +7591,60 @@ func _MilvusService_DropIndex_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MilvusService_AlterIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AlterIndexRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).AlterIndex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/AlterIndex",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).AlterIndex(ctx, req.(*AlterIndexRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_GetIndexStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetIndexStatisticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).GetIndexStatistics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/GetIndexStatistics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).GetIndexStatistics(ctx, req.(*GetIndexStatisticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_EstimateIndexRecall_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EstimateIndexRecallRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).EstimateIndexRecall(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/EstimateIndexRecall",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).EstimateIndexRecall(ctx, req.(*EstimateIndexRecallRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MilvusService_Insert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(InsertRequest)
 	if err := dec(in); err != nil {
@@ -5697,6 +7843,204 @@ func _MilvusService_GetMetrics_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MilvusService_CreateCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCredentialRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).CreateCredential(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/CreateCredential",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).CreateCredential(ctx, req.(*CreateCredentialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_UpdateCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCredentialRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).UpdateCredential(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/UpdateCredential",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).UpdateCredential(ctx, req.(*UpdateCredentialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_DeleteCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCredentialRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).DeleteCredential(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/DeleteCredential",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).DeleteCredential(ctx, req.(*DeleteCredentialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_ListCredUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCredUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).ListCredUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/ListCredUsers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).ListCredUsers(ctx, req.(*ListCredUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_CreateRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).CreateRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/CreateRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).CreateRole(ctx, req.(*CreateRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_DropRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DropRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).DropRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/DropRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).DropRole(ctx, req.(*DropRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_OperateUserRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OperateUserRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).OperateUserRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/OperateUserRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).OperateUserRole(ctx, req.(*OperateUserRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_SelectRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SelectRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).SelectRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/SelectRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).SelectRole(ctx, req.(*SelectRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_SelectUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SelectUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).SelectUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/SelectUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).SelectUser(ctx, req.(*SelectUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_OperatePrivilege_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OperatePrivilegeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).OperatePrivilege(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/OperatePrivilege",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).OperatePrivilege(ctx, req.(*OperatePrivilegeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_SelectGrant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SelectGrantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).SelectGrant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/SelectGrant",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).SelectGrant(ctx, req.(*SelectGrantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _MilvusService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "milvus.proto.milvus.MilvusService",
 	HandlerType: (*MilvusServiceServer)(nil),
@@ -5793,6 +8137,18 @@ var _MilvusService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DropIndex",
 			Handler:    _MilvusService_DropIndex_Handler,
 		},
+		{
+			MethodName: "AlterIndex",
+			Handler:    _MilvusService_AlterIndex_Handler,
+		},
+		{
+			MethodName: "GetIndexStatistics",
+			Handler:    _MilvusService_GetIndexStatistics_Handler,
+		},
+		{
+			MethodName: "EstimateIndexRecall",
+			Handler:    _MilvusService_EstimateIndexRecall_Handler,
+		},
 		{
 			MethodName: "Insert",
 			Handler:    _MilvusService_Insert_Handler,
@@ -5837,6 +8193,50 @@ var _MilvusService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetMetrics",
 			Handler:    _MilvusService_GetMetrics_Handler,
 		},
+		{
+			MethodName: "CreateCredential",
+			Handler:    _MilvusService_CreateCredential_Handler,
+		},
+		{
+			MethodName: "UpdateCredential",
+			Handler:    _MilvusService_UpdateCredential_Handler,
+		},
+		{
+			MethodName: "DeleteCredential",
+			Handler:    _MilvusService_DeleteCredential_Handler,
+		},
+		{
+			MethodName: "ListCredUsers",
+			Handler:    _MilvusService_ListCredUsers_Handler,
+		},
+		{
+			MethodName: "CreateRole",
+			Handler:    _MilvusService_CreateRole_Handler,
+		},
+		{
+			MethodName: "DropRole",
+			Handler:    _MilvusService_DropRole_Handler,
+		},
+		{
+			MethodName: "OperateUserRole",
+			Handler:    _MilvusService_OperateUserRole_Handler,
+		},
+		{
+			MethodName: "SelectRole",
+			Handler:    _MilvusService_SelectRole_Handler,
+		},
+		{
+			MethodName: "SelectUser",
+			Handler:    _MilvusService_SelectUser_Handler,
+		},
+		{
+			MethodName: "OperatePrivilege",
+			Handler:    _MilvusService_OperatePrivilege_Handler,
+		},
+		{
+			MethodName: "SelectGrant",
+			Handler:    _MilvusService_SelectGrant_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "milvus.proto",
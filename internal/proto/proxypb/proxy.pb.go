@@ -137,41 +137,213 @@ func (m *ReleaseDQLMessageStreamRequest) GetCollectionID() int64 {
 	return 0
 }
 
+type InvalidateCredCacheRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Username             string            `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *InvalidateCredCacheRequest) Reset()         { *m = InvalidateCredCacheRequest{} }
+func (m *InvalidateCredCacheRequest) String() string { return proto.CompactTextString(m) }
+func (*InvalidateCredCacheRequest) ProtoMessage()    {}
+func (*InvalidateCredCacheRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{2}
+}
+
+func (m *InvalidateCredCacheRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InvalidateCredCacheRequest.Unmarshal(m, b)
+}
+func (m *InvalidateCredCacheRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InvalidateCredCacheRequest.Marshal(b, m, deterministic)
+}
+func (m *InvalidateCredCacheRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InvalidateCredCacheRequest.Merge(m, src)
+}
+func (m *InvalidateCredCacheRequest) XXX_Size() int {
+	return xxx_messageInfo_InvalidateCredCacheRequest.Size(m)
+}
+func (m *InvalidateCredCacheRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_InvalidateCredCacheRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InvalidateCredCacheRequest proto.InternalMessageInfo
+
+func (m *InvalidateCredCacheRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *InvalidateCredCacheRequest) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+type InvalidatePolicyInfoCacheRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *InvalidatePolicyInfoCacheRequest) Reset()         { *m = InvalidatePolicyInfoCacheRequest{} }
+func (m *InvalidatePolicyInfoCacheRequest) String() string { return proto.CompactTextString(m) }
+func (*InvalidatePolicyInfoCacheRequest) ProtoMessage()    {}
+func (*InvalidatePolicyInfoCacheRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{3}
+}
+
+func (m *InvalidatePolicyInfoCacheRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InvalidatePolicyInfoCacheRequest.Unmarshal(m, b)
+}
+func (m *InvalidatePolicyInfoCacheRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InvalidatePolicyInfoCacheRequest.Marshal(b, m, deterministic)
+}
+func (m *InvalidatePolicyInfoCacheRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InvalidatePolicyInfoCacheRequest.Merge(m, src)
+}
+func (m *InvalidatePolicyInfoCacheRequest) XXX_Size() int {
+	return xxx_messageInfo_InvalidatePolicyInfoCacheRequest.Size(m)
+}
+func (m *InvalidatePolicyInfoCacheRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_InvalidatePolicyInfoCacheRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InvalidatePolicyInfoCacheRequest proto.InternalMessageInfo
+
+func (m *InvalidatePolicyInfoCacheRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+// SetQuotaFactorsRequest carries the throttling factors computed by the quota center of root coordinator,
+// a factor in [0, 1] scales the rate limits of the proxy, and 0 means the requests are rejected
+type SetQuotaFactorsRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	WriteFactor          float64           `protobuf:"fixed64,2,opt,name=write_factor,json=writeFactor,proto3" json:"write_factor,omitempty"`
+	ReadFactor           float64           `protobuf:"fixed64,3,opt,name=read_factor,json=readFactor,proto3" json:"read_factor,omitempty"`
+	WriteReason          string            `protobuf:"bytes,4,opt,name=write_reason,json=writeReason,proto3" json:"write_reason,omitempty"`
+	ReadReason           string            `protobuf:"bytes,5,opt,name=read_reason,json=readReason,proto3" json:"read_reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *SetQuotaFactorsRequest) Reset()         { *m = SetQuotaFactorsRequest{} }
+func (m *SetQuotaFactorsRequest) String() string { return proto.CompactTextString(m) }
+func (*SetQuotaFactorsRequest) ProtoMessage()    {}
+func (*SetQuotaFactorsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{4}
+}
+
+func (m *SetQuotaFactorsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetQuotaFactorsRequest.Unmarshal(m, b)
+}
+func (m *SetQuotaFactorsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetQuotaFactorsRequest.Marshal(b, m, deterministic)
+}
+func (m *SetQuotaFactorsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetQuotaFactorsRequest.Merge(m, src)
+}
+func (m *SetQuotaFactorsRequest) XXX_Size() int {
+	return xxx_messageInfo_SetQuotaFactorsRequest.Size(m)
+}
+func (m *SetQuotaFactorsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetQuotaFactorsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetQuotaFactorsRequest proto.InternalMessageInfo
+
+func (m *SetQuotaFactorsRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *SetQuotaFactorsRequest) GetWriteFactor() float64 {
+	if m != nil {
+		return m.WriteFactor
+	}
+	return 0
+}
+
+func (m *SetQuotaFactorsRequest) GetReadFactor() float64 {
+	if m != nil {
+		return m.ReadFactor
+	}
+	return 0
+}
+
+func (m *SetQuotaFactorsRequest) GetWriteReason() string {
+	if m != nil {
+		return m.WriteReason
+	}
+	return ""
+}
+
+func (m *SetQuotaFactorsRequest) GetReadReason() string {
+	if m != nil {
+		return m.ReadReason
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*InvalidateCollMetaCacheRequest)(nil), "milvus.proto.proxy.InvalidateCollMetaCacheRequest")
 	proto.RegisterType((*ReleaseDQLMessageStreamRequest)(nil), "milvus.proto.proxy.ReleaseDQLMessageStreamRequest")
+	proto.RegisterType((*InvalidateCredCacheRequest)(nil), "milvus.proto.proxy.InvalidateCredCacheRequest")
+	proto.RegisterType((*InvalidatePolicyInfoCacheRequest)(nil), "milvus.proto.proxy.InvalidatePolicyInfoCacheRequest")
+	proto.RegisterType((*SetQuotaFactorsRequest)(nil), "milvus.proto.proxy.SetQuotaFactorsRequest")
 }
 
 func init() { proto.RegisterFile("proxy.proto", fileDescriptor_700b50b08ed8dbaf) }
 
 var fileDescriptor_700b50b08ed8dbaf = []byte{
-	// 413 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x52, 0xd1, 0x0a, 0xd3, 0x30,
-	0x14, 0x5d, 0xdd, 0x9c, 0x98, 0x95, 0x09, 0x41, 0xd8, 0xa8, 0x3a, 0x46, 0x05, 0x1d, 0x82, 0xeb,
-	0xa8, 0x7e, 0xc1, 0x5a, 0x18, 0x03, 0x27, 0xda, 0xbd, 0xf9, 0x22, 0x69, 0x7b, 0xe9, 0x02, 0x69,
-	0xd2, 0x35, 0xe9, 0xd0, 0x5f, 0xf0, 0xd9, 0x1f, 0xf1, 0x0f, 0xa5, 0x69, 0xb7, 0xd9, 0x6d, 0x9d,
-	0xe8, 0x5b, 0xcf, 0xcd, 0xb9, 0x9c, 0x73, 0x6e, 0x0f, 0x1a, 0x64, 0xb9, 0xf8, 0xf6, 0x7d, 0x9e,
-	0xe5, 0x42, 0x09, 0x8c, 0x53, 0xca, 0x0e, 0x85, 0xac, 0xd0, 0x5c, 0xbf, 0x58, 0x66, 0x24, 0xd2,
-	0x54, 0xf0, 0x6a, 0x66, 0x0d, 0x29, 0x57, 0x90, 0x73, 0xc2, 0x6a, 0x6c, 0xfe, 0xb9, 0x61, 0xff,
-	0x34, 0xd0, 0x64, 0xcd, 0x0f, 0x84, 0xd1, 0x98, 0x28, 0xf0, 0x04, 0x63, 0x1b, 0x50, 0xc4, 0x23,
-	0xd1, 0x0e, 0x02, 0xd8, 0x17, 0x20, 0x15, 0x5e, 0xa0, 0x5e, 0x48, 0x24, 0x8c, 0x8d, 0xa9, 0x31,
-	0x1b, 0xb8, 0xcf, 0xe7, 0x0d, 0xc5, 0x5a, 0x6a, 0x23, 0x93, 0x25, 0x91, 0x10, 0x68, 0x26, 0x1e,
-	0xa1, 0x47, 0x71, 0xf8, 0x95, 0x93, 0x14, 0xc6, 0x0f, 0xa6, 0xc6, 0xec, 0x71, 0xd0, 0x8f, 0xc3,
-	0x8f, 0x24, 0x05, 0xfc, 0x1a, 0x3d, 0x89, 0x04, 0x63, 0x10, 0x29, 0x2a, 0x78, 0x45, 0xe8, 0x6a,
-	0xc2, 0xf0, 0x3c, 0x2e, 0x89, 0xf6, 0x0f, 0x03, 0x4d, 0x02, 0x60, 0x40, 0x24, 0xf8, 0x9f, 0x3f,
-	0x6c, 0x40, 0x4a, 0x92, 0xc0, 0x56, 0xe5, 0x40, 0xd2, 0xff, 0xb7, 0x85, 0x51, 0x2f, 0x0e, 0xd7,
-	0xbe, 0xf6, 0xd4, 0x0d, 0xf4, 0x37, 0xb6, 0x91, 0x79, 0x96, 0x5e, 0xfb, 0xda, 0x4e, 0x37, 0x68,
-	0xcc, 0xdc, 0x5f, 0x3d, 0xf4, 0xf0, 0x53, 0x79, 0x59, 0x9c, 0x21, 0xbc, 0x02, 0xe5, 0x89, 0x34,
-	0x13, 0x1c, 0xb8, 0xda, 0x2a, 0xa2, 0x40, 0xe2, 0x45, 0x53, 0xfb, 0x74, 0xef, 0x6b, 0x6a, 0xed,
-	0xdd, 0x7a, 0xd5, 0xb2, 0x71, 0x41, 0xb7, 0x3b, 0x78, 0x8f, 0x9e, 0xae, 0x40, 0x43, 0x2a, 0x15,
-	0x8d, 0xa4, 0xb7, 0x23, 0x9c, 0x03, 0xc3, 0x6e, 0xbb, 0xe6, 0x15, 0xf9, 0xa8, 0xfa, 0xb2, 0xb9,
-	0x53, 0x83, 0xad, 0xca, 0x29, 0x4f, 0x02, 0x90, 0x99, 0xe0, 0x12, 0xec, 0x0e, 0xce, 0xd1, 0x8b,
-	0x66, 0x23, 0xaa, 0x43, 0x9c, 0x7a, 0x71, 0xa9, 0x5d, 0xd5, 0xf1, 0x7e, 0x89, 0xac, 0x67, 0x37,
-	0xff, 0x4f, 0x69, 0xb5, 0x28, 0x63, 0x12, 0x64, 0xae, 0x40, 0xf9, 0xf1, 0x31, 0xde, 0x9b, 0xf6,
-	0x78, 0x27, 0xd2, 0x3f, 0xc6, 0x62, 0x68, 0xd4, 0xd2, 0xa8, 0xdb, 0x81, 0xee, 0xd7, 0xef, 0x2f,
-	0x81, 0x96, 0xef, 0xbf, 0xb8, 0x09, 0x55, 0xbb, 0x22, 0x2c, 0x5f, 0x9c, 0x8a, 0xfa, 0x96, 0x8a,
-	0xfa, 0xcb, 0x39, 0x06, 0x72, 0xf4, 0xb6, 0xa3, 0x15, 0xb3, 0x30, 0xec, 0x6b, 0xf8, 0xee, 0x77,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0x46, 0xe1, 0x7c, 0xd9, 0xe3, 0x03, 0x00, 0x00,
+	// 575 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xff, 0x6e, 0xd3, 0x30,
+	0x10, 0x5e, 0xe8, 0x36, 0xe0, 0x5a, 0x6d, 0x92, 0x85, 0xd8, 0x16, 0x60, 0x8c, 0x20, 0xc1, 0x34,
+	0x89, 0x76, 0x2a, 0x7b, 0x82, 0xb5, 0x62, 0xaa, 0xc4, 0xd0, 0x96, 0xf2, 0x17, 0x42, 0x9a, 0x9c,
+	0xe4, 0xd6, 0x7a, 0x72, 0xec, 0xcc, 0x76, 0x06, 0x7b, 0x05, 0xfe, 0xe6, 0xc9, 0x78, 0x0f, 0xde,
+	0x01, 0xc5, 0x49, 0xd3, 0xa6, 0xeb, 0x0f, 0xb1, 0xfd, 0x97, 0x3b, 0x7f, 0x77, 0xdf, 0x7d, 0x17,
+	0x7f, 0x86, 0x7a, 0xa2, 0xe4, 0xcf, 0xdb, 0x66, 0xa2, 0xa4, 0x91, 0x84, 0xc4, 0x8c, 0xdf, 0xa4,
+	0x3a, 0x8f, 0x9a, 0xf6, 0xc4, 0x6d, 0x84, 0x32, 0x8e, 0xa5, 0xc8, 0x73, 0xee, 0x06, 0x13, 0x06,
+	0x95, 0xa0, 0xbc, 0x88, 0x1b, 0x93, 0x15, 0xde, 0x6f, 0x07, 0x76, 0x7b, 0xe2, 0x86, 0x72, 0x16,
+	0x51, 0x83, 0x1d, 0xc9, 0xf9, 0x29, 0x1a, 0xda, 0xa1, 0xe1, 0x10, 0x7d, 0xbc, 0x4e, 0x51, 0x1b,
+	0x72, 0x08, 0xab, 0x01, 0xd5, 0xb8, 0xed, 0xec, 0x39, 0xfb, 0xf5, 0xf6, 0xcb, 0x66, 0x85, 0xb1,
+	0xa0, 0x3a, 0xd5, 0x83, 0x63, 0xaa, 0xd1, 0xb7, 0x48, 0xb2, 0x05, 0x8f, 0xa3, 0xe0, 0x42, 0xd0,
+	0x18, 0xb7, 0x1f, 0xed, 0x39, 0xfb, 0x4f, 0xfd, 0xf5, 0x28, 0xf8, 0x42, 0x63, 0x24, 0xef, 0x61,
+	0x33, 0x94, 0x9c, 0x63, 0x68, 0x98, 0x14, 0x39, 0xa0, 0x66, 0x01, 0x1b, 0xe3, 0x74, 0x06, 0xf4,
+	0x7e, 0x39, 0xb0, 0xeb, 0x23, 0x47, 0xaa, 0xb1, 0x7b, 0xfe, 0xf9, 0x14, 0xb5, 0xa6, 0x03, 0xec,
+	0x1b, 0x85, 0x34, 0xbe, 0xff, 0x58, 0x04, 0x56, 0xa3, 0xa0, 0xd7, 0xb5, 0x33, 0xd5, 0x7c, 0xfb,
+	0x4d, 0x3c, 0x68, 0x8c, 0xa9, 0x7b, 0x5d, 0x3b, 0x4e, 0xcd, 0xaf, 0xe4, 0xbc, 0x2b, 0x70, 0x27,
+	0x56, 0xa4, 0x30, 0x7a, 0xe0, 0x7a, 0x5c, 0x78, 0x92, 0x6a, 0x54, 0x13, 0xfb, 0x29, 0x63, 0xef,
+	0x2b, 0xec, 0x8d, 0xb9, 0xce, 0x24, 0x67, 0xe1, 0x6d, 0x4f, 0x5c, 0xca, 0x87, 0x31, 0x7a, 0x7f,
+	0x1c, 0x78, 0xde, 0x47, 0x73, 0x9e, 0x4a, 0x43, 0x3f, 0xd1, 0xd0, 0x48, 0xa5, 0xef, 0x3f, 0xfe,
+	0x1b, 0x68, 0xfc, 0x50, 0xcc, 0xe0, 0xc5, 0xa5, 0xed, 0x64, 0x25, 0x38, 0x7e, 0xdd, 0xe6, 0xf2,
+	0xe6, 0xe4, 0x35, 0xd4, 0x15, 0xd2, 0x68, 0x84, 0xa8, 0x59, 0x04, 0x64, 0xa9, 0x02, 0x50, 0xf6,
+	0x50, 0x48, 0xb5, 0x14, 0xdb, 0xab, 0x76, 0x0d, 0x79, 0x0f, 0xdf, 0xa6, 0xca, 0x1e, 0x05, 0x62,
+	0xcd, 0x22, 0x6c, 0x8f, 0x1c, 0xd0, 0xfe, 0xbb, 0x0e, 0x6b, 0x67, 0xd9, 0x85, 0x27, 0x09, 0x90,
+	0x13, 0x34, 0x1d, 0x19, 0x27, 0x52, 0xa0, 0x30, 0x7d, 0x43, 0x0d, 0x6a, 0x72, 0x58, 0xd5, 0x52,
+	0xda, 0xe0, 0x2e, 0xb4, 0xd8, 0x85, 0xfb, 0x6e, 0x4e, 0xc5, 0x14, 0xdc, 0x5b, 0x21, 0xd7, 0xf0,
+	0xec, 0x04, 0x6d, 0xc8, 0xb4, 0x61, 0xa1, 0xee, 0x0c, 0xa9, 0x10, 0xc8, 0x49, 0x7b, 0x3e, 0xe7,
+	0x1d, 0xf0, 0x88, 0xf5, 0x6d, 0xb5, 0xa6, 0x08, 0xfa, 0x46, 0x31, 0x31, 0xf0, 0x51, 0x27, 0x52,
+	0x68, 0xf4, 0x56, 0x88, 0x82, 0x57, 0x55, 0xa3, 0xe6, 0xf7, 0xb3, 0xb4, 0xeb, 0x34, 0x77, 0xfe,
+	0x4a, 0x2c, 0xf6, 0xb6, 0xfb, 0x62, 0xe6, 0xff, 0xce, 0x46, 0x4d, 0x33, 0x99, 0x14, 0x1a, 0x27,
+	0x68, 0xba, 0xd1, 0x48, 0xde, 0xc1, 0x7c, 0x79, 0x25, 0xe8, 0x3f, 0x65, 0x71, 0xd8, 0x9a, 0x63,
+	0xf4, 0xd9, 0x82, 0x16, 0xbf, 0x0a, 0xcb, 0x04, 0x5d, 0xc1, 0x4e, 0xd5, 0xca, 0x28, 0x0c, 0xa3,
+	0x3c, 0x5f, 0x60, 0x73, 0xc9, 0x02, 0xa7, 0x9c, 0xbf, 0x8c, 0x2b, 0x81, 0x9d, 0xb9, 0x56, 0x26,
+	0x47, 0x8b, 0xb9, 0x66, 0x3b, 0x7f, 0x19, 0xe3, 0x77, 0xd8, 0x9c, 0x72, 0x39, 0x39, 0x98, 0xc5,
+	0x33, 0xfb, 0x29, 0x58, 0xd2, 0xfd, 0xf8, 0xe8, 0x5b, 0x7b, 0xc0, 0xcc, 0x30, 0x0d, 0xb2, 0x93,
+	0x56, 0x0e, 0xfd, 0xc0, 0x64, 0xf1, 0xd5, 0x1a, 0x5d, 0x86, 0x96, 0xad, 0x6e, 0x59, 0xa6, 0x24,
+	0x08, 0xd6, 0x6d, 0xf8, 0xf1, 0xdf, 0x00, 0x2a, 0xf8, 0x8c, 0x2f, 0xb6, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -191,6 +363,9 @@ type ProxyClient interface {
 	InvalidateCollectionMetaCache(ctx context.Context, in *InvalidateCollMetaCacheRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	GetDdChannel(ctx context.Context, in *internalpb.GetDdChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error)
 	ReleaseDQLMessageStream(ctx context.Context, in *ReleaseDQLMessageStreamRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	InvalidateCredentialCache(ctx context.Context, in *InvalidateCredCacheRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	InvalidatePolicyInfoCache(ctx context.Context, in *InvalidatePolicyInfoCacheRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	SetQuotaFactors(ctx context.Context, in *SetQuotaFactorsRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 }
 
 type proxyClient struct {
@@ -246,6 +421,33 @@ func (c *proxyClient) ReleaseDQLMessageStream(ctx context.Context, in *ReleaseDQ
 	return out, nil
 }
 
+func (c *proxyClient) InvalidateCredentialCache(ctx context.Context, in *InvalidateCredCacheRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.proxy.Proxy/InvalidateCredentialCache", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *proxyClient) InvalidatePolicyInfoCache(ctx context.Context, in *InvalidatePolicyInfoCacheRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.proxy.Proxy/InvalidatePolicyInfoCache", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *proxyClient) SetQuotaFactors(ctx context.Context, in *SetQuotaFactorsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.proxy.Proxy/SetQuotaFactors", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ProxyServer is the server API for Proxy service.
 type ProxyServer interface {
 	GetComponentStates(context.Context, *internalpb.GetComponentStatesRequest) (*internalpb.ComponentStates, error)
@@ -253,6 +455,9 @@ type ProxyServer interface {
 	InvalidateCollectionMetaCache(context.Context, *InvalidateCollMetaCacheRequest) (*commonpb.Status, error)
 	GetDdChannel(context.Context, *internalpb.GetDdChannelRequest) (*milvuspb.StringResponse, error)
 	ReleaseDQLMessageStream(context.Context, *ReleaseDQLMessageStreamRequest) (*commonpb.Status, error)
+	InvalidateCredentialCache(context.Context, *InvalidateCredCacheRequest) (*commonpb.Status, error)
+	InvalidatePolicyInfoCache(context.Context, *InvalidatePolicyInfoCacheRequest) (*commonpb.Status, error)
+	SetQuotaFactors(context.Context, *SetQuotaFactorsRequest) (*commonpb.Status, error)
 }
 
 // UnimplementedProxyServer can be embedded to have forward compatible implementations.
@@ -274,6 +479,15 @@ func (*UnimplementedProxyServer) GetDdChannel(ctx context.Context, req *internal
 func (*UnimplementedProxyServer) ReleaseDQLMessageStream(ctx context.Context, req *ReleaseDQLMessageStreamRequest) (*commonpb.Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReleaseDQLMessageStream not implemented")
 }
+func (*UnimplementedProxyServer) InvalidateCredentialCache(ctx context.Context, req *InvalidateCredCacheRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InvalidateCredentialCache not implemented")
+}
+func (*UnimplementedProxyServer) InvalidatePolicyInfoCache(ctx context.Context, req *InvalidatePolicyInfoCacheRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InvalidatePolicyInfoCache not implemented")
+}
+func (*UnimplementedProxyServer) SetQuotaFactors(ctx context.Context, req *SetQuotaFactorsRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetQuotaFactors not implemented")
+}
 
 func RegisterProxyServer(s *grpc.Server, srv ProxyServer) {
 	s.RegisterService(&_Proxy_serviceDesc, srv)
@@ -369,6 +583,60 @@ func _Proxy_ReleaseDQLMessageStream_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Proxy_InvalidateCredentialCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InvalidateCredCacheRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProxyServer).InvalidateCredentialCache(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.proxy.Proxy/InvalidateCredentialCache",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProxyServer).InvalidateCredentialCache(ctx, req.(*InvalidateCredCacheRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Proxy_InvalidatePolicyInfoCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InvalidatePolicyInfoCacheRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProxyServer).InvalidatePolicyInfoCache(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.proxy.Proxy/InvalidatePolicyInfoCache",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProxyServer).InvalidatePolicyInfoCache(ctx, req.(*InvalidatePolicyInfoCacheRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Proxy_SetQuotaFactors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetQuotaFactorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProxyServer).SetQuotaFactors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.proxy.Proxy/SetQuotaFactors",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProxyServer).SetQuotaFactors(ctx, req.(*SetQuotaFactorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Proxy_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "milvus.proto.proxy.Proxy",
 	HandlerType: (*ProxyServer)(nil),
@@ -393,6 +661,18 @@ var _Proxy_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ReleaseDQLMessageStream",
 			Handler:    _Proxy_ReleaseDQLMessageStream_Handler,
 		},
+		{
+			MethodName: "InvalidateCredentialCache",
+			Handler:    _Proxy_InvalidateCredentialCache_Handler,
+		},
+		{
+			MethodName: "InvalidatePolicyInfoCache",
+			Handler:    _Proxy_InvalidatePolicyInfoCache_Handler,
+		},
+		{
+			MethodName: "SetQuotaFactors",
+			Handler:    _Proxy_SetQuotaFactors_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proxy.proto",
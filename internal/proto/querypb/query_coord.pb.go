@@ -1599,6 +1599,101 @@ func (m *ReleaseSegmentsRequest) GetSegmentIDs() []int64 {
 	return nil
 }
 
+type SwapSegmentIndexRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	NodeID               int64             `protobuf:"varint,2,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	CollectionID         int64             `protobuf:"varint,3,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	SegmentID            int64             `protobuf:"varint,4,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
+	FieldID              int64             `protobuf:"varint,5,opt,name=fieldID,proto3" json:"fieldID,omitempty"`
+	IndexName            string            `protobuf:"bytes,6,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
+	IndexID              int64             `protobuf:"varint,7,opt,name=indexID,proto3" json:"indexID,omitempty"`
+	ReplacedIndexID      int64             `protobuf:"varint,8,opt,name=replacedIndexID,proto3" json:"replacedIndexID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *SwapSegmentIndexRequest) Reset()         { *m = SwapSegmentIndexRequest{} }
+func (m *SwapSegmentIndexRequest) String() string { return proto.CompactTextString(m) }
+func (*SwapSegmentIndexRequest) ProtoMessage()    {}
+func (*SwapSegmentIndexRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{22}
+}
+
+func (m *SwapSegmentIndexRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SwapSegmentIndexRequest.Unmarshal(m, b)
+}
+func (m *SwapSegmentIndexRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SwapSegmentIndexRequest.Marshal(b, m, deterministic)
+}
+func (m *SwapSegmentIndexRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SwapSegmentIndexRequest.Merge(m, src)
+}
+func (m *SwapSegmentIndexRequest) XXX_Size() int {
+	return xxx_messageInfo_SwapSegmentIndexRequest.Size(m)
+}
+func (m *SwapSegmentIndexRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SwapSegmentIndexRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SwapSegmentIndexRequest proto.InternalMessageInfo
+
+func (m *SwapSegmentIndexRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *SwapSegmentIndexRequest) GetNodeID() int64 {
+	if m != nil {
+		return m.NodeID
+	}
+	return 0
+}
+
+func (m *SwapSegmentIndexRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *SwapSegmentIndexRequest) GetSegmentID() int64 {
+	if m != nil {
+		return m.SegmentID
+	}
+	return 0
+}
+
+func (m *SwapSegmentIndexRequest) GetFieldID() int64 {
+	if m != nil {
+		return m.FieldID
+	}
+	return 0
+}
+
+func (m *SwapSegmentIndexRequest) GetIndexName() string {
+	if m != nil {
+		return m.IndexName
+	}
+	return ""
+}
+
+func (m *SwapSegmentIndexRequest) GetIndexID() int64 {
+	if m != nil {
+		return m.IndexID
+	}
+	return 0
+}
+
+func (m *SwapSegmentIndexRequest) GetReplacedIndexID() int64 {
+	if m != nil {
+		return m.ReplacedIndexID
+	}
+	return 0
+}
+
 type DmChannelInfo struct {
 	NodeIDLoaded         int64    `protobuf:"varint,1,opt,name=nodeID_loaded,json=nodeIDLoaded,proto3" json:"nodeID_loaded,omitempty"`
 	ChannelIDs           []string `protobuf:"bytes,2,rep,name=channelIDs,proto3" json:"channelIDs,omitempty"`
@@ -1611,7 +1706,7 @@ func (m *DmChannelInfo) Reset()         { *m = DmChannelInfo{} }
 func (m *DmChannelInfo) String() string { return proto.CompactTextString(m) }
 func (*DmChannelInfo) ProtoMessage()    {}
 func (*DmChannelInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{22}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{23}
 }
 
 func (m *DmChannelInfo) XXX_Unmarshal(b []byte) error {
@@ -1659,7 +1754,7 @@ func (m *QueryChannelInfo) Reset()         { *m = QueryChannelInfo{} }
 func (m *QueryChannelInfo) String() string { return proto.CompactTextString(m) }
 func (*QueryChannelInfo) ProtoMessage()    {}
 func (*QueryChannelInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{23}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{24}
 }
 
 func (m *QueryChannelInfo) XXX_Unmarshal(b []byte) error {
@@ -1719,7 +1814,7 @@ func (m *CollectionInfo) Reset()         { *m = CollectionInfo{} }
 func (m *CollectionInfo) String() string { return proto.CompactTextString(m) }
 func (*CollectionInfo) ProtoMessage()    {}
 func (*CollectionInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{24}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{25}
 }
 
 func (m *CollectionInfo) XXX_Unmarshal(b []byte) error {
@@ -1808,7 +1903,7 @@ func (m *HandoffSegments) Reset()         { *m = HandoffSegments{} }
 func (m *HandoffSegments) String() string { return proto.CompactTextString(m) }
 func (*HandoffSegments) ProtoMessage()    {}
 func (*HandoffSegments) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{25}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{26}
 }
 
 func (m *HandoffSegments) XXX_Unmarshal(b []byte) error {
@@ -1861,7 +1956,7 @@ func (m *LoadBalanceSegmentInfo) Reset()         { *m = LoadBalanceSegmentInfo{}
 func (m *LoadBalanceSegmentInfo) String() string { return proto.CompactTextString(m) }
 func (*LoadBalanceSegmentInfo) ProtoMessage()    {}
 func (*LoadBalanceSegmentInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{26}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{27}
 }
 
 func (m *LoadBalanceSegmentInfo) XXX_Unmarshal(b []byte) error {
@@ -1951,7 +2046,7 @@ func (m *LoadBalanceRequest) Reset()         { *m = LoadBalanceRequest{} }
 func (m *LoadBalanceRequest) String() string { return proto.CompactTextString(m) }
 func (*LoadBalanceRequest) ProtoMessage()    {}
 func (*LoadBalanceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{27}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{28}
 }
 
 func (m *LoadBalanceRequest) XXX_Unmarshal(b []byte) error {
@@ -2020,6 +2115,7 @@ func init() {
 	proto.RegisterType((*SegmentLoadInfo)(nil), "milvus.proto.query.SegmentLoadInfo")
 	proto.RegisterType((*LoadSegmentsRequest)(nil), "milvus.proto.query.LoadSegmentsRequest")
 	proto.RegisterType((*ReleaseSegmentsRequest)(nil), "milvus.proto.query.ReleaseSegmentsRequest")
+	proto.RegisterType((*SwapSegmentIndexRequest)(nil), "milvus.proto.query.SwapSegmentIndexRequest")
 	proto.RegisterType((*DmChannelInfo)(nil), "milvus.proto.query.DmChannelInfo")
 	proto.RegisterType((*QueryChannelInfo)(nil), "milvus.proto.query.QueryChannelInfo")
 	proto.RegisterType((*CollectionInfo)(nil), "milvus.proto.query.CollectionInfo")
@@ -2031,131 +2127,135 @@ func init() {
 func init() { proto.RegisterFile("query_coord.proto", fileDescriptor_aab7cc9a69ed26e8) }
 
 var fileDescriptor_aab7cc9a69ed26e8 = []byte{
-	// 1978 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0xcd, 0x73, 0xdc, 0x48,
-	0x15, 0xb7, 0x66, 0xc6, 0xf3, 0xf1, 0xe6, 0x4b, 0xe9, 0xc4, 0x66, 0x32, 0x6c, 0xb2, 0x46, 0xd9,
-	0x6c, 0xb2, 0x5e, 0xd6, 0xde, 0x75, 0x96, 0x2a, 0x72, 0xe0, 0xb0, 0xf1, 0x6c, 0xcc, 0xc0, 0xc6,
-	0x31, 0xb2, 0x59, 0x8a, 0x54, 0xaa, 0x84, 0x46, 0x6a, 0x8f, 0x55, 0x2b, 0xa9, 0x27, 0x6a, 0x4d,
-	0x1c, 0xe7, 0xc0, 0x89, 0x1b, 0x67, 0x4e, 0x50, 0x54, 0x51, 0xc5, 0x47, 0x71, 0xe0, 0x1f, 0xe0,
-	0xb4, 0x17, 0xee, 0xfc, 0x03, 0x50, 0x45, 0xc1, 0x9d, 0x7f, 0x81, 0xea, 0x56, 0x4b, 0xa3, 0x8f,
-	0x1e, 0x7b, 0x62, 0x63, 0x92, 0xda, 0xe2, 0x36, 0xfd, 0xfa, 0xe9, 0xbd, 0xd7, 0xef, 0xe3, 0xd7,
-	0xaf, 0xdf, 0xc0, 0x95, 0x67, 0x53, 0x1c, 0x9c, 0x18, 0x16, 0x21, 0x81, 0xbd, 0x31, 0x09, 0x48,
-	0x48, 0x10, 0xf2, 0x1c, 0xf7, 0xf9, 0x94, 0x46, 0xab, 0x0d, 0xbe, 0xdf, 0x6f, 0x59, 0xc4, 0xf3,
-	0x88, 0x1f, 0xd1, 0xfa, 0xad, 0x34, 0x47, 0xbf, 0xe3, 0xf8, 0x21, 0x0e, 0x7c, 0xd3, 0x8d, 0x77,
-	0xa9, 0x75, 0x84, 0x3d, 0x53, 0xac, 0x54, 0xdb, 0x0c, 0xcd, 0xb4, 0x7c, 0xed, 0x67, 0x0a, 0xac,
-	0xee, 0x1f, 0x91, 0xe3, 0x6d, 0xe2, 0xba, 0xd8, 0x0a, 0x1d, 0xe2, 0x53, 0x1d, 0x3f, 0x9b, 0x62,
-	0x1a, 0xa2, 0x0f, 0xa1, 0x32, 0x32, 0x29, 0xee, 0x29, 0x6b, 0xca, 0xdd, 0xe6, 0xd6, 0x5b, 0x1b,
-	0x19, 0x4b, 0x84, 0x09, 0x8f, 0xe8, 0xf8, 0x81, 0x49, 0xb1, 0xce, 0x39, 0x11, 0x82, 0x8a, 0x3d,
-	0x1a, 0x0e, 0x7a, 0xa5, 0x35, 0xe5, 0x6e, 0x59, 0xe7, 0xbf, 0xd1, 0x3b, 0xd0, 0xb6, 0x12, 0xd9,
-	0xc3, 0x01, 0xed, 0x95, 0xd7, 0xca, 0x77, 0xcb, 0x7a, 0x96, 0xa8, 0xfd, 0x41, 0x81, 0xaf, 0x15,
-	0xcc, 0xa0, 0x13, 0xe2, 0x53, 0x8c, 0xee, 0x41, 0x95, 0x86, 0x66, 0x38, 0xa5, 0xc2, 0x92, 0xaf,
-	0x4b, 0x2d, 0xd9, 0xe7, 0x2c, 0xba, 0x60, 0x2d, 0xaa, 0x2d, 0x49, 0xd4, 0xa2, 0x8f, 0xe0, 0x9a,
-	0xe3, 0x3f, 0xc2, 0x1e, 0x09, 0x4e, 0x8c, 0x09, 0x0e, 0x2c, 0xec, 0x87, 0xe6, 0x18, 0xc7, 0x36,
-	0x5e, 0x8d, 0xf7, 0xf6, 0x66, 0x5b, 0xda, 0xef, 0x14, 0x58, 0x61, 0x96, 0xee, 0x99, 0x41, 0xe8,
-	0x5c, 0x82, 0xbf, 0x34, 0x68, 0xa5, 0x6d, 0xec, 0x95, 0xf9, 0x5e, 0x86, 0xc6, 0x78, 0x26, 0xb1,
-	0x7a, 0x76, 0xb6, 0x0a, 0x37, 0x37, 0x43, 0xd3, 0x7e, 0x2b, 0x02, 0x9b, 0xb6, 0xf3, 0x22, 0x0e,
-	0xcd, 0xeb, 0x2c, 0x15, 0x75, 0x9e, 0xc7, 0x9d, 0x5f, 0x2a, 0xb0, 0xf2, 0x19, 0x31, 0xed, 0x59,
-	0xe0, 0xff, 0xf7, 0xee, 0xfc, 0x0e, 0x54, 0xa3, 0x2a, 0xe9, 0x55, 0xb8, 0xae, 0xdb, 0x59, 0x5d,
-	0xa2, 0x82, 0x66, 0x16, 0xee, 0x73, 0x82, 0x2e, 0x3e, 0xd2, 0x7e, 0xa5, 0x40, 0x4f, 0xc7, 0x2e,
-	0x36, 0x29, 0x7e, 0x9d, 0xa7, 0x58, 0x85, 0xaa, 0x4f, 0x6c, 0x3c, 0x1c, 0xf0, 0x53, 0x94, 0x75,
-	0xb1, 0xd2, 0xfe, 0x25, 0x3c, 0xfc, 0x86, 0x27, 0x6c, 0x2a, 0x0a, 0xcb, 0xe7, 0x89, 0xc2, 0x97,
-	0xb3, 0x28, 0xbc, 0xe9, 0x27, 0x9d, 0x45, 0x6a, 0x39, 0x13, 0xa9, 0x1f, 0xc3, 0xf5, 0xed, 0x00,
-	0x9b, 0x21, 0xfe, 0x01, 0x83, 0xf9, 0xed, 0x23, 0xd3, 0xf7, 0xb1, 0x1b, 0x1f, 0x21, 0xaf, 0x5c,
-	0x91, 0x28, 0xef, 0x41, 0x6d, 0x12, 0x90, 0x17, 0x27, 0x89, 0xdd, 0xf1, 0x52, 0xfb, 0x8d, 0x02,
-	0x7d, 0x99, 0xec, 0x8b, 0x20, 0xc2, 0x1d, 0xe8, 0x06, 0x91, 0x71, 0x86, 0x15, 0xc9, 0xe3, 0x5a,
-	0x1b, 0x7a, 0x47, 0x90, 0x85, 0x16, 0x74, 0x1b, 0x3a, 0x01, 0xa6, 0x53, 0x77, 0xc6, 0x57, 0xe6,
-	0x7c, 0xed, 0x88, 0x2a, 0xd8, 0xb4, 0x3f, 0x2a, 0x70, 0x7d, 0x07, 0x87, 0x49, 0xf4, 0x98, 0x3a,
-	0xfc, 0x86, 0xa2, 0xeb, 0xaf, 0x15, 0xe8, 0xe6, 0x0c, 0x45, 0x6b, 0xd0, 0x4c, 0xf1, 0x88, 0x00,
-	0xa5, 0x49, 0xe8, 0xdb, 0xb0, 0xcc, 0x7c, 0x87, 0xb9, 0x49, 0x9d, 0x2d, 0x6d, 0xa3, 0x78, 0xb9,
-	0x6f, 0x64, 0xa5, 0xea, 0xd1, 0x07, 0x68, 0x13, 0xae, 0x4a, 0x90, 0x55, 0x98, 0x8f, 0x8a, 0xc0,
-	0xaa, 0xfd, 0x49, 0x81, 0xbe, 0xcc, 0x99, 0x17, 0x09, 0xf8, 0x13, 0x58, 0x4d, 0x4e, 0x63, 0xd8,
-	0x98, 0x5a, 0x81, 0x33, 0xe1, 0x65, 0xc6, 0x2f, 0x83, 0xe6, 0xd6, 0xad, 0xb3, 0xcf, 0x43, 0xf5,
-	0x95, 0x44, 0xc4, 0x20, 0x25, 0x41, 0x73, 0x60, 0x65, 0x07, 0x87, 0xfb, 0x78, 0xec, 0x61, 0x3f,
-	0x1c, 0xfa, 0x87, 0xe4, 0xfc, 0x71, 0xbf, 0x09, 0x40, 0x85, 0x9c, 0xe4, 0x9e, 0x4a, 0x51, 0xb4,
-	0xbf, 0x95, 0xa0, 0x99, 0x52, 0x84, 0xde, 0x82, 0x46, 0xb2, 0x2b, 0xa2, 0x36, 0x23, 0x14, 0x32,
-	0xa6, 0x24, 0xc9, 0x98, 0x5c, 0xe4, 0xcb, 0xc5, 0xc8, 0xcf, 0x01, 0x67, 0x74, 0x1d, 0xea, 0x1e,
-	0xf6, 0x0c, 0xea, 0xbc, 0xc4, 0x02, 0x0c, 0x6a, 0x1e, 0xf6, 0xf6, 0x9d, 0x97, 0x98, 0x6d, 0xf9,
-	0x53, 0xcf, 0x08, 0xc8, 0x31, 0xed, 0x55, 0xa3, 0x2d, 0x7f, 0xea, 0xe9, 0xe4, 0x98, 0xa2, 0x1b,
-	0x00, 0x8e, 0x6f, 0xe3, 0x17, 0x86, 0x6f, 0x7a, 0xb8, 0x57, 0xe3, 0xc5, 0xd4, 0xe0, 0x94, 0x5d,
-	0xd3, 0xc3, 0x0c, 0x06, 0xf8, 0x62, 0x38, 0xe8, 0xd5, 0xa3, 0x0f, 0xc5, 0x92, 0x1d, 0x55, 0x94,
-	0xe0, 0x70, 0xd0, 0x6b, 0x44, 0xdf, 0x25, 0x04, 0xf4, 0x29, 0xb4, 0xc5, 0xb9, 0x8d, 0x28, 0x4d,
-	0x81, 0xa7, 0xe9, 0x9a, 0x2c, 0xac, 0xc2, 0x81, 0x51, 0x92, 0xb6, 0x68, 0x6a, 0xc5, 0x5b, 0xca,
-	0x7c, 0x2c, 0x2f, 0x92, 0x76, 0xdf, 0x82, 0x65, 0xc7, 0x3f, 0x24, 0x71, 0x96, 0xbd, 0x7d, 0x8a,
-	0x39, 0x5c, 0x59, 0xc4, 0xad, 0xfd, 0x5d, 0x81, 0xd5, 0x4f, 0x6c, 0x5b, 0x86, 0xa5, 0xaf, 0x9e,
-	0x53, 0xb3, 0xf8, 0x95, 0x32, 0xf1, 0x5b, 0x04, 0x4f, 0xde, 0x87, 0x2b, 0x39, 0x9c, 0x14, 0x69,
-	0xd0, 0xd0, 0xd5, 0x2c, 0x52, 0x0e, 0x07, 0xe8, 0x3d, 0x50, 0xb3, 0x58, 0x29, 0x6e, 0x89, 0x86,
-	0xde, 0xcd, 0xa0, 0xe5, 0x70, 0xa0, 0xfd, 0x43, 0x81, 0xeb, 0x3a, 0xf6, 0xc8, 0x73, 0xfc, 0xd5,
-	0x3d, 0xe3, 0x3f, 0x4b, 0xb0, 0xfa, 0x23, 0x33, 0xb4, 0x8e, 0x06, 0x9e, 0x20, 0xd2, 0xd7, 0x73,
-	0xc0, 0x5c, 0x89, 0x57, 0x8a, 0x25, 0x9e, 0xa4, 0xe9, 0xb2, 0x2c, 0x4d, 0xd9, 0xc3, 0x6b, 0xe3,
-	0xf3, 0xf8, 0xbc, 0xb3, 0x34, 0x4d, 0xb5, 0x3d, 0xd5, 0x73, 0xb4, 0x3d, 0x68, 0x1b, 0xda, 0xf8,
-	0x85, 0xe5, 0x4e, 0x6d, 0x6c, 0x44, 0xda, 0x6b, 0x5c, 0xfb, 0x4d, 0x89, 0xf6, 0x74, 0x8d, 0xb4,
-	0xc4, 0x47, 0x43, 0x5e, 0x2a, 0x3f, 0x2f, 0x41, 0x57, 0xec, 0xb2, 0x4e, 0x71, 0x01, 0x54, 0xcc,
-	0xb9, 0xa3, 0x54, 0x74, 0xc7, 0x22, 0x4e, 0x8d, 0x6f, 0xe8, 0x4a, 0xea, 0x86, 0xbe, 0x01, 0x70,
-	0xe8, 0x4e, 0xe9, 0x91, 0x11, 0x3a, 0x5e, 0x8c, 0x89, 0x0d, 0x4e, 0x39, 0x70, 0x3c, 0x8c, 0x3e,
-	0x81, 0xd6, 0xc8, 0xf1, 0x5d, 0x32, 0x36, 0x26, 0x66, 0x78, 0xc4, 0x90, 0x71, 0xde, 0x71, 0x1f,
-	0x3a, 0xd8, 0xb5, 0x1f, 0x70, 0x5e, 0xbd, 0x19, 0x7d, 0xb3, 0xc7, 0x3e, 0x41, 0x37, 0xa1, 0xc9,
-	0x80, 0x95, 0x1c, 0x46, 0xd8, 0x5a, 0x8b, 0x54, 0xf8, 0x53, 0xef, 0xf1, 0x21, 0x43, 0x57, 0xed,
-	0xf7, 0x25, 0xb8, 0xca, 0xdc, 0x20, 0x3c, 0x72, 0x09, 0x09, 0x77, 0x3f, 0x4e, 0x95, 0xf2, 0xfc,
-	0x7b, 0x33, 0x17, 0x8f, 0x62, 0xba, 0x9c, 0xe7, 0xad, 0x82, 0xbe, 0x0f, 0x1d, 0x97, 0x98, 0xb6,
-	0x61, 0x11, 0xdf, 0xe6, 0x91, 0xe2, 0x1e, 0xee, 0x6c, 0xbd, 0x23, 0x33, 0xe1, 0x20, 0x70, 0xc6,
-	0x63, 0x1c, 0x6c, 0xc7, 0xbc, 0x7a, 0xdb, 0xe5, 0x2f, 0x35, 0xb1, 0xe4, 0x08, 0x2b, 0x5a, 0xee,
-	0xcb, 0xf3, 0x55, 0x9c, 0x23, 0xe5, 0x53, 0xba, 0xb8, 0xca, 0x02, 0x5d, 0xdc, 0xb2, 0xa4, 0x11,
-	0xcf, 0x76, 0x0a, 0xd5, 0x42, 0xa7, 0x70, 0x00, 0xed, 0x04, 0x77, 0x78, 0x51, 0xdc, 0x82, 0x76,
-	0x64, 0x96, 0xc1, 0x3c, 0x81, 0xed, 0xb8, 0x0b, 0x8f, 0x88, 0x9f, 0x71, 0x1a, 0x93, 0x9a, 0xe0,
-	0x5a, 0x74, 0x69, 0x35, 0xf4, 0x14, 0x45, 0xfb, 0x85, 0x02, 0x6a, 0x1a, 0xb1, 0xb9, 0xe4, 0x45,
-	0xda, 0xfb, 0x3b, 0xd0, 0x15, 0x03, 0xa2, 0x04, 0x36, 0x45, 0xc3, 0xfd, 0x2c, 0x2d, 0x6e, 0x80,
-	0x3e, 0x86, 0xd5, 0x88, 0xb1, 0x00, 0xb3, 0x51, 0xe3, 0x7d, 0x8d, 0xef, 0xea, 0x39, 0xac, 0xfd,
-	0x6b, 0x19, 0x3a, 0xb3, 0xc4, 0x59, 0xd8, 0xaa, 0x45, 0x06, 0x03, 0xbb, 0xa0, 0xce, 0x3a, 0x47,
-	0xde, 0x5b, 0x9c, 0x9a, 0xfb, 0xf9, 0x9e, 0xb1, 0x3b, 0xc9, 0xb5, 0xda, 0x0f, 0xa1, 0x2d, 0xce,
-	0x24, 0x50, 0xaf, 0xc2, 0x85, 0x7d, 0x43, 0x26, 0x2c, 0x13, 0x41, 0xbd, 0x95, 0x82, 0x60, 0x8a,
-	0xee, 0x43, 0x83, 0x97, 0x43, 0x78, 0x32, 0xc1, 0xa2, 0x12, 0xde, 0x92, 0xc9, 0x60, 0x91, 0x3d,
-	0x38, 0x99, 0x60, 0xbd, 0xee, 0x8a, 0x5f, 0x17, 0xc5, 0xed, 0x7b, 0xb0, 0x12, 0x44, 0xa5, 0x63,
-	0x1b, 0x19, 0xf7, 0xd5, 0xb8, 0xfb, 0xae, 0xc5, 0x9b, 0x7b, 0x69, 0x37, 0xce, 0x79, 0x05, 0xd4,
-	0xe7, 0xbe, 0x02, 0x7e, 0x0a, 0xdd, 0xef, 0x9a, 0xbe, 0x4d, 0x0e, 0x0f, 0xe3, 0x02, 0x3d, 0x47,
-	0x65, 0xde, 0xcf, 0xf6, 0x5f, 0xaf, 0x80, 0x56, 0xda, 0x2f, 0x4b, 0xb0, 0xca, 0x68, 0x0f, 0x4c,
-	0xd7, 0xf4, 0x2d, 0xbc, 0x78, 0xd7, 0xfd, 0xdf, 0xb9, 0x5f, 0x6e, 0x41, 0x9b, 0x92, 0x69, 0x60,
-	0x61, 0x23, 0xd3, 0x7c, 0xb7, 0x22, 0xe2, 0x6e, 0x04, 0x30, 0x37, 0x00, 0x6c, 0x1a, 0x1a, 0x99,
-	0x17, 0x79, 0xc3, 0xa6, 0xa1, 0xd8, 0x7e, 0x1b, 0x9a, 0x42, 0x86, 0x4d, 0x7c, 0xcc, 0x83, 0x5d,
-	0xd7, 0x21, 0x22, 0x0d, 0x88, 0xcf, 0xfb, 0x74, 0xf6, 0x3d, 0xdf, 0xad, 0xf1, 0xdd, 0x9a, 0x4d,
-	0x43, 0xbe, 0x75, 0x03, 0xe0, 0xb9, 0xe9, 0x3a, 0x36, 0x4f, 0x52, 0x1e, 0xa6, 0xba, 0xde, 0xe0,
-	0x14, 0xe6, 0x02, 0xed, 0xcf, 0x0a, 0xa0, 0x94, 0x77, 0xce, 0x8f, 0x9d, 0xb7, 0xa1, 0x93, 0x39,
-	0x67, 0x32, 0xed, 0x4c, 0x1f, 0x94, 0x32, 0xf0, 0x1f, 0x45, 0xaa, 0x8c, 0x00, 0x9b, 0x94, 0xf8,
-	0xdc, 0x69, 0x0b, 0x83, 0xff, 0x28, 0x36, 0x93, 0x7d, 0xba, 0xfe, 0x12, 0x3a, 0xd9, 0x32, 0x45,
-	0x2d, 0xa8, 0xef, 0x92, 0xf0, 0xd3, 0x17, 0x0e, 0x0d, 0xd5, 0x25, 0xd4, 0x01, 0xd8, 0x25, 0xe1,
-	0x5e, 0x80, 0x29, 0xf6, 0x43, 0x55, 0x41, 0x00, 0xd5, 0xc7, 0xfe, 0xc0, 0xa1, 0x5f, 0xa8, 0x25,
-	0x74, 0x55, 0x3c, 0x9e, 0x4d, 0x77, 0x28, 0x72, 0x56, 0x2d, 0xb3, 0xcf, 0x93, 0x55, 0x05, 0xa9,
-	0xd0, 0x4a, 0x58, 0x76, 0xf6, 0x7e, 0xa8, 0x2e, 0xa3, 0x06, 0x2c, 0x47, 0x3f, 0xab, 0xeb, 0x8f,
-	0x41, 0xcd, 0x9b, 0x87, 0x9a, 0x50, 0x3b, 0x8a, 0x52, 0x5d, 0x5d, 0x42, 0x5d, 0x68, 0xba, 0x33,
-	0xc7, 0xaa, 0x0a, 0x23, 0x8c, 0x83, 0x89, 0x25, 0x5c, 0xac, 0x96, 0x98, 0x36, 0xe6, 0xab, 0x01,
-	0x39, 0xf6, 0xd5, 0xf2, 0xfa, 0xf7, 0xa0, 0x95, 0x7e, 0xd0, 0xa0, 0x3a, 0x54, 0x76, 0x89, 0x8f,
-	0xd5, 0x25, 0x26, 0x76, 0x27, 0x20, 0xc7, 0x8e, 0x3f, 0x8e, 0xce, 0xf0, 0x30, 0x20, 0x2f, 0xb1,
-	0xaf, 0x96, 0xd8, 0x06, 0xc5, 0xa6, 0xcb, 0x36, 0xca, 0x6c, 0x83, 0x2d, 0xb0, 0xad, 0x56, 0xd6,
-	0x3f, 0x82, 0x7a, 0x0c, 0x17, 0xe8, 0x0a, 0xb4, 0x33, 0xa3, 0x37, 0x75, 0x09, 0xa1, 0xe8, 0x06,
-	0x9e, 0x01, 0x83, 0xaa, 0x6c, 0xfd, 0x1b, 0x00, 0xa2, 0x1b, 0x81, 0x90, 0xc0, 0x46, 0x13, 0x40,
-	0x3b, 0x38, 0xdc, 0x26, 0xde, 0x84, 0xf8, 0xb1, 0x49, 0x14, 0x7d, 0x98, 0x8d, 0x52, 0x32, 0xe7,
-	0x2f, 0xb2, 0x8a, 0x53, 0xf6, 0xdf, 0x9d, 0xf3, 0x45, 0x8e, 0x5d, 0x5b, 0x42, 0x1e, 0xd7, 0xc8,
-	0x1a, 0xac, 0x03, 0xc7, 0xfa, 0x22, 0x9e, 0xdb, 0x9c, 0xa2, 0x31, 0xc7, 0x1a, 0x6b, 0xcc, 0x61,
-	0x83, 0x58, 0xec, 0x87, 0x81, 0xe3, 0x8f, 0xe3, 0x47, 0xa0, 0xb6, 0x84, 0x9e, 0xc1, 0x35, 0xf6,
-	0x40, 0x0c, 0xcd, 0xd0, 0xa1, 0xa1, 0x63, 0xd1, 0x58, 0xe1, 0xd6, 0x7c, 0x85, 0x05, 0xe6, 0x57,
-	0x54, 0xe9, 0x42, 0x37, 0xf7, 0xff, 0x02, 0x5a, 0x97, 0x02, 0x99, 0xf4, 0xbf, 0x90, 0xfe, 0xfb,
-	0x0b, 0xf1, 0x26, 0xda, 0x1c, 0xe8, 0x64, 0x67, 0xef, 0xe8, 0xbd, 0x79, 0x02, 0x0a, 0xc3, 0xca,
-	0xfe, 0xfa, 0x22, 0xac, 0x89, 0xaa, 0x27, 0xd0, 0xc9, 0x4e, 0x77, 0xe5, 0xaa, 0xa4, 0x13, 0xe0,
-	0xfe, 0x69, 0xef, 0x6f, 0x6d, 0x09, 0xfd, 0x04, 0xae, 0x14, 0x46, 0xaa, 0xe8, 0x9b, 0x32, 0xf1,
-	0xf3, 0x26, 0xaf, 0x67, 0x69, 0x10, 0xd6, 0xcf, 0xbc, 0x38, 0xdf, 0xfa, 0xc2, 0x6c, 0x7d, 0x71,
-	0xeb, 0x53, 0xe2, 0x4f, 0xb3, 0xfe, 0x95, 0x35, 0x4c, 0x01, 0x15, 0x87, 0xaa, 0xe8, 0x03, 0x99,
-	0x8a, 0xb9, 0x83, 0xdd, 0xfe, 0xc6, 0xa2, 0xec, 0x49, 0xc8, 0xa7, 0xbc, 0x5a, 0xf3, 0xe3, 0x47,
-	0xa9, 0xda, 0xb9, 0xf3, 0x54, 0xb9, 0xda, 0xf9, 0x13, 0xc3, 0x28, 0xa9, 0xb3, 0x63, 0x1d, 0x79,
-	0xac, 0xa4, 0x63, 0x3c, 0x79, 0x52, 0xcb, 0xa7, 0x44, 0xda, 0x12, 0x32, 0x00, 0x76, 0x70, 0xf8,
-	0x08, 0x87, 0x81, 0x63, 0x51, 0xf4, 0xae, 0xb4, 0xc4, 0x67, 0x0c, 0xb1, 0x8e, 0x3b, 0x67, 0xf2,
-	0xc5, 0x0a, 0xb6, 0xfe, 0xd2, 0x80, 0x06, 0xf7, 0x2e, 0xbb, 0x1b, 0xff, 0x0f, 0xb8, 0x97, 0x00,
-	0xb8, 0x4f, 0xa1, 0x9b, 0x9b, 0xbe, 0xc9, 0x01, 0x57, 0x3e, 0xa2, 0x3b, 0xab, 0xf2, 0x46, 0x80,
-	0x8a, 0xa3, 0x2f, 0x79, 0x09, 0xcc, 0x1d, 0x91, 0x9d, 0xa5, 0xe3, 0x29, 0x74, 0x73, 0xa3, 0x27,
-	0xf9, 0x09, 0xe4, 0xf3, 0xa9, 0xb3, 0xa4, 0x7f, 0x0e, 0xad, 0xf4, 0x90, 0x01, 0xdd, 0x99, 0x87,
-	0x7b, 0xb9, 0xa7, 0xf5, 0xeb, 0x47, 0xbd, 0xcb, 0xbf, 0x15, 0x9e, 0x42, 0x37, 0x37, 0x57, 0x90,
-	0x7b, 0x5e, 0x3e, 0x7c, 0x38, 0x4b, 0xfa, 0x57, 0x08, 0xc7, 0x1e, 0x7c, 0xfc, 0x64, 0x6b, 0xec,
-	0x84, 0x47, 0xd3, 0x11, 0x3b, 0xe5, 0x66, 0xc4, 0xf9, 0x81, 0x43, 0xc4, 0xaf, 0xcd, 0xb8, 0xa0,
-	0x37, 0xb9, 0xa4, 0x4d, 0x6e, 0xed, 0x64, 0x34, 0xaa, 0xf2, 0xe5, 0xbd, 0xff, 0x04, 0x00, 0x00,
-	0xff, 0xff, 0x70, 0xb6, 0x53, 0xcf, 0x70, 0x22, 0x00, 0x00,
+	// 2044 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0x4f, 0x73, 0xdc, 0x48,
+	0x15, 0xb7, 0x66, 0xc6, 0x33, 0x9e, 0xe7, 0xf9, 0xa3, 0x74, 0x62, 0xef, 0x64, 0x48, 0xb2, 0x46,
+	0xd9, 0x6c, 0xb2, 0x5e, 0xd6, 0xde, 0x75, 0x96, 0x2a, 0x72, 0xe0, 0xb0, 0xf1, 0x6c, 0xcc, 0xc0,
+	0xc6, 0x31, 0xb2, 0x59, 0x8a, 0x54, 0x0a, 0xa1, 0x91, 0xda, 0x63, 0xd5, 0x4a, 0xea, 0x89, 0x5a,
+	0x13, 0xdb, 0x39, 0x70, 0xe2, 0xc6, 0x99, 0x13, 0x14, 0x55, 0x50, 0x2c, 0x14, 0x07, 0xbe, 0x00,
+	0xa7, 0xfd, 0x08, 0x7c, 0x01, 0xa8, 0xa2, 0xe0, 0xce, 0x57, 0xa0, 0xfa, 0x8f, 0x34, 0xfa, 0x37,
+	0xf6, 0xd8, 0xc6, 0x24, 0x95, 0xda, 0x9b, 0xfa, 0xf5, 0xeb, 0xf7, 0x5e, 0xbf, 0xf7, 0xfa, 0xf7,
+	0xba, 0x9f, 0xe0, 0xca, 0xf3, 0x31, 0x0e, 0x8e, 0x0d, 0x8b, 0x90, 0xc0, 0x5e, 0x1b, 0x05, 0x24,
+	0x24, 0x08, 0x79, 0x8e, 0xfb, 0x62, 0x4c, 0xc5, 0x68, 0x8d, 0xcf, 0x77, 0x1b, 0x16, 0xf1, 0x3c,
+	0xe2, 0x0b, 0x5a, 0xb7, 0x91, 0xe4, 0xe8, 0xb6, 0x1c, 0x3f, 0xc4, 0x81, 0x6f, 0xba, 0xd1, 0x2c,
+	0xb5, 0x0e, 0xb0, 0x67, 0xca, 0x91, 0x6a, 0x9b, 0xa1, 0x99, 0x94, 0xaf, 0xfd, 0x42, 0x81, 0xe5,
+	0xdd, 0x03, 0x72, 0xb8, 0x49, 0x5c, 0x17, 0x5b, 0xa1, 0x43, 0x7c, 0xaa, 0xe3, 0xe7, 0x63, 0x4c,
+	0x43, 0xf4, 0x21, 0x54, 0x06, 0x26, 0xc5, 0x1d, 0x65, 0x45, 0xb9, 0xb7, 0xb8, 0x71, 0x63, 0x2d,
+	0x65, 0x89, 0x34, 0xe1, 0x31, 0x1d, 0x3e, 0x34, 0x29, 0xd6, 0x39, 0x27, 0x42, 0x50, 0xb1, 0x07,
+	0xfd, 0x5e, 0xa7, 0xb4, 0xa2, 0xdc, 0x2b, 0xeb, 0xfc, 0x1b, 0xbd, 0x03, 0x4d, 0x2b, 0x96, 0xdd,
+	0xef, 0xd1, 0x4e, 0x79, 0xa5, 0x7c, 0xaf, 0xac, 0xa7, 0x89, 0xda, 0x9f, 0x14, 0x78, 0x2b, 0x67,
+	0x06, 0x1d, 0x11, 0x9f, 0x62, 0x74, 0x1f, 0xaa, 0x34, 0x34, 0xc3, 0x31, 0x95, 0x96, 0x7c, 0xa3,
+	0xd0, 0x92, 0x5d, 0xce, 0xa2, 0x4b, 0xd6, 0xbc, 0xda, 0x52, 0x81, 0x5a, 0xf4, 0x11, 0x5c, 0x73,
+	0xfc, 0xc7, 0xd8, 0x23, 0xc1, 0xb1, 0x31, 0xc2, 0x81, 0x85, 0xfd, 0xd0, 0x1c, 0xe2, 0xc8, 0xc6,
+	0xab, 0xd1, 0xdc, 0xce, 0x64, 0x4a, 0xfb, 0x52, 0x81, 0x25, 0x66, 0xe9, 0x8e, 0x19, 0x84, 0xce,
+	0x25, 0xf8, 0x4b, 0x83, 0x46, 0xd2, 0xc6, 0x4e, 0x99, 0xcf, 0xa5, 0x68, 0x8c, 0x67, 0x14, 0xa9,
+	0x67, 0x7b, 0xab, 0x70, 0x73, 0x53, 0x34, 0xed, 0x0f, 0x32, 0xb0, 0x49, 0x3b, 0x2f, 0xe2, 0xd0,
+	0xac, 0xce, 0x52, 0x5e, 0xe7, 0x79, 0xdc, 0xf9, 0x95, 0x02, 0x4b, 0x9f, 0x11, 0xd3, 0x9e, 0x04,
+	0xfe, 0xff, 0xef, 0xce, 0xef, 0x42, 0x55, 0x9c, 0x92, 0x4e, 0x85, 0xeb, 0xba, 0x93, 0xd6, 0x25,
+	0xe6, 0xd6, 0x26, 0x16, 0xee, 0x72, 0x82, 0x2e, 0x17, 0x69, 0xbf, 0x51, 0xa0, 0xa3, 0x63, 0x17,
+	0x9b, 0x14, 0xbf, 0xca, 0x5d, 0x2c, 0x43, 0xd5, 0x27, 0x36, 0xee, 0xf7, 0xf8, 0x2e, 0xca, 0xba,
+	0x1c, 0x69, 0xff, 0x96, 0x1e, 0x7e, 0xcd, 0x13, 0x36, 0x11, 0x85, 0xf9, 0xf3, 0x44, 0xe1, 0xab,
+	0x49, 0x14, 0x5e, 0xf7, 0x9d, 0x4e, 0x22, 0x35, 0x9f, 0x8a, 0xd4, 0x4f, 0xe0, 0xfa, 0x66, 0x80,
+	0xcd, 0x10, 0xff, 0x90, 0xc1, 0xfc, 0xe6, 0x81, 0xe9, 0xfb, 0xd8, 0x8d, 0xb6, 0x90, 0x55, 0xae,
+	0x14, 0x28, 0xef, 0x40, 0x6d, 0x14, 0x90, 0xa3, 0xe3, 0xd8, 0xee, 0x68, 0xa8, 0xfd, 0x4e, 0x81,
+	0x6e, 0x91, 0xec, 0x8b, 0x20, 0xc2, 0x5d, 0x68, 0x07, 0xc2, 0x38, 0xc3, 0x12, 0xf2, 0xb8, 0xd6,
+	0xba, 0xde, 0x92, 0x64, 0xa9, 0x05, 0xdd, 0x81, 0x56, 0x80, 0xe9, 0xd8, 0x9d, 0xf0, 0x95, 0x39,
+	0x5f, 0x53, 0x50, 0x25, 0x9b, 0xf6, 0x67, 0x05, 0xae, 0x6f, 0xe1, 0x30, 0x8e, 0x1e, 0x53, 0x87,
+	0x5f, 0x53, 0x74, 0xfd, 0xad, 0x02, 0xed, 0x8c, 0xa1, 0x68, 0x05, 0x16, 0x13, 0x3c, 0x32, 0x40,
+	0x49, 0x12, 0xfa, 0x0e, 0xcc, 0x33, 0xdf, 0x61, 0x6e, 0x52, 0x6b, 0x43, 0x5b, 0xcb, 0x17, 0xf7,
+	0xb5, 0xb4, 0x54, 0x5d, 0x2c, 0x40, 0xeb, 0x70, 0xb5, 0x00, 0x59, 0xa5, 0xf9, 0x28, 0x0f, 0xac,
+	0xda, 0x5f, 0x14, 0xe8, 0x16, 0x39, 0xf3, 0x22, 0x01, 0x7f, 0x0a, 0xcb, 0xf1, 0x6e, 0x0c, 0x1b,
+	0x53, 0x2b, 0x70, 0x46, 0xec, 0x5b, 0x14, 0x83, 0xc5, 0x8d, 0xdb, 0xa7, 0xef, 0x87, 0xea, 0x4b,
+	0xb1, 0x88, 0x5e, 0x42, 0x82, 0xe6, 0xc0, 0xd2, 0x16, 0x0e, 0x77, 0xf1, 0xd0, 0xc3, 0x7e, 0xd8,
+	0xf7, 0xf7, 0xc9, 0xf9, 0xe3, 0x7e, 0x0b, 0x80, 0x4a, 0x39, 0x71, 0x9d, 0x4a, 0x50, 0xb4, 0xbf,
+	0x97, 0x60, 0x31, 0xa1, 0x08, 0xdd, 0x80, 0x7a, 0x3c, 0x2b, 0xa3, 0x36, 0x21, 0xe4, 0x32, 0xa6,
+	0x54, 0x90, 0x31, 0x99, 0xc8, 0x97, 0xf3, 0x91, 0x9f, 0x02, 0xce, 0xe8, 0x3a, 0x2c, 0x78, 0xd8,
+	0x33, 0xa8, 0xf3, 0x12, 0x4b, 0x30, 0xa8, 0x79, 0xd8, 0xdb, 0x75, 0x5e, 0x62, 0x36, 0xe5, 0x8f,
+	0x3d, 0x23, 0x20, 0x87, 0xb4, 0x53, 0x15, 0x53, 0xfe, 0xd8, 0xd3, 0xc9, 0x21, 0x45, 0x37, 0x01,
+	0x1c, 0xdf, 0xc6, 0x47, 0x86, 0x6f, 0x7a, 0xb8, 0x53, 0xe3, 0x87, 0xa9, 0xce, 0x29, 0xdb, 0xa6,
+	0x87, 0x19, 0x0c, 0xf0, 0x41, 0xbf, 0xd7, 0x59, 0x10, 0x0b, 0xe5, 0x90, 0x6d, 0x55, 0x1e, 0xc1,
+	0x7e, 0xaf, 0x53, 0x17, 0xeb, 0x62, 0x02, 0xfa, 0x14, 0x9a, 0x72, 0xdf, 0x86, 0x48, 0x53, 0xe0,
+	0x69, 0xba, 0x52, 0x14, 0x56, 0xe9, 0x40, 0x91, 0xa4, 0x0d, 0x9a, 0x18, 0xf1, 0x2b, 0x65, 0x36,
+	0x96, 0x17, 0x49, 0xbb, 0x6f, 0xc3, 0xbc, 0xe3, 0xef, 0x93, 0x28, 0xcb, 0xde, 0x3e, 0xc1, 0x1c,
+	0xae, 0x4c, 0x70, 0x6b, 0xff, 0x50, 0x60, 0xf9, 0x13, 0xdb, 0x2e, 0xc2, 0xd2, 0xb3, 0xe7, 0xd4,
+	0x24, 0x7e, 0xa5, 0x54, 0xfc, 0x66, 0xc1, 0x93, 0xf7, 0xe1, 0x4a, 0x06, 0x27, 0x65, 0x1a, 0xd4,
+	0x75, 0x35, 0x8d, 0x94, 0xfd, 0x1e, 0x7a, 0x0f, 0xd4, 0x34, 0x56, 0xca, 0x2a, 0x51, 0xd7, 0xdb,
+	0x29, 0xb4, 0xec, 0xf7, 0xb4, 0x7f, 0x2a, 0x70, 0x5d, 0xc7, 0x1e, 0x79, 0x81, 0xdf, 0xdc, 0x3d,
+	0xfe, 0xab, 0x04, 0xcb, 0x3f, 0x36, 0x43, 0xeb, 0xa0, 0xe7, 0x49, 0x22, 0x7d, 0x35, 0x1b, 0xcc,
+	0x1c, 0xf1, 0x4a, 0xfe, 0x88, 0xc7, 0x69, 0x3a, 0x5f, 0x94, 0xa6, 0xec, 0xe1, 0xb5, 0xf6, 0x79,
+	0xb4, 0xdf, 0x49, 0x9a, 0x26, 0xae, 0x3d, 0xd5, 0x73, 0x5c, 0x7b, 0xd0, 0x26, 0x34, 0xf1, 0x91,
+	0xe5, 0x8e, 0x6d, 0x6c, 0x08, 0xed, 0x35, 0xae, 0xfd, 0x56, 0x81, 0xf6, 0xe4, 0x19, 0x69, 0xc8,
+	0x45, 0x7d, 0x7e, 0x54, 0x7e, 0x59, 0x82, 0xb6, 0x9c, 0x65, 0x37, 0xc5, 0x19, 0x50, 0x31, 0xe3,
+	0x8e, 0x52, 0xde, 0x1d, 0xb3, 0x38, 0x35, 0xaa, 0xd0, 0x95, 0x44, 0x85, 0xbe, 0x09, 0xb0, 0xef,
+	0x8e, 0xe9, 0x81, 0x11, 0x3a, 0x5e, 0x84, 0x89, 0x75, 0x4e, 0xd9, 0x73, 0x3c, 0x8c, 0x3e, 0x81,
+	0xc6, 0xc0, 0xf1, 0x5d, 0x32, 0x34, 0x46, 0x66, 0x78, 0xc0, 0x90, 0x71, 0xda, 0x76, 0x1f, 0x39,
+	0xd8, 0xb5, 0x1f, 0x72, 0x5e, 0x7d, 0x51, 0xac, 0xd9, 0x61, 0x4b, 0xd0, 0x2d, 0x58, 0x64, 0xc0,
+	0x4a, 0xf6, 0x05, 0xb6, 0xd6, 0x84, 0x0a, 0x7f, 0xec, 0x3d, 0xd9, 0x67, 0xe8, 0xaa, 0xfd, 0xb1,
+	0x04, 0x57, 0x99, 0x1b, 0xa4, 0x47, 0x2e, 0x21, 0xe1, 0x1e, 0x44, 0xa9, 0x52, 0x9e, 0x5e, 0x37,
+	0x33, 0xf1, 0xc8, 0xa7, 0xcb, 0x79, 0xde, 0x2a, 0xe8, 0x07, 0xd0, 0x72, 0x89, 0x69, 0x1b, 0x16,
+	0xf1, 0x6d, 0x1e, 0x29, 0xee, 0xe1, 0xd6, 0xc6, 0x3b, 0x45, 0x26, 0xec, 0x05, 0xce, 0x70, 0x88,
+	0x83, 0xcd, 0x88, 0x57, 0x6f, 0xba, 0xfc, 0xa5, 0x26, 0x87, 0x1c, 0x61, 0xe5, 0x95, 0xfb, 0xf2,
+	0x7c, 0x15, 0xe5, 0x48, 0xf9, 0x84, 0x5b, 0x5c, 0x65, 0x86, 0x5b, 0xdc, 0x7c, 0xc1, 0x45, 0x3c,
+	0x7d, 0x53, 0xa8, 0xe6, 0x6e, 0x0a, 0xbf, 0x2f, 0xc1, 0x5b, 0xbb, 0x87, 0xe6, 0x28, 0x3e, 0x39,
+	0x36, 0x3e, 0x7a, 0x35, 0xf0, 0x93, 0x3a, 0x8d, 0x95, 0xec, 0x69, 0xec, 0x40, 0x6d, 0x9f, 0x65,
+	0x7b, 0xfc, 0xa2, 0x88, 0x86, 0x99, 0x9b, 0x42, 0xf5, 0x84, 0x9b, 0x42, 0x2d, 0x7d, 0x53, 0xb8,
+	0xc7, 0x2e, 0xf7, 0x23, 0xd7, 0xb4, 0xb0, 0xdd, 0x4f, 0xdd, 0x25, 0xb2, 0x64, 0x6d, 0x0f, 0x9a,
+	0x31, 0x38, 0x73, 0xe4, 0xb8, 0x0d, 0x4d, 0xb1, 0x33, 0x83, 0xa5, 0x0b, 0xb6, 0xa3, 0xa7, 0x8a,
+	0x20, 0x7e, 0xc6, 0x69, 0xcc, 0xf5, 0x31, 0xf8, 0x8b, 0xca, 0x5e, 0xd7, 0x13, 0x14, 0xed, 0x57,
+	0x0a, 0xa8, 0xc9, 0xb2, 0xc6, 0x25, 0xcf, 0xf2, 0x06, 0xba, 0x0b, 0x6d, 0xd9, 0x45, 0x8b, 0x6b,
+	0x8b, 0x7c, 0x95, 0x3c, 0x4f, 0x8a, 0xeb, 0xa1, 0x8f, 0x61, 0x59, 0x30, 0xe6, 0x6a, 0x91, 0x78,
+	0x9d, 0x5c, 0xe3, 0xb3, 0x7a, 0xa6, 0x20, 0xfd, 0xad, 0x0c, 0xad, 0xc9, 0xe9, 0x9a, 0xd9, 0xaa,
+	0x59, 0xba, 0x27, 0xdb, 0xa0, 0x4e, 0xae, 0xd7, 0xfc, 0x02, 0x76, 0x22, 0x40, 0x64, 0x2f, 0xd6,
+	0xed, 0x51, 0x9a, 0x80, 0x1e, 0x41, 0x53, 0xee, 0x49, 0x96, 0x86, 0x0a, 0x17, 0xf6, 0xcd, 0x22,
+	0x61, 0xa9, 0x08, 0xea, 0x8d, 0x44, 0x9d, 0xa2, 0xe8, 0x01, 0xd4, 0x39, 0x66, 0x84, 0xc7, 0x23,
+	0x2c, 0xe1, 0xe2, 0x46, 0x91, 0x0c, 0x16, 0xd9, 0xbd, 0xe3, 0x11, 0xd6, 0x17, 0x5c, 0xf9, 0x75,
+	0xd1, 0xe2, 0x76, 0x1f, 0x96, 0x02, 0x81, 0x2f, 0xb6, 0x91, 0x72, 0x5f, 0x8d, 0xbb, 0xef, 0x5a,
+	0x34, 0xb9, 0x93, 0x74, 0xe3, 0x94, 0xa7, 0xd2, 0xc2, 0xd4, 0xa7, 0xd2, 0xcf, 0xa1, 0xfd, 0x3d,
+	0xd3, 0xb7, 0xc9, 0xfe, 0x7e, 0x84, 0x62, 0xe7, 0x38, 0xdc, 0x0f, 0xd2, 0x97, 0xd4, 0x33, 0x40,
+	0xba, 0xf6, 0xeb, 0x12, 0x2c, 0x33, 0xda, 0x43, 0xd3, 0x35, 0x7d, 0x0b, 0xcf, 0xfe, 0x34, 0xf9,
+	0xdf, 0x14, 0xe1, 0xdb, 0xd0, 0xa4, 0x64, 0x1c, 0x58, 0xd8, 0x48, 0xbd, 0x50, 0x1a, 0x82, 0xb8,
+	0xcd, 0x69, 0x0c, 0x47, 0x6c, 0x1a, 0x1a, 0xa9, 0xb6, 0x45, 0xdd, 0xa6, 0xa1, 0x9c, 0x7e, 0x1b,
+	0x16, 0xa5, 0x0c, 0x9b, 0xf8, 0x02, 0x67, 0x16, 0x74, 0x10, 0xa4, 0x1e, 0xf1, 0xf9, 0x63, 0x86,
+	0xad, 0xe7, 0xb3, 0x35, 0x3e, 0x5b, 0xb3, 0x69, 0xc8, 0xa7, 0x6e, 0x02, 0xbc, 0x30, 0x5d, 0xc7,
+	0xe6, 0x49, 0xca, 0xc3, 0xb4, 0xa0, 0xd7, 0x39, 0x85, 0xb9, 0x40, 0xfb, 0xab, 0x02, 0x28, 0xe1,
+	0x9d, 0xf3, 0xc3, 0xef, 0x1d, 0x68, 0xa5, 0xf6, 0x19, 0xb7, 0x84, 0x93, 0x1b, 0xa5, 0xac, 0x42,
+	0x0e, 0x84, 0x2a, 0x23, 0xc0, 0x26, 0x25, 0x7e, 0xa7, 0x7c, 0x96, 0x0a, 0x39, 0x88, 0xcc, 0x64,
+	0x4b, 0x57, 0x5f, 0x42, 0x2b, 0x7d, 0x4c, 0x51, 0x03, 0x16, 0xb6, 0x49, 0xf8, 0xe9, 0x91, 0x43,
+	0x43, 0x75, 0x0e, 0xb5, 0x00, 0xb6, 0x49, 0xb8, 0x13, 0x60, 0x8a, 0xfd, 0x50, 0x55, 0x10, 0x40,
+	0xf5, 0x89, 0xdf, 0x73, 0xe8, 0x17, 0x6a, 0x09, 0x5d, 0x95, 0x1d, 0x06, 0xd3, 0xed, 0xcb, 0x9c,
+	0x55, 0xcb, 0x6c, 0x79, 0x3c, 0xaa, 0x20, 0x15, 0x1a, 0x31, 0xcb, 0xd6, 0xce, 0x8f, 0xd4, 0x79,
+	0x54, 0x87, 0x79, 0xf1, 0x59, 0x5d, 0x7d, 0x02, 0x6a, 0xd6, 0x3c, 0xb4, 0x08, 0xb5, 0x03, 0x91,
+	0xea, 0xea, 0x1c, 0x6a, 0xc3, 0xa2, 0x3b, 0x71, 0xac, 0xaa, 0x30, 0xc2, 0x30, 0x18, 0x59, 0xd2,
+	0xc5, 0x6a, 0x89, 0x69, 0x63, 0xbe, 0xea, 0x91, 0x43, 0x5f, 0x2d, 0xaf, 0x7e, 0x1f, 0x1a, 0xc9,
+	0x57, 0x1f, 0x5a, 0x80, 0xca, 0x36, 0xf1, 0xb1, 0x3a, 0xc7, 0xc4, 0x6e, 0x05, 0xe4, 0xd0, 0xf1,
+	0x87, 0x62, 0x0f, 0x8f, 0x02, 0xf2, 0x12, 0xfb, 0x6a, 0x89, 0x4d, 0x50, 0x6c, 0xba, 0x6c, 0xa2,
+	0xcc, 0x26, 0xd8, 0x00, 0xdb, 0x6a, 0x65, 0xf5, 0x23, 0x58, 0x88, 0xe0, 0x02, 0x5d, 0x81, 0x66,
+	0xaa, 0x3f, 0xa9, 0xce, 0x21, 0x24, 0xae, 0x29, 0x13, 0x60, 0x50, 0x95, 0x8d, 0xff, 0x00, 0x80,
+	0xa8, 0x08, 0xec, 0xf7, 0x05, 0x1a, 0x01, 0xda, 0xc2, 0xe1, 0x26, 0xf1, 0x46, 0xc4, 0x8f, 0x4c,
+	0xa2, 0xe8, 0xc3, 0x74, 0x94, 0xe2, 0x9f, 0x21, 0x79, 0x56, 0xb9, 0xcb, 0xee, 0xbb, 0x53, 0x56,
+	0x64, 0xd8, 0xb5, 0x39, 0xe4, 0x71, 0x8d, 0xec, 0x16, 0xba, 0xe7, 0x58, 0x5f, 0x44, 0xcd, 0xad,
+	0x13, 0x34, 0x66, 0x58, 0x23, 0x8d, 0x19, 0x6c, 0x90, 0x83, 0xdd, 0x30, 0x70, 0xfc, 0x61, 0xf4,
+	0x52, 0xd6, 0xe6, 0xd0, 0x73, 0xb8, 0xc6, 0x5e, 0xd1, 0xa1, 0x19, 0x3a, 0x34, 0x74, 0x2c, 0x1a,
+	0x29, 0xdc, 0x98, 0xae, 0x30, 0xc7, 0x7c, 0x46, 0x95, 0x2e, 0xb4, 0x33, 0x3f, 0x61, 0xd0, 0x6a,
+	0x21, 0x90, 0x15, 0xfe, 0x30, 0xea, 0xbe, 0x3f, 0x13, 0x6f, 0xac, 0xcd, 0x81, 0x56, 0xfa, 0x07,
+	0x05, 0x7a, 0x6f, 0x9a, 0x80, 0x5c, 0x47, 0xb7, 0xbb, 0x3a, 0x0b, 0x6b, 0xac, 0xea, 0x29, 0xb4,
+	0xd2, 0x2d, 0xf0, 0x62, 0x55, 0x85, 0x6d, 0xf2, 0xee, 0x49, 0x4d, 0x0a, 0x6d, 0x0e, 0xfd, 0x0c,
+	0xae, 0xe4, 0xfa, 0xce, 0xe8, 0x5b, 0x45, 0xe2, 0xa7, 0xb5, 0xa7, 0x4f, 0xd3, 0x20, 0xad, 0x9f,
+	0x78, 0x71, 0xba, 0xf5, 0xb9, 0x1f, 0x10, 0xb3, 0x5b, 0x9f, 0x10, 0x7f, 0x92, 0xf5, 0x67, 0xd6,
+	0x30, 0x06, 0x94, 0xef, 0x3c, 0xa3, 0x0f, 0x8a, 0x54, 0x4c, 0xed, 0x7e, 0x77, 0xd7, 0x66, 0x65,
+	0x8f, 0x43, 0x3e, 0xe6, 0xa7, 0x35, 0xdb, 0xa3, 0x2d, 0x54, 0x3b, 0xb5, 0xe9, 0xdc, 0x5d, 0x9b,
+	0x95, 0x3d, 0x99, 0xd4, 0xe9, 0xde, 0x57, 0x71, 0xac, 0x0a, 0x7b, 0x9d, 0xdd, 0xd5, 0x59, 0x58,
+	0x63, 0x55, 0x06, 0xc0, 0x16, 0x0e, 0x1f, 0xe3, 0x30, 0x70, 0x2c, 0x8a, 0xde, 0x2d, 0x3c, 0xe2,
+	0x13, 0x86, 0x48, 0xc7, 0xdd, 0x53, 0xf9, 0x22, 0x05, 0x1b, 0x5f, 0x02, 0xd4, 0xb9, 0x77, 0x59,
+	0x6d, 0xfc, 0x1a, 0x70, 0x2f, 0x01, 0x70, 0x9f, 0x41, 0x3b, 0xd3, 0xa2, 0x2c, 0x06, 0xdc, 0xe2,
+	0x3e, 0xe6, 0x69, 0x27, 0x6f, 0x00, 0x28, 0xdf, 0x1f, 0x2c, 0x3e, 0x02, 0x53, 0xfb, 0x88, 0xa7,
+	0xe9, 0x78, 0x06, 0xed, 0x4c, 0x7f, 0xae, 0x78, 0x07, 0xc5, 0x4d, 0xbc, 0xd3, 0xa4, 0x7f, 0x0e,
+	0x8d, 0x64, 0x27, 0x06, 0xdd, 0x9d, 0x86, 0x7b, 0x99, 0xfe, 0xc3, 0xab, 0x47, 0xbd, 0xcb, 0xaf,
+	0x0a, 0xcf, 0xa0, 0x9d, 0x69, 0xbe, 0x14, 0x7b, 0xbe, 0xb8, 0x43, 0x73, 0x9a, 0xf4, 0x9f, 0x82,
+	0x9a, 0xed, 0x7c, 0xa0, 0xe2, 0xfa, 0x5e, 0xdc, 0x1f, 0x39, 0x4d, 0xfe, 0x1b, 0x84, 0x93, 0x0f,
+	0x3f, 0x7e, 0xba, 0x31, 0x74, 0xc2, 0x83, 0xf1, 0x80, 0xed, 0x72, 0x5d, 0x70, 0x7e, 0xe0, 0x10,
+	0xf9, 0xb5, 0x1e, 0x01, 0xc6, 0x3a, 0x97, 0xb4, 0xce, 0xad, 0x1d, 0x0d, 0x06, 0x55, 0x3e, 0xbc,
+	0xff, 0xdf, 0x01, 0x00, 0x3d, 0x9d, 0xa0, 0x89, 0xf5, 0x23, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2686,6 +2786,7 @@ type QueryNodeClient interface {
 	ReleaseCollection(ctx context.Context, in *ReleaseCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	ReleasePartitions(ctx context.Context, in *ReleasePartitionsRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	ReleaseSegments(ctx context.Context, in *ReleaseSegmentsRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	SwapSegmentIndex(ctx context.Context, in *SwapSegmentIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	GetSegmentInfo(ctx context.Context, in *GetSegmentInfoRequest, opts ...grpc.CallOption) (*GetSegmentInfoResponse, error)
 	// https://wiki.lfaidata.foundation/display/MIL/MEP+8+--+Add+metrics+for+proxy
 	GetMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error)
@@ -2789,6 +2890,15 @@ func (c *queryNodeClient) ReleaseSegments(ctx context.Context, in *ReleaseSegmen
 	return out, nil
 }
 
+func (c *queryNodeClient) SwapSegmentIndex(ctx context.Context, in *SwapSegmentIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryNode/SwapSegmentIndex", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryNodeClient) GetSegmentInfo(ctx context.Context, in *GetSegmentInfoRequest, opts ...grpc.CallOption) (*GetSegmentInfoResponse, error) {
 	out := new(GetSegmentInfoResponse)
 	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryNode/GetSegmentInfo", in, out, opts...)
@@ -2819,6 +2929,7 @@ type QueryNodeServer interface {
 	ReleaseCollection(context.Context, *ReleaseCollectionRequest) (*commonpb.Status, error)
 	ReleasePartitions(context.Context, *ReleasePartitionsRequest) (*commonpb.Status, error)
 	ReleaseSegments(context.Context, *ReleaseSegmentsRequest) (*commonpb.Status, error)
+	SwapSegmentIndex(context.Context, *SwapSegmentIndexRequest) (*commonpb.Status, error)
 	GetSegmentInfo(context.Context, *GetSegmentInfoRequest) (*GetSegmentInfoResponse, error)
 	// https://wiki.lfaidata.foundation/display/MIL/MEP+8+--+Add+metrics+for+proxy
 	GetMetrics(context.Context, *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error)
@@ -2858,6 +2969,9 @@ func (*UnimplementedQueryNodeServer) ReleasePartitions(ctx context.Context, req
 func (*UnimplementedQueryNodeServer) ReleaseSegments(ctx context.Context, req *ReleaseSegmentsRequest) (*commonpb.Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReleaseSegments not implemented")
 }
+func (*UnimplementedQueryNodeServer) SwapSegmentIndex(ctx context.Context, req *SwapSegmentIndexRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SwapSegmentIndex not implemented")
+}
 func (*UnimplementedQueryNodeServer) GetSegmentInfo(ctx context.Context, req *GetSegmentInfoRequest) (*GetSegmentInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSegmentInfo not implemented")
 }
@@ -3049,6 +3163,24 @@ func _QueryNode_ReleaseSegments_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _QueryNode_SwapSegmentIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SwapSegmentIndexRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryNodeServer).SwapSegmentIndex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryNode/SwapSegmentIndex",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryNodeServer).SwapSegmentIndex(ctx, req.(*SwapSegmentIndexRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _QueryNode_GetSegmentInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetSegmentInfoRequest)
 	if err := dec(in); err != nil {
@@ -3129,6 +3261,10 @@ var _QueryNode_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ReleaseSegments",
 			Handler:    _QueryNode_ReleaseSegments_Handler,
 		},
+		{
+			MethodName: "SwapSegmentIndex",
+			Handler:    _QueryNode_SwapSegmentIndex_Handler,
+		},
 		{
 			MethodName: "GetSegmentInfo",
 			Handler:    _QueryNode_GetSegmentInfo_Handler,
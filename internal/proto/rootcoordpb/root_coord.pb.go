@@ -233,69 +233,629 @@ func (m *AllocIDResponse) GetCount() uint32 {
 	return 0
 }
 
+type GetCredentialRequest struct {
+	// Not useful for now
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// username
+	Username             string   `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetCredentialRequest) Reset()         { *m = GetCredentialRequest{} }
+func (m *GetCredentialRequest) String() string { return proto.CompactTextString(m) }
+func (*GetCredentialRequest) ProtoMessage()    {}
+func (*GetCredentialRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{4}
+}
+
+func (m *GetCredentialRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCredentialRequest.Unmarshal(m, b)
+}
+func (m *GetCredentialRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCredentialRequest.Marshal(b, m, deterministic)
+}
+func (m *GetCredentialRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCredentialRequest.Merge(m, src)
+}
+func (m *GetCredentialRequest) XXX_Size() int {
+	return xxx_messageInfo_GetCredentialRequest.Size(m)
+}
+func (m *GetCredentialRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCredentialRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCredentialRequest proto.InternalMessageInfo
+
+func (m *GetCredentialRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *GetCredentialRequest) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+type GetCredentialResponse struct {
+	// Contain error_code and reason
+	Status *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// username
+	Username string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	// password stored in etcd, encrypted by bcrypt
+	Password             string   `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetCredentialResponse) Reset()         { *m = GetCredentialResponse{} }
+func (m *GetCredentialResponse) String() string { return proto.CompactTextString(m) }
+func (*GetCredentialResponse) ProtoMessage()    {}
+func (*GetCredentialResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{5}
+}
+
+func (m *GetCredentialResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCredentialResponse.Unmarshal(m, b)
+}
+func (m *GetCredentialResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCredentialResponse.Marshal(b, m, deterministic)
+}
+func (m *GetCredentialResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCredentialResponse.Merge(m, src)
+}
+func (m *GetCredentialResponse) XXX_Size() int {
+	return xxx_messageInfo_GetCredentialResponse.Size(m)
+}
+func (m *GetCredentialResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCredentialResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCredentialResponse proto.InternalMessageInfo
+
+func (m *GetCredentialResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetCredentialResponse) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *GetCredentialResponse) GetPassword() string {
+	if m != nil {
+		return m.Password
+	}
+	return ""
+}
+
+type ListPolicyRequest struct {
+	// Not useful for now
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ListPolicyRequest) Reset()         { *m = ListPolicyRequest{} }
+func (m *ListPolicyRequest) String() string { return proto.CompactTextString(m) }
+func (*ListPolicyRequest) ProtoMessage()    {}
+func (*ListPolicyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{6}
+}
+
+func (m *ListPolicyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListPolicyRequest.Unmarshal(m, b)
+}
+func (m *ListPolicyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListPolicyRequest.Marshal(b, m, deterministic)
+}
+func (m *ListPolicyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListPolicyRequest.Merge(m, src)
+}
+func (m *ListPolicyRequest) XXX_Size() int {
+	return xxx_messageInfo_ListPolicyRequest.Size(m)
+}
+func (m *ListPolicyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListPolicyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListPolicyRequest proto.InternalMessageInfo
+
+func (m *ListPolicyRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+type ListPolicyResponse struct {
+	// Contain error_code and reason
+	Status *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// all the grants of all roles
+	Grants []*milvuspb.GrantEntity `protobuf:"bytes,2,rep,name=grants,proto3" json:"grants,omitempty"`
+	// roles of every user
+	UserRoles            []*milvuspb.UserResult `protobuf:"bytes,3,rep,name=user_roles,json=userRoles,proto3" json:"user_roles,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *ListPolicyResponse) Reset()         { *m = ListPolicyResponse{} }
+func (m *ListPolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*ListPolicyResponse) ProtoMessage()    {}
+func (*ListPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{7}
+}
+
+func (m *ListPolicyResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListPolicyResponse.Unmarshal(m, b)
+}
+func (m *ListPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListPolicyResponse.Marshal(b, m, deterministic)
+}
+func (m *ListPolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListPolicyResponse.Merge(m, src)
+}
+func (m *ListPolicyResponse) XXX_Size() int {
+	return xxx_messageInfo_ListPolicyResponse.Size(m)
+}
+func (m *ListPolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListPolicyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListPolicyResponse proto.InternalMessageInfo
+
+func (m *ListPolicyResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *ListPolicyResponse) GetGrants() []*milvuspb.GrantEntity {
+	if m != nil {
+		return m.Grants
+	}
+	return nil
+}
+
+func (m *ListPolicyResponse) GetUserRoles() []*milvuspb.UserResult {
+	if m != nil {
+		return m.UserRoles
+	}
+	return nil
+}
+
+type MsgSubscription struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// number of messages not acked by the subscription, -1 means unknown
+	Backlog int64 `protobuf:"varint,2,opt,name=backlog,proto3" json:"backlog,omitempty"`
+	// number of connected consumers, -1 means unknown
+	Consumers            int64    `protobuf:"varint,3,opt,name=consumers,proto3" json:"consumers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MsgSubscription) Reset()         { *m = MsgSubscription{} }
+func (m *MsgSubscription) String() string { return proto.CompactTextString(m) }
+func (*MsgSubscription) ProtoMessage()    {}
+func (*MsgSubscription) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{8}
+}
+
+func (m *MsgSubscription) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MsgSubscription.Unmarshal(m, b)
+}
+func (m *MsgSubscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MsgSubscription.Marshal(b, m, deterministic)
+}
+func (m *MsgSubscription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubscription.Merge(m, src)
+}
+func (m *MsgSubscription) XXX_Size() int {
+	return xxx_messageInfo_MsgSubscription.Size(m)
+}
+func (m *MsgSubscription) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubscription.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubscription proto.InternalMessageInfo
+
+func (m *MsgSubscription) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *MsgSubscription) GetBacklog() int64 {
+	if m != nil {
+		return m.Backlog
+	}
+	return 0
+}
+
+func (m *MsgSubscription) GetConsumers() int64 {
+	if m != nil {
+		return m.Consumers
+	}
+	return 0
+}
+
+type MsgTopic struct {
+	Name          string             `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Subscriptions []*MsgSubscription `protobuf:"bytes,2,rep,name=subscriptions,proto3" json:"subscriptions,omitempty"`
+	// whether the topic is still used by rootcoord dml channels, querycoord query channels
+	// or is a system channel, topics not referenced can be purged
+	Referenced           bool     `protobuf:"varint,3,opt,name=referenced,proto3" json:"referenced,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MsgTopic) Reset()         { *m = MsgTopic{} }
+func (m *MsgTopic) String() string { return proto.CompactTextString(m) }
+func (*MsgTopic) ProtoMessage()    {}
+func (*MsgTopic) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{9}
+}
+
+func (m *MsgTopic) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MsgTopic.Unmarshal(m, b)
+}
+func (m *MsgTopic) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MsgTopic.Marshal(b, m, deterministic)
+}
+func (m *MsgTopic) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTopic.Merge(m, src)
+}
+func (m *MsgTopic) XXX_Size() int {
+	return xxx_messageInfo_MsgTopic.Size(m)
+}
+func (m *MsgTopic) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTopic.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTopic proto.InternalMessageInfo
+
+func (m *MsgTopic) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *MsgTopic) GetSubscriptions() []*MsgSubscription {
+	if m != nil {
+		return m.Subscriptions
+	}
+	return nil
+}
+
+func (m *MsgTopic) GetReferenced() bool {
+	if m != nil {
+		return m.Referenced
+	}
+	return false
+}
+
+type ListMsgTopicsRequest struct {
+	// Not useful for now
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ListMsgTopicsRequest) Reset()         { *m = ListMsgTopicsRequest{} }
+func (m *ListMsgTopicsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListMsgTopicsRequest) ProtoMessage()    {}
+func (*ListMsgTopicsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{10}
+}
+
+func (m *ListMsgTopicsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListMsgTopicsRequest.Unmarshal(m, b)
+}
+func (m *ListMsgTopicsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListMsgTopicsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListMsgTopicsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListMsgTopicsRequest.Merge(m, src)
+}
+func (m *ListMsgTopicsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListMsgTopicsRequest.Size(m)
+}
+func (m *ListMsgTopicsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListMsgTopicsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListMsgTopicsRequest proto.InternalMessageInfo
+
+func (m *ListMsgTopicsRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+type ListMsgTopicsResponse struct {
+	// Contain error_code and reason
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Topics               []*MsgTopic      `protobuf:"bytes,2,rep,name=topics,proto3" json:"topics,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ListMsgTopicsResponse) Reset()         { *m = ListMsgTopicsResponse{} }
+func (m *ListMsgTopicsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListMsgTopicsResponse) ProtoMessage()    {}
+func (*ListMsgTopicsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{11}
+}
+
+func (m *ListMsgTopicsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListMsgTopicsResponse.Unmarshal(m, b)
+}
+func (m *ListMsgTopicsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListMsgTopicsResponse.Marshal(b, m, deterministic)
+}
+func (m *ListMsgTopicsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListMsgTopicsResponse.Merge(m, src)
+}
+func (m *ListMsgTopicsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListMsgTopicsResponse.Size(m)
+}
+func (m *ListMsgTopicsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListMsgTopicsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListMsgTopicsResponse proto.InternalMessageInfo
+
+func (m *ListMsgTopicsResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *ListMsgTopicsResponse) GetTopics() []*MsgTopic {
+	if m != nil {
+		return m.Topics
+	}
+	return nil
+}
+
+type PurgeMsgTopicsRequest struct {
+	// Not useful for now
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// only report what would be purged
+	DryRun               bool     `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PurgeMsgTopicsRequest) Reset()         { *m = PurgeMsgTopicsRequest{} }
+func (m *PurgeMsgTopicsRequest) String() string { return proto.CompactTextString(m) }
+func (*PurgeMsgTopicsRequest) ProtoMessage()    {}
+func (*PurgeMsgTopicsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{12}
+}
+
+func (m *PurgeMsgTopicsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PurgeMsgTopicsRequest.Unmarshal(m, b)
+}
+func (m *PurgeMsgTopicsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PurgeMsgTopicsRequest.Marshal(b, m, deterministic)
+}
+func (m *PurgeMsgTopicsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PurgeMsgTopicsRequest.Merge(m, src)
+}
+func (m *PurgeMsgTopicsRequest) XXX_Size() int {
+	return xxx_messageInfo_PurgeMsgTopicsRequest.Size(m)
+}
+func (m *PurgeMsgTopicsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PurgeMsgTopicsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PurgeMsgTopicsRequest proto.InternalMessageInfo
+
+func (m *PurgeMsgTopicsRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *PurgeMsgTopicsRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+type PurgeMsgTopicsResponse struct {
+	// Contain error_code and reason
+	Status *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// topics deleted together with their subscriptions
+	PurgedTopics []string `protobuf:"bytes,2,rep,name=purged_topics,json=purgedTopics,proto3" json:"purged_topics,omitempty"`
+	// subscriptions deleted from the topics which are kept
+	PurgedSubscriptions  []*MsgTopic `protobuf:"bytes,3,rep,name=purged_subscriptions,json=purgedSubscriptions,proto3" json:"purged_subscriptions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *PurgeMsgTopicsResponse) Reset()         { *m = PurgeMsgTopicsResponse{} }
+func (m *PurgeMsgTopicsResponse) String() string { return proto.CompactTextString(m) }
+func (*PurgeMsgTopicsResponse) ProtoMessage()    {}
+func (*PurgeMsgTopicsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{13}
+}
+
+func (m *PurgeMsgTopicsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PurgeMsgTopicsResponse.Unmarshal(m, b)
+}
+func (m *PurgeMsgTopicsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PurgeMsgTopicsResponse.Marshal(b, m, deterministic)
+}
+func (m *PurgeMsgTopicsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PurgeMsgTopicsResponse.Merge(m, src)
+}
+func (m *PurgeMsgTopicsResponse) XXX_Size() int {
+	return xxx_messageInfo_PurgeMsgTopicsResponse.Size(m)
+}
+func (m *PurgeMsgTopicsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PurgeMsgTopicsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PurgeMsgTopicsResponse proto.InternalMessageInfo
+
+func (m *PurgeMsgTopicsResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *PurgeMsgTopicsResponse) GetPurgedTopics() []string {
+	if m != nil {
+		return m.PurgedTopics
+	}
+	return nil
+}
+
+func (m *PurgeMsgTopicsResponse) GetPurgedSubscriptions() []*MsgTopic {
+	if m != nil {
+		return m.PurgedSubscriptions
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*AllocTimestampRequest)(nil), "milvus.proto.rootcoord.AllocTimestampRequest")
 	proto.RegisterType((*AllocTimestampResponse)(nil), "milvus.proto.rootcoord.AllocTimestampResponse")
 	proto.RegisterType((*AllocIDRequest)(nil), "milvus.proto.rootcoord.AllocIDRequest")
 	proto.RegisterType((*AllocIDResponse)(nil), "milvus.proto.rootcoord.AllocIDResponse")
+	proto.RegisterType((*GetCredentialRequest)(nil), "milvus.proto.rootcoord.GetCredentialRequest")
+	proto.RegisterType((*GetCredentialResponse)(nil), "milvus.proto.rootcoord.GetCredentialResponse")
+	proto.RegisterType((*ListPolicyRequest)(nil), "milvus.proto.rootcoord.ListPolicyRequest")
+	proto.RegisterType((*ListPolicyResponse)(nil), "milvus.proto.rootcoord.ListPolicyResponse")
+	proto.RegisterType((*MsgSubscription)(nil), "milvus.proto.rootcoord.MsgSubscription")
+	proto.RegisterType((*MsgTopic)(nil), "milvus.proto.rootcoord.MsgTopic")
+	proto.RegisterType((*ListMsgTopicsRequest)(nil), "milvus.proto.rootcoord.ListMsgTopicsRequest")
+	proto.RegisterType((*ListMsgTopicsResponse)(nil), "milvus.proto.rootcoord.ListMsgTopicsResponse")
+	proto.RegisterType((*PurgeMsgTopicsRequest)(nil), "milvus.proto.rootcoord.PurgeMsgTopicsRequest")
+	proto.RegisterType((*PurgeMsgTopicsResponse)(nil), "milvus.proto.rootcoord.PurgeMsgTopicsResponse")
 }
 
 func init() { proto.RegisterFile("root_coord.proto", fileDescriptor_4513485a144f6b06) }
 
 var fileDescriptor_4513485a144f6b06 = []byte{
-	// 826 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0xdb, 0x4f, 0xe3, 0x46,
-	0x14, 0xc6, 0x49, 0xa0, 0x54, 0x1c, 0x72, 0x41, 0x23, 0xa0, 0x28, 0xe5, 0x81, 0xa6, 0x2a, 0x24,
-	0x5c, 0x1c, 0x04, 0x52, 0xd5, 0x57, 0x48, 0x54, 0x88, 0xd4, 0x48, 0xc5, 0x01, 0x69, 0x6f, 0x28,
-	0x9a, 0x38, 0x47, 0x89, 0x85, 0xed, 0x31, 0x9e, 0xc9, 0xc2, 0x3e, 0xee, 0x5f, 0xb6, 0xff, 0xda,
-	0xca, 0x97, 0x71, 0x6c, 0xc7, 0x0e, 0x8e, 0x76, 0xdf, 0xe2, 0xf8, 0x37, 0xdf, 0x37, 0xe7, 0x3b,
-	0x1e, 0xfb, 0xc0, 0x96, 0xc3, 0x98, 0x18, 0x68, 0x8c, 0x39, 0x23, 0xc5, 0x76, 0x98, 0x60, 0x64,
-	0xd7, 0xd4, 0x8d, 0xcf, 0x53, 0xee, 0x5f, 0x29, 0xee, 0x6d, 0xef, 0x6e, 0xad, 0xa4, 0x31, 0xd3,
-	0x64, 0x96, 0xff, 0x7f, 0xad, 0x14, 0xa5, 0x6a, 0x15, 0xdd, 0x12, 0xe8, 0x58, 0xd4, 0x08, 0xae,
-	0x37, 0x6d, 0x87, 0xbd, 0x7e, 0x09, 0x2e, 0xb6, 0x46, 0x54, 0xd0, 0xa8, 0x45, 0x7d, 0x00, 0x3b,
-	0x57, 0x86, 0xc1, 0xb4, 0x7b, 0xdd, 0x44, 0x2e, 0xa8, 0x69, 0xab, 0xf8, 0x3c, 0x45, 0x2e, 0xc8,
-	0x39, 0xac, 0x0d, 0x29, 0xc7, 0xbd, 0xc2, 0x41, 0xa1, 0xb1, 0x79, 0xb1, 0xaf, 0xc4, 0xb6, 0x12,
-	0xf8, 0xf7, 0xf8, 0xf8, 0x9a, 0x72, 0x54, 0x3d, 0x92, 0x6c, 0xc3, 0x2f, 0x1a, 0x9b, 0x5a, 0x62,
-	0x6f, 0xf5, 0xa0, 0xd0, 0x28, 0xab, 0xfe, 0x45, 0xfd, 0x6b, 0x01, 0x76, 0x93, 0x0e, 0xdc, 0x66,
-	0x16, 0x47, 0x72, 0x09, 0xeb, 0x5c, 0x50, 0x31, 0xe5, 0x81, 0xc9, 0xef, 0xa9, 0x26, 0x7d, 0x0f,
-	0x51, 0x03, 0x94, 0xec, 0xc3, 0x86, 0x90, 0x4a, 0x7b, 0xc5, 0x83, 0x42, 0x63, 0x4d, 0x9d, 0xfd,
-	0x91, 0xb1, 0x87, 0x77, 0x50, 0xf1, 0xb6, 0xd0, 0xed, 0xfc, 0x84, 0xea, 0x8a, 0x51, 0x65, 0x03,
-	0xaa, 0xa1, 0xf2, 0x8f, 0x54, 0x55, 0x81, 0x62, 0xb7, 0xe3, 0x49, 0xaf, 0xaa, 0xc5, 0x6e, 0x27,
-	0xbd, 0x8e, 0x8b, 0x6f, 0xdb, 0xb0, 0xa1, 0x32, 0x26, 0xda, 0x6e, 0x03, 0x89, 0x0d, 0xe4, 0x06,
-	0x45, 0x9b, 0x99, 0x36, 0xb3, 0xd0, 0x12, 0xae, 0x22, 0x72, 0x72, 0x1e, 0xb7, 0x0b, 0x9f, 0x86,
-	0x79, 0x34, 0xc8, 0xa2, 0x76, 0x98, 0xb1, 0x22, 0x81, 0xd7, 0x57, 0x88, 0xe9, 0x39, 0xba, 0x8d,
-	0xbc, 0xd7, 0xb5, 0xa7, 0xf6, 0x84, 0x5a, 0x16, 0x1a, 0x8b, 0x1c, 0x13, 0xa8, 0x74, 0xfc, 0x33,
-	0xbe, 0x22, 0xb8, 0xe8, 0x0b, 0x47, 0xb7, 0xc6, 0x32, 0xc7, 0xfa, 0x0a, 0x79, 0x86, 0xed, 0x1b,
-	0xf4, 0xdc, 0x75, 0x2e, 0x74, 0x8d, 0x4b, 0xc3, 0x8b, 0x6c, 0xc3, 0x39, 0x78, 0x49, 0xcb, 0x01,
-	0x6c, 0xb5, 0x1d, 0xa4, 0x02, 0xdb, 0xcc, 0x30, 0x50, 0x13, 0x3a, 0xb3, 0xc8, 0x69, 0xea, 0xd2,
-	0x24, 0x26, 0x8d, 0x16, 0xb5, 0xbb, 0xbe, 0x42, 0x3e, 0x42, 0xa5, 0xe3, 0x30, 0x3b, 0x22, 0x7f,
-	0x9c, 0x2a, 0x1f, 0x87, 0x72, 0x8a, 0x0f, 0xa0, 0x7c, 0x4b, 0x79, 0x44, 0xbb, 0x99, 0xaa, 0x1d,
-	0x63, 0xa4, 0xf4, 0x1f, 0xa9, 0xe8, 0x35, 0x63, 0x46, 0x24, 0x9e, 0x17, 0x20, 0x1d, 0xe4, 0x9a,
-	0xa3, 0x0f, 0xa3, 0x01, 0x29, 0xe9, 0x15, 0xcc, 0x81, 0xd2, 0xaa, 0x95, 0x9b, 0x0f, 0x8d, 0x1f,
-	0x60, 0xd3, 0x0f, 0xfc, 0xca, 0xd0, 0x29, 0x27, 0x47, 0x0b, 0x5a, 0xe2, 0x11, 0x39, 0x03, 0xbb,
-	0x83, 0x0d, 0x37, 0x68, 0x5f, 0xf4, 0xaf, 0xcc, 0x46, 0x2c, 0x23, 0xd9, 0x07, 0xb8, 0x32, 0x04,
-	0x3a, 0xbe, 0xe6, 0x61, 0xaa, 0xe6, 0x0c, 0xc8, 0x29, 0x6a, 0x41, 0xb5, 0x3f, 0x61, 0x2f, 0xb3,
-	0x68, 0x38, 0x39, 0x49, 0x7f, 0xa0, 0xe3, 0x94, 0x94, 0x3f, 0xcd, 0x07, 0x87, 0x71, 0x3f, 0x42,
-	0xd5, 0x0f, 0xf3, 0x7f, 0xea, 0x08, 0xdd, 0x6b, 0xf2, 0xc9, 0x82, 0xc8, 0x43, 0x2a, 0x67, 0x39,
-	0xef, 0xa1, 0xec, 0xc6, 0x3a, 0x13, 0x6f, 0x66, 0x46, 0xbf, 0xac, 0xf4, 0x23, 0x94, 0x6e, 0x29,
-	0x9f, 0x29, 0x37, 0xb2, 0x4e, 0xc0, 0x9c, 0x70, 0xae, 0x03, 0xf0, 0x04, 0x15, 0x37, 0xb5, 0x70,
-	0x31, 0xcf, 0x38, 0xbe, 0x71, 0x48, 0x5a, 0x9c, 0xe4, 0x62, 0x43, 0x33, 0x0b, 0xaa, 0xf2, 0x50,
-	0xf4, 0x71, 0x6c, 0xa2, 0x25, 0x32, 0xba, 0x90, 0xa0, 0x16, 0x77, 0x7d, 0x0e, 0x0e, 0xfd, 0x10,
-	0x4a, 0xee, 0x5e, 0x82, 0x1b, 0x3c, 0x23, 0xbb, 0x28, 0x22, 0x9d, 0x9a, 0x39, 0xc8, 0xf9, 0xb3,
-	0xdc, 0xb5, 0x46, 0xf8, 0xba, 0xf0, 0x2c, 0x7b, 0x44, 0xce, 0xce, 0x4f, 0xa0, 0x2c, 0x4b, 0xf3,
-	0x85, 0x9b, 0x0b, 0xcb, 0x8f, 0x49, 0x1f, 0xe7, 0x41, 0xc3, 0x02, 0x82, 0xb7, 0x86, 0xef, 0x92,
-	0xfd, 0xd6, 0x58, 0x66, 0xf3, 0xcf, 0xc1, 0x84, 0x12, 0x0e, 0x49, 0xe4, 0x4c, 0x49, 0x1f, 0xfe,
-	0x94, 0xd4, 0x71, 0xad, 0xa6, 0xe4, 0xc5, 0xc3, 0x2a, 0x3e, 0xc1, 0xaf, 0xc1, 0xe8, 0x92, 0x7c,
-	0x4b, 0x25, 0x16, 0x87, 0x53, 0x53, 0xed, 0xe8, 0x4d, 0x2e, 0x54, 0xa7, 0xb0, 0xf3, 0x60, 0x8f,
-	0xdc, 0x2f, 0xa4, 0xff, 0x1d, 0x96, 0x93, 0x40, 0xb2, 0x2b, 0xb3, 0x69, 0x23, 0xce, 0xf5, 0xf8,
-	0xf8, 0xad, 0xcc, 0x0c, 0xf8, 0x4d, 0x45, 0x03, 0x29, 0xc7, 0xce, 0xdd, 0x7f, 0x3d, 0xe4, 0x9c,
-	0x8e, 0xb1, 0x2f, 0x1c, 0xa4, 0x66, 0x72, 0x42, 0xf0, 0x47, 0xe0, 0x0c, 0x38, 0x67, 0x87, 0x34,
-	0xd8, 0x09, 0x9e, 0xe5, 0x7f, 0x8d, 0x29, 0x9f, 0xb8, 0xc3, 0x91, 0x81, 0x02, 0x47, 0xc9, 0x23,
-	0xe9, 0x4e, 0xd8, 0x4a, 0x2a, 0x99, 0xa3, 0xa4, 0x01, 0xc0, 0x0d, 0x8a, 0x1e, 0x0a, 0x47, 0xd7,
-	0xb2, 0x3e, 0x1e, 0x33, 0x20, 0xa3, 0x2d, 0x29, 0x9c, 0x6c, 0xcb, 0xf5, 0x3f, 0x1f, 0xfe, 0x1e,
-	0xeb, 0x62, 0x32, 0x1d, 0xba, 0xd6, 0x2d, 0x9f, 0x3c, 0xd3, 0x59, 0xf0, 0xab, 0x25, 0xbb, 0xd1,
-	0xf2, 0x94, 0x5a, 0x61, 0x83, 0xed, 0xe1, 0x70, 0xdd, 0xfb, 0xeb, 0xf2, 0x7b, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x27, 0xad, 0x32, 0xbe, 0xa6, 0x0c, 0x00, 0x00,
+	// 1403 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0x5d, 0x6f, 0xd4, 0x46,
+	0x17, 0x66, 0x13, 0xde, 0x90, 0x3d, 0xc9, 0x26, 0x79, 0xa7, 0x09, 0x44, 0x5b, 0xd4, 0xa6, 0xa6,
+	0x40, 0x02, 0x64, 0x83, 0x82, 0x54, 0x71, 0x55, 0x09, 0xb2, 0x14, 0x22, 0xb1, 0x22, 0x78, 0x89,
+	0x44, 0x3f, 0xa2, 0x95, 0xd7, 0x7b, 0x70, 0x2c, 0xbc, 0x1e, 0x33, 0x33, 0x06, 0x72, 0x59, 0xa9,
+	0xea, 0x5d, 0x7f, 0x55, 0x2f, 0xfa, 0x1b, 0xfa, 0x6f, 0xaa, 0x19, 0x7f, 0xac, 0xed, 0xf5, 0x38,
+	0x4e, 0x96, 0xbb, 0x1d, 0xef, 0x73, 0x9e, 0x67, 0xce, 0x39, 0x73, 0xce, 0x7c, 0xc0, 0x1a, 0xa3,
+	0x54, 0x0c, 0x6c, 0x4a, 0xd9, 0xa8, 0x13, 0x30, 0x2a, 0x28, 0xb9, 0x3e, 0x76, 0xbd, 0x8f, 0x21,
+	0x8f, 0x46, 0x1d, 0xf9, 0xb7, 0xfa, 0xb7, 0xbd, 0x6c, 0xd3, 0xf1, 0x98, 0xfa, 0xd1, 0xf7, 0xf6,
+	0x72, 0x16, 0xd5, 0x5e, 0x71, 0x7d, 0x81, 0xcc, 0xb7, 0xbc, 0x78, 0xbc, 0x14, 0x30, 0xfa, 0xf9,
+	0x2c, 0x1e, 0xac, 0x8d, 0x2c, 0x61, 0x65, 0x25, 0x8c, 0x01, 0x6c, 0x3c, 0xf1, 0x3c, 0x6a, 0xbf,
+	0x71, 0xc7, 0xc8, 0x85, 0x35, 0x0e, 0x4c, 0xfc, 0x10, 0x22, 0x17, 0xe4, 0x21, 0x5c, 0x1d, 0x5a,
+	0x1c, 0x37, 0x1b, 0x5b, 0x8d, 0xed, 0xa5, 0xfd, 0x9b, 0x9d, 0xdc, 0x54, 0x62, 0xfd, 0x1e, 0x77,
+	0x9e, 0x5a, 0x1c, 0x4d, 0x85, 0x24, 0xeb, 0xf0, 0x3f, 0x9b, 0x86, 0xbe, 0xd8, 0x9c, 0xdf, 0x6a,
+	0x6c, 0xb7, 0xcc, 0x68, 0x60, 0xfc, 0xde, 0x80, 0xeb, 0x45, 0x05, 0x1e, 0x50, 0x9f, 0x23, 0x79,
+	0x04, 0x0b, 0x5c, 0x58, 0x22, 0xe4, 0xb1, 0xc8, 0xd7, 0xa5, 0x22, 0x7d, 0x05, 0x31, 0x63, 0x28,
+	0xb9, 0x09, 0x4d, 0x91, 0x30, 0x6d, 0xce, 0x6d, 0x35, 0xb6, 0xaf, 0x9a, 0x93, 0x0f, 0x9a, 0x39,
+	0xbc, 0x85, 0x15, 0x35, 0x85, 0xc3, 0xee, 0x17, 0xf0, 0x6e, 0x2e, 0xcb, 0xec, 0xc1, 0x6a, 0xca,
+	0x3c, 0x8b, 0x57, 0x2b, 0x30, 0x77, 0xd8, 0x55, 0xd4, 0xf3, 0xe6, 0xdc, 0x61, 0x57, 0xe3, 0xc7,
+	0x08, 0xd6, 0x9f, 0xa3, 0x38, 0x60, 0x38, 0x42, 0x5f, 0xb8, 0x96, 0x77, 0x79, 0x6f, 0xda, 0xb0,
+	0x18, 0x72, 0xb9, 0x4c, 0xc6, 0xa8, 0x54, 0x9b, 0x66, 0x3a, 0x36, 0xfe, 0x68, 0xc0, 0x46, 0x41,
+	0x66, 0x16, 0xd7, 0x2a, 0xa4, 0xe4, 0x7f, 0x81, 0xc5, 0xf9, 0x27, 0xca, 0x46, 0xca, 0xd3, 0xa6,
+	0x99, 0x8e, 0x8d, 0x67, 0xf0, 0xff, 0x97, 0x2e, 0x17, 0x47, 0xd4, 0x73, 0xed, 0xb3, 0x4b, 0x7b,
+	0x6a, 0xfc, 0xdd, 0x00, 0x92, 0xe5, 0x99, 0xc5, 0x95, 0xc7, 0xb0, 0xe0, 0x30, 0xcb, 0x17, 0x7c,
+	0x73, 0x6e, 0x6b, 0x7e, 0x7b, 0x69, 0x7f, 0x2b, 0x6f, 0x14, 0x0f, 0x9e, 0x4b, 0xc8, 0x33, 0x5f,
+	0xb8, 0xe2, 0xcc, 0x8c, 0xf1, 0xe4, 0x47, 0x00, 0xe9, 0xf4, 0x80, 0x51, 0x0f, 0xf9, 0xe6, 0xbc,
+	0xb2, 0xfe, 0xb6, 0xd4, 0xfa, 0x98, 0x23, 0x33, 0x91, 0x87, 0x9e, 0x30, 0x9b, 0xd2, 0xc4, 0x94,
+	0x16, 0xc6, 0x09, 0xac, 0xf6, 0xb8, 0xd3, 0x0f, 0x87, 0xdc, 0x66, 0x6e, 0x20, 0x5c, 0xea, 0x13,
+	0x02, 0x57, 0x55, 0x4c, 0x1b, 0x2a, 0x6e, 0xea, 0x37, 0xd9, 0x84, 0x6b, 0x43, 0xcb, 0x7e, 0xef,
+	0x51, 0x27, 0x5e, 0x4b, 0xc9, 0x50, 0x96, 0x8d, 0x4d, 0x7d, 0x1e, 0x8e, 0x91, 0x71, 0x15, 0xea,
+	0x79, 0x73, 0xf2, 0xc1, 0xf8, 0xab, 0x01, 0x8b, 0x3d, 0xee, 0xbc, 0xa1, 0x81, 0x6b, 0x97, 0x12,
+	0xf7, 0xa0, 0xc5, 0x33, 0xe2, 0x49, 0x00, 0xee, 0x76, 0xca, 0x3b, 0x54, 0xa7, 0x30, 0x59, 0x33,
+	0x6f, 0x4d, 0xbe, 0x01, 0x60, 0xf8, 0x0e, 0x19, 0xfa, 0x36, 0x46, 0x99, 0x5f, 0x34, 0x33, 0x5f,
+	0x8c, 0x17, 0xb0, 0x2e, 0x73, 0x96, 0x4c, 0x89, 0x5f, 0x3e, 0xfd, 0x7f, 0x36, 0x60, 0xa3, 0x40,
+	0x35, 0xe3, 0x0a, 0x10, 0x8a, 0xa6, 0x7c, 0x05, 0xe4, 0x02, 0xa0, 0xf4, 0xcc, 0x18, 0x6f, 0x0c,
+	0x61, 0xe3, 0x28, 0x64, 0x0e, 0xce, 0xee, 0x13, 0xb9, 0x01, 0xd7, 0x46, 0xec, 0x6c, 0xc0, 0x42,
+	0x5f, 0x65, 0x79, 0xd1, 0x5c, 0x18, 0xb1, 0x33, 0x33, 0xf4, 0x8d, 0x7f, 0x1a, 0x70, 0xbd, 0x28,
+	0x32, 0x8b, 0xb7, 0xb7, 0xa0, 0x15, 0x48, 0xba, 0xd1, 0x20, 0xe3, 0x74, 0xd3, 0x5c, 0x8e, 0x3e,
+	0x46, 0x0a, 0xa4, 0x0f, 0xeb, 0x31, 0x28, 0xbf, 0x42, 0xe6, 0x6b, 0x06, 0xe8, 0xab, 0xc8, 0x3a,
+	0xbb, 0x5c, 0xf8, 0xfe, 0xbf, 0x5b, 0xd0, 0x34, 0x29, 0x15, 0x07, 0x12, 0x4b, 0x02, 0x20, 0xb2,
+	0x21, 0xd1, 0x71, 0x40, 0x7d, 0xf4, 0x85, 0x9c, 0x25, 0x72, 0xf2, 0x30, 0x4f, 0x9d, 0xee, 0x7b,
+	0xd3, 0xd0, 0x38, 0xd4, 0xed, 0x3b, 0x1a, 0x8b, 0x02, 0xdc, 0xb8, 0x42, 0xc6, 0x4a, 0x51, 0x6e,
+	0x59, 0x6f, 0x5c, 0xfb, 0xfd, 0xc1, 0xa9, 0xe5, 0xfb, 0xe8, 0x55, 0x29, 0x16, 0xa0, 0x89, 0xe2,
+	0xad, 0xd2, 0x1a, 0xef, 0x0b, 0xe6, 0xfa, 0x4e, 0x92, 0x1b, 0xe3, 0x0a, 0xf9, 0xa0, 0x1a, 0xbb,
+	0x54, 0x77, 0xb9, 0x70, 0x6d, 0x9e, 0x08, 0xee, 0xeb, 0x05, 0xa7, 0xc0, 0x17, 0x94, 0x1c, 0xc0,
+	0xda, 0x01, 0x43, 0x4b, 0xe0, 0x01, 0xf5, 0x3c, 0xb4, 0x55, 0x4b, 0x79, 0x50, 0x6a, 0x5a, 0x84,
+	0x25, 0x42, 0x55, 0x4b, 0xc8, 0xb8, 0x42, 0x7e, 0x85, 0x95, 0x2e, 0xa3, 0x41, 0x86, 0xfe, 0x5e,
+	0x29, 0x7d, 0x1e, 0x54, 0x93, 0x7c, 0x00, 0xad, 0x17, 0x16, 0xcf, 0x70, 0xef, 0x94, 0x72, 0xe7,
+	0x30, 0x09, 0xf5, 0x77, 0xa5, 0xd0, 0xa7, 0x94, 0x7a, 0x99, 0xf0, 0x7c, 0x02, 0xd2, 0x45, 0xb9,
+	0x20, 0x87, 0xd9, 0x00, 0x75, 0xca, 0x3d, 0x98, 0x02, 0x26, 0x52, 0x7b, 0xb5, 0xf1, 0xa9, 0xf0,
+	0x31, 0x2c, 0x45, 0x01, 0x7f, 0xe2, 0xb9, 0x16, 0x27, 0x77, 0x2b, 0x52, 0xa2, 0x10, 0x35, 0x03,
+	0xf6, 0x1a, 0x9a, 0x32, 0xd0, 0x11, 0xe9, 0x6d, 0x6d, 0x22, 0x2e, 0x42, 0xd9, 0x07, 0x78, 0xe2,
+	0x09, 0x64, 0x11, 0xe7, 0x9d, 0x52, 0xce, 0x09, 0xa0, 0x26, 0xa9, 0x0f, 0xab, 0xfd, 0x53, 0xfa,
+	0x69, 0x12, 0x1a, 0x4e, 0xee, 0x97, 0x2f, 0xe8, 0x3c, 0x2a, 0xa1, 0x7f, 0x50, 0x0f, 0x9c, 0x86,
+	0xfb, 0x04, 0x56, 0xa3, 0x60, 0x1e, 0x59, 0x4c, 0xb8, 0x2a, 0xc9, 0xf7, 0x2b, 0x42, 0x9e, 0xa2,
+	0x6a, 0xba, 0xf3, 0x33, 0xb4, 0x64, 0x58, 0x27, 0xe4, 0x3b, 0xda, 0xd0, 0x5f, 0x94, 0xfa, 0x04,
+	0x96, 0x5f, 0x58, 0x7c, 0xc2, 0xbc, 0xad, 0xab, 0x80, 0x29, 0xe2, 0x5a, 0x05, 0xf0, 0x1e, 0x56,
+	0x64, 0xd4, 0x52, 0x63, 0xae, 0x29, 0xdf, 0x3c, 0x28, 0x91, 0xb8, 0x5f, 0x0b, 0x9b, 0x8a, 0xf9,
+	0xb0, 0x9a, 0x14, 0x45, 0x1f, 0x9d, 0x31, 0xfa, 0x42, 0x93, 0x85, 0x02, 0xaa, 0x3a, 0xeb, 0x53,
+	0xe0, 0x54, 0x0f, 0x61, 0x59, 0xce, 0x25, 0xfe, 0x83, 0x6b, 0x62, 0x97, 0x85, 0x24, 0x4a, 0x3b,
+	0x35, 0x90, 0xd3, 0xb5, 0x7c, 0xe8, 0x8f, 0xf0, 0x73, 0x65, 0x2d, 0x2b, 0x44, 0xcd, 0xcc, 0x9f,
+	0x42, 0x2b, 0x71, 0x2d, 0x22, 0xde, 0xa9, 0x74, 0x3f, 0x47, 0x7d, 0xaf, 0x0e, 0x34, 0x75, 0x20,
+	0xee, 0x1a, 0x91, 0x8a, 0xbe, 0x6b, 0x5c, 0x64, 0xf2, 0x49, 0xd7, 0x88, 0x38, 0x2b, 0xba, 0xc6,
+	0x45, 0x48, 0x3f, 0xc4, 0x17, 0xbc, 0xf4, 0x8e, 0x49, 0x76, 0x75, 0xe7, 0x8e, 0xd2, 0xdb, 0x6e,
+	0xbb, 0x53, 0x17, 0x9e, 0x86, 0xe6, 0x37, 0xb8, 0x16, 0xdf, 0xfc, 0xc8, 0x9d, 0x4a, 0xe3, 0xf4,
+	0xd2, 0xd9, 0xbe, 0x7b, 0x2e, 0x2e, 0x65, 0xb7, 0x60, 0xe3, 0x38, 0x18, 0xc9, 0x6d, 0x37, 0xda,
+	0xdc, 0x93, 0xe3, 0x05, 0xd9, 0xd1, 0x9c, 0x08, 0x0a, 0xb8, 0x1e, 0x77, 0xce, 0x8b, 0x99, 0x07,
+	0x37, 0x4c, 0xf4, 0xd0, 0xe2, 0xd8, 0x7d, 0xfd, 0xb2, 0x87, 0x9c, 0x5b, 0x0e, 0xf6, 0x05, 0x43,
+	0x6b, 0x5c, 0x3c, 0x76, 0x44, 0x2f, 0x08, 0x1a, 0x70, 0xcd, 0x0c, 0xd9, 0xb0, 0x11, 0x17, 0xc8,
+	0x4f, 0x5e, 0xc8, 0x4f, 0xe5, 0x89, 0xcb, 0x43, 0x81, 0xa3, 0x62, 0x9d, 0xcb, 0x07, 0x8a, 0x4e,
+	0x29, 0xb2, 0x86, 0x4b, 0x03, 0x80, 0xe7, 0x28, 0x7a, 0x28, 0x98, 0x3c, 0x98, 0x96, 0xaf, 0xad,
+	0x09, 0x40, 0x93, 0x96, 0x12, 0x5c, 0x9a, 0x96, 0xb7, 0xe9, 0xa1, 0x29, 0xbd, 0x1c, 0x93, 0xdb,
+	0xba, 0x8c, 0xa4, 0x90, 0x43, 0xff, 0x1d, 0x3d, 0x6f, 0xea, 0x6f, 0x61, 0x2d, 0x4e, 0xf8, 0x97,
+	0x66, 0x1e, 0xc0, 0x5a, 0x17, 0x65, 0x04, 0x33, 0xcc, 0xba, 0x7e, 0x99, 0x87, 0xd5, 0x6f, 0x47,
+	0xf2, 0x86, 0x25, 0xed, 0xe4, 0xe5, 0x95, 0x6b, 0xda, 0x51, 0x0e, 0x53, 0xdd, 0x8e, 0x0a, 0xd0,
+	0xcc, 0x36, 0xd1, 0xca, 0x3d, 0x4c, 0x90, 0x07, 0xba, 0x8a, 0x2a, 0x7b, 0x26, 0x69, 0xef, 0xd6,
+	0x44, 0xa7, 0x7a, 0x7d, 0x80, 0x28, 0xdd, 0xf2, 0x12, 0xae, 0x59, 0x4f, 0x13, 0x40, 0xcd, 0x70,
+	0xbd, 0x82, 0x45, 0xd9, 0x33, 0x15, 0xe5, 0xf7, 0xda, 0x96, 0x7a, 0x01, 0xc2, 0x13, 0x58, 0x7d,
+	0x15, 0x20, 0xb3, 0x04, 0x1e, 0xc7, 0xef, 0x05, 0x9a, 0xcd, 0xb3, 0x80, 0xaa, 0x7d, 0xd4, 0x86,
+	0x3e, 0x7a, 0x68, 0x8b, 0x8a, 0x20, 0x4c, 0x00, 0xd5, 0x45, 0x95, 0xc5, 0x65, 0x6e, 0x22, 0xb1,
+	0x80, 0x9c, 0x58, 0xa5, 0x80, 0x9a, 0x79, 0x0d, 0x81, 0x08, 0x97, 0xbd, 0xea, 0xc4, 0xae, 0x1f,
+	0x31, 0xf7, 0xa3, 0xeb, 0xa1, 0x83, 0x9a, 0x0a, 0x28, 0xc2, 0x6a, 0x86, 0x68, 0x08, 0x4b, 0x91,
+	0xb0, 0x7a, 0xfa, 0x21, 0x55, 0x53, 0x53, 0x88, 0x84, 0x76, 0xfb, 0x7c, 0x60, 0xe6, 0xc8, 0x02,
+	0x93, 0x67, 0x2c, 0xb2, 0xa3, 0x5b, 0xca, 0x53, 0x4f, 0x66, 0xed, 0x7b, 0x75, 0xa0, 0xd9, 0x12,
+	0xcb, 0x3d, 0x97, 0xe8, 0x4b, 0xac, 0xec, 0x81, 0xa6, 0xbd, 0x5b, 0x13, 0x9d, 0xb9, 0xf9, 0xae,
+	0xe4, 0x5f, 0x2c, 0xf4, 0x3b, 0x77, 0xe9, 0xf3, 0x49, 0xbb, 0x53, 0x17, 0x9e, 0x48, 0x3e, 0x7d,
+	0xfc, 0xcb, 0x0f, 0x8e, 0x2b, 0x4e, 0xc3, 0xa1, 0xcc, 0xe3, 0x5e, 0x64, 0xbd, 0xeb, 0xd2, 0xf8,
+	0xd7, 0x5e, 0xd2, 0x66, 0xf7, 0x14, 0xe1, 0x5e, 0x4a, 0x18, 0x0c, 0x87, 0x0b, 0xea, 0xd3, 0xa3,
+	0xff, 0x06, 0x00, 0x78, 0xd9, 0x39, 0x79, 0xaa, 0x17, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -376,6 +936,7 @@ type RootCoordClient interface {
 	CreateIndex(ctx context.Context, in *milvuspb.CreateIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	DescribeIndex(ctx context.Context, in *milvuspb.DescribeIndexRequest, opts ...grpc.CallOption) (*milvuspb.DescribeIndexResponse, error)
 	DropIndex(ctx context.Context, in *milvuspb.DropIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	AlterIndex(ctx context.Context, in *milvuspb.AlterIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	AllocTimestamp(ctx context.Context, in *AllocTimestampRequest, opts ...grpc.CallOption) (*AllocTimestampResponse, error)
 	AllocID(ctx context.Context, in *AllocIDRequest, opts ...grpc.CallOption) (*AllocIDResponse, error)
 	UpdateChannelTimeTick(ctx context.Context, in *internalpb.ChannelTimeTickMsg, opts ...grpc.CallOption) (*commonpb.Status, error)
@@ -383,6 +944,24 @@ type RootCoordClient interface {
 	SegmentFlushCompleted(ctx context.Context, in *datapb.SegmentFlushCompletedMsg, opts ...grpc.CallOption) (*commonpb.Status, error)
 	// https://wiki.lfaidata.foundation/display/MIL/MEP+8+--+Add+metrics+for+proxy
 	GetMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error)
+	CreateCredential(ctx context.Context, in *internalpb.CredentialInfo, opts ...grpc.CallOption) (*commonpb.Status, error)
+	UpdateCredential(ctx context.Context, in *internalpb.CredentialInfo, opts ...grpc.CallOption) (*commonpb.Status, error)
+	DeleteCredential(ctx context.Context, in *milvuspb.DeleteCredentialRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	ListCredUsers(ctx context.Context, in *milvuspb.ListCredUsersRequest, opts ...grpc.CallOption) (*milvuspb.ListCredUsersResponse, error)
+	// used by proxy to fetch the encrypted password of a user
+	GetCredential(ctx context.Context, in *GetCredentialRequest, opts ...grpc.CallOption) (*GetCredentialResponse, error)
+	CreateRole(ctx context.Context, in *milvuspb.CreateRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	DropRole(ctx context.Context, in *milvuspb.DropRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	OperateUserRole(ctx context.Context, in *milvuspb.OperateUserRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	SelectRole(ctx context.Context, in *milvuspb.SelectRoleRequest, opts ...grpc.CallOption) (*milvuspb.SelectRoleResponse, error)
+	SelectUser(ctx context.Context, in *milvuspb.SelectUserRequest, opts ...grpc.CallOption) (*milvuspb.SelectUserResponse, error)
+	OperatePrivilege(ctx context.Context, in *milvuspb.OperatePrivilegeRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	SelectGrant(ctx context.Context, in *milvuspb.SelectGrantRequest, opts ...grpc.CallOption) (*milvuspb.SelectGrantResponse, error)
+	// used by proxy to load all the grants and user-role bindings
+	ListPolicy(ctx context.Context, in *ListPolicyRequest, opts ...grpc.CallOption) (*ListPolicyResponse, error)
+	// admin interfaces of the topics and subscriptions created by milvus in the message queue
+	ListMsgTopics(ctx context.Context, in *ListMsgTopicsRequest, opts ...grpc.CallOption) (*ListMsgTopicsResponse, error)
+	PurgeMsgTopics(ctx context.Context, in *PurgeMsgTopicsRequest, opts ...grpc.CallOption) (*PurgeMsgTopicsResponse, error)
 }
 
 type rootCoordClient struct {
@@ -573,6 +1152,15 @@ func (c *rootCoordClient) DropIndex(ctx context.Context, in *milvuspb.DropIndexR
 	return out, nil
 }
 
+func (c *rootCoordClient) AlterIndex(ctx context.Context, in *milvuspb.AlterIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/AlterIndex", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *rootCoordClient) AllocTimestamp(ctx context.Context, in *AllocTimestampRequest, opts ...grpc.CallOption) (*AllocTimestampResponse, error) {
 	out := new(AllocTimestampResponse)
 	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/AllocTimestamp", in, out, opts...)
@@ -627,6 +1215,141 @@ func (c *rootCoordClient) GetMetrics(ctx context.Context, in *milvuspb.GetMetric
 	return out, nil
 }
 
+func (c *rootCoordClient) CreateCredential(ctx context.Context, in *internalpb.CredentialInfo, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/CreateCredential", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) UpdateCredential(ctx context.Context, in *internalpb.CredentialInfo, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/UpdateCredential", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) DeleteCredential(ctx context.Context, in *milvuspb.DeleteCredentialRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/DeleteCredential", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) ListCredUsers(ctx context.Context, in *milvuspb.ListCredUsersRequest, opts ...grpc.CallOption) (*milvuspb.ListCredUsersResponse, error) {
+	out := new(milvuspb.ListCredUsersResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/ListCredUsers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) GetCredential(ctx context.Context, in *GetCredentialRequest, opts ...grpc.CallOption) (*GetCredentialResponse, error) {
+	out := new(GetCredentialResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/GetCredential", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) CreateRole(ctx context.Context, in *milvuspb.CreateRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/CreateRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) DropRole(ctx context.Context, in *milvuspb.DropRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/DropRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) OperateUserRole(ctx context.Context, in *milvuspb.OperateUserRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/OperateUserRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) SelectRole(ctx context.Context, in *milvuspb.SelectRoleRequest, opts ...grpc.CallOption) (*milvuspb.SelectRoleResponse, error) {
+	out := new(milvuspb.SelectRoleResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/SelectRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) SelectUser(ctx context.Context, in *milvuspb.SelectUserRequest, opts ...grpc.CallOption) (*milvuspb.SelectUserResponse, error) {
+	out := new(milvuspb.SelectUserResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/SelectUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) OperatePrivilege(ctx context.Context, in *milvuspb.OperatePrivilegeRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/OperatePrivilege", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) SelectGrant(ctx context.Context, in *milvuspb.SelectGrantRequest, opts ...grpc.CallOption) (*milvuspb.SelectGrantResponse, error) {
+	out := new(milvuspb.SelectGrantResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/SelectGrant", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) ListPolicy(ctx context.Context, in *ListPolicyRequest, opts ...grpc.CallOption) (*ListPolicyResponse, error) {
+	out := new(ListPolicyResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/ListPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) ListMsgTopics(ctx context.Context, in *ListMsgTopicsRequest, opts ...grpc.CallOption) (*ListMsgTopicsResponse, error) {
+	out := new(ListMsgTopicsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/ListMsgTopics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) PurgeMsgTopics(ctx context.Context, in *PurgeMsgTopicsRequest, opts ...grpc.CallOption) (*PurgeMsgTopicsResponse, error) {
+	out := new(PurgeMsgTopicsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/PurgeMsgTopics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RootCoordServer is the server API for RootCoord service.
 type RootCoordServer interface {
 	GetComponentStates(context.Context, *internalpb.GetComponentStatesRequest) (*internalpb.ComponentStates, error)
@@ -695,6 +1418,7 @@ type RootCoordServer interface {
 	CreateIndex(context.Context, *milvuspb.CreateIndexRequest) (*commonpb.Status, error)
 	DescribeIndex(context.Context, *milvuspb.DescribeIndexRequest) (*milvuspb.DescribeIndexResponse, error)
 	DropIndex(context.Context, *milvuspb.DropIndexRequest) (*commonpb.Status, error)
+	AlterIndex(context.Context, *milvuspb.AlterIndexRequest) (*commonpb.Status, error)
 	AllocTimestamp(context.Context, *AllocTimestampRequest) (*AllocTimestampResponse, error)
 	AllocID(context.Context, *AllocIDRequest) (*AllocIDResponse, error)
 	UpdateChannelTimeTick(context.Context, *internalpb.ChannelTimeTickMsg) (*commonpb.Status, error)
@@ -702,6 +1426,24 @@ type RootCoordServer interface {
 	SegmentFlushCompleted(context.Context, *datapb.SegmentFlushCompletedMsg) (*commonpb.Status, error)
 	// https://wiki.lfaidata.foundation/display/MIL/MEP+8+--+Add+metrics+for+proxy
 	GetMetrics(context.Context, *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error)
+	CreateCredential(context.Context, *internalpb.CredentialInfo) (*commonpb.Status, error)
+	UpdateCredential(context.Context, *internalpb.CredentialInfo) (*commonpb.Status, error)
+	DeleteCredential(context.Context, *milvuspb.DeleteCredentialRequest) (*commonpb.Status, error)
+	ListCredUsers(context.Context, *milvuspb.ListCredUsersRequest) (*milvuspb.ListCredUsersResponse, error)
+	// used by proxy to fetch the encrypted password of a user
+	GetCredential(context.Context, *GetCredentialRequest) (*GetCredentialResponse, error)
+	CreateRole(context.Context, *milvuspb.CreateRoleRequest) (*commonpb.Status, error)
+	DropRole(context.Context, *milvuspb.DropRoleRequest) (*commonpb.Status, error)
+	OperateUserRole(context.Context, *milvuspb.OperateUserRoleRequest) (*commonpb.Status, error)
+	SelectRole(context.Context, *milvuspb.SelectRoleRequest) (*milvuspb.SelectRoleResponse, error)
+	SelectUser(context.Context, *milvuspb.SelectUserRequest) (*milvuspb.SelectUserResponse, error)
+	OperatePrivilege(context.Context, *milvuspb.OperatePrivilegeRequest) (*commonpb.Status, error)
+	SelectGrant(context.Context, *milvuspb.SelectGrantRequest) (*milvuspb.SelectGrantResponse, error)
+	// used by proxy to load all the grants and user-role bindings
+	ListPolicy(context.Context, *ListPolicyRequest) (*ListPolicyResponse, error)
+	// admin interfaces of the topics and subscriptions created by milvus in the message queue
+	ListMsgTopics(context.Context, *ListMsgTopicsRequest) (*ListMsgTopicsResponse, error)
+	PurgeMsgTopics(context.Context, *PurgeMsgTopicsRequest) (*PurgeMsgTopicsResponse, error)
 }
 
 // UnimplementedRootCoordServer can be embedded to have forward compatible implementations.
@@ -768,6 +1510,9 @@ func (*UnimplementedRootCoordServer) DescribeIndex(ctx context.Context, req *mil
 func (*UnimplementedRootCoordServer) DropIndex(ctx context.Context, req *milvuspb.DropIndexRequest) (*commonpb.Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DropIndex not implemented")
 }
+func (*UnimplementedRootCoordServer) AlterIndex(ctx context.Context, req *milvuspb.AlterIndexRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AlterIndex not implemented")
+}
 func (*UnimplementedRootCoordServer) AllocTimestamp(ctx context.Context, req *AllocTimestampRequest) (*AllocTimestampResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AllocTimestamp not implemented")
 }
@@ -786,6 +1531,51 @@ func (*UnimplementedRootCoordServer) SegmentFlushCompleted(ctx context.Context,
 func (*UnimplementedRootCoordServer) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMetrics not implemented")
 }
+func (*UnimplementedRootCoordServer) CreateCredential(ctx context.Context, req *internalpb.CredentialInfo) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCredential not implemented")
+}
+func (*UnimplementedRootCoordServer) UpdateCredential(ctx context.Context, req *internalpb.CredentialInfo) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCredential not implemented")
+}
+func (*UnimplementedRootCoordServer) DeleteCredential(ctx context.Context, req *milvuspb.DeleteCredentialRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCredential not implemented")
+}
+func (*UnimplementedRootCoordServer) ListCredUsers(ctx context.Context, req *milvuspb.ListCredUsersRequest) (*milvuspb.ListCredUsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCredUsers not implemented")
+}
+func (*UnimplementedRootCoordServer) GetCredential(ctx context.Context, req *GetCredentialRequest) (*GetCredentialResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCredential not implemented")
+}
+func (*UnimplementedRootCoordServer) CreateRole(ctx context.Context, req *milvuspb.CreateRoleRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRole not implemented")
+}
+func (*UnimplementedRootCoordServer) DropRole(ctx context.Context, req *milvuspb.DropRoleRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DropRole not implemented")
+}
+func (*UnimplementedRootCoordServer) OperateUserRole(ctx context.Context, req *milvuspb.OperateUserRoleRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OperateUserRole not implemented")
+}
+func (*UnimplementedRootCoordServer) SelectRole(ctx context.Context, req *milvuspb.SelectRoleRequest) (*milvuspb.SelectRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SelectRole not implemented")
+}
+func (*UnimplementedRootCoordServer) SelectUser(ctx context.Context, req *milvuspb.SelectUserRequest) (*milvuspb.SelectUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SelectUser not implemented")
+}
+func (*UnimplementedRootCoordServer) OperatePrivilege(ctx context.Context, req *milvuspb.OperatePrivilegeRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OperatePrivilege not implemented")
+}
+func (*UnimplementedRootCoordServer) SelectGrant(ctx context.Context, req *milvuspb.SelectGrantRequest) (*milvuspb.SelectGrantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SelectGrant not implemented")
+}
+func (*UnimplementedRootCoordServer) ListPolicy(ctx context.Context, req *ListPolicyRequest) (*ListPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPolicy not implemented")
+}
+func (*UnimplementedRootCoordServer) ListMsgTopics(ctx context.Context, req *ListMsgTopicsRequest) (*ListMsgTopicsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMsgTopics not implemented")
+}
+func (*UnimplementedRootCoordServer) PurgeMsgTopics(ctx context.Context, req *PurgeMsgTopicsRequest) (*PurgeMsgTopicsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeMsgTopics not implemented")
+}
 
 func RegisterRootCoordServer(s *grpc.Server, srv RootCoordServer) {
 	s.RegisterService(&_RootCoord_serviceDesc, srv)
@@ -1151,6 +1941,24 @@ func _RootCoord_DropIndex_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RootCoord_AlterIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.AlterIndexRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).AlterIndex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/AlterIndex",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).AlterIndex(ctx, req.(*milvuspb.AlterIndexRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RootCoord_AllocTimestamp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AllocTimestampRequest)
 	if err := dec(in); err != nil {
@@ -1259,6 +2067,276 @@ func _RootCoord_GetMetrics_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RootCoord_CreateCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(internalpb.CredentialInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).CreateCredential(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/CreateCredential",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).CreateCredential(ctx, req.(*internalpb.CredentialInfo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_UpdateCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(internalpb.CredentialInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).UpdateCredential(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/UpdateCredential",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).UpdateCredential(ctx, req.(*internalpb.CredentialInfo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_DeleteCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.DeleteCredentialRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).DeleteCredential(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/DeleteCredential",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).DeleteCredential(ctx, req.(*milvuspb.DeleteCredentialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_ListCredUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.ListCredUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).ListCredUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/ListCredUsers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).ListCredUsers(ctx, req.(*milvuspb.ListCredUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_GetCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCredentialRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).GetCredential(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/GetCredential",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).GetCredential(ctx, req.(*GetCredentialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_CreateRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.CreateRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).CreateRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/CreateRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).CreateRole(ctx, req.(*milvuspb.CreateRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_DropRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.DropRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).DropRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/DropRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).DropRole(ctx, req.(*milvuspb.DropRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_OperateUserRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.OperateUserRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).OperateUserRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/OperateUserRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).OperateUserRole(ctx, req.(*milvuspb.OperateUserRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_SelectRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.SelectRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).SelectRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/SelectRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).SelectRole(ctx, req.(*milvuspb.SelectRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_SelectUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.SelectUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).SelectUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/SelectUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).SelectUser(ctx, req.(*milvuspb.SelectUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_OperatePrivilege_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.OperatePrivilegeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).OperatePrivilege(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/OperatePrivilege",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).OperatePrivilege(ctx, req.(*milvuspb.OperatePrivilegeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_SelectGrant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.SelectGrantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).SelectGrant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/SelectGrant",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).SelectGrant(ctx, req.(*milvuspb.SelectGrantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_ListPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).ListPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/ListPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).ListPolicy(ctx, req.(*ListPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_ListMsgTopics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMsgTopicsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).ListMsgTopics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/ListMsgTopics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).ListMsgTopics(ctx, req.(*ListMsgTopicsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_PurgeMsgTopics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeMsgTopicsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).PurgeMsgTopics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/PurgeMsgTopics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).PurgeMsgTopics(ctx, req.(*PurgeMsgTopicsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _RootCoord_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "milvus.proto.rootcoord.RootCoord",
 	HandlerType: (*RootCoordServer)(nil),
@@ -1343,6 +2421,10 @@ var _RootCoord_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DropIndex",
 			Handler:    _RootCoord_DropIndex_Handler,
 		},
+		{
+			MethodName: "AlterIndex",
+			Handler:    _RootCoord_AlterIndex_Handler,
+		},
 		{
 			MethodName: "AllocTimestamp",
 			Handler:    _RootCoord_AllocTimestamp_Handler,
@@ -1367,6 +2449,66 @@ var _RootCoord_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetMetrics",
 			Handler:    _RootCoord_GetMetrics_Handler,
 		},
+		{
+			MethodName: "CreateCredential",
+			Handler:    _RootCoord_CreateCredential_Handler,
+		},
+		{
+			MethodName: "UpdateCredential",
+			Handler:    _RootCoord_UpdateCredential_Handler,
+		},
+		{
+			MethodName: "DeleteCredential",
+			Handler:    _RootCoord_DeleteCredential_Handler,
+		},
+		{
+			MethodName: "ListCredUsers",
+			Handler:    _RootCoord_ListCredUsers_Handler,
+		},
+		{
+			MethodName: "GetCredential",
+			Handler:    _RootCoord_GetCredential_Handler,
+		},
+		{
+			MethodName: "CreateRole",
+			Handler:    _RootCoord_CreateRole_Handler,
+		},
+		{
+			MethodName: "DropRole",
+			Handler:    _RootCoord_DropRole_Handler,
+		},
+		{
+			MethodName: "OperateUserRole",
+			Handler:    _RootCoord_OperateUserRole_Handler,
+		},
+		{
+			MethodName: "SelectRole",
+			Handler:    _RootCoord_SelectRole_Handler,
+		},
+		{
+			MethodName: "SelectUser",
+			Handler:    _RootCoord_SelectUser_Handler,
+		},
+		{
+			MethodName: "OperatePrivilege",
+			Handler:    _RootCoord_OperatePrivilege_Handler,
+		},
+		{
+			MethodName: "SelectGrant",
+			Handler:    _RootCoord_SelectGrant_Handler,
+		},
+		{
+			MethodName: "ListPolicy",
+			Handler:    _RootCoord_ListPolicy_Handler,
+		},
+		{
+			MethodName: "ListMsgTopics",
+			Handler:    _RootCoord_ListMsgTopics_Handler,
+		},
+		{
+			MethodName: "PurgeMsgTopics",
+			Handler:    _RootCoord_PurgeMsgTopics_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "root_coord.proto",
@@ -0,0 +1,91 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"strings"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/util/crypto"
+	"github.com/milvus-io/milvus/internal/util/tlsutil"
+)
+
+// internalServicePrefix is the method prefix of the Proxy service, which is only called by
+// other milvus components, so the callers are checked by the internal credential instead of username/password
+const internalServicePrefix = "/milvus.proto.proxy.Proxy/"
+
+// validAuth validates the authentication
+func validAuth(ctx context.Context, authorization []string) bool {
+	if len(authorization) < 1 {
+		return false
+	}
+	// token format: base64<username:password>
+	token := authorization[0]
+	rawToken, err := crypto.Base64Decode(token)
+	if err != nil {
+		return false
+	}
+	secrets := strings.SplitN(rawToken, common.CredentialSeperator, 2)
+	if len(secrets) < 2 {
+		return false
+	}
+	username := secrets[0]
+	password := secrets[1]
+
+	return passwordVerify(ctx, username, password, globalMetaCache)
+}
+
+// passwordVerify checks the raw password against the encrypted one cached in proxy,
+// the credential is fetched from rootcoord when it's not cached yet.
+func passwordVerify(ctx context.Context, username, rawPwd string, globalMetaCache Cache) bool {
+	if globalMetaCache == nil {
+		return false
+	}
+	credInfo, err := globalMetaCache.GetCredentialInfo(ctx, username)
+	if err != nil {
+		log.Error("found no credential", zap.String("username", username), zap.Error(err))
+		return false
+	}
+	return crypto.PasswordVerify(rawPwd, credInfo.EncryptedPassword)
+}
+
+// AuthenticationInterceptor verify based on kv pair <"authorization": "token"> in header
+func AuthenticationInterceptor(ctx context.Context) (context.Context, error) {
+	if !Params.AuthorizationEnabled {
+		return ctx, nil
+	}
+	// rpc calls from members (like rootcoord) carry the internal credential instead of username/password
+	if method, ok := grpc.Method(ctx); ok && strings.HasPrefix(method, internalServicePrefix) {
+		if err := tlsutil.VerifyInternalPeer(ctx); err != nil {
+			return nil, status.Error(codes.Unauthenticated, err.Error())
+		}
+		return ctx, nil
+	}
+	// The keys within metadata.MD are normalized to lowercase.
+	// See: https://godoc.org/google.golang.org/grpc/metadata#New
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing metadata")
+	}
+	if !validAuth(ctx, md[strings.ToLower(common.HeaderAuthorize)]) {
+		return nil, status.Error(codes.Unauthenticated, "auth check failure, please check username and password are correct")
+	}
+	return ctx, nil
+}
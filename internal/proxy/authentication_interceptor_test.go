@@ -0,0 +1,123 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/util/crypto"
+	"github.com/milvus-io/milvus/internal/util/tlsutil"
+)
+
+func TestValidAuth(t *testing.T) {
+	ctx := context.Background()
+	// no metadata
+	res := validAuth(ctx, nil)
+	assert.False(t, res)
+	// illegal metadata
+	res = validAuth(ctx, []string{"xxx"})
+	assert.False(t, res)
+	// normal metadata
+	rootCoord := NewRootCoordMock()
+	err := InitMetaCache(rootCoord)
+	assert.Nil(t, err)
+	encryptedPassword, err := crypto.PasswordEncrypt("123456")
+	assert.Nil(t, err)
+	globalMetaCache.UpdateCredential(&internalpb.CredentialInfo{
+		Username:          "mockUser",
+		EncryptedPassword: encryptedPassword,
+	})
+	res = validAuth(ctx, []string{crypto.Base64Encode("mockUser:123456")})
+	assert.True(t, res)
+	res = validAuth(ctx, []string{crypto.Base64Encode("mockUser:654321")})
+	assert.False(t, res)
+	res = validAuth(ctx, []string{crypto.Base64Encode("unknownUser:123456")})
+	assert.False(t, res)
+}
+
+func TestAuthenticationInterceptor(t *testing.T) {
+	ctx := context.Background()
+	Params.AuthorizationEnabled = true
+	defer func() {
+		Params.AuthorizationEnabled = false
+	}()
+	// no metadata
+	_, err := AuthenticationInterceptor(ctx)
+	assert.NotNil(t, err)
+	// mock metacache
+	rootCoord := NewRootCoordMock()
+	err = InitMetaCache(rootCoord)
+	assert.Nil(t, err)
+	encryptedPassword, err := crypto.PasswordEncrypt("123456")
+	assert.Nil(t, err)
+	_, err = rootCoord.CreateCredential(ctx, &internalpb.CredentialInfo{
+		Username:          "mockUser",
+		EncryptedPassword: encryptedPassword,
+	})
+	assert.Nil(t, err)
+	// with invalid metadata
+	md := metadata.Pairs("xxx", "yyy")
+	ctx = metadata.NewIncomingContext(ctx, md)
+	_, err = AuthenticationInterceptor(ctx)
+	assert.NotNil(t, err)
+	// with valid username/password, credential is fetched from rootcoord
+	md = metadata.Pairs(common.HeaderAuthorize, crypto.Base64Encode("mockUser:123456"))
+	ctx = metadata.NewIncomingContext(ctx, md)
+	_, err = AuthenticationInterceptor(ctx)
+	assert.Nil(t, err)
+	// with wrong password
+	md = metadata.Pairs(common.HeaderAuthorize, crypto.Base64Encode("mockUser:654321"))
+	ctx = metadata.NewIncomingContext(ctx, md)
+	_, err = AuthenticationInterceptor(ctx)
+	assert.NotNil(t, err)
+
+	// internal calls without internal credential
+	internalCtx := grpc.NewContextWithServerTransportStream(context.Background(), &mockServerTransportStream{method: internalServicePrefix + "InvalidateCollectionMetaCache"})
+	_, err = AuthenticationInterceptor(internalCtx)
+	assert.NotNil(t, err)
+	// username/password doesn't pass the internal calls
+	_, err = AuthenticationInterceptor(metadata.NewIncomingContext(internalCtx, md))
+	assert.NotNil(t, err)
+	// internal calls with internal token
+	tlsutil.Params.Init()
+	tlsutil.Params.InternalToken = "token"
+	defer func() {
+		tlsutil.Params.InternalToken = ""
+	}()
+	_, err = AuthenticationInterceptor(metadata.NewIncomingContext(internalCtx, metadata.Pairs(tlsutil.HeaderInternalToken, "wrong")))
+	assert.NotNil(t, err)
+	_, err = AuthenticationInterceptor(metadata.NewIncomingContext(internalCtx, metadata.Pairs(tlsutil.HeaderInternalToken, "token")))
+	assert.Nil(t, err)
+
+	// authorization disabled
+	Params.AuthorizationEnabled = false
+	_, err = AuthenticationInterceptor(context.Background())
+	assert.Nil(t, err)
+	_, err = AuthenticationInterceptor(internalCtx)
+	assert.Nil(t, err)
+}
+
+type mockServerTransportStream struct {
+	grpc.ServerTransportStream
+	method string
+}
+
+func (s *mockServerTransportStream) Method() string {
+	return s.method
+}
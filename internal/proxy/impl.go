@@ -26,6 +26,7 @@ import (
 
 	"go.uber.org/zap"
 
+	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/msgstream"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
@@ -35,6 +36,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/proxypb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/crypto"
 	"github.com/milvus-io/milvus/internal/util/distance"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
@@ -87,6 +89,9 @@ func (node *Proxy) InvalidateCollectionMetaCache(ctx context.Context, request *p
 	if globalMetaCache != nil {
 		globalMetaCache.RemoveCollection(ctx, collectionName) // no need to return error, though collection may be not cached
 	}
+	if node.rateLimiter != nil {
+		node.rateLimiter.removeCollection(collectionName)
+	}
 	log.Debug("InvalidateCollectionMetaCache Done",
 		zap.String("role", Params.RoleName),
 		zap.String("db", request.DbName),
@@ -125,6 +130,9 @@ func (node *Proxy) CreateCollection(ctx context.Context, request *milvuspb.Creat
 	if !node.checkHealthy() {
 		return unhealthyStatus(), nil
 	}
+	if status := node.checkRateLimit("", rateTypeDDLOps, 1); status != nil {
+		return status, nil
+	}
 	cct := &createCollectionTask{
 		ctx:                     ctx,
 		Condition:               NewTaskCondition(ctx),
@@ -177,6 +185,9 @@ func (node *Proxy) DropCollection(ctx context.Context, request *milvuspb.DropCol
 	if !node.checkHealthy() {
 		return unhealthyStatus(), nil
 	}
+	if status := node.checkRateLimit("", rateTypeDDLOps, 1); status != nil {
+		return status, nil
+	}
 	dct := &dropCollectionTask{
 		ctx:                   ctx,
 		Condition:             NewTaskCondition(ctx),
@@ -285,6 +296,9 @@ func (node *Proxy) LoadCollection(ctx context.Context, request *milvuspb.LoadCol
 	if !node.checkHealthy() {
 		return unhealthyStatus(), nil
 	}
+	if status := node.checkRateLimit("", rateTypeDDLOps, 1); status != nil {
+		return status, nil
+	}
 	lct := &loadCollectionTask{
 		ctx:                   ctx,
 		Condition:             NewTaskCondition(ctx),
@@ -335,6 +349,9 @@ func (node *Proxy) ReleaseCollection(ctx context.Context, request *milvuspb.Rele
 	if !node.checkHealthy() {
 		return unhealthyStatus(), nil
 	}
+	if status := node.checkRateLimit("", rateTypeDDLOps, 1); status != nil {
+		return status, nil
+	}
 	rct := &releaseCollectionTask{
 		ctx:                      ctx,
 		Condition:                NewTaskCondition(ctx),
@@ -551,6 +568,9 @@ func (node *Proxy) CreatePartition(ctx context.Context, request *milvuspb.Create
 	if !node.checkHealthy() {
 		return unhealthyStatus(), nil
 	}
+	if status := node.checkRateLimit("", rateTypeDDLOps, 1); status != nil {
+		return status, nil
+	}
 	cpt := &createPartitionTask{
 		ctx:                    ctx,
 		Condition:              NewTaskCondition(ctx),
@@ -604,6 +624,9 @@ func (node *Proxy) DropPartition(ctx context.Context, request *milvuspb.DropPart
 	if !node.checkHealthy() {
 		return unhealthyStatus(), nil
 	}
+	if status := node.checkRateLimit("", rateTypeDDLOps, 1); status != nil {
+		return status, nil
+	}
 	dpt := &dropPartitionTask{
 		ctx:                  ctx,
 		Condition:            NewTaskCondition(ctx),
@@ -718,6 +741,9 @@ func (node *Proxy) LoadPartitions(ctx context.Context, request *milvuspb.LoadPar
 	if !node.checkHealthy() {
 		return unhealthyStatus(), nil
 	}
+	if status := node.checkRateLimit("", rateTypeDDLOps, 1); status != nil {
+		return status, nil
+	}
 	lpt := &loadPartitionsTask{
 		ctx:                   ctx,
 		Condition:             NewTaskCondition(ctx),
@@ -771,6 +797,9 @@ func (node *Proxy) ReleasePartitions(ctx context.Context, request *milvuspb.Rele
 	if !node.checkHealthy() {
 		return unhealthyStatus(), nil
 	}
+	if status := node.checkRateLimit("", rateTypeDDLOps, 1); status != nil {
+		return status, nil
+	}
 	rpt := &releasePartitionsTask{
 		ctx:                      ctx,
 		Condition:                NewTaskCondition(ctx),
@@ -936,11 +965,15 @@ func (node *Proxy) CreateIndex(ctx context.Context, request *milvuspb.CreateInde
 	if !node.checkHealthy() {
 		return unhealthyStatus(), nil
 	}
+	if status := node.checkRateLimit("", rateTypeDDLOps, 1); status != nil {
+		return status, nil
+	}
 	cit := &createIndexTask{
 		ctx:                ctx,
 		Condition:          NewTaskCondition(ctx),
 		CreateIndexRequest: request,
 		rootCoord:          node.rootCoord,
+		dataCoord:          node.dataCoord,
 	}
 
 	log.Debug("CreateIndex enqueue",
@@ -1058,6 +1091,9 @@ func (node *Proxy) DropIndex(ctx context.Context, request *milvuspb.DropIndexReq
 	if !node.checkHealthy() {
 		return unhealthyStatus(), nil
 	}
+	if status := node.checkRateLimit("", rateTypeDDLOps, 1); status != nil {
+		return status, nil
+	}
 	dit := &dropIndexTask{
 		ctx:              ctx,
 		Condition:        NewTaskCondition(ctx),
@@ -1110,6 +1146,67 @@ func (node *Proxy) DropIndex(ctx context.Context, request *milvuspb.DropIndexReq
 	return dit.result, nil
 }
 
+// AlterIndex rebuilds the index of the field with new index params, the index keeps serving searches until
+// query nodes switch to the rebuilt index.
+func (node *Proxy) AlterIndex(ctx context.Context, request *milvuspb.AlterIndexRequest) (*commonpb.Status, error) {
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+	if status := node.checkRateLimit("", rateTypeDDLOps, 1); status != nil {
+		return status, nil
+	}
+	ait := &alterIndexTask{
+		ctx:               ctx,
+		Condition:         NewTaskCondition(ctx),
+		AlterIndexRequest: request,
+		rootCoord:         node.rootCoord,
+		dataCoord:         node.dataCoord,
+	}
+
+	log.Debug("AlterIndex enqueue",
+		zap.String("role", Params.RoleName),
+		zap.String("db", request.DbName),
+		zap.String("collection", request.CollectionName),
+		zap.String("field", request.FieldName),
+		zap.Any("extra_params", request.ExtraParams))
+	err := node.sched.ddQueue.Enqueue(ait)
+	if err != nil {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+
+	log.Debug("AlterIndex",
+		zap.String("role", Params.RoleName),
+		zap.Int64("msgID", request.Base.MsgID),
+		zap.Uint64("timestamp", request.Base.Timestamp),
+		zap.String("db", request.DbName),
+		zap.String("collection", request.CollectionName),
+		zap.String("field", request.FieldName),
+		zap.Any("extra_params", request.ExtraParams))
+	defer func() {
+		log.Debug("AlterIndex Done",
+			zap.Error(err),
+			zap.String("role", Params.RoleName),
+			zap.Int64("msgID", request.Base.MsgID),
+			zap.Uint64("timestamp", request.Base.Timestamp),
+			zap.String("db", request.DbName),
+			zap.String("collection", request.CollectionName),
+			zap.String("field", request.FieldName),
+			zap.Any("extra_params", request.ExtraParams))
+	}()
+
+	err = ait.WaitToFinish()
+	if err != nil {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+	return ait.result, nil
+}
+
 // GetIndexBuildProgress gets index build progress with filed_name and index_name.
 // IndexRows is the num of indexed rows. And TotalRows is the total number of segment rows.
 func (node *Proxy) GetIndexBuildProgress(ctx context.Context, request *milvuspb.GetIndexBuildProgressRequest) (*milvuspb.GetIndexBuildProgressResponse, error) {
@@ -1178,6 +1275,150 @@ func (node *Proxy) GetIndexBuildProgress(ctx context.Context, request *milvuspb.
 	return gibpt.result, nil
 }
 
+// GetIndexStatistics gets the type, params, file size, build time and IndexNode of the index on every segment.
+func (node *Proxy) GetIndexStatistics(ctx context.Context, request *milvuspb.GetIndexStatisticsRequest) (*milvuspb.GetIndexStatisticsResponse, error) {
+	if !node.checkHealthy() {
+		return &milvuspb.GetIndexStatisticsResponse{
+			Status: unhealthyStatus(),
+		}, nil
+	}
+	if status := node.checkRateLimit("", rateTypeDDLOps, 1); status != nil {
+		return &milvuspb.GetIndexStatisticsResponse{
+			Status: status,
+		}, nil
+	}
+	gist := &getIndexStatisticsTask{
+		ctx:                       ctx,
+		Condition:                 NewTaskCondition(ctx),
+		GetIndexStatisticsRequest: request,
+		indexCoord:                node.indexCoord,
+		rootCoord:                 node.rootCoord,
+	}
+
+	log.Debug("GetIndexStatistics enqueue",
+		zap.String("role", Params.RoleName),
+		zap.String("db", request.DbName),
+		zap.String("collection", request.CollectionName),
+		zap.String("field", request.FieldName),
+		zap.String("index name", request.IndexName))
+	err := node.sched.ddQueue.Enqueue(gist)
+	if err != nil {
+		return &milvuspb.GetIndexStatisticsResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+
+	log.Debug("GetIndexStatistics",
+		zap.String("role", Params.RoleName),
+		zap.Int64("msgID", request.Base.MsgID),
+		zap.Uint64("timestamp", request.Base.Timestamp),
+		zap.String("db", request.DbName),
+		zap.String("collection", request.CollectionName),
+		zap.String("field", request.FieldName),
+		zap.String("index name", request.IndexName))
+	defer func() {
+		log.Debug("GetIndexStatistics Done",
+			zap.Error(err),
+			zap.String("role", Params.RoleName),
+			zap.Int64("msgID", request.Base.MsgID),
+			zap.Uint64("timestamp", request.Base.Timestamp),
+			zap.String("db", request.DbName),
+			zap.String("collection", request.CollectionName),
+			zap.String("field", request.FieldName),
+			zap.String("index name", request.IndexName))
+	}()
+
+	err = gist.WaitToFinish()
+	if err != nil {
+		return &milvuspb.GetIndexStatisticsResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+
+	return gist.result, nil
+}
+
+// EstimateIndexRecall estimates the recall@topk of the index by comparing the search results of vectors sampled
+// from the segments with the exact results of brute force search.
+func (node *Proxy) EstimateIndexRecall(ctx context.Context, request *milvuspb.EstimateIndexRecallRequest) (*milvuspb.EstimateIndexRecallResponse, error) {
+	if !node.checkHealthy() {
+		return &milvuspb.EstimateIndexRecallResponse{
+			Status: unhealthyStatus(),
+		}, nil
+	}
+	if status := node.checkRateLimit(request.CollectionName, rateTypeDQLRequests, 1); status != nil {
+		return &milvuspb.EstimateIndexRecallResponse{
+			Status: status,
+		}, nil
+	}
+	eirt := &estimateIndexRecallTask{
+		ctx:                        ctx,
+		Condition:                  NewTaskCondition(ctx),
+		EstimateIndexRecallRequest: request,
+		indexCoord:                 node.indexCoord,
+		rootCoord:                  node.rootCoord,
+	}
+
+	log.Debug("EstimateIndexRecall enqueue",
+		zap.String("role", Params.RoleName),
+		zap.String("db", request.DbName),
+		zap.String("collection", request.CollectionName),
+		zap.String("field", request.FieldName),
+		zap.String("index name", request.IndexName),
+		zap.Int64("nq", request.Nq),
+		zap.Int64("topk", request.Topk))
+	err := node.sched.dqQueue.Enqueue(eirt)
+	if err != nil {
+		return &milvuspb.EstimateIndexRecallResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+
+	log.Debug("EstimateIndexRecall",
+		zap.String("role", Params.RoleName),
+		zap.Int64("msgID", request.Base.MsgID),
+		zap.Uint64("timestamp", request.Base.Timestamp),
+		zap.String("db", request.DbName),
+		zap.String("collection", request.CollectionName),
+		zap.String("field", request.FieldName),
+		zap.String("index name", request.IndexName))
+	defer func() {
+		log.Debug("EstimateIndexRecall Done",
+			zap.Error(err),
+			zap.String("role", Params.RoleName),
+			zap.Int64("msgID", request.Base.MsgID),
+			zap.Uint64("timestamp", request.Base.Timestamp),
+			zap.String("db", request.DbName),
+			zap.String("collection", request.CollectionName),
+			zap.String("field", request.FieldName),
+			zap.String("index name", request.IndexName))
+	}()
+
+	err = eirt.WaitToFinish()
+	if err != nil {
+		return &milvuspb.EstimateIndexRecallResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+	log.Debug("EstimateIndexRecall result",
+		zap.String("collection", request.CollectionName),
+		zap.Float32("recall", eirt.result.Recall))
+
+	return eirt.result, nil
+}
+
 func (node *Proxy) GetIndexState(ctx context.Context, request *milvuspb.GetIndexStateRequest) (*milvuspb.GetIndexStateResponse, error) {
 	if !node.checkHealthy() {
 		return &milvuspb.GetIndexStateResponse{
@@ -1247,6 +1488,11 @@ func (node *Proxy) Insert(ctx context.Context, request *milvuspb.InsertRequest)
 			Status: unhealthyStatus(),
 		}, nil
 	}
+	if status := node.checkRateLimit(request.CollectionName, rateTypeDMLRows, int(request.NumRows)); status != nil {
+		return &milvuspb.MutationResult{
+			Status: status,
+		}, nil
+	}
 	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-Insert")
 	defer sp.Finish()
 	it := &insertTask{
@@ -1358,6 +1604,12 @@ func (node *Proxy) Delete(ctx context.Context, request *milvuspb.DeleteRequest)
 			Status: unhealthyStatus(),
 		}, nil
 	}
+	// the number of deleted rows is unknown before the expression is evaluated, a delete takes one token
+	if status := node.checkRateLimit(request.CollectionName, rateTypeDMLRows, 1); status != nil {
+		return &milvuspb.MutationResult{
+			Status: status,
+		}, nil
+	}
 
 	dt := &deleteTask{
 		ctx:           ctx,
@@ -1420,6 +1672,11 @@ func (node *Proxy) Search(ctx context.Context, request *milvuspb.SearchRequest)
 			Status: unhealthyStatus(),
 		}, nil
 	}
+	if status := node.checkRateLimit(request.CollectionName, rateTypeDQLRequests, 1); status != nil {
+		return &milvuspb.SearchResults{
+			Status: status,
+		}, nil
+	}
 	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-Search")
 	defer sp.Finish()
 	qt := &searchTask{
@@ -1435,6 +1692,7 @@ func (node *Proxy) Search(ctx context.Context, request *milvuspb.SearchRequest)
 		resultBuf: make(chan []*internalpb.SearchResults),
 		query:     request,
 		chMgr:     node.chMgr,
+		rootCoord: node.rootCoord,
 		qc:        node.queryCoord,
 	}
 
@@ -1564,6 +1822,11 @@ func (node *Proxy) Query(ctx context.Context, request *milvuspb.QueryRequest) (*
 			Status: unhealthyStatus(),
 		}, nil
 	}
+	if status := node.checkRateLimit(request.CollectionName, rateTypeDQLRequests, 1); status != nil {
+		return &milvuspb.QueryResults{
+			Status: status,
+		}, nil
+	}
 
 	queryRequest := &milvuspb.QueryRequest{
 		DbName:         request.DbName,
@@ -1642,6 +1905,9 @@ func (node *Proxy) CreateAlias(ctx context.Context, request *milvuspb.CreateAlia
 	if !node.checkHealthy() {
 		return unhealthyStatus(), nil
 	}
+	if status := node.checkRateLimit("", rateTypeDDLOps, 1); status != nil {
+		return status, nil
+	}
 	cat := &CreateAliasTask{
 		ctx:                ctx,
 		Condition:          NewTaskCondition(ctx),
@@ -1688,6 +1954,9 @@ func (node *Proxy) DropAlias(ctx context.Context, request *milvuspb.DropAliasReq
 	if !node.checkHealthy() {
 		return unhealthyStatus(), nil
 	}
+	if status := node.checkRateLimit("", rateTypeDDLOps, 1); status != nil {
+		return status, nil
+	}
 	dat := &DropAliasTask{
 		ctx:              ctx,
 		Condition:        NewTaskCondition(ctx),
@@ -1732,6 +2001,9 @@ func (node *Proxy) AlterAlias(ctx context.Context, request *milvuspb.AlterAliasR
 	if !node.checkHealthy() {
 		return unhealthyStatus(), nil
 	}
+	if status := node.checkRateLimit("", rateTypeDDLOps, 1); status != nil {
+		return status, nil
+	}
 	aat := &AlterAliasTask{
 		ctx:               ctx,
 		Condition:         NewTaskCondition(ctx),
@@ -2381,6 +2653,419 @@ func (node *Proxy) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsReque
 	}, nil
 }
 
+// InvalidateCredentialCache clears the cached credential of the specific user
+func (node *Proxy) InvalidateCredentialCache(ctx context.Context, request *proxypb.InvalidateCredCacheRequest) (*commonpb.Status, error) {
+	log.Debug("InvalidateCredentialCache",
+		zap.String("role", Params.RoleName),
+		zap.String("username", request.Username))
+
+	username := request.Username
+	if globalMetaCache != nil {
+		globalMetaCache.RemoveCredential(username) // no need to return error, though credential may be not cached
+	}
+	log.Debug("InvalidateCredentialCache Done",
+		zap.String("role", Params.RoleName),
+		zap.String("username", request.Username))
+
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
+// CreateCredential creates a new user, the password in request is base64 encoded
+func (node *Proxy) CreateCredential(ctx context.Context, req *milvuspb.CreateCredentialRequest) (*commonpb.Status, error) {
+	log.Debug("CreateCredential", zap.String("role", Params.RoleName), zap.String("username", req.Username))
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+	// validate params
+	username := req.Username
+	if err := ValidateUsername(username); err != nil {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_IllegalArgument,
+			Reason:    err.Error(),
+		}, nil
+	}
+	rawPassword, err := crypto.Base64Decode(req.Password)
+	if err != nil {
+		log.Error("decode password fail", zap.String("username", req.Username), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_CreateCredentialFailure,
+			Reason:    "decode password fail key:" + req.Username,
+		}, nil
+	}
+	if err = ValidatePassword(rawPassword); err != nil {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_IllegalArgument,
+			Reason:    err.Error(),
+		}, nil
+	}
+	encryptedPassword, err := crypto.PasswordEncrypt(rawPassword)
+	if err != nil {
+		log.Error("encrypt password fail", zap.String("username", req.Username), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_CreateCredentialFailure,
+			Reason:    "encrypt password fail key:" + req.Username,
+		}, nil
+	}
+	credInfo := &internalpb.CredentialInfo{
+		Username:          req.Username,
+		EncryptedPassword: encryptedPassword,
+	}
+	result, err := node.rootCoord.CreateCredential(ctx, credInfo)
+	if err != nil { // for error like conntext timeout etc.
+		log.Error("create credential fail", zap.String("username", req.Username), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+	return result, err
+}
+
+// UpdateCredential updates the password of a user, the old password must be verified first
+func (node *Proxy) UpdateCredential(ctx context.Context, req *milvuspb.UpdateCredentialRequest) (*commonpb.Status, error) {
+	log.Debug("UpdateCredential", zap.String("role", Params.RoleName), zap.String("username", req.Username))
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+	rawOldPassword, err := crypto.Base64Decode(req.OldPassword)
+	if err != nil {
+		log.Error("decode old password fail", zap.String("username", req.Username), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UpdateCredentialFailure,
+			Reason:    "decode old password fail when updating:" + req.Username,
+		}, nil
+	}
+	rawNewPassword, err := crypto.Base64Decode(req.NewPassword)
+	if err != nil {
+		log.Error("decode password fail", zap.String("username", req.Username), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UpdateCredentialFailure,
+			Reason:    "decode password fail when updating:" + req.Username,
+		}, nil
+	}
+	// valid new password
+	if err = ValidatePassword(rawNewPassword); err != nil {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_IllegalArgument,
+			Reason:    err.Error(),
+		}, nil
+	}
+	if !passwordVerify(ctx, req.Username, rawOldPassword, globalMetaCache) {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UpdateCredentialFailure,
+			Reason:    "old password is not correct:" + req.Username,
+		}, nil
+	}
+	// update meta data
+	encryptedPassword, err := crypto.PasswordEncrypt(rawNewPassword)
+	if err != nil {
+		log.Error("encrypt password fail", zap.String("username", req.Username), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UpdateCredentialFailure,
+			Reason:    "encrypt password fail when updating:" + req.Username,
+		}, nil
+	}
+	credInfo := &internalpb.CredentialInfo{
+		Username:          req.Username,
+		EncryptedPassword: encryptedPassword,
+	}
+	result, err := node.rootCoord.UpdateCredential(ctx, credInfo)
+	if err != nil { // for error like conntext timeout etc.
+		log.Error("update credential fail", zap.String("username", req.Username), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+	return result, err
+}
+
+// DeleteCredential deletes a user, the builtin root user can not be deleted
+func (node *Proxy) DeleteCredential(ctx context.Context, req *milvuspb.DeleteCredentialRequest) (*commonpb.Status, error) {
+	log.Debug("DeleteCredential", zap.String("role", Params.RoleName), zap.String("username", req.Username))
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+	if req.Username == common.DefaultRootUsername {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_DeleteCredentialFailure,
+			Reason:    "user root cannot be deleted",
+		}, nil
+	}
+	result, err := node.rootCoord.DeleteCredential(ctx, req)
+	if err != nil { // for error like conntext timeout etc.
+		log.Error("delete credential fail", zap.String("username", req.Username), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+	return result, err
+}
+
+// ListCredUsers lists the names of all users
+func (node *Proxy) ListCredUsers(ctx context.Context, req *milvuspb.ListCredUsersRequest) (*milvuspb.ListCredUsersResponse, error) {
+	log.Debug("ListCredUsers", zap.String("role", Params.RoleName))
+	if !node.checkHealthy() {
+		return &milvuspb.ListCredUsersResponse{Status: unhealthyStatus()}, nil
+	}
+	resp, err := node.rootCoord.ListCredUsers(ctx, &milvuspb.ListCredUsersRequest{
+		Base: &commonpb.MsgBase{
+			MsgType: commonpb.MsgType_ListCredUsernames,
+		},
+	})
+	if err != nil {
+		return &milvuspb.ListCredUsersResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+	return resp, nil
+}
+
+// InvalidatePolicyInfoCache clears the cached grants and user-role bindings
+func (node *Proxy) InvalidatePolicyInfoCache(ctx context.Context, request *proxypb.InvalidatePolicyInfoCacheRequest) (*commonpb.Status, error) {
+	log.Debug("InvalidatePolicyInfoCache",
+		zap.String("role", Params.RoleName),
+		zap.String("msgType", request.GetBase().GetMsgType().String()))
+
+	if globalMetaCache != nil {
+		globalMetaCache.RefreshPolicyInfo()
+	}
+
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
+// SetQuotaFactors throttles the dml and dql requests by the factors computed by the quota center
+func (node *Proxy) SetQuotaFactors(ctx context.Context, request *proxypb.SetQuotaFactorsRequest) (*commonpb.Status, error) {
+	log.Debug("SetQuotaFactors",
+		zap.String("role", Params.RoleName),
+		zap.Float64("writeFactor", request.WriteFactor),
+		zap.Float64("readFactor", request.ReadFactor),
+		zap.String("writeReason", request.WriteReason),
+		zap.String("readReason", request.ReadReason))
+
+	if node.rateLimiter != nil {
+		node.rateLimiter.setQuotaFactors(request.WriteFactor, request.ReadFactor, request.WriteReason, request.ReadReason)
+	}
+
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
+// CreateRole creates a new role
+func (node *Proxy) CreateRole(ctx context.Context, req *milvuspb.CreateRoleRequest) (*commonpb.Status, error) {
+	log.Debug("CreateRole", zap.String("role", Params.RoleName), zap.Any("req", req))
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+	if err := ValidateRoleName(req.Entity.GetName()); err != nil {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_IllegalArgument,
+			Reason:    err.Error(),
+		}, nil
+	}
+	result, err := node.rootCoord.CreateRole(ctx, req)
+	if err != nil {
+		log.Error("create role fail", zap.String("role_name", req.Entity.GetName()), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+	return result, nil
+}
+
+// DropRole drops a role, the builtin roles can not be dropped
+func (node *Proxy) DropRole(ctx context.Context, req *milvuspb.DropRoleRequest) (*commonpb.Status, error) {
+	log.Debug("DropRole", zap.String("role", Params.RoleName), zap.Any("req", req))
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+	if err := ValidateRoleName(req.RoleName); err != nil {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_IllegalArgument,
+			Reason:    err.Error(),
+		}, nil
+	}
+	result, err := node.rootCoord.DropRole(ctx, req)
+	if err != nil {
+		log.Error("drop role fail", zap.String("role_name", req.RoleName), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+	return result, nil
+}
+
+// OperateUserRole binds a user to a role, or unbinds it
+func (node *Proxy) OperateUserRole(ctx context.Context, req *milvuspb.OperateUserRoleRequest) (*commonpb.Status, error) {
+	log.Debug("OperateUserRole", zap.String("role", Params.RoleName), zap.Any("req", req))
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+	if err := ValidateUsername(req.Username); err != nil {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_IllegalArgument,
+			Reason:    err.Error(),
+		}, nil
+	}
+	if err := ValidateRoleName(req.RoleName); err != nil {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_IllegalArgument,
+			Reason:    err.Error(),
+		}, nil
+	}
+	result, err := node.rootCoord.OperateUserRole(ctx, req)
+	if err != nil {
+		log.Error("operate user role fail", zap.Any("req", req), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+	return result, nil
+}
+
+// SelectRole selects a role, or all roles if the role is not specified
+func (node *Proxy) SelectRole(ctx context.Context, req *milvuspb.SelectRoleRequest) (*milvuspb.SelectRoleResponse, error) {
+	log.Debug("SelectRole", zap.String("role", Params.RoleName), zap.Any("req", req))
+	if !node.checkHealthy() {
+		return &milvuspb.SelectRoleResponse{Status: unhealthyStatus()}, nil
+	}
+	if req.Role != nil {
+		if err := ValidateRoleName(req.Role.Name); err != nil {
+			return &milvuspb.SelectRoleResponse{
+				Status: &commonpb.Status{
+					ErrorCode: commonpb.ErrorCode_IllegalArgument,
+					Reason:    err.Error(),
+				},
+			}, nil
+		}
+	}
+	result, err := node.rootCoord.SelectRole(ctx, req)
+	if err != nil {
+		log.Error("select role fail", zap.Any("req", req), zap.Error(err))
+		return &milvuspb.SelectRoleResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+	return result, nil
+}
+
+// SelectUser selects a user, or all users if the user is not specified
+func (node *Proxy) SelectUser(ctx context.Context, req *milvuspb.SelectUserRequest) (*milvuspb.SelectUserResponse, error) {
+	log.Debug("SelectUser", zap.String("role", Params.RoleName), zap.Any("req", req))
+	if !node.checkHealthy() {
+		return &milvuspb.SelectUserResponse{Status: unhealthyStatus()}, nil
+	}
+	if req.User != nil {
+		if err := ValidateUsername(req.User.Name); err != nil {
+			return &milvuspb.SelectUserResponse{
+				Status: &commonpb.Status{
+					ErrorCode: commonpb.ErrorCode_IllegalArgument,
+					Reason:    err.Error(),
+				},
+			}, nil
+		}
+	}
+	result, err := node.rootCoord.SelectUser(ctx, req)
+	if err != nil {
+		log.Error("select user fail", zap.Any("req", req), zap.Error(err))
+		return &milvuspb.SelectUserResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+	return result, nil
+}
+
+func validateGrantEntity(entity *milvuspb.GrantEntity) error {
+	if entity == nil {
+		return errors.New("the grant entity is empty")
+	}
+	if err := ValidateRoleName(entity.Role.GetName()); err != nil {
+		return err
+	}
+	if err := ValidateObjectType(entity.Object.GetName()); err != nil {
+		return err
+	}
+	if entity.ObjectName == "" {
+		return fmt.Errorf("the object name is empty, use %s for all objects", common.AnyWord)
+	}
+	return ValidatePrivilege(entity.Privilege.GetName())
+}
+
+// OperatePrivilege grants a privilege on an object to a role, or revokes it
+func (node *Proxy) OperatePrivilege(ctx context.Context, req *milvuspb.OperatePrivilegeRequest) (*commonpb.Status, error) {
+	log.Debug("OperatePrivilege", zap.String("role", Params.RoleName), zap.Any("req", req))
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+	if err := validateGrantEntity(req.Entity); err != nil {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_IllegalArgument,
+			Reason:    err.Error(),
+		}, nil
+	}
+	result, err := node.rootCoord.OperatePrivilege(ctx, req)
+	if err != nil {
+		log.Error("operate privilege fail", zap.Any("req", req), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+	return result, nil
+}
+
+// SelectGrant selects the grants of a role, optionally filtered by object type and object name
+func (node *Proxy) SelectGrant(ctx context.Context, req *milvuspb.SelectGrantRequest) (*milvuspb.SelectGrantResponse, error) {
+	log.Debug("SelectGrant", zap.String("role", Params.RoleName), zap.Any("req", req))
+	if !node.checkHealthy() {
+		return &milvuspb.SelectGrantResponse{Status: unhealthyStatus()}, nil
+	}
+	err := ValidateRoleName(req.GetEntity().GetRole().GetName())
+	if err == nil && req.Entity.Object.GetName() != "" {
+		err = ValidateObjectType(req.Entity.Object.GetName())
+	}
+	if err != nil {
+		return &milvuspb.SelectGrantResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_IllegalArgument,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+	result, err := node.rootCoord.SelectGrant(ctx, req)
+	if err != nil {
+		log.Error("select grant fail", zap.Any("req", req), zap.Error(err))
+		return &milvuspb.SelectGrantResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+	return result, nil
+}
+
 // checkHealthy checks proxy state is Healthy
 func (node *Proxy) checkHealthy() bool {
 	code := node.stateCode.Load().(internalpb.StateCode)
@@ -134,6 +134,42 @@ func (coord *IndexCoordMock) GetIndexFilePaths(ctx context.Context, req *indexpb
 	}, nil
 }
 
+func (coord *IndexCoordMock) EstimateRecall(ctx context.Context, req *indexpb.EstimateRecallRequest) (*indexpb.EstimateRecallResponse, error) {
+	return &indexpb.EstimateRecallResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+			Reason:    "",
+		},
+		IndexBuildID: req.IndexBuildID,
+		JobID:        req.IndexBuildID,
+		State:        commonpb.IndexState_InProgress,
+	}, nil
+}
+
+func (coord *IndexCoordMock) GetRecallEstimations(ctx context.Context, req *indexpb.GetRecallEstimationsRequest) (*indexpb.GetRecallEstimationsResponse, error) {
+	results := make([]*indexpb.EstimateRecallResponse, 0, len(req.JobIDs))
+	for _, jobID := range req.JobIDs {
+		results = append(results, &indexpb.EstimateRecallResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_Success,
+				Reason:    "",
+			},
+			IndexBuildID: jobID,
+			JobID:        jobID,
+			State:        commonpb.IndexState_Finished,
+			Nq:           10,
+			Recall:       1,
+		})
+	}
+	return &indexpb.GetRecallEstimationsResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+			Reason:    "",
+		},
+		Results: results,
+	}, nil
+}
+
 func (coord *IndexCoordMock) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
 	if !coord.healthy() {
 		return &milvuspb.GetMetricsResponse{
@@ -24,9 +24,12 @@ import (
 
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
@@ -39,6 +42,21 @@ type Cache interface {
 	GetCollectionSchema(ctx context.Context, collectionName string) (*schemapb.CollectionSchema, error)
 	RemoveCollection(ctx context.Context, collectionName string)
 	RemovePartition(ctx context.Context, collectionName string, partitionName string)
+
+	// GetIndexParams returns the flattened params of the index on the field, describing it from rootcoord if not cached
+	GetIndexParams(ctx context.Context, collectionName string, fieldName string, indexName string) (map[string]string, error)
+
+	// GetCredentialInfo returns the credential of the user, fetching it from rootcoord if not cached
+	GetCredentialInfo(ctx context.Context, username string) (*internalpb.CredentialInfo, error)
+	RemoveCredential(username string)
+	UpdateCredential(credInfo *internalpb.CredentialInfo)
+
+	// GetPrivilegeInfo returns the set of granted policies, see funcutil.PolicyForPrivilege for the format
+	GetPrivilegeInfo(ctx context.Context) (map[string]struct{}, error)
+	// GetUserRole returns the roles the user is bound to
+	GetUserRole(ctx context.Context, username string) ([]string, error)
+	// RefreshPolicyInfo drops the cached policies, they will be reloaded from rootcoord on next access
+	RefreshPolicyInfo()
 }
 
 type collectionInfo struct {
@@ -49,6 +67,11 @@ type collectionInfo struct {
 	createdUtcTimestamp uint64
 }
 
+type indexKey struct {
+	fieldName string
+	indexName string
+}
+
 type partitionInfo struct {
 	partitionID         typeutil.UniqueID
 	createdTimestamp    uint64
@@ -60,6 +83,16 @@ type MetaCache struct {
 
 	collInfo map[string]*collectionInfo
 	mu       sync.RWMutex
+
+	indexParams map[string]map[indexKey]map[string]string // cache for the index params of collections, lazy load
+	indexMut    sync.RWMutex
+
+	credMap map[string]*internalpb.CredentialInfo // cache for credential, lazy load
+	credMut sync.RWMutex
+
+	privilegeInfos map[string]struct{} // cache for the granted policies, nil if not loaded
+	userToRoles    map[string][]string // cache for the roles of users
+	privilegeMut   sync.RWMutex
 }
 
 var globalMetaCache Cache
@@ -75,8 +108,10 @@ func InitMetaCache(client types.RootCoord) error {
 
 func NewMetaCache(client types.RootCoord) (*MetaCache, error) {
 	return &MetaCache{
-		client:   client,
-		collInfo: map[string]*collectionInfo{},
+		client:      client,
+		collInfo:    map[string]*collectionInfo{},
+		indexParams: map[string]map[indexKey]map[string]string{},
+		credMap:     map[string]*internalpb.CredentialInfo{},
 	}, nil
 }
 
@@ -359,8 +394,12 @@ func (m *MetaCache) updatePartitions(partitions *milvuspb.ShowPartitionsResponse
 
 func (m *MetaCache) RemoveCollection(ctx context.Context, collectionName string) {
 	m.mu.Lock()
-	defer m.mu.Unlock()
 	delete(m.collInfo, collectionName)
+	m.mu.Unlock()
+
+	m.indexMut.Lock()
+	defer m.indexMut.Unlock()
+	delete(m.indexParams, collectionName)
 }
 
 func (m *MetaCache) RemovePartition(ctx context.Context, collectionName, partitionName string) {
@@ -376,3 +415,171 @@ func (m *MetaCache) RemovePartition(ctx context.Context, collectionName, partiti
 	}
 	delete(partInfo, partitionName)
 }
+
+func (m *MetaCache) GetIndexParams(ctx context.Context, collectionName string, fieldName string, indexName string) (map[string]string, error) {
+	key := indexKey{fieldName: fieldName, indexName: indexName}
+	m.indexMut.RLock()
+	params, ok := m.indexParams[collectionName][key]
+	m.indexMut.RUnlock()
+	if ok {
+		return params, nil
+	}
+
+	params, err := m.describeIndex(ctx, collectionName, fieldName, indexName)
+	if err != nil {
+		return nil, err
+	}
+	m.indexMut.Lock()
+	defer m.indexMut.Unlock()
+	if _, ok := m.indexParams[collectionName]; !ok {
+		m.indexParams[collectionName] = map[indexKey]map[string]string{}
+	}
+	m.indexParams[collectionName][key] = params
+	return params, nil
+}
+
+func (m *MetaCache) describeIndex(ctx context.Context, collectionName string, fieldName string, indexName string) (map[string]string, error) {
+	req := &milvuspb.DescribeIndexRequest{
+		Base: &commonpb.MsgBase{
+			MsgType: commonpb.MsgType_DescribeIndex,
+		},
+		CollectionName: collectionName,
+		FieldName:      fieldName,
+		IndexName:      indexName,
+	}
+	resp, err := m.client.DescribeIndex(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Status.ErrorCode != commonpb.ErrorCode_Success {
+		return nil, errors.New(resp.Status.Reason)
+	}
+	// the indexes of all fields with the name are described
+	var desc *milvuspb.IndexDescription
+	for _, d := range resp.IndexDescriptions {
+		if d.FieldName == fieldName {
+			desc = d
+			break
+		}
+	}
+	if desc == nil {
+		return nil, fmt.Errorf("index %s not found on field %s", indexName, fieldName)
+	}
+	params := make(map[string]string)
+	for _, kv := range desc.Params {
+		if kv.Key == "params" {
+			nested, err := funcutil.ParseIndexParamsMap(kv.Value)
+			if err != nil {
+				return nil, err
+			}
+			for k, v := range nested {
+				params[k] = v
+			}
+		} else {
+			params[kv.Key] = kv.Value
+		}
+	}
+	return params, nil
+}
+
+func (m *MetaCache) GetCredentialInfo(ctx context.Context, username string) (*internalpb.CredentialInfo, error) {
+	m.credMut.RLock()
+	credInfo, ok := m.credMap[username]
+	m.credMut.RUnlock()
+	if ok {
+		return credInfo, nil
+	}
+
+	req := &rootcoordpb.GetCredentialRequest{
+		Base: &commonpb.MsgBase{
+			MsgType: commonpb.MsgType_GetCredential,
+		},
+		Username: username,
+	}
+	resp, err := m.client.GetCredential(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Status.ErrorCode != commonpb.ErrorCode_Success {
+		return nil, errors.New(resp.Status.Reason)
+	}
+	credInfo = &internalpb.CredentialInfo{
+		Username:          resp.Username,
+		EncryptedPassword: resp.Password,
+	}
+	m.UpdateCredential(credInfo)
+	return credInfo, nil
+}
+
+func (m *MetaCache) RemoveCredential(username string) {
+	m.credMut.Lock()
+	defer m.credMut.Unlock()
+	delete(m.credMap, username)
+}
+
+func (m *MetaCache) UpdateCredential(credInfo *internalpb.CredentialInfo) {
+	m.credMut.Lock()
+	defer m.credMut.Unlock()
+	m.credMap[credInfo.Username] = credInfo
+}
+
+func (m *MetaCache) loadPolicyInfo(ctx context.Context) error {
+	m.privilegeMut.Lock()
+	defer m.privilegeMut.Unlock()
+	if m.privilegeInfos != nil {
+		return nil
+	}
+
+	req := &rootcoordpb.ListPolicyRequest{
+		Base: &commonpb.MsgBase{
+			MsgType: commonpb.MsgType_ListPolicy,
+		},
+	}
+	resp, err := m.client.ListPolicy(ctx, req)
+	if err != nil {
+		return err
+	}
+	if resp.Status.ErrorCode != commonpb.ErrorCode_Success {
+		return errors.New(resp.Status.Reason)
+	}
+	privilegeInfos := make(map[string]struct{}, len(resp.Grants))
+	for _, grant := range resp.Grants {
+		policy := funcutil.PolicyForPrivilege(grant.Role.GetName(), grant.Object.GetName(), grant.ObjectName, grant.Privilege.GetName())
+		privilegeInfos[policy] = struct{}{}
+	}
+	userToRoles := make(map[string][]string, len(resp.UserRoles))
+	for _, userRole := range resp.UserRoles {
+		for _, role := range userRole.Roles {
+			userToRoles[userRole.User.GetName()] = append(userToRoles[userRole.User.GetName()], role.GetName())
+		}
+	}
+	m.privilegeInfos = privilegeInfos
+	m.userToRoles = userToRoles
+	return nil
+}
+
+func (m *MetaCache) GetPrivilegeInfo(ctx context.Context) (map[string]struct{}, error) {
+	if err := m.loadPolicyInfo(ctx); err != nil {
+		return nil, err
+	}
+	m.privilegeMut.RLock()
+	defer m.privilegeMut.RUnlock()
+	// the map is replaced rather than modified when refreshed, so it's safe to return it directly
+	return m.privilegeInfos, nil
+}
+
+func (m *MetaCache) GetUserRole(ctx context.Context, username string) ([]string, error) {
+	if err := m.loadPolicyInfo(ctx); err != nil {
+		return nil, err
+	}
+	m.privilegeMut.RLock()
+	defer m.privilegeMut.RUnlock()
+	return m.userToRoles[username], nil
+}
+
+func (m *MetaCache) RefreshPolicyInfo() {
+	m.privilegeMut.Lock()
+	defer m.privilegeMut.Unlock()
+	m.privilegeInfos = nil
+	m.userToRoles = nil
+}
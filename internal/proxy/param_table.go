@@ -19,6 +19,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/milvus-io/milvus/internal/util/indexparamcheck"
 	"github.com/milvus-io/milvus/internal/util/paramtable"
 )
 
@@ -26,6 +27,12 @@ const (
 	SuggestPulsarMaxMessageSizeKey = 5 * 1024 * 1024
 )
 
+// CollectionRateLimit is the rate limits of a collection, a negative value means no limit
+type CollectionRateLimit struct {
+	MaxDMLRowsPerSecond     float64
+	MaxDQLRequestsPerSecond float64
+}
+
 type ParamTable struct {
 	paramtable.BaseTable
 
@@ -67,6 +74,20 @@ type ParamTable struct {
 
 	PulsarMaxMessageSize int
 	RoleName             string
+
+	AuthorizationEnabled bool
+
+	// --- Rate limit ---
+	RateLimitEnabled                  bool
+	MaxDMLRowsPerSecond               float64
+	MaxDQLRequestsPerSecond           float64
+	MaxDDLOpsPerMinute                float64
+	MaxCollectionDMLRowsPerSecond     float64
+	MaxCollectionDQLRequestsPerSecond float64
+	// the limits of the named collections, overriding the limits of each collection above
+	CollectionRateLimits map[string]CollectionRateLimit
+
+	AutoIndexConfig indexparamcheck.AutoIndexConfig
 }
 
 var Params ParamTable
@@ -106,6 +127,9 @@ func (pt *ParamTable) Init() {
 	pt.initRoleName()
 
 	pt.initMaxTaskNum()
+	pt.initAuthorizationEnabled()
+	pt.initRateLimit()
+	pt.initAutoIndexConfig()
 
 	Params.initLogCfg()
 }
@@ -237,6 +261,98 @@ func (pt *ParamTable) initDefaultIndexName() {
 	pt.DefaultIndexName = name
 }
 
+func (pt *ParamTable) initAuthorizationEnabled() {
+	enabled, err := pt.Load("common.security.authorizationEnabled")
+	if err != nil {
+		panic(err)
+	}
+	pt.AuthorizationEnabled, err = strconv.ParseBool(enabled)
+	if err != nil {
+		panic(err)
+	}
+}
+
+func (pt *ParamTable) initRateLimit() {
+	pt.RateLimitEnabled = pt.ParseBool("proxy.rateLimit.enabled", false)
+	pt.MaxDMLRowsPerSecond = pt.parseRateLimit("proxy.rateLimit.dmlRowsPerSecond")
+	pt.MaxDQLRequestsPerSecond = pt.parseRateLimit("proxy.rateLimit.dqlRequestsPerSecond")
+	pt.MaxDDLOpsPerMinute = pt.parseRateLimit("proxy.rateLimit.ddlOpsPerMinute")
+	pt.MaxCollectionDMLRowsPerSecond = pt.parseRateLimit("proxy.rateLimit.collection.dmlRowsPerSecond")
+	pt.MaxCollectionDQLRequestsPerSecond = pt.parseRateLimit("proxy.rateLimit.collection.dqlRequestsPerSecond")
+
+	str, err := pt.LoadWithDefault("proxy.rateLimit.collection.overrides", "")
+	if err != nil {
+		panic(err)
+	}
+	pt.CollectionRateLimits, err = parseCollectionRateLimits(str)
+	if err != nil {
+		panic(err)
+	}
+}
+
+// parseCollectionRateLimits parses the comma separated limits of collections, each in the form of
+// "<collection name>:<dml rows per second>:<dql requests per second>"
+func parseCollectionRateLimits(str string) (map[string]CollectionRateLimit, error) {
+	limits := make(map[string]CollectionRateLimit)
+	for _, item := range strings.Split(str, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		parts := strings.Split(item, ":")
+		if len(parts) != 3 || parts[0] == "" {
+			return nil, fmt.Errorf("invalid collection rate limit %s, expect <collection name>:<dml rows per second>:<dql requests per second>", item)
+		}
+		dml, err := strconv.ParseFloat(parts[1], 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid dml rate limit of collection %s: %w", parts[0], err)
+		}
+		dql, err := strconv.ParseFloat(parts[2], 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid dql rate limit of collection %s: %w", parts[0], err)
+		}
+		limits[parts[0]] = CollectionRateLimit{
+			MaxDMLRowsPerSecond:     dml,
+			MaxDQLRequestsPerSecond: dql,
+		}
+	}
+	return limits, nil
+}
+
+// parseRateLimit parses a rate limit, a negative value or not set means no limit
+func (pt *ParamTable) parseRateLimit(key string) float64 {
+	str, err := pt.LoadWithDefault(key, "-1")
+	if err != nil {
+		panic(err)
+	}
+	limit, err := strconv.ParseFloat(str, 64)
+	if err != nil {
+		panic(err)
+	}
+	return limit
+}
+
+func (pt *ParamTable) initAutoIndexConfig() {
+	cfg := indexparamcheck.DefaultAutoIndexConfig()
+	cfg.FlatMaxRows = pt.parseInt64WithDefault("proxy.autoIndex.flatMaxRows", cfg.FlatMaxRows)
+	cfg.HNSWMaxRows = pt.parseInt64WithDefault("proxy.autoIndex.hnswMaxRows", cfg.HNSWMaxRows)
+	cfg.HNSWM = int(pt.parseInt64WithDefault("proxy.autoIndex.hnsw.M", int64(cfg.HNSWM)))
+	cfg.HNSWEfConstruction = int(pt.parseInt64WithDefault("proxy.autoIndex.hnsw.efConstruction", int64(cfg.HNSWEfConstruction)))
+	pt.AutoIndexConfig = cfg
+}
+
+func (pt *ParamTable) parseInt64WithDefault(key string, defaultValue int64) int64 {
+	str, err := pt.LoadWithDefault(key, strconv.FormatInt(defaultValue, 10))
+	if err != nil {
+		panic(err)
+	}
+	value, err := strconv.ParseInt(str, 10, 64)
+	if err != nil {
+		panic(err)
+	}
+	return value
+}
+
 func (pt *ParamTable) initPulsarMaxMessageSize() {
 	// pulsarHost, err := pt.Load("pulsar.address")
 	// if err != nil {
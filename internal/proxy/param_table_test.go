@@ -97,6 +97,28 @@ func shouldPanic(t *testing.T, name string, f func()) {
 	t.Errorf("%s should have panicked", name)
 }
 
+func TestParseCollectionRateLimits(t *testing.T) {
+	limits, err := parseCollectionRateLimits("")
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(limits))
+
+	limits, err = parseCollectionRateLimits("col1:100:-1, col2:-1:10.5")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]CollectionRateLimit{
+		"col1": {MaxDMLRowsPerSecond: 100, MaxDQLRequestsPerSecond: -1},
+		"col2": {MaxDMLRowsPerSecond: -1, MaxDQLRequestsPerSecond: 10.5},
+	}, limits)
+
+	_, err = parseCollectionRateLimits("col1:100")
+	assert.Error(t, err)
+	_, err = parseCollectionRateLimits(":100:10")
+	assert.Error(t, err)
+	_, err = parseCollectionRateLimits("col1:abc:10")
+	assert.Error(t, err)
+	_, err = parseCollectionRateLimits("col1:100:abc")
+	assert.Error(t, err)
+}
+
 func TestParamTable_Panics(t *testing.T) {
 	shouldPanic(t, "proxy.timeTickInterval", func() {
 		Params.Remove("proxy.timeTickInterval")
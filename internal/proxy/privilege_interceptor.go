@@ -0,0 +1,255 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	grpcstatus "google.golang.org/grpc/status"
+
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/util/crypto"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+)
+
+// milvusServicePrefix is the method prefix of the MilvusService, which is called by sdk
+const milvusServicePrefix = "/milvus.proto.milvus.MilvusService/"
+
+// privilegeRule is the privilege required by a MilvusService method
+type privilegeRule struct {
+	objectType commonpb.ObjectType
+	privilege  commonpb.ObjectPrivilege
+}
+
+// publicMethods are the MilvusService methods any authenticated user can call
+var publicMethods = map[string]struct{}{
+	"Dummy":        {},
+	"RegisterLink": {},
+}
+
+// methodPrivileges maps the MilvusService methods to the privileges they require,
+// the methods listed in neither publicMethods nor here are denied to all users but root
+var methodPrivileges = map[string]privilegeRule{
+	"CreateCollection":         {commonpb.ObjectType_Global, commonpb.ObjectPrivilege_PrivilegeCreateCollection},
+	"ShowCollections":          {commonpb.ObjectType_Global, commonpb.ObjectPrivilege_PrivilegeShowCollections},
+	"CreateAlias":              {commonpb.ObjectType_Global, commonpb.ObjectPrivilege_PrivilegeManageAlias},
+	"DropAlias":                {commonpb.ObjectType_Global, commonpb.ObjectPrivilege_PrivilegeManageAlias},
+	"AlterAlias":               {commonpb.ObjectType_Global, commonpb.ObjectPrivilege_PrivilegeManageAlias},
+	"DropCollection":           {commonpb.ObjectType_Collection, commonpb.ObjectPrivilege_PrivilegeDropCollection},
+	"HasCollection":            {commonpb.ObjectType_Collection, commonpb.ObjectPrivilege_PrivilegeDescribeCollection},
+	"DescribeCollection":       {commonpb.ObjectType_Collection, commonpb.ObjectPrivilege_PrivilegeDescribeCollection},
+	"LoadCollection":           {commonpb.ObjectType_Collection, commonpb.ObjectPrivilege_PrivilegeLoad},
+	"LoadPartitions":           {commonpb.ObjectType_Collection, commonpb.ObjectPrivilege_PrivilegeLoad},
+	"ReleaseCollection":        {commonpb.ObjectType_Collection, commonpb.ObjectPrivilege_PrivilegeRelease},
+	"ReleasePartitions":        {commonpb.ObjectType_Collection, commonpb.ObjectPrivilege_PrivilegeRelease},
+	"GetCollectionStatistics":  {commonpb.ObjectType_Collection, commonpb.ObjectPrivilege_PrivilegeGetStatistics},
+	"GetPartitionStatistics":   {commonpb.ObjectType_Collection, commonpb.ObjectPrivilege_PrivilegeGetStatistics},
+	"GetPersistentSegmentInfo": {commonpb.ObjectType_Collection, commonpb.ObjectPrivilege_PrivilegeGetStatistics},
+	"GetQuerySegmentInfo":      {commonpb.ObjectType_Collection, commonpb.ObjectPrivilege_PrivilegeGetStatistics},
+	"CreatePartition":          {commonpb.ObjectType_Collection, commonpb.ObjectPrivilege_PrivilegeCreatePartition},
+	"DropPartition":            {commonpb.ObjectType_Collection, commonpb.ObjectPrivilege_PrivilegeDropPartition},
+	"HasPartition":             {commonpb.ObjectType_Collection, commonpb.ObjectPrivilege_PrivilegeShowPartitions},
+	"ShowPartitions":           {commonpb.ObjectType_Collection, commonpb.ObjectPrivilege_PrivilegeShowPartitions},
+	"CreateIndex":              {commonpb.ObjectType_Collection, commonpb.ObjectPrivilege_PrivilegeCreateIndex},
+	"DescribeIndex":            {commonpb.ObjectType_Collection, commonpb.ObjectPrivilege_PrivilegeIndexDetail},
+	"GetIndexState":            {commonpb.ObjectType_Collection, commonpb.ObjectPrivilege_PrivilegeIndexDetail},
+	"GetIndexBuildProgress":    {commonpb.ObjectType_Collection, commonpb.ObjectPrivilege_PrivilegeIndexDetail},
+	"DropIndex":                {commonpb.ObjectType_Collection, commonpb.ObjectPrivilege_PrivilegeDropIndex},
+	"AlterIndex":               {commonpb.ObjectType_Collection, commonpb.ObjectPrivilege_PrivilegeCreateIndex},
+	"GetIndexStatistics":       {commonpb.ObjectType_Collection, commonpb.ObjectPrivilege_PrivilegeIndexDetail},
+	"EstimateIndexRecall":      {commonpb.ObjectType_Collection, commonpb.ObjectPrivilege_PrivilegeIndexDetail},
+	"Insert":                   {commonpb.ObjectType_Collection, commonpb.ObjectPrivilege_PrivilegeInsert},
+	"Delete":                   {commonpb.ObjectType_Collection, commonpb.ObjectPrivilege_PrivilegeDelete},
+	"Search":                   {commonpb.ObjectType_Collection, commonpb.ObjectPrivilege_PrivilegeSearch},
+	"Query":                    {commonpb.ObjectType_Collection, commonpb.ObjectPrivilege_PrivilegeQuery},
+	"Flush":                    {commonpb.ObjectType_Collection, commonpb.ObjectPrivilege_PrivilegeFlush},
+	"CalcDistance":             {commonpb.ObjectType_Collection, commonpb.ObjectPrivilege_PrivilegeQuery},
+	"GetMetrics":               {commonpb.ObjectType_Global, commonpb.ObjectPrivilege_PrivilegeGetStatistics},
+	"CreateCredential":         {commonpb.ObjectType_Global, commonpb.ObjectPrivilege_PrivilegeCreateOwnership},
+	"DeleteCredential":         {commonpb.ObjectType_Global, commonpb.ObjectPrivilege_PrivilegeDropOwnership},
+	"ListCredUsers":            {commonpb.ObjectType_Global, commonpb.ObjectPrivilege_PrivilegeSelectOwnership},
+	"UpdateCredential":         {commonpb.ObjectType_User, commonpb.ObjectPrivilege_PrivilegeUpdateUser},
+	"CreateRole":               {commonpb.ObjectType_Global, commonpb.ObjectPrivilege_PrivilegeManageOwnership},
+	"DropRole":                 {commonpb.ObjectType_Global, commonpb.ObjectPrivilege_PrivilegeManageOwnership},
+	"OperateUserRole":          {commonpb.ObjectType_Global, commonpb.ObjectPrivilege_PrivilegeManageOwnership},
+	"OperatePrivilege":         {commonpb.ObjectType_Global, commonpb.ObjectPrivilege_PrivilegeManageOwnership},
+	"SelectRole":               {commonpb.ObjectType_Global, commonpb.ObjectPrivilege_PrivilegeSelectOwnership},
+	"SelectUser":               {commonpb.ObjectType_Global, commonpb.ObjectPrivilege_PrivilegeSelectOwnership},
+	"SelectGrant":              {commonpb.ObjectType_Global, commonpb.ObjectPrivilege_PrivilegeSelectOwnership},
+}
+
+// GetCurUserFromContext returns the username in the authorization metadata of the request
+func GetCurUserFromContext(ctx context.Context) (string, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", fmt.Errorf("fail to get metadata from the context")
+	}
+	authorization := md[strings.ToLower(common.HeaderAuthorize)]
+	if len(authorization) < 1 {
+		return "", fmt.Errorf("fail to get authorization from the metadata, key = %s", common.HeaderAuthorize)
+	}
+	rawToken, err := crypto.Base64Decode(authorization[0])
+	if err != nil {
+		return "", fmt.Errorf("fail to decode the token, error = %w", err)
+	}
+	secrets := strings.SplitN(rawToken, common.CredentialSeperator, 2)
+	if len(secrets) < 2 {
+		return "", fmt.Errorf("invalid token format, the token should be base64(username:password)")
+	}
+	return secrets[0], nil
+}
+
+// getCalcDistanceCollections returns the collections whose vectors are queried by ids to calculate the distances,
+// none if the vectors are all given in the request
+func getCalcDistanceCollections(req *milvuspb.CalcDistanceRequest) []string {
+	names := make([]string, 0, 2)
+	for _, op := range []*milvuspb.VectorsArray{req.GetOpLeft(), req.GetOpRight()} {
+		ids := op.GetIdArray()
+		if ids == nil || funcutil.SliceContain(names, ids.GetCollectionName()) {
+			continue
+		}
+		names = append(names, ids.GetCollectionName())
+	}
+	return names
+}
+
+// getObjectNames returns the names of the objects the request operates on
+func getObjectNames(objectType commonpb.ObjectType, req interface{}) []string {
+	switch objectType {
+	case commonpb.ObjectType_Collection:
+		if r, ok := req.(*milvuspb.CalcDistanceRequest); ok {
+			return getCalcDistanceCollections(r)
+		}
+		if r, ok := req.(interface{ GetCollectionNames() []string }); ok {
+			return r.GetCollectionNames()
+		}
+		if r, ok := req.(interface{ GetCollectionName() string }); ok {
+			return []string{r.GetCollectionName()}
+		}
+	case commonpb.ObjectType_User:
+		if r, ok := req.(interface{ GetUsername() string }); ok {
+			return []string{r.GetUsername()}
+		}
+	}
+	return []string{common.AnyWord}
+}
+
+// hasPrivilege checks whether any of the roles is granted the privilege on the object,
+// either directly, on all objects of the type, or by PrivilegeAll
+func hasPrivilege(privilegeInfos map[string]struct{}, roles []string, objectType, objectName, privilege string) bool {
+	for _, role := range roles {
+		if role == common.RoleAdmin {
+			return true
+		}
+		for _, name := range []string{objectName, common.AnyWord} {
+			for _, p := range []string{privilege, commonpb.ObjectPrivilege_PrivilegeAll.String()} {
+				if _, ok := privilegeInfos[funcutil.PolicyForPrivilege(role, objectType, name, p)]; ok {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// PrivilegeInterceptor checks whether the current user has the privilege required by the MilvusService method
+func PrivilegeInterceptor(ctx context.Context, fullMethod string, req interface{}) error {
+	if !Params.AuthorizationEnabled || !strings.HasPrefix(fullMethod, milvusServicePrefix) {
+		return nil
+	}
+	methodName := strings.TrimPrefix(fullMethod, milvusServicePrefix)
+	if _, ok := publicMethods[methodName]; ok {
+		return nil
+	}
+	username, err := GetCurUserFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	if username == common.DefaultRootUsername {
+		return nil
+	}
+	rule, ok := methodPrivileges[methodName]
+	if !ok {
+		return fmt.Errorf("permission deny, user = %s, method = %s has no privilege rule", username, methodName)
+	}
+	objectNames := getObjectNames(rule.objectType, req)
+	// users can always operate on themselves, such as updating their own password
+	if rule.objectType == commonpb.ObjectType_User && len(objectNames) == 1 && objectNames[0] == username {
+		return nil
+	}
+	if globalMetaCache == nil {
+		return fmt.Errorf("meta cache is not initialized")
+	}
+	roles, err := globalMetaCache.GetUserRole(ctx, username)
+	if err != nil {
+		return err
+	}
+	roles = append(roles, common.RolePublic)
+	privilegeInfos, err := globalMetaCache.GetPrivilegeInfo(ctx)
+	if err != nil {
+		return err
+	}
+	for _, objectName := range objectNames {
+		if !hasPrivilege(privilegeInfos, roles, rule.objectType.String(), objectName, rule.privilege.String()) {
+			return fmt.Errorf("permission deny, user = %s, object = %s/%s, privilege = %s",
+				username, rule.objectType.String(), objectName, rule.privilege.String())
+		}
+	}
+	return nil
+}
+
+// PrivilegeUnaryServerInterceptor is the grpc unary interceptor wrapping PrivilegeInterceptor,
+// the calls without privilege are rejected by a response whose status is ErrorCode_PermissionDenied
+func PrivilegeUnaryServerInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if err := PrivilegeInterceptor(ctx, info.FullMethod, req); err != nil {
+		log.Warn("PrivilegeInterceptor reject the request", zap.String("method", info.FullMethod), zap.Error(err))
+		return permissionDeniedResponse(info, err.Error())
+	}
+	return handler(ctx, req)
+}
+
+// permissionDeniedResponse builds the response of the method, with a status of ErrorCode_PermissionDenied
+func permissionDeniedResponse(info *grpc.UnaryServerInfo, reason string) (interface{}, error) {
+	status := &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_PermissionDenied,
+		Reason:    reason,
+	}
+	methodName := info.FullMethod[strings.LastIndex(info.FullMethod, "/")+1:]
+	method := reflect.ValueOf(info.Server).MethodByName(methodName)
+	if !method.IsValid() || method.Type().NumOut() != 2 {
+		return nil, grpcstatus.Error(codes.PermissionDenied, reason)
+	}
+	respType := method.Type().Out(0)
+	if respType == reflect.TypeOf(status) {
+		return status, nil
+	}
+	if respType.Kind() != reflect.Ptr || respType.Elem().Kind() != reflect.Struct {
+		return nil, grpcstatus.Error(codes.PermissionDenied, reason)
+	}
+	resp := reflect.New(respType.Elem())
+	field := resp.Elem().FieldByName("Status")
+	if !field.IsValid() || field.Type() != reflect.TypeOf(status) {
+		return nil, grpcstatus.Error(codes.PermissionDenied, reason)
+	}
+	field.Set(reflect.ValueOf(status))
+	return resp.Interface(), nil
+}
@@ -0,0 +1,252 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/crypto"
+)
+
+func userContext(username string) context.Context {
+	md := metadata.Pairs(common.HeaderAuthorize, crypto.Base64Encode(username+common.CredentialSeperator+"123456"))
+	return metadata.NewIncomingContext(context.Background(), md)
+}
+
+func TestGetCurUserFromContext(t *testing.T) {
+	_, err := GetCurUserFromContext(context.Background())
+	assert.NotNil(t, err)
+
+	_, err = GetCurUserFromContext(metadata.NewIncomingContext(context.Background(), metadata.Pairs()))
+	assert.NotNil(t, err)
+
+	_, err = GetCurUserFromContext(metadata.NewIncomingContext(context.Background(),
+		metadata.Pairs(common.HeaderAuthorize, "123456")))
+	assert.NotNil(t, err)
+
+	_, err = GetCurUserFromContext(metadata.NewIncomingContext(context.Background(),
+		metadata.Pairs(common.HeaderAuthorize, crypto.Base64Encode("mockUser"))))
+	assert.NotNil(t, err)
+
+	username, err := GetCurUserFromContext(userContext("mockUser"))
+	assert.Nil(t, err)
+	assert.Equal(t, "mockUser", username)
+}
+
+func TestPrivilegeInterceptor(t *testing.T) {
+	ctx := context.Background()
+	method := func(name string) string {
+		return milvusServicePrefix + name
+	}
+	hasReq := &milvuspb.HasCollectionRequest{CollectionName: "col1"}
+
+	// authorization disabled
+	err := PrivilegeInterceptor(ctx, method("HasCollection"), hasReq)
+	assert.Nil(t, err)
+
+	Params.AuthorizationEnabled = true
+	defer func() {
+		Params.AuthorizationEnabled = false
+	}()
+
+	rootCoord := NewRootCoordMock()
+	err = InitMetaCache(rootCoord)
+	assert.Nil(t, err)
+
+	// internal service and public methods are not checked
+	err = PrivilegeInterceptor(ctx, "/milvus.proto.proxy.Proxy/InvalidatePolicyInfoCache", nil)
+	assert.Nil(t, err)
+	err = PrivilegeInterceptor(ctx, method("Dummy"), &milvuspb.DummyRequest{})
+	assert.Nil(t, err)
+
+	// unknown methods are denied but to root
+	err = PrivilegeInterceptor(userContext("fooUser"), method("Unknown"), nil)
+	assert.NotNil(t, err)
+	err = PrivilegeInterceptor(userContext(common.DefaultRootUsername), method("Unknown"), nil)
+	assert.Nil(t, err)
+
+	// no user in the context
+	err = PrivilegeInterceptor(ctx, method("HasCollection"), hasReq)
+	assert.NotNil(t, err)
+
+	// root is not checked
+	err = PrivilegeInterceptor(userContext(common.DefaultRootUsername), method("HasCollection"), hasReq)
+	assert.Nil(t, err)
+
+	// users can update themselves
+	updateReq := &milvuspb.UpdateCredentialRequest{Username: "fooUser"}
+	err = PrivilegeInterceptor(userContext("fooUser"), method("UpdateCredential"), updateReq)
+	assert.Nil(t, err)
+	err = PrivilegeInterceptor(userContext("barUser"), method("UpdateCredential"), updateReq)
+	assert.NotNil(t, err)
+
+	// no grant
+	err = PrivilegeInterceptor(userContext("fooUser"), method("HasCollection"), hasReq)
+	assert.NotNil(t, err)
+
+	grant := func(roleName, objectType, objectName, privilege string) {
+		_, err := rootCoord.OperatePrivilege(ctx, &milvuspb.OperatePrivilegeRequest{
+			Entity: &milvuspb.GrantEntity{
+				Role:       &milvuspb.RoleEntity{Name: roleName},
+				Object:     &milvuspb.ObjectEntity{Name: objectType},
+				ObjectName: objectName,
+				Privilege:  &milvuspb.PrivilegeEntity{Name: privilege},
+			},
+			Type: milvuspb.OperatePrivilegeType_Grant,
+		})
+		assert.Nil(t, err)
+		globalMetaCache.RefreshPolicyInfo()
+	}
+	bind := func(username, roleName string) {
+		_, err := rootCoord.OperateUserRole(ctx, &milvuspb.OperateUserRoleRequest{
+			Username: username,
+			RoleName: roleName,
+			Type:     milvuspb.OperateUserRoleType_AddUserToRole,
+		})
+		assert.Nil(t, err)
+		globalMetaCache.RefreshPolicyInfo()
+	}
+
+	// grant on a single collection
+	grant("role1", commonpb.ObjectType_Collection.String(), "col1",
+		commonpb.ObjectPrivilege_PrivilegeDescribeCollection.String())
+	err = PrivilegeInterceptor(userContext("fooUser"), method("HasCollection"), hasReq)
+	assert.NotNil(t, err)
+	bind("fooUser", "role1")
+	err = PrivilegeInterceptor(userContext("fooUser"), method("HasCollection"), hasReq)
+	assert.Nil(t, err)
+	err = PrivilegeInterceptor(userContext("fooUser"), method("HasCollection"),
+		&milvuspb.HasCollectionRequest{CollectionName: "col2"})
+	assert.NotNil(t, err)
+
+	// other privileges on the collection are not granted
+	segmentReq := &milvuspb.GetPersistentSegmentInfoRequest{CollectionName: "col1"}
+	err = PrivilegeInterceptor(userContext("fooUser"), method("GetPersistentSegmentInfo"), segmentReq)
+	assert.NotNil(t, err)
+
+	// calculating the distances of the vectors of a collection requires to query it
+	calcReq := func(leftCollection, rightCollection string) *milvuspb.CalcDistanceRequest {
+		op := func(collectionName string) *milvuspb.VectorsArray {
+			if collectionName == "" {
+				return &milvuspb.VectorsArray{Array: &milvuspb.VectorsArray_DataArray{DataArray: &schemapb.VectorField{}}}
+			}
+			return &milvuspb.VectorsArray{Array: &milvuspb.VectorsArray_IdArray{IdArray: &milvuspb.VectorIDs{CollectionName: collectionName}}}
+		}
+		return &milvuspb.CalcDistanceRequest{OpLeft: op(leftCollection), OpRight: op(rightCollection)}
+	}
+	err = PrivilegeInterceptor(userContext("fooUser"), method("CalcDistance"), calcReq("", ""))
+	assert.Nil(t, err)
+	err = PrivilegeInterceptor(userContext("fooUser"), method("CalcDistance"), calcReq("col1", ""))
+	assert.NotNil(t, err)
+	grant("role1", commonpb.ObjectType_Collection.String(), "col1", commonpb.ObjectPrivilege_PrivilegeQuery.String())
+	err = PrivilegeInterceptor(userContext("fooUser"), method("CalcDistance"), calcReq("col1", "col1"))
+	assert.Nil(t, err)
+	err = PrivilegeInterceptor(userContext("fooUser"), method("CalcDistance"), calcReq("col1", "col2"))
+	assert.NotNil(t, err)
+
+	// metrics of the cluster require the global privilege
+	err = PrivilegeInterceptor(userContext("fooUser"), method("GetMetrics"), &milvuspb.GetMetricsRequest{})
+	assert.NotNil(t, err)
+
+	// grant on all objects with all privileges
+	grant("role1", commonpb.ObjectType_Collection.String(), common.AnyWord,
+		commonpb.ObjectPrivilege_PrivilegeAll.String())
+	err = PrivilegeInterceptor(userContext("fooUser"), method("HasCollection"),
+		&milvuspb.HasCollectionRequest{CollectionName: "col2"})
+	assert.Nil(t, err)
+	err = PrivilegeInterceptor(userContext("fooUser"), method("Search"),
+		&milvuspb.SearchRequest{CollectionName: "col2"})
+	assert.Nil(t, err)
+
+	// the public role is implicit
+	err = PrivilegeInterceptor(userContext("barUser"), method("ShowCollections"), &milvuspb.ShowCollectionsRequest{})
+	assert.NotNil(t, err)
+	grant(common.RolePublic, commonpb.ObjectType_Global.String(), common.AnyWord,
+		commonpb.ObjectPrivilege_PrivilegeShowCollections.String())
+	err = PrivilegeInterceptor(userContext("barUser"), method("ShowCollections"), &milvuspb.ShowCollectionsRequest{})
+	assert.Nil(t, err)
+
+	// admin has all the privileges
+	err = PrivilegeInterceptor(userContext("adminUser"), method("CreateRole"), &milvuspb.CreateRoleRequest{})
+	assert.NotNil(t, err)
+	bind("adminUser", common.RoleAdmin)
+	err = PrivilegeInterceptor(userContext("adminUser"), method("CreateRole"), &milvuspb.CreateRoleRequest{})
+	assert.Nil(t, err)
+}
+
+type mockPrivilegeServer struct{}
+
+func (s *mockPrivilegeServer) CreateRole(ctx context.Context, req *milvuspb.CreateRoleRequest) (*commonpb.Status, error) {
+	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
+}
+
+func (s *mockPrivilegeServer) SelectRole(ctx context.Context, req *milvuspb.SelectRoleRequest) (*milvuspb.SelectRoleResponse, error) {
+	return &milvuspb.SelectRoleResponse{Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}}, nil
+}
+
+func (s *mockPrivilegeServer) Dummy(ctx context.Context) error {
+	return nil
+}
+
+func TestPermissionDeniedResponse(t *testing.T) {
+	server := &mockPrivilegeServer{}
+
+	resp, err := permissionDeniedResponse(&grpc.UnaryServerInfo{Server: server, FullMethod: milvusServicePrefix + "CreateRole"}, "deny")
+	assert.Nil(t, err)
+	status, ok := resp.(*commonpb.Status)
+	assert.True(t, ok)
+	assert.Equal(t, commonpb.ErrorCode_PermissionDenied, status.ErrorCode)
+	assert.Equal(t, "deny", status.Reason)
+
+	resp, err = permissionDeniedResponse(&grpc.UnaryServerInfo{Server: server, FullMethod: milvusServicePrefix + "SelectRole"}, "deny")
+	assert.Nil(t, err)
+	selectResp, ok := resp.(*milvuspb.SelectRoleResponse)
+	assert.True(t, ok)
+	assert.Equal(t, commonpb.ErrorCode_PermissionDenied, selectResp.Status.ErrorCode)
+
+	_, err = permissionDeniedResponse(&grpc.UnaryServerInfo{Server: server, FullMethod: milvusServicePrefix + "Dummy"}, "deny")
+	assert.NotNil(t, err)
+
+	_, err = permissionDeniedResponse(&grpc.UnaryServerInfo{Server: server, FullMethod: milvusServicePrefix + "Unknown"}, "deny")
+	assert.NotNil(t, err)
+}
+
+func TestPrivilegeUnaryServerInterceptor(t *testing.T) {
+	Params.AuthorizationEnabled = true
+	defer func() {
+		Params.AuthorizationEnabled = false
+	}()
+	err := InitMetaCache(NewRootCoordMock())
+	assert.Nil(t, err)
+
+	info := &grpc.UnaryServerInfo{Server: &mockPrivilegeServer{}, FullMethod: milvusServicePrefix + "CreateRole"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
+	}
+
+	resp, err := PrivilegeUnaryServerInterceptor(userContext("fooUser"), &milvuspb.CreateRoleRequest{}, info, handler)
+	assert.Nil(t, err)
+	assert.Equal(t, commonpb.ErrorCode_PermissionDenied, resp.(*commonpb.Status).ErrorCode)
+
+	resp, err = PrivilegeUnaryServerInterceptor(userContext(common.DefaultRootUsername), &milvuspb.CreateRoleRequest{}, info, handler)
+	assert.Nil(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, resp.(*commonpb.Status).ErrorCode)
+}
@@ -71,6 +71,8 @@ type Proxy struct {
 
 	metricsCacheManager *metricsinfo.MetricsCacheManager
 
+	rateLimiter *rateLimiter
+
 	session *sessionutil.Session
 
 	msFactory msgstream.Factory
@@ -202,6 +204,8 @@ func (node *Proxy) Init() error {
 
 	node.metricsCacheManager = metricsinfo.NewMetricsCacheManager()
 
+	node.rateLimiter = newRateLimiter()
+
 	return nil
 }
 
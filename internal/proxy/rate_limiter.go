@@ -0,0 +1,275 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package proxy
+
+import (
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/metrics"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/util/ratelimitutil"
+)
+
+type rateType int
+
+const (
+	rateTypeDMLRows rateType = iota
+	rateTypeDQLRequests
+	rateTypeDDLOps
+)
+
+func (rt rateType) String() string {
+	switch rt {
+	case rateTypeDMLRows:
+		return "dml_rows"
+	case rateTypeDQLRequests:
+		return "dql_requests"
+	case rateTypeDDLOps:
+		return "ddl_ops"
+	}
+	return "unknown"
+}
+
+// rateLimiter limits the rate of dml rows, dql requests and ddl operations, the dml and dql limits
+// apply both to the whole proxy and to each collection, the ddl limit only to the whole proxy.
+// Each collection is limited by its own limits if configured, otherwise by the limits shared by all collections.
+// The dml and dql limits are scaled by the write and read factors pushed by the quota center of root coordinator.
+type rateLimiter struct {
+	global       map[rateType]*ratelimitutil.Limiter
+	globalLimits map[rateType]float64
+	// the observed rates of the dml and dql allowed by the proxy
+	rates map[rateType]*ratelimitutil.RateCollector
+
+	// the limits of each collection, and of the collections configured with their own limits
+	collectionLimits    map[rateType]float64
+	collectionOverrides map[string]map[rateType]float64
+	collections         sync.Map // collection name -> map[rateType]*ratelimitutil.Limiter
+
+	quotaMut sync.RWMutex
+	factors  map[rateType]float64
+	reasons  map[rateType]string
+	// the rates scaled by the factors instead of the global limits not configured
+	throttleBases map[rateType]float64
+}
+
+// rateWindow is the window to observe the rates, which the throttled limits are derived from
+const rateWindow = 10 * time.Second
+
+// window returns the period whose tokens a limiter can hold, ddl operations are limited per minute
+func (rt rateType) window() time.Duration {
+	if rt == rateTypeDDLOps {
+		return time.Minute
+	}
+	return time.Second
+}
+
+// newLimiter returns a limiter holding the tokens of a window, or one token at least
+func newLimiter(rt rateType, limit float64) *ratelimitutil.Limiter {
+	return ratelimitutil.NewLimiter(limit, math.Max(limit*rt.window().Seconds(), 1))
+}
+
+func newRateLimiter() *rateLimiter {
+	rl := &rateLimiter{
+		global: make(map[rateType]*ratelimitutil.Limiter),
+		globalLimits: map[rateType]float64{
+			rateTypeDMLRows:     -1,
+			rateTypeDQLRequests: -1,
+			rateTypeDDLOps:      -1,
+		},
+		collectionLimits: map[rateType]float64{
+			rateTypeDMLRows:     -1,
+			rateTypeDQLRequests: -1,
+		},
+		rates: map[rateType]*ratelimitutil.RateCollector{
+			rateTypeDMLRows:     ratelimitutil.NewRateCollector(rateWindow, time.Now()),
+			rateTypeDQLRequests: ratelimitutil.NewRateCollector(rateWindow, time.Now()),
+		},
+		factors: map[rateType]float64{
+			rateTypeDMLRows:     1,
+			rateTypeDQLRequests: 1,
+		},
+		collectionOverrides: make(map[string]map[rateType]float64),
+		reasons:             make(map[rateType]string),
+		throttleBases:       make(map[rateType]float64),
+	}
+	if Params.RateLimitEnabled {
+		rl.globalLimits[rateTypeDMLRows] = Params.MaxDMLRowsPerSecond
+		rl.globalLimits[rateTypeDQLRequests] = Params.MaxDQLRequestsPerSecond
+		rl.globalLimits[rateTypeDDLOps] = Params.MaxDDLOpsPerMinute / 60
+		rl.collectionLimits[rateTypeDMLRows] = Params.MaxCollectionDMLRowsPerSecond
+		rl.collectionLimits[rateTypeDQLRequests] = Params.MaxCollectionDQLRequestsPerSecond
+		for name, limit := range Params.CollectionRateLimits {
+			rl.collectionOverrides[name] = map[rateType]float64{
+				rateTypeDMLRows:     limit.MaxDMLRowsPerSecond,
+				rateTypeDQLRequests: limit.MaxDQLRequestsPerSecond,
+			}
+		}
+	}
+	for rt, limit := range rl.globalLimits {
+		rl.global[rt] = newLimiter(rt, limit)
+		metrics.ProxyRateLimitGauge.WithLabelValues(rt.String(), "global").Set(toLimitMetric(limit))
+	}
+	for rt, limit := range rl.collectionLimits {
+		metrics.ProxyRateLimitGauge.WithLabelValues(rt.String(), "collection").Set(toLimitMetric(limit))
+	}
+	for name, limits := range rl.collectionOverrides {
+		for rt, limit := range limits {
+			metrics.ProxyRateLimitGauge.WithLabelValues(rt.String(), "collection/"+name).Set(toLimitMetric(limit))
+		}
+	}
+	return rl
+}
+
+// collectionLimit returns the configured limit of the collection before scaled by the factors
+func (rl *rateLimiter) collectionLimit(collectionName string, rt rateType) float64 {
+	if limits, ok := rl.collectionOverrides[collectionName]; ok {
+		return limits[rt]
+	}
+	return rl.collectionLimits[rt]
+}
+
+// scaleLimit applies the factor to the configured limit, no limit is kept as is
+func scaleLimit(limit float64, factor float64) float64 {
+	if limit < 0 {
+		return limit
+	}
+	return limit * factor
+}
+
+func toLimitMetric(limit float64) float64 {
+	if limit < 0 {
+		return -1
+	}
+	return limit
+}
+
+func (rl *rateLimiter) getCollectionLimiter(collectionName string, rt rateType) *ratelimitutil.Limiter {
+	if _, ok := rl.collectionLimits[rt]; !ok || collectionName == "" || rl.collectionLimit(collectionName, rt) < 0 {
+		return nil
+	}
+	limiters, ok := rl.collections.Load(collectionName)
+	if !ok {
+		newLimiters := make(map[rateType]*ratelimitutil.Limiter)
+		rl.quotaMut.RLock()
+		for t := range rl.collectionLimits {
+			newLimiters[t] = newLimiter(t, scaleLimit(rl.collectionLimit(collectionName, t), rl.factors[t]))
+		}
+		rl.quotaMut.RUnlock()
+		limiters, _ = rl.collections.LoadOrStore(collectionName, newLimiters)
+	}
+	return limiters.(map[rateType]*ratelimitutil.Limiter)[rt]
+}
+
+// check takes n tokens from the global limiter and the limiter of the collection,
+// returns an error if any of them is exhausted
+func (rl *rateLimiter) check(collectionName string, rt rateType, n int) error {
+	now := time.Now()
+	if !rl.global[rt].AllowN(now, n) {
+		metrics.ProxyRateLimitedCounter.WithLabelValues(rt.String(), "").Inc()
+		return fmt.Errorf("rate limit exceeded, the %s of the proxy is limited to %v per second", rt.String(), rl.global[rt].Limit())
+	}
+	if limiter := rl.getCollectionLimiter(collectionName, rt); limiter != nil && !limiter.AllowN(now, n) {
+		rl.global[rt].Cancel(n)
+		metrics.ProxyRateLimitedCounter.WithLabelValues(rt.String(), collectionName).Inc()
+		return fmt.Errorf("rate limit exceeded, the %s of collection %s is limited to %v per second", rt.String(), collectionName, limiter.Limit())
+	}
+	if rate, ok := rl.rates[rt]; ok {
+		rate.Add(now, float64(n))
+	}
+	return nil
+}
+
+// throttleBaseLocked returns the global limit to scale by the factor. If no global limit is configured, the
+// rate observed by the proxy is scaled instead, otherwise the factor does nothing until it denies all the requests.
+// The rate is bounded by the scaled limit once throttled, so the highest rate observed since the throttling starts
+// is kept as the base, rather than scaling the throttled rate again on every push.
+func (rl *rateLimiter) throttleBaseLocked(rt rateType, factor float64, now time.Time) float64 {
+	limit := rl.globalLimits[rt]
+	if limit >= 0 || factor >= 1 {
+		delete(rl.throttleBases, rt)
+		return limit
+	}
+	base := math.Max(rl.throttleBases[rt], rl.rates[rt].Rate(now))
+	rl.throttleBases[rt] = base
+	if base <= 0 {
+		// nothing is observed to throttle yet
+		return limit
+	}
+	return base
+}
+
+// setQuotaFactors scales the dml limits by the write factor and the dql limits by the read factor
+func (rl *rateLimiter) setQuotaFactors(writeFactor, readFactor float64, writeReason, readReason string) {
+	rl.quotaMut.Lock()
+	defer rl.quotaMut.Unlock()
+	rl.factors[rateTypeDMLRows] = math.Max(0, math.Min(writeFactor, 1))
+	rl.factors[rateTypeDQLRequests] = math.Max(0, math.Min(readFactor, 1))
+	rl.reasons[rateTypeDMLRows] = writeReason
+	rl.reasons[rateTypeDQLRequests] = readReason
+
+	now := time.Now()
+	for rt, factor := range rl.factors {
+		limit := scaleLimit(rl.throttleBaseLocked(rt, factor, now), factor)
+		rl.global[rt].SetLimit(limit)
+		metrics.ProxyRateLimitGauge.WithLabelValues(rt.String(), "global").Set(toLimitMetric(limit))
+		metrics.ProxyRateLimitGauge.WithLabelValues(rt.String(), "collection").Set(toLimitMetric(scaleLimit(rl.collectionLimits[rt], factor)))
+		for name, limits := range rl.collectionOverrides {
+			metrics.ProxyRateLimitGauge.WithLabelValues(rt.String(), "collection/"+name).Set(toLimitMetric(scaleLimit(limits[rt], factor)))
+		}
+	}
+	rl.collections.Range(func(key, value interface{}) bool {
+		for rt, limiter := range value.(map[rateType]*ratelimitutil.Limiter) {
+			limiter.SetLimit(scaleLimit(rl.collectionLimit(key.(string), rt), rl.factors[rt]))
+		}
+		return true
+	})
+}
+
+// checkQuota returns an error if the requests of the type are denied by the quota center
+func (rl *rateLimiter) checkQuota(rt rateType) error {
+	rl.quotaMut.RLock()
+	defer rl.quotaMut.RUnlock()
+	factor, ok := rl.factors[rt]
+	if !ok || factor > 0 {
+		return nil
+	}
+	metrics.ProxyRateLimitedCounter.WithLabelValues(rt.String(), "").Inc()
+	return fmt.Errorf("quota exceeded, the %s are denied, reason = %s", rt.String(), rl.reasons[rt])
+}
+
+// removeCollection drops the limiters of the collection
+func (rl *rateLimiter) removeCollection(collectionName string) {
+	rl.collections.Delete(collectionName)
+}
+
+// checkRateLimit returns a RateLimit status if the request exceeds the rate limits, otherwise nil
+func (node *Proxy) checkRateLimit(collectionName string, rt rateType, n int) *commonpb.Status {
+	if node.rateLimiter == nil {
+		return nil
+	}
+	if err := node.rateLimiter.checkQuota(rt); err != nil {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_QuotaExceeded,
+			Reason:    err.Error(),
+		}
+	}
+	if err := node.rateLimiter.check(collectionName, rt, n); err != nil {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_RateLimit,
+			Reason:    err.Error(),
+		}
+	}
+	return nil
+}
@@ -0,0 +1,226 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package proxy
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/util/ratelimitutil"
+)
+
+func TestRateLimiter(t *testing.T) {
+	Params.Init()
+	enabled := Params.RateLimitEnabled
+	defer func() {
+		Params.RateLimitEnabled = enabled
+	}()
+
+	t.Run("disabled", func(t *testing.T) {
+		Params.RateLimitEnabled = false
+		rl := newRateLimiter()
+		for i := 0; i < 100; i++ {
+			assert.NoError(t, rl.check("col1", rateTypeDMLRows, 10000))
+			assert.NoError(t, rl.check("col1", rateTypeDQLRequests, 1))
+			assert.NoError(t, rl.check("", rateTypeDDLOps, 1))
+		}
+	})
+
+	t.Run("global", func(t *testing.T) {
+		Params.RateLimitEnabled = true
+		Params.MaxDMLRowsPerSecond = 100
+		Params.MaxDQLRequestsPerSecond = 2
+		Params.MaxDDLOpsPerMinute = 3
+		Params.MaxCollectionDMLRowsPerSecond = -1
+		Params.MaxCollectionDQLRequestsPerSecond = -1
+		rl := newRateLimiter()
+
+		assert.NoError(t, rl.check("col1", rateTypeDMLRows, 150))
+		assert.Error(t, rl.check("col2", rateTypeDMLRows, 1))
+
+		assert.NoError(t, rl.check("col1", rateTypeDQLRequests, 1))
+		assert.NoError(t, rl.check("col2", rateTypeDQLRequests, 1))
+		assert.Error(t, rl.check("col3", rateTypeDQLRequests, 1))
+
+		// a minute of ddl operations can be done at once
+		for i := 0; i < 3; i++ {
+			assert.NoError(t, rl.check("", rateTypeDDLOps, 1))
+		}
+		assert.Error(t, rl.check("", rateTypeDDLOps, 1))
+	})
+
+	t.Run("collection", func(t *testing.T) {
+		Params.RateLimitEnabled = true
+		Params.MaxDMLRowsPerSecond = 1000
+		Params.MaxDQLRequestsPerSecond = 3
+		Params.MaxDDLOpsPerMinute = -1
+		Params.MaxCollectionDMLRowsPerSecond = 100
+		Params.MaxCollectionDQLRequestsPerSecond = 1
+		rl := newRateLimiter()
+
+		assert.NoError(t, rl.check("col1", rateTypeDMLRows, 100))
+		assert.Error(t, rl.check("col1", rateTypeDMLRows, 1))
+		assert.NoError(t, rl.check("col2", rateTypeDMLRows, 100))
+
+		assert.NoError(t, rl.check("col1", rateTypeDQLRequests, 1))
+		// the rejected request gives back the global tokens
+		assert.Error(t, rl.check("col1", rateTypeDQLRequests, 1))
+		assert.NoError(t, rl.check("col2", rateTypeDQLRequests, 1))
+		assert.NoError(t, rl.check("col3", rateTypeDQLRequests, 1))
+
+		// the limiters are reset once the collection is removed
+		rl.removeCollection("col1")
+		assert.NoError(t, rl.check("col1", rateTypeDMLRows, 1))
+
+		for i := 0; i < 100; i++ {
+			assert.NoError(t, rl.check("", rateTypeDDLOps, 1))
+		}
+	})
+
+	t.Run("collection overrides", func(t *testing.T) {
+		Params.RateLimitEnabled = true
+		Params.MaxDMLRowsPerSecond = -1
+		Params.MaxDQLRequestsPerSecond = -1
+		Params.MaxDDLOpsPerMinute = -1
+		Params.MaxCollectionDMLRowsPerSecond = 100
+		Params.MaxCollectionDQLRequestsPerSecond = -1
+		Params.CollectionRateLimits = map[string]CollectionRateLimit{
+			"col1": {MaxDMLRowsPerSecond: 10, MaxDQLRequestsPerSecond: 1},
+			"col2": {MaxDMLRowsPerSecond: -1, MaxDQLRequestsPerSecond: -1},
+		}
+		defer func() { Params.CollectionRateLimits = nil }()
+		rl := newRateLimiter()
+
+		assert.NoError(t, rl.check("col1", rateTypeDMLRows, 10))
+		assert.Error(t, rl.check("col1", rateTypeDMLRows, 1))
+		assert.NoError(t, rl.check("col1", rateTypeDQLRequests, 1))
+		assert.Error(t, rl.check("col1", rateTypeDQLRequests, 1))
+
+		// not limited
+		assert.NoError(t, rl.check("col2", rateTypeDMLRows, 1000))
+		assert.NoError(t, rl.check("col2", rateTypeDMLRows, 1000))
+
+		// limited by the limits of each collection
+		assert.NoError(t, rl.check("col3", rateTypeDMLRows, 100))
+		assert.Error(t, rl.check("col3", rateTypeDMLRows, 1))
+		assert.NoError(t, rl.check("col3", rateTypeDQLRequests, 1))
+		assert.NoError(t, rl.check("col3", rateTypeDQLRequests, 1))
+
+		rl.setQuotaFactors(0.5, 1, "memory", "")
+		assert.Equal(t, float64(5), rl.getCollectionLimiter("col1", rateTypeDMLRows).Limit())
+		assert.Equal(t, float64(50), rl.getCollectionLimiter("col3", rateTypeDMLRows).Limit())
+	})
+}
+
+func TestProxy_checkRateLimit(t *testing.T) {
+	Params.Init()
+	enabled := Params.RateLimitEnabled
+	defer func() {
+		Params.RateLimitEnabled = enabled
+	}()
+
+	node := &Proxy{}
+	assert.Nil(t, node.checkRateLimit("col1", rateTypeDMLRows, 100))
+
+	Params.RateLimitEnabled = true
+	Params.MaxDMLRowsPerSecond = -1
+	Params.MaxCollectionDMLRowsPerSecond = 10
+	node.rateLimiter = newRateLimiter()
+	assert.Nil(t, node.checkRateLimit("col1", rateTypeDMLRows, 100))
+	status := node.checkRateLimit("col1", rateTypeDMLRows, 1)
+	assert.NotNil(t, status)
+	assert.Equal(t, commonpb.ErrorCode_RateLimit, status.ErrorCode)
+}
+
+func TestRateLimiter_setQuotaFactors(t *testing.T) {
+	Params.Init()
+	enabled := Params.RateLimitEnabled
+	defer func() {
+		Params.RateLimitEnabled = enabled
+	}()
+
+	Params.RateLimitEnabled = true
+	Params.MaxDMLRowsPerSecond = 100
+	Params.MaxDQLRequestsPerSecond = -1
+	Params.MaxDDLOpsPerMinute = -1
+	Params.MaxCollectionDMLRowsPerSecond = -1
+	Params.MaxCollectionDQLRequestsPerSecond = 10
+	rl := newRateLimiter()
+	assert.NoError(t, rl.checkQuota(rateTypeDMLRows))
+	assert.NoError(t, rl.checkQuota(rateTypeDQLRequests))
+	assert.NoError(t, rl.checkQuota(rateTypeDDLOps))
+
+	rl.setQuotaFactors(0.5, 0.2, "memory", "tSafe")
+	assert.Equal(t, float64(50), rl.global[rateTypeDMLRows].Limit())
+	assert.NoError(t, rl.check("col1", rateTypeDQLRequests, 1))
+	assert.Equal(t, float64(2), rl.getCollectionLimiter("col1", rateTypeDQLRequests).Limit())
+	// no limit is kept until any request is observed
+	assert.Equal(t, ratelimitutil.Inf, rl.global[rateTypeDQLRequests].Limit())
+
+	// the limits are restored and the factors are clamped
+	rl.setQuotaFactors(2, 1, "", "")
+	assert.Equal(t, float64(100), rl.global[rateTypeDMLRows].Limit())
+	assert.Equal(t, float64(10), rl.getCollectionLimiter("col1", rateTypeDQLRequests).Limit())
+
+	rl.setQuotaFactors(0, 1, "memory", "")
+	err := rl.checkQuota(rateTypeDMLRows)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "memory")
+	assert.NoError(t, rl.checkQuota(rateTypeDQLRequests))
+	assert.NoError(t, rl.checkQuota(rateTypeDDLOps))
+
+	node := &Proxy{rateLimiter: rl}
+	status := node.checkRateLimit("col1", rateTypeDMLRows, 1)
+	assert.NotNil(t, status)
+	assert.Equal(t, commonpb.ErrorCode_QuotaExceeded, status.ErrorCode)
+	assert.Nil(t, node.checkRateLimit("col1", rateTypeDQLRequests, 1))
+}
+
+func TestRateLimiter_throttleObservedRate(t *testing.T) {
+	Params.Init()
+	enabled := Params.RateLimitEnabled
+	defer func() {
+		Params.RateLimitEnabled = enabled
+	}()
+
+	Params.RateLimitEnabled = false
+	rl := newRateLimiter()
+	now := time.Now()
+	rl.rates[rateTypeDQLRequests] = ratelimitutil.NewRateCollector(rateWindow, now.Add(-rateWindow))
+	rl.rates[rateTypeDQLRequests].Add(now, 100)
+
+	// the observed rate is scaled without a configured limit
+	rl.setQuotaFactors(1, 0.5, "", "tSafe")
+	assert.Equal(t, float64(5), rl.global[rateTypeDQLRequests].Limit())
+	assert.Equal(t, ratelimitutil.Inf, rl.global[rateTypeDMLRows].Limit())
+
+	// the throttled rate isn't scaled again
+	rl.setQuotaFactors(1, 0.5, "", "tSafe")
+	assert.Equal(t, float64(5), rl.global[rateTypeDQLRequests].Limit())
+	rl.setQuotaFactors(1, 0.8, "", "tSafe")
+	assert.Equal(t, float64(8), rl.global[rateTypeDQLRequests].Limit())
+
+	// no limit is restored
+	rl.setQuotaFactors(1, 1, "", "")
+	assert.Equal(t, ratelimitutil.Inf, rl.global[rateTypeDQLRequests].Limit())
+	_, ok := rl.throttleBases[rateTypeDQLRequests]
+	assert.False(t, ok)
+
+	// the allowed requests are observed
+	for i := 0; i < 10; i++ {
+		assert.NoError(t, rl.check("col1", rateTypeDMLRows, 100))
+	}
+	assert.True(t, rl.rates[rateTypeDMLRows].Rate(time.Now()) > 0)
+}
@@ -99,6 +99,13 @@ type RootCoordMock struct {
 
 	// TODO(dragondriver): TimeTick-related
 
+	credentials map[string]*internalpb.CredentialInfo
+	credMtx     sync.RWMutex
+
+	grants    []*milvuspb.GrantEntity
+	userRoles map[string][]string
+	policyMtx sync.RWMutex
+
 	lastTs    typeutil.Timestamp
 	lastTsMtx sync.Mutex
 }
@@ -195,6 +202,145 @@ func (coord *RootCoordMock) AlterAlias(ctx context.Context, req *milvuspb.AlterA
 	}, nil
 }
 
+func (coord *RootCoordMock) CreateCredential(ctx context.Context, req *internalpb.CredentialInfo) (*commonpb.Status, error) {
+	coord.credMtx.Lock()
+	defer coord.credMtx.Unlock()
+	if _, exist := coord.credentials[req.Username]; exist {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_CreateCredentialFailure,
+			Reason:    fmt.Sprintf("user already exists, username = %s", req.Username),
+		}, nil
+	}
+	coord.credentials[req.Username] = req
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}, nil
+}
+
+func (coord *RootCoordMock) UpdateCredential(ctx context.Context, req *internalpb.CredentialInfo) (*commonpb.Status, error) {
+	coord.credMtx.Lock()
+	defer coord.credMtx.Unlock()
+	if _, exist := coord.credentials[req.Username]; !exist {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UpdateCredentialFailure,
+			Reason:    fmt.Sprintf("user does not exist, username = %s", req.Username),
+		}, nil
+	}
+	coord.credentials[req.Username] = req
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}, nil
+}
+
+func (coord *RootCoordMock) DeleteCredential(ctx context.Context, req *milvuspb.DeleteCredentialRequest) (*commonpb.Status, error) {
+	coord.credMtx.Lock()
+	defer coord.credMtx.Unlock()
+	delete(coord.credentials, req.Username)
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}, nil
+}
+
+func (coord *RootCoordMock) ListCredUsers(ctx context.Context, req *milvuspb.ListCredUsersRequest) (*milvuspb.ListCredUsersResponse, error) {
+	coord.credMtx.RLock()
+	defer coord.credMtx.RUnlock()
+	usernames := make([]string, 0, len(coord.credentials))
+	for username := range coord.credentials {
+		usernames = append(usernames, username)
+	}
+	return &milvuspb.ListCredUsersResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		Usernames: usernames,
+	}, nil
+}
+
+func (coord *RootCoordMock) GetCredential(ctx context.Context, req *rootcoordpb.GetCredentialRequest) (*rootcoordpb.GetCredentialResponse, error) {
+	coord.credMtx.RLock()
+	defer coord.credMtx.RUnlock()
+	credInfo, exist := coord.credentials[req.Username]
+	if !exist {
+		return &rootcoordpb.GetCredentialResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_GetCredentialFailure,
+				Reason:    fmt.Sprintf("user does not exist, username = %s", req.Username),
+			},
+		}, nil
+	}
+	return &rootcoordpb.GetCredentialResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		Username: credInfo.Username,
+		Password: credInfo.EncryptedPassword,
+	}, nil
+}
+
+func (coord *RootCoordMock) CreateRole(ctx context.Context, req *milvuspb.CreateRoleRequest) (*commonpb.Status, error) {
+	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
+}
+
+func (coord *RootCoordMock) DropRole(ctx context.Context, req *milvuspb.DropRoleRequest) (*commonpb.Status, error) {
+	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
+}
+
+func (coord *RootCoordMock) OperateUserRole(ctx context.Context, req *milvuspb.OperateUserRoleRequest) (*commonpb.Status, error) {
+	coord.policyMtx.Lock()
+	defer coord.policyMtx.Unlock()
+	if req.Type == milvuspb.OperateUserRoleType_AddUserToRole {
+		coord.userRoles[req.Username] = append(coord.userRoles[req.Username], req.RoleName)
+	}
+	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
+}
+
+func (coord *RootCoordMock) SelectRole(ctx context.Context, req *milvuspb.SelectRoleRequest) (*milvuspb.SelectRoleResponse, error) {
+	return &milvuspb.SelectRoleResponse{Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}}, nil
+}
+
+func (coord *RootCoordMock) SelectUser(ctx context.Context, req *milvuspb.SelectUserRequest) (*milvuspb.SelectUserResponse, error) {
+	return &milvuspb.SelectUserResponse{Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}}, nil
+}
+
+func (coord *RootCoordMock) OperatePrivilege(ctx context.Context, req *milvuspb.OperatePrivilegeRequest) (*commonpb.Status, error) {
+	coord.policyMtx.Lock()
+	defer coord.policyMtx.Unlock()
+	if req.Type == milvuspb.OperatePrivilegeType_Grant {
+		coord.grants = append(coord.grants, req.Entity)
+	}
+	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
+}
+
+func (coord *RootCoordMock) SelectGrant(ctx context.Context, req *milvuspb.SelectGrantRequest) (*milvuspb.SelectGrantResponse, error) {
+	return &milvuspb.SelectGrantResponse{Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}}, nil
+}
+
+func (coord *RootCoordMock) ListPolicy(ctx context.Context, req *rootcoordpb.ListPolicyRequest) (*rootcoordpb.ListPolicyResponse, error) {
+	coord.policyMtx.RLock()
+	defer coord.policyMtx.RUnlock()
+	userRoles := make([]*milvuspb.UserResult, 0, len(coord.userRoles))
+	for username, roleNames := range coord.userRoles {
+		result := &milvuspb.UserResult{User: &milvuspb.UserEntity{Name: username}}
+		for _, roleName := range roleNames {
+			result.Roles = append(result.Roles, &milvuspb.RoleEntity{Name: roleName})
+		}
+		userRoles = append(userRoles, result)
+	}
+	return &rootcoordpb.ListPolicyResponse{
+		Status:    &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		Grants:    coord.grants,
+		UserRoles: userRoles,
+	}, nil
+}
+
+func (coord *RootCoordMock) ListMsgTopics(ctx context.Context, req *rootcoordpb.ListMsgTopicsRequest) (*rootcoordpb.ListMsgTopicsResponse, error) {
+	return &rootcoordpb.ListMsgTopicsResponse{Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}}, nil
+}
+
+func (coord *RootCoordMock) PurgeMsgTopics(ctx context.Context, req *rootcoordpb.PurgeMsgTopicsRequest) (*rootcoordpb.PurgeMsgTopicsResponse, error) {
+	return &rootcoordpb.PurgeMsgTopicsResponse{Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}}, nil
+}
+
 func (coord *RootCoordMock) updateState(state internalpb.StateCode) {
 	coord.state.Store(state)
 }
@@ -759,6 +905,20 @@ func (coord *RootCoordMock) DropIndex(ctx context.Context, req *milvuspb.DropInd
 	}, nil
 }
 
+func (coord *RootCoordMock) AlterIndex(ctx context.Context, req *milvuspb.AlterIndexRequest) (*commonpb.Status, error) {
+	code := coord.state.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
 func (coord *RootCoordMock) AllocTimestamp(ctx context.Context, req *rootcoordpb.AllocTimestampRequest) (*rootcoordpb.AllocTimestampResponse, error) {
 	code := coord.state.Load().(internalpb.StateCode)
 	if code != internalpb.StateCode_Healthy {
@@ -930,6 +1090,8 @@ func NewRootCoordMock(opts ...RootCoordMockOption) *RootCoordMock {
 		collName2ID:       make(map[string]typeutil.UniqueID),
 		collID2Meta:       make(map[typeutil.UniqueID]collectionMeta),
 		collID2Partitions: make(map[typeutil.UniqueID]partitionMap),
+		credentials:       make(map[string]*internalpb.CredentialInfo),
+		userRoles:         make(map[string][]string),
 		lastTs:            typeutil.Timestamp(time.Now().UnixNano()),
 	}
 
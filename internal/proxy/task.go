@@ -15,8 +15,10 @@ import (
 	"bytes"
 	"context"
 	"encoding/binary"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"hash/fnv"
 	"math"
 	"reflect"
 	"regexp"
@@ -73,8 +75,11 @@ const (
 	CreateIndexTaskName             = "CreateIndexTask"
 	DescribeIndexTaskName           = "DescribeIndexTask"
 	DropIndexTaskName               = "DropIndexTask"
+	AlterIndexTaskName              = "AlterIndexTask"
 	GetIndexStateTaskName           = "GetIndexStateTask"
 	GetIndexBuildProgressTaskName   = "GetIndexBuildProgressTask"
+	GetIndexStatisticsTaskName      = "GetIndexStatisticsTask"
+	EstimateIndexRecallTaskName     = "EstimateIndexRecallTask"
 	FlushTaskName                   = "FlushTask"
 	LoadCollectionTaskName          = "LoadCollectionTask"
 	ReleaseCollectionTaskName       = "ReleaseCollectionTask"
@@ -998,9 +1003,59 @@ func (it *insertTask) _assignSegmentID(stream msgstream.MsgStream, pack *msgstre
 		}
 	}
 
+	// assign every message an id, so that the consumers could drop the messages replayed or produced repeatedly
+	if err := assignInsertMsgIDs(newPack.Msgs, it.req.GetBase().GetMsgID(), it.rowIDAllocator.Alloc); err != nil {
+		return nil, err
+	}
+
 	return newPack, nil
 }
 
+// idempotentInsertSourceID is the source id of the insert messages whose ids are derived from the idempotency key of
+// the client request, so that the retries of a request are deduplicated no matter which proxy they are sent to
+const idempotentInsertSourceID = -1
+
+// assignInsertMsgIDs assigns the ids of the insert messages of a request. If the client request carries a non-zero
+// msg id, it's taken as the idempotency key of the request and the id of every message is derived from the key, the
+// collection, the shard and the index of the message in the shard, so that a retried request produces the same ids
+// and the copies are dropped by the consumers. The derived ids only match if the retry is sharded the same way, which
+// holds when the primary keys are provided by the client. Otherwise the ids are allocated by alloc.
+func assignInsertMsgIDs(msgs []msgstream.TsMsg, idempotencyKey UniqueID, alloc func(count uint32) (UniqueID, UniqueID, error)) error {
+	if idempotencyKey == 0 {
+		msgIDBegin, _, err := alloc(uint32(len(msgs)))
+		if err != nil {
+			return err
+		}
+		for i, msg := range msgs {
+			msg.(*msgstream.InsertMsg).Base.MsgID = msgIDBegin + UniqueID(i)
+		}
+		return nil
+	}
+
+	shardIndexes := make(map[string]int64)
+	for _, msg := range msgs {
+		insertMsg := msg.(*msgstream.InsertMsg)
+		shardIndex := shardIndexes[insertMsg.ShardName]
+		shardIndexes[insertMsg.ShardName] = shardIndex + 1
+
+		h := fnv.New64a()
+		buf := make([]byte, 8)
+		for _, v := range []int64{idempotencyKey, insertMsg.CollectionID, shardIndex} {
+			binary.LittleEndian.PutUint64(buf, uint64(v))
+			_, _ = h.Write(buf)
+		}
+		_, _ = h.Write([]byte(insertMsg.ShardName))
+		// keep the id positive and non-zero, a zero id is never deduplicated
+		msgID := UniqueID(h.Sum64() & math.MaxInt64)
+		if msgID == 0 {
+			msgID = 1
+		}
+		insertMsg.Base.MsgID = msgID
+		insertMsg.Base.SourceID = idempotentInsertSourceID
+	}
+	return nil
+}
+
 func (it *insertTask) Execute(ctx context.Context) error {
 	sp, ctx := trace.StartSpanFromContextWithOperationName(it.ctx, "Proxy-Insert-Execute")
 	defer sp.Finish()
@@ -1362,6 +1417,7 @@ type searchTask struct {
 	query     *milvuspb.SearchRequest
 	chMgr     channelsMgr
 	qc        types.QueryCoord
+	rootCoord types.RootCoord
 }
 
 func (st *searchTask) TraceCtx() context.Context {
@@ -1528,6 +1584,10 @@ func (st *searchTask) PreExecute(ctx context.Context) error {
 		if err != nil {
 			return errors.New(SearchParamsKey + " not found in search_params")
 		}
+		searchParams, err = st.resolveSearchLevel(ctx, collectionName, annsField, int64(topK), searchParams)
+		if err != nil {
+			return err
+		}
 
 		queryInfo := &planpb.QueryInfo{
 			Topk:         int64(topK),
@@ -1580,6 +1640,12 @@ func (st *searchTask) PreExecute(ctx context.Context) error {
 		log.Debug("Proxy::searchTask::PreExecute", zap.Any("plan.OutputFieldIds", plan.OutputFieldIds),
 			zap.Any("plan", plan.String()))
 	}
+	if st.query.IndexName != "" {
+		if st.query.GetDslType() != commonpb.DslType_BoolExprV1 {
+			return errors.New("index_name is only supported by boolean expression search")
+		}
+		st.SearchRequest.IndexName = st.query.IndexName
+	}
 	travelTimestamp := st.query.TravelTimestamp
 	if travelTimestamp == 0 {
 		travelTimestamp = st.BeginTs()
@@ -1630,6 +1696,59 @@ func (st *searchTask) PreExecute(ctx context.Context) error {
 	return nil
 }
 
+// resolveSearchLevel replaces the search level in the search params with the nprobe or ef mapped from the params of
+// the searched index, the params set explicitly are kept
+func (st *searchTask) resolveSearchLevel(ctx context.Context, collectionName, annsField string, topK int64, searchParams string) (string, error) {
+	params := make(map[string]interface{})
+	if err := json.Unmarshal([]byte(searchParams), &params); err != nil {
+		// leave the params to be checked by segcore
+		return searchParams, nil
+	}
+	value, ok := params[indexparamcheck.SearchLevel]
+	if !ok {
+		return searchParams, nil
+	}
+	level, ok := value.(float64)
+	if !ok || level != float64(int(level)) {
+		return "", fmt.Errorf("invalid search level: %v", value)
+	}
+	delete(params, indexparamcheck.SearchLevel)
+
+	indexParams, err := st.describeSearchIndex(ctx, collectionName, annsField)
+	if err != nil {
+		return "", err
+	}
+	levelParams, err := indexparamcheck.SearchParamsByLevel(indexParams, int(level), topK)
+	if err != nil {
+		return "", err
+	}
+	for k, v := range levelParams {
+		if _, ok := params[k]; ok {
+			continue
+		}
+		n, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return "", err
+		}
+		params[k] = n
+	}
+	bs, err := json.Marshal(params)
+	if err != nil {
+		return "", err
+	}
+	log.Debug("resolve search level", zap.Float64("level", level), zap.String("search_params", string(bs)))
+	return string(bs), nil
+}
+
+// describeSearchIndex returns the flattened params of the index searched on the vector field
+func (st *searchTask) describeSearchIndex(ctx context.Context, collectionName, annsField string) (map[string]string, error) {
+	indexName := st.query.IndexName
+	if indexName == "" {
+		indexName = Params.DefaultIndexName
+	}
+	return globalMetaCache.GetIndexParams(ctx, collectionName, annsField, indexName)
+}
+
 func (st *searchTask) Execute(ctx context.Context) error {
 	sp, ctx := trace.StartSpanFromContextWithOperationName(st.TraceCtx(), "Proxy-Search-Execute")
 	defer sp.Finish()
@@ -3423,6 +3542,7 @@ type createIndexTask struct {
 	*milvuspb.CreateIndexRequest
 	ctx       context.Context
 	rootCoord types.RootCoord
+	dataCoord types.DataCoord
 	result    *commonpb.Status
 }
 
@@ -3477,6 +3597,12 @@ func (cit *createIndexTask) PreExecute(ctx context.Context) error {
 		return err
 	}
 
+	if cit.IndexName != "" {
+		if err := ValidateIndexName(cit.IndexName); err != nil {
+			return err
+		}
+	}
+
 	// check index param, not accurate, only some static rules
 	indexParams := make(map[string]string)
 	for _, kv := range cit.CreateIndexRequest.ExtraParams {
@@ -3500,6 +3626,9 @@ func (cit *createIndexTask) PreExecute(ctx context.Context) error {
 	if !exist {
 		indexType = indexparamcheck.IndexFaissIvfPQ // IVF_PQ is the default index type
 	}
+	if indexType == indexparamcheck.IndexAutoIndex {
+		return cit.resolveAutoIndex(ctx, indexParams[indexparamcheck.Metric])
+	}
 
 	adapter, err := indexparamcheck.GetConfAdapterMgrInstance().GetAdapter(indexType)
 	if err != nil {
@@ -3516,6 +3645,90 @@ func (cit *createIndexTask) PreExecute(ctx context.Context) error {
 	return nil
 }
 
+// resolveAutoIndex replaces the params of AUTOINDEX with the concrete index type and params chosen by the row count
+// of the collection, the dimension and data type of the field
+func (cit *createIndexTask) resolveAutoIndex(ctx context.Context, metricType string) error {
+	schema, err := globalMetaCache.GetCollectionSchema(ctx, cit.CollectionName)
+	if err != nil {
+		return err
+	}
+	var field *schemapb.FieldSchema
+	for _, f := range schema.Fields {
+		if f.Name == cit.FieldName {
+			field = f
+			break
+		}
+	}
+	if field == nil {
+		return fmt.Errorf("field %s not found in collection %s", cit.FieldName, cit.CollectionName)
+	}
+	var dim int64
+	for _, kv := range field.TypeParams {
+		if kv.Key == "dim" {
+			dim, err = strconv.ParseInt(kv.Value, 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid dim of field %s: %s", field.Name, kv.Value)
+			}
+		}
+	}
+
+	collID, err := globalMetaCache.GetCollectionID(ctx, cit.CollectionName)
+	if err != nil {
+		return err
+	}
+	rows, err := cit.getRowCount(ctx, collID)
+	if err != nil {
+		return err
+	}
+
+	params, err := indexparamcheck.ResolveAutoIndex(Params.AutoIndexConfig, rows, dim, field.DataType, metricType)
+	if err != nil {
+		return err
+	}
+	keys := make([]string, 0, len(params))
+	for k := range params {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	cit.ExtraParams = make([]*commonpb.KeyValuePair, 0, len(keys))
+	for _, k := range keys {
+		cit.ExtraParams = append(cit.ExtraParams, &commonpb.KeyValuePair{Key: k, Value: params[k]})
+	}
+	log.Debug("resolve auto index",
+		zap.String("collection", cit.CollectionName),
+		zap.String("field", cit.FieldName),
+		zap.Int64("rows", rows),
+		zap.Int64("dim", dim),
+		zap.Any("index_params", params))
+	return nil
+}
+
+func (cit *createIndexTask) getRowCount(ctx context.Context, collID UniqueID) (int64, error) {
+	if cit.dataCoord == nil {
+		return 0, errors.New("data coord is not available to get the row count")
+	}
+	resp, err := cit.dataCoord.GetCollectionStatistics(ctx, &datapb.GetCollectionStatisticsRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:  commonpb.MsgType_GetCollectionStatistics,
+			MsgID:    cit.Base.MsgID,
+			SourceID: Params.ProxyID,
+		},
+		CollectionID: collID,
+	})
+	if err != nil {
+		return 0, err
+	}
+	if resp.Status.ErrorCode != commonpb.ErrorCode_Success {
+		return 0, errors.New(resp.Status.Reason)
+	}
+	for _, kv := range resp.Stats {
+		if kv.Key == "row_count" {
+			return strconv.ParseInt(kv.Value, 10, 64)
+		}
+	}
+	return 0, nil
+}
+
 func (cit *createIndexTask) Execute(ctx context.Context) error {
 	var err error
 	cit.result, err = cit.rootCoord.CreateIndex(ctx, cit.CreateIndexRequest)
@@ -3691,6 +3904,93 @@ func (dit *dropIndexTask) PostExecute(ctx context.Context) error {
 	return nil
 }
 
+type alterIndexTask struct {
+	Condition
+	*milvuspb.AlterIndexRequest
+	ctx       context.Context
+	rootCoord types.RootCoord
+	dataCoord types.DataCoord
+	result    *commonpb.Status
+}
+
+func (ait *alterIndexTask) TraceCtx() context.Context {
+	return ait.ctx
+}
+
+func (ait *alterIndexTask) ID() UniqueID {
+	return ait.Base.MsgID
+}
+
+func (ait *alterIndexTask) SetID(uid UniqueID) {
+	ait.Base.MsgID = uid
+}
+
+func (ait *alterIndexTask) Name() string {
+	return AlterIndexTaskName
+}
+
+func (ait *alterIndexTask) Type() commonpb.MsgType {
+	return ait.Base.MsgType
+}
+
+func (ait *alterIndexTask) BeginTs() Timestamp {
+	return ait.Base.Timestamp
+}
+
+func (ait *alterIndexTask) EndTs() Timestamp {
+	return ait.Base.Timestamp
+}
+
+func (ait *alterIndexTask) SetTs(ts Timestamp) {
+	ait.Base.Timestamp = ts
+}
+
+func (ait *alterIndexTask) OnEnqueue() error {
+	ait.Base = &commonpb.MsgBase{}
+	return nil
+}
+
+func (ait *alterIndexTask) PreExecute(ctx context.Context) error {
+	// the new index params are checked and AUTOINDEX is resolved the same way as creating an index
+	cit := &createIndexTask{
+		CreateIndexRequest: &milvuspb.CreateIndexRequest{
+			Base:           ait.Base,
+			DbName:         ait.DbName,
+			CollectionName: ait.CollectionName,
+			FieldName:      ait.FieldName,
+			ExtraParams:    ait.ExtraParams,
+			IndexName:      ait.IndexName,
+		},
+		ctx:       ait.ctx,
+		rootCoord: ait.rootCoord,
+		dataCoord: ait.dataCoord,
+	}
+	if err := cit.PreExecute(ctx); err != nil {
+		return err
+	}
+	ait.ExtraParams = cit.ExtraParams
+
+	ait.Base.MsgType = commonpb.MsgType_AlterIndex
+	ait.Base.SourceID = Params.ProxyID
+	return nil
+}
+
+func (ait *alterIndexTask) Execute(ctx context.Context) error {
+	var err error
+	ait.result, err = ait.rootCoord.AlterIndex(ctx, ait.AlterIndexRequest)
+	if ait.result == nil {
+		return errors.New("alter index resp is nil")
+	}
+	if ait.result.ErrorCode != commonpb.ErrorCode_Success {
+		return errors.New(ait.result.Reason)
+	}
+	return err
+}
+
+func (ait *alterIndexTask) PostExecute(ctx context.Context) error {
+	return nil
+}
+
 type getIndexBuildProgressTask struct {
 	Condition
 	*milvuspb.GetIndexBuildProgressRequest
@@ -3867,11 +4167,9 @@ func (gibpt *getIndexBuildProgressTask) Execute(ctx context.Context) error {
 		}
 	}
 
-	buildFinishMap := make(map[int64]bool)
+	buildStateMap := make(map[int64]*indexpb.IndexInfo)
 	for _, state := range states.States {
-		if state.State == commonpb.IndexState_Finished {
-			buildFinishMap[state.IndexBuildID] = true
-		}
+		buildStateMap[state.IndexBuildID] = state
 	}
 
 	infoResp, err := gibpt.dataCoord.GetSegmentInfo(ctx, &datapb.GetSegmentInfoRequest{
@@ -3892,8 +4190,20 @@ func (gibpt *getIndexBuildProgressTask) Execute(ctx context.Context) error {
 
 	for _, info := range infoResp.Infos {
 		total += info.NumOfRows
-		if buildFinishMap[buildIndexMap[info.ID]] {
+		state, ok := buildStateMap[buildIndexMap[info.ID]]
+		if !ok {
+			continue
+		}
+		switch state.State {
+		case commonpb.IndexState_Finished:
 			indexed += info.NumOfRows
+		case commonpb.IndexState_InProgress:
+			// the IndexNode reports the rows it has indexed for a build in progress
+			if state.IndexedRows < info.NumOfRows {
+				indexed += state.IndexedRows
+			} else {
+				indexed += info.NumOfRows
+			}
 		}
 	}
 
@@ -3913,6 +4223,426 @@ func (gibpt *getIndexBuildProgressTask) PostExecute(ctx context.Context) error {
 	return nil
 }
 
+// segmentIndexBuild is the index build of a sealed segment.
+type segmentIndexBuild struct {
+	segmentID UniqueID
+	buildID   UniqueID
+}
+
+// describeSegmentIndexes returns the description of the index named indexName on the collection, and the builds of
+// the index on the segments which have the index enabled.
+func describeSegmentIndexes(ctx context.Context, rootCoord types.RootCoord, base *commonpb.MsgBase, dbName, collectionName,
+	fieldName, indexName string, collectionID UniqueID) (*milvuspb.IndexDescription, []segmentIndexBuild, error) {
+	newBase := func(msgType commonpb.MsgType) *commonpb.MsgBase {
+		return &commonpb.MsgBase{
+			MsgType:   msgType,
+			MsgID:     base.MsgID,
+			Timestamp: base.Timestamp,
+			SourceID:  Params.ProxyID,
+		}
+	}
+	if indexName == "" {
+		indexName = Params.DefaultIndexName
+	}
+	indexDescriptionResp, err := rootCoord.DescribeIndex(ctx, &milvuspb.DescribeIndexRequest{
+		Base:           newBase(commonpb.MsgType_DescribeIndex),
+		DbName:         dbName,
+		CollectionName: collectionName,
+		FieldName:      fieldName,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	if indexDescriptionResp.Status.ErrorCode != commonpb.ErrorCode_Success {
+		return nil, nil, errors.New(indexDescriptionResp.Status.Reason)
+	}
+	var index *milvuspb.IndexDescription
+	for _, desc := range indexDescriptionResp.IndexDescriptions {
+		if desc.IndexName == indexName && (fieldName == "" || desc.FieldName == fieldName) {
+			index = desc
+			break
+		}
+	}
+	if index == nil {
+		return nil, nil, fmt.Errorf("index %s not found in collection %s", indexName, collectionName)
+	}
+
+	partitions, err := rootCoord.ShowPartitions(ctx, &milvuspb.ShowPartitionsRequest{
+		Base:           newBase(commonpb.MsgType_ShowPartitions),
+		DbName:         dbName,
+		CollectionName: collectionName,
+		CollectionID:   collectionID,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	if partitions.Status.ErrorCode != commonpb.ErrorCode_Success {
+		return nil, nil, errors.New(partitions.Status.Reason)
+	}
+
+	var builds []segmentIndexBuild
+	for _, partitionID := range partitions.PartitionIDs {
+		segments, err := rootCoord.ShowSegments(ctx, &milvuspb.ShowSegmentsRequest{
+			Base:         newBase(commonpb.MsgType_ShowSegments),
+			CollectionID: collectionID,
+			PartitionID:  partitionID,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		if segments.Status.ErrorCode != commonpb.ErrorCode_Success {
+			return nil, nil, errors.New(segments.Status.Reason)
+		}
+		for _, segmentID := range segments.SegmentIDs {
+			segmentDesc, err := rootCoord.DescribeSegment(ctx, &milvuspb.DescribeSegmentRequest{
+				Base:         newBase(commonpb.MsgType_DescribeSegment),
+				CollectionID: collectionID,
+				SegmentID:    segmentID,
+			})
+			if err != nil {
+				return nil, nil, err
+			}
+			if segmentDesc.IndexID == index.IndexID && segmentDesc.EnableIndex {
+				builds = append(builds, segmentIndexBuild{segmentID: segmentID, buildID: segmentDesc.BuildID})
+			}
+		}
+	}
+	return index, builds, nil
+}
+
+type getIndexStatisticsTask struct {
+	Condition
+	*milvuspb.GetIndexStatisticsRequest
+	ctx        context.Context
+	indexCoord types.IndexCoord
+	rootCoord  types.RootCoord
+	result     *milvuspb.GetIndexStatisticsResponse
+}
+
+func (gist *getIndexStatisticsTask) TraceCtx() context.Context {
+	return gist.ctx
+}
+
+func (gist *getIndexStatisticsTask) ID() UniqueID {
+	return gist.Base.MsgID
+}
+
+func (gist *getIndexStatisticsTask) SetID(uid UniqueID) {
+	gist.Base.MsgID = uid
+}
+
+func (gist *getIndexStatisticsTask) Name() string {
+	return GetIndexStatisticsTaskName
+}
+
+func (gist *getIndexStatisticsTask) Type() commonpb.MsgType {
+	return gist.Base.MsgType
+}
+
+func (gist *getIndexStatisticsTask) BeginTs() Timestamp {
+	return gist.Base.Timestamp
+}
+
+func (gist *getIndexStatisticsTask) EndTs() Timestamp {
+	return gist.Base.Timestamp
+}
+
+func (gist *getIndexStatisticsTask) SetTs(ts Timestamp) {
+	gist.Base.Timestamp = ts
+}
+
+func (gist *getIndexStatisticsTask) OnEnqueue() error {
+	gist.Base = &commonpb.MsgBase{}
+	return nil
+}
+
+func (gist *getIndexStatisticsTask) PreExecute(ctx context.Context) error {
+	gist.Base.MsgType = commonpb.MsgType_GetIndexStatistics
+	gist.Base.SourceID = Params.ProxyID
+
+	return ValidateCollectionName(gist.CollectionName)
+}
+
+func (gist *getIndexStatisticsTask) Execute(ctx context.Context) error {
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, gist.CollectionName)
+	if err != nil { // err is not nil if collection not exists
+		return err
+	}
+	index, builds, err := describeSegmentIndexes(ctx, gist.rootCoord, gist.Base, gist.DbName, gist.CollectionName,
+		gist.FieldName, gist.IndexName, collectionID)
+	if err != nil {
+		return err
+	}
+
+	buildIDs := make([]UniqueID, 0, len(builds))
+	for _, build := range builds {
+		buildIDs = append(buildIDs, build.buildID)
+	}
+	states, err := gist.indexCoord.GetIndexStates(ctx, &indexpb.GetIndexStatesRequest{IndexBuildIDs: buildIDs})
+	if err != nil {
+		return err
+	}
+	if states.Status.ErrorCode != commonpb.ErrorCode_Success {
+		gist.result = &milvuspb.GetIndexStatisticsResponse{
+			Status: states.Status,
+		}
+		return nil
+	}
+	gist.result = &milvuspb.GetIndexStatisticsResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		Segments: segmentIndexStatistics(index, builds, states.States),
+	}
+	return nil
+}
+
+func (gist *getIndexStatisticsTask) PostExecute(ctx context.Context) error {
+	return nil
+}
+
+// segmentIndexStatistics combines the index description with the build states reported by IndexCoord.
+func segmentIndexStatistics(index *milvuspb.IndexDescription, builds []segmentIndexBuild,
+	states []*indexpb.IndexInfo) []*milvuspb.SegmentIndexStatistics {
+	buildStateMap := make(map[UniqueID]*indexpb.IndexInfo, len(states))
+	for _, state := range states {
+		buildStateMap[state.IndexBuildID] = state
+	}
+	var indexType string
+	for _, param := range index.Params {
+		if param.Key == "index_type" {
+			indexType = param.Value
+		}
+	}
+
+	ret := make([]*milvuspb.SegmentIndexStatistics, 0, len(builds))
+	for _, build := range builds {
+		stats := &milvuspb.SegmentIndexStatistics{
+			SegmentID: build.segmentID,
+			BuildID:   build.buildID,
+			IndexType: indexType,
+			Params:    index.Params,
+		}
+		if state, ok := buildStateMap[build.buildID]; ok {
+			stats.State = state.State
+			stats.IndexedRows = state.IndexedRows
+			stats.TotalRows = state.TotalRows
+			stats.IndexFileSize = state.SerializedSize
+			stats.BuildTimeMs = state.BuildTimeMs
+			stats.NodeID = state.NodeID
+			stats.FailReason = state.Reason
+		}
+		ret = append(ret, stats)
+	}
+	return ret
+}
+
+const (
+	// maxEstimateRecallNq is the max number of queries sampled from a segment to estimate the recall.
+	maxEstimateRecallNq = 10000
+	// maxEstimateRecallTopK is the max topk of the recall estimation, the same as the limit of search.
+	maxEstimateRecallTopK = 16384
+	// estimateRecallPollInterval is the interval to poll IndexCoord for the results of the recall estimation jobs.
+	estimateRecallPollInterval = time.Second
+)
+
+type estimateIndexRecallTask struct {
+	Condition
+	*milvuspb.EstimateIndexRecallRequest
+	ctx        context.Context
+	indexCoord types.IndexCoord
+	rootCoord  types.RootCoord
+	result     *milvuspb.EstimateIndexRecallResponse
+}
+
+func (eirt *estimateIndexRecallTask) TraceCtx() context.Context {
+	return eirt.ctx
+}
+
+func (eirt *estimateIndexRecallTask) ID() UniqueID {
+	return eirt.Base.MsgID
+}
+
+func (eirt *estimateIndexRecallTask) SetID(uid UniqueID) {
+	eirt.Base.MsgID = uid
+}
+
+func (eirt *estimateIndexRecallTask) Name() string {
+	return EstimateIndexRecallTaskName
+}
+
+func (eirt *estimateIndexRecallTask) Type() commonpb.MsgType {
+	return eirt.Base.MsgType
+}
+
+func (eirt *estimateIndexRecallTask) BeginTs() Timestamp {
+	return eirt.Base.Timestamp
+}
+
+func (eirt *estimateIndexRecallTask) EndTs() Timestamp {
+	return eirt.Base.Timestamp
+}
+
+func (eirt *estimateIndexRecallTask) SetTs(ts Timestamp) {
+	eirt.Base.Timestamp = ts
+}
+
+func (eirt *estimateIndexRecallTask) OnEnqueue() error {
+	eirt.Base = &commonpb.MsgBase{}
+	return nil
+}
+
+func (eirt *estimateIndexRecallTask) PreExecute(ctx context.Context) error {
+	eirt.Base.MsgType = commonpb.MsgType_EstimateIndexRecall
+	eirt.Base.SourceID = Params.ProxyID
+
+	if err := ValidateCollectionName(eirt.CollectionName); err != nil {
+		return err
+	}
+	if eirt.Nq <= 0 || eirt.Nq > maxEstimateRecallNq {
+		return fmt.Errorf("nq should be in range [1, %d], but got %d", maxEstimateRecallNq, eirt.Nq)
+	}
+	if eirt.Topk <= 0 || eirt.Topk > maxEstimateRecallTopK {
+		return fmt.Errorf("topk should be in range [1, %d], but got %d", maxEstimateRecallTopK, eirt.Topk)
+	}
+	if eirt.MaxSegments < 0 {
+		return fmt.Errorf("max_segments should not be negative, but got %d", eirt.MaxSegments)
+	}
+	return nil
+}
+
+func (eirt *estimateIndexRecallTask) Execute(ctx context.Context) error {
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, eirt.CollectionName)
+	if err != nil { // err is not nil if collection not exists
+		return err
+	}
+	_, builds, err := describeSegmentIndexes(ctx, eirt.rootCoord, eirt.Base, eirt.DbName, eirt.CollectionName,
+		eirt.FieldName, eirt.IndexName, collectionID)
+	if err != nil {
+		return err
+	}
+
+	// only the finished indexes can be searched
+	buildIDs := make([]UniqueID, 0, len(builds))
+	for _, build := range builds {
+		buildIDs = append(buildIDs, build.buildID)
+	}
+	states, err := eirt.indexCoord.GetIndexStates(ctx, &indexpb.GetIndexStatesRequest{IndexBuildIDs: buildIDs})
+	if err != nil {
+		return err
+	}
+	if states.Status.ErrorCode != commonpb.ErrorCode_Success {
+		return errors.New(states.Status.Reason)
+	}
+	finished := make(map[UniqueID]bool, len(states.States))
+	for _, state := range states.States {
+		finished[state.IndexBuildID] = state.State == commonpb.IndexState_Finished
+	}
+	var finishedBuilds []segmentIndexBuild
+	for _, build := range builds {
+		if finished[build.buildID] {
+			finishedBuilds = append(finishedBuilds, build)
+		}
+	}
+	if len(finishedBuilds) == 0 {
+		return errors.New("no segment has finished building the index")
+	}
+	if eirt.MaxSegments > 0 && int64(len(finishedBuilds)) > eirt.MaxSegments {
+		finishedBuilds = finishedBuilds[:eirt.MaxSegments]
+	}
+
+	// IndexCoord runs the estimations as jobs on IndexNodes, their results are polled until all of them are done
+	jobIDs := make([]UniqueID, 0, len(finishedBuilds))
+	for _, build := range finishedBuilds {
+		resp, err := eirt.indexCoord.EstimateRecall(ctx, &indexpb.EstimateRecallRequest{
+			IndexBuildID: build.buildID,
+			Nq:           eirt.Nq,
+			Topk:         eirt.Topk,
+			SearchParams: eirt.SearchParams,
+		})
+		if err != nil {
+			return err
+		}
+		if resp.Status.ErrorCode != commonpb.ErrorCode_Success {
+			return fmt.Errorf("estimate the recall of segment %d failed, reason: %s", build.segmentID, resp.Status.Reason)
+		}
+		jobIDs = append(jobIDs, resp.JobID)
+	}
+	recalls, err := eirt.waitRecallJobs(ctx, finishedBuilds, jobIDs)
+	if err != nil {
+		return err
+	}
+
+	eirt.result = &milvuspb.EstimateIndexRecallResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		Topk:     eirt.Topk,
+		Recall:   weightedRecall(recalls),
+		Segments: recalls,
+	}
+	return nil
+}
+
+// waitRecallJobs polls the recall estimation jobs of the builds until all of them finish, or any of them fails.
+func (eirt *estimateIndexRecallTask) waitRecallJobs(ctx context.Context, builds []segmentIndexBuild, jobIDs []UniqueID) ([]*milvuspb.SegmentIndexRecall, error) {
+	ticker := time.NewTicker(estimateRecallPollInterval)
+	defer ticker.Stop()
+	for {
+		resp, err := eirt.indexCoord.GetRecallEstimations(ctx, &indexpb.GetRecallEstimationsRequest{JobIDs: jobIDs})
+		if err != nil {
+			return nil, err
+		}
+		if resp.Status.ErrorCode != commonpb.ErrorCode_Success {
+			return nil, errors.New(resp.Status.Reason)
+		}
+		if len(resp.Results) != len(jobIDs) {
+			return nil, fmt.Errorf("expect %d recall estimation results, but got %d", len(jobIDs), len(resp.Results))
+		}
+		recalls := make([]*milvuspb.SegmentIndexRecall, 0, len(builds))
+		for idx, result := range resp.Results {
+			if result.State == commonpb.IndexState_Failed || result.Status.ErrorCode != commonpb.ErrorCode_Success {
+				return nil, fmt.Errorf("estimate the recall of segment %d failed, reason: %s", builds[idx].segmentID, result.Status.Reason)
+			}
+			if result.State != commonpb.IndexState_Finished {
+				break
+			}
+			recalls = append(recalls, &milvuspb.SegmentIndexRecall{
+				SegmentID: builds[idx].segmentID,
+				BuildID:   builds[idx].buildID,
+				Nq:        result.Nq,
+				Recall:    result.Recall,
+			})
+		}
+		if len(recalls) == len(builds) {
+			return recalls, nil
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func (eirt *estimateIndexRecallTask) PostExecute(ctx context.Context) error {
+	return nil
+}
+
+// weightedRecall returns the recall of all the queries of the segments.
+func weightedRecall(recalls []*milvuspb.SegmentIndexRecall) float32 {
+	var nq int64
+	var hits float64
+	for _, recall := range recalls {
+		nq += recall.Nq
+		hits += float64(recall.Recall) * float64(recall.Nq)
+	}
+	if nq == 0 {
+		return 0
+	}
+	return float32(hits / float64(nq))
+}
+
 type getIndexStateTask struct {
 	Condition
 	*milvuspb.GetIndexStateRequest
@@ -20,11 +20,14 @@ import (
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/msgstream"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util/distance"
+	"github.com/milvus-io/milvus/internal/util/flowgraph"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
 	"github.com/milvus-io/milvus/internal/util/uniquegenerator"
 	"github.com/stretchr/testify/assert"
@@ -2957,6 +2960,81 @@ func TestQueryTask_all(t *testing.T) {
 	wg.Wait()
 }
 
+func TestInsertTask_assignInsertMsgIDs(t *testing.T) {
+	newMsgs := func() []msgstream.TsMsg {
+		msgs := make([]msgstream.TsMsg, 0)
+		for _, shard := range []string{"shard-0", "shard-0", "shard-1"} {
+			msgs = append(msgs, &msgstream.InsertMsg{
+				BaseMsg: msgstream.BaseMsg{
+					HashValues: []uint32{0},
+				},
+				InsertRequest: internalpb.InsertRequest{
+					Base: &commonpb.MsgBase{
+						MsgType:  commonpb.MsgType_Insert,
+						SourceID: 1,
+					},
+					CollectionID: 1,
+					ShardName:    shard,
+				},
+			})
+		}
+		return msgs
+	}
+	alloc := func(count uint32) (UniqueID, UniqueID, error) {
+		return 100, 100 + UniqueID(count), nil
+	}
+
+	t.Run("allocate without idempotency key", func(t *testing.T) {
+		msgs := newMsgs()
+		err := assignInsertMsgIDs(msgs, 0, alloc)
+		assert.NoError(t, err)
+		for i, msg := range msgs {
+			assert.Equal(t, UniqueID(100+i), msg.ID())
+			assert.Equal(t, int64(1), msg.(*msgstream.InsertMsg).Base.SourceID)
+		}
+
+		err = assignInsertMsgIDs(newMsgs(), 0, func(count uint32) (UniqueID, UniqueID, error) {
+			return 0, 0, errors.New("mock")
+		})
+		assert.Error(t, err)
+	})
+
+	t.Run("retried insert is deduplicated", func(t *testing.T) {
+		first, retried := newMsgs(), newMsgs()
+		assert.NoError(t, assignInsertMsgIDs(first, 10, alloc))
+		assert.NoError(t, assignInsertMsgIDs(retried, 10, alloc))
+
+		ids := make(map[UniqueID]struct{})
+		for i := range first {
+			assert.NotZero(t, first[i].ID())
+			assert.Equal(t, first[i].ID(), retried[i].ID())
+			assert.Equal(t, int64(idempotentInsertSourceID), retried[i].(*msgstream.InsertMsg).Base.SourceID)
+			ids[first[i].ID()] = struct{}{}
+		}
+		assert.Equal(t, len(first), len(ids))
+
+		// another request gets other ids
+		other := newMsgs()
+		assert.NoError(t, assignInsertMsgIDs(other, 11, alloc))
+		for i := range other {
+			assert.NotEqual(t, first[i].ID(), other[i].ID())
+		}
+
+		stream := newSimpleMockMsgStream()
+		inputNode := flowgraph.NewInputNode(stream, "input_node", 1024, 1)
+		inputNode.SetDedupWindow(1024)
+		consumed := func(msgs []msgstream.TsMsg) int {
+			assert.NoError(t, stream.Produce(&msgstream.MsgPack{Msgs: msgs}))
+			out := inputNode.Operate(nil)
+			assert.Equal(t, 1, len(out))
+			return len(out[0].(*flowgraph.MsgStreamMsg).TsMessages())
+		}
+		assert.Equal(t, len(first), consumed(first))
+		assert.Equal(t, 0, consumed(retried))
+		assert.Equal(t, len(other), consumed(other))
+	})
+}
+
 func TestInsertTask_all(t *testing.T) {
 	var err error
 
@@ -3444,3 +3522,229 @@ func TestAlterAlias_all(t *testing.T) {
 	assert.NoError(t, task.Execute(ctx))
 	assert.NoError(t, task.PostExecute(ctx))
 }
+
+type describeIndexRootCoord struct {
+	types.RootCoord
+	params []*commonpb.KeyValuePair
+	calls  int
+}
+
+func (coord *describeIndexRootCoord) DescribeIndex(ctx context.Context, req *milvuspb.DescribeIndexRequest) (*milvuspb.DescribeIndexResponse, error) {
+	coord.calls++
+	if coord.params == nil {
+		return &milvuspb.DescribeIndexResponse{
+			Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_UnexpectedError, Reason: "index not exist"},
+		}, nil
+	}
+	return &milvuspb.DescribeIndexResponse{
+		Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		IndexDescriptions: []*milvuspb.IndexDescription{
+			{IndexName: req.IndexName, FieldName: "int64", Params: []*commonpb.KeyValuePair{{Key: "index_type", Value: "SORTED"}}},
+			{IndexName: req.IndexName, FieldName: req.FieldName, Params: coord.params},
+		},
+	}, nil
+}
+
+func TestSearchTask_resolveSearchLevel(t *testing.T) {
+	Params.Init()
+	ctx := context.Background()
+
+	rc := &describeIndexRootCoord{
+		params: []*commonpb.KeyValuePair{
+			{Key: "index_type", Value: "IVF_FLAT"},
+			{Key: "params", Value: `{"nlist": 1024}`},
+		},
+	}
+	cache, err := NewMetaCache(rc)
+	assert.NoError(t, err)
+	oldCache := globalMetaCache
+	globalMetaCache = cache
+	defer func() { globalMetaCache = oldCache }()
+	task := &searchTask{
+		query: &milvuspb.SearchRequest{},
+	}
+
+	// no level
+	searchParams, err := task.resolveSearchLevel(ctx, "coll", "vec", 10, `{"nprobe": 10}`)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"nprobe": 10}`, searchParams)
+
+	searchParams, err = task.resolveSearchLevel(ctx, "coll", "vec", 10, `{"level": 2}`)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"nprobe":32}`, searchParams)
+
+	// explicit params win
+	searchParams, err = task.resolveSearchLevel(ctx, "coll", "vec", 10, `{"level": 2, "nprobe": 5}`)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"nprobe":5}`, searchParams)
+
+	_, err = task.resolveSearchLevel(ctx, "coll", "vec", 10, `{"level": 1.5}`)
+	assert.Error(t, err)
+	_, err = task.resolveSearchLevel(ctx, "coll", "vec", 10, `{"level": 10}`)
+	assert.Error(t, err)
+
+	// the index is described once
+	assert.Equal(t, 1, rc.calls)
+
+	// the cached params are dropped with the collection
+	rc.params = []*commonpb.KeyValuePair{{Key: "index_type", Value: "HNSW"}}
+	globalMetaCache.RemoveCollection(ctx, "coll")
+	searchParams, err = task.resolveSearchLevel(ctx, "coll", "vec", 100, `{"level": 1}`)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"ef":100}`, searchParams)
+
+	rc.params = nil
+	globalMetaCache.RemoveCollection(ctx, "coll")
+	_, err = task.resolveSearchLevel(ctx, "coll", "vec", 10, `{"level": 1}`)
+	assert.Error(t, err)
+}
+
+type segmentIndexRootCoord struct {
+	types.RootCoord
+	segments map[UniqueID][]UniqueID
+	indexIDs map[UniqueID]UniqueID
+}
+
+func (coord *segmentIndexRootCoord) DescribeIndex(ctx context.Context, req *milvuspb.DescribeIndexRequest) (*milvuspb.DescribeIndexResponse, error) {
+	return &milvuspb.DescribeIndexResponse{
+		Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		IndexDescriptions: []*milvuspb.IndexDescription{
+			{IndexName: "idx", IndexID: 1, FieldName: req.FieldName, Params: []*commonpb.KeyValuePair{{Key: "index_type", Value: "IVF_FLAT"}}},
+		},
+	}, nil
+}
+
+func (coord *segmentIndexRootCoord) ShowPartitions(ctx context.Context, req *milvuspb.ShowPartitionsRequest) (*milvuspb.ShowPartitionsResponse, error) {
+	resp := &milvuspb.ShowPartitionsResponse{Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}}
+	for partitionID := range coord.segments {
+		resp.PartitionIDs = append(resp.PartitionIDs, partitionID)
+	}
+	return resp, nil
+}
+
+func (coord *segmentIndexRootCoord) ShowSegments(ctx context.Context, req *milvuspb.ShowSegmentsRequest) (*milvuspb.ShowSegmentsResponse, error) {
+	return &milvuspb.ShowSegmentsResponse{
+		Status:     &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		SegmentIDs: coord.segments[req.PartitionID],
+	}, nil
+}
+
+func (coord *segmentIndexRootCoord) DescribeSegment(ctx context.Context, req *milvuspb.DescribeSegmentRequest) (*milvuspb.DescribeSegmentResponse, error) {
+	indexID, ok := coord.indexIDs[req.SegmentID]
+	return &milvuspb.DescribeSegmentResponse{
+		Status:      &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		IndexID:     indexID,
+		BuildID:     req.SegmentID * 10,
+		EnableIndex: ok,
+	}, nil
+}
+
+func TestDescribeSegmentIndexes(t *testing.T) {
+	Params.Init()
+	ctx := context.Background()
+	rootCoord := &segmentIndexRootCoord{
+		segments: map[UniqueID][]UniqueID{100: {1, 2, 3}},
+		indexIDs: map[UniqueID]UniqueID{1: 1, 3: 2},
+	}
+
+	index, builds, err := describeSegmentIndexes(ctx, rootCoord, &commonpb.MsgBase{}, "", "coll", "vec", "idx", 1)
+	assert.NoError(t, err)
+	assert.Equal(t, UniqueID(1), index.IndexID)
+	assert.Equal(t, []segmentIndexBuild{{segmentID: 1, buildID: 10}}, builds)
+
+	_, _, err = describeSegmentIndexes(ctx, rootCoord, &commonpb.MsgBase{}, "", "coll", "vec", "not_exist", 1)
+	assert.Error(t, err)
+}
+
+func TestSegmentIndexStatistics(t *testing.T) {
+	index := &milvuspb.IndexDescription{
+		IndexName: "idx",
+		Params:    []*commonpb.KeyValuePair{{Key: "index_type", Value: "HNSW"}, {Key: "M", Value: "16"}},
+	}
+	builds := []segmentIndexBuild{{segmentID: 1, buildID: 10}, {segmentID: 2, buildID: 20}}
+	states := []*indexpb.IndexInfo{
+		{
+			IndexBuildID:   10,
+			State:          commonpb.IndexState_Finished,
+			IndexedRows:    100,
+			TotalRows:      100,
+			NodeID:         7,
+			SerializedSize: 4096,
+			BuildTimeMs:    15,
+		},
+	}
+
+	stats := segmentIndexStatistics(index, builds, states)
+	assert.Equal(t, 2, len(stats))
+	assert.Equal(t, UniqueID(1), stats[0].SegmentID)
+	assert.Equal(t, "HNSW", stats[0].IndexType)
+	assert.Equal(t, commonpb.IndexState_Finished, stats[0].State)
+	assert.Equal(t, int64(4096), stats[0].IndexFileSize)
+	assert.Equal(t, int64(15), stats[0].BuildTimeMs)
+	assert.Equal(t, int64(7), stats[0].NodeID)
+	assert.Equal(t, 2, len(stats[0].Params))
+	// the state of the build is unknown to IndexCoord
+	assert.Equal(t, UniqueID(20), stats[1].BuildID)
+	assert.Equal(t, commonpb.IndexState_IndexStateNone, stats[1].State)
+}
+
+func TestWeightedRecall(t *testing.T) {
+	assert.Equal(t, float32(0), weightedRecall(nil))
+	recalls := []*milvuspb.SegmentIndexRecall{
+		{SegmentID: 1, Nq: 30, Recall: 1},
+		{SegmentID: 2, Nq: 10, Recall: 0.6},
+	}
+	assert.InDelta(t, 0.9, weightedRecall(recalls), 1e-6)
+}
+
+func TestEstimateIndexRecallTask_waitRecallJobs(t *testing.T) {
+	ctx := context.Background()
+	task := &estimateIndexRecallTask{
+		ctx:        ctx,
+		Condition:  NewTaskCondition(ctx),
+		indexCoord: NewIndexCoordMock(),
+	}
+	builds := []segmentIndexBuild{{segmentID: 1, buildID: 10}, {segmentID: 2, buildID: 20}}
+	recalls, err := task.waitRecallJobs(ctx, builds, []UniqueID{10, 20})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(recalls))
+	assert.Equal(t, UniqueID(2), recalls[1].SegmentID)
+	assert.Equal(t, UniqueID(20), recalls[1].BuildID)
+	assert.Equal(t, float32(1), recalls[1].Recall)
+
+	cancelCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	_, err = task.waitRecallJobs(cancelCtx, builds, []UniqueID{10, 20})
+	assert.NoError(t, err)
+}
+
+func TestEstimateIndexRecallTask_PreExecute(t *testing.T) {
+	Params.Init()
+	ctx := context.Background()
+	task := &estimateIndexRecallTask{
+		ctx:       ctx,
+		Condition: NewTaskCondition(ctx),
+		EstimateIndexRecallRequest: &milvuspb.EstimateIndexRecallRequest{
+			CollectionName: "coll",
+			Nq:             10,
+			Topk:           10,
+		},
+	}
+	assert.NoError(t, task.OnEnqueue())
+	assert.Equal(t, EstimateIndexRecallTaskName, task.Name())
+	assert.NoError(t, task.PreExecute(ctx))
+	assert.Equal(t, commonpb.MsgType_EstimateIndexRecall, task.Type())
+
+	task.Nq = 0
+	assert.Error(t, task.PreExecute(ctx))
+	task.Nq = maxEstimateRecallNq + 1
+	assert.Error(t, task.PreExecute(ctx))
+
+	task.Nq = 10
+	task.Topk = 0
+	assert.Error(t, task.PreExecute(ctx))
+
+	task.Topk = 10
+	task.MaxSegments = -1
+	assert.Error(t, task.PreExecute(ctx))
+}
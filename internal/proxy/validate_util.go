@@ -17,6 +17,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 )
@@ -139,6 +140,37 @@ func ValidateFieldName(fieldName string) error {
 	return nil
 }
 
+// ValidateIndexName checks the index name follows the naming rule of field names.
+func ValidateIndexName(indexName string) error {
+	indexName = strings.TrimSpace(indexName)
+
+	if indexName == "" {
+		return errors.New("Index name should not be empty")
+	}
+
+	invalidMsg := "Invalid index name: " + indexName + ". "
+	if int64(len(indexName)) > Params.MaxNameLength {
+		msg := invalidMsg + "The length of an index name must be less than " +
+			strconv.FormatInt(Params.MaxNameLength, 10) + " characters."
+		return errors.New(msg)
+	}
+
+	firstChar := indexName[0]
+	if firstChar != '_' && !isAlpha(firstChar) {
+		msg := invalidMsg + "The first character of an index name must be an underscore or letter."
+		return errors.New(msg)
+	}
+
+	for i := 1; i < len(indexName); i++ {
+		c := indexName[i]
+		if c != '_' && !isAlpha(c) && !isNumber(c) {
+			msg := invalidMsg + "Index name can only contain numbers, letters, and underscores."
+			return errors.New(msg)
+		}
+	}
+	return nil
+}
+
 func ValidateDimension(dim int64, isBinary bool) error {
 	if dim <= 0 || dim > Params.MaxDimension {
 		return fmt.Errorf("invalid dimension: %d. should be in range 1 ~ %d", dim, Params.MaxDimension)
@@ -336,3 +368,71 @@ func ValidateSchema(coll *schemapb.CollectionSchema) error {
 
 	return nil
 }
+
+// validateIdentifier checks the name is non-empty, starts with a letter, contains only
+// letters, numbers and underscores, and is no longer than common.MaxUsernameLength.
+func validateIdentifier(entity, entityType string) error {
+	entity = strings.TrimSpace(entity)
+
+	if entity == "" {
+		return fmt.Errorf("%s should not be empty", entityType)
+	}
+
+	invalidMsg := fmt.Sprintf("Invalid %s: %s. ", entityType, entity)
+	if len(entity) > common.MaxUsernameLength {
+		msg := invalidMsg + fmt.Sprintf("The length of %s must be less than ", entityType) +
+			strconv.Itoa(common.MaxUsernameLength) + " characters."
+		return errors.New(msg)
+	}
+
+	firstChar := entity[0]
+	if !isAlpha(firstChar) {
+		msg := invalidMsg + fmt.Sprintf("The first character of %s must be a letter.", entityType)
+		return errors.New(msg)
+	}
+
+	for i := 1; i < len(entity); i++ {
+		c := entity[i]
+		if c != '_' && !isAlpha(c) && !isNumber(c) {
+			msg := invalidMsg + fmt.Sprintf("The %s should only contain numbers, letters, and underscores.", entityType)
+			return errors.New(msg)
+		}
+	}
+	return nil
+}
+
+// ValidateUsername checks the username follows the naming rule of validateIdentifier.
+func ValidateUsername(username string) error {
+	return validateIdentifier(username, "username")
+}
+
+// ValidateRoleName checks the role name follows the naming rule of validateIdentifier.
+func ValidateRoleName(roleName string) error {
+	return validateIdentifier(roleName, "role name")
+}
+
+// ValidateObjectType checks the object type is a name of commonpb.ObjectType.
+func ValidateObjectType(objectType string) error {
+	if _, ok := commonpb.ObjectType_value[objectType]; !ok {
+		return fmt.Errorf("invalid object type: %s", objectType)
+	}
+	return nil
+}
+
+// ValidatePrivilege checks the privilege is a name of commonpb.ObjectPrivilege.
+func ValidatePrivilege(privilege string) error {
+	if _, ok := commonpb.ObjectPrivilege_value[privilege]; !ok {
+		return fmt.Errorf("invalid privilege: %s", privilege)
+	}
+	return nil
+}
+
+// ValidatePassword checks the length of password is within [common.MinPasswordLength, common.MaxPasswordLength].
+func ValidatePassword(password string) error {
+	if len(password) < common.MinPasswordLength || len(password) > common.MaxPasswordLength {
+		msg := "The length of password must be great than " + strconv.Itoa(common.MinPasswordLength) +
+			" and less than " + strconv.Itoa(common.MaxPasswordLength) + " characters."
+		return errors.New(msg)
+	}
+	return nil
+}
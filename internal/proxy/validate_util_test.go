@@ -12,6 +12,7 @@
 package proxy
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
@@ -95,6 +96,29 @@ func TestValidateFieldName(t *testing.T) {
 	}
 }
 
+func TestValidateIndexName(t *testing.T) {
+	assert.Nil(t, ValidateIndexName("ivf_flat"))
+	assert.Nil(t, ValidateIndexName("_default_idx"))
+
+	longName := make([]byte, 256)
+	for i := 0; i < len(longName); i++ {
+		longName[i] = 'a'
+	}
+	invalidNames := []string{
+		"123abc",
+		"$abc",
+		"_12 ac",
+		" ",
+		"",
+		string(longName),
+		"中文",
+	}
+
+	for _, name := range invalidNames {
+		assert.NotNil(t, ValidateIndexName(name))
+	}
+}
+
 func TestValidateDimension(t *testing.T) {
 	assert.Nil(t, ValidateDimension(1, false))
 	assert.Nil(t, ValidateDimension(Params.MaxDimension, false))
@@ -367,3 +391,50 @@ func TestValidateSchema(t *testing.T) {
 	pf3.IndexParams = ip3Good
 	assert.Nil(t, ValidateSchema(coll))
 }
+
+func TestValidateUsername(t *testing.T) {
+	// only spaces
+	res := ValidateUsername(" ")
+	assert.Error(t, res)
+	// starts with non-alphabet
+	res = ValidateUsername("1abc")
+	assert.Error(t, res)
+	// length gt 32
+	res = ValidateUsername("aaaaaaaaaabbbbbbbbbbccccccccccddddd")
+	assert.Error(t, res)
+	// illegal character which not alphabet, _, or number
+	res = ValidateUsername("a1^7*).,")
+	assert.Error(t, res)
+	// normal username that only contains alphabet, _, and number
+	res = ValidateUsername("a17_good")
+	assert.Nil(t, res)
+}
+
+func TestValidatePassword(t *testing.T) {
+	// only spaces
+	res := ValidatePassword("")
+	assert.NotNil(t, res)
+	// length less than 6
+	res = ValidatePassword("12345")
+	assert.NotNil(t, res)
+	// length gt 256
+	res = ValidatePassword(strings.Repeat("a", 257))
+	assert.NotNil(t, res)
+	// normal password
+	res = ValidatePassword("abc123")
+	assert.Nil(t, res)
+}
+
+func TestValidateRoleName(t *testing.T) {
+	assert.NotNil(t, ValidateRoleName(""))
+	assert.NotNil(t, ValidateRoleName("1role"))
+	assert.NotNil(t, ValidateRoleName("role-1"))
+	assert.Nil(t, ValidateRoleName("role_1"))
+}
+
+func TestValidateObjectTypeAndPrivilege(t *testing.T) {
+	assert.Nil(t, ValidateObjectType(commonpb.ObjectType_Collection.String()))
+	assert.NotNil(t, ValidateObjectType("Table"))
+	assert.Nil(t, ValidatePrivilege(commonpb.ObjectPrivilege_PrivilegeSearch.String()))
+	assert.NotNil(t, ValidatePrivilege("Search"))
+}
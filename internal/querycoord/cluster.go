@@ -23,7 +23,7 @@ import (
 	"github.com/golang/protobuf/proto"
 	"go.uber.org/zap"
 
-	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
+	"github.com/milvus-io/milvus/internal/kv"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
@@ -44,6 +44,7 @@ type Cluster interface {
 
 	loadSegments(ctx context.Context, nodeID int64, in *querypb.LoadSegmentsRequest) error
 	releaseSegments(ctx context.Context, nodeID int64, in *querypb.ReleaseSegmentsRequest) error
+	swapSegmentIndex(ctx context.Context, nodeID int64, in *querypb.SwapSegmentIndexRequest) error
 	getNumSegments(nodeID int64) (int, error)
 
 	watchDmChannels(ctx context.Context, nodeID int64, in *querypb.WatchDmChannelsRequest) error
@@ -71,7 +72,7 @@ type Cluster interface {
 	getMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest) []queryNodeGetMetricsResponse
 }
 
-type newQueryNodeFn func(ctx context.Context, address string, id UniqueID, kv *etcdkv.EtcdKV) (Node, error)
+type newQueryNodeFn func(ctx context.Context, address string, id UniqueID, kv kv.MetaKv) (Node, error)
 
 type nodeState int
 
@@ -84,7 +85,7 @@ const (
 type queryNodeCluster struct {
 	ctx    context.Context
 	cancel context.CancelFunc
-	client *etcdkv.EtcdKV
+	client kv.MetaKv
 
 	session        *sessionutil.Session
 	sessionVersion int64
@@ -95,7 +96,7 @@ type queryNodeCluster struct {
 	newNodeFn   newQueryNodeFn
 }
 
-func newQueryNodeCluster(ctx context.Context, clusterMeta Meta, kv *etcdkv.EtcdKV, newNodeFn newQueryNodeFn, session *sessionutil.Session) (*queryNodeCluster, error) {
+func newQueryNodeCluster(ctx context.Context, clusterMeta Meta, kv kv.MetaKv, newNodeFn newQueryNodeFn, session *sessionutil.Session) (*queryNodeCluster, error) {
 	childCtx, cancel := context.WithCancel(ctx)
 	nodes := make(map[int64]Node)
 	c := &queryNodeCluster{
@@ -277,6 +278,22 @@ func (c *queryNodeCluster) releaseSegments(ctx context.Context, nodeID int64, in
 	return errors.New("ReleaseSegments: Can't find query node by nodeID ")
 }
 
+func (c *queryNodeCluster) swapSegmentIndex(ctx context.Context, nodeID int64, in *querypb.SwapSegmentIndexRequest) error {
+	c.RLock()
+	node, ok := c.nodes[nodeID]
+	c.RUnlock()
+	if !ok {
+		return errors.New("SwapSegmentIndex: Can't find query node by nodeID ")
+	}
+
+	err := node.swapSegmentIndex(ctx, in)
+	if err != nil {
+		log.Debug("SwapSegmentIndex: queryNode swap segment index error", zap.Int64("nodeID", nodeID), zap.Int64("segmentID", in.SegmentID), zap.String("error info", err.Error()))
+		return err
+	}
+	return nil
+}
+
 func (c *queryNodeCluster) watchDmChannels(ctx context.Context, nodeID int64, in *querypb.WatchDmChannelsRequest) error {
 	c.Lock()
 	defer c.Unlock()
@@ -0,0 +1,209 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package querycoord
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+)
+
+// indexSwapLoop switches the loaded segments to the indexes rebuilt by AlterIndex, segment by segment,
+// and drops the replaced index once no loaded segment uses it, so that its files are recycled by index coord.
+// The replaced indexes of the collections not loaded are dropped at once since no query node uses them.
+func (qc *QueryCoord) indexSwapLoop() {
+	ctx, cancel := context.WithCancel(qc.loopCtx)
+	defer cancel()
+	defer qc.loopWg.Done()
+	log.Debug("query coordinator start index swap loop", zap.Duration("interval", Params.IndexSwapInterval))
+
+	ticker := time.NewTicker(Params.IndexSwapInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			for _, info := range qc.meta.showCollections() {
+				if err := qc.swapRebuiltIndexes(ctx, info); err != nil {
+					log.Debug("swap rebuilt indexes failed", zap.Int64("collectionID", info.CollectionID), zap.Error(err))
+				}
+			}
+			if err := qc.dropUnloadedReplacedIndexes(ctx); err != nil {
+				log.Debug("drop replaced indexes of unloaded collections failed", zap.Error(err))
+			}
+		}
+	}
+}
+
+// swapRebuiltIndexes switches the loaded segments of the collection to the rebuilt indexes,
+// the segments whose rebuilt index isn't ready yet are retried in the next round
+func (qc *QueryCoord) swapRebuiltIndexes(ctx context.Context, info *querypb.CollectionInfo) error {
+	if info.Schema == nil {
+		return nil
+	}
+	descs, err := qc.describeIndexes(ctx, info.Schema.Name)
+	if err != nil {
+		return err
+	}
+
+	indexNames := make(map[UniqueID]string)
+	for _, desc := range descs {
+		indexNames[desc.IndexID] = desc.IndexName
+	}
+	for _, desc := range descs {
+		if desc.ReplacedIndexID == 0 {
+			continue
+		}
+		replacedName, ok := indexNames[desc.ReplacedIndexID]
+		if !ok {
+			continue
+		}
+		var fieldID UniqueID
+		for _, field := range info.Schema.Fields {
+			if field.Name == desc.FieldName {
+				fieldID = field.FieldID
+			}
+		}
+		if fieldID == 0 {
+			return fmt.Errorf("field %s not found in collection %d", desc.FieldName, info.CollectionID)
+		}
+
+		swapped := true
+		for _, segment := range qc.meta.showSegmentInfos(info.CollectionID, nil) {
+			err := qc.cluster.swapSegmentIndex(ctx, segment.NodeID, &querypb.SwapSegmentIndexRequest{
+				Base: &commonpb.MsgBase{
+					MsgType: commonpb.MsgType_LoadIndex,
+				},
+				NodeID:          segment.NodeID,
+				CollectionID:    info.CollectionID,
+				SegmentID:       segment.SegmentID,
+				FieldID:         fieldID,
+				IndexName:       desc.IndexName,
+				IndexID:         desc.IndexID,
+				ReplacedIndexID: desc.ReplacedIndexID,
+			})
+			if err != nil {
+				swapped = false
+			}
+		}
+		if !swapped {
+			continue
+		}
+
+		// no loaded segment uses the replaced index any more
+		if err := qc.dropIndex(ctx, info.Schema.Name, desc.FieldName, replacedName); err != nil {
+			return err
+		}
+		log.Debug("rebuilt index swapped",
+			zap.Int64("collectionID", info.CollectionID),
+			zap.String("field", desc.FieldName),
+			zap.Int64("indexID", desc.IndexID),
+			zap.Int64("replacedIndexID", desc.ReplacedIndexID))
+	}
+	return nil
+}
+
+// dropUnloadedReplacedIndexes drops the indexes replaced by the rebuilt ones of the collections not loaded. A segment
+// loaded later falls back to the replaced index only until the rebuilt one is ready, so it isn't kept for them.
+func (qc *QueryCoord) dropUnloadedReplacedIndexes(ctx context.Context) error {
+	resp, err := qc.rootCoordClient.ShowCollections(ctx, &milvuspb.ShowCollectionsRequest{
+		Base: &commonpb.MsgBase{
+			MsgType: commonpb.MsgType_ShowCollections,
+		},
+	})
+	if err != nil {
+		return err
+	}
+	if resp.Status.ErrorCode != commonpb.ErrorCode_Success {
+		return errors.New(resp.Status.Reason)
+	}
+	if len(resp.CollectionIds) != len(resp.CollectionNames) {
+		return fmt.Errorf("%d collection ids mismatch %d collection names", len(resp.CollectionIds), len(resp.CollectionNames))
+	}
+
+	for i, collectionID := range resp.CollectionIds {
+		if qc.meta.hasCollection(collectionID) {
+			continue
+		}
+		collectionName := resp.CollectionNames[i]
+		descs, err := qc.describeIndexes(ctx, collectionName)
+		if err != nil {
+			return err
+		}
+		indexNames := make(map[UniqueID]string)
+		for _, desc := range descs {
+			indexNames[desc.IndexID] = desc.IndexName
+		}
+		for _, desc := range descs {
+			replacedName, ok := indexNames[desc.ReplacedIndexID]
+			if desc.ReplacedIndexID == 0 || !ok {
+				continue
+			}
+			if err := qc.dropIndex(ctx, collectionName, desc.FieldName, replacedName); err != nil {
+				return err
+			}
+			log.Debug("replaced index of unloaded collection dropped",
+				zap.Int64("collectionID", collectionID),
+				zap.String("field", desc.FieldName),
+				zap.Int64("indexID", desc.IndexID),
+				zap.Int64("replacedIndexID", desc.ReplacedIndexID))
+		}
+	}
+	return nil
+}
+
+// describeIndexes returns the indexes of the collection, nil if it has no index
+func (qc *QueryCoord) describeIndexes(ctx context.Context, collectionName string) ([]*milvuspb.IndexDescription, error) {
+	resp, err := qc.rootCoordClient.DescribeIndex(ctx, &milvuspb.DescribeIndexRequest{
+		Base: &commonpb.MsgBase{
+			MsgType: commonpb.MsgType_DescribeIndex,
+		},
+		CollectionName: collectionName,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.Status.ErrorCode == commonpb.ErrorCode_IndexNotExist {
+		return nil, nil
+	}
+	if resp.Status.ErrorCode != commonpb.ErrorCode_Success {
+		return nil, errors.New(resp.Status.Reason)
+	}
+	return resp.IndexDescriptions, nil
+}
+
+func (qc *QueryCoord) dropIndex(ctx context.Context, collectionName string, fieldName string, indexName string) error {
+	status, err := qc.rootCoordClient.DropIndex(ctx, &milvuspb.DropIndexRequest{
+		Base: &commonpb.MsgBase{
+			MsgType: commonpb.MsgType_DropIndex,
+		},
+		CollectionName: collectionName,
+		FieldName:      fieldName,
+		IndexName:      indexName,
+	})
+	if err != nil {
+		return err
+	}
+	if status.ErrorCode != commonpb.ErrorCode_Success {
+		return errors.New(status.Reason)
+	}
+	return nil
+}
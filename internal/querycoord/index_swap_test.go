@@ -0,0 +1,132 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package querycoord
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+)
+
+type swapIndexCluster struct {
+	Cluster
+	sync.Mutex
+	notReady map[UniqueID]bool
+	swapped  map[UniqueID]UniqueID
+}
+
+func (c *swapIndexCluster) swapSegmentIndex(ctx context.Context, nodeID int64, in *querypb.SwapSegmentIndexRequest) error {
+	c.Lock()
+	defer c.Unlock()
+	if c.notReady[in.SegmentID] {
+		return errors.New("index not built on segment")
+	}
+	c.swapped[in.SegmentID] = in.IndexID
+	return nil
+}
+
+func TestSwapRebuiltIndexes(t *testing.T) {
+	collectionInfo := &querypb.CollectionInfo{
+		CollectionID: defaultCollectionID,
+		Schema: &schemapb.CollectionSchema{
+			Name: "test_swap_index",
+			Fields: []*schemapb.FieldSchema{
+				{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64},
+				{FieldID: 101, Name: "vec", DataType: schemapb.DataType_FloatVector},
+			},
+		},
+	}
+	meta := &MetaReplica{
+		collectionInfos: map[UniqueID]*querypb.CollectionInfo{defaultCollectionID: collectionInfo},
+		segmentInfos: map[UniqueID]*querypb.SegmentInfo{
+			1: {SegmentID: 1, CollectionID: defaultCollectionID, NodeID: 1},
+			2: {SegmentID: 2, CollectionID: defaultCollectionID, NodeID: 2},
+		},
+	}
+	cluster := &swapIndexCluster{
+		notReady: map[UniqueID]bool{2: true},
+		swapped:  make(map[UniqueID]UniqueID),
+	}
+	rootCoord := newRootCoordMock()
+	rootCoord.indexes = []*milvuspb.IndexDescription{
+		{IndexName: "_default_idx", IndexID: 11, FieldName: "vec", ReplacedIndexID: 10},
+		{IndexName: "_default_idx_bak", IndexID: 10, FieldName: "vec"},
+	}
+	qc := &QueryCoord{
+		meta:            meta,
+		cluster:         cluster,
+		rootCoordClient: rootCoord,
+	}
+
+	ctx := context.Background()
+	err := qc.swapRebuiltIndexes(ctx, collectionInfo)
+	assert.Nil(t, err)
+	assert.Equal(t, map[UniqueID]UniqueID{1: 11}, cluster.swapped)
+	// segment 2 still uses the replaced index
+	assert.Equal(t, 2, len(rootCoord.indexes))
+
+	cluster.notReady[2] = false
+	err = qc.swapRebuiltIndexes(ctx, collectionInfo)
+	assert.Nil(t, err)
+	assert.Equal(t, map[UniqueID]UniqueID{1: 11, 2: 11}, cluster.swapped)
+	assert.Equal(t, 1, len(rootCoord.indexes))
+	assert.Equal(t, "_default_idx", rootCoord.indexes[0].IndexName)
+
+	// nothing to swap once the replaced index is dropped
+	cluster.swapped = make(map[UniqueID]UniqueID)
+	err = qc.swapRebuiltIndexes(ctx, collectionInfo)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(cluster.swapped))
+}
+
+func TestDropUnloadedReplacedIndexes(t *testing.T) {
+	meta := &MetaReplica{
+		collectionInfos: map[UniqueID]*querypb.CollectionInfo{
+			defaultCollectionID: {CollectionID: defaultCollectionID},
+		},
+	}
+	rootCoord := newRootCoordMock()
+	rootCoord.CollectionIDs = []UniqueID{defaultCollectionID}
+	rootCoord.indexes = []*milvuspb.IndexDescription{
+		{IndexName: "_default_idx", IndexID: 11, FieldName: "vec", ReplacedIndexID: 10},
+		{IndexName: "_default_idx_bak", IndexID: 10, FieldName: "vec"},
+	}
+	qc := &QueryCoord{
+		meta:            meta,
+		rootCoordClient: rootCoord,
+	}
+
+	// the replaced index is kept for the loaded collection
+	ctx := context.Background()
+	err := qc.dropUnloadedReplacedIndexes(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(rootCoord.indexes))
+
+	// the collection is released
+	meta.collectionInfos = make(map[UniqueID]*querypb.CollectionInfo)
+	err = qc.dropUnloadedReplacedIndexes(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(rootCoord.indexes))
+	assert.Equal(t, "_default_idx", rootCoord.indexes[0].IndexName)
+
+	// nothing to drop
+	err = qc.dropUnloadedReplacedIndexes(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(rootCoord.indexes))
+}
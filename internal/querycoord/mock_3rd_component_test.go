@@ -195,6 +195,7 @@ type rootCoordMock struct {
 	types.RootCoord
 	CollectionIDs []UniqueID
 	Col2partition map[UniqueID][]UniqueID
+	indexes       []*milvuspb.IndexDescription
 	sync.RWMutex
 }
 
@@ -258,10 +259,60 @@ func (rc *rootCoordMock) ReleaseDQLMessageStream(ctx context.Context, in *proxyp
 	}, nil
 }
 
+func (rc *rootCoordMock) ShowCollections(ctx context.Context, in *milvuspb.ShowCollectionsRequest) (*milvuspb.ShowCollectionsResponse, error) {
+	rc.RLock()
+	defer rc.RUnlock()
+	names := make([]string, 0, len(rc.CollectionIDs))
+	for _, collectionID := range rc.CollectionIDs {
+		names = append(names, fmt.Sprintf("collection_%d", collectionID))
+	}
+	return &milvuspb.ShowCollectionsResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		CollectionIds:   append([]UniqueID{}, rc.CollectionIDs...),
+		CollectionNames: names,
+	}, nil
+}
+
 func (rc *rootCoordMock) DescribeSegment(ctx context.Context, req *milvuspb.DescribeSegmentRequest) (*milvuspb.DescribeSegmentResponse, error) {
 	return nil, errors.New("describeSegment fail")
 }
 
+func (rc *rootCoordMock) DescribeIndex(ctx context.Context, req *milvuspb.DescribeIndexRequest) (*milvuspb.DescribeIndexResponse, error) {
+	rc.RLock()
+	defer rc.RUnlock()
+	if len(rc.indexes) == 0 {
+		return &milvuspb.DescribeIndexResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_IndexNotExist,
+			},
+		}, nil
+	}
+	return &milvuspb.DescribeIndexResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		IndexDescriptions: rc.indexes,
+	}, nil
+}
+
+func (rc *rootCoordMock) DropIndex(ctx context.Context, req *milvuspb.DropIndexRequest) (*commonpb.Status, error) {
+	rc.Lock()
+	defer rc.Unlock()
+	indexes := make([]*milvuspb.IndexDescription, 0, len(rc.indexes))
+	for _, desc := range rc.indexes {
+		if desc.IndexName == req.IndexName && desc.FieldName == req.FieldName {
+			continue
+		}
+		indexes = append(indexes, desc)
+	}
+	rc.indexes = indexes
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}, nil
+}
+
 type dataCoordMock struct {
 	types.DataCoord
 	minioKV             kv.BaseKV
@@ -17,7 +17,7 @@ import (
 
 	"google.golang.org/grpc"
 
-	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
+	"github.com/milvus-io/milvus/internal/kv"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
@@ -35,7 +35,7 @@ type queryNodeClientMock struct {
 	addr string
 }
 
-func newQueryNodeTest(ctx context.Context, address string, id UniqueID, kv *etcdkv.EtcdKV) (Node, error) {
+func newQueryNodeTest(ctx context.Context, address string, id UniqueID, kv kv.MetaKv) (Node, error) {
 	collectionInfo := make(map[UniqueID]*querypb.CollectionInfo)
 	watchedChannels := make(map[UniqueID]*querypb.QueryChannelInfo)
 	childCtx, cancel := context.WithCancel(ctx)
@@ -139,6 +139,10 @@ func (client *queryNodeClientMock) ReleaseSegments(ctx context.Context, req *que
 	return client.grpcClient.ReleaseSegments(ctx, req)
 }
 
+func (client *queryNodeClientMock) SwapSegmentIndex(ctx context.Context, req *querypb.SwapSegmentIndexRequest) (*commonpb.Status, error) {
+	return client.grpcClient.SwapSegmentIndex(ctx, req)
+}
+
 func (client *queryNodeClientMock) GetSegmentInfo(ctx context.Context, req *querypb.GetSegmentInfoRequest) (*querypb.GetSegmentInfoResponse, error) {
 	return client.grpcClient.GetSegmentInfo(ctx, req)
 }
@@ -49,6 +49,7 @@ type queryNodeServerMock struct {
 	releaseCollection   func() (*commonpb.Status, error)
 	releasePartition    func() (*commonpb.Status, error)
 	releaseSegments     func() (*commonpb.Status, error)
+	swapSegmentIndex    func() (*commonpb.Status, error)
 }
 
 func newQueryNodeServerMock(ctx context.Context) *queryNodeServerMock {
@@ -65,6 +66,7 @@ func newQueryNodeServerMock(ctx context.Context) *queryNodeServerMock {
 		releaseCollection:   returnSuccessResult,
 		releasePartition:    returnSuccessResult,
 		releaseSegments:     returnSuccessResult,
+		swapSegmentIndex:    returnSuccessResult,
 	}
 }
 
@@ -182,6 +184,10 @@ func (qs *queryNodeServerMock) ReleaseSegments(ctx context.Context, req *querypb
 	return qs.releaseSegments()
 }
 
+func (qs *queryNodeServerMock) SwapSegmentIndex(ctx context.Context, req *querypb.SwapSegmentIndexRequest) (*commonpb.Status, error) {
+	return qs.swapSegmentIndex()
+}
+
 func (qs *queryNodeServerMock) GetSegmentInfo(context.Context, *querypb.GetSegmentInfoRequest) (*querypb.GetSegmentInfoResponse, error) {
 	return &querypb.GetSegmentInfoResponse{
 		Status: &commonpb.Status{
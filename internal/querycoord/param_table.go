@@ -17,6 +17,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/util/paramtable"
@@ -47,6 +48,9 @@ type ParamTable struct {
 	SearchChannelPrefix       string
 	SearchResultChannelPrefix string
 
+	// interval to switch loaded segments to rebuilt indexes
+	IndexSwapInterval time.Duration
+
 	// --- ETCD ---
 	EtcdEndpoints []string
 	MetaRootPath  string
@@ -92,6 +96,8 @@ func (p *ParamTable) Init() {
 	p.initStatsChannelName()
 	p.initTimeTickChannelName()
 
+	p.initIndexSwapInterval()
+
 	// --- ETCD ---
 	p.initEtcdEndpoints()
 	p.initMetaRootPath()
@@ -113,6 +119,10 @@ func (p *ParamTable) initQueryCoordAddress() {
 	p.Address = url
 }
 
+func (p *ParamTable) initIndexSwapInterval() {
+	p.IndexSwapInterval = time.Duration(p.ParseInt64("queryCoord.indexSwapInterval")) * time.Second
+}
+
 func (p *ParamTable) initRoleName() {
 	p.RoleName = fmt.Sprintf("%s-%d", "QueryCoord", p.NodeID)
 }
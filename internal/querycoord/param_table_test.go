@@ -13,6 +13,7 @@ package querycoord
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -32,4 +33,6 @@ func TestParamTable(t *testing.T) {
 
 	assert.Equal(t, Params.TimeTickChannelName, "by-dev-queryTimeTick")
 	t.Logf("query coord  time tick channel = %s", Params.TimeTickChannelName)
+
+	assert.Equal(t, Params.IndexSwapInterval, 10*time.Second)
 }
@@ -21,9 +21,9 @@ import (
 	"time"
 
 	"github.com/golang/protobuf/proto"
-	"go.etcd.io/etcd/api/v3/mvccpb"
 	"go.uber.org/zap"
 
+	"github.com/milvus-io/milvus/internal/kv"
 	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/msgstream"
@@ -32,6 +32,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util/metricsinfo"
+	"github.com/milvus-io/milvus/internal/util/migration"
 	"github.com/milvus-io/milvus/internal/util/retry"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
@@ -48,7 +49,7 @@ type QueryCoord struct {
 	loopCtx    context.Context
 	loopCancel context.CancelFunc
 	loopWg     sync.WaitGroup
-	kvClient   *etcdkv.EtcdKV
+	kvClient   kv.MetaKv
 
 	initOnce sync.Once
 
@@ -84,10 +85,13 @@ func (qc *QueryCoord) Register() error {
 
 func (qc *QueryCoord) Init() error {
 	connectEtcdFn := func() error {
-		etcdKV, err := etcdkv.NewEtcdKV(Params.EtcdEndpoints, Params.MetaRootPath)
+		etcdKV, err := etcdkv.NewMetaKv(Params.EtcdEndpoints, Params.MetaRootPath)
 		if err != nil {
 			return err
 		}
+		if err = migration.CoordinatorSchema(typeutil.QueryCoordRole).Check(etcdKV); err != nil {
+			return err
+		}
 		qc.kvClient = etcdKV
 		return nil
 	}
@@ -135,6 +139,9 @@ func (qc *QueryCoord) Start() error {
 	qc.loopWg.Add(1)
 	go qc.watchMetaLoop()
 
+	qc.loopWg.Add(1)
+	go qc.indexSwapLoop()
+
 	go qc.session.LivenessCheck(qc.loopCtx, qc.liveCh, func() {
 		qc.Stop()
 	})
@@ -307,10 +314,10 @@ func (qc *QueryCoord) watchMetaLoop() {
 					log.Error("watch MetaReplica loop error when unmarshal", zap.Any("error", err.Error()))
 				}
 				switch event.Type {
-				case mvccpb.PUT:
+				case kv.EventTypePut:
 					//TODO::
 					qc.meta.setSegmentInfo(segmentID, segmentInfo)
-				case mvccpb.DELETE:
+				case kv.EventTypeDelete:
 					//TODO::
 				}
 			}
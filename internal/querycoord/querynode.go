@@ -21,7 +21,7 @@ import (
 	"go.uber.org/zap"
 
 	nodeclient "github.com/milvus-io/milvus/internal/distributed/querynode/client"
-	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
+	"github.com/milvus-io/milvus/internal/kv"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
@@ -60,6 +60,7 @@ type Node interface {
 	getSegmentInfo(ctx context.Context, in *querypb.GetSegmentInfoRequest) (*querypb.GetSegmentInfoResponse, error)
 	loadSegments(ctx context.Context, in *querypb.LoadSegmentsRequest) error
 	releaseSegments(ctx context.Context, in *querypb.ReleaseSegmentsRequest) error
+	swapSegmentIndex(ctx context.Context, in *querypb.SwapSegmentIndexRequest) error
 	getComponentInfo(ctx context.Context) *internalpb.ComponentInfo
 
 	getMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error)
@@ -71,7 +72,7 @@ type queryNode struct {
 	id       int64
 	address  string
 	client   types.QueryNode
-	kvClient *etcdkv.EtcdKV
+	kvClient kv.MetaKv
 
 	sync.RWMutex
 	collectionInfos      map[UniqueID]*querypb.CollectionInfo
@@ -80,7 +81,7 @@ type queryNode struct {
 	stateLock            sync.RWMutex
 }
 
-func newQueryNode(ctx context.Context, address string, id UniqueID, kv *etcdkv.EtcdKV) (Node, error) {
+func newQueryNode(ctx context.Context, address string, id UniqueID, kv kv.MetaKv) (Node, error) {
 	collectionInfo := make(map[UniqueID]*querypb.CollectionInfo)
 	watchedChannels := make(map[UniqueID]*querypb.QueryChannelInfo)
 	childCtx, cancel := context.WithCancel(ctx)
@@ -575,9 +576,25 @@ func (qn *queryNode) releaseSegments(ctx context.Context, in *querypb.ReleaseSeg
 	return nil
 }
 
+func (qn *queryNode) swapSegmentIndex(ctx context.Context, in *querypb.SwapSegmentIndexRequest) error {
+	if !qn.isOnline() {
+		return errors.New("SwapSegmentIndex: queryNode is offline")
+	}
+
+	status, err := qn.client.SwapSegmentIndex(ctx, in)
+	if err != nil {
+		return err
+	}
+	if status.ErrorCode != commonpb.ErrorCode_Success {
+		return errors.New(status.Reason)
+	}
+
+	return nil
+}
+
 //****************************************************//
 
-func saveNodeCollectionInfo(collectionID UniqueID, info *querypb.CollectionInfo, nodeID int64, kv *etcdkv.EtcdKV) error {
+func saveNodeCollectionInfo(collectionID UniqueID, info *querypb.CollectionInfo, nodeID int64, kv kv.MetaKv) error {
 	infoBytes, err := proto.Marshal(info)
 	if err != nil {
 		log.Error("QueryNode::saveNodeCollectionInfo ", zap.Error(err))
@@ -588,7 +605,7 @@ func saveNodeCollectionInfo(collectionID UniqueID, info *querypb.CollectionInfo,
 	return kv.Save(key, string(infoBytes))
 }
 
-func removeNodeCollectionInfo(collectionID UniqueID, nodeID int64, kv *etcdkv.EtcdKV) error {
+func removeNodeCollectionInfo(collectionID UniqueID, nodeID int64, kv kv.MetaKv) error {
 	key := fmt.Sprintf("%s/%d/%d", queryNodeMetaPrefix, nodeID, collectionID)
 	return kv.Remove(key)
 }
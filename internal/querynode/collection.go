@@ -26,6 +26,7 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"strconv"
 	"sync"
 	"unsafe"
 
@@ -40,10 +41,12 @@ type Collection struct {
 	collectionPtr C.CCollection
 	id            UniqueID
 	partitionIDs  []UniqueID
-	schema        *schemapb.CollectionSchema
 	vChannels     []Channel
 	pChannels     []Channel
 
+	schemaMu sync.RWMutex // guards schema
+	schema   *schemapb.CollectionSchema
+
 	loadType loadType
 
 	releaseMu          sync.RWMutex // guards release
@@ -57,9 +60,34 @@ func (c *Collection) ID() UniqueID {
 }
 
 func (c *Collection) Schema() *schemapb.CollectionSchema {
+	c.schemaMu.RLock()
+	defer c.schemaMu.RUnlock()
 	return c.schema
 }
 
+// updateSchema replaces the schema of the collection in segcore, the growing segments created afterwards use the new
+// schema while the existing ones keep the old one
+func (c *Collection) updateSchema(schema *schemapb.CollectionSchema) error {
+	/*
+		CStatus
+		UpdateCollectionSchema(CCollection collection, const char* schema_proto_blob);
+	*/
+	c.schemaMu.Lock()
+	defer c.schemaMu.Unlock()
+
+	cSchemaBlob := C.CString(proto.MarshalTextString(schema))
+	defer C.free(unsafe.Pointer(cSchemaBlob))
+	status := C.UpdateCollectionSchema(c.collectionPtr, cSchemaBlob)
+	errorCode := status.error_code
+	if errorCode != 0 {
+		errorMsg := C.GoString(status.error_msg)
+		defer C.free(unsafe.Pointer(status.error_msg))
+		return errors.New("UpdateCollectionSchema failed, C runtime error detected, error code = " + strconv.Itoa(int(errorCode)) + ", error msg = " + errorMsg)
+	}
+	c.schema = schema
+	return nil
+}
+
 func (c *Collection) addPartitionID(partitionID UniqueID) {
 	c.releaseMu.Lock()
 	defer c.releaseMu.Unlock()
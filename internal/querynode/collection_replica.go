@@ -25,7 +25,7 @@ import "C"
 import (
 	"errors"
 	"fmt"
-	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
+	"github.com/milvus-io/milvus/internal/kv"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"strconv"
 	"sync"
@@ -95,7 +95,7 @@ type collectionReplica struct {
 
 	excludedSegments map[UniqueID][]*datapb.SegmentInfo // map[collectionID]segmentIDs
 
-	etcdKV *etcdkv.EtcdKV
+	etcdKV kv.MetaKv
 }
 
 func (colReplica *collectionReplica) getSegmentsMemSize() int64 {
@@ -534,7 +534,7 @@ func (colReplica *collectionReplica) freeAll() {
 	colReplica.segments = make(map[UniqueID]*Segment)
 }
 
-func newCollectionReplica(etcdKv *etcdkv.EtcdKV) ReplicaInterface {
+func newCollectionReplica(etcdKv kv.MetaKv) ReplicaInterface {
 	collections := make(map[UniqueID]*Collection)
 	partitions := make(map[UniqueID]*Partition)
 	segments := make(map[UniqueID]*Segment)
@@ -41,6 +41,9 @@ type dataSyncService struct {
 	streamingReplica ReplicaInterface
 	tSafeReplica     TSafeReplicaInterface
 	msFactory        msgstream.Factory
+
+	// refreshSchema is called by the flow graphs before a growing segment is created
+	refreshSchema func(collectionID UniqueID)
 }
 
 // collection flow graph
@@ -62,7 +65,8 @@ func (dsService *dataSyncService) addCollectionFlowGraph(collectionID UniqueID,
 			dsService.streamingReplica,
 			dsService.tSafeReplica,
 			vChannel,
-			dsService.msFactory)
+			dsService.msFactory,
+			dsService.refreshSchema)
 		dsService.collectionFlowGraphs[collectionID][vChannel] = newFlowGraph
 		log.Debug("add collection flow graph",
 			zap.Any("collectionID", collectionID),
@@ -135,7 +139,8 @@ func (dsService *dataSyncService) addPartitionFlowGraph(collectionID UniqueID, p
 			dsService.streamingReplica,
 			dsService.tSafeReplica,
 			vChannel,
-			dsService.msFactory)
+			dsService.msFactory,
+			dsService.refreshSchema)
 		dsService.partitionFlowGraphs[partitionID][vChannel] = newFlowGraph
 	}
 }
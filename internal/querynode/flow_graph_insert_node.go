@@ -26,6 +26,9 @@ import (
 type insertNode struct {
 	baseNode
 	replica ReplicaInterface
+	// refreshSchema is called before a growing segment is created, if not nil, so the segment is created with the
+	// latest schema of the collection
+	refreshSchema func(collectionID UniqueID)
 }
 
 type InsertData struct {
@@ -84,6 +87,9 @@ func (iNode *insertNode) Operate(in []flowgraph.Msg) []flowgraph.Msg {
 
 		// check if segment exists, if not, create this segment
 		if !iNode.replica.hasSegment(task.SegmentID) {
+			if iNode.refreshSchema != nil {
+				iNode.refreshSchema(task.CollectionID)
+			}
 			err := iNode.replica.addSegment(task.SegmentID, task.PartitionID, task.CollectionID, task.ShardName, segmentTypeGrowing, true)
 			if err != nil {
 				log.Warn(err.Error())
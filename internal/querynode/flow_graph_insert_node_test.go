@@ -165,4 +165,29 @@ func TestFlowGraphInsertNode_operate(t *testing.T) {
 		msg := []flowgraph.Msg{&iMsg, &iMsg}
 		insertNode.Operate(msg)
 	})
+
+	t.Run("test refresh schema before creating segment", func(t *testing.T) {
+		replica, err := genSimpleReplica()
+		assert.NoError(t, err)
+		insertNode := newInsertNode(replica)
+		var refreshed []UniqueID
+		insertNode.refreshSchema = func(collectionID UniqueID) {
+			refreshed = append(refreshed, collectionID)
+		}
+
+		msgInsertMsg, err := genSimpleInsertMsg()
+		assert.NoError(t, err)
+		iMsg := insertMsg{
+			insertMessages: []*msgstream.InsertMsg{
+				msgInsertMsg,
+			},
+		}
+		insertNode.Operate([]flowgraph.Msg{&iMsg})
+		assert.True(t, replica.hasSegment(msgInsertMsg.SegmentID))
+		assert.Equal(t, []UniqueID{msgInsertMsg.CollectionID}, refreshed)
+
+		// the schema isn't refreshed for an existing segment
+		insertNode.Operate([]flowgraph.Msg{&iMsg})
+		assert.Equal(t, 1, len(refreshed))
+	})
 }
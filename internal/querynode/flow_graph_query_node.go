@@ -32,6 +32,7 @@ type queryNodeFlowGraph struct {
 	channel      Channel
 	flowGraph    *flowgraph.TimeTickedFlowGraph
 	dmlStream    msgstream.MsgStream
+	dmlInput     *flowgraph.InputNode
 }
 
 func newQueryNodeFlowGraph(ctx context.Context,
@@ -41,7 +42,8 @@ func newQueryNodeFlowGraph(ctx context.Context,
 	streamingReplica ReplicaInterface,
 	tSafeReplica TSafeReplicaInterface,
 	channel Channel,
-	factory msgstream.Factory) *queryNodeFlowGraph {
+	factory msgstream.Factory,
+	refreshSchema func(collectionID UniqueID)) *queryNodeFlowGraph {
 
 	ctx1, cancel := context.WithCancel(ctx)
 
@@ -56,7 +58,9 @@ func newQueryNodeFlowGraph(ctx context.Context,
 
 	var dmStreamNode node = q.newDmInputNode(ctx1, factory)
 	var filterDmNode node = newFilteredDmNode(streamingReplica, loadType, collectionID, partitionID)
-	var insertNode node = newInsertNode(streamingReplica)
+	iNode := newInsertNode(streamingReplica)
+	iNode.refreshSchema = refreshSchema
+	var insertNode node = iNode
 	var serviceTimeNode node = newServiceTimeNode(ctx1, tSafeReplica, loadType, collectionID, partitionID, channel, factory)
 
 	q.flowGraph.AddNode(dmStreamNode)
@@ -115,6 +119,8 @@ func (q *queryNodeFlowGraph) newDmInputNode(ctx context.Context, factory msgstre
 	maxParallelism := Params.FlowGraphMaxParallelism
 
 	node := flowgraph.NewInputNode(insertStream, "dmlInputNode", maxQueueLength, maxParallelism)
+	node.SetDedupWindow(Params.FlowGraphDedupWindow)
+	q.dmlInput = node
 	return node
 }
 
@@ -134,6 +140,7 @@ func (q *queryNodeFlowGraph) consumerFlowGraph(channel Channel, subName ConsumeS
 func (q *queryNodeFlowGraph) seekQueryNodeFlowGraph(position *internalpb.MsgPosition) error {
 	q.dmlStream.AsConsumer([]string{position.ChannelName}, position.MsgGroup)
 	err := q.dmlStream.Seek([]*internalpb.MsgPosition{position})
+	q.dmlInput.SeedDedup(position)
 	log.Debug("query node flow graph seeks from pChannel",
 		zap.Any("collectionID", q.collectionID),
 		zap.Any("channel", position.ChannelName),
@@ -37,7 +37,8 @@ func TestQueryNodeFlowGraph_consumerFlowGraph(t *testing.T) {
 		streaming.replica,
 		streaming.tSafeReplica,
 		defaultVChannel,
-		fac)
+		fac,
+		nil)
 
 	err = fg.consumerFlowGraph(defaultVChannel, defaultSubName)
 	assert.NoError(t, err)
@@ -60,7 +61,8 @@ func TestQueryNodeFlowGraph_seekQueryNodeFlowGraph(t *testing.T) {
 		streaming.replica,
 		streaming.tSafeReplica,
 		defaultVChannel,
-		fac)
+		fac,
+		nil)
 
 	position := &internalpb.MsgPosition{
 		ChannelName: defaultVChannel,
@@ -20,12 +20,12 @@ import (
 	"sync"
 
 	"github.com/golang/protobuf/proto"
-	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
-	"go.etcd.io/etcd/api/v3/mvccpb"
+	"github.com/milvus-io/milvus/internal/kv"
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/msgstream"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/proto/segcorepb"
 	"github.com/milvus-io/milvus/internal/storage"
@@ -47,14 +47,14 @@ type historical struct {
 	mu                   sync.Mutex // guards globalSealedSegments
 	globalSealedSegments map[UniqueID]*querypb.SegmentInfo
 
-	etcdKV *etcdkv.EtcdKV
+	etcdKV kv.MetaKv
 }
 
 func newHistorical(ctx context.Context,
 	rootCoord types.RootCoord,
 	indexCoord types.IndexCoord,
 	factory msgstream.Factory,
-	etcdKV *etcdkv.EtcdKV) *historical {
+	etcdKV kv.MetaKv) *historical {
 	replica := newCollectionReplica(etcdKV)
 	loader := newSegmentLoader(ctx, rootCoord, indexCoord, replica, etcdKV)
 	ss := newStatsService(ctx, replica, loader.indexLoader.fieldStatsChan, factory)
@@ -98,7 +98,7 @@ func (h *historical) watchGlobalSegmentMeta() {
 					continue
 				}
 				switch event.Type {
-				case mvccpb.PUT:
+				case kv.EventTypePut:
 					log.Debug("globalSealedSegments add segment",
 						zap.Any("segmentID", segmentID),
 					)
@@ -109,7 +109,7 @@ func (h *historical) watchGlobalSegmentMeta() {
 						continue
 					}
 					h.addGlobalSegmentInfo(segmentID, segmentInfo)
-				case mvccpb.DELETE:
+				case kv.EventTypeDelete:
 					log.Debug("globalSealedSegments delete segment",
 						zap.Any("segmentID", segmentID),
 					)
@@ -213,6 +213,12 @@ func (h *historical) retrieve(collID UniqueID, partIDs []UniqueID, vcm storage.C
 			if err != nil {
 				return retrieveResults, retrieveSegmentIDs, err
 			}
+			if !seg.mayMatch(plan.predicates) {
+				log.Debug("skip segment by field statistics",
+					zap.Int64("collectionID", collID),
+					zap.Int64("segmentID", segID))
+				continue
+			}
 			result, err := seg.getEntityByIds(plan)
 			if err != nil {
 				return retrieveResults, retrieveSegmentIDs, err
@@ -284,6 +290,16 @@ func (h *historical) search(searchReqs []*searchRequest, collID UniqueID, partID
 		zap.Any("searchPartitionIDs", searchPartIDs),
 	)
 
+	// the segments are searched with the index named by the search, or the default index of the field if empty
+	var searchIndex *milvuspb.IndexDescription
+	if plan.indexName != "" && plan.annsFieldID != 0 {
+		searchIndex, err = h.loader.indexLoader.getIndexByName(collID, plan.annsFieldID, plan.indexName)
+		if err != nil {
+			return nil, nil, err
+		}
+		plan.setIndexID(searchIndex.IndexID)
+	}
+
 	for _, partID := range searchPartIDs {
 		segIDs, err := h.replica.getSegmentIDs(partID)
 		if err != nil {
@@ -297,7 +313,14 @@ func (h *historical) search(searchReqs []*searchRequest, collID UniqueID, partID
 			if !seg.getOnService() {
 				continue
 			}
-			searchResult, err := seg.search(plan, searchReqs, []Timestamp{searchTs})
+			filter, matched := seg.filterRows(plan.predicates)
+			if !matched {
+				log.Debug("skip segment by field statistics",
+					zap.Int64("collectionID", collID),
+					zap.Int64("segmentID", segID))
+				continue
+			}
+			searchResult, err := h.searchSegment(seg, plan, searchIndex, filter, searchReqs, searchTs)
 			if err != nil {
 				return searchResults, searchSegmentIDs, err
 			}
@@ -308,3 +331,16 @@ func (h *historical) search(searchReqs []*searchRequest, collID UniqueID, partID
 
 	return searchResults, searchSegmentIDs, nil
 }
+
+// searchSegment searches the sealed segment with the index requested by the search, which is loaded along with the
+// segment. Indexes are never loaded by searches, the segment is searched with the default index of the field if the
+// index requested isn't loaded on it, such as the one not built on the segment when the segment was loaded.
+func (h *historical) searchSegment(seg *Segment, plan *SearchPlan, searchIndex *milvuspb.IndexDescription, filter *rowFilter,
+	searchReqs []*searchRequest, searchTs Timestamp) (*SearchResult, error) {
+	if searchIndex != nil && seg.getLoadedIndexInfo(plan.annsFieldID, searchIndex.IndexID) == nil {
+		log.Debug("search with the default index, the index requested isn't loaded",
+			zap.Int64("segmentID", seg.segmentID),
+			zap.String("indexName", searchIndex.IndexName))
+	}
+	return seg.searchWithRowFilter(plan, searchReqs, []Timestamp{searchTs}, filter)
+}
@@ -419,6 +419,49 @@ func (node *QueryNode) ReleaseSegments(ctx context.Context, in *queryPb.ReleaseS
 	return status, nil
 }
 
+// SwapSegmentIndex switches the sealed segment from the index replaced by the rebuilt index to the rebuilt one,
+// ErrorCode_IndexNotExist is returned if the rebuilt index isn't ready on the segment yet
+func (node *QueryNode) SwapSegmentIndex(ctx context.Context, in *queryPb.SwapSegmentIndexRequest) (*commonpb.Status, error) {
+	code := node.stateCode.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		err := fmt.Errorf("query node %d is not ready", Params.QueryNodeID)
+		status := &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}
+		return status, err
+	}
+	segment, err := node.historical.replica.getSegmentByID(in.SegmentID)
+	if err != nil {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+
+	segment.indexMu.Lock()
+	defer segment.indexMu.Unlock()
+	err = node.historical.loader.indexLoader.swapIndex(segment, in.FieldID, in.IndexID, in.IndexName, in.ReplacedIndexID)
+	if err != nil {
+		log.Warn("swap segment index failed",
+			zap.Int64("segmentID", in.SegmentID),
+			zap.Int64("fieldID", in.FieldID),
+			zap.Int64("indexID", in.IndexID),
+			zap.Error(err))
+		status := &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}
+		if errors.Is(err, errIndexNotBuilt) {
+			status.ErrorCode = commonpb.ErrorCode_IndexNotExist
+		}
+		return status, nil
+	}
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}, nil
+}
+
 func (node *QueryNode) GetSegmentInfo(ctx context.Context, in *queryPb.GetSegmentInfoRequest) (*queryPb.GetSegmentInfoResponse, error) {
 	code := node.stateCode.Load().(internalpb.StateCode)
 	if code != internalpb.StateCode_Healthy {
@@ -20,6 +20,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
 	queryPb "github.com/milvus-io/milvus/internal/proto/querypb"
@@ -388,3 +389,107 @@ func TestImpl_ReleaseSegments(t *testing.T) {
 		assert.NotEqual(t, commonpb.ErrorCode_Success, status.ErrorCode)
 	})
 }
+
+// notBuiltIndexCoord returns no index file as the index isn't built yet
+type notBuiltIndexCoord struct {
+	*mockIndexCoord
+}
+
+func (m *notBuiltIndexCoord) GetIndexFilePaths(ctx context.Context, req *indexpb.GetIndexFilePathsRequest) (*indexpb.GetIndexFilePathsResponse, error) {
+	return &indexpb.GetIndexFilePathsResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		FilePaths: []*indexpb.IndexFilePathInfo{
+			{
+				Status: &commonpb.Status{
+					ErrorCode: commonpb.ErrorCode_Success,
+				},
+				IndexBuildID: buildID,
+			},
+		},
+	}, nil
+}
+
+func TestImpl_SwapSegmentIndex(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	node, err := genSimpleQueryNode(ctx)
+	assert.NoError(t, err)
+
+	segment, err := node.historical.replica.getSegmentByID(defaultSegmentID)
+	assert.NoError(t, err)
+	loader := node.historical.loader.indexLoader
+	loader.rootCoord = newMockRootCoord()
+	loader.indexCoord = newMockIndexCoord()
+	err = loader.setIndexInfo(defaultCollectionID, segment, simpleVecField.id)
+	assert.NoError(t, err)
+	err = loader.loadIndex(segment, simpleVecField.id)
+	assert.NoError(t, err)
+
+	rebuiltID := indexID + 1
+	genReq := func() *queryPb.SwapSegmentIndexRequest {
+		return &queryPb.SwapSegmentIndexRequest{
+			Base:            genCommonMsgBase(commonpb.MsgType_LoadIndex),
+			CollectionID:    defaultCollectionID,
+			SegmentID:       defaultSegmentID,
+			FieldID:         simpleVecField.id,
+			IndexName:       indexName,
+			IndexID:         rebuiltID,
+			ReplacedIndexID: indexID,
+		}
+	}
+
+	t.Run("test index not built", func(t *testing.T) {
+		loader.indexCoord = &notBuiltIndexCoord{mockIndexCoord: newMockIndexCoord()}
+		defer func() {
+			loader.indexCoord = newMockIndexCoord()
+		}()
+		status, err := node.SwapSegmentIndex(ctx, genReq())
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_IndexNotExist, status.ErrorCode)
+		// the replaced index keeps serving
+		assert.Equal(t, indexID, segment.getIndexID(simpleVecField.id))
+		assert.NotNil(t, segment.getLoadedIndexInfo(simpleVecField.id, indexID))
+	})
+
+	t.Run("test swap", func(t *testing.T) {
+		paths, err := generateIndex(defaultSegmentID)
+		assert.NoError(t, err)
+		indexBuffer, indexParams, _, err := loader.getIndexBinlog(paths)
+		assert.NoError(t, err)
+		err = segment.addSegmentIndex(indexBuffer, simpleVecField.id, &indexInfo{
+			indexID:     rebuiltID,
+			indexPaths:  paths,
+			indexParams: indexParams,
+			readyLoad:   true,
+		})
+		assert.NoError(t, err)
+
+		status, err := node.SwapSegmentIndex(ctx, genReq())
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+		assert.Equal(t, rebuiltID, segment.getIndexID(simpleVecField.id))
+		assert.Nil(t, segment.getLoadedIndexInfo(simpleVecField.id, indexID))
+
+		// swapped already
+		status, err = node.SwapSegmentIndex(ctx, genReq())
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+	})
+
+	t.Run("test segment not exists", func(t *testing.T) {
+		req := genReq()
+		req.SegmentID = defaultSegmentID + 1
+		status, err := node.SwapSegmentIndex(ctx, req)
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.ErrorCode)
+	})
+
+	t.Run("test unhealthy", func(t *testing.T) {
+		node.UpdateStateCode(internalpb.StateCode_Abnormal)
+		status, err := node.SwapSegmentIndex(ctx, genReq())
+		assert.Error(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.ErrorCode)
+	})
+}
@@ -16,24 +16,43 @@ import (
 	"errors"
 	"fmt"
 	"path"
+	"sync"
 	"time"
 
 	"go.uber.org/zap"
 
-	"github.com/milvus-io/milvus/internal/kv"
-	minioKV "github.com/milvus-io/milvus/internal/kv/minio"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/indexparamcheck"
 	"github.com/milvus-io/milvus/internal/util/retry"
+	"github.com/milvus-io/milvus/internal/util/scalarindex"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
 type indexParam = map[string]string
 
+// errIndexNotBuilt means the requested index hasn't been built on the segment
+var errIndexNotBuilt = errors.New("index not built on segment")
+
+const (
+	// indexDescriptionsTTL is how long the indexes of a collection described by root coord are cached
+	indexDescriptionsTTL = time.Minute
+	// indexDescriptionsMinRefresh is the minimal interval to refresh the cached indexes of a collection when
+	// the index looked up isn't found in them
+	indexDescriptionsMinRefresh = time.Second
+)
+
+type cachedIndexDescriptions struct {
+	descriptions []*milvuspb.IndexDescription
+	updateTime   time.Time
+}
+
 // indexLoader is in charge of loading index in query node
 type indexLoader struct {
 	replica ReplicaInterface
@@ -44,10 +63,16 @@ type indexLoader struct {
 	rootCoord  types.RootCoord
 	indexCoord types.IndexCoord
 
-	kv kv.BaseKV // minio kv
+	chunkManager storage.ChunkManager // remote storage of index files
+
+	indexDescMu sync.Mutex // guards indexDescs
+	indexDescs  map[UniqueID]*cachedIndexDescriptions
 }
 
 func (loader *indexLoader) loadIndex(segment *Segment, fieldID FieldID) error {
+	segment.indexMu.Lock()
+	defer segment.indexMu.Unlock()
+
 	// 1. use msg's index paths to get index bytes
 	var err error
 	var indexBuffer [][]byte
@@ -111,7 +136,7 @@ func (loader *indexLoader) getIndexBinlog(indexPath []string) ([][]byte, indexPa
 	defer indexCodec.Close()
 	for _, p := range indexPath {
 		log.Debug("", zap.String("load path", fmt.Sprintln(indexPath)))
-		indexPiece, err := loader.kv.Load(p)
+		indexPiece, err := loader.chunkManager.Read(p)
 		if err != nil {
 			return nil, nil, "", err
 		}
@@ -120,7 +145,7 @@ func (loader *indexLoader) getIndexBinlog(indexPath []string) ([][]byte, indexPa
 			_, indexParams, indexName, _, err = indexCodec.Deserialize([]*storage.Blob{
 				{
 					Key:   storage.IndexParamsFile,
-					Value: []byte(indexPiece),
+					Value: indexPiece,
 				},
 			})
 			if err != nil {
@@ -130,7 +155,7 @@ func (loader *indexLoader) getIndexBinlog(indexPath []string) ([][]byte, indexPa
 			data, _, _, _, err := indexCodec.Deserialize([]*storage.Blob{
 				{
 					Key:   path.Base(p), // though key is not important here
-					Value: []byte(indexPiece),
+					Value: indexPiece,
 				},
 			})
 			if err != nil {
@@ -146,9 +171,11 @@ func (loader *indexLoader) getIndexBinlog(indexPath []string) ([][]byte, indexPa
 	return index, indexParams, indexName, nil
 }
 
-func (loader *indexLoader) setIndexInfo(collectionID UniqueID, segment *Segment, fieldID UniqueID) error {
+// getIndexInfo gets the index of the field on the segment, fieldID 0 for the default index of the segment,
+// indexName empty for any index of the field
+func (loader *indexLoader) getIndexInfo(collectionID UniqueID, segmentID UniqueID, fieldID UniqueID, indexName string) (*indexInfo, error) {
 	if loader.indexCoord == nil || loader.rootCoord == nil {
-		return errors.New("null index coordinator client or root coordinator client, collectionID = " +
+		return nil, errors.New("null index coordinator client or root coordinator client, collectionID = " +
 			fmt.Sprintln(collectionID))
 	}
 
@@ -158,39 +185,65 @@ func (loader *indexLoader) setIndexInfo(collectionID UniqueID, segment *Segment,
 			MsgType: commonpb.MsgType_DescribeSegment,
 		},
 		CollectionID: collectionID,
-		SegmentID:    segment.segmentID,
+		SegmentID:    segmentID,
+		FieldID:      fieldID,
+		IndexName:    indexName,
 	}
 	response, err := loader.rootCoord.DescribeSegment(ctx, req)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	if response.Status.ErrorCode != commonpb.ErrorCode_Success {
-		return errors.New(response.Status.Reason)
+		return nil, errors.New(response.Status.Reason)
 	}
 
 	if !response.EnableIndex {
-		return errors.New("there are no indexes on this segment")
+		return nil, errors.New("there are no indexes on this segment")
 	}
 
 	indexFilePathRequest := &indexpb.GetIndexFilePathsRequest{
 		IndexBuildIDs: []UniqueID{response.BuildID},
 	}
 	pathResponse, err := loader.indexCoord.GetIndexFilePaths(ctx, indexFilePathRequest)
-	if err != nil || pathResponse.Status.ErrorCode != commonpb.ErrorCode_Success {
-		return err
+	if err != nil {
+		return nil, err
+	}
+	if pathResponse.Status.ErrorCode != commonpb.ErrorCode_Success {
+		return nil, errors.New(pathResponse.Status.Reason)
 	}
 
 	if len(pathResponse.FilePaths) <= 0 {
-		return errors.New("illegal index file paths")
+		return nil, errors.New("illegal index file paths")
+	}
+	// the index files are saved once the build finishes
+	if len(pathResponse.FilePaths[0].IndexFilePaths) == 0 {
+		return nil, fmt.Errorf("%w: build id = %d", errIndexNotBuilt, response.BuildID)
 	}
 
-	info := &indexInfo{
+	return &indexInfo{
 		indexID:    response.IndexID,
 		buildID:    response.BuildID,
 		indexPaths: pathResponse.FilePaths[0].IndexFilePaths,
 		readyLoad:  true,
+	}, nil
+}
+
+func (loader *indexLoader) setIndexInfo(collectionID UniqueID, segment *Segment, fieldID UniqueID) error {
+	info, err := loader.getIndexInfo(collectionID, segment.segmentID, 0, "")
+	if err != nil {
+		// the index being rebuilt keeps serving until the rebuilt index is ready on the segment
+		var replacedErr error
+		info, replacedErr = loader.getReplacedIndexInfo(collectionID, segment.segmentID, fieldID)
+		if replacedErr != nil {
+			return err
+		}
+		log.Debug("load the index being rebuilt",
+			zap.Int64("segmentID", segment.segmentID),
+			zap.Int64("fieldID", fieldID),
+			zap.Int64("indexID", info.indexID),
+			zap.Error(err))
 	}
-	segment.setEnableIndex(response.EnableIndex)
+	segment.setEnableIndex(true)
 	err = segment.setIndexInfo(fieldID, info)
 	if err != nil {
 		return err
@@ -199,21 +252,297 @@ func (loader *indexLoader) setIndexInfo(collectionID UniqueID, segment *Segment,
 	return nil
 }
 
-func newIndexLoader(ctx context.Context, rootCoord types.RootCoord, indexCoord types.IndexCoord, replica ReplicaInterface) *indexLoader {
-	option := &minioKV.Option{
-		Address:           Params.MinioEndPoint,
-		AccessKeyID:       Params.MinioAccessKeyID,
-		SecretAccessKeyID: Params.MinioSecretAccessKey,
-		UseSSL:            Params.MinioUseSSLStr,
-		CreateBucket:      true,
-		BucketName:        Params.MinioBucketName,
+// getReplacedIndexInfo gets the index replaced by an index of the field being rebuilt, which keeps serving until
+// the rebuilt index is ready on the segment
+func (loader *indexLoader) getReplacedIndexInfo(collectionID UniqueID, segmentID UniqueID, fieldID UniqueID) (*indexInfo, error) {
+	fieldName, err := loader.getFieldName(collectionID, fieldID)
+	if err != nil {
+		return nil, err
+	}
+	descs, err := loader.describeIndexes(collectionID, indexDescriptionsTTL)
+	if err != nil {
+		return nil, err
+	}
+	indexNames := make(map[UniqueID]string)
+	for _, desc := range descs {
+		indexNames[desc.IndexID] = desc.IndexName
+	}
+	err = fmt.Errorf("no index is being rebuilt on field %d of collection %d", fieldID, collectionID)
+	for _, desc := range descs {
+		if desc.FieldName != fieldName || desc.ReplacedIndexID == 0 {
+			continue
+		}
+		replacedName, ok := indexNames[desc.ReplacedIndexID]
+		if !ok {
+			err = fmt.Errorf("replaced index %d not found in collection %d", desc.ReplacedIndexID, collectionID)
+			continue
+		}
+		var info *indexInfo
+		info, err = loader.getIndexInfo(collectionID, segmentID, fieldID, replacedName)
+		if err == nil {
+			return info, nil
+		}
+	}
+	return nil, err
+}
+
+// describeIndexes returns the indexes of the collection, which are described by root coord again if the cached
+// ones are older than maxAge
+func (loader *indexLoader) describeIndexes(collectionID UniqueID, maxAge time.Duration) ([]*milvuspb.IndexDescription, error) {
+	loader.indexDescMu.Lock()
+	cached, ok := loader.indexDescs[collectionID]
+	loader.indexDescMu.Unlock()
+	if ok && time.Since(cached.updateTime) < maxAge {
+		return cached.descriptions, nil
+	}
+
+	if loader.rootCoord == nil {
+		return nil, errors.New("null root coordinator client, collectionID = " + fmt.Sprintln(collectionID))
+	}
+	collection, err := loader.replica.getCollectionByID(collectionID)
+	if err != nil {
+		return nil, err
+	}
+	response, err := loader.rootCoord.DescribeIndex(context.TODO(), &milvuspb.DescribeIndexRequest{
+		Base: &commonpb.MsgBase{
+			MsgType: commonpb.MsgType_DescribeIndex,
+		},
+		CollectionName: collection.Schema().Name,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if response.Status.ErrorCode != commonpb.ErrorCode_Success && response.Status.ErrorCode != commonpb.ErrorCode_IndexNotExist {
+		return nil, errors.New(response.Status.Reason)
+	}
+
+	loader.indexDescMu.Lock()
+	loader.indexDescs[collectionID] = &cachedIndexDescriptions{
+		descriptions: response.IndexDescriptions,
+		updateTime:   time.Now(),
+	}
+	loader.indexDescMu.Unlock()
+	return response.IndexDescriptions, nil
+}
+
+// invalidateIndexDescriptions drops the cached indexes of the collection
+func (loader *indexLoader) invalidateIndexDescriptions(collectionID UniqueID) {
+	loader.indexDescMu.Lock()
+	defer loader.indexDescMu.Unlock()
+	delete(loader.indexDescs, collectionID)
+}
+
+func (loader *indexLoader) getFieldName(collectionID UniqueID, fieldID FieldID) (string, error) {
+	collection, err := loader.replica.getCollectionByID(collectionID)
+	if err != nil {
+		return "", err
+	}
+	for _, field := range collection.Schema().Fields {
+		if field.FieldID == fieldID {
+			return field.Name, nil
+		}
+	}
+	return "", fmt.Errorf("field %d not found in collection %d", fieldID, collectionID)
+}
+
+// getIndexByName returns the index named indexName on the vector field, the cached indexes of the collection
+// are refreshed if it isn't found in them since it may be created after they were cached
+func (loader *indexLoader) getIndexByName(collectionID UniqueID, fieldID FieldID, indexName string) (*milvuspb.IndexDescription, error) {
+	fieldName, err := loader.getFieldName(collectionID, fieldID)
+	if err != nil {
+		return nil, err
+	}
+	for _, maxAge := range []time.Duration{indexDescriptionsTTL, indexDescriptionsMinRefresh} {
+		descs, err := loader.describeIndexes(collectionID, maxAge)
+		if err != nil {
+			return nil, err
+		}
+		for _, desc := range descs {
+			if desc.IndexName == indexName && desc.FieldName == fieldName {
+				return desc, nil
+			}
+		}
 	}
+	return nil, fmt.Errorf("index %s not found on field %s of collection %d", indexName, fieldName, collectionID)
+}
 
-	client, err := minioKV.NewMinIOKV(ctx, option)
+// addIndex loads the index on the vector field of the sealed segment besides the indexes loaded on it already,
+// errIndexNotBuilt is wrapped in the returned error if the index isn't built on the segment yet.
+// The caller holds segment.indexMu.
+func (loader *indexLoader) addIndex(segment *Segment, fieldID FieldID, indexID UniqueID, indexName string) error {
+	info, err := loader.getIndexInfo(segment.collectionID, segment.segmentID, fieldID, indexName)
+	if err != nil {
+		return fmt.Errorf("%w: %s", errIndexNotBuilt, err.Error())
+	}
+	if info.indexID != indexID {
+		// the index has been dropped and created again since the indexes of the collection were cached
+		loader.invalidateIndexDescriptions(segment.collectionID)
+		return fmt.Errorf("index %s of field %d is %d instead of %d", indexName, fieldID, info.indexID, indexID)
+	}
+	indexBuffer, indexParams, _, err := loader.getIndexBinlog(info.indexPaths)
 	if err != nil {
-		panic(err)
+		return err
 	}
+	info.indexName = indexName
+	info.indexParams = indexParams
 
+	// the raw vector data is kept until the first index of the field is loaded
+	indexed := segment.hasLoadedIndex(fieldID)
+	if err = segment.addSegmentIndex(indexBuffer, fieldID, info); err != nil {
+		return err
+	}
+	if !indexed {
+		segment.setEnableIndex(true)
+		if err = segment.dropFieldData(fieldID); err != nil {
+			return err
+		}
+	}
+	log.Debug("add index done",
+		zap.Int64("segmentID", segment.segmentID),
+		zap.Int64("fieldID", fieldID),
+		zap.Int64("indexID", indexID),
+		zap.String("indexName", indexName))
+	return nil
+}
+
+// loadExtraIndexes loads the vector indexes of the field besides the default one on the sealed segment while it's
+// being loaded, so that the searches naming them never load indexes. Their memory is counted by the memory check of
+// the segment. The indexes failing to load, such as the ones not built on the segment yet, are skipped, and the
+// searches naming them are served with the default index of the field.
+func (loader *indexLoader) loadExtraIndexes(segment *Segment, fieldID FieldID) {
+	fieldName, err := loader.getFieldName(segment.collectionID, fieldID)
+	if err != nil {
+		log.Warn("failed to load extra indexes", zap.Int64("segmentID", segment.segmentID), zap.Error(err))
+		return
+	}
+	descs, err := loader.describeIndexes(segment.collectionID, indexDescriptionsTTL)
+	if err != nil {
+		log.Warn("failed to load extra indexes", zap.Int64("segmentID", segment.segmentID), zap.Error(err))
+		return
+	}
+
+	segment.indexMu.Lock()
+	defer segment.indexMu.Unlock()
+	for _, desc := range descs {
+		if desc.FieldName != fieldName || segment.getLoadedIndexInfo(fieldID, desc.IndexID) != nil {
+			continue
+		}
+		if err := loader.addIndex(segment, fieldID, desc.IndexID, desc.IndexName); err != nil {
+			log.Debug("skip extra index",
+				zap.Int64("segmentID", segment.segmentID),
+				zap.Int64("fieldID", fieldID),
+				zap.String("indexName", desc.IndexName),
+				zap.Error(err))
+		}
+	}
+}
+
+// swapIndex makes the index rebuilt to replace another index of the vector field the one searched on the sealed
+// segment, and drops the replaced index from the segment. The rebuilt index is loaded besides the replaced one
+// before the replaced one is dropped, so the segment keeps serving throughout. The caller holds segment.indexMu.
+func (loader *indexLoader) swapIndex(segment *Segment, fieldID FieldID, indexID UniqueID, indexName string, replacedID UniqueID) error {
+	defaultID := segment.getIndexID(fieldID)
+	if replacedID == 0 {
+		replacedID = defaultID
+	}
+	if replacedID == indexID || segment.getLoadedIndexInfo(fieldID, replacedID) == nil {
+		// the rebuilt index is loaded once the segment is loaded again
+		return nil
+	}
+	if segment.getLoadedIndexInfo(fieldID, indexID) == nil {
+		if err := loader.addIndex(segment, fieldID, indexID, indexName); err != nil {
+			return err
+		}
+	}
+	if defaultID == replacedID {
+		if err := segment.setDefaultSegmentIndex(fieldID, indexID); err != nil {
+			return err
+		}
+	}
+	if err := segment.dropSegmentIndexByID(fieldID, replacedID); err != nil {
+		return err
+	}
+	log.Debug("swap index done",
+		zap.Int64("segmentID", segment.segmentID),
+		zap.Int64("fieldID", fieldID),
+		zap.Int64("indexID", indexID),
+		zap.Int64("replacedIndexID", replacedID))
+	return nil
+}
+
+// getScalarIndexedFieldIDs returns the scalar fields of the collection with scalar indexes, the indexes of the
+// collection are cached, so that loading the segments of the collection doesn't describe them again
+func (loader *indexLoader) getScalarIndexedFieldIDs(collectionID UniqueID, schema *schemapb.CollectionSchema) ([]FieldID, error) {
+	descs, err := loader.describeIndexes(collectionID, indexDescriptionsTTL)
+	if err != nil {
+		return nil, err
+	}
+
+	fieldIDs := make([]FieldID, 0)
+	for _, desc := range descs {
+		if !indexparamcheck.IsScalarIndexType(indexparamcheck.GetIndexType(desc.Params)) {
+			continue
+		}
+		for _, field := range schema.Fields {
+			if field.Name == desc.FieldName && !typeutil.IsVectorType(field.DataType) {
+				fieldIDs = append(fieldIDs, field.FieldID)
+			}
+		}
+	}
+	return fieldIDs, nil
+}
+
+// getNonMmapIndexedFieldIDs returns the vector fields of the collection with indexes that segcore doesn't map from
+// local files, the vectors of which are held in memory even if mmap is enabled
+func (loader *indexLoader) getNonMmapIndexedFieldIDs(collectionID UniqueID, schema *schemapb.CollectionSchema) ([]FieldID, error) {
+	descs, err := loader.describeIndexes(collectionID, indexDescriptionsTTL)
+	if err != nil {
+		return nil, err
+	}
+
+	fieldIDs := make([]FieldID, 0)
+	for _, desc := range descs {
+		if isMmapIndexType(indexparamcheck.GetIndexType(desc.Params)) {
+			continue
+		}
+		for _, field := range schema.Fields {
+			if field.Name == desc.FieldName && typeutil.IsVectorType(field.DataType) {
+				fieldIDs = append(fieldIDs, field.FieldID)
+			}
+		}
+	}
+	return fieldIDs, nil
+}
+
+// loadScalarIndex loads the scalar index of the field, which is used to skip the segment if no row could match the filter
+// and to pre-filter the rows searched
+func (loader *indexLoader) loadScalarIndex(collectionID UniqueID, segment *Segment, fieldID FieldID) error {
+	info, err := loader.getIndexInfo(collectionID, segment.segmentID, fieldID, "")
+	if err != nil {
+		return err
+	}
+	indexBuffer, indexParams, _, err := loader.getIndexBinlog(info.indexPaths)
+	if err != nil {
+		return err
+	}
+	indexType := indexParams[indexparamcheck.IndexTypeKey]
+	if !indexparamcheck.IsScalarIndexType(indexType) || len(indexBuffer) != 1 {
+		return fmt.Errorf("invalid scalar index of field %d, index type = %s", fieldID, indexType)
+	}
+	index, err := scalarindex.Load(indexBuffer[0])
+	if err != nil {
+		return err
+	}
+	segment.setScalarIndex(fieldID, index)
+	log.Debug("load scalar index done",
+		zap.Int64("segmentID", segment.segmentID),
+		zap.Int64("fieldID", fieldID),
+		zap.String("indexType", indexType),
+		zap.Int("rowCount", index.RowCount()))
+	return nil
+}
+
+func newIndexLoader(rootCoord types.RootCoord, indexCoord types.IndexCoord, replica ReplicaInterface, chunkManager storage.ChunkManager) *indexLoader {
 	return &indexLoader{
 		replica: replica,
 
@@ -223,7 +552,9 @@ func newIndexLoader(ctx context.Context, rootCoord types.RootCoord, indexCoord t
 		rootCoord:  rootCoord,
 		indexCoord: indexCoord,
 
-		kv: client,
+		chunkManager: chunkManager,
+
+		indexDescs: make(map[UniqueID]*cachedIndexDescriptions),
 	}
 }
 
@@ -14,10 +14,15 @@ package querynode
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
 func TestIndexLoader_setIndexInfo(t *testing.T) {
@@ -144,3 +149,241 @@ func TestIndexLoader_loadIndex(t *testing.T) {
 		assert.Error(t, err)
 	})
 }
+
+func TestIndexLoader_getIndexByName(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	historical, err := genSimpleHistorical(ctx)
+	assert.NoError(t, err)
+	loader := historical.loader.indexLoader
+	rc := &describeIndexRootCoord{
+		descriptions: []*milvuspb.IndexDescription{
+			{IndexName: "idx", IndexID: 11, FieldName: defaultVecFieldName},
+		},
+	}
+	loader.rootCoord = rc
+
+	desc, err := loader.getIndexByName(defaultCollectionID, simpleVecField.id, "idx")
+	assert.NoError(t, err)
+	assert.Equal(t, UniqueID(11), desc.IndexID)
+	_, err = loader.getIndexByName(defaultCollectionID, simpleVecField.id, "idx")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, rc.calls)
+
+	// the index created after the indexes were cached
+	rc.descriptions = append(rc.descriptions, &milvuspb.IndexDescription{IndexName: "new", IndexID: 12, FieldName: defaultVecFieldName})
+	_, err = loader.getIndexByName(defaultCollectionID, simpleVecField.id, "new")
+	assert.Error(t, err)
+	assert.Equal(t, 1, rc.calls)
+	loader.indexDescs[defaultCollectionID].updateTime = time.Now().Add(-indexDescriptionsMinRefresh)
+	desc, err = loader.getIndexByName(defaultCollectionID, simpleVecField.id, "new")
+	assert.NoError(t, err)
+	assert.Equal(t, UniqueID(12), desc.IndexID)
+	assert.Equal(t, 2, rc.calls)
+
+	_, err = loader.getIndexByName(defaultCollectionID, simpleConstField.id, "idx")
+	assert.Error(t, err)
+
+	loader.invalidateIndexDescriptions(defaultCollectionID)
+	_, err = loader.getIndexByName(defaultCollectionID, simpleVecField.id, "idx")
+	assert.NoError(t, err)
+	assert.Equal(t, 3, rc.calls)
+}
+
+// extraIndexRootCoord describes the indexes, and the ones in builtIndexNames are built on the segments
+type extraIndexRootCoord struct {
+	*mockRootCoord
+	indexes         []*milvuspb.IndexDescription
+	builtIndexNames []string
+}
+
+func (m *extraIndexRootCoord) DescribeSegment(ctx context.Context, req *milvuspb.DescribeSegmentRequest) (*milvuspb.DescribeSegmentResponse, error) {
+	for _, index := range m.indexes {
+		if index.IndexName == req.IndexName && funcutil.SliceContain(m.builtIndexNames, index.IndexName) {
+			return &milvuspb.DescribeSegmentResponse{
+				Status:      &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+				IndexID:     index.IndexID,
+				BuildID:     buildID,
+				EnableIndex: true,
+			}, nil
+		}
+	}
+	return &milvuspb.DescribeSegmentResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    "index not built on segment",
+		},
+	}, nil
+}
+
+func (m *extraIndexRootCoord) DescribeIndex(ctx context.Context, req *milvuspb.DescribeIndexRequest) (*milvuspb.DescribeIndexResponse, error) {
+	return &milvuspb.DescribeIndexResponse{
+		Status:            &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		IndexDescriptions: m.indexes,
+	}, nil
+}
+
+func TestIndexLoader_loadExtraIndexes(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	historical, err := genSimpleHistorical(ctx)
+	assert.NoError(t, err)
+	loader := historical.loader.indexLoader
+	fieldName, err := loader.getFieldName(defaultCollectionID, simpleVecField.id)
+	assert.NoError(t, err)
+	loader.rootCoord = &extraIndexRootCoord{
+		mockRootCoord: newMockRootCoord(),
+		indexes: []*milvuspb.IndexDescription{
+			{IndexName: indexName, IndexID: indexID + 1, FieldName: fieldName},
+			{IndexName: "extra", IndexID: indexID + 2, FieldName: fieldName},
+			{IndexName: "not_built", IndexID: indexID + 3, FieldName: fieldName},
+		},
+		builtIndexNames: []string{indexName, "extra"},
+	}
+	loader.indexCoord = newMockIndexCoord()
+
+	segment, err := genSimpleSealedSegment()
+	assert.NoError(t, err)
+	err = loader.addIndex(segment, simpleVecField.id, indexID+1, indexName)
+	assert.NoError(t, err)
+
+	loader.loadExtraIndexes(segment, simpleVecField.id)
+	assert.Equal(t, indexID+1, segment.getIndexID(simpleVecField.id))
+	assert.NotNil(t, segment.getLoadedIndexInfo(simpleVecField.id, indexID+2))
+	assert.Nil(t, segment.getLoadedIndexInfo(simpleVecField.id, indexID+3))
+
+	// the extra indexes are counted by the memory check
+	col, err := historical.replica.getCollectionByID(defaultCollectionID)
+	assert.NoError(t, err)
+	size, err := historical.loader.estimateExtraIndexMemory(col, defaultMsgLength)
+	assert.NoError(t, err)
+	var vecField *schemapb.FieldSchema
+	for _, field := range col.Schema().Fields {
+		if field.FieldID == simpleVecField.id {
+			vecField = field
+		}
+	}
+	sizePerRecord, err := typeutil.EstimateSizePerRecord(&schemapb.CollectionSchema{Fields: []*schemapb.FieldSchema{vecField}})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2*sizePerRecord*defaultMsgLength), size)
+}
+
+func TestIndexLoader_swapIndex(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	historical, err := genSimpleHistorical(ctx)
+	assert.NoError(t, err)
+	loader := historical.loader.indexLoader
+	loader.rootCoord = newMockRootCoord()
+	loader.indexCoord = newMockIndexCoord()
+
+	segment, err := genSimpleSealedSegment()
+	assert.NoError(t, err)
+	err = loader.setIndexInfo(defaultCollectionID, segment, simpleVecField.id)
+	assert.NoError(t, err)
+	err = loader.loadIndex(segment, simpleVecField.id)
+	assert.NoError(t, err)
+
+	// load the rebuilt index besides the replaced one
+	rebuiltID := indexID + 1
+	paths, err := generateIndex(defaultSegmentID)
+	assert.NoError(t, err)
+	indexBuffer, indexParams, _, err := loader.getIndexBinlog(paths)
+	assert.NoError(t, err)
+	err = segment.addSegmentIndex(indexBuffer, simpleVecField.id, &indexInfo{
+		indexID:     rebuiltID,
+		indexPaths:  paths,
+		indexParams: indexParams,
+		readyLoad:   true,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, indexID, segment.getIndexID(simpleVecField.id))
+
+	err = loader.swapIndex(segment, simpleVecField.id, rebuiltID, indexName, indexID)
+	assert.NoError(t, err)
+	assert.Equal(t, rebuiltID, segment.getIndexID(simpleVecField.id))
+	assert.Nil(t, segment.getLoadedIndexInfo(simpleVecField.id, indexID))
+	assert.NotNil(t, segment.getLoadedIndexInfo(simpleVecField.id, rebuiltID))
+
+	// the replaced index isn't loaded any more
+	err = loader.swapIndex(segment, simpleVecField.id, rebuiltID, indexName, indexID)
+	assert.NoError(t, err)
+	assert.Equal(t, rebuiltID, segment.getIndexID(simpleVecField.id))
+}
+
+// rebuildingRootCoord describes an index being rebuilt, and only the index it replaces is built on the segments
+type rebuildingRootCoord struct {
+	*mockRootCoord
+	indexes        []*milvuspb.IndexDescription
+	builtIndexName string
+}
+
+func (m *rebuildingRootCoord) DescribeSegment(ctx context.Context, req *milvuspb.DescribeSegmentRequest) (*milvuspb.DescribeSegmentResponse, error) {
+	if req.IndexName != m.builtIndexName {
+		return &milvuspb.DescribeSegmentResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    "index not built on segment",
+			},
+		}, nil
+	}
+	return m.mockRootCoord.DescribeSegment(ctx, req)
+}
+
+func (m *rebuildingRootCoord) DescribeIndex(ctx context.Context, req *milvuspb.DescribeIndexRequest) (*milvuspb.DescribeIndexResponse, error) {
+	return &milvuspb.DescribeIndexResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		IndexDescriptions: m.indexes,
+	}, nil
+}
+
+func TestIndexLoader_setIndexInfo_replacedIndex(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	historical, err := genSimpleHistorical(ctx)
+	assert.NoError(t, err)
+	loader := historical.loader.indexLoader
+	fieldName, err := loader.getFieldName(defaultCollectionID, simpleVecField.id)
+	assert.NoError(t, err)
+	replacedName := indexName + "_bak"
+	rootCoord := &rebuildingRootCoord{
+		mockRootCoord: newMockRootCoord(),
+		indexes: []*milvuspb.IndexDescription{
+			{IndexName: indexName, IndexID: indexID + 1, FieldName: fieldName, ReplacedIndexID: indexID},
+			{IndexName: replacedName, IndexID: indexID, FieldName: fieldName},
+		},
+		builtIndexName: replacedName,
+	}
+	loader.rootCoord = rootCoord
+	loader.indexCoord = newMockIndexCoord()
+
+	t.Run("fall back to the replaced index", func(t *testing.T) {
+		segment, err := genSimpleSealedSegment()
+		assert.NoError(t, err)
+		err = loader.setIndexInfo(defaultCollectionID, segment, simpleVecField.id)
+		assert.NoError(t, err)
+		assert.Equal(t, indexID, segment.getIndexID(simpleVecField.id))
+		assert.True(t, segment.getEnableIndex())
+	})
+
+	t.Run("no index being rebuilt", func(t *testing.T) {
+		rootCoord.indexes = []*milvuspb.IndexDescription{
+			{IndexName: indexName, IndexID: indexID + 1, FieldName: fieldName},
+		}
+		loader.indexDescMu.Lock()
+		delete(loader.indexDescs, defaultCollectionID)
+		loader.indexDescMu.Unlock()
+
+		segment, err := genSimpleSealedSegment()
+		assert.NoError(t, err)
+		err = loader.setIndexInfo(defaultCollectionID, segment, simpleVecField.id)
+		assert.Error(t, err)
+		assert.False(t, segment.getEnableIndex())
+	})
+}
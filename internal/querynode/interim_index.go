@@ -0,0 +1,171 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package querynode
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+)
+
+const metricTypeKey = "metric_type"
+
+// refreshInterimIndexSchemaTimeout is the timeout of describing the indexes before a growing segment is created
+const refreshInterimIndexSchemaTimeout = 3 * time.Second
+
+// interimIndexSchema returns the schema of the streaming collection with the metric type of the index set on the float
+// vector fields, which makes segcore build interim IVF indexes on the full chunks of the growing segments. The schema
+// is returned as is if interim indexes are disabled or no field without the metric type has an index yet.
+func interimIndexSchema(ctx context.Context, rootCoord types.RootCoord, schema *schemapb.CollectionSchema) *schemapb.CollectionSchema {
+	if !Params.InterimIndexEnabled || rootCoord == nil || schema == nil {
+		return schema
+	}
+	var newSchema *schemapb.CollectionSchema
+	for i, field := range schema.Fields {
+		if field.DataType != schemapb.DataType_FloatVector {
+			continue
+		}
+		if _, err := funcutil.GetAttrByKeyFromRepeatedKV(metricTypeKey, field.IndexParams); err == nil {
+			continue
+		}
+		metricType, err := getIndexMetricType(ctx, rootCoord, schema.Name, field.Name)
+		if err != nil {
+			log.Debug("no interim index for field",
+				zap.String("collection", schema.Name),
+				zap.String("field", field.Name),
+				zap.Error(err))
+			continue
+		}
+		// segcore builds interim indexes for L2 and IP only
+		if metricType != "L2" && metricType != "IP" {
+			continue
+		}
+		if newSchema == nil {
+			newSchema = proto.Clone(schema).(*schemapb.CollectionSchema)
+		}
+		newField := newSchema.Fields[i]
+		newField.IndexParams = append(newField.IndexParams, &commonpb.KeyValuePair{Key: metricTypeKey, Value: metricType})
+		log.Debug("enable interim index for field",
+			zap.String("collection", schema.Name),
+			zap.String("field", field.Name),
+			zap.String("metricType", metricType))
+	}
+	if newSchema == nil {
+		return schema
+	}
+	return newSchema
+}
+
+// getIndexMetricType returns the metric type of the index on the field, the default index is preferred if the field
+// has more than one index
+func getIndexMetricType(ctx context.Context, rootCoord types.RootCoord, collectionName, fieldName string) (string, error) {
+	resp, err := rootCoord.DescribeIndex(ctx, &milvuspb.DescribeIndexRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:  commonpb.MsgType_DescribeIndex,
+			SourceID: Params.QueryNodeID,
+		},
+		CollectionName: collectionName,
+		FieldName:      fieldName,
+	})
+	if err != nil {
+		return "", err
+	}
+	if resp.Status.ErrorCode != commonpb.ErrorCode_Success {
+		return "", errors.New(resp.Status.Reason)
+	}
+	metricType := ""
+	for _, desc := range resp.IndexDescriptions {
+		// the indexes of all fields are described
+		if desc.FieldName != fieldName {
+			continue
+		}
+		params := make(map[string]string)
+		for _, kv := range desc.Params {
+			if kv.Key == "params" {
+				nested, err := funcutil.ParseIndexParamsMap(kv.Value)
+				if err != nil {
+					continue
+				}
+				for k, v := range nested {
+					params[k] = v
+				}
+			} else {
+				params[kv.Key] = kv.Value
+			}
+		}
+		if m, ok := params[metricTypeKey]; ok && (metricType == "" || desc.IndexName == Params.DefaultIndexName) {
+			metricType = m
+		}
+	}
+	if metricType == "" {
+		return "", errors.New("no index with metric type on the field")
+	}
+	return metricType, nil
+}
+
+// refreshInterimIndexSchema sets the metric type of the indexes created since the streaming collection was added on its
+// schema, so that the growing segments created afterwards build interim indexes
+func (node *QueryNode) refreshInterimIndexSchema(ctx context.Context, collectionID UniqueID) {
+	if !Params.InterimIndexEnabled {
+		return
+	}
+	col, err := node.streaming.replica.getCollectionByID(collectionID)
+	if err != nil {
+		return
+	}
+	schema := col.Schema()
+	newSchema := interimIndexSchema(ctx, node.rootCoord, schema)
+	if newSchema == schema {
+		return
+	}
+	if err = col.updateSchema(newSchema); err != nil {
+		log.Warn("failed to update the interim index schema of collection", zap.Int64("collectionID", collectionID), zap.Error(err))
+		return
+	}
+	log.Debug("update the interim index schema of collection", zap.Int64("collectionID", collectionID))
+}
+
+// refreshInterimIndexSchemaForSegment is called before a growing segment of the collection is created, so that the
+// segment builds interim indexes for the indexes created after the collection was watched
+func (node *QueryNode) refreshInterimIndexSchemaForSegment(collectionID UniqueID) {
+	ctx, cancel := context.WithTimeout(node.queryNodeLoopCtx, refreshInterimIndexSchemaTimeout)
+	defer cancel()
+	node.refreshInterimIndexSchema(ctx, collectionID)
+}
+
+// dropInterimIndexes drops the interim indexes of the growing segments, whose sealed segments have been loaded with
+// the index
+func (node *QueryNode) dropInterimIndexes(segmentIDs []UniqueID) {
+	for _, segmentID := range segmentIDs {
+		sealed, err := node.historical.replica.getSegmentByID(segmentID)
+		if err != nil || sealed.getType() != segmentTypeIndexing {
+			continue
+		}
+		growing, err := node.streaming.replica.getSegmentByID(segmentID)
+		if err != nil || growing.getType() != segmentTypeGrowing {
+			continue
+		}
+		if err = growing.dropInterimIndex(); err != nil {
+			log.Warn("failed to drop interim index", zap.Int64("segmentID", segmentID), zap.Error(err))
+		}
+	}
+}
@@ -0,0 +1,115 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package querynode
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/types"
+)
+
+type describeIndexRootCoord struct {
+	types.RootCoord
+	descriptions []*milvuspb.IndexDescription
+	calls        int
+}
+
+func (rc *describeIndexRootCoord) DescribeIndex(ctx context.Context, req *milvuspb.DescribeIndexRequest) (*milvuspb.DescribeIndexResponse, error) {
+	rc.calls++
+	return &milvuspb.DescribeIndexResponse{
+		Status:            &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		IndexDescriptions: rc.descriptions,
+	}, nil
+}
+
+func TestInterimIndexSchema(t *testing.T) {
+	Params.Init()
+	ctx := context.Background()
+
+	schema := &schemapb.CollectionSchema{
+		Name: "coll",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64},
+			{FieldID: 101, Name: "vec", DataType: schemapb.DataType_FloatVector},
+		},
+	}
+	rc := &describeIndexRootCoord{
+		descriptions: []*milvuspb.IndexDescription{
+			{IndexName: "other", FieldName: "vec", Params: []*commonpb.KeyValuePair{{Key: "params", Value: `{"metric_type": "L2"}`}}},
+			{IndexName: Params.DefaultIndexName, FieldName: "vec", Params: []*commonpb.KeyValuePair{{Key: metricTypeKey, Value: "IP"}}},
+			{IndexName: Params.DefaultIndexName, FieldName: "pk", Params: []*commonpb.KeyValuePair{{Key: metricTypeKey, Value: "L2"}}},
+		},
+	}
+
+	newSchema := interimIndexSchema(ctx, rc, schema)
+	assert.Equal(t, 0, len(newSchema.Fields[0].IndexParams))
+	assert.Equal(t, []*commonpb.KeyValuePair{{Key: metricTypeKey, Value: "IP"}}, newSchema.Fields[1].IndexParams)
+	// the schema of the request is not changed
+	assert.Equal(t, 0, len(schema.Fields[1].IndexParams))
+
+	// no index with L2 or IP
+	rc.descriptions = []*milvuspb.IndexDescription{
+		{IndexName: Params.DefaultIndexName, FieldName: "vec", Params: []*commonpb.KeyValuePair{{Key: metricTypeKey, Value: "HAMMING"}}},
+	}
+	assert.Equal(t, schema, interimIndexSchema(ctx, rc, schema))
+	rc.descriptions = nil
+	assert.Equal(t, schema, interimIndexSchema(ctx, rc, schema))
+
+	Params.InterimIndexEnabled = false
+	defer func() { Params.InterimIndexEnabled = true }()
+	rc.descriptions = []*milvuspb.IndexDescription{
+		{IndexName: Params.DefaultIndexName, FieldName: "vec", Params: []*commonpb.KeyValuePair{{Key: metricTypeKey, Value: "L2"}}},
+	}
+	assert.Equal(t, schema, interimIndexSchema(ctx, rc, schema))
+}
+
+func TestQueryNode_refreshInterimIndexSchema(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	node, err := genSimpleQueryNode(ctx)
+	assert.NoError(t, err)
+
+	// the collection is added before the index is created
+	collectionID := UniqueID(1000)
+	schema := genSimpleSegCoreSchema()
+	schema.Fields[0].IndexParams = nil
+	err = node.streaming.replica.addCollection(collectionID, schema)
+	assert.NoError(t, err)
+	col, err := node.streaming.replica.getCollectionByID(collectionID)
+	assert.NoError(t, err)
+
+	rc := &describeIndexRootCoord{}
+	node.rootCoord = rc
+	node.refreshInterimIndexSchema(ctx, collectionID)
+	assert.Equal(t, schema, col.Schema())
+
+	rc.descriptions = []*milvuspb.IndexDescription{
+		{IndexName: Params.DefaultIndexName, FieldName: defaultVecFieldName, Params: []*commonpb.KeyValuePair{{Key: metricTypeKey, Value: "L2"}}},
+	}
+	node.refreshInterimIndexSchema(ctx, collectionID)
+	assert.Equal(t, []*commonpb.KeyValuePair{{Key: metricTypeKey, Value: "L2"}}, col.Schema().Fields[0].IndexParams)
+
+	// the index is not described again once the metric type is set
+	calls := rc.calls
+	node.refreshInterimIndexSchema(ctx, collectionID)
+	assert.Equal(t, calls, rc.calls)
+
+	// no collection
+	node.refreshInterimIndexSchema(ctx, UniqueID(1001))
+}
@@ -80,6 +80,18 @@ func (li *LoadIndexInfo) appendFieldInfo(fieldID FieldID) error {
 	return nil
 }
 
+func (li *LoadIndexInfo) appendIndexID(indexID UniqueID) error {
+	status := C.AppendIndexID(li.cLoadIndexInfo, C.int64_t(indexID))
+	errorCode := status.error_code
+
+	if errorCode != 0 {
+		errorMsg := C.GoString(status.error_msg)
+		defer C.free(unsafe.Pointer(status.error_msg))
+		return errors.New("AppendIndexID failed, C runtime error detected, error code = " + strconv.Itoa(int(errorCode)) + ", error msg = " + errorMsg)
+	}
+	return nil
+}
+
 func (li *LoadIndexInfo) appendIndex(bytesIndex [][]byte, indexKeys []string) error {
 	var cBinarySet C.CBinarySet
 	status := C.NewBinarySet(&cBinarySet)
@@ -121,3 +133,17 @@ func (li *LoadIndexInfo) appendIndex(bytesIndex [][]byte, indexKeys []string) er
 
 	return nil
 }
+
+// appendIndexFromFile loads the index from the local file written by writeIndexFile
+func (li *LoadIndexInfo) appendIndexFromFile(filePath string) error {
+	cFilePath := C.CString(filePath)
+	defer C.free(unsafe.Pointer(cFilePath))
+	status := C.AppendIndexFromFile(li.cLoadIndexInfo, cFilePath)
+	errorCode := status.error_code
+	if errorCode != 0 {
+		errorMsg := C.GoString(status.error_msg)
+		defer C.free(unsafe.Pointer(status.error_msg))
+		return errors.New("AppendIndexFromFile failed, C runtime error detected, error code = " + strconv.Itoa(int(errorCode)) + ", error msg = " + errorMsg)
+	}
+	return nil
+}
@@ -20,7 +20,6 @@ import (
 	"path"
 	"strconv"
 
-	minioKV "github.com/milvus-io/milvus/internal/kv/minio"
 	"github.com/milvus-io/milvus/internal/msgstream"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/etcdpb"
@@ -810,16 +809,7 @@ func generateInsertBinLog(collectionID UniqueID, partitionID UniqueID, segmentID
 	}
 
 	// create minio client
-	bucketName := Params.MinioBucketName
-	option := &minioKV.Option{
-		Address:           Params.MinioEndPoint,
-		AccessKeyID:       Params.MinioAccessKeyID,
-		SecretAccessKeyID: Params.MinioSecretAccessKey,
-		UseSSL:            Params.MinioUseSSLStr,
-		BucketName:        bucketName,
-		CreateBucket:      true,
-	}
-	kv, err := minioKV.NewMinIOKV(context.Background(), option)
+	cm, err := genRemoteChunkManager(context.Background())
 	if err != nil {
 		return nil, nil, err
 	}
@@ -834,7 +824,7 @@ func generateInsertBinLog(collectionID UniqueID, partitionID UniqueID, segmentID
 	for _, blob := range binLogs {
 		uid := rand.Int63n(100000000)
 		key := path.Join(keyPrefix, blob.Key, strconv.FormatInt(uid, 10))
-		err = kv.Save(key, string(blob.Value[:]))
+		err = cm.Write(key, blob.Value)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -52,6 +52,9 @@ func getSystemInfoMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest,
 			SimdType: Params.SimdType,
 		},
 	}
+	if node.streaming != nil {
+		nodeInfos.QuotaMetrics.MinFlowGraphTt = node.streaming.tSafeReplica.getMinTSafe()
+	}
 	resp, err := metricsinfo.MarshalComponentInfos(nodeInfos)
 	if err != nil {
 		return &milvuspb.GetMetricsResponse{
@@ -0,0 +1,187 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package querynode
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/indexparamcheck"
+)
+
+// mmapIndexTypes are the index types whose inverted lists segcore maps from local files
+var mmapIndexTypes = []string{
+	string(indexparamcheck.IndexFaissIvfSQ8),
+	string(indexparamcheck.IndexFaissIvfPQ),
+}
+
+const (
+	// ivfIndexBinaryName is the name of the faiss index in the binary set of the IVF indexes
+	ivfIndexBinaryName = "IVF"
+	// indexSliceMetaName is the name of the meta of the sliced binaries in the binary set of an index
+	indexSliceMetaName = "SLICE_META"
+	// mmapDirName is the directory under the mmap path owned by the query node, the other files under the mmap
+	// path are never touched
+	mmapDirName = "querynode"
+)
+
+func isMmapIndexType(indexType string) bool {
+	return funcutil.SliceContain(mmapIndexTypes, indexType)
+}
+
+// mmapDir returns the local directory of the files mapped by the query node
+func mmapDir() string {
+	return filepath.Join(Params.MmapPath, mmapDirName)
+}
+
+// mmapSegmentDir returns the local directory of the files mapped by the sealed segment
+func mmapSegmentDir(segmentID UniqueID) string {
+	return filepath.Join(mmapDir(), strconv.FormatInt(segmentID, 10))
+}
+
+func mmapIndexFilePath(segmentID UniqueID, fieldID FieldID, indexID UniqueID) string {
+	return filepath.Join(mmapSegmentDir(segmentID), fmt.Sprintf("index_%d_%d", fieldID, indexID))
+}
+
+func mmapFieldDataFilePath(segmentID UniqueID, fieldID FieldID) string {
+	return filepath.Join(mmapSegmentDir(segmentID), fmt.Sprintf("field_%d", fieldID))
+}
+
+// writeMmapFile writes a file to be mapped by segcore. The file is written aside and renamed into place, so a
+// file still mapped by the previously loaded index of the field is replaced rather than truncated under it.
+func writeMmapFile(filePath string, write func(w io.Writer) error) error {
+	if err := os.MkdirAll(filepath.Dir(filePath), os.ModePerm); err != nil {
+		return err
+	}
+	tmpPath := filePath + ".tmp"
+	f, err := os.Create(tmpPath)
+	if err != nil {
+		return err
+	}
+	w := bufio.NewWriter(f)
+	err = write(w)
+	if err == nil {
+		err = w.Flush()
+	}
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		_ = os.Remove(tmpPath)
+		return err
+	}
+	return os.Rename(tmpPath, filePath)
+}
+
+// writeIndexFile writes the faiss index in the binary set of the IVF index to the file,
+// reassembling the slices the binary was split into when the index was serialized
+func writeIndexFile(filePath string, bytesIndex [][]byte, indexKeys []string) error {
+	binaries := make(map[string][]byte, len(bytesIndex))
+	for i, b := range bytesIndex {
+		binaries[filepath.Base(indexKeys[i])] = b
+	}
+	pieces, err := getIndexBinaryPieces(binaries, ivfIndexBinaryName)
+	if err != nil {
+		return err
+	}
+	return writeMmapFile(filePath, func(w io.Writer) error {
+		for _, piece := range pieces {
+			if _, err := w.Write(piece); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// getIndexBinaryPieces returns the pieces of the named binary in order, which is the binary itself unless
+// knowhere sliced it on serialization and recorded the slices in the slice meta
+func getIndexBinaryPieces(binaries map[string][]byte, name string) ([][]byte, error) {
+	if b, ok := binaries[name]; ok {
+		return [][]byte{b}, nil
+	}
+	meta, ok := binaries[indexSliceMetaName]
+	if !ok {
+		return nil, fmt.Errorf("index binary %s not found", name)
+	}
+	var sliceMeta struct {
+		Meta []struct {
+			Name     string `json:"name"`
+			SliceNum int    `json:"slice_num"`
+			TotalLen int    `json:"total_len"`
+		} `json:"meta"`
+	}
+	// the slice meta is serialized with a trailing NUL
+	if err := json.Unmarshal(bytes.TrimRight(meta, "\x00"), &sliceMeta); err != nil {
+		return nil, err
+	}
+	for _, item := range sliceMeta.Meta {
+		if item.Name != name {
+			continue
+		}
+		pieces := make([][]byte, 0, item.SliceNum)
+		totalLen := 0
+		for i := 0; i < item.SliceNum; i++ {
+			slice, ok := binaries[fmt.Sprintf("%s_%d", name, i)]
+			if !ok {
+				return nil, fmt.Errorf("slice %d of index binary %s not found", i, name)
+			}
+			pieces = append(pieces, slice)
+			totalLen += len(slice)
+		}
+		if totalLen != item.TotalLen {
+			return nil, fmt.Errorf("slices of index binary %s have %d bytes, expect %d", name, totalLen, item.TotalLen)
+		}
+		return pieces, nil
+	}
+	return nil, fmt.Errorf("index binary %s not found", name)
+}
+
+// writeFieldDataFile writes the vectors to the file in the layout of the field data of segcore
+func writeFieldDataFile(filePath string, data interface{}) error {
+	return writeMmapFile(filePath, func(w io.Writer) error {
+		return binary.Write(w, binary.LittleEndian, data)
+	})
+}
+
+// removeMmapFiles removes the files mapped by the sealed segment, the mappings held by segcore stay valid until
+// they are unmapped
+func removeMmapFiles(segmentID UniqueID) {
+	if !Params.MmapEnabled {
+		return
+	}
+	if err := os.RemoveAll(mmapSegmentDir(segmentID)); err != nil {
+		log.Warn("failed to remove mmap files of segment", zap.Int64("segmentID", segmentID), zap.Error(err))
+	}
+}
+
+// cleanMmapDir removes the files left in the mmap directory of the query node by its last run, e.g. after a crash,
+// none of them is mapped since the segments are loaded from binlogs again
+func cleanMmapDir() {
+	if !Params.MmapEnabled {
+		return
+	}
+	if err := os.RemoveAll(mmapDir()); err != nil {
+		log.Warn("failed to remove stale mmap files", zap.String("path", mmapDir()), zap.Error(err))
+	}
+}
@@ -0,0 +1,181 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package querynode
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/util/indexparamcheck"
+)
+
+func TestGetIndexBinaryPieces(t *testing.T) {
+	pieces, err := getIndexBinaryPieces(map[string][]byte{"IVF": []byte("index")}, "IVF")
+	assert.NoError(t, err)
+	assert.Equal(t, [][]byte{[]byte("index")}, pieces)
+
+	meta := append([]byte(`{"meta":[{"name":"IVF","slice_num":2,"total_len":5}]}`), 0)
+	binaries := map[string][]byte{
+		"SLICE_META": meta,
+		"IVF_0":      []byte("ind"),
+		"IVF_1":      []byte("ex"),
+	}
+	pieces, err = getIndexBinaryPieces(binaries, "IVF")
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("index"), bytes.Join(pieces, nil))
+
+	delete(binaries, "IVF_1")
+	_, err = getIndexBinaryPieces(binaries, "IVF")
+	assert.Error(t, err)
+
+	binaries["IVF_1"] = []byte("e")
+	_, err = getIndexBinaryPieces(binaries, "IVF")
+	assert.Error(t, err)
+
+	_, err = getIndexBinaryPieces(map[string][]byte{"HNSW": []byte("index")}, "IVF")
+	assert.Error(t, err)
+}
+
+func TestWriteMmapFiles(t *testing.T) {
+	dir, err := ioutil.TempDir("", "mmap")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	indexPath := filepath.Join(dir, "1", "index_100")
+	meta := append([]byte(`{"meta":[{"name":"IVF","slice_num":2,"total_len":5}]}`), 0)
+	err = writeIndexFile(indexPath,
+		[][]byte{meta, []byte("ind"), []byte("ex")},
+		[]string{"files/1/SLICE_META", "files/1/IVF_0", "files/1/IVF_1"})
+	assert.NoError(t, err)
+	content, err := ioutil.ReadFile(indexPath)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("index"), content)
+
+	// the file is replaced as a whole, rather than rewritten in place
+	err = writeIndexFile(indexPath, [][]byte{[]byte("new")}, []string{"files/2/IVF"})
+	assert.NoError(t, err)
+	content, err = ioutil.ReadFile(indexPath)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("new"), content)
+	_, err = os.Stat(indexPath + ".tmp")
+	assert.True(t, os.IsNotExist(err))
+
+	dataPath := filepath.Join(dir, "1", "field_101")
+	vectors := []float32{1, 2, 3, 4}
+	err = writeFieldDataFile(dataPath, vectors)
+	assert.NoError(t, err)
+	content, err = ioutil.ReadFile(dataPath)
+	assert.NoError(t, err)
+	read := make([]float32, len(vectors))
+	assert.NoError(t, binary.Read(bytes.NewReader(content), binary.LittleEndian, read))
+	assert.Equal(t, vectors, read)
+}
+
+func TestSegmentLoader_estimateSegmentMemory(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	collectionID := UniqueID(0)
+	replica := newCollectionReplica(nil)
+	err := replica.addCollection(collectionID, genTestCollectionSchema(collectionID, false, 16))
+	assert.NoError(t, err)
+	loader := newSegmentLoader(ctx, nil, nil, replica, nil)
+	col, err := replica.getCollectionByID(collectionID)
+	assert.NoError(t, err)
+
+	loaded, transient, err := loader.estimateSegmentMemory(col, 10)
+	assert.NoError(t, err)
+	assert.Equal(t, int64((16*4+4)*10), loaded)
+	assert.Equal(t, int64(0), transient)
+
+	Params.MmapEnabled = true
+	defer func() {
+		Params.MmapEnabled = false
+	}()
+	setIndexes := func(indexType string) {
+		loader.indexLoader.indexDescs[collectionID] = &cachedIndexDescriptions{
+			descriptions: []*milvuspb.IndexDescription{{
+				FieldName: "vec",
+				Params:    []*commonpb.KeyValuePair{{Key: "index_type", Value: indexType}},
+			}},
+			updateTime: time.Now(),
+		}
+	}
+
+	// the vectors are mapped, but read into memory while loading
+	setIndexes(string(indexparamcheck.IndexFaissIvfSQ8))
+	loaded, transient, err = loader.estimateSegmentMemory(col, 10)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(4*10), loaded)
+	assert.Equal(t, int64(2*16*4*10), transient)
+
+	// the index is loaded into memory
+	setIndexes(string(indexparamcheck.IndexHNSW))
+	loaded, transient, err = loader.estimateSegmentMemory(col, 10)
+	assert.NoError(t, err)
+	assert.Equal(t, int64((16*4+4)*10), loaded)
+	assert.Equal(t, int64(0), transient)
+
+	// the vectors are counted in memory if the indexes can't be described
+	delete(loader.indexLoader.indexDescs, collectionID)
+	loaded, transient, err = loader.estimateSegmentMemory(col, 10)
+	assert.NoError(t, err)
+	assert.Equal(t, int64((16*4+4)*10), loaded)
+	assert.Equal(t, int64(0), transient)
+}
+
+func TestCleanMmapDir(t *testing.T) {
+	dir, err := ioutil.TempDir("", "mmap")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	mmapPath := Params.MmapPath
+	Params.MmapPath = dir
+	defer func() {
+		Params.MmapPath = mmapPath
+	}()
+
+	assert.NoError(t, os.MkdirAll(mmapSegmentDir(1), 0755))
+	assert.NoError(t, ioutil.WriteFile(mmapFieldDataFilePath(1, 100)+".tmp", []byte("vectors"), 0644))
+	// the files not owned by the query node
+	assert.NoError(t, os.MkdirAll(filepath.Join(dir, "2"), 0755))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "other"), []byte("other"), 0644))
+
+	// nothing is removed if mmap is disabled
+	cleanMmapDir()
+	_, err = os.Stat(mmapSegmentDir(1))
+	assert.NoError(t, err)
+
+	Params.MmapEnabled = true
+	defer func() {
+		Params.MmapEnabled = false
+	}()
+	cleanMmapDir()
+	_, err = os.Stat(mmapDir())
+	assert.True(t, os.IsNotExist(err))
+	entries, err := ioutil.ReadDir(dir)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(entries))
+
+	// the directory not exists
+	Params.MmapPath = filepath.Join(dir, "not_exist")
+	cleanMmapDir()
+}
@@ -44,8 +44,7 @@ const ctxTimeInMillisecond = 500
 const debug = false
 
 const (
-	dimKey        = "dim"
-	metricTypeKey = "metric_type"
+	dimKey = "dim"
 
 	defaultVecFieldName   = "vec"
 	defaultConstFieldName = "const"
@@ -226,16 +225,7 @@ func generateIndex(segmentID UniqueID) ([]string, error) {
 		return nil, err
 	}
 
-	option := &minioKV.Option{
-		Address:           Params.MinioEndPoint,
-		AccessKeyID:       Params.MinioAccessKeyID,
-		SecretAccessKeyID: Params.MinioSecretAccessKey,
-		UseSSL:            Params.MinioUseSSLStr,
-		BucketName:        Params.MinioBucketName,
-		CreateBucket:      true,
-	}
-
-	kv, err := minioKV.NewMinIOKV(context.Background(), option)
+	cm, err := genRemoteChunkManager(context.Background())
 	if err != nil {
 		return nil, err
 	}
@@ -268,7 +258,7 @@ func generateIndex(segmentID UniqueID) ([]string, error) {
 	for _, index := range serializedIndexBlobs {
 		p := strconv.Itoa(int(segmentID)) + "/" + index.Key
 		indexPaths = append(indexPaths, p)
-		err := kv.Save(p, string(index.Value))
+		err := cm.Write(p, index.Value)
 		if err != nil {
 			return nil, err
 		}
@@ -327,18 +317,15 @@ func genSimpleCollectionMeta() *etcdpb.CollectionMeta {
 
 // ---------- unittest util functions ----------
 // functions of third-party
-func genMinioKV(ctx context.Context) (*minioKV.MinIOKV, error) {
-	bucketName := Params.MinioBucketName
-	option := &minioKV.Option{
+func genMinioOption() *minioKV.Option {
+	return &minioKV.Option{
 		Address:           Params.MinioEndPoint,
 		AccessKeyID:       Params.MinioAccessKeyID,
 		SecretAccessKeyID: Params.MinioSecretAccessKey,
 		UseSSL:            Params.MinioUseSSLStr,
-		BucketName:        bucketName,
+		BucketName:        Params.MinioBucketName,
 		CreateBucket:      true,
 	}
-	kv, err := minioKV.NewMinIOKV(ctx, option)
-	return kv, err
 }
 
 func genEtcdKV() (*etcdkv.EtcdKV, error) {
@@ -385,11 +372,10 @@ func genLocalChunkManager() (storage.ChunkManager, error) {
 }
 
 func genRemoteChunkManager(ctx context.Context) (storage.ChunkManager, error) {
-	client, err := genMinioKV(ctx)
+	rcm, err := storage.NewMinioChunkManager(ctx, genMinioOption())
 	if err != nil {
 		return nil, err
 	}
-	rcm := storage.NewMinioChunkManager(client)
 
 	return rcm, nil
 }
@@ -401,11 +387,10 @@ func genVectorChunkManager(ctx context.Context) (storage.ChunkManager, error) {
 	}
 	lcm := storage.NewLocalChunkManager(p)
 
-	client, err := genMinioKV(ctx)
+	rcm, err := storage.NewMinioChunkManager(ctx, genMinioOption())
 	if err != nil {
 		return nil, err
 	}
-	rcm := storage.NewMinioChunkManager(client)
 
 	schema := genSimpleInsertDataSchema()
 	vcm := storage.NewVectorChunkManager(lcm, rcm, &etcdpb.CollectionMeta{
@@ -637,7 +622,7 @@ func saveBinLog(ctx context.Context,
 	}
 
 	log.Debug(".. [query node unittest] Saving bin logs to MinIO ..", zap.Int("number", len(binLogs)))
-	kvs := make(map[string]string, len(binLogs))
+	kvs := make(map[string][]byte, len(binLogs))
 
 	// write insert binlog
 	fieldBinlog := make([]*datapb.FieldBinlog, 0)
@@ -649,7 +634,7 @@ func saveBinLog(ctx context.Context,
 		}
 
 		key := genKey(collectionID, partitionID, segmentID, fieldID)
-		kvs[key] = string(blob.Value[:])
+		kvs[key] = blob.Value
 		fieldBinlog = append(fieldBinlog, &datapb.FieldBinlog{
 			FieldID: fieldID,
 			Binlogs: []string{key},
@@ -657,11 +642,11 @@ func saveBinLog(ctx context.Context,
 	}
 	log.Debug("[query node unittest] save binlog file to MinIO/S3")
 
-	kv, err := genMinioKV(ctx)
+	cm, err := genRemoteChunkManager(ctx)
 	if err != nil {
 		return nil, err
 	}
-	err = kv.MultiSave(kvs)
+	err = cm.MultiWrite(kvs)
 	return fieldBinlog, err
 }
 
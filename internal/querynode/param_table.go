@@ -45,6 +45,7 @@ type ParamTable struct {
 
 	FlowGraphMaxQueueLength int32
 	FlowGraphMaxParallelism int32
+	FlowGraphDedupWindow    int
 
 	// minio
 	MinioEndPoint        string
@@ -53,6 +54,15 @@ type ParamTable struct {
 	MinioUseSSLStr       bool
 	MinioBucketName      string
 
+	// disk cache
+	DiskCacheEnabled  bool
+	DiskCachePath     string
+	DiskCacheCapacity int64
+
+	// mmap
+	MmapEnabled bool
+	MmapPath    string
+
 	// search
 	SearchChannelNames         []string
 	SearchResultChannelNames   []string
@@ -76,6 +86,13 @@ type ParamTable struct {
 	// segcore
 	ChunkRows int64
 	SimdType  string
+
+	DefaultIndexName string
+
+	// interim indexes of growing segments
+	InterimIndexEnabled bool
+	InterimIndexNList   int64
+	InterimIndexNProbe  int64
 }
 
 var Params ParamTable
@@ -108,6 +125,13 @@ func (p *ParamTable) Init() {
 	p.initMinioUseSSLStr()
 	p.initMinioBucketName()
 
+	p.initDiskCacheEnabled()
+	p.initDiskCachePath()
+	p.initDiskCacheCapacity()
+
+	p.initMmapEnabled()
+	p.initMmapPath()
+
 	p.initPulsarAddress()
 	p.initRocksmqPath()
 	p.initEtcdEndpoints()
@@ -117,6 +141,7 @@ func (p *ParamTable) Init() {
 
 	p.initFlowGraphMaxQueueLength()
 	p.initFlowGraphMaxParallelism()
+	p.initFlowGraphDedupWindow()
 
 	p.initSearchReceiveBufSize()
 	p.initSearchPulsarBufSize()
@@ -132,6 +157,8 @@ func (p *ParamTable) Init() {
 
 	p.initSegcoreChunkRows()
 	p.initKnowhereSimdType()
+	p.initInterimIndex()
+	p.initDefaultIndexName()
 
 	p.initLogCfg()
 }
@@ -232,6 +259,41 @@ func (p *ParamTable) initFlowGraphMaxParallelism() {
 	p.FlowGraphMaxParallelism = p.ParseInt32("queryNode.dataSync.flowGraph.maxParallelism")
 }
 
+func (p *ParamTable) initFlowGraphDedupWindow() {
+	p.FlowGraphDedupWindow = p.ParseInt("queryNode.dataSync.flowGraph.dedupWindow")
+}
+
+// diskCache
+func (p *ParamTable) initDiskCacheEnabled() {
+	p.DiskCacheEnabled = p.ParseBool("queryNode.diskCache.enabled", false)
+}
+
+func (p *ParamTable) initDiskCachePath() {
+	cachePath, err := p.LoadWithDefault("queryNode.diskCache.path", "/tmp/milvus/disk_cache")
+	if err != nil {
+		panic(err)
+	}
+	p.DiskCachePath = cachePath
+}
+
+func (p *ParamTable) initDiskCacheCapacity() {
+	// capacity is configured in MB
+	p.DiskCacheCapacity = p.ParseInt64("queryNode.diskCache.capacity") * 1024 * 1024
+}
+
+// mmap
+func (p *ParamTable) initMmapEnabled() {
+	p.MmapEnabled = p.ParseBool("queryNode.mmap.enabled", false)
+}
+
+func (p *ParamTable) initMmapPath() {
+	mmapPath, err := p.LoadWithDefault("queryNode.mmap.path", "/tmp/milvus/mmap")
+	if err != nil {
+		panic(err)
+	}
+	p.MmapPath = mmapPath
+}
+
 // msgStream
 func (p *ParamTable) initSearchReceiveBufSize() {
 	p.SearchReceiveBufSize = p.ParseInt64("queryNode.msgStream.search.recvBufSize")
@@ -311,6 +373,20 @@ func (p *ParamTable) initSegcoreChunkRows() {
 	p.ChunkRows = p.ParseInt64("queryNode.segcore.chunkRows")
 }
 
+func (p *ParamTable) initInterimIndex() {
+	p.InterimIndexEnabled = p.ParseBool("queryNode.segcore.interimIndex.enabled", true)
+	p.InterimIndexNList = p.ParseInt64("queryNode.segcore.interimIndex.nlist")
+	p.InterimIndexNProbe = p.ParseInt64("queryNode.segcore.interimIndex.nprobe")
+}
+
+func (p *ParamTable) initDefaultIndexName() {
+	name, err := p.Load("common.defaultIndexName")
+	if err != nil {
+		panic(err)
+	}
+	p.DefaultIndexName = name
+}
+
 func (p *ParamTable) initKnowhereSimdType() {
 	simdType, err := p.LoadWithDefault("knowhere.simdType", "auto")
 	if err != nil {
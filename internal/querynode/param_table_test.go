@@ -93,6 +93,22 @@ func TestParamTable_flowGraphMaxParallelism(t *testing.T) {
 	assert.Equal(t, int32(1024), maxParallelism)
 }
 
+func TestParamTable_flowGraphDedupWindow(t *testing.T) {
+	window := Params.FlowGraphDedupWindow
+	assert.Equal(t, 8192, window)
+}
+
+func TestParamTable_diskCache(t *testing.T) {
+	assert.False(t, Params.DiskCacheEnabled)
+	assert.Equal(t, "/tmp/milvus/disk_cache", Params.DiskCachePath)
+	assert.Equal(t, int64(10240*1024*1024), Params.DiskCacheCapacity)
+}
+
+func TestParamTable_mmap(t *testing.T) {
+	assert.False(t, Params.MmapEnabled)
+	assert.Equal(t, "/tmp/milvus/mmap", Params.MmapPath)
+}
+
 func TestParamTable_msgChannelSubName(t *testing.T) {
 	Params.QueryNodeID = 3
 	Params.initMsgChannelSubName()
@@ -24,10 +24,17 @@ import (
 	"errors"
 	"fmt"
 	"unsafe"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/milvus-io/milvus/internal/proto/planpb"
 )
 
 type SearchPlan struct {
 	cSearchPlan C.CSearchPlan
+	predicates  *planpb.Expr // filter used to skip the sealed segments by field statistics
+	annsFieldID FieldID      // vector field of the search, 0 if unknown
+	indexName   string       // index of the vector field to search with, empty for the default one
 }
 
 func createSearchPlan(col *Collection, dsl string) (*SearchPlan, error) {
@@ -62,10 +69,19 @@ func createSearchPlanByExpr(col *Collection, expr []byte) (*SearchPlan, error) {
 		return nil, err1
 	}
 
-	var newPlan = &SearchPlan{cSearchPlan: cPlan}
+	var newPlan = &SearchPlan{cSearchPlan: cPlan, predicates: parsePlanPredicates(expr), annsFieldID: parsePlanAnnsFieldID(expr)}
 	return newPlan, nil
 }
 
+// parsePlanAnnsFieldID returns the vector field searched by the serialized plan, 0 if it's not a vector search
+func parsePlanAnnsFieldID(expr []byte) FieldID {
+	planNode := &planpb.PlanNode{}
+	if err := proto.Unmarshal(expr, planNode); err != nil {
+		return 0
+	}
+	return planNode.GetVectorAnns().GetFieldId()
+}
+
 func (plan *SearchPlan) getTopK() int64 {
 	topK := C.GetTopK(plan.cSearchPlan)
 	return int64(topK)
@@ -78,6 +94,12 @@ func (plan *SearchPlan) getMetricType() string {
 	return metricType
 }
 
+// setIndexID sets the index searched on the sealed segments, the default index of the vector field is searched
+// on the segments the index isn't loaded on
+func (plan *SearchPlan) setIndexID(indexID UniqueID) {
+	C.SetSearchPlanIndexID(plan.cSearchPlan, C.int64_t(indexID))
+}
+
 func (plan *SearchPlan) delete() {
 	C.DeleteSearchPlan(plan.cSearchPlan)
 }
@@ -115,6 +137,7 @@ func (pg *searchRequest) delete() {
 type RetrievePlan struct {
 	cRetrievePlan C.CRetrievePlan
 	Timestamp     Timestamp
+	predicates    *planpb.Expr // filter used to skip the sealed segments by field statistics
 }
 
 // func createRetrievePlan(col *Collection, msg *segcorepb.RetrieveRequest, timestamp uint64) (*RetrievePlan, error) {
@@ -145,6 +168,7 @@ func createRetrievePlanByExpr(col *Collection, expr []byte, timestamp Timestamp)
 	var newPlan = &RetrievePlan{
 		cRetrievePlan: cPlan,
 		Timestamp:     timestamp,
+		predicates:    parsePlanPredicates(expr),
 	}
 	return newPlan, nil
 }
@@ -39,6 +39,7 @@ func TestPlan_Plan(t *testing.T) {
 	assert.Equal(t, int(topk), 10)
 	metricType := plan.getMetricType()
 	assert.Equal(t, metricType, "L2")
+	plan.setIndexID(1)
 	plan.delete()
 	deleteCollection(collection)
 }
@@ -63,6 +64,28 @@ func TestPlan_createSearchPlanByExpr(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestPlan_parsePlanAnnsFieldID(t *testing.T) {
+	planNode := &planpb.PlanNode{
+		Node: &planpb.PlanNode_VectorAnns{
+			VectorAnns: &planpb.VectorANNS{
+				FieldId: 101,
+			},
+		},
+	}
+	expr, err := proto.Marshal(planNode)
+	assert.NoError(t, err)
+	assert.Equal(t, FieldID(101), parsePlanAnnsFieldID(expr))
+
+	planNode = &planpb.PlanNode{
+		Node: &planpb.PlanNode_Predicates{Predicates: &planpb.Expr{}},
+	}
+	expr, err = proto.Marshal(planNode)
+	assert.NoError(t, err)
+	assert.Equal(t, FieldID(0), parsePlanAnnsFieldID(expr))
+
+	assert.Equal(t, FieldID(0), parsePlanAnnsFieldID([]byte{0xff}))
+}
+
 func TestPlan_NilCollection(t *testing.T) {
 	collection := &Collection{
 		id: defaultCollectionID,
@@ -821,7 +821,7 @@ func (q *queryCollection) search(msg queryMsg) error {
 		return err
 	}
 
-	schema, err := typeutil.CreateSchemaHelper(collection.schema)
+	schema, err := typeutil.CreateSchemaHelper(collection.Schema())
 	if err != nil {
 		return err
 	}
@@ -840,6 +840,7 @@ func (q *queryCollection) search(msg queryMsg) error {
 			return err
 		}
 	}
+	plan.indexName = searchMsg.IndexName
 	topK := plan.getTopK()
 	if topK == 0 {
 		return fmt.Errorf("limit must be greater than 0")
@@ -1100,7 +1101,7 @@ func (q *queryCollection) retrieve(msg queryMsg) error {
 		q.vectorChunkManager = storage.NewVectorChunkManager(q.localChunkManager, q.remoteChunkManager,
 			&etcdpb.CollectionMeta{
 				ID:     collection.id,
-				Schema: collection.schema,
+				Schema: collection.Schema(),
 			}, q.localCacheEnabled)
 	}
 	// historical retrieve
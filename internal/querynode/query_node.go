@@ -87,8 +87,7 @@ type QueryNode struct {
 
 	session *sessionutil.Session
 
-	minioKV kv.BaseKV // minio minioKV
-	etcdKV  *etcdkv.EtcdKV
+	etcdKV kv.MetaKv
 }
 
 // NewQueryNode will return a QueryNode with abnormal state.
@@ -130,6 +129,9 @@ func (node *QueryNode) InitSegcore() {
 	cChunkRows := C.int64_t(Params.ChunkRows)
 	C.SegcoreSetChunkRows(cChunkRows)
 
+	// override segcore interim index params of growing segments
+	C.SegcoreSetSmallIndexParams(C.int64_t(Params.InterimIndexNList), C.int64_t(Params.InterimIndexNProbe))
+
 	// override segcore SIMD type
 	cSimdType := C.CString(Params.SimdType)
 	cRealSimdType := C.SegcoreSetSimdType(cSimdType)
@@ -143,7 +145,7 @@ func (node *QueryNode) Init() error {
 	node.initOnce.Do(func() {
 		//ctx := context.Background()
 		connectEtcdFn := func() error {
-			etcdKV, err := etcdkv.NewEtcdKV(Params.EtcdEndpoints, Params.MetaRootPath)
+			etcdKV, err := etcdkv.NewMetaKv(Params.EtcdEndpoints, Params.MetaRootPath)
 			if err != nil {
 				return err
 			}
@@ -165,12 +167,15 @@ func (node *QueryNode) Init() error {
 			zap.Any("MetaRootPath", Params.MetaRootPath),
 		)
 
+		cleanMmapDir()
+
 		node.historical = newHistorical(node.queryNodeLoopCtx,
 			node.rootCoord,
 			node.indexCoord,
 			node.msFactory,
 			node.etcdKV)
 		node.streaming = newStreaming(node.queryNodeLoopCtx, node.msFactory, node.etcdKV)
+		node.streaming.dataSyncService.refreshSchema = node.refreshInterimIndexSchemaForSegment
 
 		node.InitSegcore()
 
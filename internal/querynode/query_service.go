@@ -70,11 +70,17 @@ func newQueryService(ctx context.Context,
 		BucketName:        Params.MinioBucketName,
 	}
 
-	client, err := miniokv.NewMinIOKV(ctx, option)
-	if err != nil {
-		panic(err)
+	var remoteChunkManager storage.ChunkManager
+	if historical != nil && historical.loader != nil && Params.DiskCacheEnabled {
+		// share the disk cache with the segment loader, vectors are cached and evicted by it instead
+		remoteChunkManager = historical.loader.chunkManager
+		localCacheEnabled = false
+	} else {
+		remoteChunkManager, err = storage.NewMinioChunkManager(ctx, option)
+		if err != nil {
+			panic(err)
+		}
 	}
-	remoteChunkManager := storage.NewMinioChunkManager(client)
 
 	return &queryService{
 		ctx:    queryServiceCtx,
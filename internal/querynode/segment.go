@@ -25,6 +25,7 @@ import (
 	"encoding/binary"
 	"errors"
 	"fmt"
+	"os"
 	"strconv"
 	"sync"
 	"unsafe"
@@ -36,9 +37,11 @@ import (
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/proto/segcorepb"
 	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/scalarindex"
 )
 
 type segmentType int32
@@ -64,6 +67,7 @@ func newVectorFieldInfo(fieldBinlog *datapb.FieldBinlog) *VectorFieldInfo {
 type Segment struct {
 	segPtrMu   sync.RWMutex // guards segmentPtr
 	segmentPtr C.CSegmentInterface
+	unpinFiles func() // releases the files of the segment pinned in the disk cache, called once the segment is deleted
 
 	segmentID    UniqueID
 	partitionID  UniqueID
@@ -84,8 +88,11 @@ type Segment struct {
 	typeMu      sync.Mutex // guards builtIndex
 	segmentType segmentType
 
-	paramMutex sync.RWMutex // guards index
-	indexInfos map[FieldID]*indexInfo
+	paramMutex    sync.RWMutex                        // guards index
+	indexInfos    map[FieldID]*indexInfo              // default index of the vector fields
+	loadedIndexes map[FieldID]map[UniqueID]*indexInfo // all the indexes loaded on the vector fields, by index id
+
+	indexMu sync.Mutex // serializes loading and dropping the vector indexes, searches don't take it
 
 	idBinlogRowSizes []int64
 
@@ -93,6 +100,12 @@ type Segment struct {
 	vectorFieldInfos map[UniqueID]*VectorFieldInfo
 
 	pkFilter *bloom.BloomFilter //  bloom filter of pk inside a segment
+
+	statsMutex sync.RWMutex // guards fieldStats
+	fieldStats map[FieldID]*storage.FieldStats
+
+	scalarIndexMutex sync.RWMutex // guards scalarIndexes
+	scalarIndexes    map[FieldID]scalarindex.Index
 }
 
 //-------------------------------------------------------------------------------------- common interfaces
@@ -120,6 +133,46 @@ func (s *Segment) getIDBinlogRowSizes() []int64 {
 	return s.idBinlogRowSizes
 }
 
+func (s *Segment) setFieldStats(fieldStats map[FieldID]*storage.FieldStats) {
+	s.statsMutex.Lock()
+	defer s.statsMutex.Unlock()
+	s.fieldStats = fieldStats
+}
+
+func (s *Segment) setScalarIndex(fieldID FieldID, index scalarindex.Index) {
+	s.scalarIndexMutex.Lock()
+	defer s.scalarIndexMutex.Unlock()
+	if s.scalarIndexes == nil {
+		s.scalarIndexes = make(map[FieldID]scalarindex.Index)
+	}
+	s.scalarIndexes[fieldID] = index
+}
+
+// mayMatch returns false if no row of the segment could match the filter according to the field statistics
+// and the scalar indexes
+func (s *Segment) mayMatch(predicates *planpb.Expr) bool {
+	_, matched := s.filterRows(predicates)
+	return matched
+}
+
+// filterRows returns false if no row of the segment could match the filter according to the field statistics
+// and the scalar indexes, otherwise the rows pre-filtered by the scalar indexes, nil if they can't evaluate the filter
+func (s *Segment) filterRows(predicates *planpb.Expr) (*rowFilter, bool) {
+	s.statsMutex.RLock()
+	matched := mayMatchStats(predicates, s.fieldStats)
+	s.statsMutex.RUnlock()
+	if !matched {
+		return nil, false
+	}
+	s.scalarIndexMutex.RLock()
+	defer s.scalarIndexMutex.RUnlock()
+	filter := scalarIndexRowFilter(predicates, s.scalarIndexes)
+	if filter != nil && !filter.rows.Any() {
+		return nil, false
+	}
+	return filter, true
+}
+
 func (s *Segment) setRecentlyModified(modify bool) {
 	s.rmMutex.Lock()
 	defer s.rmMutex.Unlock()
@@ -189,15 +242,16 @@ func newSegment(collection *Collection, segmentID UniqueID, partitionID UniqueID
 	log.Debug("create segment", zap.Int64("segmentID", segmentID), zap.Int32("segmentType", int32(segType)))
 
 	var segment = &Segment{
-		segmentPtr:       segmentPtr,
-		segmentType:      segType,
-		segmentID:        segmentID,
-		partitionID:      partitionID,
-		collectionID:     collectionID,
-		vChannelID:       vChannelID,
-		onService:        onService,
-		indexInfos:       make(map[int64]*indexInfo),
-		vectorFieldInfos: make(map[UniqueID]*VectorFieldInfo),
+		segmentPtr:        segmentPtr,
+		segmentType:       segType,
+		segmentID:         segmentID,
+		partitionID:       partitionID,
+		collectionID:      collectionID,
+		vChannelID:        vChannelID,
+		onService:         onService,
+		indexInfos:        make(map[int64]*indexInfo),
+		loadedIndexes:     make(map[FieldID]map[UniqueID]*indexInfo),
+		vectorFieldInfos:  make(map[UniqueID]*VectorFieldInfo),
 	}
 
 	return segment
@@ -217,6 +271,13 @@ func deleteSegment(segment *Segment) {
 	cPtr := segment.segmentPtr
 	C.DeleteSegment(cPtr)
 	segment.segmentPtr = nil
+	if segment.unpinFiles != nil {
+		segment.unpinFiles()
+		segment.unpinFiles = nil
+	}
+	if segment.getType() != segmentTypeGrowing {
+		removeMmapFiles(segment.ID())
+	}
 
 	log.Debug("delete segment", zap.Int64("segmentID", segment.ID()))
 
@@ -269,6 +330,15 @@ func (s *Segment) getMemSize() int64 {
 func (s *Segment) search(plan *SearchPlan,
 	searchRequests []*searchRequest,
 	timestamp []Timestamp) (*SearchResult, error) {
+	return s.searchWithRowFilter(plan, searchRequests, timestamp, nil)
+}
+
+// searchWithRowFilter searches the segment with the rows pre-filtered for the filter of the plan, nil to evaluate
+// the filter on all the rows
+func (s *Segment) searchWithRowFilter(plan *SearchPlan,
+	searchRequests []*searchRequest,
+	timestamp []Timestamp,
+	filter *rowFilter) (*SearchResult, error) {
 	/*
 		CStatus
 		Search(void* plan,
@@ -293,7 +363,18 @@ func (s *Segment) search(plan *SearchPlan,
 	cPlaceHolderGroup := cPlaceholderGroups[0]
 
 	log.Debug("do search on segment", zap.Int64("segmentID", s.segmentID), zap.Int32("segmentType", int32(s.segmentType)))
-	var status = C.Search(s.segmentPtr, plan.cSearchPlan, cPlaceHolderGroup, ts, &searchResult.cSearchResult)
+	var status C.CStatus
+	if filter != nil {
+		rows := filter.bytes()
+		var cRows *C.uint8_t
+		if len(rows) > 0 {
+			cRows = (*C.uint8_t)(unsafe.Pointer(&rows[0]))
+		}
+		status = C.SearchWithRowFilter(s.segmentPtr, plan.cSearchPlan, cPlaceHolderGroup, ts,
+			cRows, C.int64_t(filter.rows.Len()), C.bool(filter.exact), &searchResult.cSearchResult)
+	} else {
+		status = C.Search(s.segmentPtr, plan.cSearchPlan, cPlaceHolderGroup, ts, &searchResult.cSearchResult)
+	}
 	errorCode := status.error_code
 
 	if errorCode != 0 {
@@ -526,6 +607,20 @@ func (s *Segment) setIndexInfo(fieldID int64, info *indexInfo) error {
 	return nil
 }
 
+// getLoadedIndexInfo returns the index loaded on the vector field with the index id, nil if it isn't loaded
+func (s *Segment) getLoadedIndexInfo(fieldID FieldID, indexID UniqueID) *indexInfo {
+	s.paramMutex.RLock()
+	defer s.paramMutex.RUnlock()
+	return s.loadedIndexes[fieldID][indexID]
+}
+
+// hasLoadedIndex returns whether any index is loaded on the vector field
+func (s *Segment) hasLoadedIndex(fieldID FieldID) bool {
+	s.paramMutex.RLock()
+	defer s.paramMutex.RUnlock()
+	return len(s.loadedIndexes[fieldID]) > 0
+}
+
 func (s *Segment) checkIndexReady(fieldID int64) bool {
 	s.paramMutex.RLock()
 	defer s.paramMutex.RUnlock()
@@ -766,6 +861,42 @@ func (s *Segment) segmentLoadFieldData(fieldID int64, rowCount int, data interfa
 	return nil
 }
 
+// segmentLoadFieldDataFromFile loads the vector field data written to the local file, which segcore maps instead
+// of copying into memory
+func (s *Segment) segmentLoadFieldDataFromFile(fieldID int64, rowCount int, filePath string) error {
+	/*
+		CStatus
+		LoadFieldDataFromFile(CSegmentInterface c_segment, int64_t field_id, const char* file_path, int64_t row_count);
+	*/
+	s.segPtrMu.RLock()
+	defer s.segPtrMu.RUnlock() // thread safe guaranteed by segCore, use RLock
+	if s.segmentPtr == nil {
+		return errors.New("null seg core pointer")
+	}
+	if s.segmentType != segmentTypeSealed {
+		errMsg := fmt.Sprintln("segmentLoadFieldDataFromFile failed, illegal segment type ", s.segmentType, "segmentID = ", s.ID())
+		return errors.New(errMsg)
+	}
+
+	cFilePath := C.CString(filePath)
+	defer C.free(unsafe.Pointer(cFilePath))
+	var status = C.LoadFieldDataFromFile(s.segmentPtr, C.int64_t(fieldID), cFilePath, C.int64_t(rowCount))
+	errorCode := status.error_code
+	if errorCode != 0 {
+		errorMsg := C.GoString(status.error_msg)
+		defer C.free(unsafe.Pointer(status.error_msg))
+		return errors.New("LoadFieldDataFromFile failed, C runtime error detected, error code = " + strconv.Itoa(int(errorCode)) + ", error msg = " + errorMsg)
+	}
+
+	log.Debug("load field from file done",
+		zap.Int64("fieldID", fieldID),
+		zap.Int("row count", rowCount),
+		zap.String("path", filePath),
+		zap.Int64("segmentID", s.ID()))
+
+	return nil
+}
+
 func (s *Segment) dropFieldData(fieldID int64) error {
 	/*
 		CStatus
@@ -794,7 +925,20 @@ func (s *Segment) dropFieldData(fieldID int64) error {
 	return nil
 }
 
+// updateSegmentIndex loads the default index set by setIndexInfo on the vector field
 func (s *Segment) updateSegmentIndex(bytesIndex [][]byte, fieldID UniqueID) error {
+	s.paramMutex.RLock()
+	info, ok := s.indexInfos[fieldID]
+	s.paramMutex.RUnlock()
+	if !ok {
+		return fmt.Errorf("index info of field %d not set, segmentID = %d", fieldID, s.ID())
+	}
+	return s.addSegmentIndex(bytesIndex, fieldID, info)
+}
+
+// addSegmentIndex loads the index on the vector field besides the indexes already loaded on it,
+// the first index loaded on the field becomes the default one searched
+func (s *Segment) addSegmentIndex(bytesIndex [][]byte, fieldID UniqueID, info *indexInfo) error {
 	loadIndexInfo, err := newLoadIndexInfo()
 	defer deleteLoadIndexInfo(loadIndexInfo)
 	if err != nil {
@@ -804,15 +948,28 @@ func (s *Segment) updateSegmentIndex(bytesIndex [][]byte, fieldID UniqueID) erro
 	if err != nil {
 		return err
 	}
-	indexParams := s.getIndexParams(fieldID)
+	err = loadIndexInfo.appendIndexID(info.getIndexID())
+	if err != nil {
+		return err
+	}
+	indexParams := info.getIndexParams()
 	for k, v := range indexParams {
 		err = loadIndexInfo.appendIndexParam(k, v)
 		if err != nil {
 			return err
 		}
 	}
-	indexPaths := s.getIndexPaths(fieldID)
-	err = loadIndexInfo.appendIndex(bytesIndex, indexPaths)
+	indexPaths := info.getIndexPaths()
+	if Params.MmapEnabled && isMmapIndexType(indexParams["index_type"]) {
+		filePath := mmapIndexFilePath(s.ID(), fieldID, info.getIndexID())
+		err = writeIndexFile(filePath, bytesIndex, indexPaths)
+		if err != nil {
+			return err
+		}
+		err = loadIndexInfo.appendIndexFromFile(filePath)
+	} else {
+		err = loadIndexInfo.appendIndex(bytesIndex, indexPaths)
+	}
 	if err != nil {
 		return err
 	}
@@ -823,8 +980,9 @@ func (s *Segment) updateSegmentIndex(bytesIndex [][]byte, fieldID UniqueID) erro
 		return errors.New("null seg core pointer")
 	}
 
-	if s.segmentType != segmentTypeSealed {
-		errMsg := fmt.Sprintln("updateSegmentIndex failed, illegal segment type ", s.segmentType, "segmentID = ", s.ID())
+	segType := s.getType()
+	if segType != segmentTypeSealed && segType != segmentTypeIndexing {
+		errMsg := fmt.Sprintln("updateSegmentIndex failed, illegal segment type ", segType, "segmentID = ", s.ID())
 		return errors.New(errMsg)
 	}
 
@@ -836,12 +994,110 @@ func (s *Segment) updateSegmentIndex(bytesIndex [][]byte, fieldID UniqueID) erro
 		return errors.New("updateSegmentIndex failed, C runtime error detected, error code = " + strconv.Itoa(int(errorCode)) + ", error msg = " + errorMsg)
 	}
 
+	s.paramMutex.Lock()
+	if s.loadedIndexes == nil {
+		s.loadedIndexes = make(map[FieldID]map[UniqueID]*indexInfo)
+	}
+	if s.loadedIndexes[fieldID] == nil {
+		s.loadedIndexes[fieldID] = make(map[UniqueID]*indexInfo)
+	}
+	if len(s.loadedIndexes[fieldID]) == 0 {
+		s.indexInfos[fieldID] = info
+	}
+	s.loadedIndexes[fieldID][info.getIndexID()] = info
+	s.paramMutex.Unlock()
+
 	s.setType(segmentTypeIndexing)
-	log.Debug("updateSegmentIndex done", zap.Int64("segmentID", s.ID()))
+	log.Debug("updateSegmentIndex done",
+		zap.Int64("segmentID", s.ID()),
+		zap.Int64("fieldID", fieldID),
+		zap.Int64("indexID", info.getIndexID()))
 
 	return nil
 }
 
+// setDefaultSegmentIndex makes the index loaded on the vector field the default one searched
+func (s *Segment) setDefaultSegmentIndex(fieldID FieldID, indexID UniqueID) error {
+	/*
+		CStatus
+		SetSealedSegmentDefaultIndex(CSegmentInterface c_segment, int64_t field_id, int64_t index_id);
+	*/
+	s.segPtrMu.RLock()
+	defer s.segPtrMu.RUnlock() // thread safe guaranteed by segCore, use RLock
+	if s.segmentPtr == nil {
+		return errors.New("null seg core pointer")
+	}
+	info := s.getLoadedIndexInfo(fieldID, indexID)
+	if info == nil {
+		return fmt.Errorf("index %d not loaded on field %d, segmentID = %d", indexID, fieldID, s.ID())
+	}
+
+	var status = C.SetSealedSegmentDefaultIndex(s.segmentPtr, C.int64_t(fieldID), C.int64_t(indexID))
+	errorCode := status.error_code
+	if errorCode != 0 {
+		errorMsg := C.GoString(status.error_msg)
+		defer C.free(unsafe.Pointer(status.error_msg))
+		return errors.New("setDefaultSegmentIndex failed, C runtime error detected, error code = " + strconv.Itoa(int(errorCode)) + ", error msg = " + errorMsg)
+	}
+	s.paramMutex.Lock()
+	s.indexInfos[fieldID] = info
+	s.paramMutex.Unlock()
+
+	log.Debug("setDefaultSegmentIndex done", zap.Int64("fieldID", fieldID), zap.Int64("indexID", indexID), zap.Int64("segmentID", s.ID()))
+	return nil
+}
+
+// dropSegmentIndexByID drops one of the indexes loaded on the vector field, another loaded index becomes the
+// default one if the default index is dropped
+func (s *Segment) dropSegmentIndexByID(fieldID FieldID, indexID UniqueID) error {
+	/*
+		CStatus
+		DropSealedSegmentIndexByID(CSegmentInterface c_segment, int64_t field_id, int64_t index_id);
+	*/
+	s.segPtrMu.RLock()
+	defer s.segPtrMu.RUnlock() // thread safe guaranteed by segCore, use RLock
+	if s.segmentPtr == nil {
+		return errors.New("null seg core pointer")
+	}
+	if s.getType() != segmentTypeIndexing {
+		errMsg := fmt.Sprintln("dropSegmentIndexByID failed, illegal segment type ", s.getType(), "segmentID = ", s.ID())
+		return errors.New(errMsg)
+	}
+
+	var status = C.DropSealedSegmentIndexByID(s.segmentPtr, C.int64_t(fieldID), C.int64_t(indexID))
+	errorCode := status.error_code
+	if errorCode != 0 {
+		errorMsg := C.GoString(status.error_msg)
+		defer C.free(unsafe.Pointer(status.error_msg))
+		return errors.New("dropSegmentIndexByID failed, C runtime error detected, error code = " + strconv.Itoa(int(errorCode)) + ", error msg = " + errorMsg)
+	}
+
+	s.paramMutex.Lock()
+	delete(s.loadedIndexes[fieldID], indexID)
+	if info, ok := s.indexInfos[fieldID]; ok && info.getIndexID() == indexID {
+		delete(s.indexInfos, fieldID)
+		// segcore falls back to the loaded index with the smallest id
+		var defaultID UniqueID
+		for id := range s.loadedIndexes[fieldID] {
+			if defaultID == 0 || id < defaultID {
+				defaultID = id
+			}
+		}
+		if defaultID != 0 {
+			s.indexInfos[fieldID] = s.loadedIndexes[fieldID][defaultID]
+		}
+	}
+	s.paramMutex.Unlock()
+	if Params.MmapEnabled {
+		if err := os.Remove(mmapIndexFilePath(s.ID(), fieldID, indexID)); err != nil && !os.IsNotExist(err) {
+			log.Warn("failed to remove mmap file of index", zap.Int64("segmentID", s.ID()), zap.Error(err))
+		}
+	}
+
+	log.Debug("dropSegmentIndexByID done", zap.Int64("fieldID", fieldID), zap.Int64("indexID", indexID), zap.Int64("segmentID", s.ID()))
+	return nil
+}
+
 func (s *Segment) dropSegmentIndex(fieldID int64) error {
 	/*
 		CStatus
@@ -865,7 +1121,41 @@ func (s *Segment) dropSegmentIndex(fieldID int64) error {
 		return errors.New("dropSegmentIndex failed, C runtime error detected, error code = " + strconv.Itoa(int(errorCode)) + ", error msg = " + errorMsg)
 	}
 
+	s.paramMutex.Lock()
+	delete(s.loadedIndexes, fieldID)
+	s.paramMutex.Unlock()
+
 	log.Debug("dropSegmentIndex done", zap.Int64("fieldID", fieldID), zap.Int64("segmentID", s.ID()))
 
 	return nil
 }
+
+// dropInterimIndex drops the interim indexes built on the chunks of the growing segment, the segment is searched by
+// brute force after that
+func (s *Segment) dropInterimIndex() error {
+	/*
+		CStatus
+		DropGrowingSegmentSmallIndex(CSegmentInterface c_segment);
+	*/
+	s.segPtrMu.Lock()
+	defer s.segPtrMu.Unlock() // not concurrent with insert and search in segCore
+	if s.segmentPtr == nil {
+		return errors.New("null seg core pointer")
+	}
+	if s.segmentType != segmentTypeGrowing {
+		errMsg := fmt.Sprintln("dropInterimIndex failed, illegal segment type ", s.segmentType, "segmentID = ", s.ID())
+		return errors.New(errMsg)
+	}
+
+	var status = C.DropGrowingSegmentSmallIndex(s.segmentPtr)
+	errorCode := status.error_code
+	if errorCode != 0 {
+		errorMsg := C.GoString(status.error_msg)
+		defer C.free(unsafe.Pointer(status.error_msg))
+		return errors.New("dropInterimIndex failed, C runtime error detected, error code = " + strconv.Itoa(int(errorCode)) + ", error msg = " + errorMsg)
+	}
+
+	log.Debug("dropInterimIndex done", zap.Int64("segmentID", s.ID()))
+
+	return nil
+}
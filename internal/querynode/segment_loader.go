@@ -20,11 +20,11 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus/internal/kv"
-	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
 	minioKV "github.com/milvus-io/milvus/internal/kv/minio"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/rootcoord"
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/types"
@@ -43,8 +43,8 @@ type segmentLoader struct {
 
 	dataCoord types.DataCoord
 
-	minioKV kv.BaseKV // minio minioKV
-	etcdKV  *etcdkv.EtcdKV
+	chunkManager storage.ChunkManager // remote storage of binlogs
+	etcdKV       kv.MetaKv
 
 	indexLoader *indexLoader
 }
@@ -75,6 +75,7 @@ func (loader *segmentLoader) loadSegment(req *querypb.LoadSegmentsRequest, onSer
 	if err != nil {
 		return err
 	}
+	loader.warmupDiskCache(req.Infos)
 
 	newSegments := make([]*Segment, 0)
 	segmentGC := func() {
@@ -106,6 +107,9 @@ func (loader *segmentLoader) loadSegment(req *querypb.LoadSegmentsRequest, onSer
 			return err
 		}
 		segment := newSegment(collection, segmentID, partitionID, collectionID, "", segmentTypeSealed, onService)
+		// the binlogs are read again by the queries to retrieve the vectors, so they're pinned until the segment is deleted
+		pinned := loader.pinSegmentFiles(info)
+		segment.unpinFiles = func() { loader.unpinSegmentFiles(pinned) }
 		err = loader.loadSegmentInternal(collectionID, segment, info)
 		if err != nil {
 			deleteSegment(segment)
@@ -194,11 +198,37 @@ func (loader *segmentLoader) loadSegmentInternal(collectionID UniqueID, segment
 		if err != nil {
 			return err
 		}
+		loader.indexLoader.loadExtraIndexes(segment, id)
 	}
+	loader.loadScalarIndexes(collectionID, segment)
 
 	return nil
 }
 
+// loadScalarIndexes loads the scalar indexes of the segment, the segment is still served if they fail
+// to load, since they are only used to skip the segment or pre-filter its rows when filtering
+func (loader *segmentLoader) loadScalarIndexes(collectionID UniqueID, segment *Segment) {
+	collection, err := loader.historicalReplica.getCollectionByID(collectionID)
+	if err != nil {
+		log.Warn(err.Error())
+		return
+	}
+	fieldIDs, err := loader.indexLoader.getScalarIndexedFieldIDs(collectionID, collection.Schema())
+	if err != nil {
+		log.Debug("failed to get scalar indexes", zap.Int64("collectionID", collectionID), zap.Error(err))
+		return
+	}
+	for _, fieldID := range fieldIDs {
+		log.Debug("loading scalar index...", zap.Int64("segmentID", segment.segmentID), zap.Int64("fieldID", fieldID))
+		if err := loader.indexLoader.loadScalarIndex(collectionID, segment, fieldID); err != nil {
+			log.Warn("failed to load scalar index",
+				zap.Int64("segmentID", segment.segmentID),
+				zap.Int64("fieldID", fieldID),
+				zap.Error(err))
+		}
+	}
+}
+
 func (loader *segmentLoader) checkSegmentMemory(segmentLoadInfos []*querypb.SegmentLoadInfo) error {
 	totalRAMInMB := Params.CacheSize * 1024.0
 	usedRAMInMB := loader.historicalReplica.getSegmentsMemSize() / 1024.0 / 1024.0
@@ -213,13 +243,20 @@ func (loader *segmentLoader) checkSegmentMemory(segmentLoadInfos []*querypb.Segm
 			return err
 		}
 
-		sizePerRecord, err := typeutil.EstimateSizePerRecord(col.schema)
+		segmentSize, transientSize, err := loader.estimateSegmentMemory(col, segInfo.NumOfRows)
 		if err != nil {
 			return err
 		}
+		extraIndexSize, err := loader.estimateExtraIndexMemory(col, segInfo.NumOfRows)
+		if err != nil {
+			log.Warn("failed to estimate the memory of the extra indexes",
+				zap.Int64("collectionID", collectionID), zap.Error(err))
+		}
+		segmentSize += extraIndexSize
 
-		segmentSize := int64(sizePerRecord) * segInfo.NumOfRows
-		segmentTotalSize += segmentSize / 1024.0 / 1024.0
+		segmentTotalSize += segmentSize
+		// the segments are loaded one by one, the transient memory of a segment is released before loading the next
+		peakSizeInMB := (segmentTotalSize + transientSize) / 1024.0 / 1024.0
 		// TODO: get threshold factor from param table
 		thresholdMemSize := float64(totalRAMInMB) * 0.5
 
@@ -229,10 +266,11 @@ func (loader *segmentLoader) checkSegmentMemory(segmentLoadInfos []*querypb.Segm
 			zap.Any("numOfRows", segInfo.NumOfRows),
 			zap.Any("totalRAM(MB)", totalRAMInMB),
 			zap.Any("usedRAM(MB)", usedRAMInMB),
-			zap.Any("segmentTotalSize(MB)", segmentTotalSize),
+			zap.Any("segmentTotalSize(MB)", segmentTotalSize/1024.0/1024.0),
+			zap.Any("transientSize(MB)", transientSize/1024.0/1024.0),
 			zap.Any("thresholdMemSize(MB)", thresholdMemSize),
 		)
-		if usedRAMInMB+segmentTotalSize > int64(thresholdMemSize) {
+		if usedRAMInMB+peakSizeInMB > int64(thresholdMemSize) {
 			return errors.New("load segment failed, OOM if load, collectionID = " + fmt.Sprintln(collectionID))
 		}
 	}
@@ -240,6 +278,81 @@ func (loader *segmentLoader) checkSegmentMemory(segmentLoadInfos []*querypb.Segm
 	return nil
 }
 
+// estimateSegmentMemory estimates the memory held by a sealed segment of numRows rows once loaded, and the extra
+// memory held while it's being loaded. If mmap is enabled, the vectors of the fields without indexes or with the IVF
+// indexes in mmapIndexTypes are mapped from local files, but their binlogs or index files are still read and
+// deserialized in memory before written to the files, which is counted as the transient memory.
+func (loader *segmentLoader) estimateSegmentMemory(col *Collection, numRows int64) (int64, int64, error) {
+	schema := col.Schema()
+	if !Params.MmapEnabled {
+		sizePerRecord, err := typeutil.EstimateSizePerRecord(schema)
+		if err != nil {
+			return 0, 0, err
+		}
+		return int64(sizePerRecord) * numRows, 0, nil
+	}
+
+	inMemoryFieldIDs, err := loader.indexLoader.getNonMmapIndexedFieldIDs(col.ID(), schema)
+	if err != nil {
+		// count all the vectors in memory, which is the worst case
+		log.Warn("failed to get the index types of collection, estimate the memory without mmap",
+			zap.Int64("collectionID", col.ID()), zap.Error(err))
+		inMemoryFieldIDs = nil
+		for _, field := range schema.Fields {
+			inMemoryFieldIDs = append(inMemoryFieldIDs, field.FieldID)
+		}
+	}
+	inMemoryFields := make([]*schemapb.FieldSchema, 0, len(schema.Fields))
+	mmapFields := make([]*schemapb.FieldSchema, 0)
+	for _, field := range schema.Fields {
+		if typeutil.IsVectorType(field.DataType) && !funcutil.SliceContain(inMemoryFieldIDs, field.FieldID) {
+			mmapFields = append(mmapFields, field)
+		} else {
+			inMemoryFields = append(inMemoryFields, field)
+		}
+	}
+	sizePerRecord, err := typeutil.EstimateSizePerRecord(&schemapb.CollectionSchema{Fields: inMemoryFields})
+	if err != nil {
+		return 0, 0, err
+	}
+	mmapSizePerRecord, err := typeutil.EstimateSizePerRecord(&schemapb.CollectionSchema{Fields: mmapFields})
+	if err != nil {
+		return 0, 0, err
+	}
+	// both the blobs read and the data deserialized from them are held until written to the files
+	return int64(sizePerRecord) * numRows, 2 * int64(mmapSizePerRecord) * numRows, nil
+}
+
+// estimateExtraIndexMemory estimates the memory held by the vector indexes loaded on a sealed segment of numRows rows
+// besides the default index of each vector field, an index is estimated as large as the vectors it's built on.
+func (loader *segmentLoader) estimateExtraIndexMemory(col *Collection, numRows int64) (int64, error) {
+	descs, err := loader.indexLoader.describeIndexes(col.ID(), indexDescriptionsTTL)
+	if err != nil {
+		return 0, err
+	}
+	size := int64(0)
+	for _, field := range col.Schema().Fields {
+		if !typeutil.IsVectorType(field.DataType) {
+			continue
+		}
+		count := 0
+		for _, desc := range descs {
+			if desc.FieldName == field.Name {
+				count++
+			}
+		}
+		if count <= 1 {
+			continue
+		}
+		sizePerRecord, err := typeutil.EstimateSizePerRecord(&schemapb.CollectionSchema{Fields: []*schemapb.FieldSchema{field}})
+		if err != nil {
+			return 0, err
+		}
+		size += int64(count-1) * int64(sizePerRecord) * numRows
+	}
+	return size, nil
+}
+
 //func (loader *segmentLoader) GetSegmentStates(segmentID UniqueID) (*datapb.GetSegmentStatesResponse, error) {
 //	ctx := context.TODO()
 //	if loader.dataCoord == nil {
@@ -287,14 +400,14 @@ func (loader *segmentLoader) loadSegmentFieldsData(segment *Segment, fieldBinlog
 		)
 		for _, path := range fb.Binlogs {
 			p := path
-			binLog, err := loader.minioKV.Load(path)
+			binLog, err := loader.chunkManager.Read(path)
 			if err != nil {
 				// TODO: return or continue?
 				return err
 			}
 			blob := &storage.Blob{
 				Key:   p,
-				Value: []byte(binLog),
+				Value: binLog,
 			}
 			blobs = append(blobs, blob)
 		}
@@ -306,9 +419,17 @@ func (loader *segmentLoader) loadSegmentFieldsData(segment *Segment, fieldBinlog
 		return err
 	}
 
+	fieldStats, err := iCodec.DeserializeFieldStats(blobs)
+	if err != nil {
+		log.Warn(err.Error())
+		return err
+	}
+	segment.setFieldStats(fieldStats)
+
 	for fieldID, value := range insertData.Data {
 		var numRows []int64
 		var data interface{}
+		isVector := false
 		switch fieldData := value.(type) {
 		case *storage.BoolFieldData:
 			numRows = fieldData.NumRows
@@ -337,9 +458,11 @@ func (loader *segmentLoader) loadSegmentFieldsData(segment *Segment, fieldBinlog
 		case *storage.FloatVectorFieldData:
 			numRows = fieldData.NumRows
 			data = fieldData.Data
+			isVector = true
 		case *storage.BinaryVectorFieldData:
 			numRows = fieldData.NumRows
 			data = fieldData.Data
+			isVector = true
 		default:
 			return errors.New("unexpected field data type")
 		}
@@ -350,7 +473,15 @@ func (loader *segmentLoader) loadSegmentFieldsData(segment *Segment, fieldBinlog
 		for _, numRow := range numRows {
 			totalNumRows += numRow
 		}
-		err = segment.segmentLoadFieldData(fieldID, int(totalNumRows), data)
+		if Params.MmapEnabled && isVector {
+			filePath := mmapFieldDataFilePath(segment.segmentID, fieldID)
+			if err = writeFieldDataFile(filePath, data); err != nil {
+				return err
+			}
+			err = segment.segmentLoadFieldDataFromFile(fieldID, int(totalNumRows), filePath)
+		} else {
+			err = segment.segmentLoadFieldData(fieldID, int(totalNumRows), data)
+		}
 		if err != nil {
 			// TODO: return or continue?
 			return err
@@ -360,7 +491,57 @@ func (loader *segmentLoader) loadSegmentFieldsData(segment *Segment, fieldBinlog
 	return nil
 }
 
-func newSegmentLoader(ctx context.Context, rootCoord types.RootCoord, indexCoord types.IndexCoord, replica ReplicaInterface, etcdKV *etcdkv.EtcdKV) *segmentLoader {
+// warmupDiskCache downloads the binlogs of the segments to the disk cache in parallel before loading them one by one.
+func (loader *segmentLoader) warmupDiskCache(infos []*querypb.SegmentLoadInfo) {
+	cache, ok := loader.chunkManager.(*storage.DiskCacheChunkManager)
+	if !ok {
+		return
+	}
+	paths := make([]string, 0)
+	for _, info := range infos {
+		for _, fb := range info.BinlogPaths {
+			paths = append(paths, fb.Binlogs...)
+		}
+	}
+	if err := cache.Warmup(paths); err != nil {
+		log.Warn("failed to warm up disk cache, the missing binlogs are read from remote storage", zap.Error(err))
+	}
+}
+
+// pinSegmentFiles keeps the binlogs of the segment in the disk cache while the segment is in use.
+func (loader *segmentLoader) pinSegmentFiles(info *querypb.SegmentLoadInfo) []string {
+	cache, ok := loader.chunkManager.(*storage.DiskCacheChunkManager)
+	if !ok {
+		return nil
+	}
+	pinned := make([]string, 0)
+	for _, fb := range info.BinlogPaths {
+		for _, path := range fb.Binlogs {
+			if err := cache.Pin(path); err != nil {
+				log.Warn("failed to pin binlog in disk cache",
+					zap.Int64("segmentID", info.SegmentID),
+					zap.String("path", path),
+					zap.Error(err))
+				continue
+			}
+			pinned = append(pinned, path)
+		}
+	}
+	return pinned
+}
+
+func (loader *segmentLoader) unpinSegmentFiles(paths []string) {
+	cache, ok := loader.chunkManager.(*storage.DiskCacheChunkManager)
+	if !ok {
+		return
+	}
+	for _, path := range paths {
+		cache.Unpin(path)
+	}
+}
+
+// newRemoteChunkManager creates the chunk manager of minio, which is fronted by the local disk cache if enabled.
+func newRemoteChunkManager(ctx context.Context) storage.ChunkManager {
 	option := &minioKV.Option{
 		Address:           Params.MinioEndPoint,
 		AccessKeyID:       Params.MinioAccessKeyID,
@@ -370,17 +551,33 @@ func newSegmentLoader(ctx context.Context, rootCoord types.RootCoord, indexCoord
 		BucketName:        Params.MinioBucketName,
 	}
 
-	client, err := minioKV.NewMinIOKV(ctx, option)
+	chunkManager, err := storage.NewMinioChunkManager(ctx, option)
 	if err != nil {
 		panic(err)
 	}
+	if !Params.DiskCacheEnabled {
+		return chunkManager
+	}
+
+	cache, err := storage.NewDiskCacheChunkManager(storage.NewLocalChunkManager(Params.DiskCachePath), chunkManager, Params.DiskCacheCapacity)
+	if err != nil {
+		panic(err)
+	}
+	log.Debug("querynode disk cache enabled",
+		zap.String("path", Params.DiskCachePath),
+		zap.Int64("capacity", Params.DiskCacheCapacity))
+	return cache
+}
+
+func newSegmentLoader(ctx context.Context, rootCoord types.RootCoord, indexCoord types.IndexCoord, replica ReplicaInterface, etcdKV kv.MetaKv) *segmentLoader {
+	chunkManager := newRemoteChunkManager(ctx)
 
-	iLoader := newIndexLoader(ctx, rootCoord, indexCoord, replica)
+	iLoader := newIndexLoader(rootCoord, indexCoord, replica, chunkManager)
 	return &segmentLoader{
 		historicalReplica: replica,
 
-		minioKV: client,
-		etcdKV:  etcdKV,
+		chunkManager: chunkManager,
+		etcdKV:       etcdKV,
 
 		indexLoader: iLoader,
 	}
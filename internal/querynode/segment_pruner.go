@@ -0,0 +1,347 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package querynode
+
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/bits-and-blooms/bitset"
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/scalarindex"
+)
+
+// parsePlanPredicates returns the filter of the serialized plan, returns nil if the plan has no filter
+// or fails to be parsed, in which case no segment is skipped
+func parsePlanPredicates(expr []byte) *planpb.Expr {
+	planNode := &planpb.PlanNode{}
+	if err := proto.Unmarshal(expr, planNode); err != nil {
+		log.Warn("failed to parse plan predicates", zap.Error(err))
+		return nil
+	}
+	if anns := planNode.GetVectorAnns(); anns != nil {
+		return anns.GetPredicates()
+	}
+	return planNode.GetPredicates()
+}
+
+// maxExactInt64 is the max absolute value of integers exactly representable by float64
+const maxExactInt64 = 1 << 53
+
+// compareStatsValue compares the min and max of the field statistics with the value, ok is false if
+// they are not comparable, or the value can't be converted to the field type exactly, since the result
+// may depend on how segcore converts the value.
+func compareStatsValue(stats *storage.FieldStats, value *planpb.GenericValue) (cmpMin int, cmpMax int, ok bool) {
+	if stats == nil || !stats.HasMinMax || value == nil {
+		return 0, 0, false
+	}
+	switch stats.DataType {
+	case schemapb.DataType_Bool, schemapb.DataType_Int8, schemapb.DataType_Int16,
+		schemapb.DataType_Int32, schemapb.DataType_Int64:
+		var i int64
+		switch v := value.GetVal().(type) {
+		case *planpb.GenericValue_BoolVal:
+			if v.BoolVal {
+				i = 1
+			}
+		case *planpb.GenericValue_Int64Val:
+			i = v.Int64Val
+		case *planpb.GenericValue_FloatVal:
+			if math.Trunc(v.FloatVal) != v.FloatVal || math.Abs(v.FloatVal) > maxExactInt64 {
+				return 0, 0, false
+			}
+			i = int64(v.FloatVal)
+		default:
+			return 0, 0, false
+		}
+		return compareInt64(stats.MinInt64, i), compareInt64(stats.MaxInt64, i), true
+	case schemapb.DataType_Float, schemapb.DataType_Double:
+		var f float64
+		switch v := value.GetVal().(type) {
+		case *planpb.GenericValue_Int64Val:
+			if v.Int64Val > maxExactInt64 || v.Int64Val < -maxExactInt64 {
+				return 0, 0, false
+			}
+			f = float64(v.Int64Val)
+		case *planpb.GenericValue_FloatVal:
+			f = v.FloatVal
+		default:
+			return 0, 0, false
+		}
+		if math.IsNaN(f) || (stats.DataType == schemapb.DataType_Float && float64(float32(f)) != f) {
+			return 0, 0, false
+		}
+		return compareFloat64(stats.MinFloat64, f), compareFloat64(stats.MaxFloat64, f), true
+	}
+	return 0, 0, false
+}
+
+func compareInt64(a, b int64) int {
+	if a < b {
+		return -1
+	} else if a > b {
+		return 1
+	}
+	return 0
+}
+
+func compareFloat64(a, b float64) int {
+	if a < b {
+		return -1
+	} else if a > b {
+		return 1
+	}
+	return 0
+}
+
+// mayMatchStats returns false only if none of the rows described by the field statistics could match the
+// filter, it's always true for the filters unable to be decided by the statistics
+func mayMatchStats(expr *planpb.Expr, fieldStats map[FieldID]*storage.FieldStats) bool {
+	if expr == nil || len(fieldStats) == 0 {
+		return true
+	}
+	switch e := expr.GetExpr().(type) {
+	case *planpb.Expr_BinaryExpr:
+		switch e.BinaryExpr.GetOp() {
+		case planpb.BinaryExpr_LogicalAnd:
+			return mayMatchStats(e.BinaryExpr.GetLeft(), fieldStats) && mayMatchStats(e.BinaryExpr.GetRight(), fieldStats)
+		case planpb.BinaryExpr_LogicalOr:
+			return mayMatchStats(e.BinaryExpr.GetLeft(), fieldStats) || mayMatchStats(e.BinaryExpr.GetRight(), fieldStats)
+		}
+	case *planpb.Expr_UnaryRangeExpr:
+		stats := fieldStats[e.UnaryRangeExpr.GetColumnInfo().GetFieldId()]
+		cmpMin, cmpMax, ok := compareStatsValue(stats, e.UnaryRangeExpr.GetValue())
+		if !ok {
+			return true
+		}
+		switch e.UnaryRangeExpr.GetOp() {
+		case planpb.OpType_GreaterThan:
+			return cmpMax > 0
+		case planpb.OpType_GreaterEqual:
+			return cmpMax >= 0
+		case planpb.OpType_LessThan:
+			return cmpMin < 0
+		case planpb.OpType_LessEqual:
+			return cmpMin <= 0
+		case planpb.OpType_Equal:
+			return cmpMin <= 0 && cmpMax >= 0
+		case planpb.OpType_NotEqual:
+			return cmpMin != 0 || cmpMax != 0
+		}
+	case *planpb.Expr_BinaryRangeExpr:
+		stats := fieldStats[e.BinaryRangeExpr.GetColumnInfo().GetFieldId()]
+		_, lowerCmpMax, lowerOK := compareStatsValue(stats, e.BinaryRangeExpr.GetLowerValue())
+		upperCmpMin, _, upperOK := compareStatsValue(stats, e.BinaryRangeExpr.GetUpperValue())
+		if !lowerOK || !upperOK {
+			return true
+		}
+		lowerMatch := lowerCmpMax > 0 || (e.BinaryRangeExpr.GetLowerInclusive() && lowerCmpMax == 0)
+		upperMatch := upperCmpMin < 0 || (e.BinaryRangeExpr.GetUpperInclusive() && upperCmpMin == 0)
+		return lowerMatch && upperMatch
+	case *planpb.Expr_TermExpr:
+		stats := fieldStats[e.TermExpr.GetColumnInfo().GetFieldId()]
+		for _, value := range e.TermExpr.GetValues() {
+			cmpMin, cmpMax, ok := compareStatsValue(stats, value)
+			if !ok || (cmpMin <= 0 && cmpMax >= 0) {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+// scalarIndexKey encodes the value into the key of the scalar index of the data type, ok is false if the value
+// can't be converted to the data type exactly, since the result may depend on how segcore converts the value.
+func scalarIndexKey(dataType schemapb.DataType, value *planpb.GenericValue) (string, bool) {
+	switch dataType {
+	case schemapb.DataType_Bool:
+		if v, ok := value.GetVal().(*planpb.GenericValue_BoolVal); ok {
+			return scalarindex.EncodeBool(v.BoolVal), true
+		}
+	case schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32, schemapb.DataType_Int64:
+		var i int64
+		switch v := value.GetVal().(type) {
+		case *planpb.GenericValue_Int64Val:
+			i = v.Int64Val
+		case *planpb.GenericValue_FloatVal:
+			if math.Trunc(v.FloatVal) != v.FloatVal || math.Abs(v.FloatVal) > maxExactInt64 {
+				return "", false
+			}
+			i = int64(v.FloatVal)
+		default:
+			return "", false
+		}
+		if !fitsIntType(i, dataType) {
+			return "", false
+		}
+		return scalarindex.EncodeInt64(i), true
+	case schemapb.DataType_Float, schemapb.DataType_Double:
+		var f float64
+		switch v := value.GetVal().(type) {
+		case *planpb.GenericValue_Int64Val:
+			if v.Int64Val > maxExactInt64 || v.Int64Val < -maxExactInt64 {
+				return "", false
+			}
+			f = float64(v.Int64Val)
+		case *planpb.GenericValue_FloatVal:
+			f = v.FloatVal
+		default:
+			return "", false
+		}
+		if math.IsNaN(f) || (dataType == schemapb.DataType_Float && float64(float32(f)) != f) {
+			return "", false
+		}
+		return scalarindex.EncodeFloat64(f), true
+	}
+	return "", false
+}
+
+func fitsIntType(i int64, dataType schemapb.DataType) bool {
+	switch dataType {
+	case schemapb.DataType_Int8:
+		return i >= math.MinInt8 && i <= math.MaxInt8
+	case schemapb.DataType_Int16:
+		return i >= math.MinInt16 && i <= math.MaxInt16
+	case schemapb.DataType_Int32:
+		return i >= math.MinInt32 && i <= math.MaxInt32
+	}
+	return true
+}
+
+// evalScalarIndexes evaluates the filter with the scalar indexes, the rows returned are a superset of the rows
+// matching the filter, or exactly the matching rows if exact is true. nil is returned if the filter can't be
+// evaluated by the indexes.
+func evalScalarIndexes(expr *planpb.Expr, indexes map[FieldID]scalarindex.Index) (rows *bitset.BitSet, exact bool) {
+	switch e := expr.GetExpr().(type) {
+	case *planpb.Expr_BinaryExpr:
+		left, leftExact := evalScalarIndexes(e.BinaryExpr.GetLeft(), indexes)
+		right, rightExact := evalScalarIndexes(e.BinaryExpr.GetRight(), indexes)
+		switch e.BinaryExpr.GetOp() {
+		case planpb.BinaryExpr_LogicalAnd:
+			if left == nil {
+				return right, false
+			}
+			if right == nil {
+				return left, false
+			}
+			return left.Intersection(right), leftExact && rightExact
+		case planpb.BinaryExpr_LogicalOr:
+			if left == nil || right == nil {
+				return nil, false
+			}
+			return left.Union(right), leftExact && rightExact
+		}
+	case *planpb.Expr_UnaryExpr:
+		if e.UnaryExpr.GetOp() == planpb.UnaryExpr_Not {
+			child, childExact := evalScalarIndexes(e.UnaryExpr.GetChild(), indexes)
+			if child != nil && childExact {
+				return child.Complement(), true
+			}
+		}
+	case *planpb.Expr_TermExpr:
+		index, ok := indexes[e.TermExpr.GetColumnInfo().GetFieldId()]
+		if !ok {
+			return nil, false
+		}
+		keys := make([]string, 0, len(e.TermExpr.GetValues()))
+		for _, value := range e.TermExpr.GetValues() {
+			key, ok := scalarIndexKey(index.DataType(), value)
+			if !ok {
+				return nil, false
+			}
+			keys = append(keys, key)
+		}
+		return index.In(keys), true
+	case *planpb.Expr_UnaryRangeExpr:
+		index, ok := indexes[e.UnaryRangeExpr.GetColumnInfo().GetFieldId()]
+		if !ok {
+			return nil, false
+		}
+		key, ok := scalarIndexKey(index.DataType(), e.UnaryRangeExpr.GetValue())
+		if !ok {
+			return nil, false
+		}
+		switch e.UnaryRangeExpr.GetOp() {
+		case planpb.OpType_GreaterThan:
+			return index.Range(&scalarindex.Bound{Key: key}, nil), true
+		case planpb.OpType_GreaterEqual:
+			return index.Range(&scalarindex.Bound{Key: key, Inclusive: true}, nil), true
+		case planpb.OpType_LessThan:
+			return index.Range(nil, &scalarindex.Bound{Key: key}), true
+		case planpb.OpType_LessEqual:
+			return index.Range(nil, &scalarindex.Bound{Key: key, Inclusive: true}), true
+		case planpb.OpType_Equal:
+			return index.In([]string{key}), true
+		case planpb.OpType_NotEqual:
+			return index.In([]string{key}).Complement(), true
+		}
+	case *planpb.Expr_BinaryRangeExpr:
+		index, ok := indexes[e.BinaryRangeExpr.GetColumnInfo().GetFieldId()]
+		if !ok {
+			return nil, false
+		}
+		lower, lowerOK := scalarIndexKey(index.DataType(), e.BinaryRangeExpr.GetLowerValue())
+		upper, upperOK := scalarIndexKey(index.DataType(), e.BinaryRangeExpr.GetUpperValue())
+		if !lowerOK || !upperOK {
+			return nil, false
+		}
+		return index.Range(
+			&scalarindex.Bound{Key: lower, Inclusive: e.BinaryRangeExpr.GetLowerInclusive()},
+			&scalarindex.Bound{Key: upper, Inclusive: e.BinaryRangeExpr.GetUpperInclusive()}), true
+	}
+	return nil, false
+}
+
+// rowFilter is the rows of the sealed segment pre-filtered by the scalar indexes, segcore searches only the rows
+// matching the filter among them, and doesn't evaluate the filter at all if they are exactly the matching rows.
+// If they aren't exact, segcore still evaluates the filter on all the rows of the segment, and only skips it if no
+// row is left, so an inexact filter saves the search of the rows filtered out but not the evaluation.
+type rowFilter struct {
+	rows  *bitset.BitSet
+	exact bool
+}
+
+// scalarIndexRowFilter evaluates the filter with the scalar indexes, nil is returned if the filter can't be
+// evaluated by the indexes
+func scalarIndexRowFilter(expr *planpb.Expr, indexes map[FieldID]scalarindex.Index) *rowFilter {
+	if expr == nil || len(indexes) == 0 {
+		return nil
+	}
+	rows, exact := evalScalarIndexes(expr, indexes)
+	if rows == nil {
+		return nil
+	}
+	return &rowFilter{rows: rows, exact: exact}
+}
+
+// mayMatchScalarIndexes returns false only if the scalar indexes tell none of the rows could match the filter
+func mayMatchScalarIndexes(expr *planpb.Expr, indexes map[FieldID]scalarindex.Index) bool {
+	filter := scalarIndexRowFilter(expr, indexes)
+	return filter == nil || filter.rows.Any()
+}
+
+// bytes returns the rows as a bitmap with bit i of byte j for row j * 8 + i
+func (f *rowFilter) bytes() []byte {
+	words := f.rows.Bytes()
+	b := make([]byte, len(words)*8)
+	for i, word := range words {
+		binary.LittleEndian.PutUint64(b[i*8:], word)
+	}
+	return b
+}
@@ -0,0 +1,271 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package querynode
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/indexparamcheck"
+	"github.com/milvus-io/milvus/internal/util/scalarindex"
+)
+
+func int64Value(v int64) *planpb.GenericValue {
+	return &planpb.GenericValue{Val: &planpb.GenericValue_Int64Val{Int64Val: v}}
+}
+
+func floatValue(v float64) *planpb.GenericValue {
+	return &planpb.GenericValue{Val: &planpb.GenericValue_FloatVal{FloatVal: v}}
+}
+
+func unaryRangeExpr(fieldID FieldID, op planpb.OpType, value *planpb.GenericValue) *planpb.Expr {
+	return &planpb.Expr{
+		Expr: &planpb.Expr_UnaryRangeExpr{
+			UnaryRangeExpr: &planpb.UnaryRangeExpr{
+				ColumnInfo: &planpb.ColumnInfo{FieldId: fieldID},
+				Op:         op,
+				Value:      value,
+			},
+		},
+	}
+}
+
+func TestMayMatchStats(t *testing.T) {
+	fieldStats := map[FieldID]*storage.FieldStats{
+		100: {FieldID: 100, DataType: schemapb.DataType_Int64, RowNum: 10, HasMinMax: true, MinInt64: 10, MaxInt64: 20},
+		101: {FieldID: 101, DataType: schemapb.DataType_Double, RowNum: 10, HasMinMax: true, MinFloat64: -1.5, MaxFloat64: 1.5},
+		102: {FieldID: 102, DataType: schemapb.DataType_Float, RowNum: 10, HasMinMax: true, MinFloat64: 0, MaxFloat64: 1},
+	}
+
+	assert.True(t, mayMatchStats(nil, fieldStats))
+	assert.True(t, mayMatchStats(unaryRangeExpr(100, planpb.OpType_GreaterThan, int64Value(5)), nil))
+
+	assert.True(t, mayMatchStats(unaryRangeExpr(100, planpb.OpType_GreaterThan, int64Value(19)), fieldStats))
+	assert.False(t, mayMatchStats(unaryRangeExpr(100, planpb.OpType_GreaterThan, int64Value(20)), fieldStats))
+	assert.True(t, mayMatchStats(unaryRangeExpr(100, planpb.OpType_GreaterEqual, int64Value(20)), fieldStats))
+	assert.False(t, mayMatchStats(unaryRangeExpr(100, planpb.OpType_LessThan, int64Value(10)), fieldStats))
+	assert.True(t, mayMatchStats(unaryRangeExpr(100, planpb.OpType_LessEqual, int64Value(10)), fieldStats))
+	assert.False(t, mayMatchStats(unaryRangeExpr(100, planpb.OpType_Equal, int64Value(21)), fieldStats))
+	assert.True(t, mayMatchStats(unaryRangeExpr(100, planpb.OpType_NotEqual, int64Value(10)), fieldStats))
+	assert.False(t, mayMatchStats(unaryRangeExpr(101, planpb.OpType_LessThan, floatValue(-1.5)), fieldStats))
+	assert.False(t, mayMatchStats(unaryRangeExpr(101, planpb.OpType_GreaterThan, int64Value(2)), fieldStats))
+	// not exact for float
+	assert.True(t, mayMatchStats(unaryRangeExpr(102, planpb.OpType_GreaterThan, floatValue(1.1)), fieldStats))
+	assert.False(t, mayMatchStats(unaryRangeExpr(102, planpb.OpType_GreaterThan, floatValue(1.5)), fieldStats))
+	// not integral for int64
+	assert.True(t, mayMatchStats(unaryRangeExpr(100, planpb.OpType_GreaterThan, floatValue(20.5)), fieldStats))
+	// no statistics
+	assert.True(t, mayMatchStats(unaryRangeExpr(103, planpb.OpType_GreaterThan, int64Value(100)), fieldStats))
+
+	binaryRange := &planpb.Expr{
+		Expr: &planpb.Expr_BinaryRangeExpr{
+			BinaryRangeExpr: &planpb.BinaryRangeExpr{
+				ColumnInfo:     &planpb.ColumnInfo{FieldId: 100},
+				LowerInclusive: false,
+				UpperInclusive: true,
+				LowerValue:     int64Value(0),
+				UpperValue:     int64Value(10),
+			},
+		},
+	}
+	assert.True(t, mayMatchStats(binaryRange, fieldStats))
+	binaryRange.GetBinaryRangeExpr().UpperInclusive = false
+	assert.False(t, mayMatchStats(binaryRange, fieldStats))
+
+	term := &planpb.Expr{
+		Expr: &planpb.Expr_TermExpr{
+			TermExpr: &planpb.TermExpr{
+				ColumnInfo: &planpb.ColumnInfo{FieldId: 100},
+				Values:     []*planpb.GenericValue{int64Value(1), int64Value(30)},
+			},
+		},
+	}
+	assert.False(t, mayMatchStats(term, fieldStats))
+	term.GetTermExpr().Values = append(term.GetTermExpr().Values, int64Value(15))
+	assert.True(t, mayMatchStats(term, fieldStats))
+
+	and := &planpb.Expr{
+		Expr: &planpb.Expr_BinaryExpr{
+			BinaryExpr: &planpb.BinaryExpr{
+				Op:    planpb.BinaryExpr_LogicalAnd,
+				Left:  unaryRangeExpr(100, planpb.OpType_GreaterThan, int64Value(15)),
+				Right: unaryRangeExpr(101, planpb.OpType_GreaterThan, int64Value(2)),
+			},
+		},
+	}
+	assert.False(t, mayMatchStats(and, fieldStats))
+	and.GetBinaryExpr().Op = planpb.BinaryExpr_LogicalOr
+	assert.True(t, mayMatchStats(and, fieldStats))
+
+	not := &planpb.Expr{
+		Expr: &planpb.Expr_UnaryExpr{
+			UnaryExpr: &planpb.UnaryExpr{
+				Op:    planpb.UnaryExpr_Not,
+				Child: unaryRangeExpr(100, planpb.OpType_GreaterThan, int64Value(100)),
+			},
+		},
+	}
+	assert.True(t, mayMatchStats(not, fieldStats))
+}
+
+func TestMayMatchScalarIndexes(t *testing.T) {
+	newIndex := func(indexType string, dataType schemapb.DataType, keys []string) scalarindex.Index {
+		index, err := scalarindex.NewIndex(dataType, map[string]string{indexparamcheck.IndexTypeKey: indexType})
+		assert.NoError(t, err)
+		assert.NoError(t, index.Build(keys))
+		return index
+	}
+	// field 100: 1, 3, 5, 7; field 101: 0.5, 1.5, 2.5, 3.5; field 102: 1, 1, 2, 2
+	indexes := map[FieldID]scalarindex.Index{
+		100: newIndex(indexparamcheck.IndexScalarSorted, schemapb.DataType_Int64, []string{
+			scalarindex.EncodeInt64(1), scalarindex.EncodeInt64(3), scalarindex.EncodeInt64(5), scalarindex.EncodeInt64(7)}),
+		101: newIndex(indexparamcheck.IndexScalarInverted, schemapb.DataType_Double, []string{
+			scalarindex.EncodeFloat64(0.5), scalarindex.EncodeFloat64(1.5), scalarindex.EncodeFloat64(2.5), scalarindex.EncodeFloat64(3.5)}),
+		102: newIndex(indexparamcheck.IndexScalarBitmap, schemapb.DataType_Int8, []string{
+			scalarindex.EncodeInt64(1), scalarindex.EncodeInt64(1), scalarindex.EncodeInt64(2), scalarindex.EncodeInt64(2)}),
+	}
+	term := func(fieldID FieldID, values ...*planpb.GenericValue) *planpb.Expr {
+		return &planpb.Expr{
+			Expr: &planpb.Expr_TermExpr{
+				TermExpr: &planpb.TermExpr{
+					ColumnInfo: &planpb.ColumnInfo{FieldId: fieldID},
+					Values:     values,
+				},
+			},
+		}
+	}
+	binary := func(op planpb.BinaryExpr_BinaryOp, left, right *planpb.Expr) *planpb.Expr {
+		return &planpb.Expr{
+			Expr: &planpb.Expr_BinaryExpr{
+				BinaryExpr: &planpb.BinaryExpr{Op: op, Left: left, Right: right},
+			},
+		}
+	}
+	not := func(child *planpb.Expr) *planpb.Expr {
+		return &planpb.Expr{
+			Expr: &planpb.Expr_UnaryExpr{
+				UnaryExpr: &planpb.UnaryExpr{Op: planpb.UnaryExpr_Not, Child: child},
+			},
+		}
+	}
+
+	assert.True(t, mayMatchScalarIndexes(nil, indexes))
+	assert.True(t, mayMatchScalarIndexes(term(100, int64Value(4)), nil))
+
+	// gaps between values can't be told by the min/max statistics
+	assert.False(t, mayMatchScalarIndexes(term(100, int64Value(2), int64Value(4)), indexes))
+	assert.True(t, mayMatchScalarIndexes(term(100, int64Value(2), int64Value(5)), indexes))
+	assert.False(t, mayMatchScalarIndexes(unaryRangeExpr(100, planpb.OpType_Equal, floatValue(6)), indexes))
+	assert.True(t, mayMatchScalarIndexes(unaryRangeExpr(100, planpb.OpType_GreaterThan, int64Value(6)), indexes))
+	assert.False(t, mayMatchScalarIndexes(unaryRangeExpr(100, planpb.OpType_GreaterThan, int64Value(7)), indexes))
+	assert.False(t, mayMatchScalarIndexes(unaryRangeExpr(101, planpb.OpType_LessThan, floatValue(0.5)), indexes))
+	assert.True(t, mayMatchScalarIndexes(unaryRangeExpr(101, planpb.OpType_LessEqual, floatValue(0.5)), indexes))
+	assert.False(t, mayMatchScalarIndexes(&planpb.Expr{
+		Expr: &planpb.Expr_BinaryRangeExpr{
+			BinaryRangeExpr: &planpb.BinaryRangeExpr{
+				ColumnInfo:     &planpb.ColumnInfo{FieldId: 101},
+				LowerInclusive: false,
+				UpperInclusive: false,
+				LowerValue:     floatValue(1.5),
+				UpperValue:     floatValue(2.5),
+			},
+		},
+	}, indexes))
+	// out of the range of int8
+	assert.True(t, mayMatchScalarIndexes(term(102, int64Value(1000)), indexes))
+	assert.False(t, mayMatchScalarIndexes(term(102, int64Value(3)), indexes))
+	// no index
+	assert.True(t, mayMatchScalarIndexes(term(103, int64Value(3)), indexes))
+
+	// rows 0, 1 for field 100 and rows 2, 3 for field 102
+	assert.False(t, mayMatchScalarIndexes(binary(planpb.BinaryExpr_LogicalAnd,
+		unaryRangeExpr(100, planpb.OpType_LessThan, int64Value(5)),
+		unaryRangeExpr(102, planpb.OpType_Equal, int64Value(2))), indexes))
+	assert.True(t, mayMatchScalarIndexes(binary(planpb.BinaryExpr_LogicalOr,
+		unaryRangeExpr(100, planpb.OpType_LessThan, int64Value(5)),
+		unaryRangeExpr(102, planpb.OpType_Equal, int64Value(2))), indexes))
+	assert.False(t, mayMatchScalarIndexes(binary(planpb.BinaryExpr_LogicalAnd,
+		term(100, int64Value(4)), term(103, int64Value(3))), indexes))
+	assert.True(t, mayMatchScalarIndexes(binary(planpb.BinaryExpr_LogicalOr,
+		term(100, int64Value(4)), term(103, int64Value(3))), indexes))
+
+	assert.False(t, mayMatchScalarIndexes(not(unaryRangeExpr(100, planpb.OpType_GreaterEqual, int64Value(1))), indexes))
+	assert.True(t, mayMatchScalarIndexes(not(unaryRangeExpr(100, planpb.OpType_GreaterEqual, int64Value(3))), indexes))
+	assert.True(t, mayMatchScalarIndexes(unaryRangeExpr(102, planpb.OpType_NotEqual, int64Value(1)), indexes))
+	// not of an inexact result can't be evaluated
+	assert.True(t, mayMatchScalarIndexes(not(binary(planpb.BinaryExpr_LogicalAnd,
+		unaryRangeExpr(100, planpb.OpType_GreaterEqual, int64Value(1)), term(103, int64Value(3)))), indexes))
+}
+
+func TestScalarIndexRowFilter(t *testing.T) {
+	index, err := scalarindex.NewIndex(schemapb.DataType_Int64, map[string]string{indexparamcheck.IndexTypeKey: indexparamcheck.IndexScalarSorted})
+	assert.NoError(t, err)
+	keys := make([]string, 0, 10)
+	for i := 0; i < 10; i++ {
+		keys = append(keys, scalarindex.EncodeInt64(int64(i)))
+	}
+	assert.NoError(t, index.Build(keys))
+	indexes := map[FieldID]scalarindex.Index{100: index}
+
+	assert.Nil(t, scalarIndexRowFilter(nil, indexes))
+	assert.Nil(t, scalarIndexRowFilter(unaryRangeExpr(101, planpb.OpType_GreaterThan, int64Value(5)), indexes))
+
+	filter := scalarIndexRowFilter(unaryRangeExpr(100, planpb.OpType_GreaterEqual, int64Value(1)), indexes)
+	assert.NotNil(t, filter)
+	assert.True(t, filter.exact)
+	assert.Equal(t, uint(10), filter.rows.Len())
+	// rows 1 to 9
+	assert.Equal(t, []byte{0xfe, 0x03, 0, 0, 0, 0, 0, 0}, filter.bytes())
+
+	// the filter on the field without index is evaluated by segcore on the rows
+	filter = scalarIndexRowFilter(&planpb.Expr{
+		Expr: &planpb.Expr_BinaryExpr{
+			BinaryExpr: &planpb.BinaryExpr{
+				Op:    planpb.BinaryExpr_LogicalAnd,
+				Left:  unaryRangeExpr(100, planpb.OpType_LessThan, int64Value(3)),
+				Right: unaryRangeExpr(101, planpb.OpType_GreaterThan, int64Value(5)),
+			},
+		},
+	}, indexes)
+	assert.NotNil(t, filter)
+	assert.False(t, filter.exact)
+	assert.Equal(t, []byte{0x07, 0, 0, 0, 0, 0, 0, 0}, filter.bytes())
+}
+
+func TestParsePlanPredicates(t *testing.T) {
+	predicates := unaryRangeExpr(100, planpb.OpType_GreaterThan, int64Value(5))
+	planNode := &planpb.PlanNode{
+		Node: &planpb.PlanNode_VectorAnns{
+			VectorAnns: &planpb.VectorANNS{
+				FieldId:    101,
+				Predicates: predicates,
+			},
+		},
+	}
+	expr, err := proto.Marshal(planNode)
+	assert.NoError(t, err)
+	assert.True(t, proto.Equal(predicates, parsePlanPredicates(expr)))
+
+	planNode = &planpb.PlanNode{
+		Node: &planpb.PlanNode_Predicates{Predicates: predicates},
+	}
+	expr, err = proto.Marshal(planNode)
+	assert.NoError(t, err)
+	assert.True(t, proto.Equal(predicates, parsePlanPredicates(expr)))
+
+	assert.Nil(t, parsePlanPredicates([]byte{1, 2, 3}))
+}
@@ -18,7 +18,7 @@ import (
 
 	"go.uber.org/zap"
 
-	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
+	"github.com/milvus-io/milvus/internal/kv"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/msgstream"
 	"github.com/milvus-io/milvus/internal/proto/segcorepb"
@@ -35,7 +35,7 @@ type streaming struct {
 	msFactory       msgstream.Factory
 }
 
-func newStreaming(ctx context.Context, factory msgstream.Factory, etcdKV *etcdkv.EtcdKV) *streaming {
+func newStreaming(ctx context.Context, factory msgstream.Factory, etcdKV kv.MetaKv) *streaming {
 	replica := newCollectionReplica(etcdKV)
 	tReplica := newTSafeReplica()
 	newDS := newDataSyncService(ctx, replica, tReplica, factory)
@@ -141,10 +141,12 @@ func (w *watchDmChannelsTask) Execute(ctx context.Context) error {
 
 	// init replica
 	if hasCollectionInStreaming := w.node.streaming.replica.hasCollection(collectionID); !hasCollectionInStreaming {
-		err := w.node.streaming.replica.addCollection(collectionID, w.req.Schema)
+		err := w.node.streaming.replica.addCollection(collectionID, interimIndexSchema(ctx, w.node.rootCoord, w.req.Schema))
 		if err != nil {
 			return err
 		}
+	} else {
+		w.node.refreshInterimIndexSchema(ctx, collectionID)
 	}
 	w.node.streaming.replica.initExcludedSegments(collectionID)
 	if hasCollectionInHistorical := w.node.historical.replica.hasCollection(collectionID); !hasCollectionInHistorical {
@@ -348,6 +350,7 @@ func (l *loadSegmentsTask) Execute(ctx context.Context) error {
 	var err error
 
 	// init meta
+	refreshed := make(map[UniqueID]struct{})
 	for _, info := range l.req.Infos {
 		collectionID := info.CollectionID
 		partitionID := info.PartitionID
@@ -368,10 +371,14 @@ func (l *loadSegmentsTask) Execute(ctx context.Context) error {
 		hasCollectionInStreaming := l.node.streaming.replica.hasCollection(collectionID)
 		hasPartitionInStreaming := l.node.streaming.replica.hasPartition(partitionID)
 		if !hasCollectionInStreaming {
-			err = l.node.streaming.replica.addCollection(collectionID, l.req.Schema)
+			err = l.node.streaming.replica.addCollection(collectionID, interimIndexSchema(ctx, l.node.rootCoord, l.req.Schema))
 			if err != nil {
 				return err
 			}
+		} else if _, ok := refreshed[collectionID]; !ok {
+			// the segments loaded may come with an index created after the collection was added
+			l.node.refreshInterimIndexSchema(ctx, collectionID)
+			refreshed[collectionID] = struct{}{}
 		}
 		if !hasPartitionInStreaming {
 			err = l.node.streaming.replica.addPartition(collectionID, partitionID)
@@ -412,6 +419,12 @@ func (l *loadSegmentsTask) Execute(ctx context.Context) error {
 		hCol.deleteReleasedPartition(partitionID)
 	}
 
+	segmentIDs := make([]UniqueID, 0, len(l.req.Infos))
+	for _, info := range l.req.Infos {
+		segmentIDs = append(segmentIDs, info.SegmentID)
+	}
+	l.node.dropInterimIndexes(segmentIDs)
+
 	log.Debug("LoadSegments done", zap.String("SegmentLoadInfos", fmt.Sprintln(l.req.Infos)))
 	return nil
 }
@@ -493,6 +506,8 @@ func (r *releaseCollectionTask) Execute(ctx context.Context) error {
 	// remove query collection
 	r.node.queryService.stopQueryCollection(r.req.CollectionID)
 
+	r.node.historical.loader.indexLoader.invalidateIndexDescriptions(r.req.CollectionID)
+
 	// remove collection metas in streaming and historical
 	hasCollectionInHistorical := r.node.historical.replica.hasCollection(r.req.CollectionID)
 	if hasCollectionInHistorical {
@@ -24,6 +24,7 @@ import (
 // TSafeReplicaInterface is the interface wrapper of tSafeReplica
 type TSafeReplicaInterface interface {
 	getTSafe(vChannel Channel) (Timestamp, error)
+	getMinTSafe() Timestamp
 	setTSafe(vChannel Channel, id UniqueID, timestamp Timestamp) error
 	addTSafe(vChannel Channel)
 	removeTSafe(vChannel Channel) error
@@ -56,6 +57,24 @@ func (t *tSafeReplica) getTSafe(vChannel Channel) (Timestamp, error) {
 	return safer.get(), nil
 }
 
+// getMinTSafe returns the minimum tSafe of all the vChannels, the vChannels not consumed yet are skipped,
+// and 0 is returned if there is no tSafe
+func (t *tSafeReplica) getMinTSafe() Timestamp {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	var minTSafe Timestamp
+	for _, ref := range t.tSafes {
+		ts := ref.tSafer.get()
+		if ts == 0 {
+			continue
+		}
+		if minTSafe == 0 || ts < minTSafe {
+			minTSafe = ts
+		}
+	}
+	return minTSafe
+}
+
 func (t *tSafeReplica) setTSafe(vChannel Channel, id UniqueID, timestamp Timestamp) error {
 	t.mu.Lock()
 	defer t.mu.Unlock()
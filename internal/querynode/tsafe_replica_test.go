@@ -60,3 +60,23 @@ func TestTSafeReplica_invalid(t *testing.T) {
 	replica.addTSafe(defaultVChannel)
 	replica.addTSafe(defaultVChannel)
 }
+
+func TestTSafeReplica_getMinTSafe(t *testing.T) {
+	replica := newTSafeReplica()
+	assert.Equal(t, Timestamp(0), replica.getMinTSafe())
+
+	anotherVChannel := defaultVChannel + "-another"
+	replica.addTSafe(defaultVChannel)
+	replica.addTSafe(anotherVChannel)
+	assert.Equal(t, Timestamp(0), replica.getMinTSafe())
+
+	err := replica.setTSafe(defaultVChannel, defaultCollectionID, Timestamp(1000))
+	assert.NoError(t, err)
+	time.Sleep(20 * time.Millisecond)
+	assert.Equal(t, Timestamp(1000), replica.getMinTSafe())
+
+	err = replica.setTSafe(anotherVChannel, defaultCollectionID, Timestamp(500))
+	assert.NoError(t, err)
+	time.Sleep(20 * time.Millisecond)
+	assert.Equal(t, Timestamp(500), replica.getMinTSafe())
+}
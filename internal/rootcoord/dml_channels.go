@@ -74,6 +74,17 @@ func (d *dmlChannels) ListChannels() []string {
 	return chanNames
 }
 
+// ListPoolChannels lists all dml channel names in pool, including the ones not in use
+func (d *dmlChannels) ListPoolChannels() []string {
+	chanNames := make([]string, 0)
+	d.pool.Range(
+		func(k, v interface{}) bool {
+			chanNames = append(chanNames, k.(string))
+			return true
+		})
+	return chanNames
+}
+
 // GetNumChannels get current dml channel count
 func (d *dmlChannels) GetNumChannels() int {
 	return len(d.ListChannels())
@@ -15,6 +15,7 @@ import (
 	"fmt"
 	"path"
 	"strconv"
+	"strings"
 	"sync"
 
 	"github.com/golang/protobuf/proto"
@@ -24,6 +25,8 @@ import (
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	pb "github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
@@ -59,6 +62,18 @@ const (
 	// DDMsgSendPrefix prefix to indicate whether DD msg has been send
 	DDMsgSendPrefix = ComponentPrefix + "/dd-msg-send"
 
+	// CredentialPrefix prefix for credential user
+	CredentialPrefix = ComponentPrefix + "/credential/users"
+
+	// RolePrefix prefix for role, key: RolePrefix/role
+	RolePrefix = ComponentPrefix + "/credential/roles"
+
+	// RoleMappingPrefix prefix for user-role binding, key: RoleMappingPrefix/username/role
+	RoleMappingPrefix = ComponentPrefix + "/credential/user-role-mapping"
+
+	// GranteePrefix prefix for privilege grant, key: GranteePrefix/role/objectType/objectName/privilege
+	GranteePrefix = ComponentPrefix + "/credential/grantee-privileges"
+
 	// CreateCollectionDDType name of DD type for create collection
 	CreateCollectionDDType = "CreateCollection"
 
@@ -83,6 +98,7 @@ const (
 
 // MetaTable store all rootcoord meta info
 type MetaTable struct {
+	txn             kv.TxnKV                                                        // client of a reliable txnkv service, i.e. etcd client
 	client          kv.SnapShotKV                                                   // client of a reliable kv service, i.e. etcd client
 	tenantID2Meta   map[typeutil.UniqueID]pb.TenantMeta                             // tenant id to tenant meta
 	proxyID2Meta    map[typeutil.UniqueID]pb.ProxyMeta                              // proxy id to proxy meta
@@ -96,16 +112,20 @@ type MetaTable struct {
 	tenantLock sync.RWMutex
 	proxyLock  sync.RWMutex
 	ddLock     sync.RWMutex
+	credLock   sync.RWMutex
 }
 
 // NewMetaTable create meta table for rootcoord, which stores all in-memory information
 // for collection, partion, segment, index etc.
-func NewMetaTable(kv kv.SnapShotKV) (*MetaTable, error) {
+// Credentials are not versioned, so they are kept in txn instead of the snapshot kv.
+func NewMetaTable(txn kv.TxnKV, snap kv.SnapShotKV) (*MetaTable, error) {
 	mt := &MetaTable{
-		client:     kv,
+		txn:        txn,
+		client:     snap,
 		tenantLock: sync.RWMutex{},
 		proxyLock:  sync.RWMutex{},
 		ddLock:     sync.RWMutex{},
+		credLock:   sync.RWMutex{},
 	}
 	err := mt.reloadFromKV()
 	if err != nil {
@@ -895,6 +915,24 @@ func (mt *MetaTable) DropIndex(collName, fieldName, indexName string, ts typeuti
 
 	delete(mt.indexID2Meta, dropIdxID)
 
+	// the rebuild is done once the replaced index is dropped
+	for _, info := range collMeta.FieldIndexes {
+		idxMeta, ok := mt.indexID2Meta[info.IndexID]
+		if !ok || idxMeta.ReplacedIndexID != dropIdxID {
+			continue
+		}
+		idxMeta.ReplacedIndexID = 0
+		mt.indexID2Meta[info.IndexID] = idxMeta
+		k := path.Join(IndexMetaPrefix, strconv.FormatInt(info.IndexID, 10))
+		v, err := proto.Marshal(&idxMeta)
+		if err != nil {
+			log.Error("MetaTable DropIndex Marshal idxMeta fail",
+				zap.String("key", k), zap.Error(err))
+			return 0, false, fmt.Errorf("MetaTable DropIndex Marshal idxMeta fail key:%s, err:%w", k, err)
+		}
+		saveMeta[k] = string(v)
+	}
+
 	// update segID2IndexMeta
 	for partID := range collMeta.PartitionIDs {
 		if segIDMap, ok := mt.partID2SegID[typeutil.UniqueID(partID)]; ok {
@@ -947,17 +985,30 @@ func (mt *MetaTable) GetSegmentIndexInfoByID(segID typeutil.UniqueID, filedID in
 			}
 		}
 	} else {
+		// if index name is not specified, the default index of the field, or the index created first on the field,
+		// so the result doesn't depend on the order of the map
+		var first *pb.SegmentIndexInfo
 		for idxID, seg := range segIdxMap {
 			idxMeta, ok := mt.indexID2Meta[idxID]
-			if ok {
-				if idxMeta.IndexName != idxName {
-					continue
-				}
-				if seg.FieldID != filedID {
-					continue
+			if !ok || (filedID != -1 && seg.FieldID != filedID) {
+				continue
+			}
+			if idxName != "" {
+				if idxMeta.IndexName == idxName {
+					return seg, nil
 				}
+				continue
+			}
+			if idxMeta.IndexName == Params.DefaultIndexName {
 				return seg, nil
 			}
+			if first == nil || idxID < first.IndexID {
+				seg := seg
+				first = &seg
+			}
+		}
+		if first != nil {
+			return *first, nil
 		}
 	}
 	return pb.SegmentIndexInfo{}, fmt.Errorf("can't find index name = %s on segment = %d, with filed id = %d", idxName, segID, filedID)
@@ -1321,3 +1372,463 @@ func (mt *MetaTable) AlterAlias(collectionAlias string, collectionName string, t
 	}
 	return nil
 }
+
+func credentialKey(username string) string {
+	return fmt.Sprintf("%s/%s", CredentialPrefix, username)
+}
+
+// AddCredential add a new user credential, the password must have been encrypted already
+func (mt *MetaTable) AddCredential(credInfo *internalpb.CredentialInfo) error {
+	mt.credLock.Lock()
+	defer mt.credLock.Unlock()
+
+	if credInfo.Username == "" {
+		return fmt.Errorf("username is empty")
+	}
+	k := credentialKey(credInfo.Username)
+	if mt.isKeyExist(k) {
+		return fmt.Errorf("user already exists, username = %s", credInfo.Username)
+	}
+	return mt.saveCredential(k, credInfo)
+}
+
+// UpdateCredential overwrite the credential of an existing user
+func (mt *MetaTable) UpdateCredential(credInfo *internalpb.CredentialInfo) error {
+	mt.credLock.Lock()
+	defer mt.credLock.Unlock()
+
+	k := credentialKey(credInfo.Username)
+	if !mt.isKeyExist(k) {
+		return fmt.Errorf("user does not exist, username = %s", credInfo.Username)
+	}
+	return mt.saveCredential(k, credInfo)
+}
+
+func (mt *MetaTable) saveCredential(k string, credInfo *internalpb.CredentialInfo) error {
+	v, err := proto.Marshal(credInfo)
+	if err != nil {
+		log.Error("MetaTable marshal credential info fail", zap.String("key", k), zap.Error(err))
+		return fmt.Errorf("MetaTable marshal credential info fail key:%s, err:%w", k, err)
+	}
+	err = mt.txn.Save(k, string(v))
+	if err != nil {
+		log.Error("MetaTable save credential fail", zap.String("key", k), zap.Error(err))
+		return fmt.Errorf("MetaTable save credential fail key:%s, err:%w", k, err)
+	}
+	return nil
+}
+
+// GetCredential get the credential of the user
+func (mt *MetaTable) GetCredential(username string) (*internalpb.CredentialInfo, error) {
+	mt.credLock.RLock()
+	defer mt.credLock.RUnlock()
+
+	k := credentialKey(username)
+	v, err := mt.txn.Load(k)
+	if err != nil || v == "" {
+		log.Warn("MetaTable load credential fail", zap.String("key", k), zap.Error(err))
+		return nil, fmt.Errorf("user does not exist, username = %s", username)
+	}
+	credInfo := &internalpb.CredentialInfo{}
+	if err = proto.Unmarshal([]byte(v), credInfo); err != nil {
+		return nil, fmt.Errorf("RootCoord Unmarshal internalpb.CredentialInfo err:%w", err)
+	}
+	return credInfo, nil
+}
+
+// DeleteCredential delete the credential of the user
+func (mt *MetaTable) DeleteCredential(username string) error {
+	mt.credLock.Lock()
+	defer mt.credLock.Unlock()
+
+	k := credentialKey(username)
+	if !mt.isKeyExist(k) {
+		return fmt.Errorf("user does not exist, username = %s", username)
+	}
+	// the roles bound to the user are removed together with the user
+	mappings, err := mt.loadUserRoleMappings()
+	if err != nil {
+		return err
+	}
+	removals := []string{k}
+	for _, m := range mappings {
+		if m.username == username {
+			removals = append(removals, m.key)
+		}
+	}
+	if err := mt.txn.MultiSaveAndRemove(nil, removals); err != nil {
+		log.Error("MetaTable remove credential fail", zap.String("key", k), zap.Error(err))
+		return fmt.Errorf("MetaTable remove credential fail key:%s, err:%w", k, err)
+	}
+	return nil
+}
+
+// ListCredentialUsernames list the names of all users
+func (mt *MetaTable) ListCredentialUsernames() ([]string, error) {
+	mt.credLock.RLock()
+	defer mt.credLock.RUnlock()
+
+	return mt.listCredentialUsernames()
+}
+
+func (mt *MetaTable) listCredentialUsernames() ([]string, error) {
+	keys, _, err := mt.txn.LoadWithPrefix(CredentialPrefix + "/")
+	if err != nil {
+		log.Error("MetaTable list all credential usernames fail", zap.Error(err))
+		return nil, err
+	}
+
+	usernames := make([]string, 0, len(keys))
+	for _, key := range keys {
+		usernames = append(usernames, path.Base(key))
+	}
+	return usernames, nil
+}
+
+// isKeyExist checks whether the key exists in txn kv, values saved by credential and
+// role related methods are never empty, so an empty value is regarded as absence
+func (mt *MetaTable) isKeyExist(k string) bool {
+	v, err := mt.txn.Load(k)
+	return err == nil && v != ""
+}
+
+// splitSubKey returns the parts of key after prefix, the key loaded from txn kv may contain root path
+func splitSubKey(prefix, key string) []string {
+	idx := strings.Index(key, prefix+"/")
+	if idx < 0 {
+		return nil
+	}
+	return strings.Split(key[idx+len(prefix)+1:], "/")
+}
+
+func roleKey(roleName string) string {
+	return fmt.Sprintf("%s/%s", RolePrefix, roleName)
+}
+
+func roleMappingKey(username, roleName string) string {
+	return fmt.Sprintf("%s/%s/%s", RoleMappingPrefix, username, roleName)
+}
+
+func granteeKey(entity *milvuspb.GrantEntity) string {
+	return fmt.Sprintf("%s/%s/%s/%s/%s", GranteePrefix, entity.Role.GetName(), entity.Object.GetName(),
+		entity.ObjectName, entity.Privilege.GetName())
+}
+
+type userRoleMapping struct {
+	key      string
+	username string
+	roleName string
+}
+
+func (mt *MetaTable) loadUserRoleMappings() ([]userRoleMapping, error) {
+	keys, _, err := mt.txn.LoadWithPrefix(RoleMappingPrefix + "/")
+	if err != nil {
+		log.Error("MetaTable load user-role mappings fail", zap.Error(err))
+		return nil, err
+	}
+	mappings := make([]userRoleMapping, 0, len(keys))
+	for _, key := range keys {
+		parts := splitSubKey(RoleMappingPrefix, key)
+		if len(parts) != 2 {
+			log.Warn("MetaTable invalid user-role mapping key", zap.String("key", key))
+			continue
+		}
+		mappings = append(mappings, userRoleMapping{key: key, username: parts[0], roleName: parts[1]})
+	}
+	return mappings, nil
+}
+
+func (mt *MetaTable) listRoleNames() ([]string, error) {
+	keys, _, err := mt.txn.LoadWithPrefix(RolePrefix + "/")
+	if err != nil {
+		log.Error("MetaTable list all roles fail", zap.Error(err))
+		return nil, err
+	}
+	roleNames := make([]string, 0, len(keys))
+	for _, key := range keys {
+		roleNames = append(roleNames, path.Base(key))
+	}
+	return roleNames, nil
+}
+
+// loadGrants loads the grants of the role, or grants of all roles if the role name is empty
+func (mt *MetaTable) loadGrants(roleName string) ([]*milvuspb.GrantEntity, []string, error) {
+	prefix := GranteePrefix + "/"
+	if roleName != "" {
+		prefix = GranteePrefix + "/" + roleName + "/"
+	}
+	keys, _, err := mt.txn.LoadWithPrefix(prefix)
+	if err != nil {
+		log.Error("MetaTable load grants fail", zap.String("prefix", prefix), zap.Error(err))
+		return nil, nil, err
+	}
+	grants := make([]*milvuspb.GrantEntity, 0, len(keys))
+	grantKeys := make([]string, 0, len(keys))
+	for _, key := range keys {
+		parts := splitSubKey(GranteePrefix, key)
+		if len(parts) != 4 {
+			log.Warn("MetaTable invalid grant key", zap.String("key", key))
+			continue
+		}
+		if roleName != "" && parts[0] != roleName {
+			continue
+		}
+		grants = append(grants, &milvuspb.GrantEntity{
+			Role:       &milvuspb.RoleEntity{Name: parts[0]},
+			Object:     &milvuspb.ObjectEntity{Name: parts[1]},
+			ObjectName: parts[2],
+			Privilege:  &milvuspb.PrivilegeEntity{Name: parts[3]},
+		})
+		grantKeys = append(grantKeys, key)
+	}
+	return grants, grantKeys, nil
+}
+
+// CreateRole create a new role
+func (mt *MetaTable) CreateRole(roleName string) error {
+	mt.credLock.Lock()
+	defer mt.credLock.Unlock()
+
+	if roleName == "" {
+		return fmt.Errorf("role name is empty")
+	}
+	k := roleKey(roleName)
+	if mt.isKeyExist(k) {
+		return fmt.Errorf("role already exists, role = %s", roleName)
+	}
+	if err := mt.txn.Save(k, roleName); err != nil {
+		log.Error("MetaTable save role fail", zap.String("key", k), zap.Error(err))
+		return fmt.Errorf("MetaTable save role fail key:%s, err:%w", k, err)
+	}
+	return nil
+}
+
+// DropRole drop the role, together with its user bindings and grants
+func (mt *MetaTable) DropRole(roleName string) error {
+	mt.credLock.Lock()
+	defer mt.credLock.Unlock()
+
+	k := roleKey(roleName)
+	if !mt.isKeyExist(k) {
+		return fmt.Errorf("role does not exist, role = %s", roleName)
+	}
+	removals := []string{k}
+	mappings, err := mt.loadUserRoleMappings()
+	if err != nil {
+		return err
+	}
+	for _, m := range mappings {
+		if m.roleName == roleName {
+			removals = append(removals, m.key)
+		}
+	}
+	_, grantKeys, err := mt.loadGrants(roleName)
+	if err != nil {
+		return err
+	}
+	removals = append(removals, grantKeys...)
+	if err := mt.txn.MultiSaveAndRemove(nil, removals); err != nil {
+		log.Error("MetaTable remove role fail", zap.String("key", k), zap.Error(err))
+		return fmt.Errorf("MetaTable remove role fail key:%s, err:%w", k, err)
+	}
+	return nil
+}
+
+// OperateUserRole bind the user to the role, or unbind the user from the role
+func (mt *MetaTable) OperateUserRole(username string, roleName string, operateType milvuspb.OperateUserRoleType) error {
+	mt.credLock.Lock()
+	defer mt.credLock.Unlock()
+
+	if !mt.isKeyExist(credentialKey(username)) {
+		return fmt.Errorf("user does not exist, username = %s", username)
+	}
+	if !mt.isKeyExist(roleKey(roleName)) {
+		return fmt.Errorf("role does not exist, role = %s", roleName)
+	}
+	k := roleMappingKey(username, roleName)
+	switch operateType {
+	case milvuspb.OperateUserRoleType_AddUserToRole:
+		if mt.isKeyExist(k) {
+			return fmt.Errorf("user is already bound to the role, username = %s, role = %s", username, roleName)
+		}
+		if err := mt.txn.Save(k, roleName); err != nil {
+			log.Error("MetaTable save user-role mapping fail", zap.String("key", k), zap.Error(err))
+			return fmt.Errorf("MetaTable save user-role mapping fail key:%s, err:%w", k, err)
+		}
+	case milvuspb.OperateUserRoleType_RemoveUserFromRole:
+		if !mt.isKeyExist(k) {
+			return fmt.Errorf("user is not bound to the role, username = %s, role = %s", username, roleName)
+		}
+		if err := mt.txn.Remove(k); err != nil {
+			log.Error("MetaTable remove user-role mapping fail", zap.String("key", k), zap.Error(err))
+			return fmt.Errorf("MetaTable remove user-role mapping fail key:%s, err:%w", k, err)
+		}
+	default:
+		return fmt.Errorf("invalid operate user role type, type = %s", operateType.String())
+	}
+	return nil
+}
+
+// SelectRole returns the role, or all roles if the role name is empty, with the users bound to each role
+func (mt *MetaTable) SelectRole(roleName string, includeUserInfo bool) ([]*milvuspb.RoleResult, error) {
+	mt.credLock.RLock()
+	defer mt.credLock.RUnlock()
+
+	var roleNames []string
+	if roleName != "" {
+		if !mt.isKeyExist(roleKey(roleName)) {
+			return nil, fmt.Errorf("role does not exist, role = %s", roleName)
+		}
+		roleNames = []string{roleName}
+	} else {
+		var err error
+		if roleNames, err = mt.listRoleNames(); err != nil {
+			return nil, err
+		}
+	}
+
+	role2Users := make(map[string][]*milvuspb.UserEntity)
+	if includeUserInfo {
+		mappings, err := mt.loadUserRoleMappings()
+		if err != nil {
+			return nil, err
+		}
+		for _, m := range mappings {
+			role2Users[m.roleName] = append(role2Users[m.roleName], &milvuspb.UserEntity{Name: m.username})
+		}
+	}
+
+	results := make([]*milvuspb.RoleResult, 0, len(roleNames))
+	for _, name := range roleNames {
+		results = append(results, &milvuspb.RoleResult{
+			Role:  &milvuspb.RoleEntity{Name: name},
+			Users: role2Users[name],
+		})
+	}
+	return results, nil
+}
+
+// SelectUser returns the user, or all users if the username is empty, with the roles bound to each user
+func (mt *MetaTable) SelectUser(username string, includeRoleInfo bool) ([]*milvuspb.UserResult, error) {
+	mt.credLock.RLock()
+	defer mt.credLock.RUnlock()
+
+	var usernames []string
+	if username != "" {
+		if !mt.isKeyExist(credentialKey(username)) {
+			return nil, fmt.Errorf("user does not exist, username = %s", username)
+		}
+		usernames = []string{username}
+	} else {
+		var err error
+		if usernames, err = mt.listCredentialUsernames(); err != nil {
+			return nil, err
+		}
+	}
+
+	user2Roles := make(map[string][]*milvuspb.RoleEntity)
+	if includeRoleInfo {
+		mappings, err := mt.loadUserRoleMappings()
+		if err != nil {
+			return nil, err
+		}
+		for _, m := range mappings {
+			user2Roles[m.username] = append(user2Roles[m.username], &milvuspb.RoleEntity{Name: m.roleName})
+		}
+	}
+
+	results := make([]*milvuspb.UserResult, 0, len(usernames))
+	for _, name := range usernames {
+		results = append(results, &milvuspb.UserResult{
+			User:  &milvuspb.UserEntity{Name: name},
+			Roles: user2Roles[name],
+		})
+	}
+	return results, nil
+}
+
+// OperatePrivilege grant the privilege on the object to the role, or revoke it
+func (mt *MetaTable) OperatePrivilege(entity *milvuspb.GrantEntity, operateType milvuspb.OperatePrivilegeType) error {
+	mt.credLock.Lock()
+	defer mt.credLock.Unlock()
+
+	roleName := entity.Role.GetName()
+	if !mt.isKeyExist(roleKey(roleName)) {
+		return fmt.Errorf("role does not exist, role = %s", roleName)
+	}
+	k := granteeKey(entity)
+	switch operateType {
+	case milvuspb.OperatePrivilegeType_Grant:
+		if mt.isKeyExist(k) {
+			return fmt.Errorf("privilege is already granted, grant = %s", entity.String())
+		}
+		if err := mt.txn.Save(k, entity.Privilege.GetName()); err != nil {
+			log.Error("MetaTable save grant fail", zap.String("key", k), zap.Error(err))
+			return fmt.Errorf("MetaTable save grant fail key:%s, err:%w", k, err)
+		}
+	case milvuspb.OperatePrivilegeType_Revoke:
+		if !mt.isKeyExist(k) {
+			return fmt.Errorf("privilege is not granted, grant = %s", entity.String())
+		}
+		if err := mt.txn.Remove(k); err != nil {
+			log.Error("MetaTable remove grant fail", zap.String("key", k), zap.Error(err))
+			return fmt.Errorf("MetaTable remove grant fail key:%s, err:%w", k, err)
+		}
+	default:
+		return fmt.Errorf("invalid operate privilege type, type = %s", operateType.String())
+	}
+	return nil
+}
+
+// SelectGrant returns the grants of the role, filtered by object type and object name if they are specified
+func (mt *MetaTable) SelectGrant(entity *milvuspb.GrantEntity) ([]*milvuspb.GrantEntity, error) {
+	mt.credLock.RLock()
+	defer mt.credLock.RUnlock()
+
+	roleName := entity.Role.GetName()
+	if !mt.isKeyExist(roleKey(roleName)) {
+		return nil, fmt.Errorf("role does not exist, role = %s", roleName)
+	}
+	grants, _, err := mt.loadGrants(roleName)
+	if err != nil {
+		return nil, err
+	}
+	results := make([]*milvuspb.GrantEntity, 0, len(grants))
+	for _, grant := range grants {
+		if entity.Object.GetName() != "" && grant.Object.GetName() != entity.Object.GetName() {
+			continue
+		}
+		if entity.ObjectName != "" && grant.ObjectName != entity.ObjectName {
+			continue
+		}
+		results = append(results, grant)
+	}
+	return results, nil
+}
+
+// ListPolicy returns all the grants and the roles bound to every user
+func (mt *MetaTable) ListPolicy() ([]*milvuspb.GrantEntity, []*milvuspb.UserResult, error) {
+	mt.credLock.RLock()
+	defer mt.credLock.RUnlock()
+
+	grants, _, err := mt.loadGrants("")
+	if err != nil {
+		return nil, nil, err
+	}
+	mappings, err := mt.loadUserRoleMappings()
+	if err != nil {
+		return nil, nil, err
+	}
+	user2Roles := make(map[string]*milvuspb.UserResult)
+	userRoles := make([]*milvuspb.UserResult, 0)
+	for _, m := range mappings {
+		result, ok := user2Roles[m.username]
+		if !ok {
+			result = &milvuspb.UserResult{User: &milvuspb.UserEntity{Name: m.username}}
+			user2Roles[m.username] = result
+			userRoles = append(userRoles, result)
+		}
+		result.Roles = append(result.Roles, &milvuspb.RoleEntity{Name: m.roleName})
+	}
+	return grants, userRoles, nil
+}
@@ -18,8 +18,12 @@ import (
 
 	"github.com/golang/protobuf/proto"
 	"github.com/milvus-io/milvus/internal/kv"
+	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
+	memkv "github.com/milvus-io/milvus/internal/kv/mem"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	pb "github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 	"github.com/stretchr/testify/assert"
@@ -64,68 +68,68 @@ func Test_MockKV(t *testing.T) {
 		return nil, nil, fmt.Errorf("load prefix error")
 	}
 
-	_, err := NewMetaTable(k1)
+	_, err := NewMetaTable(nil, k1)
 	assert.NotNil(t, err)
 	assert.EqualError(t, err, "load prefix error")
 
 	// tenant
 	prefix[TenantMetaPrefix] = []string{"tenant-prefix"}
-	_, err = NewMetaTable(k1)
+	_, err = NewMetaTable(nil, k1)
 	assert.NotNil(t, err)
 
 	value, err := proto.Marshal(&pb.TenantMeta{})
 	assert.Nil(t, err)
 	prefix[TenantMetaPrefix] = []string{string(value)}
-	_, err = NewMetaTable(k1)
+	_, err = NewMetaTable(nil, k1)
 	assert.NotNil(t, err)
 
 	// proxy
 	prefix[ProxyMetaPrefix] = []string{"porxy-meta"}
-	_, err = NewMetaTable(k1)
+	_, err = NewMetaTable(nil, k1)
 	assert.NotNil(t, err)
 
 	value, err = proto.Marshal(&pb.ProxyMeta{})
 	assert.Nil(t, err)
 	prefix[ProxyMetaPrefix] = []string{string(value)}
-	_, err = NewMetaTable(k1)
+	_, err = NewMetaTable(nil, k1)
 	assert.NotNil(t, err)
 
 	// collection
 	prefix[CollectionMetaPrefix] = []string{"collection-meta"}
-	_, err = NewMetaTable(k1)
+	_, err = NewMetaTable(nil, k1)
 	assert.NotNil(t, err)
 
 	value, err = proto.Marshal(&pb.CollectionInfo{Schema: &schemapb.CollectionSchema{}})
 	assert.Nil(t, err)
 	prefix[CollectionMetaPrefix] = []string{string(value)}
-	_, err = NewMetaTable(k1)
+	_, err = NewMetaTable(nil, k1)
 	assert.NotNil(t, err)
 
 	// segment index
 	prefix[SegmentIndexMetaPrefix] = []string{"segment-index-meta"}
-	_, err = NewMetaTable(k1)
+	_, err = NewMetaTable(nil, k1)
 	assert.NotNil(t, err)
 
 	value, err = proto.Marshal(&pb.SegmentIndexInfo{})
 	assert.Nil(t, err)
 	prefix[SegmentIndexMetaPrefix] = []string{string(value)}
-	_, err = NewMetaTable(k1)
+	_, err = NewMetaTable(nil, k1)
 	assert.NotNil(t, err)
 
 	prefix[SegmentIndexMetaPrefix] = []string{string(value), string(value)}
-	_, err = NewMetaTable(k1)
+	_, err = NewMetaTable(nil, k1)
 	assert.NotNil(t, err)
 	assert.EqualError(t, err, "load prefix error")
 
 	// index
 	prefix[IndexMetaPrefix] = []string{"index-meta"}
-	_, err = NewMetaTable(k1)
+	_, err = NewMetaTable(nil, k1)
 	assert.NotNil(t, err)
 
 	value, err = proto.Marshal(&pb.IndexInfo{})
 	assert.Nil(t, err)
 	prefix[IndexMetaPrefix] = []string{string(value)}
-	m1, err := NewMetaTable(k1)
+	m1, err := NewMetaTable(nil, k1)
 	assert.NotNil(t, err)
 	assert.EqualError(t, err, "load prefix error")
 	prefix[CollectionAliasMetaPrefix] = []string{"alias-meta"}
@@ -182,7 +186,10 @@ func TestMetaTable(t *testing.T) {
 	skv, err := newMetaSnapshot(etcdCli, rootPath, TimestampPrefix, 7)
 	assert.Nil(t, err)
 	assert.NotNil(t, skv)
-	mt, err := NewMetaTable(skv)
+	txnKV, err := etcdkv.NewEtcdKV(Params.EtcdEndpoints, rootPath)
+	assert.Nil(t, err)
+	defer txnKV.Close()
+	mt, err := NewMetaTable(txnKV, skv)
 	assert.Nil(t, err)
 
 	collInfo := &pb.CollectionInfo{
@@ -430,7 +437,7 @@ func TestMetaTable(t *testing.T) {
 		err = mt.AddProxy(&po, 0)
 		assert.Nil(t, err)
 
-		_, err = NewMetaTable(skv)
+		_, err = NewMetaTable(txnKV, skv)
 		assert.Nil(t, err)
 	})
 
@@ -880,6 +887,17 @@ func TestMetaTable(t *testing.T) {
 		_, err = mt.GetSegmentIndexInfoByID(segIdxInfo.SegmentID, 11, idxInfo[0].IndexName)
 		assert.NotNil(t, err)
 		assert.EqualError(t, err, fmt.Sprintf("can't find index name = %s on segment = %d, with filed id = 11", idxInfo[0].IndexName, segIdxInfo.SegmentID))
+
+		idx, err = mt.GetSegmentIndexInfoByID(segIdxInfo.SegmentID, segIdxInfo.FieldID, "")
+		assert.Nil(t, err)
+		assert.Equal(t, segIdxInfo.IndexID, idx.IndexID)
+
+		idx, err = mt.GetSegmentIndexInfoByID(segIdxInfo.SegmentID, -1, idxInfo[0].IndexName)
+		assert.Nil(t, err)
+		assert.Equal(t, segIdxInfo.IndexID, idx.IndexID)
+
+		_, err = mt.GetSegmentIndexInfoByID(segIdxInfo.SegmentID, 11, "")
+		assert.NotNil(t, err)
 	})
 
 	t.Run("get field schema failed", func(t *testing.T) {
@@ -1081,6 +1099,46 @@ func TestMetaTable(t *testing.T) {
 	})
 }
 
+func TestMetaTable_GetSegmentIndexInfoByID_AnyIndex(t *testing.T) {
+	Params.Init()
+	const (
+		segID   = 1
+		fieldID = 100
+	)
+	mt := &MetaTable{
+		segID2IndexMeta: map[typeutil.UniqueID]map[typeutil.UniqueID]pb.SegmentIndexInfo{segID: {}},
+		indexID2Meta:    map[typeutil.UniqueID]pb.IndexInfo{},
+	}
+	addIndex := func(indexID typeutil.UniqueID, indexName string) {
+		mt.segID2IndexMeta[segID][indexID] = pb.SegmentIndexInfo{SegmentID: segID, FieldID: fieldID, IndexID: indexID, BuildID: indexID}
+		mt.indexID2Meta[indexID] = pb.IndexInfo{IndexID: indexID, IndexName: indexName}
+	}
+	for i := 10; i > 0; i-- {
+		addIndex(typeutil.UniqueID(100+i), fmt.Sprintf("idx%d", i))
+	}
+
+	// the index created first on the field
+	for i := 0; i < 10; i++ {
+		info, err := mt.GetSegmentIndexInfoByID(segID, fieldID, "")
+		assert.Nil(t, err)
+		assert.Equal(t, typeutil.UniqueID(101), info.IndexID)
+	}
+
+	// the default index
+	addIndex(200, Params.DefaultIndexName)
+	for i := 0; i < 10; i++ {
+		info, err := mt.GetSegmentIndexInfoByID(segID, fieldID, "")
+		assert.Nil(t, err)
+		assert.Equal(t, typeutil.UniqueID(200), info.IndexID)
+	}
+
+	info, err := mt.GetSegmentIndexInfoByID(segID, fieldID, "idx5")
+	assert.Nil(t, err)
+	assert.Equal(t, typeutil.UniqueID(105), info.IndexID)
+	_, err = mt.GetSegmentIndexInfoByID(segID, fieldID+1, "")
+	assert.NotNil(t, err)
+}
+
 func TestMetaWithTimestamp(t *testing.T) {
 	const (
 		collID1   = typeutil.UniqueID(1)
@@ -1111,7 +1169,10 @@ func TestMetaWithTimestamp(t *testing.T) {
 	skv, err := newMetaSnapshot(etcdCli, rootPath, TimestampPrefix, 7)
 	assert.Nil(t, err)
 	assert.NotNil(t, skv)
-	mt, err := NewMetaTable(skv)
+	txnKV, err := etcdkv.NewEtcdKV(Params.EtcdEndpoints, rootPath)
+	assert.Nil(t, err)
+	defer txnKV.Close()
+	mt, err := NewMetaTable(txnKV, skv)
 	assert.Nil(t, err)
 
 	collInfo := &pb.CollectionInfo{
@@ -1253,3 +1314,171 @@ func TestMetaWithTimestamp(t *testing.T) {
 	_, err = mt.GetPartitionByName(2, partName2, tsoStart)
 	assert.NotNil(t, err)
 }
+
+func TestMetaTable_Credential(t *testing.T) {
+	mt, err := NewMetaTable(memkv.NewMemoryKV(), &mockTestKV{
+		loadWithPrefix: func(key string, ts typeutil.Timestamp) ([]string, []string, error) {
+			return nil, nil, nil
+		},
+	})
+	assert.Nil(t, err)
+
+	usernames, err := mt.ListCredentialUsernames()
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(usernames))
+
+	err = mt.AddCredential(&internalpb.CredentialInfo{Username: "", EncryptedPassword: "pwd"})
+	assert.NotNil(t, err)
+
+	err = mt.AddCredential(&internalpb.CredentialInfo{Username: "user1", EncryptedPassword: "pwd1"})
+	assert.Nil(t, err)
+	err = mt.AddCredential(&internalpb.CredentialInfo{Username: "user2", EncryptedPassword: "pwd2"})
+	assert.Nil(t, err)
+	err = mt.AddCredential(&internalpb.CredentialInfo{Username: "user1", EncryptedPassword: "pwd3"})
+	assert.NotNil(t, err)
+
+	credInfo, err := mt.GetCredential("user1")
+	assert.Nil(t, err)
+	assert.Equal(t, "user1", credInfo.Username)
+	assert.Equal(t, "pwd1", credInfo.EncryptedPassword)
+
+	usernames, err = mt.ListCredentialUsernames()
+	assert.Nil(t, err)
+	assert.ElementsMatch(t, []string{"user1", "user2"}, usernames)
+
+	err = mt.UpdateCredential(&internalpb.CredentialInfo{Username: "user1", EncryptedPassword: "pwd3"})
+	assert.Nil(t, err)
+	credInfo, err = mt.GetCredential("user1")
+	assert.Nil(t, err)
+	assert.Equal(t, "pwd3", credInfo.EncryptedPassword)
+
+	err = mt.UpdateCredential(&internalpb.CredentialInfo{Username: "user3", EncryptedPassword: "pwd3"})
+	assert.NotNil(t, err)
+
+	err = mt.DeleteCredential("user1")
+	assert.Nil(t, err)
+	err = mt.DeleteCredential("user1")
+	assert.NotNil(t, err)
+	_, err = mt.GetCredential("user1")
+	assert.NotNil(t, err)
+
+	usernames, err = mt.ListCredentialUsernames()
+	assert.Nil(t, err)
+	assert.ElementsMatch(t, []string{"user2"}, usernames)
+}
+
+func TestMetaTable_RBAC(t *testing.T) {
+	mt, err := NewMetaTable(memkv.NewMemoryKV(), &mockTestKV{
+		loadWithPrefix: func(key string, ts typeutil.Timestamp) ([]string, []string, error) {
+			return nil, nil, nil
+		},
+	})
+	assert.Nil(t, err)
+	err = mt.AddCredential(&internalpb.CredentialInfo{Username: "user1", EncryptedPassword: "pwd1"})
+	assert.Nil(t, err)
+	err = mt.AddCredential(&internalpb.CredentialInfo{Username: "user10", EncryptedPassword: "pwd10"})
+	assert.Nil(t, err)
+
+	t.Run("role", func(t *testing.T) {
+		err := mt.CreateRole("")
+		assert.NotNil(t, err)
+		err = mt.CreateRole("role1")
+		assert.Nil(t, err)
+		err = mt.CreateRole("role1")
+		assert.NotNil(t, err)
+		err = mt.CreateRole("role2")
+		assert.Nil(t, err)
+
+		results, err := mt.SelectRole("", false)
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(results))
+		_, err = mt.SelectRole("role3", false)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("user role", func(t *testing.T) {
+		err := mt.OperateUserRole("user2", "role1", milvuspb.OperateUserRoleType_AddUserToRole)
+		assert.NotNil(t, err)
+		err = mt.OperateUserRole("user1", "role3", milvuspb.OperateUserRoleType_AddUserToRole)
+		assert.NotNil(t, err)
+		err = mt.OperateUserRole("user1", "role1", milvuspb.OperateUserRoleType_AddUserToRole)
+		assert.Nil(t, err)
+		err = mt.OperateUserRole("user1", "role1", milvuspb.OperateUserRoleType_AddUserToRole)
+		assert.NotNil(t, err)
+		err = mt.OperateUserRole("user1", "role2", milvuspb.OperateUserRoleType_AddUserToRole)
+		assert.Nil(t, err)
+		err = mt.OperateUserRole("user10", "role2", milvuspb.OperateUserRoleType_AddUserToRole)
+		assert.Nil(t, err)
+
+		users, err := mt.SelectUser("user1", true)
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(users))
+		assert.Equal(t, 2, len(users[0].Roles))
+
+		roles, err := mt.SelectRole("role2", true)
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(roles))
+		assert.Equal(t, 2, len(roles[0].Users))
+
+		err = mt.OperateUserRole("user1", "role2", milvuspb.OperateUserRoleType_RemoveUserFromRole)
+		assert.Nil(t, err)
+		err = mt.OperateUserRole("user1", "role2", milvuspb.OperateUserRoleType_RemoveUserFromRole)
+		assert.NotNil(t, err)
+		users, err = mt.SelectUser("user1", true)
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(users[0].Roles))
+		assert.Equal(t, "role1", users[0].Roles[0].Name)
+	})
+
+	t.Run("privilege", func(t *testing.T) {
+		grant := &milvuspb.GrantEntity{
+			Role:       &milvuspb.RoleEntity{Name: "role1"},
+			Object:     &milvuspb.ObjectEntity{Name: commonpb.ObjectType_Collection.String()},
+			ObjectName: "col1",
+			Privilege:  &milvuspb.PrivilegeEntity{Name: commonpb.ObjectPrivilege_PrivilegeSearch.String()},
+		}
+		err := mt.OperatePrivilege(grant, milvuspb.OperatePrivilegeType_Grant)
+		assert.Nil(t, err)
+		err = mt.OperatePrivilege(grant, milvuspb.OperatePrivilegeType_Grant)
+		assert.NotNil(t, err)
+		grant2 := proto.Clone(grant).(*milvuspb.GrantEntity)
+		grant2.ObjectName = "col2"
+		err = mt.OperatePrivilege(grant2, milvuspb.OperatePrivilegeType_Grant)
+		assert.Nil(t, err)
+
+		grants, err := mt.SelectGrant(&milvuspb.GrantEntity{Role: &milvuspb.RoleEntity{Name: "role1"}})
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(grants))
+		grants, err = mt.SelectGrant(&milvuspb.GrantEntity{Role: &milvuspb.RoleEntity{Name: "role1"}, ObjectName: "col2"})
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(grants))
+		assert.True(t, proto.Equal(grant2, grants[0]))
+
+		policies, userRoles, err := mt.ListPolicy()
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(policies))
+		assert.Equal(t, 2, len(userRoles))
+
+		err = mt.OperatePrivilege(grant2, milvuspb.OperatePrivilegeType_Revoke)
+		assert.Nil(t, err)
+		err = mt.OperatePrivilege(grant2, milvuspb.OperatePrivilegeType_Revoke)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("drop", func(t *testing.T) {
+		err := mt.DropRole("role1")
+		assert.Nil(t, err)
+		err = mt.DropRole("role1")
+		assert.NotNil(t, err)
+		policies, userRoles, err := mt.ListPolicy()
+		assert.Nil(t, err)
+		assert.Equal(t, 0, len(policies))
+		assert.Equal(t, 1, len(userRoles))
+
+		err = mt.DeleteCredential("user10")
+		assert.Nil(t, err)
+		_, userRoles, err = mt.ListPolicy()
+		assert.Nil(t, err)
+		assert.Equal(t, 0, len(userRoles))
+	})
+}
@@ -0,0 +1,218 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/msgstream"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/internal/util/mqclient"
+)
+
+type msgTopicAction int
+
+const (
+	// keep the topic and its subscriptions with connected consumers
+	msgTopicKeep msgTopicAction = iota
+	// keep the topic but delete its subscriptions
+	msgTopicPurgeSubscriptions
+	// delete the topic together with its subscriptions
+	msgTopicPurge
+)
+
+// msgTopicChecker decides whether the topics created by milvus are still referenced
+type msgTopicChecker struct {
+	dmlPool       map[string]struct{}
+	dmlInUse      map[string]struct{}
+	queryChannels map[string]struct{}
+}
+
+func newStringSet(values []string) map[string]struct{} {
+	set := make(map[string]struct{}, len(values))
+	for _, v := range values {
+		set[v] = struct{}{}
+	}
+	return set
+}
+
+// queryChannelMetaPrefix is the prefix of the query channels saved by query coord in the meta
+const queryChannelMetaPrefix = "queryCoord-queryChannel"
+
+// listQueryChannels returns the query channels allocated by query coord, they are read from the meta of query coord
+// rather than asked for, since query coord allocates the query channel of a collection once asked
+func (c *Core) listQueryChannels() ([]string, error) {
+	_, values, err := c.metaKV.LoadWithPrefix(queryChannelMetaPrefix)
+	if err != nil {
+		return nil, err
+	}
+	channels := make([]string, 0, 2*len(values))
+	for _, value := range values {
+		info := &querypb.QueryChannelInfo{}
+		if err := proto.Unmarshal([]byte(value), info); err != nil {
+			return nil, err
+		}
+		channels = append(channels, info.QueryChannelID, info.QueryResultChannelID)
+	}
+	return channels, nil
+}
+
+func (c *Core) newMsgTopicChecker() (*msgTopicChecker, error) {
+	queryChannels, err := c.listQueryChannels()
+	if err != nil {
+		return nil, err
+	}
+	return &msgTopicChecker{
+		dmlPool:       newStringSet(c.dmlChannels.ListPoolChannels()),
+		dmlInUse:      newStringSet(c.dmlChannels.ListChannels()),
+		queryChannels: newStringSet(queryChannels),
+	}, nil
+}
+
+// action returns how to purge the topic
+func (m *msgTopicChecker) action(topic string) msgTopicAction {
+	if strings.HasPrefix(topic, Params.DmlChannelName+"_") {
+		if _, ok := m.dmlInUse[topic]; ok {
+			return msgTopicKeep
+		}
+		// rootcoord keeps producers of all the channels in pool, so only their subscriptions are purged
+		if _, ok := m.dmlPool[topic]; ok {
+			return msgTopicPurgeSubscriptions
+		}
+		return msgTopicPurge
+	}
+	if strings.HasPrefix(topic, Params.SearchChannelPrefix+"-") ||
+		strings.HasPrefix(topic, Params.SearchResultChannelPrefix+"-") {
+		if _, ok := m.queryChannels[topic]; ok {
+			return msgTopicKeep
+		}
+		return msgTopicPurge
+	}
+	// system channels, e.g. time tick and statistics channels, are always referenced
+	return msgTopicKeep
+}
+
+func (c *Core) newMsgAdmin() (mqclient.Admin, error) {
+	factory, ok := c.msFactory.(msgstream.AdminFactory)
+	if !ok {
+		return nil, errors.New("the message queue doesn't support managing topics")
+	}
+	return factory.NewAdmin()
+}
+
+// staleSubscriptions returns the subscriptions without any connected consumer, which keep the messages of the topic
+// from being retained. The subscriptions whose consumers are unknown are not counted.
+func staleSubscriptions(info mqclient.TopicInfo) []mqclient.SubscriptionInfo {
+	var stale []mqclient.SubscriptionInfo
+	for _, sub := range info.Subscriptions {
+		if sub.Consumers == 0 {
+			stale = append(stale, sub)
+		}
+	}
+	return stale
+}
+
+func convertMsgTopic(info mqclient.TopicInfo, referenced bool) *rootcoordpb.MsgTopic {
+	topic := &rootcoordpb.MsgTopic{
+		Name:       info.Name,
+		Referenced: referenced,
+	}
+	for _, sub := range info.Subscriptions {
+		topic.Subscriptions = append(topic.Subscriptions, &rootcoordpb.MsgSubscription{
+			Name:      sub.Name,
+			Backlog:   sub.Backlog,
+			Consumers: sub.Consumers,
+		})
+	}
+	return topic
+}
+
+// listMsgTopics lists the topics created by milvus with their subscriptions
+func (c *Core) listMsgTopics(ctx context.Context) ([]*rootcoordpb.MsgTopic, error) {
+	admin, err := c.newMsgAdmin()
+	if err != nil {
+		return nil, err
+	}
+	defer admin.Close()
+	checker, err := c.newMsgTopicChecker()
+	if err != nil {
+		return nil, err
+	}
+	infos, err := admin.ListTopics(ctx, Params.ClusterChannelPrefix+"-")
+	if err != nil {
+		return nil, err
+	}
+	topics := make([]*rootcoordpb.MsgTopic, 0, len(infos))
+	for _, info := range infos {
+		topics = append(topics, convertMsgTopic(info, checker.action(info.Name) == msgTopicKeep))
+	}
+	return topics, nil
+}
+
+// purgeMsgTopics deletes the topics no longer referenced, the subscriptions of the pooled dml channels not in use and
+// the stale subscriptions of the topics in use, returns the deleted topics and the deleted subscriptions of the kept topics
+func (c *Core) purgeMsgTopics(ctx context.Context, dryRun bool) ([]string, []*rootcoordpb.MsgTopic, error) {
+	admin, err := c.newMsgAdmin()
+	if err != nil {
+		return nil, nil, err
+	}
+	defer admin.Close()
+	checker, err := c.newMsgTopicChecker()
+	if err != nil {
+		return nil, nil, err
+	}
+	infos, err := admin.ListTopics(ctx, Params.ClusterChannelPrefix+"-")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	purgedTopics := make([]string, 0)
+	purgedSubs := make([]*rootcoordpb.MsgTopic, 0)
+	for _, info := range infos {
+		action := checker.action(info.Name)
+		switch action {
+		case msgTopicPurge:
+			if !dryRun {
+				if err := admin.DeleteTopic(ctx, info.Name); err != nil {
+					return purgedTopics, purgedSubs, err
+				}
+				log.Debug("purge msg topic", zap.String("topic", info.Name))
+			}
+			purgedTopics = append(purgedTopics, info.Name)
+		case msgTopicPurgeSubscriptions, msgTopicKeep:
+			subs := info.Subscriptions
+			if action == msgTopicKeep {
+				subs = staleSubscriptions(info)
+			}
+			if len(subs) == 0 {
+				continue
+			}
+			if !dryRun {
+				for _, sub := range subs {
+					if err := admin.DeleteSubscription(ctx, info.Name, sub.Name); err != nil {
+						return purgedTopics, purgedSubs, err
+					}
+					log.Debug("purge msg subscription", zap.String("topic", info.Name), zap.String("subscription", sub.Name))
+				}
+			}
+			purgedSubs = append(purgedSubs, convertMsgTopic(mqclient.TopicInfo{Name: info.Name, Subscriptions: subs}, true))
+		}
+	}
+	return purgedTopics, purgedSubs, nil
+}
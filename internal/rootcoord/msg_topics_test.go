@@ -0,0 +1,161 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	memkv "github.com/milvus-io/milvus/internal/kv/mem"
+	"github.com/milvus-io/milvus/internal/msgstream"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/util/mqclient"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMsgTopicChecker(t *testing.T) {
+	Params.Init()
+
+	inUse := Params.DmlChannelName + "_0"
+	inPool := Params.DmlChannelName + "_1"
+	dropped := Params.DmlChannelName + "_10"
+	search := Params.SearchChannelPrefix + "-1"
+	staleSearch := Params.SearchChannelPrefix + "-2"
+	searchResult := Params.SearchResultChannelPrefix + "-1"
+	staleSearchResult := Params.SearchResultChannelPrefix + "-2"
+
+	checker := &msgTopicChecker{
+		dmlPool:       newStringSet([]string{inUse, inPool}),
+		dmlInUse:      newStringSet([]string{inUse}),
+		queryChannels: newStringSet([]string{search, searchResult}),
+	}
+	assert.Equal(t, msgTopicKeep, checker.action(inUse))
+	assert.Equal(t, msgTopicPurgeSubscriptions, checker.action(inPool))
+	assert.Equal(t, msgTopicPurge, checker.action(dropped))
+	assert.Equal(t, msgTopicKeep, checker.action(search))
+	assert.Equal(t, msgTopicPurge, checker.action(staleSearch))
+	assert.Equal(t, msgTopicKeep, checker.action(searchResult))
+	assert.Equal(t, msgTopicPurge, checker.action(staleSearchResult))
+	assert.Equal(t, msgTopicKeep, checker.action(Params.TimeTickChannel))
+}
+
+func TestConvertMsgTopic(t *testing.T) {
+	topic := convertMsgTopic(mqclient.TopicInfo{
+		Name: "topic",
+		Subscriptions: []mqclient.SubscriptionInfo{
+			{Name: "sub", Backlog: 10, Consumers: 1},
+		},
+	}, true)
+	assert.Equal(t, "topic", topic.Name)
+	assert.True(t, topic.Referenced)
+	assert.Equal(t, 1, len(topic.Subscriptions))
+	assert.Equal(t, "sub", topic.Subscriptions[0].Name)
+	assert.Equal(t, int64(10), topic.Subscriptions[0].Backlog)
+	assert.Equal(t, int64(1), topic.Subscriptions[0].Consumers)
+}
+
+type fakeMsgAdmin struct {
+	topics        []mqclient.TopicInfo
+	deletedTopics []string
+	deletedSubs   []string
+}
+
+func (a *fakeMsgAdmin) ListTopics(ctx context.Context, prefix string) ([]mqclient.TopicInfo, error) {
+	return a.topics, nil
+}
+
+func (a *fakeMsgAdmin) DeleteSubscription(ctx context.Context, topic string, subName string) error {
+	a.deletedSubs = append(a.deletedSubs, topic+"/"+subName)
+	return nil
+}
+
+func (a *fakeMsgAdmin) DeleteTopic(ctx context.Context, topic string) error {
+	a.deletedTopics = append(a.deletedTopics, topic)
+	return nil
+}
+
+func (a *fakeMsgAdmin) Close() {
+}
+
+type fakeAdminFactory struct {
+	msgstream.Factory
+	admin *fakeMsgAdmin
+}
+
+func (f *fakeAdminFactory) NewAdmin() (mqclient.Admin, error) {
+	return f.admin, nil
+}
+
+func TestCore_purgeMsgTopics(t *testing.T) {
+	Params.Init()
+	ctx := context.Background()
+
+	inUse := Params.DmlChannelName + "_0"
+	inPool := Params.DmlChannelName + "_1"
+	dropped := Params.DmlChannelName + "_10"
+	search := Params.SearchChannelPrefix + "-0"
+	staleSearch := Params.SearchChannelPrefix + "-2"
+
+	store, err := memkv.NewMetaStore(nil)
+	assert.NoError(t, err)
+	metaKV := store.NewMetaKV(Params.MetaRootPath)
+	info, err := proto.Marshal(&querypb.QueryChannelInfo{
+		QueryChannelID:       search,
+		QueryResultChannelID: Params.SearchResultChannelPrefix + "-0",
+	})
+	assert.NoError(t, err)
+	assert.NoError(t, metaKV.Save(fmt.Sprintf("%s/%d", queryChannelMetaPrefix, 0), string(info)))
+
+	admin := &fakeMsgAdmin{
+		topics: []mqclient.TopicInfo{
+			{Name: inUse, Subscriptions: []mqclient.SubscriptionInfo{
+				{Name: "live", Consumers: 1},
+				{Name: "stale", Consumers: 0},
+				{Name: "unknown", Consumers: -1},
+			}},
+			{Name: inPool, Subscriptions: []mqclient.SubscriptionInfo{{Name: "live", Consumers: 1}}},
+			{Name: dropped},
+			{Name: search, Subscriptions: []mqclient.SubscriptionInfo{{Name: "stale", Consumers: 0}}},
+			{Name: staleSearch},
+			{Name: Params.TimeTickChannel, Subscriptions: []mqclient.SubscriptionInfo{{Name: "live", Consumers: 1}}},
+		},
+	}
+	core := &Core{
+		metaKV:      metaKV,
+		msFactory:   &fakeAdminFactory{admin: admin},
+		dmlChannels: &dmlChannels{},
+	}
+	core.dmlChannels.pool.Store(inUse, nil)
+	core.dmlChannels.pool.Store(inPool, nil)
+	core.dmlChannels.refcnt.Store(inUse, 1)
+
+	channels, err := core.listQueryChannels()
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []string{search, Params.SearchResultChannelPrefix + "-0"}, channels)
+
+	// nothing is deleted in dry run
+	purgedTopics, purgedSubs, err := core.purgeMsgTopics(ctx, true)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{dropped, staleSearch}, purgedTopics)
+	assert.Equal(t, 3, len(purgedSubs))
+	assert.Equal(t, 0, len(admin.deletedTopics))
+	assert.Equal(t, 0, len(admin.deletedSubs))
+
+	purgedTopics, purgedSubs, err = core.purgeMsgTopics(ctx, false)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{dropped, staleSearch}, purgedTopics)
+	assert.Equal(t, 3, len(purgedSubs))
+	assert.Equal(t, []string{dropped, staleSearch}, admin.deletedTopics)
+	assert.Equal(t, []string{inUse + "/stale", inPool + "/live", search + "/stale"}, admin.deletedSubs)
+}
@@ -12,6 +12,8 @@
 package rootcoord
 
 import (
+	"fmt"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -30,10 +32,11 @@ type ParamTable struct {
 	Address string
 	Port    int
 
-	PulsarAddress string
-	EtcdEndpoints []string
-	MetaRootPath  string
-	KvRootPath    string
+	PulsarAddress    string
+	PulsarWebAddress string
+	EtcdEndpoints    []string
+	MetaRootPath     string
+	KvRootPath       string
 
 	ClusterChannelPrefix string
 	MsgChannelSubName    string
@@ -41,6 +44,10 @@ type ParamTable struct {
 	StatisticsChannel    string
 	DmlChannelName       string
 
+	// prefixes of the query channels allocated by querycoord
+	SearchChannelPrefix       string
+	SearchResultChannelPrefix string
+
 	DmlChannelNum               int64
 	MaxPartitionNum             int64
 	DefaultPartitionName        string
@@ -50,6 +57,15 @@ type ParamTable struct {
 	Timeout          int
 	TimeTickInterval int
 
+	// --- Quota ---
+	QuotaEnabled         bool
+	QuotaCollectInterval time.Duration
+	MemoryLowWaterLevel  float64
+	MemoryHighWaterLevel float64
+	MaxTimeTickDelay     time.Duration
+	ForceDenyWriting     bool
+	ForceDenyReading     bool
+
 	CreatedTime time.Time
 	UpdatedTime time.Time
 
@@ -73,6 +89,7 @@ func (p *ParamTable) Init() {
 	}
 
 	p.initPulsarAddress()
+	p.initPulsarWebAddress()
 	p.initEtcdEndpoints()
 	p.initMetaRootPath()
 	p.initKvRootPath()
@@ -83,6 +100,8 @@ func (p *ParamTable) Init() {
 	p.initTimeTickChannel()
 	p.initStatisticsChannelName()
 	p.initDmlChannelName()
+	p.initSearchChannelPrefix()
+	p.initSearchResultChannelPrefix()
 
 	p.initDmlChannelNum()
 	p.initMaxPartitionNum()
@@ -93,6 +112,8 @@ func (p *ParamTable) Init() {
 	p.initTimeout()
 	p.initTimeTickInterval()
 
+	p.initQuota()
+
 	p.initLogCfg()
 	p.initRoleName()
 }
@@ -105,6 +126,14 @@ func (p *ParamTable) initPulsarAddress() {
 	p.PulsarAddress = addr
 }
 
+func (p *ParamTable) initPulsarWebAddress() {
+	addr, err := p.Load("_PulsarWebAddress")
+	if err != nil {
+		panic(err)
+	}
+	p.PulsarWebAddress = addr
+}
+
 func (p *ParamTable) initEtcdEndpoints() {
 	endpoints, err := p.Load("_EtcdEndpoints")
 	if err != nil {
@@ -181,6 +210,24 @@ func (p *ParamTable) initDmlChannelName() {
 	p.DmlChannelName = strings.Join(s, "-")
 }
 
+func (p *ParamTable) initSearchChannelPrefix() {
+	config, err := p.Load("msgChannel.chanNamePrefix.search")
+	if err != nil {
+		panic(err)
+	}
+	s := []string{p.ClusterChannelPrefix, config}
+	p.SearchChannelPrefix = strings.Join(s, "-")
+}
+
+func (p *ParamTable) initSearchResultChannelPrefix() {
+	config, err := p.Load("msgChannel.chanNamePrefix.searchResult")
+	if err != nil {
+		panic(err)
+	}
+	s := []string{p.ClusterChannelPrefix, config}
+	p.SearchResultChannelPrefix = strings.Join(s, "-")
+}
+
 func (p *ParamTable) initDmlChannelNum() {
 	p.DmlChannelNum = p.ParseInt64("rootcoord.dmlChannelNum")
 }
@@ -217,6 +264,32 @@ func (p *ParamTable) initTimeTickInterval() {
 	p.TimeTickInterval = p.ParseInt("rootcoord.timeTickInterval")
 }
 
+func (p *ParamTable) initQuota() {
+	p.QuotaEnabled = p.ParseBool("quotaAndLimits.enabled", false)
+	p.QuotaCollectInterval = time.Duration(p.parseFloatWithDefault("quotaAndLimits.collectInterval", 3) * float64(time.Second))
+	p.MemoryLowWaterLevel = p.parseFloatWithDefault("quotaAndLimits.memoryLowWaterLevel", 0.8)
+	p.MemoryHighWaterLevel = p.parseFloatWithDefault("quotaAndLimits.memoryHighWaterLevel", 0.9)
+	if p.MemoryLowWaterLevel > p.MemoryHighWaterLevel {
+		panic(fmt.Sprintf("quotaAndLimits.memoryLowWaterLevel %f is greater than quotaAndLimits.memoryHighWaterLevel %f",
+			p.MemoryLowWaterLevel, p.MemoryHighWaterLevel))
+	}
+	p.MaxTimeTickDelay = time.Duration(p.parseFloatWithDefault("quotaAndLimits.maxTimeTickDelay", 300) * float64(time.Second))
+	p.ForceDenyWriting = p.ParseBool("quotaAndLimits.forceDenyWriting", false)
+	p.ForceDenyReading = p.ParseBool("quotaAndLimits.forceDenyReading", false)
+}
+
+func (p *ParamTable) parseFloatWithDefault(key string, defaultValue float64) float64 {
+	str, err := p.LoadWithDefault(key, strconv.FormatFloat(defaultValue, 'f', -1, 64))
+	if err != nil {
+		panic(err)
+	}
+	value, err := strconv.ParseFloat(str, 64)
+	if err != nil {
+		panic(err)
+	}
+	return value
+}
+
 func (p *ParamTable) initLogCfg() {
 	p.InitLogCfg("rootcoord", 0)
 }
@@ -24,6 +24,9 @@ func TestParamTable(t *testing.T) {
 	assert.NotEqual(t, Params.PulsarAddress, "")
 	t.Logf("pulsar address = %s", Params.PulsarAddress)
 
+	assert.NotEqual(t, Params.PulsarWebAddress, "")
+	t.Logf("pulsar web address = %s", Params.PulsarWebAddress)
+
 	assert.NotZero(t, len(Params.EtcdEndpoints))
 	t.Logf("etcd endpoints = %s", Params.EtcdEndpoints)
 
@@ -45,6 +48,12 @@ func TestParamTable(t *testing.T) {
 	assert.Equal(t, Params.DmlChannelName, "by-dev-rootcoord-dml")
 	t.Logf("dml channel = %s", Params.DmlChannelName)
 
+	assert.Equal(t, Params.SearchChannelPrefix, "by-dev-search")
+	t.Logf("search channel prefix = %s", Params.SearchChannelPrefix)
+
+	assert.Equal(t, Params.SearchResultChannelPrefix, "by-dev-searchResult")
+	t.Logf("search result channel prefix = %s", Params.SearchResultChannelPrefix)
+
 	assert.NotEqual(t, Params.MaxPartitionNum, 0)
 	t.Logf("master MaxPartitionNum = %d", Params.MaxPartitionNum)
 
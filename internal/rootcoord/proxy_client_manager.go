@@ -13,7 +13,9 @@ package rootcoord
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 	"sync"
 
 	"github.com/milvus-io/milvus/internal/log"
@@ -55,7 +57,7 @@ func (p *proxyClientManager) GetProxyClients(sess []*sessionutil.Session) {
 		pc, err := p.core.NewProxyClient(s)
 		if err != nil {
 			log.Debug("create proxy client failed", zap.String("proxy address", s.Address), zap.Int64("proxy id", s.ServerID), zap.Error(err))
-			pl, _ = listProxyInEtcd(p.core.ctx, p.core.etcdCli)
+			pl, _ = listProxyInEtcd(p.core.metaKV)
 			continue
 		}
 		p.proxyClient[s.ServerID] = pc
@@ -120,6 +122,74 @@ func (p *proxyClientManager) InvalidateCollectionMetaCache(ctx context.Context,
 	}
 }
 
+// InvalidateCredentialCache notifies all proxies to drop the cached credential of the user,
+// an error is returned if any of the proxies fails, so that the caller could tell the client to retry.
+func (p *proxyClientManager) InvalidateCredentialCache(ctx context.Context, request *proxypb.InvalidateCredCacheRequest) error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if len(p.proxyClient) == 0 {
+		log.Debug("proxy client is empty,InvalidateCredentialCache will not send to any client")
+		return nil
+	}
+
+	for k, f := range p.proxyClient {
+		sta, err := f.InvalidateCredentialCache(ctx, request)
+		if err != nil {
+			return fmt.Errorf("grpc fail, proxy id = %d, error = %w", k, err)
+		}
+		if sta.ErrorCode != commonpb.ErrorCode_Success {
+			return fmt.Errorf("proxy id = %d, message = %s", k, sta.Reason)
+		}
+		log.Debug("send invalidate credential cache to proxy node", zap.Int64("node id", k))
+	}
+	return nil
+}
+
+func (p *proxyClientManager) InvalidatePolicyInfoCache(ctx context.Context, request *proxypb.InvalidatePolicyInfoCacheRequest) error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if len(p.proxyClient) == 0 {
+		log.Debug("proxy client is empty,InvalidatePolicyInfoCache will not send to any client")
+		return nil
+	}
+
+	for k, f := range p.proxyClient {
+		sta, err := f.InvalidatePolicyInfoCache(ctx, request)
+		if err != nil {
+			return fmt.Errorf("grpc fail, proxy id = %d, error = %w", k, err)
+		}
+		if sta.ErrorCode != commonpb.ErrorCode_Success {
+			return fmt.Errorf("proxy id = %d, message = %s", k, sta.Reason)
+		}
+		log.Debug("send invalidate policy info cache to proxy node", zap.Int64("node id", k))
+	}
+	return nil
+}
+
+// SetQuotaFactors sends the factors to all the proxies, a failed proxy doesn't stop the others
+func (p *proxyClientManager) SetQuotaFactors(ctx context.Context, request *proxypb.SetQuotaFactorsRequest) error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	var errs []string
+	for k, f := range p.proxyClient {
+		sta, err := f.SetQuotaFactors(ctx, request)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("grpc fail, proxy id = %d, error = %s", k, err.Error()))
+			continue
+		}
+		if sta.ErrorCode != commonpb.ErrorCode_Success {
+			errs = append(errs, fmt.Sprintf("proxy id = %d, message = %s", k, sta.Reason))
+		}
+	}
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+	return nil
+}
+
 func (p *proxyClientManager) ReleaseDQLMessageStream(ctx context.Context, in *proxypb.ReleaseDQLMessageStreamRequest) (*commonpb.Status, error) {
 	p.lock.Lock()
 	defer p.lock.Unlock()
@@ -16,10 +16,10 @@ import (
 	"errors"
 	"testing"
 
+	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
 	"github.com/stretchr/testify/assert"
-	clientv3 "go.etcd.io/etcd/client/v3"
 )
 
 func TestProxyClientManager_GetProxyClients(t *testing.T) {
@@ -27,9 +27,9 @@ func TestProxyClientManager_GetProxyClients(t *testing.T) {
 
 	core, err := NewCore(context.Background(), nil)
 	assert.Nil(t, err)
-	cli, err := clientv3.New(clientv3.Config{Endpoints: Params.EtcdEndpoints})
+	metaKV, err := etcdkv.NewEtcdKV(Params.EtcdEndpoints, Params.MetaRootPath)
 	assert.Nil(t, err)
-	core.etcdCli = cli
+	core.metaKV = metaKV
 
 	core.SetNewProxyClient(
 		func(se *sessionutil.Session) (types.Proxy, error) {
@@ -53,9 +53,9 @@ func TestProxyClientManager_AddProxyClient(t *testing.T) {
 
 	core, err := NewCore(context.Background(), nil)
 	assert.Nil(t, err)
-	cli, err := clientv3.New(clientv3.Config{Endpoints: Params.EtcdEndpoints})
+	metaKV, err := etcdkv.NewEtcdKV(Params.EtcdEndpoints, Params.MetaRootPath)
 	assert.Nil(t, err)
-	core.etcdCli = cli
+	core.metaKV = metaKV
 
 	core.SetNewProxyClient(
 		func(se *sessionutil.Session) (types.Proxy, error) {
@@ -79,9 +79,9 @@ func TestProxyClientManager_InvalidateCollectionMetaCache(t *testing.T) {
 
 	core, err := NewCore(ctx, nil)
 	assert.Nil(t, err)
-	cli, err := clientv3.New(clientv3.Config{Endpoints: Params.EtcdEndpoints})
+	metaKV, err := etcdkv.NewEtcdKV(Params.EtcdEndpoints, Params.MetaRootPath)
 	assert.Nil(t, err)
-	core.etcdCli = cli
+	core.metaKV = metaKV
 
 	pcm := newProxyClientManager(core)
 
@@ -109,9 +109,9 @@ func TestProxyClientManager_ReleaseDQLMessageStream(t *testing.T) {
 
 	core, err := NewCore(ctx, nil)
 	assert.Nil(t, err)
-	cli, err := clientv3.New(clientv3.Config{Endpoints: Params.EtcdEndpoints})
+	metaKV, err := etcdkv.NewEtcdKV(Params.EtcdEndpoints, Params.MetaRootPath)
 	assert.Nil(t, err)
-	core.etcdCli = cli
+	core.metaKV = metaKV
 
 	pcm := newProxyClientManager(core)
 
@@ -18,12 +18,12 @@ import (
 	"path"
 	"sync"
 
+	"github.com/milvus-io/milvus/internal/kv"
+	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/metrics"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
-	"go.etcd.io/etcd/api/v3/mvccpb"
-	clientv3 "go.etcd.io/etcd/client/v3"
 	"go.uber.org/zap"
 )
 
@@ -32,26 +32,26 @@ type proxyManager struct {
 	ctx         context.Context
 	cancel      context.CancelFunc
 	lock        sync.Mutex
-	etcdCli     *clientv3.Client
+	metaKV      kv.MetaKv
 	getSessions []func([]*sessionutil.Session)
 	addSessions []func(*sessionutil.Session)
 	delSessions []func(*sessionutil.Session)
 }
 
 // newProxyManager helper function to create a proxyManager
-// etcdEndpoints is the address list of etcd
+// etcdEndpoints is the address list of etcd, it is not used if the local meta store is in use
 // fns are the custom getSessions function list
 func newProxyManager(ctx context.Context, etcdEndpoints []string, fns ...func([]*sessionutil.Session)) (*proxyManager, error) {
-	cli, err := clientv3.New(clientv3.Config{Endpoints: etcdEndpoints})
+	metaKV, err := etcdkv.NewMetaKv(etcdEndpoints, Params.MetaRootPath)
 	if err != nil {
 		return nil, err
 	}
 	ctx2, cancel2 := context.WithCancel(ctx)
 	p := &proxyManager{
-		ctx:     ctx2,
-		cancel:  cancel2,
-		lock:    sync.Mutex{},
-		etcdCli: cli,
+		ctx:    ctx2,
+		cancel: cancel2,
+		lock:   sync.Mutex{},
+		metaKV: metaKV,
 	}
 	p.getSessions = append(p.getSessions, fns...)
 	return p, nil
@@ -73,23 +73,16 @@ func (p *proxyManager) DelSession(fns ...func(*sessionutil.Session)) {
 
 // WatchProxy starts a goroutine to watch proxy session changes on etcd
 func (p *proxyManager) WatchProxy() error {
-	ctx2, cancel := context.WithTimeout(p.ctx, RequestTimeout)
-	defer cancel()
-	resp, err := p.etcdCli.Get(
-		ctx2,
-		path.Join(Params.MetaRootPath, sessionutil.DefaultServiceRoot, typeutil.ProxyRole),
-		clientv3.WithPrefix(),
-		clientv3.WithSort(clientv3.SortByKey, clientv3.SortAscend),
-	)
+	_, values, revision, err := p.metaKV.LoadWithRevision(path.Join(sessionutil.DefaultServiceRoot, typeutil.ProxyRole))
 	if err != nil {
 		return fmt.Errorf("proxyManager, watch proxy failed, error = %w", err)
 	}
 
 	go func() {
 		sessions := []*sessionutil.Session{}
-		for _, v := range resp.Kvs {
+		for _, v := range values {
 			sess := new(sessionutil.Session)
-			err := json.Unmarshal(v.Value, sess)
+			err := json.Unmarshal([]byte(v), sess)
 			if err != nil {
 				log.Debug("unmarshal SvrSession failed", zap.Error(err))
 				continue
@@ -106,14 +99,7 @@ func (p *proxyManager) WatchProxy() error {
 			log.Debug("Get proxy", zap.Int64("id", s.ServerID), zap.String("addr", s.Address), zap.String("name", s.ServerName))
 		}
 
-		rch := p.etcdCli.Watch(
-			p.ctx,
-			path.Join(Params.MetaRootPath, sessionutil.DefaultServiceRoot, typeutil.ProxyRole),
-			clientv3.WithPrefix(),
-			clientv3.WithCreatedNotify(),
-			clientv3.WithPrevKV(),
-			clientv3.WithRev(resp.Header.Revision+1),
-		)
+		rch := p.metaKV.WatchWithRevision(path.Join(sessionutil.DefaultServiceRoot, typeutil.ProxyRole), revision+1)
 		for {
 			select {
 			case <-p.ctx.Done():
@@ -124,10 +110,10 @@ func (p *proxyManager) WatchProxy() error {
 					log.Debug("watch proxy failed")
 					return
 				}
-				pl, _ := listProxyInEtcd(p.ctx, p.etcdCli)
+				pl, _ := listProxyInEtcd(p.metaKV)
 				for _, ev := range wresp.Events {
 					switch ev.Type {
-					case mvccpb.PUT:
+					case kv.EventTypePut:
 						sess := new(sessionutil.Session)
 						err := json.Unmarshal(ev.Kv.Value, sess)
 						if err != nil {
@@ -145,7 +131,7 @@ func (p *proxyManager) WatchProxy() error {
 						}
 						p.lock.Unlock()
 						metrics.RootCoordProxyLister.WithLabelValues(metricProxy(sess.ServerID)).Set(1)
-					case mvccpb.DELETE:
+					case kv.EventTypeDelete:
 						sess := new(sessionutil.Session)
 						err := json.Unmarshal(ev.PrevKv.Value, sess)
 						if err != nil {
@@ -173,22 +159,16 @@ func (p *proxyManager) Stop() {
 }
 
 // listProxyInEtcd helper function lists proxy in etcd
-func listProxyInEtcd(ctx context.Context, cli *clientv3.Client) (map[int64]*sessionutil.Session, error) {
-	ctx2, cancel := context.WithTimeout(ctx, RequestTimeout)
-	defer cancel()
-	resp, err := cli.Get(
-		ctx2,
-		path.Join(Params.MetaRootPath, sessionutil.DefaultServiceRoot, typeutil.ProxyRole),
-		clientv3.WithPrefix(),
-		clientv3.WithSort(clientv3.SortByKey, clientv3.SortAscend),
-	)
+// metaKV is the MetaKv rooted at Params.MetaRootPath
+func listProxyInEtcd(metaKV kv.MetaKv) (map[int64]*sessionutil.Session, error) {
+	_, values, err := metaKV.LoadWithPrefix(path.Join(sessionutil.DefaultServiceRoot, typeutil.ProxyRole))
 	if err != nil {
 		return nil, fmt.Errorf("list proxy failed, etcd error = %w", err)
 	}
 	sess := make(map[int64]*sessionutil.Session)
-	for _, v := range resp.Kvs {
+	for _, v := range values {
 		var s sessionutil.Session
-		err := json.Unmarshal(v.Value, &s)
+		err := json.Unmarshal([]byte(v), &s)
 		if err != nil {
 			log.Debug("unmarshal SvrSession failed", zap.Error(err))
 			continue
@@ -0,0 +1,223 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metrics"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
+	"github.com/milvus-io/milvus/internal/util/metricsinfo"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// quotaCenter collects the hardware metrics and the tSafe of query nodes and data nodes,
+// computes the write and read factors, and pushes them to all the proxies.
+//
+// The write factor drops linearly from 1 to 0 as the memory water level of any node goes from
+// MemoryLowWaterLevel to MemoryHighWaterLevel, and both factors drop linearly from 1 to 0 as the
+// tSafe delay of any query node goes from 0 to MaxTimeTickDelay.
+type quotaCenter struct {
+	getQueryClusterMetrics func(ctx context.Context) (*metricsinfo.QueryCoordTopology, error)
+	getDataClusterMetrics  func(ctx context.Context) (*metricsinfo.DataCoordTopology, error)
+	setQuotaFactors        func(ctx context.Context, request *proxypb.SetQuotaFactorsRequest) error
+	tsoAllocator           func(count uint32) (typeutil.Timestamp, error)
+
+	queryNodeMetrics []metricsinfo.QueryNodeInfos
+	dataNodeMetrics  []metricsinfo.DataNodeInfos
+
+	writeFactor float64
+	readFactor  float64
+	writeReason string
+	readReason  string
+}
+
+func newQuotaCenter(c *Core) *quotaCenter {
+	return &quotaCenter{
+		getQueryClusterMetrics: func(ctx context.Context) (*metricsinfo.QueryCoordTopology, error) {
+			if c.CallGetQueryClusterMetrics == nil {
+				return nil, fmt.Errorf("query coord is not set")
+			}
+			return c.CallGetQueryClusterMetrics(ctx)
+		},
+		getDataClusterMetrics: func(ctx context.Context) (*metricsinfo.DataCoordTopology, error) {
+			if c.CallGetDataClusterMetrics == nil {
+				return nil, fmt.Errorf("data coord is not set")
+			}
+			return c.CallGetDataClusterMetrics(ctx)
+		},
+		setQuotaFactors: func(ctx context.Context, request *proxypb.SetQuotaFactorsRequest) error {
+			return c.proxyClientManager.SetQuotaFactors(ctx, request)
+		},
+		tsoAllocator: c.TSOAllocator,
+		writeFactor:  1,
+		readFactor:   1,
+	}
+}
+
+// syncMetrics fetches the metrics of query nodes and data nodes, the nodes with error are skipped
+func (q *quotaCenter) syncMetrics(ctx context.Context) error {
+	queryTopology, err := q.getQueryClusterMetrics(ctx)
+	if err != nil {
+		return err
+	}
+	dataTopology, err := q.getDataClusterMetrics(ctx)
+	if err != nil {
+		return err
+	}
+
+	q.queryNodeMetrics = q.queryNodeMetrics[:0]
+	for _, node := range queryTopology.Cluster.ConnectedNodes {
+		if !node.HasError {
+			q.queryNodeMetrics = append(q.queryNodeMetrics, node)
+		}
+	}
+	q.dataNodeMetrics = q.dataNodeMetrics[:0]
+	for _, node := range dataTopology.Cluster.ConnectedNodes {
+		if !node.HasError {
+			q.dataNodeMetrics = append(q.dataNodeMetrics, node)
+		}
+	}
+	return nil
+}
+
+// memoryFactor returns the factor of the node with the highest memory water level
+func memoryFactor(name string, hw metricsinfo.HardwareMetrics) (float64, string) {
+	if hw.Memory == 0 {
+		return 1, ""
+	}
+	waterLevel := float64(hw.MemoryUsage) / float64(hw.Memory)
+	if waterLevel <= Params.MemoryLowWaterLevel {
+		return 1, ""
+	}
+	reason := fmt.Sprintf("memory water level of %s is %.2f", name, waterLevel)
+	if waterLevel >= Params.MemoryHighWaterLevel {
+		return 0, reason
+	}
+	return (Params.MemoryHighWaterLevel - waterLevel) / (Params.MemoryHighWaterLevel - Params.MemoryLowWaterLevel), reason
+}
+
+// timeTickDelayFactor returns the factor of a query node whose tSafe falls behind now
+func timeTickDelayFactor(name string, tSafe typeutil.Timestamp, now time.Time) (float64, string) {
+	if tSafe == 0 || Params.MaxTimeTickDelay <= 0 {
+		return 1, ""
+	}
+	tSafeTime, _ := tsoutil.ParseTS(tSafe)
+	delay := now.Sub(tSafeTime)
+	if delay <= 0 {
+		return 1, ""
+	}
+	reason := fmt.Sprintf("tSafe of %s falls behind %s", name, delay.String())
+	if delay >= Params.MaxTimeTickDelay {
+		return 0, reason
+	}
+	return 1 - float64(delay)/float64(Params.MaxTimeTickDelay), reason
+}
+
+// calculateFactors computes the write factor and the read factor from the synced metrics
+func (q *quotaCenter) calculateFactors(now time.Time) {
+	writeFactor, writeReason := 1.0, ""
+	readFactor, readReason := 1.0, ""
+	minWrite := func(factor float64, reason string) {
+		if factor < writeFactor {
+			writeFactor, writeReason = factor, reason
+		}
+	}
+	minRead := func(factor float64, reason string) {
+		if factor < readFactor {
+			readFactor, readReason = factor, reason
+		}
+	}
+
+	for _, node := range q.queryNodeMetrics {
+		minWrite(memoryFactor(node.Name, node.HardwareInfos))
+		factor, reason := timeTickDelayFactor(node.Name, node.QuotaMetrics.MinFlowGraphTt, now)
+		minWrite(factor, reason)
+		minRead(factor, reason)
+	}
+	for _, node := range q.dataNodeMetrics {
+		minWrite(memoryFactor(node.Name, node.HardwareInfos))
+	}
+
+	if Params.ForceDenyWriting {
+		writeFactor, writeReason = 0, "writing is denied by quotaAndLimits.forceDenyWriting"
+	}
+	if Params.ForceDenyReading {
+		readFactor, readReason = 0, "reading is denied by quotaAndLimits.forceDenyReading"
+	}
+
+	q.writeFactor, q.writeReason = writeFactor, writeReason
+	q.readFactor, q.readReason = readFactor, readReason
+	metrics.RootCoordQuotaFactor.WithLabelValues("write").Set(writeFactor)
+	metrics.RootCoordQuotaFactor.WithLabelValues("read").Set(readFactor)
+}
+
+// sendFactors pushes the factors to all the proxies
+func (q *quotaCenter) sendFactors(ctx context.Context) error {
+	return q.setQuotaFactors(ctx, &proxypb.SetQuotaFactorsRequest{
+		Base: &commonpb.MsgBase{
+			MsgType: commonpb.MsgType_SetQuotaFactors,
+		},
+		WriteFactor: q.writeFactor,
+		ReadFactor:  q.readFactor,
+		WriteReason: q.writeReason,
+		ReadReason:  q.readReason,
+	})
+}
+
+// tick syncs the metrics, updates the factors and pushes them to the proxies,
+// nothing is pushed when the metrics fail to sync, since the factors computed from the stale metrics are stale too
+func (q *quotaCenter) tick(ctx context.Context) {
+	if err := q.syncMetrics(ctx); err != nil {
+		log.Warn("quota center failed to sync metrics, skip pushing the factors", zap.Error(err))
+		return
+	}
+	ts, err := q.tsoAllocator(1)
+	if err != nil {
+		log.Warn("quota center failed to allocate timestamp", zap.Error(err))
+		return
+	}
+	now, _ := tsoutil.ParseTS(ts)
+	q.calculateFactors(now)
+	if q.writeFactor < 1 || q.readFactor < 1 {
+		log.Debug("quota center throttles requests",
+			zap.Float64("writeFactor", q.writeFactor), zap.String("writeReason", q.writeReason),
+			zap.Float64("readFactor", q.readFactor), zap.String("readReason", q.readReason))
+	}
+	if err := q.sendFactors(ctx); err != nil {
+		log.Warn("quota center failed to send factors", zap.Error(err))
+	}
+}
+
+func (c *Core) quotaCenterLoop() {
+	defer c.wg.Done()
+	ticker := time.NewTicker(Params.QuotaCollectInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-c.ctx.Done():
+			log.Debug("RootCoord context done, exit quotaCenterLoop")
+			return
+		case <-ticker.C:
+			ctx, cancel := context.WithTimeout(c.ctx, Params.QuotaCollectInterval)
+			c.quotaCenter.tick(ctx)
+			cancel()
+		}
+	}
+}
@@ -0,0 +1,155 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
+	"github.com/milvus-io/milvus/internal/util/metricsinfo"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+func newQueryNodeInfos(name string, memory, memoryUsage uint64, tSafe typeutil.Timestamp) metricsinfo.QueryNodeInfos {
+	return metricsinfo.QueryNodeInfos{
+		BaseComponentInfos: metricsinfo.BaseComponentInfos{
+			Name:          name,
+			HardwareInfos: metricsinfo.HardwareMetrics{Memory: memory, MemoryUsage: memoryUsage},
+		},
+		QuotaMetrics: metricsinfo.QueryNodeQuotaMetrics{MinFlowGraphTt: tSafe},
+	}
+}
+
+func newDataNodeInfos(name string, memory, memoryUsage uint64) metricsinfo.DataNodeInfos {
+	return metricsinfo.DataNodeInfos{
+		BaseComponentInfos: metricsinfo.BaseComponentInfos{
+			Name:          name,
+			HardwareInfos: metricsinfo.HardwareMetrics{Memory: memory, MemoryUsage: memoryUsage},
+		},
+	}
+}
+
+func TestQuotaCenter(t *testing.T) {
+	Params.Init()
+	low, high, maxDelay := Params.MemoryLowWaterLevel, Params.MemoryHighWaterLevel, Params.MaxTimeTickDelay
+	defer func() {
+		Params.MemoryLowWaterLevel, Params.MemoryHighWaterLevel, Params.MaxTimeTickDelay = low, high, maxDelay
+		Params.ForceDenyWriting, Params.ForceDenyReading = false, false
+	}()
+	Params.MemoryLowWaterLevel = 0.8
+	Params.MemoryHighWaterLevel = 0.9
+	Params.MaxTimeTickDelay = 100 * time.Second
+
+	now := time.Now()
+	queryTopology := &metricsinfo.QueryCoordTopology{}
+	dataTopology := &metricsinfo.DataCoordTopology{}
+	var sent *proxypb.SetQuotaFactorsRequest
+	var syncErr error
+	q := &quotaCenter{
+		getQueryClusterMetrics: func(ctx context.Context) (*metricsinfo.QueryCoordTopology, error) {
+			return queryTopology, syncErr
+		},
+		getDataClusterMetrics: func(ctx context.Context) (*metricsinfo.DataCoordTopology, error) {
+			return dataTopology, nil
+		},
+		setQuotaFactors: func(ctx context.Context, request *proxypb.SetQuotaFactorsRequest) error {
+			sent = request
+			return nil
+		},
+		tsoAllocator: func(count uint32) (typeutil.Timestamp, error) {
+			return tsoutil.ComposeTS(now.UnixNano()/int64(time.Millisecond), 0), nil
+		},
+		writeFactor: 1,
+		readFactor:  1,
+	}
+	ctx := context.Background()
+
+	t.Run("healthy", func(t *testing.T) {
+		queryTopology.Cluster.ConnectedNodes = []metricsinfo.QueryNodeInfos{
+			newQueryNodeInfos("qn1", 100, 50, tsoutil.ComposeTS(now.UnixNano()/int64(time.Millisecond), 0)),
+			newQueryNodeInfos("qn2", 100, 80, 0),
+		}
+		dataTopology.Cluster.ConnectedNodes = []metricsinfo.DataNodeInfos{newDataNodeInfos("dn1", 0, 0)}
+		q.tick(ctx)
+		assert.Equal(t, float64(1), sent.WriteFactor)
+		assert.Equal(t, float64(1), sent.ReadFactor)
+		assert.Empty(t, sent.WriteReason)
+		assert.Empty(t, sent.ReadReason)
+	})
+
+	t.Run("memory", func(t *testing.T) {
+		queryTopology.Cluster.ConnectedNodes = []metricsinfo.QueryNodeInfos{newQueryNodeInfos("qn1", 100, 85, 0)}
+		dataTopology.Cluster.ConnectedNodes = []metricsinfo.DataNodeInfos{newDataNodeInfos("dn1", 100, 50)}
+		q.tick(ctx)
+		assert.InDelta(t, 0.5, sent.WriteFactor, 1e-6)
+		assert.Contains(t, sent.WriteReason, "qn1")
+		assert.Equal(t, float64(1), sent.ReadFactor)
+
+		dataTopology.Cluster.ConnectedNodes = []metricsinfo.DataNodeInfos{newDataNodeInfos("dn1", 100, 95)}
+		q.tick(ctx)
+		assert.Equal(t, float64(0), sent.WriteFactor)
+		assert.Contains(t, sent.WriteReason, "dn1")
+
+		// nodes with error are skipped
+		dataTopology.Cluster.ConnectedNodes[0].HasError = true
+		q.tick(ctx)
+		assert.InDelta(t, 0.5, sent.WriteFactor, 1e-6)
+	})
+
+	t.Run("time tick delay", func(t *testing.T) {
+		delayed := now.Add(-25 * time.Second)
+		queryTopology.Cluster.ConnectedNodes = []metricsinfo.QueryNodeInfos{
+			newQueryNodeInfos("qn1", 100, 10, tsoutil.ComposeTS(delayed.UnixNano()/int64(time.Millisecond), 0)),
+		}
+		dataTopology.Cluster.ConnectedNodes = nil
+		q.tick(ctx)
+		assert.InDelta(t, 0.75, sent.WriteFactor, 1e-6)
+		assert.InDelta(t, 0.75, sent.ReadFactor, 1e-6)
+		assert.Contains(t, sent.ReadReason, "qn1")
+
+		delayed = now.Add(-200 * time.Second)
+		queryTopology.Cluster.ConnectedNodes[0].QuotaMetrics.MinFlowGraphTt = tsoutil.ComposeTS(delayed.UnixNano()/int64(time.Millisecond), 0)
+		q.tick(ctx)
+		assert.Equal(t, float64(0), sent.WriteFactor)
+		assert.Equal(t, float64(0), sent.ReadFactor)
+	})
+
+	t.Run("sync failed", func(t *testing.T) {
+		// the stale factors are not sent
+		syncErr = errors.New("mock error")
+		defer func() { syncErr = nil }()
+		sent = nil
+		q.tick(ctx)
+		assert.Nil(t, sent)
+		assert.Equal(t, float64(0), q.readFactor)
+	})
+
+	t.Run("force deny", func(t *testing.T) {
+		queryTopology.Cluster.ConnectedNodes = nil
+		Params.ForceDenyWriting = true
+		q.tick(ctx)
+		assert.Equal(t, float64(0), sent.WriteFactor)
+		assert.Equal(t, float64(1), sent.ReadFactor)
+
+		Params.ForceDenyWriting = false
+		Params.ForceDenyReading = true
+		q.tick(ctx)
+		assert.Equal(t, float64(1), sent.WriteFactor)
+		assert.Equal(t, float64(0), sent.ReadFactor)
+	})
+}
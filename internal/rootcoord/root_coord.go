@@ -27,6 +27,7 @@ import (
 
 	"github.com/golang/protobuf/proto"
 	"github.com/milvus-io/milvus/internal/allocator"
+	"github.com/milvus-io/milvus/internal/common"
 	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/metrics"
@@ -43,12 +44,13 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/tso"
 	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/crypto"
+	"github.com/milvus-io/milvus/internal/util/migration"
 	"github.com/milvus-io/milvus/internal/util/retry"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
 	"github.com/milvus-io/milvus/internal/util/trace"
 	"github.com/milvus-io/milvus/internal/util/tsoutil"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
-	clientv3 "go.etcd.io/etcd/client/v3"
 	"go.uber.org/zap"
 )
 
@@ -84,11 +86,11 @@ type Core struct {
 	TSOAllocatorUpdate func() error
 
 	//inner members
-	ctx     context.Context
-	cancel  context.CancelFunc
-	wg      sync.WaitGroup
-	etcdCli *clientv3.Client
-	kvBase  kv.TxnKV //*etcdkv.EtcdKV
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+	metaKV kv.MetaKv
+	kvBase kv.TxnKV //*etcdkv.EtcdKV
 
 	//DDL lock
 	ddlLock sync.Mutex
@@ -117,7 +119,7 @@ type Core struct {
 	CallGetFlushedSegmentsService func(ctx context.Context, collID, partID typeutil.UniqueID) ([]typeutil.UniqueID, error)
 
 	//call index builder's client to build index, return build id
-	CallBuildIndexService func(ctx context.Context, binlog []string, field *schemapb.FieldSchema, idxInfo *etcdpb.IndexInfo) (typeutil.UniqueID, error)
+	CallBuildIndexService func(ctx context.Context, binlog []string, field *schemapb.FieldSchema, idxInfo *etcdpb.IndexInfo, numRows int64) (typeutil.UniqueID, error)
 	CallDropIndexService  func(ctx context.Context, indexID typeutil.UniqueID) error
 
 	NewProxyClient func(sess *sessionutil.Session) (types.Proxy, error)
@@ -126,6 +128,10 @@ type Core struct {
 	CallReleaseCollectionService func(ctx context.Context, ts typeutil.Timestamp, dbID, collectionID typeutil.UniqueID) error
 	CallReleasePartitionService  func(ctx context.Context, ts typeutil.Timestamp, dbID, collectionID typeutil.UniqueID, partitionIDs []typeutil.UniqueID) error
 
+	//get cluster metrics from query coord and data coord, used by quota center
+	CallGetQueryClusterMetrics func(ctx context.Context) (*metricsinfo.QueryCoordTopology, error)
+	CallGetDataClusterMetrics  func(ctx context.Context) (*metricsinfo.DataCoordTopology, error)
+
 	//dml channels
 	dmlChannels *dmlChannels
 
@@ -135,6 +141,9 @@ type Core struct {
 	// proxy clients
 	proxyClientManager *proxyClientManager
 
+	// quota center, throttle proxies by the metrics of the cluster
+	quotaCenter *quotaCenter
+
 	// metrics cache manager
 	metricsCacheManager *metricsinfo.MetricsCacheManager
 
@@ -200,8 +209,8 @@ func (c *Core) checkInit() error {
 	if c.TSOAllocatorUpdate == nil {
 		return fmt.Errorf("tsoAllocatorUpdate is nil")
 	}
-	if c.etcdCli == nil {
-		return fmt.Errorf("etcdCli is nil")
+	if c.metaKV == nil {
+		return fmt.Errorf("metaKV is nil")
 	}
 	if c.kvBase == nil {
 		return fmt.Errorf("kvBase is nil")
@@ -595,6 +604,30 @@ func (c *Core) SetDataCoord(ctx context.Context, s types.DataCoord) error {
 			log.Debug("RootCoord connect to DataCoord, retry")
 		}
 	}()
+	c.CallGetDataClusterMetrics = func(ctx context.Context) (retTopology *metricsinfo.DataCoordTopology, retErr error) {
+		defer func() {
+			if err := recover(); err != nil {
+				retErr = fmt.Errorf("get metrics from data service panic, msg = %v", err)
+			}
+		}()
+		<-initCh
+		req, err := metricsinfo.ConstructRequestByMetricType(metricsinfo.SystemInfoMetrics)
+		if err != nil {
+			return nil, err
+		}
+		rsp, err := s.GetMetrics(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		if rsp.Status.ErrorCode != commonpb.ErrorCode_Success {
+			return nil, fmt.Errorf("GetMetrics from data service failed, error = %s", rsp.Status.Reason)
+		}
+		topology := &metricsinfo.DataCoordTopology{}
+		if err := metricsinfo.UnmarshalTopology(rsp.Response, topology); err != nil {
+			return nil, err
+		}
+		return topology, nil
+	}
 	c.CallGetBinlogFilePathsService = func(ctx context.Context, segID typeutil.UniqueID, fieldID typeutil.UniqueID) (retFiles []string, retErr error) {
 		defer func() {
 			if err := recover(); err != nil {
@@ -712,7 +745,7 @@ func (c *Core) SetIndexCoord(s types.IndexCoord) error {
 		}
 	}()
 
-	c.CallBuildIndexService = func(ctx context.Context, binlog []string, field *schemapb.FieldSchema, idxInfo *etcdpb.IndexInfo) (retID typeutil.UniqueID, retErr error) {
+	c.CallBuildIndexService = func(ctx context.Context, binlog []string, field *schemapb.FieldSchema, idxInfo *etcdpb.IndexInfo, numRows int64) (retID typeutil.UniqueID, retErr error) {
 		defer func() {
 			if err := recover(); err != nil {
 				retErr = fmt.Errorf("build index panic, msg = %v", err)
@@ -725,6 +758,7 @@ func (c *Core) SetIndexCoord(s types.IndexCoord) error {
 			IndexParams: idxInfo.IndexParams,
 			IndexID:     idxInfo.IndexID,
 			IndexName:   idxInfo.IndexName,
+			NumRows:     numRows,
 		})
 		if err != nil {
 			return retID, err
@@ -772,6 +806,30 @@ func (c *Core) SetQueryCoord(s types.QueryCoord) error {
 			log.Debug("RootCoord connect to QueryCoord, retry")
 		}
 	}()
+	c.CallGetQueryClusterMetrics = func(ctx context.Context) (retTopology *metricsinfo.QueryCoordTopology, retErr error) {
+		defer func() {
+			if err := recover(); err != nil {
+				retErr = fmt.Errorf("get metrics from query service panic, msg = %v", err)
+			}
+		}()
+		<-initCh
+		req, err := metricsinfo.ConstructRequestByMetricType(metricsinfo.SystemInfoMetrics)
+		if err != nil {
+			return nil, err
+		}
+		rsp, err := s.GetMetrics(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		if rsp.Status.ErrorCode != commonpb.ErrorCode_Success {
+			return nil, fmt.Errorf("GetMetrics from query service failed, error = %s", rsp.Status.Reason)
+		}
+		topology := &metricsinfo.QueryCoordTopology{}
+		if err := metricsinfo.UnmarshalTopology(rsp.Response, topology); err != nil {
+			return nil, err
+		}
+		return topology, nil
+	}
 	c.CallReleaseCollectionService = func(ctx context.Context, ts typeutil.Timestamp, dbID typeutil.UniqueID, collectionID typeutil.UniqueID) (retErr error) {
 		defer func() {
 			if err := recover(); err != nil {
@@ -847,7 +905,7 @@ func (c *Core) BuildIndex(ctx context.Context, segID typeutil.UniqueID, field *s
 		if err != nil {
 			return 0, err
 		}
-		bldID, err = c.CallBuildIndexService(ctx, binlogs, field, idxInfo)
+		bldID, err = c.CallBuildIndexService(ctx, binlogs, field, idxInfo, rows)
 		if err != nil {
 			return 0, err
 		}
@@ -872,13 +930,13 @@ func (c *Core) Init() error {
 	var initError error = nil
 	if c.kvBaseCreate == nil {
 		c.kvBaseCreate = func(root string) (kv.TxnKV, error) {
-			return etcdkv.NewEtcdKV(Params.EtcdEndpoints, root)
+			return etcdkv.NewMetaKv(Params.EtcdEndpoints, root)
 		}
 	}
 	c.initOnce.Do(func() {
 		connectEtcdFn := func() error {
-			if c.etcdCli, initError = clientv3.New(clientv3.Config{Endpoints: Params.EtcdEndpoints, DialTimeout: 5 * time.Second}); initError != nil {
-				log.Error("RootCoord, Failed to new Etcd client", zap.Any("reason", initError))
+			if c.metaKV, initError = etcdkv.NewMetaKv(Params.EtcdEndpoints, Params.MetaRootPath); initError != nil {
+				log.Error("RootCoord, Failed to new meta kv", zap.Any("reason", initError))
 				return initError
 			}
 			if c.kvBase, initError = c.kvBaseCreate(Params.KvRootPath); initError != nil {
@@ -891,12 +949,16 @@ func (c *Core) Init() error {
 				log.Error("RootCoord, Failed to new EtcdKV", zap.Any("reason", initError))
 				return initError
 			}
+			if initError = migration.CoordinatorSchema(typeutil.RootCoordRole).Check(metaKV); initError != nil {
+				log.Error("RootCoord, Failed to check meta version", zap.Error(initError))
+				return initError
+			}
 			var ss *suffixSnapshot
 			if ss, initError = newSuffixSnapshot(metaKV, "_ts", Params.MetaRootPath, "snapshots"); initError != nil {
 				log.Error("RootCoord, Failed to new suffixSnapshot", zap.Error(initError))
 				return initError
 			}
-			if c.MetaTable, initError = NewMetaTable(ss); initError != nil {
+			if c.MetaTable, initError = NewMetaTable(metaKV, ss); initError != nil {
 				log.Error("RootCoord, Failed to new MetaTable", zap.Any("reason", initError))
 				return initError
 			}
@@ -941,9 +1003,10 @@ func (c *Core) Init() error {
 		}
 
 		m := map[string]interface{}{
-			"PulsarAddress":  Params.PulsarAddress,
-			"ReceiveBufSize": 1024,
-			"PulsarBufSize":  1024}
+			"PulsarAddress":    Params.PulsarAddress,
+			"PulsarWebAddress": Params.PulsarWebAddress,
+			"ReceiveBufSize":   1024,
+			"PulsarBufSize":    1024}
 		if initError = c.msFactory.SetParams(m); initError != nil {
 			return
 		}
@@ -958,6 +1021,7 @@ func (c *Core) Init() error {
 		c.chanTimeTick = newTimeTickSync(c)
 		c.chanTimeTick.AddProxy(c.session)
 		c.proxyClientManager = newProxyClientManager(c)
+		c.quotaCenter = newQuotaCenter(c)
 
 		log.Debug("RootCoord, set proxy manager")
 		c.proxyManager, initError = newProxyManager(
@@ -978,6 +1042,8 @@ func (c *Core) Init() error {
 		if initError != nil {
 			return
 		}
+
+		initError = c.initRbac()
 	})
 	if initError == nil {
 		log.Debug(typeutil.RootCoordRole, zap.String("State Code", internalpb.StateCode_name[int32(internalpb.StateCode_Initializing)]))
@@ -987,6 +1053,53 @@ func (c *Core) Init() error {
 	return initError
 }
 
+// initRbac creates the builtin roles and the root user bound to the admin role
+func (c *Core) initRbac() error {
+	roles, err := c.MetaTable.SelectRole("", false)
+	if err != nil {
+		return err
+	}
+	builtinRoles := map[string]bool{common.RoleAdmin: false, common.RolePublic: false}
+	for _, role := range roles {
+		builtinRoles[role.Role.GetName()] = true
+	}
+	for roleName, exist := range builtinRoles {
+		if exist {
+			continue
+		}
+		if err = c.MetaTable.CreateRole(roleName); err != nil {
+			log.Error("RootCoord, failed to create builtin role", zap.String("role", roleName), zap.Error(err))
+			return err
+		}
+	}
+	return c.initRootUser()
+}
+
+// initRootUser creates the builtin root user if no user exists yet, so that
+// there is always a credential to login when authorization is enabled
+func (c *Core) initRootUser() error {
+	usernames, err := c.MetaTable.ListCredentialUsernames()
+	if err != nil {
+		return err
+	}
+	if len(usernames) > 0 {
+		return nil
+	}
+	encryptedRootPassword, err := crypto.PasswordEncrypt(common.DefaultRootPassword)
+	if err != nil {
+		log.Error("RootCoord, failed to encrypt root password", zap.Error(err))
+		return err
+	}
+	log.Debug("RootCoord, create root user", zap.String("username", common.DefaultRootUsername))
+	if err = c.MetaTable.AddCredential(&internalpb.CredentialInfo{
+		Username:          common.DefaultRootUsername,
+		EncryptedPassword: encryptedRootPassword,
+	}); err != nil {
+		return err
+	}
+	return c.MetaTable.OperateUserRole(common.DefaultRootUsername, common.RoleAdmin, milvuspb.OperateUserRoleType_AddUserToRole)
+}
+
 func (c *Core) reSendDdMsg(ctx context.Context, force bool) error {
 	if !force {
 		flag, err := c.MetaTable.client.Load(DDMsgSendPrefix, 0)
@@ -1124,6 +1237,10 @@ func (c *Core) Start() error {
 		go c.sessionLoop()
 		go c.chanTimeTick.StartWatch(&c.wg)
 		go c.checkFlushedSegmentsLoop()
+		if Params.QuotaEnabled {
+			c.wg.Add(1)
+			go c.quotaCenterLoop()
+		}
 
 		Params.CreatedTime = time.Now()
 		Params.UpdatedTime = time.Now()
@@ -1685,6 +1802,42 @@ func (c *Core) DropIndex(ctx context.Context, in *milvuspb.DropIndexRequest) (*c
 	}, nil
 }
 
+// AlterIndex rebuild the index of the field with new params
+func (c *Core) AlterIndex(ctx context.Context, in *milvuspb.AlterIndexRequest) (*commonpb.Status, error) {
+	metrics.RootCoordAlterIndexCounter.WithLabelValues(metricProxy(in.Base.SourceID), MetricRequestsTotal).Inc()
+	code := c.stateCode.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+	log.Debug("AlterIndex", zap.String("collection name", in.CollectionName), zap.String("field name", in.FieldName), zap.Int64("msgID", in.Base.MsgID))
+	t := &AlterIndexReqTask{
+		baseReqTask: baseReqTask{
+			ctx:  ctx,
+			core: c,
+		},
+		Req: in,
+	}
+	err := executeTask(t)
+	if err != nil {
+		log.Debug("AlterIndex Failed", zap.String("collection name", in.CollectionName),
+			zap.String("field name", in.FieldName), zap.Int64("msgID", in.Base.MsgID),
+			zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    "AlterIndex failed, error = " + err.Error(),
+		}, nil
+	}
+	log.Debug("AlterIndex Success", zap.String("collection name", in.CollectionName), zap.String("field name", in.FieldName), zap.Int64("msgID", in.Base.MsgID))
+	metrics.RootCoordAlterIndexCounter.WithLabelValues(metricProxy(in.Base.SourceID), MetricRequestsSuccess).Inc()
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
 // DescribeSegment return segment info
 func (c *Core) DescribeSegment(ctx context.Context, in *milvuspb.DescribeSegmentRequest) (*milvuspb.DescribeSegmentResponse, error) {
 	metrics.RootCoordDescribeSegmentCounter.WithLabelValues(metricProxy(in.Base.SourceID), MetricRequestsTotal).Inc()
@@ -2135,3 +2288,494 @@ func (c *Core) AlterAlias(ctx context.Context, in *milvuspb.AlterAliasRequest) (
 		Reason:    "",
 	}, nil
 }
+
+// CreateCredential create new user and password
+func (c *Core) CreateCredential(ctx context.Context, credInfo *internalpb.CredentialInfo) (*commonpb.Status, error) {
+	code := c.stateCode.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+	log.Debug("CreateCredential", zap.String("username", credInfo.Username))
+	if err := c.MetaTable.AddCredential(credInfo); err != nil {
+		log.Error("CreateCredential failed", zap.String("username", credInfo.Username), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_CreateCredentialFailure,
+			Reason:    "CreateCredential failed: " + err.Error(),
+		}, nil
+	}
+	log.Debug("CreateCredential Success", zap.String("username", credInfo.Username))
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
+// GetCredential get credential by username
+func (c *Core) GetCredential(ctx context.Context, in *rootcoordpb.GetCredentialRequest) (*rootcoordpb.GetCredentialResponse, error) {
+	code := c.stateCode.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &rootcoordpb.GetCredentialResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+			},
+		}, nil
+	}
+	log.Debug("GetCredential", zap.String("username", in.Username))
+	credInfo, err := c.MetaTable.GetCredential(in.Username)
+	if err != nil {
+		log.Error("GetCredential failed", zap.String("username", in.Username), zap.Error(err))
+		return &rootcoordpb.GetCredentialResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_GetCredentialFailure,
+				Reason:    "GetCredential failed: " + err.Error(),
+			},
+		}, nil
+	}
+	return &rootcoordpb.GetCredentialResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+			Reason:    "",
+		},
+		Username: credInfo.Username,
+		Password: credInfo.EncryptedPassword,
+	}, nil
+}
+
+// UpdateCredential update password for a user
+func (c *Core) UpdateCredential(ctx context.Context, credInfo *internalpb.CredentialInfo) (*commonpb.Status, error) {
+	code := c.stateCode.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+	log.Debug("UpdateCredential", zap.String("username", credInfo.Username))
+	if err := c.MetaTable.UpdateCredential(credInfo); err != nil {
+		log.Error("UpdateCredential failed", zap.String("username", credInfo.Username), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UpdateCredentialFailure,
+			Reason:    "UpdateCredential failed: " + err.Error(),
+		}, nil
+	}
+	// the cached credential of proxies is stale now
+	if err := c.proxyClientManager.InvalidateCredentialCache(ctx, &proxypb.InvalidateCredCacheRequest{
+		Base:     &commonpb.MsgBase{MsgType: commonpb.MsgType_UpdateCredential},
+		Username: credInfo.Username,
+	}); err != nil {
+		log.Error("UpdateCredential invalidate proxy cache failed", zap.String("username", credInfo.Username), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UpdateCredentialFailure,
+			Reason:    "UpdateCredential failed: " + err.Error(),
+		}, nil
+	}
+	log.Debug("UpdateCredential Success", zap.String("username", credInfo.Username))
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
+// DeleteCredential delete a user
+func (c *Core) DeleteCredential(ctx context.Context, in *milvuspb.DeleteCredentialRequest) (*commonpb.Status, error) {
+	code := c.stateCode.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+	log.Debug("DeleteCredential", zap.String("username", in.Username))
+	if err := c.MetaTable.DeleteCredential(in.Username); err != nil {
+		log.Error("DeleteCredential failed", zap.String("username", in.Username), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_DeleteCredentialFailure,
+			Reason:    "DeleteCredential failed: " + err.Error(),
+		}, nil
+	}
+	if err := c.proxyClientManager.InvalidateCredentialCache(ctx, &proxypb.InvalidateCredCacheRequest{
+		Base:     &commonpb.MsgBase{MsgType: commonpb.MsgType_DeleteCredential},
+		Username: in.Username,
+	}); err != nil {
+		log.Error("DeleteCredential invalidate proxy cache failed", zap.String("username", in.Username), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_DeleteCredentialFailure,
+			Reason:    "DeleteCredential failed: " + err.Error(),
+		}, nil
+	}
+	// the user-role bindings are removed together with the user
+	if err := c.invalidatePolicyInfoCache(ctx, commonpb.MsgType_DeleteCredential); err != nil {
+		log.Error("DeleteCredential invalidate proxy cache failed", zap.String("username", in.Username), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_DeleteCredentialFailure,
+			Reason:    "DeleteCredential failed: " + err.Error(),
+		}, nil
+	}
+	log.Debug("DeleteCredential Success", zap.String("username", in.Username))
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
+// ListCredUsers list all usernames
+func (c *Core) ListCredUsers(ctx context.Context, in *milvuspb.ListCredUsersRequest) (*milvuspb.ListCredUsersResponse, error) {
+	code := c.stateCode.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &milvuspb.ListCredUsersResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+			},
+		}, nil
+	}
+	usernames, err := c.MetaTable.ListCredentialUsernames()
+	if err != nil {
+		log.Error("ListCredUsers failed", zap.Error(err))
+		return &milvuspb.ListCredUsersResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_ListCredUsersFailure,
+				Reason:    "ListCredUsers failed: " + err.Error(),
+			},
+		}, nil
+	}
+	return &milvuspb.ListCredUsersResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+			Reason:    "",
+		},
+		Usernames: usernames,
+	}, nil
+}
+
+// invalidatePolicyInfoCache notifies all proxies that the grants or user-role bindings have changed
+func (c *Core) invalidatePolicyInfoCache(ctx context.Context, msgType commonpb.MsgType) error {
+	return c.proxyClientManager.InvalidatePolicyInfoCache(ctx, &proxypb.InvalidatePolicyInfoCacheRequest{
+		Base: &commonpb.MsgBase{MsgType: msgType},
+	})
+}
+
+// CreateRole create a new role
+func (c *Core) CreateRole(ctx context.Context, in *milvuspb.CreateRoleRequest) (*commonpb.Status, error) {
+	code := c.stateCode.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+	roleName := in.Entity.GetName()
+	log.Debug("CreateRole", zap.String("role", roleName))
+	if err := c.MetaTable.CreateRole(roleName); err != nil {
+		log.Error("CreateRole failed", zap.String("role", roleName), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_CreateRoleFailure,
+			Reason:    "CreateRole failed: " + err.Error(),
+		}, nil
+	}
+	log.Debug("CreateRole Success", zap.String("role", roleName))
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
+// DropRole drop a role, together with its user bindings and grants
+func (c *Core) DropRole(ctx context.Context, in *milvuspb.DropRoleRequest) (*commonpb.Status, error) {
+	code := c.stateCode.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+	log.Debug("DropRole", zap.String("role", in.RoleName))
+	if in.RoleName == common.RoleAdmin || in.RoleName == common.RolePublic {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_DropRoleFailure,
+			Reason:    fmt.Sprintf("DropRole failed: builtin role %s can not be dropped", in.RoleName),
+		}, nil
+	}
+	if err := c.MetaTable.DropRole(in.RoleName); err != nil {
+		log.Error("DropRole failed", zap.String("role", in.RoleName), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_DropRoleFailure,
+			Reason:    "DropRole failed: " + err.Error(),
+		}, nil
+	}
+	if err := c.invalidatePolicyInfoCache(ctx, commonpb.MsgType_DropRole); err != nil {
+		log.Error("DropRole invalidate proxy cache failed", zap.String("role", in.RoleName), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_DropRoleFailure,
+			Reason:    "DropRole failed: " + err.Error(),
+		}, nil
+	}
+	log.Debug("DropRole Success", zap.String("role", in.RoleName))
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
+// OperateUserRole bind a user to a role, or unbind it
+func (c *Core) OperateUserRole(ctx context.Context, in *milvuspb.OperateUserRoleRequest) (*commonpb.Status, error) {
+	code := c.stateCode.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+	log.Debug("OperateUserRole", zap.String("username", in.Username), zap.String("role", in.RoleName),
+		zap.String("type", in.Type.String()))
+	if err := c.MetaTable.OperateUserRole(in.Username, in.RoleName, in.Type); err != nil {
+		log.Error("OperateUserRole failed", zap.String("username", in.Username), zap.String("role", in.RoleName), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_OperateUserRoleFailure,
+			Reason:    "OperateUserRole failed: " + err.Error(),
+		}, nil
+	}
+	if err := c.invalidatePolicyInfoCache(ctx, commonpb.MsgType_OperateUserRole); err != nil {
+		log.Error("OperateUserRole invalidate proxy cache failed", zap.String("username", in.Username), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_OperateUserRoleFailure,
+			Reason:    "OperateUserRole failed: " + err.Error(),
+		}, nil
+	}
+	log.Debug("OperateUserRole Success", zap.String("username", in.Username), zap.String("role", in.RoleName))
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
+// SelectRole select a role, or all roles, with the users bound to them
+func (c *Core) SelectRole(ctx context.Context, in *milvuspb.SelectRoleRequest) (*milvuspb.SelectRoleResponse, error) {
+	code := c.stateCode.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &milvuspb.SelectRoleResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+			},
+		}, nil
+	}
+	results, err := c.MetaTable.SelectRole(in.Role.GetName(), in.IncludeUserInfo)
+	if err != nil {
+		log.Error("SelectRole failed", zap.String("role", in.Role.GetName()), zap.Error(err))
+		return &milvuspb.SelectRoleResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_SelectRoleFailure,
+				Reason:    "SelectRole failed: " + err.Error(),
+			},
+		}, nil
+	}
+	return &milvuspb.SelectRoleResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+			Reason:    "",
+		},
+		Results: results,
+	}, nil
+}
+
+// SelectUser select a user, or all users, with the roles bound to them
+func (c *Core) SelectUser(ctx context.Context, in *milvuspb.SelectUserRequest) (*milvuspb.SelectUserResponse, error) {
+	code := c.stateCode.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &milvuspb.SelectUserResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+			},
+		}, nil
+	}
+	results, err := c.MetaTable.SelectUser(in.User.GetName(), in.IncludeRoleInfo)
+	if err != nil {
+		log.Error("SelectUser failed", zap.String("username", in.User.GetName()), zap.Error(err))
+		return &milvuspb.SelectUserResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_SelectUserFailure,
+				Reason:    "SelectUser failed: " + err.Error(),
+			},
+		}, nil
+	}
+	return &milvuspb.SelectUserResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+			Reason:    "",
+		},
+		Results: results,
+	}, nil
+}
+
+// OperatePrivilege grant a privilege to a role, or revoke it
+func (c *Core) OperatePrivilege(ctx context.Context, in *milvuspb.OperatePrivilegeRequest) (*commonpb.Status, error) {
+	code := c.stateCode.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+	log.Debug("OperatePrivilege", zap.String("grant", in.Entity.String()), zap.String("type", in.Type.String()))
+	if err := c.MetaTable.OperatePrivilege(in.Entity, in.Type); err != nil {
+		log.Error("OperatePrivilege failed", zap.String("grant", in.Entity.String()), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_OperatePrivilegeFailure,
+			Reason:    "OperatePrivilege failed: " + err.Error(),
+		}, nil
+	}
+	if err := c.invalidatePolicyInfoCache(ctx, commonpb.MsgType_OperatePrivilege); err != nil {
+		log.Error("OperatePrivilege invalidate proxy cache failed", zap.String("grant", in.Entity.String()), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_OperatePrivilegeFailure,
+			Reason:    "OperatePrivilege failed: " + err.Error(),
+		}, nil
+	}
+	log.Debug("OperatePrivilege Success", zap.String("grant", in.Entity.String()))
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
+// SelectGrant select the grants of a role
+func (c *Core) SelectGrant(ctx context.Context, in *milvuspb.SelectGrantRequest) (*milvuspb.SelectGrantResponse, error) {
+	code := c.stateCode.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &milvuspb.SelectGrantResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+			},
+		}, nil
+	}
+	if in.Entity == nil {
+		return &milvuspb.SelectGrantResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_SelectGrantFailure,
+				Reason:    "SelectGrant failed: the grant entity is empty",
+			},
+		}, nil
+	}
+	entities, err := c.MetaTable.SelectGrant(in.Entity)
+	if err != nil {
+		log.Error("SelectGrant failed", zap.String("grant", in.Entity.String()), zap.Error(err))
+		return &milvuspb.SelectGrantResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_SelectGrantFailure,
+				Reason:    "SelectGrant failed: " + err.Error(),
+			},
+		}, nil
+	}
+	return &milvuspb.SelectGrantResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+			Reason:    "",
+		},
+		Entities: entities,
+	}, nil
+}
+
+// ListPolicy list all the grants and user-role bindings
+func (c *Core) ListPolicy(ctx context.Context, in *rootcoordpb.ListPolicyRequest) (*rootcoordpb.ListPolicyResponse, error) {
+	code := c.stateCode.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &rootcoordpb.ListPolicyResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+			},
+		}, nil
+	}
+	grants, userRoles, err := c.MetaTable.ListPolicy()
+	if err != nil {
+		log.Error("ListPolicy failed", zap.Error(err))
+		return &rootcoordpb.ListPolicyResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    "ListPolicy failed: " + err.Error(),
+			},
+		}, nil
+	}
+	return &rootcoordpb.ListPolicyResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+			Reason:    "",
+		},
+		Grants:    grants,
+		UserRoles: userRoles,
+	}, nil
+}
+
+// ListMsgTopics list the topics and subscriptions created by milvus in the message queue
+func (c *Core) ListMsgTopics(ctx context.Context, in *rootcoordpb.ListMsgTopicsRequest) (*rootcoordpb.ListMsgTopicsResponse, error) {
+	code := c.stateCode.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &rootcoordpb.ListMsgTopicsResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+			},
+		}, nil
+	}
+	topics, err := c.listMsgTopics(ctx)
+	if err != nil {
+		log.Error("ListMsgTopics failed", zap.Error(err))
+		return &rootcoordpb.ListMsgTopicsResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    "ListMsgTopics failed: " + err.Error(),
+			},
+		}, nil
+	}
+	return &rootcoordpb.ListMsgTopicsResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+			Reason:    "",
+		},
+		Topics: topics,
+	}, nil
+}
+
+// PurgeMsgTopics delete the topics and subscriptions no longer referenced by dml channels or query channels,
+// and the stale subscriptions of the referenced topics
+func (c *Core) PurgeMsgTopics(ctx context.Context, in *rootcoordpb.PurgeMsgTopicsRequest) (*rootcoordpb.PurgeMsgTopicsResponse, error) {
+	code := c.stateCode.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &rootcoordpb.PurgeMsgTopicsResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+			},
+		}, nil
+	}
+	log.Debug("PurgeMsgTopics", zap.Bool("dryRun", in.DryRun))
+	purgedTopics, purgedSubs, err := c.purgeMsgTopics(ctx, in.DryRun)
+	if err != nil {
+		log.Error("PurgeMsgTopics failed", zap.Strings("purged topics", purgedTopics), zap.Error(err))
+		return &rootcoordpb.PurgeMsgTopicsResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    "PurgeMsgTopics failed: " + err.Error(),
+			},
+			PurgedTopics:        purgedTopics,
+			PurgedSubscriptions: purgedSubs,
+		}, nil
+	}
+	log.Debug("PurgeMsgTopics success", zap.Bool("dryRun", in.DryRun), zap.Strings("purged topics", purgedTopics),
+		zap.Int("purged subscription topics", len(purgedSubs)))
+	return &rootcoordpb.PurgeMsgTopicsResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+			Reason:    "",
+		},
+		PurgedTopics:        purgedTopics,
+		PurgedSubscriptions: purgedSubs,
+	}, nil
+}
@@ -74,6 +74,27 @@ func (p *proxyMock) GetCollArray() []string {
 	return ret
 }
 
+func (p *proxyMock) InvalidateCredentialCache(ctx context.Context, request *proxypb.InvalidateCredCacheRequest) (*commonpb.Status, error) {
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
+func (p *proxyMock) InvalidatePolicyInfoCache(ctx context.Context, request *proxypb.InvalidatePolicyInfoCacheRequest) (*commonpb.Status, error) {
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
+func (p *proxyMock) SetQuotaFactors(ctx context.Context, request *proxypb.SetQuotaFactorsRequest) (*commonpb.Status, error) {
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
 func (p *proxyMock) ReleaseDQLMessageStream(ctx context.Context, request *proxypb.ReleaseDQLMessageStreamRequest) (*commonpb.Status, error) {
 	return &commonpb.Status{
 		ErrorCode: commonpb.ErrorCode_Success,
@@ -1156,6 +1177,40 @@ func TestRootCoord(t *testing.T) {
 
 	})
 
+	t.Run("alter index", func(t *testing.T) {
+		req := &milvuspb.AlterIndexRequest{
+			Base: &commonpb.MsgBase{
+				MsgType:   commonpb.MsgType_AlterIndex,
+				MsgID:     212,
+				Timestamp: 212,
+				SourceID:  212,
+			},
+			DbName:         "",
+			CollectionName: collName,
+			FieldName:      "vector",
+			ExtraParams: []*commonpb.KeyValuePair{
+				{
+					Key:   "ik3",
+					Value: "iv3",
+				},
+			},
+		}
+		// index params not changed
+		rsp, err := core.AlterIndex(ctx, req)
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, rsp.ErrorCode)
+
+		// the index replaced by the previous CreateIndex is not dropped yet
+		req.ExtraParams[0].Value = "iv4"
+		rsp, err = core.AlterIndex(ctx, req)
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, rsp.ErrorCode)
+
+		collMeta, err := core.MetaTable.GetCollectionByName(collName, 0)
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(collMeta.FieldIndexes))
+	})
+
 	t.Run("drop index", func(t *testing.T) {
 		req := &milvuspb.DropIndexRequest{
 			Base: &commonpb.MsgBase{
@@ -1716,9 +1771,9 @@ func TestRootCoord(t *testing.T) {
 
 		proxy1 := path.Join(sessKey, typeutil.ProxyRole) + "-1"
 		proxy2 := path.Join(sessKey, typeutil.ProxyRole) + "-2"
-		_, err = core.etcdCli.Put(ctx2, proxy1, string(s1))
+		_, err = etcdCli.Put(ctx2, proxy1, string(s1))
 		assert.Nil(t, err)
-		_, err = core.etcdCli.Put(ctx2, proxy2, string(s2))
+		_, err = etcdCli.Put(ctx2, proxy2, string(s2))
 		assert.Nil(t, err)
 		time.Sleep(100 * time.Millisecond)
 
@@ -1770,9 +1825,9 @@ func TestRootCoord(t *testing.T) {
 		// add 3 proxy channels
 		assert.Equal(t, 3, core.chanTimeTick.GetChanNum()-numChan)
 
-		_, err = core.etcdCli.Delete(ctx2, proxy1)
+		_, err = etcdCli.Delete(ctx2, proxy1)
 		assert.Nil(t, err)
-		_, err = core.etcdCli.Delete(ctx2, proxy2)
+		_, err = etcdCli.Delete(ctx2, proxy2)
 		assert.Nil(t, err)
 	})
 
@@ -1881,6 +1936,108 @@ func TestRootCoord(t *testing.T) {
 		assert.Equal(t, commonpb.ErrorCode_Success, rsp.ErrorCode)
 	})
 
+	t.Run("credential", func(t *testing.T) {
+		// root user is created on init
+		listRsp, err := core.ListCredUsers(ctx, &milvuspb.ListCredUsersRequest{})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, listRsp.Status.ErrorCode)
+		assert.Contains(t, listRsp.Usernames, common.DefaultRootUsername)
+
+		credInfo := &internalpb.CredentialInfo{
+			Username:          "user1",
+			EncryptedPassword: "encrypted1",
+		}
+		status, err := core.CreateCredential(ctx, credInfo)
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+
+		status, err = core.CreateCredential(ctx, credInfo)
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_CreateCredentialFailure, status.ErrorCode)
+
+		getRsp, err := core.GetCredential(ctx, &rootcoordpb.GetCredentialRequest{Username: "user1"})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, getRsp.Status.ErrorCode)
+		assert.Equal(t, "encrypted1", getRsp.Password)
+
+		credInfo.EncryptedPassword = "encrypted2"
+		status, err = core.UpdateCredential(ctx, credInfo)
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+		getRsp, err = core.GetCredential(ctx, &rootcoordpb.GetCredentialRequest{Username: "user1"})
+		assert.Nil(t, err)
+		assert.Equal(t, "encrypted2", getRsp.Password)
+
+		status, err = core.DeleteCredential(ctx, &milvuspb.DeleteCredentialRequest{Username: "user1"})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+		getRsp, err = core.GetCredential(ctx, &rootcoordpb.GetCredentialRequest{Username: "user1"})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_GetCredentialFailure, getRsp.Status.ErrorCode)
+	})
+
+	t.Run("rbac", func(t *testing.T) {
+		// builtin roles are created on init, and root is bound to admin
+		userRsp, err := core.SelectUser(ctx, &milvuspb.SelectUserRequest{
+			User:            &milvuspb.UserEntity{Name: common.DefaultRootUsername},
+			IncludeRoleInfo: true,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, userRsp.Status.ErrorCode)
+		assert.Equal(t, 1, len(userRsp.Results))
+		assert.Equal(t, common.RoleAdmin, userRsp.Results[0].Roles[0].Name)
+
+		status, err := core.DropRole(ctx, &milvuspb.DropRoleRequest{RoleName: common.RoleAdmin})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_DropRoleFailure, status.ErrorCode)
+
+		status, err = core.CreateRole(ctx, &milvuspb.CreateRoleRequest{Entity: &milvuspb.RoleEntity{Name: "analyst"}})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+		status, err = core.CreateRole(ctx, &milvuspb.CreateRoleRequest{Entity: &milvuspb.RoleEntity{Name: "analyst"}})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_CreateRoleFailure, status.ErrorCode)
+
+		status, err = core.OperateUserRole(ctx, &milvuspb.OperateUserRoleRequest{
+			Username: common.DefaultRootUsername,
+			RoleName: "analyst",
+			Type:     milvuspb.OperateUserRoleType_AddUserToRole,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+
+		grant := &milvuspb.GrantEntity{
+			Role:       &milvuspb.RoleEntity{Name: "analyst"},
+			Object:     &milvuspb.ObjectEntity{Name: commonpb.ObjectType_Collection.String()},
+			ObjectName: common.AnyWord,
+			Privilege:  &milvuspb.PrivilegeEntity{Name: commonpb.ObjectPrivilege_PrivilegeSearch.String()},
+		}
+		status, err = core.OperatePrivilege(ctx, &milvuspb.OperatePrivilegeRequest{
+			Entity: grant,
+			Type:   milvuspb.OperatePrivilegeType_Grant,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+
+		grantRsp, err := core.SelectGrant(ctx, &milvuspb.SelectGrantRequest{Entity: &milvuspb.GrantEntity{Role: grant.Role}})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, grantRsp.Status.ErrorCode)
+		assert.Equal(t, 1, len(grantRsp.Entities))
+
+		policyRsp, err := core.ListPolicy(ctx, &rootcoordpb.ListPolicyRequest{})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, policyRsp.Status.ErrorCode)
+		assert.Equal(t, 1, len(policyRsp.Grants))
+
+		status, err = core.DropRole(ctx, &milvuspb.DropRoleRequest{RoleName: "analyst"})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+		roleRsp, err := core.SelectRole(ctx, &milvuspb.SelectRoleRequest{})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, roleRsp.Status.ErrorCode)
+		assert.Equal(t, 2, len(roleRsp.Results))
+	})
+
 	status, err = core.DropCollection(ctx, &milvuspb.DropCollectionRequest{
 		Base: &commonpb.MsgBase{
 			MsgType:   commonpb.MsgType_DropCollection,
@@ -2333,7 +2490,7 @@ func TestCheckInit(t *testing.T) {
 	err = c.checkInit()
 	assert.NotNil(t, err)
 
-	c.etcdCli = &clientv3.Client{}
+	c.metaKV = &etcdkv.EtcdKV{}
 	err = c.checkInit()
 	assert.NotNil(t, err)
 
@@ -2383,7 +2540,7 @@ func TestCheckInit(t *testing.T) {
 	err = c.checkInit()
 	assert.NotNil(t, err)
 
-	c.CallBuildIndexService = func(ctx context.Context, binlog []string, field *schemapb.FieldSchema, idxInfo *etcdpb.IndexInfo) (typeutil.UniqueID, error) {
+	c.CallBuildIndexService = func(ctx context.Context, binlog []string, field *schemapb.FieldSchema, idxInfo *etcdpb.IndexInfo, numRows int64) (typeutil.UniqueID, error) {
 		return 0, nil
 	}
 	err = c.checkInit()
@@ -2553,7 +2710,7 @@ func TestCheckFlushedSegments(t *testing.T) {
 		core.MetaTable.indexID2Meta[indexID] = etcdpb.IndexInfo{
 			IndexID: indexID,
 		}
-		core.CallBuildIndexService = func(_ context.Context, binlog []string, field *schemapb.FieldSchema, idx *etcdpb.IndexInfo) (int64, error) {
+		core.CallBuildIndexService = func(_ context.Context, binlog []string, field *schemapb.FieldSchema, idx *etcdpb.IndexInfo, numRows int64) (int64, error) {
 			assert.Equal(t, fieldID, field.FieldID)
 			assert.Equal(t, indexID, idx.IndexID)
 			return -1, errors.New("build index build")
@@ -2562,7 +2719,7 @@ func TestCheckFlushedSegments(t *testing.T) {
 		core.checkFlushedSegments(ctx)
 
 		var indexBuildID int64 = 10001
-		core.CallBuildIndexService = func(_ context.Context, binlog []string, field *schemapb.FieldSchema, idx *etcdpb.IndexInfo) (int64, error) {
+		core.CallBuildIndexService = func(_ context.Context, binlog []string, field *schemapb.FieldSchema, idx *etcdpb.IndexInfo, numRows int64) (int64, error) {
 			return indexBuildID, nil
 		}
 		core.checkFlushedSegments(core.ctx)
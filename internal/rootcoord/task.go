@@ -24,6 +24,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
 	"github.com/milvus-io/milvus/internal/proto/proxypb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/indexparamcheck"
 	"github.com/milvus-io/milvus/internal/util/tsoutil"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 	"go.uber.org/zap"
@@ -753,8 +754,11 @@ func (t *DescribeSegmentReqTask) Execute(ctx context.Context) error {
 	if !exist {
 		return fmt.Errorf("segment id %d not belong to collection id %d", t.Req.SegmentID, t.Req.CollectionID)
 	}
-	//TODO, get filed_id and index_name from request
-	segIdxInfo, err := t.core.MetaTable.GetSegmentIndexInfoByID(t.Req.SegmentID, -1, "")
+	fieldID := t.Req.FieldID
+	if fieldID == 0 {
+		fieldID = -1
+	}
+	segIdxInfo, err := t.core.MetaTable.GetSegmentIndexInfoByID(t.Req.SegmentID, fieldID, t.Req.IndexName)
 	log.Debug("RootCoord DescribeSegmentReqTask, MetaTable.GetSegmentIndexInfoByID", zap.Any("SegmentID", t.Req.SegmentID),
 		zap.Any("segIdxInfo", segIdxInfo), zap.Error(err))
 	if err != nil {
@@ -823,7 +827,17 @@ func (t *CreateIndexReqTask) Execute(ctx context.Context) error {
 	if t.Type() != commonpb.MsgType_CreateIndex {
 		return fmt.Errorf("create index, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
 	}
-	indexName := Params.DefaultIndexName //TODO, get name from request
+	field, err := t.core.MetaTable.GetFieldSchema(t.Req.CollectionName, t.Req.FieldName)
+	if err != nil {
+		return err
+	}
+	indexName, err := getIndexName(&field, t.Req.ExtraParams, t.Req.IndexName)
+	if err != nil {
+		return err
+	}
+	if err = checkIndexNameOfField(t.core.MetaTable, t.Req.CollectionName, indexName, &field); err != nil {
+		return err
+	}
 	indexID, _, err := t.core.IDAllocator(1)
 	log.Debug("RootCoord CreateIndexReqTask", zap.Any("indexID", indexID), zap.Error(err))
 	if err != nil {
@@ -834,11 +848,59 @@ func (t *CreateIndexReqTask) Execute(ctx context.Context) error {
 		IndexID:     indexID,
 		IndexParams: t.Req.ExtraParams,
 	}
-	collMeta, err := t.core.MetaTable.GetCollectionByName(t.Req.CollectionName, 0)
+	return buildFlushedSegmentsIndex(ctx, t.core, t.Req.CollectionName, t.Req.FieldName, idxInfo, t.Req.Base.GetTimestamp())
+}
+
+// getIndexName return the name of the index built on the field, checking the index type fits the data type of the field.
+// The requested name is used if given, so a vector field can have several indexes.
+func getIndexName(field *schemapb.FieldSchema, indexParams []*commonpb.KeyValuePair, requestedName string) (string, error) {
+	indexType := indexparamcheck.GetIndexType(indexParams)
+	if typeutil.IsVectorType(field.DataType) {
+		if indexparamcheck.IsScalarIndexType(indexType) {
+			return "", fmt.Errorf("field name = %s, data type = %s, can't build scalar index %s on vector field",
+				field.Name, schemapb.DataType_name[int32(field.DataType)], indexType)
+		}
+		if requestedName != "" {
+			return requestedName, nil
+		}
+		return Params.DefaultIndexName, nil
+	}
+	if !indexparamcheck.IsScalarIndexType(indexType) {
+		return "", fmt.Errorf("field name = %s, data type = %s, index type = %s", field.Name, schemapb.DataType_name[int32(field.DataType)], indexType)
+	}
+	if requestedName != "" {
+		return requestedName, nil
+	}
+	// scalar indexes are named after the field, so they don't replace the default index of the vector field
+	return Params.DefaultIndexName + "_" + field.Name, nil
+}
+
+// checkIndexNameOfField checks the index named indexName, if exists, is built on the field, since an index with the
+// same name replaces the existing one
+func checkIndexNameOfField(mt *MetaTable, collName, indexName string, field *schemapb.FieldSchema) error {
+	coll, idx, err := mt.GetIndexByName(collName, indexName)
+	if err != nil {
+		return err
+	}
+	for _, i := range idx {
+		f, err := GetFieldSchemaByIndexID(&coll, i.IndexID)
+		if err != nil {
+			return err
+		}
+		if f.FieldID != field.FieldID {
+			return fmt.Errorf("index name %s is already used by field %s", indexName, f.Name)
+		}
+	}
+	return nil
+}
+
+// buildFlushedSegmentsIndex add the index into meta table and build it on the flushed segments of the collection
+func buildFlushedSegmentsIndex(ctx context.Context, core *Core, collName, fieldName string, idxInfo *etcdpb.IndexInfo, ts typeutil.Timestamp) error {
+	collMeta, err := core.MetaTable.GetCollectionByName(collName, 0)
 	if err != nil {
 		return err
 	}
-	segID2PartID, err := t.core.getSegments(ctx, collMeta.ID)
+	segID2PartID, err := core.getSegments(ctx, collMeta.ID)
 	flushedSegs := make([]typeutil.UniqueID, 0, len(segID2PartID))
 	for k := range segID2PartID {
 		flushedSegs = append(flushedSegs, k)
@@ -848,14 +910,11 @@ func (t *CreateIndexReqTask) Execute(ctx context.Context) error {
 		return err
 	}
 
-	segIDs, field, err := t.core.MetaTable.GetNotIndexedSegments(t.Req.CollectionName, t.Req.FieldName, idxInfo, flushedSegs, t.Req.Base.GetTimestamp())
+	segIDs, field, err := core.MetaTable.GetNotIndexedSegments(collName, fieldName, idxInfo, flushedSegs, ts)
 	if err != nil {
-		log.Debug("RootCoord CreateIndexReqTask metaTable.GetNotIndexedSegments", zap.Error(err))
+		log.Debug("RootCoord metaTable.GetNotIndexedSegments", zap.Error(err))
 		return err
 	}
-	if field.DataType != schemapb.DataType_FloatVector && field.DataType != schemapb.DataType_BinaryVector {
-		return fmt.Errorf("field name = %s, data type = %s", t.Req.FieldName, schemapb.DataType_name[int32(field.DataType)])
-	}
 
 	for _, segID := range segIDs {
 		info := etcdpb.SegmentIndexInfo{
@@ -866,22 +925,98 @@ func (t *CreateIndexReqTask) Execute(ctx context.Context) error {
 			IndexID:      idxInfo.IndexID,
 			EnableIndex:  false,
 		}
-		info.BuildID, err = t.core.BuildIndex(ctx, segID, &field, idxInfo, false)
+		info.BuildID, err = core.BuildIndex(ctx, segID, &field, idxInfo, false)
 		if err != nil {
 			return err
 		}
 		if info.BuildID != 0 {
 			info.EnableIndex = true
 		}
-		ts, _ := t.core.TSOAllocator(1)
-		if err := t.core.MetaTable.AddIndex(&info, ts); err != nil {
+		ts, _ := core.TSOAllocator(1)
+		if err := core.MetaTable.AddIndex(&info, ts); err != nil {
 			log.Debug("Add index into meta table failed", zap.Int64("collection_id", collMeta.ID), zap.Int64("index_id", info.IndexID), zap.Int64("build_id", info.BuildID), zap.Error(err))
 		}
 	}
 
+	// the proxies cache the index params for search
+	req := proxypb.InvalidateCollMetaCacheRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:   0, //TODO, msg type
+			MsgID:     0, //TODO, msg id
+			Timestamp: ts,
+			SourceID:  core.session.ServerID,
+		},
+		CollectionName: collName,
+	}
+	// error doesn't matter here
+	core.proxyClientManager.InvalidateCollectionMetaCache(ctx, &req)
 	return nil
 }
 
+// AlterIndexReqTask alter index request task
+type AlterIndexReqTask struct {
+	baseReqTask
+	Req *milvuspb.AlterIndexRequest
+}
+
+// Type return msg type
+func (t *AlterIndexReqTask) Type() commonpb.MsgType {
+	return t.Req.Base.MsgType
+}
+
+// Execute task execution, the index is rebuilt with new params under the same name while the replaced index is
+// renamed with suffix "_bak" and keeps serving, query coord drops it once query nodes switch to the rebuilt index
+func (t *AlterIndexReqTask) Execute(ctx context.Context) error {
+	if t.Type() != commonpb.MsgType_AlterIndex {
+		return fmt.Errorf("alter index, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
+	}
+	field, err := t.core.MetaTable.GetFieldSchema(t.Req.CollectionName, t.Req.FieldName)
+	if err != nil {
+		return err
+	}
+	// query nodes only switch the loaded vector indexes
+	if !typeutil.IsVectorType(field.DataType) {
+		return fmt.Errorf("field name = %s, data type = %s, only the index of vector field can be altered",
+			t.Req.FieldName, schemapb.DataType_name[int32(field.DataType)])
+	}
+	indexName, err := getIndexName(&field, t.Req.ExtraParams, t.Req.IndexName)
+	if err != nil {
+		return err
+	}
+	if err = checkIndexNameOfField(t.core.MetaTable, t.Req.CollectionName, indexName, &field); err != nil {
+		return err
+	}
+	_, idx, err := t.core.MetaTable.GetIndexByName(t.Req.CollectionName, indexName)
+	if err != nil {
+		return err
+	}
+	if len(idx) != 1 {
+		return fmt.Errorf("field name = %s has no index %s to alter, len(index) = %d", t.Req.FieldName, indexName, len(idx))
+	}
+	if idx[0].ReplacedIndexID != 0 {
+		return fmt.Errorf("index %s of field name = %s is being rebuilt, replaced index id = %d", indexName, t.Req.FieldName, idx[0].ReplacedIndexID)
+	}
+	if EqualKeyPairArray(idx[0].IndexParams, t.Req.ExtraParams) {
+		log.Debug("RootCoord AlterIndexReqTask, index params not changed", zap.String("index name", indexName), zap.Int64("indexID", idx[0].IndexID))
+		return nil
+	}
+	if _, bak, err := t.core.MetaTable.GetIndexByName(t.Req.CollectionName, indexName+"_bak"); err != nil || len(bak) != 0 {
+		return fmt.Errorf("index %s_bak of field name = %s is not dropped", indexName, t.Req.FieldName)
+	}
+	indexID, _, err := t.core.IDAllocator(1)
+	log.Debug("RootCoord AlterIndexReqTask", zap.Any("indexID", indexID), zap.Int64("replaced indexID", idx[0].IndexID), zap.Error(err))
+	if err != nil {
+		return err
+	}
+	idxInfo := &etcdpb.IndexInfo{
+		IndexName:       indexName,
+		IndexID:         indexID,
+		IndexParams:     t.Req.ExtraParams,
+		ReplacedIndexID: idx[0].IndexID,
+	}
+	return buildFlushedSegmentsIndex(ctx, t.core, t.Req.CollectionName, t.Req.FieldName, idxInfo, t.Req.Base.GetTimestamp())
+}
+
 // DescribeIndexReqTask describe index request task
 type DescribeIndexReqTask struct {
 	baseReqTask
@@ -910,10 +1045,11 @@ func (t *DescribeIndexReqTask) Execute(ctx context.Context) error {
 			continue
 		}
 		desc := &milvuspb.IndexDescription{
-			IndexName: i.IndexName,
-			Params:    i.IndexParams,
-			IndexID:   i.IndexID,
-			FieldName: f.Name,
+			IndexName:       i.IndexName,
+			Params:          i.IndexParams,
+			IndexID:         i.IndexID,
+			FieldName:       f.Name,
+			ReplacedIndexID: i.ReplacedIndexID,
 		}
 		t.Rsp.IndexDescriptions = append(t.Rsp.IndexDescriptions, desc)
 	}
@@ -953,7 +1089,37 @@ func (t *DropIndexReqTask) Execute(ctx context.Context) error {
 	}
 	ts, _ := t.core.TSOAllocator(1)
 	_, _, err = t.core.MetaTable.DropIndex(t.Req.CollectionName, t.Req.FieldName, t.Req.IndexName, ts)
-	return err
+	if err != nil {
+		return err
+	}
+	// the proxies cache the index params for search
+	req := proxypb.InvalidateCollMetaCacheRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:   0, //TODO, msg type
+			MsgID:     0, //TODO, msg id
+			Timestamp: ts,
+			SourceID:  t.core.session.ServerID,
+		},
+		DbName:         t.Req.DbName,
+		CollectionName: t.Req.CollectionName,
+	}
+	// error doesn't matter here
+	t.core.proxyClientManager.InvalidateCollectionMetaCache(ctx, &req)
+	// the index being rebuilt into the dropped one is of no use any more
+	if info[0].ReplacedIndexID != 0 {
+		replaced, err := t.core.MetaTable.GetIndexByID(info[0].ReplacedIndexID)
+		if err != nil {
+			log.Warn("get replaced index failed", zap.Int64("index id", info[0].ReplacedIndexID), zap.Error(err))
+			return nil
+		}
+		if err = t.core.CallDropIndexService(ctx, replaced.IndexID); err != nil {
+			return err
+		}
+		ts, _ = t.core.TSOAllocator(1)
+		_, _, err = t.core.MetaTable.DropIndex(t.Req.CollectionName, t.Req.FieldName, replaced.IndexName, ts)
+		return err
+	}
+	return nil
 }
 
 // CreateAliasReqTask create alias request task
@@ -0,0 +1,75 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package rootcoord
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	pb "github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+func TestGetIndexName(t *testing.T) {
+	Params.Init()
+	vecField := &schemapb.FieldSchema{Name: "vec", DataType: schemapb.DataType_FloatVector}
+	scalarField := &schemapb.FieldSchema{Name: "age", DataType: schemapb.DataType_Int64}
+	ivf := []*commonpb.KeyValuePair{{Key: "index_type", Value: "IVF_FLAT"}}
+	sorted := []*commonpb.KeyValuePair{{Key: "index_type", Value: "SORTED"}}
+
+	name, err := getIndexName(vecField, ivf, "")
+	assert.NoError(t, err)
+	assert.Equal(t, Params.DefaultIndexName, name)
+	name, err = getIndexName(vecField, ivf, "ivf")
+	assert.NoError(t, err)
+	assert.Equal(t, "ivf", name)
+	_, err = getIndexName(vecField, sorted, "")
+	assert.Error(t, err)
+
+	name, err = getIndexName(scalarField, sorted, "")
+	assert.NoError(t, err)
+	assert.Equal(t, Params.DefaultIndexName+"_age", name)
+	name, err = getIndexName(scalarField, sorted, "age_idx")
+	assert.NoError(t, err)
+	assert.Equal(t, "age_idx", name)
+	_, err = getIndexName(scalarField, ivf, "")
+	assert.Error(t, err)
+}
+
+func TestCheckIndexNameOfField(t *testing.T) {
+	vecField := &schemapb.FieldSchema{FieldID: 100, Name: "vec", DataType: schemapb.DataType_FloatVector}
+	otherField := &schemapb.FieldSchema{FieldID: 101, Name: "vec2", DataType: schemapb.DataType_FloatVector}
+	mt := &MetaTable{
+		collName2ID:  map[string]typeutil.UniqueID{"coll": 1},
+		collAlias2ID: map[string]typeutil.UniqueID{},
+		collID2Meta: map[typeutil.UniqueID]pb.CollectionInfo{
+			1: {
+				ID: 1,
+				Schema: &schemapb.CollectionSchema{
+					Fields: []*schemapb.FieldSchema{vecField, otherField},
+				},
+				FieldIndexes: []*pb.FieldIndexInfo{{FiledID: 100, IndexID: 10}},
+			},
+		},
+		indexID2Meta: map[typeutil.UniqueID]pb.IndexInfo{
+			10: {IndexName: "ivf", IndexID: 10},
+		},
+	}
+
+	assert.NoError(t, checkIndexNameOfField(mt, "coll", "ivf", vecField))
+	assert.NoError(t, checkIndexNameOfField(mt, "coll", "hnsw", otherField))
+	assert.Error(t, checkIndexNameOfField(mt, "coll", "ivf", otherField))
+	assert.Error(t, checkIndexNameOfField(mt, "not_exist", "ivf", vecField))
+}
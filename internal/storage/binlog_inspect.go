@@ -0,0 +1,272 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package storage
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+)
+
+// BinlogInfo is the inspection result of a binlog file.
+type BinlogInfo struct {
+	Key             string                 `json:"key"`
+	Size            int                    `json:"size"`
+	FormatVersion   int64                  `json:"formatVersion"`
+	CollectionID    UniqueID               `json:"collectionID"`
+	PartitionID     UniqueID               `json:"partitionID"`
+	SegmentID       UniqueID               `json:"segmentID"`
+	FieldID         FieldID                `json:"fieldID"`
+	PayloadDataType string                 `json:"payloadDataType"`
+	StartTimestamp  Timestamp              `json:"startTimestamp"`
+	EndTimestamp    Timestamp              `json:"endTimestamp"`
+	Extras          map[string]interface{} `json:"extras"`
+	RowNum          int64                  `json:"rowNum"`
+	Events          []*BinlogEventInfo     `json:"events"`
+}
+
+// BinlogEventInfo is the inspection result of an event in a binlog file.
+// Values are the decoded payload values, a vector is decoded as []float32 or a hex string,
+// a delta log entry is decoded as *DeleteLogEntry and a DDL request is decoded as its text format.
+type BinlogEventInfo struct {
+	TypeCode       string        `json:"typeCode"`
+	Timestamp      Timestamp     `json:"timestamp"`
+	EventLength    int32         `json:"eventLength"`
+	NextPosition   int32         `json:"nextPosition"`
+	StartTimestamp Timestamp     `json:"startTimestamp"`
+	EndTimestamp   Timestamp     `json:"endTimestamp"`
+	RowNum         int           `json:"rowNum"`
+	Values         []interface{} `json:"values,omitempty"`
+}
+
+// DeleteLogEntry is an entity deleted by primary key, saved as "pk,ts" in delta logs.
+type DeleteLogEntry struct {
+	PrimaryKey string    `json:"pk"`
+	Timestamp  Timestamp `json:"ts"`
+}
+
+// ParseDeleteLogEntry parses a "pk,ts" string saved in delta logs.
+func ParseDeleteLogEntry(str string) (*DeleteLogEntry, error) {
+	splits := strings.Split(str, ",")
+	if len(splits) != 2 {
+		return nil, fmt.Errorf("the format of delta log is incorrect")
+	}
+	ts, err := strconv.ParseUint(splits[1], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	return &DeleteLogEntry{PrimaryKey: splits[0], Timestamp: ts}, nil
+}
+
+func eventDataTimestamps(data eventData) (Timestamp, Timestamp) {
+	switch d := data.(type) {
+	case *insertEventData:
+		return d.StartTimestamp, d.EndTimestamp
+	case *deleteEventData:
+		return d.StartTimestamp, d.EndTimestamp
+	case *createCollectionEventData:
+		return d.StartTimestamp, d.EndTimestamp
+	case *dropCollectionEventData:
+		return d.StartTimestamp, d.EndTimestamp
+	case *createPartitionEventData:
+		return d.StartTimestamp, d.EndTimestamp
+	case *dropPartitionEventData:
+		return d.StartTimestamp, d.EndTimestamp
+	case *indexFileEventData:
+		return d.StartTimestamp, d.EndTimestamp
+	}
+	return 0, 0
+}
+
+// InspectBinlog parses the binlog file, the payload values are decoded if withValues is true,
+// except the index files whose payload is the serialized index.
+func InspectBinlog(key string, data []byte, withValues bool) (*BinlogInfo, error) {
+	reader, err := NewBinlogReader(data)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	dataType := reader.PayloadDataType
+	dataTypeName, ok := schemapb.DataType_name[int32(dataType)]
+	if !ok {
+		return nil, fmt.Errorf("undefined data type %d", dataType)
+	}
+	info := &BinlogInfo{
+		Key:             key,
+		Size:            len(data),
+		FormatVersion:   reader.FormatVersion(),
+		CollectionID:    reader.CollectionID,
+		PartitionID:     reader.PartitionID,
+		SegmentID:       reader.SegmentID,
+		FieldID:         reader.FieldID,
+		PayloadDataType: dataTypeName,
+		StartTimestamp:  reader.StartTimestamp,
+		EndTimestamp:    reader.EndTimestamp,
+		Extras:          reader.Extras,
+	}
+	for {
+		event, err := reader.NextEventReader()
+		if err != nil {
+			return nil, err
+		}
+		if event == nil {
+			break
+		}
+		rowNum, err := event.GetPayloadLengthFromReader()
+		if err != nil {
+			return nil, err
+		}
+		eventInfo := &BinlogEventInfo{
+			TypeCode:     event.TypeCode.String(),
+			Timestamp:    event.eventHeader.Timestamp,
+			EventLength:  event.EventLength,
+			NextPosition: event.NextPosition,
+			RowNum:       rowNum,
+		}
+		eventInfo.StartTimestamp, eventInfo.EndTimestamp = eventDataTimestamps(event.eventData)
+		if withValues && event.TypeCode != IndexFileEventType {
+			if eventInfo.Values, err = readPayloadValues(event.TypeCode, dataType, event.PayloadReaderInterface); err != nil {
+				return nil, err
+			}
+		}
+		info.RowNum += int64(rowNum)
+		info.Events = append(info.Events, eventInfo)
+	}
+	return info, nil
+}
+
+func readPayloadValues(eventType EventTypeCode, dataType schemapb.DataType, reader PayloadReaderInterface) ([]interface{}, error) {
+	var values []interface{}
+	switch dataType {
+	case schemapb.DataType_Bool:
+		data, err := reader.GetBoolFromPayload()
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range data {
+			values = append(values, v)
+		}
+	case schemapb.DataType_Int8:
+		data, err := reader.GetInt8FromPayload()
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range data {
+			values = append(values, v)
+		}
+	case schemapb.DataType_Int16:
+		data, err := reader.GetInt16FromPayload()
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range data {
+			values = append(values, v)
+		}
+	case schemapb.DataType_Int32:
+		data, err := reader.GetInt32FromPayload()
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range data {
+			values = append(values, v)
+		}
+	case schemapb.DataType_Int64:
+		data, err := reader.GetInt64FromPayload()
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range data {
+			values = append(values, v)
+		}
+	case schemapb.DataType_Float:
+		data, err := reader.GetFloatFromPayload()
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range data {
+			values = append(values, v)
+		}
+	case schemapb.DataType_Double:
+		data, err := reader.GetDoubleFromPayload()
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range data {
+			values = append(values, v)
+		}
+	case schemapb.DataType_String:
+		rows, err := reader.GetPayloadLengthFromReader()
+		if err != nil {
+			return nil, err
+		}
+		for i := 0; i < rows; i++ {
+			str, err := reader.GetOneStringFromPayload(i)
+			if err != nil {
+				return nil, err
+			}
+			value, err := decodeStringValue(eventType, str)
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, value)
+		}
+	case schemapb.DataType_BinaryVector:
+		data, dim, err := reader.GetBinaryVectorFromPayload()
+		if err != nil {
+			return nil, err
+		}
+		size := dim / 8
+		for i := 0; i+size <= len(data) && size > 0; i += size {
+			values = append(values, hex.EncodeToString(data[i:i+size]))
+		}
+	case schemapb.DataType_FloatVector:
+		data, dim, err := reader.GetFloatVectorFromPayload()
+		if err != nil {
+			return nil, err
+		}
+		for i := 0; i+dim <= len(data) && dim > 0; i += dim {
+			values = append(values, data[i:i+dim])
+		}
+	default:
+		return nil, fmt.Errorf("undefined data type %d", dataType)
+	}
+	return values, nil
+}
+
+func decodeStringValue(eventType EventTypeCode, str string) (interface{}, error) {
+	var msg proto.Message
+	switch eventType {
+	case DeleteEventType:
+		return ParseDeleteLogEntry(str)
+	case CreateCollectionEventType:
+		msg = &internalpb.CreateCollectionRequest{}
+	case DropCollectionEventType:
+		msg = &internalpb.DropCollectionRequest{}
+	case CreatePartitionEventType:
+		msg = &internalpb.CreatePartitionRequest{}
+	case DropPartitionEventType:
+		msg = &internalpb.DropPartitionRequest{}
+	default:
+		return str, nil
+	}
+	if err := proto.Unmarshal([]byte(str), msg); err != nil {
+		return nil, err
+	}
+	return proto.CompactTextString(msg), nil
+}
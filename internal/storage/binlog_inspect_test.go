@@ -0,0 +1,76 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package storage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/etcdpb"
+)
+
+func TestInspectBinlog(t *testing.T) {
+	buf := writeTestInsertBinlog(t)
+
+	info, err := InspectBinlog("insert", buf, false)
+	assert.Nil(t, err)
+	assert.Equal(t, "insert", info.Key)
+	assert.Equal(t, len(buf), info.Size)
+	assert.Equal(t, BinlogFormatVersion, info.FormatVersion)
+	assert.Equal(t, UniqueID(10), info.CollectionID)
+	assert.Equal(t, UniqueID(20), info.PartitionID)
+	assert.Equal(t, UniqueID(30), info.SegmentID)
+	assert.Equal(t, FieldID(40), info.FieldID)
+	assert.Equal(t, "Int64", info.PayloadDataType)
+	assert.Equal(t, Timestamp(100), info.StartTimestamp)
+	assert.Equal(t, Timestamp(400), info.EndTimestamp)
+	assert.Equal(t, int64(5), info.RowNum)
+	assert.Equal(t, 2, len(info.Events))
+	assert.Equal(t, InsertEventType.String(), info.Events[0].TypeCode)
+	assert.Equal(t, 3, info.Events[0].RowNum)
+	assert.Equal(t, Timestamp(300), info.Events[1].StartTimestamp)
+	assert.Equal(t, Timestamp(400), info.Events[1].EndTimestamp)
+	assert.Nil(t, info.Events[0].Values)
+
+	info, err = InspectBinlog("insert", buf, true)
+	assert.Nil(t, err)
+	assert.Equal(t, []interface{}{int64(3), int64(1), int64(2)}, info.Events[0].Values)
+	assert.Equal(t, []interface{}{int64(-5), int64(9)}, info.Events[1].Values)
+
+	_, err = InspectBinlog("invalid", []byte{1, 2, 3, 4}, false)
+	assert.NotNil(t, err)
+}
+
+func TestInspectDeltaLog(t *testing.T) {
+	codec := NewDeleteCodec(&etcdpb.CollectionMeta{ID: 1})
+	blob, err := codec.Serialize(2, 3, &DeleteData{Data: map[string]int64{"100": 1000}})
+	assert.Nil(t, err)
+
+	info, err := InspectBinlog("delta", blob.Value, true)
+	assert.Nil(t, err)
+	assert.Equal(t, UniqueID(3), info.SegmentID)
+	assert.Equal(t, int64(1), info.RowNum)
+	assert.Equal(t, DeleteEventType.String(), info.Events[0].TypeCode)
+	assert.Equal(t, []interface{}{&DeleteLogEntry{PrimaryKey: "100", Timestamp: 1000}}, info.Events[0].Values)
+}
+
+func TestParseDeleteLogEntry(t *testing.T) {
+	entry, err := ParseDeleteLogEntry("1,2")
+	assert.Nil(t, err)
+	assert.Equal(t, &DeleteLogEntry{PrimaryKey: "1", Timestamp: 2}, entry)
+
+	_, err = ParseDeleteLogEntry("1")
+	assert.NotNil(t, err)
+	_, err = ParseDeleteLogEntry("1,a")
+	assert.NotNil(t, err)
+}
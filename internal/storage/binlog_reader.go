@@ -14,6 +14,7 @@ package storage
 import (
 	"bytes"
 	"encoding/binary"
+	"encoding/json"
 	"fmt"
 	"strconv"
 
@@ -25,9 +26,10 @@ import (
 type BinlogReader struct {
 	magicNumber int32
 	descriptorEvent
-	buffer    *bytes.Buffer
-	eventList []*EventReader
-	isClose   bool
+	formatVersion int64
+	buffer        *bytes.Buffer
+	eventList     []*EventReader
+	isClose       bool
 }
 
 // NextEventReader iters all events reader to read the binlog file.
@@ -66,6 +68,51 @@ func (reader *BinlogReader) readDescriptorEvent() (*descriptorEvent, error) {
 	return &reader.descriptorEvent, nil
 }
 
+func (reader *BinlogReader) readFormatVersion() (int64, error) {
+	value, ok := reader.descriptorEvent.Extras[formatVersionKey]
+	if !ok {
+		reader.formatVersion = 1
+		return reader.formatVersion, nil
+	}
+	str, ok := value.(string)
+	if !ok {
+		return -1, fmt.Errorf("invalid binlog format version %v", value)
+	}
+	version, err := strconv.ParseInt(str, 10, 64)
+	if err != nil {
+		return -1, fmt.Errorf("invalid binlog format version %s", str)
+	}
+	if version < MinSupportedBinlogFormatVersion || version > BinlogFormatVersion {
+		return -1, fmt.Errorf("unsupported binlog format version %d, supported versions: [%d, %d]",
+			version, MinSupportedBinlogFormatVersion, BinlogFormatVersion)
+	}
+	reader.formatVersion = version
+	return reader.formatVersion, nil
+}
+
+// FormatVersion returns the format version of the binlog.
+func (reader *BinlogReader) FormatVersion() int64 {
+	return reader.formatVersion
+}
+
+// GetFieldStats returns the field statistics saved in the descriptor event of insert binlog,
+// returns nil if the binlog doesn't have statistics.
+func (reader *BinlogReader) GetFieldStats() (*FieldStats, error) {
+	value, ok := reader.descriptorEvent.Extras[fieldStatsKey]
+	if !ok {
+		return nil, nil
+	}
+	str, ok := value.(string)
+	if !ok {
+		return nil, fmt.Errorf("invalid field stats %v", value)
+	}
+	stats := &FieldStats{}
+	if err := json.Unmarshal([]byte(str), stats); err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
+
 func (reader *BinlogReader) Close() error {
 	if reader.isClose {
 		return nil
@@ -93,5 +140,8 @@ func NewBinlogReader(data []byte) (*BinlogReader, error) {
 	if _, err := reader.readDescriptorEvent(); err != nil {
 		return nil, err
 	}
+	if _, err := reader.readFormatVersion(); err != nil {
+		return nil, err
+	}
 	return reader, nil
 }
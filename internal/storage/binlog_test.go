@@ -146,7 +146,7 @@ func TestInsertBinlog(t *testing.T) {
 		multiBytes[i] = singleByte
 		pos++
 	}
-	assert.Equal(t, string(multiBytes), "{\"test\":\"testExtra\"}")
+	assert.Equal(t, string(multiBytes), "{\"formatVersion\":\"2\",\"test\":\"testExtra\"}")
 
 	//start of e1
 	assert.Equal(t, pos, int(descNxtPos))
@@ -383,7 +383,7 @@ func TestDeleteBinlog(t *testing.T) {
 		multiBytes[i] = singleByte
 		pos++
 	}
-	assert.Equal(t, string(multiBytes), "{\"test\":\"testExtra\"}")
+	assert.Equal(t, string(multiBytes), "{\"formatVersion\":\"2\",\"test\":\"testExtra\"}")
 
 	//start of e1
 	assert.Equal(t, pos, int(descNxtPos))
@@ -620,7 +620,7 @@ func TestDDLBinlog1(t *testing.T) {
 		multiBytes[i] = singleByte
 		pos++
 	}
-	assert.Equal(t, string(multiBytes), "{\"test\":\"testExtra\"}")
+	assert.Equal(t, string(multiBytes), "{\"formatVersion\":\"2\",\"test\":\"testExtra\"}")
 
 	//start of e1
 	assert.Equal(t, pos, int(descNxtPos))
@@ -857,7 +857,7 @@ func TestDDLBinlog2(t *testing.T) {
 		multiBytes[i] = singleByte
 		pos++
 	}
-	assert.Equal(t, string(multiBytes), "{\"test\":\"testExtra\"}")
+	assert.Equal(t, string(multiBytes), "{\"formatVersion\":\"2\",\"test\":\"testExtra\"}")
 
 	//start of e1
 	assert.Equal(t, pos, int(descNxtPos))
@@ -1094,6 +1094,7 @@ func TestIndexFileBinlog(t *testing.T) {
 	assert.Equal(t, fmt.Sprintf("%v", indexName), fmt.Sprintf("%v", j["indexName"]))
 	assert.Equal(t, fmt.Sprintf("%v", indexID), fmt.Sprintf("%v", j["indexID"]))
 	assert.Equal(t, fmt.Sprintf("%v", key), fmt.Sprintf("%v", j["key"]))
+	assert.Equal(t, fmt.Sprintf("%v", BinlogFormatVersion), fmt.Sprintf("%v", j[formatVersionKey]))
 
 	// NextIndexFileBinlogWriter after close
 	_, err = w.NextIndexFileEventWriter()
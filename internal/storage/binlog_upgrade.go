@@ -0,0 +1,141 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package storage
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+)
+
+// rewriteDescriptorEvent rewrites the descriptor event of the binlog by update, the other events are copied with
+// the next positions adjusted.
+func rewriteDescriptorEvent(data []byte, update func(event *descriptorEvent) error) ([]byte, error) {
+	buffer := bytes.NewBuffer(data)
+	var magicNumber int32
+	if err := binary.Read(buffer, binary.LittleEndian, &magicNumber); err != nil {
+		return nil, err
+	}
+	if magicNumber != MagicNumber {
+		return nil, fmt.Errorf("parse magic number failed, expected: %d, actual: %d", MagicNumber, magicNumber)
+	}
+	event, err := ReadDescriptorEvent(buffer)
+	if err != nil {
+		return nil, err
+	}
+	start := int(event.NextPosition)
+	if start < binary.Size(MagicNumber) || start > len(data) {
+		return nil, fmt.Errorf("invalid next position %d of descriptor event", start)
+	}
+	if err := update(event); err != nil {
+		return nil, err
+	}
+
+	result := new(bytes.Buffer)
+	if err := binary.Write(result, binary.LittleEndian, MagicNumber); err != nil {
+		return nil, err
+	}
+	if err := event.Write(result); err != nil {
+		return nil, err
+	}
+	delta := event.NextPosition - int32(start)
+
+	headerSize := binary.Size(eventHeader{})
+	for pos := start; pos < len(data); {
+		header, err := readEventHeader(bytes.NewReader(data[pos:]))
+		if err != nil {
+			return nil, err
+		}
+		length := int(header.EventLength)
+		if length < headerSize || pos+length > len(data) {
+			return nil, fmt.Errorf("invalid length %d of event at %d", length, pos)
+		}
+		header.NextPosition += delta
+		if err := header.Write(result); err != nil {
+			return nil, err
+		}
+		result.Write(data[pos+headerSize : pos+length])
+		pos += length
+	}
+	return result.Bytes(), nil
+}
+
+func isInsertBinlog(reader *BinlogReader, data []byte) (bool, error) {
+	start := int(reader.NextPosition)
+	if start >= len(data) {
+		return false, nil
+	}
+	header, err := readEventHeader(bytes.NewReader(data[start:]))
+	if err != nil {
+		return false, err
+	}
+	return header.TypeCode == InsertEventType, nil
+}
+
+func readFieldStats(data []byte, fieldID FieldID, dataType schemapb.DataType) (*FieldStats, error) {
+	codec := &InsertCodec{}
+	_, _, _, insertData, err := codec.DeserializeAll([]*Blob{{Key: "0", Value: data}})
+	if err != nil {
+		return nil, err
+	}
+	fieldData, ok := insertData.Data[fieldID]
+	if !ok {
+		return nil, nil
+	}
+	return NewFieldStats(fieldID, dataType, fieldData), nil
+}
+
+// UpgradeBinlog rewrites the binlog of an old format version in the latest format version, the field statistics
+// are added to insert binlogs and the payloads are copied as is. The bool is false if the binlog is already in
+// the latest format version, in which case data is returned unchanged.
+func UpgradeBinlog(data []byte) ([]byte, bool, error) {
+	reader, err := NewBinlogReader(data)
+	if err != nil {
+		return nil, false, err
+	}
+	version := reader.FormatVersion()
+	fieldID, dataType := reader.FieldID, reader.PayloadDataType
+	insert, err := isInsertBinlog(reader, data)
+	reader.Close()
+	if err != nil {
+		return nil, false, err
+	}
+	if version == BinlogFormatVersion {
+		return data, false, nil
+	}
+
+	var stats *FieldStats
+	if insert && dataType != schemapb.DataType_FloatVector && dataType != schemapb.DataType_BinaryVector {
+		if stats, err = readFieldStats(data, fieldID, dataType); err != nil {
+			return nil, false, err
+		}
+	}
+	result, err := rewriteDescriptorEvent(data, func(event *descriptorEvent) error {
+		if stats != nil {
+			statsBytes, err := json.Marshal(stats)
+			if err != nil {
+				return err
+			}
+			event.AddExtra(fieldStatsKey, string(statsBytes))
+		}
+		event.AddExtra(formatVersionKey, strconv.FormatInt(BinlogFormatVersion, 10))
+		return nil
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	return result, true, nil
+}
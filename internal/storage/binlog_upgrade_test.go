@@ -0,0 +1,158 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package storage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+)
+
+func writeTestInsertBinlog(t *testing.T) []byte {
+	w := NewInsertBinlogWriter(schemapb.DataType_Int64, 10, 20, 30, 40)
+	e1, err := w.NextInsertEventWriter()
+	assert.Nil(t, err)
+	err = e1.AddDataToPayload([]int64{3, 1, 2})
+	assert.Nil(t, err)
+	e1.SetEventTimestamp(100, 200)
+	e2, err := w.NextInsertEventWriter()
+	assert.Nil(t, err)
+	err = e2.AddDataToPayload([]int64{-5, 9})
+	assert.Nil(t, err)
+	e2.SetEventTimestamp(300, 400)
+	w.SetEventTimeStamp(100, 400)
+	err = w.Close()
+	assert.Nil(t, err)
+	buf, err := w.GetBuffer()
+	assert.Nil(t, err)
+	return buf
+}
+
+// toLegacyBinlog removes the extras added by format version 2.
+func toLegacyBinlog(t *testing.T, data []byte) []byte {
+	legacy, err := rewriteDescriptorEvent(data, func(event *descriptorEvent) error {
+		delete(event.Extras, formatVersionKey)
+		delete(event.Extras, fieldStatsKey)
+		return nil
+	})
+	assert.Nil(t, err)
+	return legacy
+}
+
+func readTestInsertBinlog(t *testing.T, data []byte) []int64 {
+	reader, err := NewBinlogReader(data)
+	assert.Nil(t, err)
+	defer reader.Close()
+	var result []int64
+	for {
+		event, err := reader.NextEventReader()
+		assert.Nil(t, err)
+		if event == nil {
+			break
+		}
+		values, err := event.GetInt64FromPayload()
+		assert.Nil(t, err)
+		result = append(result, values...)
+	}
+	return result
+}
+
+func TestBinlogFormatVersion(t *testing.T) {
+	buf := writeTestInsertBinlog(t)
+	reader, err := NewBinlogReader(buf)
+	assert.Nil(t, err)
+	assert.Equal(t, BinlogFormatVersion, reader.FormatVersion())
+	reader.Close()
+
+	legacy := toLegacyBinlog(t, buf)
+	reader, err = NewBinlogReader(legacy)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), reader.FormatVersion())
+	assert.Equal(t, int64(40), reader.FieldID)
+	reader.Close()
+	assert.Equal(t, []int64{3, 1, 2, -5, 9}, readTestInsertBinlog(t, legacy))
+
+	for _, version := range []interface{}{"3", "0", "abc", 2} {
+		data, err := rewriteDescriptorEvent(buf, func(event *descriptorEvent) error {
+			event.AddExtra(formatVersionKey, version)
+			return nil
+		})
+		assert.Nil(t, err)
+		_, err = NewBinlogReader(data)
+		assert.NotNil(t, err)
+	}
+}
+
+func TestUpgradeBinlog(t *testing.T) {
+	buf := writeTestInsertBinlog(t)
+	legacy := toLegacyBinlog(t, buf)
+
+	upgraded, ok, err := UpgradeBinlog(legacy)
+	assert.Nil(t, err)
+	assert.True(t, ok)
+	reader, err := NewBinlogReader(upgraded)
+	assert.Nil(t, err)
+	assert.Equal(t, BinlogFormatVersion, reader.FormatVersion())
+	stats, err := reader.GetFieldStats()
+	assert.Nil(t, err)
+	assert.NotNil(t, stats)
+	assert.Equal(t, FieldID(40), stats.FieldID)
+	assert.Equal(t, int64(5), stats.RowNum)
+	assert.True(t, stats.HasMinMax)
+	assert.Equal(t, int64(-5), stats.MinInt64)
+	assert.Equal(t, int64(9), stats.MaxInt64)
+	reader.Close()
+	assert.Equal(t, []int64{3, 1, 2, -5, 9}, readTestInsertBinlog(t, upgraded))
+
+	// already in the latest version
+	data, ok, err := UpgradeBinlog(upgraded)
+	assert.Nil(t, err)
+	assert.False(t, ok)
+	assert.Equal(t, upgraded, data)
+
+	_, _, err = UpgradeBinlog([]byte{1, 2, 3, 4})
+	assert.NotNil(t, err)
+	_, _, err = UpgradeBinlog(legacy[:len(legacy)-1])
+	assert.NotNil(t, err)
+}
+
+func TestUpgradeDeleteBinlog(t *testing.T) {
+	w := NewDeleteBinlogWriter(schemapb.DataType_Int64, 10, 20, 30)
+	e, err := w.NextDeleteEventWriter()
+	assert.Nil(t, err)
+	err = e.AddDataToPayload([]int64{1, 2, 3})
+	assert.Nil(t, err)
+	e.SetEventTimestamp(100, 200)
+	w.SetEventTimeStamp(100, 200)
+	err = w.Close()
+	assert.Nil(t, err)
+	buf, err := w.GetBuffer()
+	assert.Nil(t, err)
+
+	upgraded, ok, err := UpgradeBinlog(toLegacyBinlog(t, buf))
+	assert.Nil(t, err)
+	assert.True(t, ok)
+	reader, err := NewBinlogReader(upgraded)
+	assert.Nil(t, err)
+	defer reader.Close()
+	assert.Equal(t, BinlogFormatVersion, reader.FormatVersion())
+	stats, err := reader.GetFieldStats()
+	assert.Nil(t, err)
+	assert.Nil(t, stats)
+	event, err := reader.NextEventReader()
+	assert.Nil(t, err)
+	values, err := event.GetInt64FromPayload()
+	assert.Nil(t, err)
+	assert.Equal(t, []int64{1, 2, 3}, values)
+}
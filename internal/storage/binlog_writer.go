@@ -15,6 +15,7 @@ import (
 	"bytes"
 	"encoding/binary"
 	"fmt"
+	"strconv"
 
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 )
@@ -32,6 +33,17 @@ const (
 	MagicNumber int32 = 0xfffabc
 )
 
+const (
+	// BinlogFormatVersion is the format version of the binlog written by this release, it's saved in the extras of
+	// the descriptor event. Version 2 adds the format version, the payload options and the field statistics.
+	BinlogFormatVersion int64 = 2
+	// MinSupportedBinlogFormatVersion is the oldest format version the binlog reader can read,
+	// the binlog without format version is version 1.
+	MinSupportedBinlogFormatVersion int64 = 1
+
+	formatVersionKey = "formatVersion"
+)
+
 type baseBinlogWriter struct {
 	descriptorEvent
 	magicNumber  int32
@@ -86,6 +98,8 @@ func (writer *baseBinlogWriter) Close() error {
 		return fmt.Errorf("invalid start/end timestamp")
 	}
 
+	writer.AddExtra(formatVersionKey, strconv.FormatInt(BinlogFormatVersion, 10))
+
 	var offset int32 = 0
 	writer.buffer = new(bytes.Buffer)
 	if err := binary.Write(writer.buffer, binary.LittleEndian, int32(MagicNumber)); err != nil {
@@ -126,6 +140,15 @@ func (writer *baseBinlogWriter) Close() error {
 // InsertBinlogWriter is an object to write binlog file which saves insert data.
 type InsertBinlogWriter struct {
 	baseBinlogWriter
+	payloadOptions PayloadOptions
+}
+
+// SetPayloadOptions sets the compression and encoding options of the payloads written by the event writers
+// created afterwards, and records the options in the descriptor event.
+func (writer *InsertBinlogWriter) SetPayloadOptions(opts PayloadOptions) {
+	writer.payloadOptions = opts
+	writer.AddExtra(compressionKey, opts.Compression.String())
+	writer.AddExtra(dictionaryKey, fmt.Sprintf("%t", opts.EnableDictionary))
 }
 
 // NextInsertEventWriter returns an event writer to write insert data to an event.
@@ -133,7 +156,7 @@ func (writer *InsertBinlogWriter) NextInsertEventWriter() (*insertEventWriter, e
 	if writer.isClosed() {
 		return nil, fmt.Errorf("binlog has closed")
 	}
-	event, err := newInsertEventWriter(writer.PayloadDataType)
+	event, err := newInsertEventWriterWithOptions(writer.PayloadDataType, writer.payloadOptions)
 	if err != nil {
 		return nil, err
 	}
@@ -252,6 +275,7 @@ func NewInsertBinlogWriter(dataType schemapb.DataType, collectionID, partitionID
 			eventWriters:    make([]EventWriter, 0),
 			buffer:          nil,
 		},
+		payloadOptions: DefaultPayloadOptions(),
 	}
 }
 
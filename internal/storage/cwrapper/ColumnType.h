@@ -24,6 +24,12 @@ enum ColumnType : int {
   VECTOR_FLOAT = 101
 };
 
+enum CompressionType : int {
+  UNCOMPRESSED = 0,
+  ZSTD = 1,
+  LZ4 = 2
+};
+
 enum ErrorCode : int {
   SUCCESS = 0,
   UNEXPECTED_ERROR = 1,
@@ -22,12 +22,38 @@ static const char *ErrorMsg(const std::string &msg) {
 
 extern "C"
 CPayloadWriter NewPayloadWriter(int columnType) {
+  return NewPayloadWriterWithOptions(columnType, CompressionType::UNCOMPRESSED, true);
+}
+
+extern "C"
+CPayloadWriter NewPayloadWriterWithOptions(int columnType, int compressionType, bool enableDictionary) {
+  parquet::Compression::type compression;
+  switch (static_cast<CompressionType>(compressionType)) {
+    case CompressionType::UNCOMPRESSED : {
+      compression = parquet::Compression::UNCOMPRESSED;
+      break;
+    }
+    case CompressionType::ZSTD : {
+      compression = parquet::Compression::ZSTD;
+      break;
+    }
+    case CompressionType::LZ4 : {
+      compression = parquet::Compression::LZ4;
+      break;
+    }
+    default: {
+      return nullptr;
+    }
+  }
+
   auto p = new wrapper::PayloadWriter;
   p->builder = nullptr;
   p->schema = nullptr;
   p->output = nullptr;
   p->dimension = wrapper::EMPTY_DIMENSION;
   p->rows = 0;
+  p->compression = compression;
+  p->enableDictionary = enableDictionary;
   switch (static_cast<ColumnType>(columnType)) {
     case ColumnType::BOOL : {
       p->columnType = ColumnType::BOOL;
@@ -307,7 +333,15 @@ CStatus FinishPayloadWriter(CPayloadWriter payloadWriter) {
     }
     auto table = arrow::Table::Make(p->schema, {array});
     p->output = std::make_shared<wrapper::PayloadOutputStream>();
-    ast = parquet::arrow::WriteTable(*table, arrow::default_memory_pool(), p->output, 1024 * 1024 * 1024);
+    parquet::WriterProperties::Builder props;
+    props.compression(p->compression);
+    if (p->enableDictionary) {
+      props.enable_dictionary();
+    } else {
+      props.disable_dictionary();
+    }
+    ast = parquet::arrow::WriteTable(*table, arrow::default_memory_pool(), p->output, 1024 * 1024 * 1024,
+                                     props.build());
     if (!ast.ok()) {
       st.error_code = static_cast<int>(ErrorCode::UNEXPECTED_ERROR);
       st.error_msg = ErrorMsg(ast.message());
@@ -31,6 +31,7 @@ typedef struct CStatus {
 //============= payload writer ======================
 typedef void *CPayloadWriter;
 CPayloadWriter NewPayloadWriter(int columnType);
+CPayloadWriter NewPayloadWriterWithOptions(int columnType, int compressionType, bool enableDictionary);
 CStatus AddBooleanToPayload(CPayloadWriter payloadWriter, bool *values, int length);
 CStatus AddInt8ToPayload(CPayloadWriter payloadWriter, int8_t *values, int length);
 CStatus AddInt16ToPayload(CPayloadWriter payloadWriter, int16_t *values, int length);
@@ -31,6 +31,8 @@ struct PayloadWriter {
   std::shared_ptr<arrow::Schema> schema;
   std::shared_ptr<PayloadOutputStream> output;
   int rows;
+  parquet::Compression::type compression;
+  bool enableDictionary;
 };
 
 struct PayloadReader {
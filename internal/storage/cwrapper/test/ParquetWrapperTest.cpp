@@ -314,3 +314,40 @@ TEST(wrapper, int8_2) {
   ASSERT_EQ(bool_array->Value(2), -100);
   ASSERT_EQ(bool_array->Value(3), 100);
 }
+
+TEST(wrapper, compression) {
+  for (auto compression : {CompressionType::ZSTD, CompressionType::LZ4}) {
+    for (auto dictionary : {true, false}) {
+      auto payload = NewPayloadWriterWithOptions(ColumnType::INT64, compression, dictionary);
+      ASSERT_NE(payload, nullptr);
+      int64_t data[] = {-1, 1, -100, 100, -1, 1, -100, 100};
+
+      auto st = AddInt64ToPayload(payload, data, 8);
+      ASSERT_EQ(st.error_code, ErrorCode::SUCCESS);
+      st = FinishPayloadWriter(payload);
+      ASSERT_EQ(st.error_code, ErrorCode::SUCCESS);
+      auto cb = GetPayloadBufferFromWriter(payload);
+      ASSERT_GT(cb.length, 0);
+      ASSERT_NE(cb.data, nullptr);
+
+      auto reader = NewPayloadReader(ColumnType::INT64, (uint8_t *) cb.data, cb.length);
+      ASSERT_NE(reader, nullptr);
+      int64_t *values;
+      int length;
+      st = GetInt64FromPayload(reader, &values, &length);
+      ASSERT_EQ(st.error_code, ErrorCode::SUCCESS);
+      ASSERT_EQ(length, 8);
+      for (int i = 0; i < length; i++) {
+        ASSERT_EQ(data[i], values[i]);
+      }
+
+      st = ReleasePayloadWriter(payload);
+      ASSERT_EQ(st.error_code, ErrorCode::SUCCESS);
+      st = ReleasePayloadReader(reader);
+      ASSERT_EQ(st.error_code, ErrorCode::SUCCESS);
+    }
+  }
+
+  auto payload = NewPayloadWriterWithOptions(ColumnType::INT64, -1, true);
+  ASSERT_EQ(payload, nullptr);
+}
@@ -144,7 +144,10 @@ type InsertData struct {
 // Blob key example:
 // ${tenant}/insert_log/${collection_id}/${partition_id}/${segment_id}/${field_id}/${log_idx}
 type InsertCodec struct {
-	Schema          *etcdpb.CollectionMeta
+	Schema *etcdpb.CollectionMeta
+	// PayloadOptions is the compression and encoding options of the binlogs by data type,
+	// the data types not in it use DefaultPayloadOptions
+	PayloadOptions  map[schemapb.DataType]PayloadOptions
 	readerCloseFunc []func() error
 }
 
@@ -179,6 +182,16 @@ func (insertCodec *InsertCodec) Serialize(partitionID UniqueID, segmentID Unique
 
 		// encode fields
 		writer = NewInsertBinlogWriter(field.DataType, insertCodec.Schema.ID, partitionID, segmentID, field.FieldID)
+		if opts, ok := insertCodec.PayloadOptions[field.DataType]; ok {
+			writer.SetPayloadOptions(opts)
+		}
+		if fieldStats := NewFieldStats(field.FieldID, field.DataType, singleData); fieldStats != nil {
+			statsBytes, err := json.Marshal(fieldStats)
+			if err != nil {
+				return nil, nil, err
+			}
+			writer.AddExtra(fieldStatsKey, string(statsBytes))
+		}
 		eventWriter, err := writer.NextInsertEventWriter()
 		if err != nil {
 			return nil, nil, err
@@ -495,6 +508,40 @@ func (insertCodec *InsertCodec) Deserialize(blobs []*Blob) (partitionID UniqueID
 	return partitionID, segmentID, data, err
 }
 
+// DeserializeFieldStats reads the field statistics from the descriptor events of the binlogs without reading
+// the payloads, and merges the statistics of the same field. The fields with any binlog lacking statistics,
+// e.g. binlogs written by old versions, are not in the result.
+func (insertCodec *InsertCodec) DeserializeFieldStats(blobs []*Blob) (map[FieldID]*FieldStats, error) {
+	result := make(map[FieldID]*FieldStats)
+	unknown := make(map[FieldID]struct{})
+	for _, blob := range blobs {
+		binlogReader, err := NewBinlogReader(blob.Value)
+		if err != nil {
+			return nil, err
+		}
+		fieldID := binlogReader.FieldID
+		stats, err := binlogReader.GetFieldStats()
+		binlogReader.Close()
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := unknown[fieldID]; ok {
+			continue
+		}
+		if stats == nil {
+			unknown[fieldID] = struct{}{}
+			delete(result, fieldID)
+			continue
+		}
+		if existing, ok := result[fieldID]; ok {
+			existing.Merge(stats)
+		} else {
+			result[fieldID] = stats
+		}
+	}
+	return result, nil
+}
+
 func (insertCodec *InsertCodec) Close() error {
 	for _, closeFunc := range insertCodec.readerCloseFunc {
 		err := closeFunc()
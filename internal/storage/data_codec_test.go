@@ -142,6 +142,11 @@ func TestInsertCodec(t *testing.T) {
 		},
 	}
 	insertCodec := NewInsertCodec(schema)
+	insertCodec.PayloadOptions = map[schemapb.DataType]PayloadOptions{
+		schemapb.DataType_Int64:       {Compression: CompressionZstd, EnableDictionary: true},
+		schemapb.DataType_String:      {Compression: CompressionZstd, EnableDictionary: true},
+		schemapb.DataType_FloatVector: {Compression: CompressionLz4, EnableDictionary: false},
+	}
 	insertData1 := &InsertData{
 		Data: map[int64]FieldData{
 			RowIDField: &Int64FieldData{
@@ -294,6 +299,20 @@ func TestInsertCodec(t *testing.T) {
 	assert.Equal(t, []byte{0, 255, 0, 255}, resultData.Data[BinaryVectorField].(*BinaryVectorFieldData).Data)
 	assert.Equal(t, []float32{0, 1, 2, 3, 0, 1, 2, 3, 4, 5, 6, 7, 4, 5, 6, 7}, resultData.Data[FloatVectorField].(*FloatVectorFieldData).Data)
 	assert.Nil(t, insertCodec.Close())
+
+	fieldStats, err := insertCodec.DeserializeFieldStats(resultBlobs)
+	assert.Nil(t, err)
+	assert.Equal(t, 10, len(fieldStats))
+	assert.Nil(t, fieldStats[FloatVectorField])
+	assert.Equal(t, int64(4), fieldStats[Int64Field].RowNum)
+	assert.Equal(t, int64(1), fieldStats[Int64Field].MinInt64)
+	assert.Equal(t, int64(4), fieldStats[Int64Field].MaxInt64)
+	assert.Equal(t, int64(0), fieldStats[BoolField].MinInt64)
+	assert.Equal(t, int64(1), fieldStats[BoolField].MaxInt64)
+	assert.Equal(t, float64(1), fieldStats[FloatField].MinFloat64)
+	assert.Equal(t, float64(4), fieldStats[FloatField].MaxFloat64)
+	assert.Equal(t, "1", fieldStats[StringField].MinString)
+	assert.Equal(t, "4", fieldStats[StringField].MaxString)
 	log.Debug("Data", zap.Any("Data", resultData.Data))
 	log.Debug("Infos", zap.Any("Infos", resultData.Infos))
 
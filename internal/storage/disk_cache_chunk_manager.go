@@ -0,0 +1,535 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package storage
+
+import (
+	"container/list"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metrics"
+)
+
+var _ ChunkManager = (*DiskCacheChunkManager)(nil)
+
+const (
+	diskCacheWarmupParallelism = 4
+)
+
+type diskCacheEntry struct {
+	key   string
+	size  int64
+	pins  int
+	ready bool          // false while the chunk is being written, the entry reserves the room of the chunk meanwhile
+	done  chan struct{} // closed once the chunk is written or discarded
+	// the chunk is invalidated while pinned, it's kept until the last pin is released so that the users don't lose
+	// the file in use, but it's not served anymore and the key can't be cached again meanwhile
+	stale bool
+}
+
+// DiskCacheChunkManager caches the chunks of the remote chunk manager in the local chunk manager. The total size of
+// the cached chunks is bounded by the capacity, the least recently used chunks are evicted first and the pinned
+// chunks are never evicted. Writes and removals go to the remote chunk manager and invalidate the cached chunks.
+type DiskCacheChunkManager struct {
+	local    *LocalChunkManager
+	remote   ChunkManager
+	capacity int64
+
+	mu      sync.Mutex
+	lru     *list.List // front is the most recently used
+	entries map[string]*list.Element
+	used    int64
+}
+
+// NewDiskCacheChunkManager creates a disk cache of capacity bytes, the chunks already in the local chunk manager
+// are reused if they have the size of the remote chunks, the others are removed.
+func NewDiskCacheChunkManager(local *LocalChunkManager, remote ChunkManager, capacity int64) (*DiskCacheChunkManager, error) {
+	if capacity <= 0 {
+		return nil, fmt.Errorf("invalid disk cache capacity %d", capacity)
+	}
+	dc := &DiskCacheChunkManager{
+		local:    local,
+		remote:   remote,
+		capacity: capacity,
+		lru:      list.New(),
+		entries:  make(map[string]*list.Element),
+	}
+	keys, err := local.ListWithPrefix("")
+	if err != nil {
+		return nil, err
+	}
+	for _, key := range keys {
+		// left by a crash while the chunk was being written
+		if strings.HasSuffix(key, localTmpSuffix) {
+			if err := local.Remove(key); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		size, err := local.Size(key)
+		if err != nil {
+			return nil, err
+		}
+		remoteSize, err := remote.Size(key)
+		if err != nil || remoteSize != size {
+			log.Debug("remove stale cached chunk", zap.String("key", key), zap.Int64("size", size),
+				zap.Int64("remoteSize", remoteSize), zap.Error(err))
+			if err := local.Remove(key); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		dc.entries[key] = dc.lru.PushBack(&diskCacheEntry{key: key, size: size, ready: true, done: closedDoneCh})
+		dc.used += size
+	}
+	dc.mu.Lock()
+	dc.evictLocked(0)
+	dc.mu.Unlock()
+	log.Debug("disk cache created", zap.Int64("capacity", capacity), zap.Int("chunks", len(dc.entries)), zap.Int64("used", dc.used))
+	return dc, nil
+}
+
+var closedDoneCh = func() chan struct{} {
+	ch := make(chan struct{})
+	close(ch)
+	return ch
+}()
+
+// removeLocked removes the chunk from the cache, the chunk being written is discarded by its writer
+func (dc *DiskCacheChunkManager) removeLocked(elem *list.Element) {
+	entry := elem.Value.(*diskCacheEntry)
+	dc.lru.Remove(elem)
+	delete(dc.entries, entry.key)
+	dc.used -= entry.size
+	if entry.ready {
+		if err := dc.local.Remove(entry.key); err != nil {
+			log.Warn("failed to remove cached chunk", zap.String("key", entry.key), zap.Error(err))
+		}
+	}
+	metrics.QueryNodeDiskCacheUsedSize.Set(float64(dc.used))
+}
+
+// invalidateLocked removes the chunk from the cache, or marks it stale if it's pinned, the stale chunk is removed once
+// the last pin is released
+func (dc *DiskCacheChunkManager) invalidateLocked(elem *list.Element) {
+	entry := elem.Value.(*diskCacheEntry)
+	if entry.pins > 0 {
+		entry.stale = true
+		return
+	}
+	dc.removeLocked(elem)
+}
+
+// unpinLocked releases a pin of the chunk, the stale chunk is removed on the last release
+func (dc *DiskCacheChunkManager) unpinLocked(elem *list.Element) {
+	entry := elem.Value.(*diskCacheEntry)
+	if entry.pins > 0 {
+		entry.pins--
+	}
+	if entry.pins == 0 && entry.stale {
+		dc.removeLocked(elem)
+	}
+}
+
+// pinnedSizeLocked returns the total size of the pinned chunks, which can't be evicted
+func (dc *DiskCacheChunkManager) pinnedSizeLocked() int64 {
+	var size int64
+	for elem := dc.lru.Front(); elem != nil; elem = elem.Next() {
+		if entry := elem.Value.(*diskCacheEntry); entry.pins > 0 {
+			size += entry.size
+		}
+	}
+	return size
+}
+
+// evictLocked evicts the least recently used chunks which are not pinned until there is room for size bytes,
+// returns false if there isn't enough room after evicting all the unpinned chunks.
+func (dc *DiskCacheChunkManager) evictLocked(size int64) bool {
+	for elem := dc.lru.Back(); elem != nil && dc.used+size > dc.capacity; {
+		prev := elem.Prev()
+		if elem.Value.(*diskCacheEntry).pins == 0 {
+			dc.removeLocked(elem)
+			metrics.QueryNodeDiskCacheEvictedCounter.Inc()
+		}
+		elem = prev
+	}
+	return dc.used+size <= dc.capacity
+}
+
+// acquire pins the chunk if it's cached, the chunk should be released after use.
+func (dc *DiskCacheChunkManager) acquire(key string) (*list.Element, bool) {
+	dc.mu.Lock()
+	defer dc.mu.Unlock()
+	elem, ok := dc.entries[key]
+	if !ok {
+		return nil, false
+	}
+	entry := elem.Value.(*diskCacheEntry)
+	if !entry.ready || entry.stale {
+		return nil, false
+	}
+	dc.lru.MoveToFront(elem)
+	entry.pins++
+	return elem, true
+}
+
+func (dc *DiskCacheChunkManager) release(elem *list.Element) {
+	dc.mu.Lock()
+	defer dc.mu.Unlock()
+	dc.unpinLocked(elem)
+}
+
+// put caches the content of the chunk, and pins it if pin is true. The room of the chunk is reserved before the
+// chunk is written by the local writer without holding the lock, and the writer is closed to save the chunk in the
+// cache only if the chunk isn't invalidated meanwhile, so a chunk in the cache is always complete. If the chunk is
+// being cached by another caller, put waits for it to be written.
+func (dc *DiskCacheChunkManager) put(key string, content []byte, pin bool) error {
+	size := int64(len(content))
+	dc.mu.Lock()
+	for {
+		elem, ok := dc.entries[key]
+		if !ok {
+			break
+		}
+		entry := elem.Value.(*diskCacheEntry)
+		if entry.stale {
+			dc.mu.Unlock()
+			return fmt.Errorf("chunk %s is invalidated but still in use", key)
+		}
+		if entry.ready {
+			dc.lru.MoveToFront(elem)
+			if pin {
+				entry.pins++
+			}
+			dc.mu.Unlock()
+			return nil
+		}
+		// being cached by another caller, check again once it's written or discarded
+		dc.mu.Unlock()
+		<-entry.done
+		dc.mu.Lock()
+	}
+	if size > dc.capacity || !dc.evictLocked(size) {
+		used := dc.used
+		dc.mu.Unlock()
+		return fmt.Errorf("no room in disk cache for chunk %s of %d bytes, capacity: %d, used: %d", key, size, dc.capacity, used)
+	}
+	// pinned by the writer, so the reserved room isn't evicted
+	entry := &diskCacheEntry{key: key, size: size, pins: 1, done: make(chan struct{})}
+	elem := dc.lru.PushFront(entry)
+	dc.entries[key] = elem
+	dc.used += size
+	metrics.QueryNodeDiskCacheUsedSize.Set(float64(dc.used))
+	dc.mu.Unlock()
+
+	w, err := dc.local.Writer(key)
+	if err == nil {
+		_, err = w.Write(content)
+	}
+
+	dc.mu.Lock()
+	defer dc.mu.Unlock()
+	defer close(entry.done)
+	if err == nil && entry.stale {
+		err = fmt.Errorf("chunk %s is invalidated while being cached", key)
+	}
+	if err == nil {
+		err = w.Close()
+	} else if w != nil {
+		_ = w.Abort()
+	}
+	if err != nil {
+		// the file isn't saved, so the stale entry is dropped regardless of the pins of the writer
+		dc.removeLocked(elem)
+		return err
+	}
+	entry.ready = true
+	if !pin {
+		entry.pins--
+	}
+	return nil
+}
+
+func (dc *DiskCacheChunkManager) invalidate(keys ...string) {
+	dc.mu.Lock()
+	defer dc.mu.Unlock()
+	for _, key := range keys {
+		if elem, ok := dc.entries[key]; ok {
+			dc.invalidateLocked(elem)
+		}
+	}
+}
+
+func (dc *DiskCacheChunkManager) invalidatePrefix(prefix string) {
+	dc.mu.Lock()
+	defer dc.mu.Unlock()
+	for key, elem := range dc.entries {
+		if strings.HasPrefix(key, prefix) {
+			dc.invalidateLocked(elem)
+		}
+	}
+}
+
+// Pin downloads the chunk to the cache if not cached, and prevents it from being evicted until Unpin is called.
+func (dc *DiskCacheChunkManager) Pin(key string) error {
+	if _, ok := dc.acquire(key); ok {
+		metrics.QueryNodeDiskCacheAccessCounter.WithLabelValues("hit").Inc()
+		return nil
+	}
+	metrics.QueryNodeDiskCacheAccessCounter.WithLabelValues("miss").Inc()
+	content, err := dc.remote.Read(key)
+	if err != nil {
+		return err
+	}
+	return dc.put(key, content, true)
+}
+
+// Unpin allows the chunk to be evicted again, every successful Pin should be followed by exactly one Unpin. The
+// pinned chunk stays under its key even if invalidated, so the pin is always released from the entry it was taken on.
+func (dc *DiskCacheChunkManager) Unpin(key string) {
+	dc.mu.Lock()
+	defer dc.mu.Unlock()
+	if elem, ok := dc.entries[key]; ok {
+		dc.unpinLocked(elem)
+	}
+}
+
+// Warmup downloads the chunks not cached yet in order, the first error is returned. The chunks are downloaded only
+// while they fit in the room left by the pinned chunks, so that the warmup doesn't evict the chunks it has just
+// downloaded.
+func (dc *DiskCacheChunkManager) Warmup(keys []string) error {
+	var wg sync.WaitGroup
+	var errOnce sync.Once
+	var resultErr error
+	keyCh := make(chan string)
+	for i := 0; i < diskCacheWarmupParallelism; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for key := range keyCh {
+				if dc.Cached(key) {
+					continue
+				}
+				content, err := dc.remote.Read(key)
+				if err == nil {
+					err = dc.put(key, content, false)
+				}
+				if err != nil {
+					errOnce.Do(func() { resultErr = err })
+				}
+			}
+		}()
+	}
+	dc.mu.Lock()
+	room := dc.capacity - dc.pinnedSizeLocked()
+	dc.mu.Unlock()
+	for _, key := range keys {
+		dc.mu.Lock()
+		elem, ok := dc.entries[key]
+		pinned := ok && elem.Value.(*diskCacheEntry).pins > 0
+		dc.mu.Unlock()
+		if pinned {
+			// the room is already taken
+			continue
+		}
+		size, err := dc.Size(key)
+		if err != nil {
+			errOnce.Do(func() { resultErr = err })
+			continue
+		}
+		if size > room {
+			log.Debug("disk cache warmup stops at capacity", zap.String("key", key), zap.Int64("size", size),
+				zap.Int64("room", room), zap.Int64("capacity", dc.capacity))
+			break
+		}
+		room -= size
+		keyCh <- key
+	}
+	close(keyCh)
+	wg.Wait()
+	return resultErr
+}
+
+// Cached checks whether the chunk is in the cache.
+func (dc *DiskCacheChunkManager) Cached(key string) bool {
+	dc.mu.Lock()
+	defer dc.mu.Unlock()
+	elem, ok := dc.entries[key]
+	return ok && elem.Value.(*diskCacheEntry).ready && !elem.Value.(*diskCacheEntry).stale
+}
+
+// UsedSize returns the total size of the cached chunks.
+func (dc *DiskCacheChunkManager) UsedSize() int64 {
+	dc.mu.Lock()
+	defer dc.mu.Unlock()
+	return dc.used
+}
+
+// GetPath returns the local path of the chunk if cached, otherwise the remote path.
+func (dc *DiskCacheChunkManager) GetPath(key string) (string, error) {
+	if elem, ok := dc.acquire(key); ok {
+		defer dc.release(elem)
+		return dc.local.GetPath(key)
+	}
+	return dc.remote.GetPath(key)
+}
+
+// Size returns the size of the chunk.
+func (dc *DiskCacheChunkManager) Size(key string) (int64, error) {
+	dc.mu.Lock()
+	elem, ok := dc.entries[key]
+	ok = ok && !elem.Value.(*diskCacheEntry).stale
+	dc.mu.Unlock()
+	if ok {
+		// the size is fixed once the entry is created
+		return elem.Value.(*diskCacheEntry).size, nil
+	}
+	return dc.remote.Size(key)
+}
+
+// Write writes the chunk to the remote chunk manager.
+func (dc *DiskCacheChunkManager) Write(key string, content []byte) error {
+	dc.invalidate(key)
+	return dc.remote.Write(key, content)
+}
+
+// MultiWrite writes the chunks to the remote chunk manager.
+func (dc *DiskCacheChunkManager) MultiWrite(contents map[string][]byte) error {
+	keys := make([]string, 0, len(contents))
+	for key := range contents {
+		keys = append(keys, key)
+	}
+	dc.invalidate(keys...)
+	return dc.remote.MultiWrite(contents)
+}
+
+// Exist checks whether the chunk exists.
+func (dc *DiskCacheChunkManager) Exist(key string) bool {
+	return dc.Cached(key) || dc.remote.Exist(key)
+}
+
+// Read reads the chunk from the cache, the chunk is downloaded and cached if not cached yet.
+func (dc *DiskCacheChunkManager) Read(key string) ([]byte, error) {
+	if elem, ok := dc.acquire(key); ok {
+		content, err := dc.local.Read(key)
+		dc.release(elem)
+		if err == nil {
+			metrics.QueryNodeDiskCacheAccessCounter.WithLabelValues("hit").Inc()
+			return content, nil
+		}
+		log.Warn("failed to read cached chunk", zap.String("key", key), zap.Error(err))
+		dc.invalidate(key)
+	}
+	metrics.QueryNodeDiskCacheAccessCounter.WithLabelValues("miss").Inc()
+	content, err := dc.remote.Read(key)
+	if err != nil {
+		return nil, err
+	}
+	if err := dc.put(key, content, false); err != nil {
+		log.Debug("chunk is not cached", zap.String("key", key), zap.Error(err))
+	}
+	return content, nil
+}
+
+// MultiRead reads the chunks in order, the first error is returned.
+func (dc *DiskCacheChunkManager) MultiRead(keys []string) ([][]byte, error) {
+	var resultErr error
+	results := make([][]byte, 0, len(keys))
+	for _, key := range keys {
+		content, err := dc.Read(key)
+		if err != nil && resultErr == nil {
+			resultErr = err
+		}
+		results = append(results, content)
+	}
+	return results, resultErr
+}
+
+// ReadAt reads the chunk from the cache if cached, otherwise from the remote chunk manager without caching.
+func (dc *DiskCacheChunkManager) ReadAt(key string, p []byte, off int64) (int, error) {
+	if elem, ok := dc.acquire(key); ok {
+		n, err := dc.local.ReadAt(key, p, off)
+		dc.release(elem)
+		if err == nil || errors.Is(err, io.EOF) {
+			metrics.QueryNodeDiskCacheAccessCounter.WithLabelValues("hit").Inc()
+			return n, err
+		}
+		log.Warn("failed to read cached chunk", zap.String("key", key), zap.Error(err))
+		dc.invalidate(key)
+	}
+	metrics.QueryNodeDiskCacheAccessCounter.WithLabelValues("miss").Inc()
+	return dc.remote.ReadAt(key, p, off)
+}
+
+// ListWithPrefix returns the keys of the chunks with the prefix in the remote chunk manager.
+func (dc *DiskCacheChunkManager) ListWithPrefix(prefix string) ([]string, error) {
+	return dc.remote.ListWithPrefix(prefix)
+}
+
+// ReadWithPrefix reads all the chunks with the prefix.
+func (dc *DiskCacheChunkManager) ReadWithPrefix(prefix string) ([]string, [][]byte, error) {
+	keys, err := dc.ListWithPrefix(prefix)
+	if err != nil {
+		return nil, nil, err
+	}
+	contents, err := dc.MultiRead(keys)
+	if err != nil {
+		return nil, nil, err
+	}
+	return keys, contents, nil
+}
+
+// Reader opens the cached chunk if cached, otherwise the remote chunk without caching.
+func (dc *DiskCacheChunkManager) Reader(key string) (io.ReadCloser, error) {
+	if elem, ok := dc.acquire(key); ok {
+		reader, err := dc.local.Reader(key)
+		dc.release(elem)
+		if err == nil {
+			metrics.QueryNodeDiskCacheAccessCounter.WithLabelValues("hit").Inc()
+			return reader, nil
+		}
+		log.Warn("failed to open cached chunk", zap.String("key", key), zap.Error(err))
+		dc.invalidate(key)
+	}
+	metrics.QueryNodeDiskCacheAccessCounter.WithLabelValues("miss").Inc()
+	return dc.remote.Reader(key)
+}
+
+// Writer creates the chunk in the remote chunk manager.
+func (dc *DiskCacheChunkManager) Writer(key string) (ChunkWriter, error) {
+	dc.invalidate(key)
+	return dc.remote.Writer(key)
+}
+
+// Remove deletes the chunk from both the cache and the remote chunk manager.
+func (dc *DiskCacheChunkManager) Remove(key string) error {
+	dc.invalidate(key)
+	return dc.remote.Remove(key)
+}
+
+// MultiRemove deletes the chunks from both the cache and the remote chunk manager.
+func (dc *DiskCacheChunkManager) MultiRemove(keys []string) error {
+	dc.invalidate(keys...)
+	return dc.remote.MultiRemove(keys)
+}
+
+// RemoveWithPrefix deletes the chunks with the prefix from both the cache and the remote chunk manager.
+func (dc *DiskCacheChunkManager) RemoveWithPrefix(prefix string) error {
+	dc.invalidatePrefix(prefix)
+	return dc.remote.RemoveWithPrefix(prefix)
+}
@@ -0,0 +1,293 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package storage
+
+import (
+	"io/ioutil"
+	"os"
+	"path"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestDiskCache(t *testing.T, capacity int64) (*DiskCacheChunkManager, ChunkManager, string) {
+	dir, err := ioutil.TempDir("", "disk_cache")
+	assert.Nil(t, err)
+	remote := NewLocalChunkManager(path.Join(dir, "remote"))
+	local := NewLocalChunkManager(path.Join(dir, "cache"))
+	dc, err := NewDiskCacheChunkManager(local, remote, capacity)
+	assert.Nil(t, err)
+	return dc, remote, dir
+}
+
+func TestDiskCacheChunkManager_Read(t *testing.T) {
+	dc, remote, dir := newTestDiskCache(t, 10)
+	defer os.RemoveAll(dir)
+
+	assert.Nil(t, remote.Write("a", []byte("aaaa")))
+	assert.Nil(t, remote.Write("b", []byte("bbbb")))
+	assert.Nil(t, remote.Write("c", []byte("cccc")))
+	assert.Nil(t, remote.Write("large", []byte("large chunk")))
+
+	content, err := dc.Read("a")
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("aaaa"), content)
+	assert.True(t, dc.Cached("a"))
+	_, err = dc.Read("b")
+	assert.Nil(t, err)
+	assert.Equal(t, int64(8), dc.UsedSize())
+
+	// a is the most recently used, b is evicted
+	_, err = dc.Read("a")
+	assert.Nil(t, err)
+	_, err = dc.Read("c")
+	assert.Nil(t, err)
+	assert.True(t, dc.Cached("a"))
+	assert.False(t, dc.Cached("b"))
+	assert.True(t, dc.Cached("c"))
+	assert.Equal(t, int64(8), dc.UsedSize())
+
+	// larger than the capacity, read without caching
+	content, err = dc.Read("large")
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("large chunk"), content)
+	assert.False(t, dc.Cached("large"))
+
+	_, err = dc.Read("not_exist")
+	assert.NotNil(t, err)
+
+	buf := make([]byte, 2)
+	n, err := dc.ReadAt("c", buf, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, n)
+	assert.Equal(t, []byte("cc"), buf)
+
+	size, err := dc.Size("a")
+	assert.Nil(t, err)
+	assert.Equal(t, int64(4), size)
+	size, err = dc.Size("large")
+	assert.Nil(t, err)
+	assert.Equal(t, int64(11), size)
+
+	reader, err := dc.Reader("a")
+	assert.Nil(t, err)
+	content, err = ioutil.ReadAll(reader)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("aaaa"), content)
+	reader.Close()
+
+	keys, contents, err := dc.ReadWithPrefix("")
+	assert.Nil(t, err)
+	assert.Equal(t, 4, len(keys))
+	assert.Equal(t, 4, len(contents))
+}
+
+func TestDiskCacheChunkManager_Pin(t *testing.T) {
+	dc, remote, dir := newTestDiskCache(t, 10)
+	defer os.RemoveAll(dir)
+
+	assert.Nil(t, remote.Write("a", []byte("aaaa")))
+	assert.Nil(t, remote.Write("b", []byte("bbbb")))
+	assert.Nil(t, remote.Write("c", []byte("cccc")))
+
+	assert.Nil(t, dc.Pin("a"))
+	assert.Nil(t, dc.Pin("b"))
+	// no room for c since a and b are pinned
+	assert.NotNil(t, dc.Pin("c"))
+	content, err := dc.Read("c")
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("cccc"), content)
+	assert.False(t, dc.Cached("c"))
+
+	dc.Unpin("a")
+	assert.Nil(t, dc.Pin("c"))
+	assert.False(t, dc.Cached("a"))
+	assert.True(t, dc.Cached("b"))
+	assert.True(t, dc.Cached("c"))
+
+	assert.NotNil(t, dc.Pin("not_exist"))
+}
+
+func TestDiskCacheChunkManager_Warmup(t *testing.T) {
+	dc, remote, dir := newTestDiskCache(t, 10)
+	defer os.RemoveAll(dir)
+
+	assert.Nil(t, remote.Write("a", []byte("aa")))
+	assert.Nil(t, remote.Write("b", []byte("bb")))
+	assert.Nil(t, remote.Write("c", []byte("cc")))
+	assert.Nil(t, dc.Warmup([]string{"a", "b", "c"}))
+	assert.True(t, dc.Cached("a"))
+	assert.True(t, dc.Cached("b"))
+	assert.True(t, dc.Cached("c"))
+	assert.Equal(t, int64(6), dc.UsedSize())
+
+	assert.NotNil(t, dc.Warmup([]string{"a", "not_exist"}))
+
+	// the cached chunks are reused after restart
+	local := NewLocalChunkManager(path.Join(dir, "cache"))
+	dc2, err := NewDiskCacheChunkManager(local, remote, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(6), dc2.UsedSize())
+	assert.True(t, dc2.Cached("b"))
+
+	// evicted to fit a smaller capacity
+	dc3, err := NewDiskCacheChunkManager(local, remote, 4)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(4), dc3.UsedSize())
+
+	_, err = NewDiskCacheChunkManager(local, remote, 0)
+	assert.NotNil(t, err)
+
+	// the chunks left incomplete by a crash aren't reused
+	assert.Nil(t, local.Write("a", []byte("a")))
+	assert.Nil(t, local.Write("b.1234"+localTmpSuffix, []byte("b")))
+	assert.Nil(t, local.Write("not_exist", []byte("n")))
+	dc4, err := NewDiskCacheChunkManager(local, remote, 10)
+	assert.Nil(t, err)
+	assert.False(t, dc4.Cached("a"))
+	assert.False(t, local.Exist("a"))
+	assert.False(t, local.Exist("b.1234"+localTmpSuffix))
+	assert.False(t, local.Exist("not_exist"))
+	content, err := dc4.Read("a")
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("aa"), content)
+	assert.True(t, dc4.Cached("a"))
+
+	// no temporary file is left after caching
+	keys, err := local.ListWithPrefix("")
+	assert.Nil(t, err)
+	for _, key := range keys {
+		assert.False(t, strings.HasSuffix(key, localTmpSuffix))
+	}
+}
+
+func TestDiskCacheChunkManager_WarmupCapacity(t *testing.T) {
+	dc, remote, dir := newTestDiskCache(t, 10)
+	defer os.RemoveAll(dir)
+
+	for _, key := range []string{"a", "b", "c", "d", "e", "f"} {
+		assert.Nil(t, remote.Write(key, []byte("xx")))
+	}
+	assert.Nil(t, dc.Pin("a"))
+	assert.Nil(t, dc.Pin("b"))
+	assert.Nil(t, dc.Pin("c"))
+
+	// the warmup stops at the room left by the pinned chunks, instead of evicting the chunks just downloaded
+	assert.Nil(t, dc.Warmup([]string{"a", "d", "e", "f"}))
+	assert.True(t, dc.Cached("a"))
+	assert.True(t, dc.Cached("d"))
+	assert.True(t, dc.Cached("e"))
+	assert.False(t, dc.Cached("f"))
+	assert.Equal(t, int64(10), dc.UsedSize())
+}
+
+func TestDiskCacheChunkManager_InvalidateWhileCaching(t *testing.T) {
+	dc, remote, dir := newTestDiskCache(t, 10)
+	defer os.RemoveAll(dir)
+
+	assert.Nil(t, remote.Write("a", []byte("aaaa")))
+	// the room is reserved while the chunk is being written
+	dc.mu.Lock()
+	entry := &diskCacheEntry{key: "a", size: 4, pins: 1, done: make(chan struct{})}
+	elem := dc.lru.PushFront(entry)
+	dc.entries["a"] = elem
+	dc.used += 4
+	dc.mu.Unlock()
+	assert.False(t, dc.Cached("a"))
+
+	// the chunk is read from remote, and cached once the writer is done
+	readDone := make(chan struct{})
+	go func() {
+		defer close(readDone)
+		content, err := dc.Read("a")
+		assert.Nil(t, err)
+		assert.Equal(t, []byte("aaaa"), content)
+	}()
+	dc.invalidate("a")
+	assert.Equal(t, int64(4), dc.UsedSize())
+	dc.mu.Lock()
+	dc.removeLocked(elem)
+	close(entry.done)
+	dc.mu.Unlock()
+	<-readDone
+	assert.True(t, dc.Cached("a"))
+	assert.Equal(t, int64(4), dc.UsedSize())
+}
+
+func TestDiskCacheChunkManager_InvalidatePinned(t *testing.T) {
+	dc, remote, dir := newTestDiskCache(t, 10)
+	defer os.RemoveAll(dir)
+
+	assert.Nil(t, remote.Write("a", []byte("aaaa")))
+	assert.Nil(t, dc.Pin("a"))
+	localPath, err := dc.GetPath("a")
+	assert.Nil(t, err)
+
+	// the pinned chunk is kept until unpinned, but not served anymore
+	assert.Nil(t, remote.Write("a", []byte("bbbb")))
+	dc.invalidate("a")
+	assert.False(t, dc.Cached("a"))
+	assert.Equal(t, int64(4), dc.UsedSize())
+	_, err = os.Stat(localPath)
+	assert.Nil(t, err)
+	assert.NotNil(t, dc.put("a", []byte("bbbb"), true))
+	content, err := dc.Read("a")
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("bbbb"), content)
+
+	dc.Unpin("a")
+	assert.Equal(t, int64(0), dc.UsedSize())
+	_, err = os.Stat(localPath)
+	assert.True(t, os.IsNotExist(err))
+
+	// the extra unpin doesn't release the pin of the new chunk
+	assert.Nil(t, dc.Pin("a"))
+	dc.Unpin("b")
+	assert.Nil(t, remote.Write("c", []byte("cccccccc")))
+	assert.NotNil(t, dc.Pin("c"))
+	dc.Unpin("a")
+	assert.Nil(t, dc.Pin("c"))
+	assert.False(t, dc.Cached("a"))
+}
+
+func TestDiskCacheChunkManager_Write(t *testing.T) {
+	dc, remote, dir := newTestDiskCache(t, 10)
+	defer os.RemoveAll(dir)
+
+	assert.Nil(t, dc.Write("a", []byte("a1")))
+	content, err := dc.Read("a")
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("a1"), content)
+	assert.True(t, dc.Cached("a"))
+
+	// writes invalidate the cached chunk
+	assert.Nil(t, dc.Write("a", []byte("a2")))
+	assert.False(t, dc.Cached("a"))
+	content, err = dc.Read("a")
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("a2"), content)
+
+	assert.Nil(t, dc.MultiWrite(map[string][]byte{"a": []byte("a3"), "p/b": []byte("b")}))
+	contents, err := dc.MultiRead([]string{"a", "p/b"})
+	assert.Nil(t, err)
+	assert.Equal(t, [][]byte{[]byte("a3"), []byte("b")}, contents)
+	assert.True(t, dc.Exist("p/b"))
+
+	assert.Nil(t, dc.RemoveWithPrefix("p/"))
+	assert.False(t, dc.Cached("p/b"))
+	assert.False(t, remote.Exist("p/b"))
+	assert.Nil(t, dc.Remove("a"))
+	assert.False(t, dc.Exist("a"))
+	assert.Equal(t, int64(0), dc.UsedSize())
+}
@@ -216,7 +216,11 @@ func newDescriptorEvent() *descriptorEvent {
 }
 
 func newInsertEventWriter(dataType schemapb.DataType) (*insertEventWriter, error) {
-	payloadWriter, err := NewPayloadWriter(dataType)
+	return newInsertEventWriterWithOptions(dataType, DefaultPayloadOptions())
+}
+
+func newInsertEventWriterWithOptions(dataType schemapb.DataType, opts PayloadOptions) (*insertEventWriter, error) {
+	payloadWriter, err := NewPayloadWriterWithOptions(dataType, opts)
 	if err != nil {
 		return nil, err
 	}
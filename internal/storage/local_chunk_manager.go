@@ -13,15 +13,23 @@ package storage
 
 import (
 	"errors"
+	"io"
 	"io/ioutil"
 	"os"
 	"path"
+	"path/filepath"
+	"strings"
 
 	"golang.org/x/exp/mmap"
 
 	"github.com/milvus-io/milvus/internal/log"
 )
 
+// localTmpSuffix is the suffix of the temporary files the chunks are written to before renamed into place
+const localTmpSuffix = ".tmp"
+
+var _ ChunkManager = (*LocalChunkManager)(nil)
+
 // LocalChunkManager is responsible for read and write local file.
 type LocalChunkManager struct {
 	localPath string
@@ -43,15 +51,28 @@ func (lcm *LocalChunkManager) GetPath(key string) (string, error) {
 	return path, nil
 }
 
+// Size returns the size of the local file.
+func (lcm *LocalChunkManager) Size(key string) (int64, error) {
+	info, err := os.Stat(path.Join(lcm.localPath, key))
+	if err != nil {
+		return 0, err
+	}
+	return info.Size(), nil
+}
+
+func mkdirForFile(filePath string) error {
+	dir := path.Dir(filePath)
+	if _, err := os.Stat(dir); os.IsNotExist(err) {
+		return os.MkdirAll(dir, os.ModePerm)
+	}
+	return nil
+}
+
 // Write writes the data to local storage.
 func (lcm *LocalChunkManager) Write(key string, content []byte) error {
 	filePath := path.Join(lcm.localPath, key)
-	dir := path.Dir(filePath)
-	if _, err := os.Stat(dir); os.IsNotExist(err) {
-		err := os.MkdirAll(dir, os.ModePerm)
-		if err != nil {
-			return err
-		}
+	if err := mkdirForFile(filePath); err != nil {
+		return err
 	}
 	err := ioutil.WriteFile(filePath, content, 0600)
 	if err != nil {
@@ -60,6 +81,17 @@ func (lcm *LocalChunkManager) Write(key string, content []byte) error {
 	return nil
 }
 
+// MultiWrite writes the data of multiple keys to local storage.
+func (lcm *LocalChunkManager) MultiWrite(contents map[string][]byte) error {
+	var resultErr error
+	for key, content := range contents {
+		if err := lcm.Write(key, content); err != nil && resultErr == nil {
+			resultErr = err
+		}
+	}
+	return resultErr
+}
+
 // Exist checks whether chunk is saved to local storage.
 func (lcm *LocalChunkManager) Exist(key string) bool {
 	path := path.Join(lcm.localPath, key)
@@ -84,6 +116,157 @@ func (lcm *LocalChunkManager) Read(key string) ([]byte, error) {
 	return content, file.Close()
 }
 
+// MultiRead reads the local storage data of multiple keys.
+func (lcm *LocalChunkManager) MultiRead(keys []string) ([][]byte, error) {
+	var resultErr error
+	results := make([][]byte, 0, len(keys))
+	for _, key := range keys {
+		content, err := lcm.Read(key)
+		if err != nil && resultErr == nil {
+			resultErr = err
+		}
+		results = append(results, content)
+	}
+	return results, resultErr
+}
+
+// ListWithPrefix returns the keys of the local files with the prefix.
+func (lcm *LocalChunkManager) ListWithPrefix(prefix string) ([]string, error) {
+	prefixPath := path.Join(lcm.localPath, prefix)
+	// the files in the directory of the prefix are walked, unless the prefix is a directory itself
+	root := path.Dir(prefixPath)
+	if prefix == "" || strings.HasSuffix(prefix, "/") {
+		root = prefixPath
+	}
+	var keys []string
+	err := filepath.Walk(root, func(filePath string, info os.FileInfo, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		if !info.IsDir() && strings.HasPrefix(filePath, prefixPath) {
+			key, err := filepath.Rel(lcm.localPath, filePath)
+			if err != nil {
+				return err
+			}
+			keys = append(keys, filepath.ToSlash(key))
+		}
+		return nil
+	})
+	return keys, err
+}
+
+// ReadWithPrefix reads the local files with the prefix.
+func (lcm *LocalChunkManager) ReadWithPrefix(prefix string) ([]string, [][]byte, error) {
+	keys, err := lcm.ListWithPrefix(prefix)
+	if err != nil {
+		return nil, nil, err
+	}
+	contents, err := lcm.MultiRead(keys)
+	if err != nil {
+		return nil, nil, err
+	}
+	return keys, contents, nil
+}
+
+// Reader opens the local file for read.
+func (lcm *LocalChunkManager) Reader(key string) (io.ReadCloser, error) {
+	return os.Open(path.Clean(path.Join(lcm.localPath, key)))
+}
+
+// localFileWriter writes a temporary file, which is renamed to the target file on close,
+// so that a broken write never leaves a partial file.
+type localFileWriter struct {
+	file     *os.File
+	filePath string
+	writeErr error
+	closed   bool
+}
+
+func (w *localFileWriter) Write(p []byte) (int, error) {
+	if w.closed {
+		return 0, errChunkWriterAborted
+	}
+	n, err := w.file.Write(p)
+	if err != nil && w.writeErr == nil {
+		w.writeErr = err
+	}
+	return n, err
+}
+
+func (w *localFileWriter) Close() error {
+	if w.writeErr != nil {
+		_ = w.Abort()
+		return w.writeErr
+	}
+	if w.closed {
+		return nil
+	}
+	w.closed = true
+	err := w.file.Close()
+	if err == nil {
+		err = os.Rename(w.file.Name(), w.filePath)
+	}
+	if err != nil {
+		_ = os.Remove(w.file.Name())
+	}
+	return err
+}
+
+func (w *localFileWriter) Abort() error {
+	if w.closed {
+		return nil
+	}
+	w.closed = true
+	_ = w.file.Close()
+	return os.Remove(w.file.Name())
+}
+
+// Writer creates the local file for write, the content is written to a temporary file with localTmpSuffix in
+// the directory of the file.
+func (lcm *LocalChunkManager) Writer(key string) (ChunkWriter, error) {
+	filePath := path.Join(lcm.localPath, key)
+	if err := mkdirForFile(filePath); err != nil {
+		return nil, err
+	}
+	file, err := ioutil.TempFile(path.Dir(filePath), path.Base(filePath)+".*"+localTmpSuffix)
+	if err != nil {
+		return nil, err
+	}
+	return &localFileWriter{file: file, filePath: filePath}, nil
+}
+
+// Remove deletes the local file.
+func (lcm *LocalChunkManager) Remove(key string) error {
+	err := os.Remove(path.Join(lcm.localPath, key))
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// MultiRemove deletes the local files of multiple keys.
+func (lcm *LocalChunkManager) MultiRemove(keys []string) error {
+	var resultErr error
+	for _, key := range keys {
+		if err := lcm.Remove(key); err != nil && resultErr == nil {
+			resultErr = err
+		}
+	}
+	return resultErr
+}
+
+// RemoveWithPrefix deletes the local files with the prefix.
+func (lcm *LocalChunkManager) RemoveWithPrefix(prefix string) error {
+	keys, err := lcm.ListWithPrefix(prefix)
+	if err != nil {
+		return err
+	}
+	return lcm.MultiRemove(keys)
+}
+
 // ReadAt reads specific position data of local storage if exist.
 func (lcm *LocalChunkManager) ReadAt(key string, p []byte, off int64) (n int, err error) {
 	path := path.Join(lcm.localPath, key)
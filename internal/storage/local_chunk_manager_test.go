@@ -12,6 +12,8 @@
 package storage
 
 import (
+	"io/ioutil"
+	"path"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -50,3 +52,118 @@ func TestLocalChunkManager_Read(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, len(res), len(bin))
 }
+
+func TestLocalChunkManager_MultiWriteRead(t *testing.T) {
+	lcm := NewLocalChunkManager(path.Join(localPath, "multi"))
+	defer lcm.RemoveWithPrefix("")
+
+	contents := map[string][]byte{
+		"a/1":  {1},
+		"a/2":  {2, 2},
+		"ab/3": {3, 3, 3},
+		"b/4":  {4},
+	}
+	err := lcm.MultiWrite(contents)
+	assert.Nil(t, err)
+
+	res, err := lcm.MultiRead([]string{"a/1", "a/2"})
+	assert.Nil(t, err)
+	assert.Equal(t, [][]byte{{1}, {2, 2}}, res)
+
+	_, err = lcm.MultiRead([]string{"a/1", "invalid"})
+	assert.Error(t, err)
+
+	size, err := lcm.Size("ab/3")
+	assert.Nil(t, err)
+	assert.Equal(t, int64(3), size)
+	_, err = lcm.Size("invalid")
+	assert.Error(t, err)
+
+	keys, err := lcm.ListWithPrefix("a")
+	assert.Nil(t, err)
+	assert.ElementsMatch(t, []string{"a/1", "a/2", "ab/3"}, keys)
+
+	keys, contents2, err := lcm.ReadWithPrefix("a/")
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(keys))
+	for i, key := range keys {
+		assert.Equal(t, contents[key], contents2[i])
+	}
+
+	keys, err = lcm.ListWithPrefix("")
+	assert.Nil(t, err)
+	assert.Equal(t, 4, len(keys))
+
+	keys, err = lcm.ListWithPrefix("c/")
+	assert.Nil(t, err)
+	assert.Empty(t, keys)
+
+	err = lcm.Remove("b/4")
+	assert.Nil(t, err)
+	assert.False(t, lcm.Exist("b/4"))
+	// removing a missing file is not an error
+	err = lcm.Remove("b/4")
+	assert.Nil(t, err)
+
+	err = lcm.MultiRemove([]string{"a/1", "a/2"})
+	assert.Nil(t, err)
+	err = lcm.RemoveWithPrefix("a")
+	assert.Nil(t, err)
+	keys, err = lcm.ListWithPrefix("")
+	assert.Nil(t, err)
+	assert.Empty(t, keys)
+}
+
+func TestLocalChunkManager_ReaderWriter(t *testing.T) {
+	lcm := NewLocalChunkManager(path.Join(localPath, "stream"))
+	defer lcm.RemoveWithPrefix("")
+
+	_, err := lcm.Reader("invalid")
+	assert.Error(t, err)
+
+	w, err := lcm.Writer("dir/key")
+	assert.Nil(t, err)
+	_, err = w.Write([]byte{1, 2})
+	assert.Nil(t, err)
+	_, err = w.Write([]byte{3})
+	assert.Nil(t, err)
+	// the file is not visible before the writer is closed
+	assert.False(t, lcm.Exist("dir/key"))
+	err = w.Close()
+	assert.Nil(t, err)
+
+	r, err := lcm.Reader("dir/key")
+	assert.Nil(t, err)
+	res, err := ioutil.ReadAll(r)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte{1, 2, 3}, res)
+	assert.Nil(t, r.Close())
+
+	keys, err := lcm.ListWithPrefix("dir/")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"dir/key"}, keys)
+
+	// the aborted file is discarded without leaving the temporary file
+	w, err = lcm.Writer("dir/aborted")
+	assert.Nil(t, err)
+	_, err = w.Write([]byte{1})
+	assert.Nil(t, err)
+	assert.Nil(t, w.Abort())
+	assert.False(t, lcm.Exist("dir/aborted"))
+	_, err = w.Write([]byte{2})
+	assert.Error(t, err)
+	assert.Nil(t, w.Abort())
+
+	// the file isn't saved after a failed write
+	w, err = lcm.Writer("dir/failed")
+	assert.Nil(t, err)
+	assert.Nil(t, w.(*localFileWriter).file.Close())
+	_, err = w.Write([]byte{1})
+	assert.Error(t, err)
+	assert.Error(t, w.Close())
+	assert.False(t, lcm.Exist("dir/failed"))
+
+	keys, err = lcm.ListWithPrefix("dir/")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"dir/key"}, keys)
+}
@@ -12,22 +12,43 @@
 package storage
 
 import (
+	"bytes"
+	"context"
 	"errors"
 	"io"
+	"io/ioutil"
+
+	"github.com/minio/minio-go/v7"
+	"go.uber.org/zap"
 
 	miniokv "github.com/milvus-io/milvus/internal/kv/minio"
+	"github.com/milvus-io/milvus/internal/log"
 )
 
-// MinioChunkManager is responsible for read and write data stored in minio.
+// minioPartSize is the part size of multipart upload, the objects larger than it are uploaded in parts,
+// and a streaming upload buffers one part in memory.
+const minioPartSize = 16 << 20
+
+var _ ChunkManager = (*MinioChunkManager)(nil)
+
+// MinioChunkManager is responsible for read and write data stored in minio or any S3 compatible storage.
 type MinioChunkManager struct {
-	minio *miniokv.MinIOKV
+	ctx        context.Context
+	client     *minio.Client
+	bucketName string
 }
 
-// NewMinioChunkManager create a new local manager object.
-func NewMinioChunkManager(minio *miniokv.MinIOKV) *MinioChunkManager {
-	return &MinioChunkManager{
-		minio: minio,
+// NewMinioChunkManager create a new minio manager object, the bucket is created if not exist and option.CreateBucket is set.
+func NewMinioChunkManager(ctx context.Context, option *miniokv.Option) (*MinioChunkManager, error) {
+	client, err := miniokv.NewMinIOClient(ctx, option)
+	if err != nil {
+		return nil, err
 	}
+	return &MinioChunkManager{
+		ctx:        ctx,
+		client:     client,
+		bucketName: option.BucketName,
+	}, nil
 }
 
 // GetPath returns the path of minio data if exist.
@@ -38,36 +59,212 @@ func (mcm *MinioChunkManager) GetPath(key string) (string, error) {
 	return key, nil
 }
 
-// Write writes the data to minio storage.
+// Size returns the size of the minio object.
+func (mcm *MinioChunkManager) Size(key string) (int64, error) {
+	info, err := mcm.client.StatObject(mcm.ctx, mcm.bucketName, key, minio.StatObjectOptions{})
+	if err != nil {
+		return 0, err
+	}
+	return info.Size, nil
+}
+
+// Write writes the data to minio storage, large data is uploaded in parts.
 func (mcm *MinioChunkManager) Write(key string, content []byte) error {
-	return mcm.minio.Save(key, string(content))
+	_, err := mcm.client.PutObject(mcm.ctx, mcm.bucketName, key, bytes.NewReader(content), int64(len(content)),
+		minio.PutObjectOptions{PartSize: minioPartSize})
+	return err
+}
+
+// MultiWrite writes the data of multiple keys to minio storage.
+func (mcm *MinioChunkManager) MultiWrite(contents map[string][]byte) error {
+	var resultErr error
+	for key, content := range contents {
+		if err := mcm.Write(key, content); err != nil && resultErr == nil {
+			resultErr = err
+		}
+	}
+	return resultErr
 }
 
 // Exist checks whether chunk is saved to minio storage.
 func (mcm *MinioChunkManager) Exist(key string) bool {
-	return mcm.minio.Exist(key)
+	_, err := mcm.client.StatObject(mcm.ctx, mcm.bucketName, key, minio.StatObjectOptions{})
+	return err == nil
 }
 
 // Read reads the minio storage data if exist.
 func (mcm *MinioChunkManager) Read(key string) ([]byte, error) {
-	results, err := mcm.minio.Load(key)
-	return []byte(results), err
+	object, err := mcm.client.GetObject(mcm.ctx, mcm.bucketName, key, minio.GetObjectOptions{})
+	if err != nil {
+		return nil, err
+	}
+	defer object.Close()
+	return ioutil.ReadAll(object)
+}
+
+// MultiRead reads the minio storage data of multiple keys.
+func (mcm *MinioChunkManager) MultiRead(keys []string) ([][]byte, error) {
+	var resultErr error
+	results := make([][]byte, 0, len(keys))
+	for _, key := range keys {
+		content, err := mcm.Read(key)
+		if err != nil && resultErr == nil {
+			resultErr = err
+		}
+		results = append(results, content)
+	}
+	return results, resultErr
 }
 
 // ReadAt reads specific position data of minio storage if exist.
 func (mcm *MinioChunkManager) ReadAt(key string, p []byte, off int64) (int, error) {
-	results, err := mcm.minio.Load(key)
+	object, err := mcm.client.GetObject(mcm.ctx, mcm.bucketName, key, minio.GetObjectOptions{})
+	if err != nil {
+		return -1, err
+	}
+	defer object.Close()
+	info, err := object.Stat()
 	if err != nil {
 		return -1, err
 	}
 
-	if off < 0 || int64(len([]byte(results))) < off {
+	if off < 0 || info.Size < off {
 		return 0, errors.New("MinioChunkManager: invalid offset")
 	}
-	n := copy(p, []byte(results)[off:])
-	if n < len(p) {
-		return n, io.EOF
+	return object.ReadAt(p, off)
+}
+
+// ListWithPrefix returns the keys of the minio objects with the prefix.
+func (mcm *MinioChunkManager) ListWithPrefix(prefix string) ([]string, error) {
+	var keys []string
+	for object := range mcm.client.ListObjects(mcm.ctx, mcm.bucketName, minio.ListObjectsOptions{Prefix: prefix, Recursive: true}) {
+		if object.Err != nil {
+			return nil, object.Err
+		}
+		keys = append(keys, object.Key)
+	}
+	return keys, nil
+}
+
+// ReadWithPrefix reads the minio objects with the prefix.
+func (mcm *MinioChunkManager) ReadWithPrefix(prefix string) ([]string, [][]byte, error) {
+	keys, err := mcm.ListWithPrefix(prefix)
+	if err != nil {
+		return nil, nil, err
+	}
+	contents, err := mcm.MultiRead(keys)
+	if err != nil {
+		return nil, nil, err
+	}
+	return keys, contents, nil
+}
+
+// Reader opens the minio object for streaming read.
+func (mcm *MinioChunkManager) Reader(key string) (io.ReadCloser, error) {
+	object, err := mcm.client.GetObject(mcm.ctx, mcm.bucketName, key, minio.GetObjectOptions{})
+	if err != nil {
+		return nil, err
+	}
+	// GetObject is lazy, stat the object to report a missing key here
+	if _, err := object.Stat(); err != nil {
+		object.Close()
+		return nil, err
 	}
+	return object, nil
+}
+
+// minioObjectWriter pipes the written data to a multipart upload, which completes on close, and fails without
+// creating the object if the pipe is closed with an error.
+type minioObjectWriter struct {
+	pw       *io.PipeWriter
+	done     chan error
+	writeErr error
+	closed   bool
+	closeErr error
+}
+
+func (w *minioObjectWriter) Write(p []byte) (int, error) {
+	if w.closed {
+		return 0, errChunkWriterAborted
+	}
+	n, err := w.pw.Write(p)
+	if err != nil && w.writeErr == nil {
+		w.writeErr = err
+	}
+	return n, err
+}
+
+// finish closes the pipe with the error and waits for the upload to return
+func (w *minioObjectWriter) finish(err error) error {
+	if !w.closed {
+		w.closed = true
+		_ = w.pw.CloseWithError(err)
+		w.closeErr = <-w.done
+	}
+	return w.closeErr
+}
 
-	return n, nil
+func (w *minioObjectWriter) Close() error {
+	if w.writeErr != nil {
+		_ = w.Abort()
+		return w.writeErr
+	}
+	return w.finish(nil)
+}
+
+func (w *minioObjectWriter) Abort() error {
+	// the upload fails as the pipe is broken
+	_ = w.finish(errChunkWriterAborted)
+	return nil
+}
+
+// Writer creates the minio object for streaming write, the object is visible after the writer is closed.
+func (mcm *MinioChunkManager) Writer(key string) (ChunkWriter, error) {
+	pr, pw := io.Pipe()
+	w := &minioObjectWriter{pw: pw, done: make(chan error, 1)}
+	go func() {
+		_, err := mcm.client.PutObject(mcm.ctx, mcm.bucketName, key, pr, -1, minio.PutObjectOptions{PartSize: minioPartSize})
+		if err != nil && !errors.Is(err, errChunkWriterAborted) {
+			log.Warn("MinioChunkManager upload failed", zap.String("key", key), zap.Error(err))
+		}
+		// unblock the writer if the upload fails in the middle
+		pr.CloseWithError(err)
+		w.done <- err
+	}()
+	return w, nil
+}
+
+// Remove deletes the minio object.
+func (mcm *MinioChunkManager) Remove(key string) error {
+	return mcm.client.RemoveObject(mcm.ctx, mcm.bucketName, key, minio.RemoveObjectOptions{})
+}
+
+// MultiRemove deletes the minio objects of multiple keys.
+func (mcm *MinioChunkManager) MultiRemove(keys []string) error {
+	var resultErr error
+	for _, key := range keys {
+		if err := mcm.Remove(key); err != nil && resultErr == nil {
+			resultErr = err
+		}
+	}
+	return resultErr
+}
+
+// RemoveWithPrefix deletes the minio objects with the prefix.
+func (mcm *MinioChunkManager) RemoveWithPrefix(prefix string) error {
+	objectsCh := make(chan minio.ObjectInfo)
+	go func() {
+		defer close(objectsCh)
+		for object := range mcm.client.ListObjects(mcm.ctx, mcm.bucketName, minio.ListObjectsOptions{Prefix: prefix, Recursive: true}) {
+			objectsCh <- object
+		}
+	}()
+
+	var resultErr error
+	for rErr := range mcm.client.RemoveObjects(mcm.ctx, mcm.bucketName, objectsCh, minio.RemoveObjectsOptions{GovernanceBypass: true}) {
+		if rErr.Err != nil && resultErr == nil {
+			resultErr = rErr.Err
+		}
+	}
+	return resultErr
 }
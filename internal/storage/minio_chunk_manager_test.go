@@ -12,7 +12,10 @@
 package storage
 
 import (
+	"bytes"
 	"context"
+	"io"
+	"io/ioutil"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -20,10 +23,8 @@ import (
 
 func TestMinioChunkManager_GetPath(t *testing.T) {
 	bucketName := "minio-chunk-manager"
-	kv, err := newMinIOKVClient(context.TODO(), bucketName)
+	minioMgr, err := newMinioChunkManager(context.TODO(), bucketName)
 	assert.Nil(t, err)
-
-	minioMgr := NewMinioChunkManager(kv)
 	path, err := minioMgr.GetPath("invalid")
 	assert.Empty(t, path)
 	assert.Error(t, err)
@@ -31,15 +32,13 @@ func TestMinioChunkManager_GetPath(t *testing.T) {
 
 func TestMinioChunkManager_ReadAt(t *testing.T) {
 	bucketName := "minio-chunk-manager"
-	kv, err := newMinIOKVClient(context.TODO(), bucketName)
+	minioMgr, err := newMinioChunkManager(context.TODO(), bucketName)
 	assert.Nil(t, err)
 
-	minioMgr := NewMinioChunkManager(kv)
-
 	key := "1"
 	bin := []byte{1, 2, 3}
 	content := make([]byte, 8)
-	err = minioMgr.minio.Remove(key)
+	err = minioMgr.Remove(key)
 	assert.Nil(t, err)
 
 	n, err := minioMgr.ReadAt(key, content, 0)
@@ -69,3 +68,100 @@ func TestMinioChunkManager_ReadAt(t *testing.T) {
 		assert.Equal(t, content[i-offset], bin[i])
 	}
 }
+
+func TestMinioChunkManager_MultiWriteRead(t *testing.T) {
+	bucketName := "minio-chunk-manager"
+	minioMgr, err := newMinioChunkManager(context.TODO(), bucketName)
+	assert.Nil(t, err)
+	prefix := "multi/"
+	defer minioMgr.RemoveWithPrefix(prefix)
+
+	contents := map[string][]byte{
+		prefix + "a/1":  {1},
+		prefix + "a/2":  {2, 2},
+		prefix + "ab/3": {3, 3, 3},
+	}
+	err = minioMgr.MultiWrite(contents)
+	assert.Nil(t, err)
+
+	res, err := minioMgr.MultiRead([]string{prefix + "a/1", prefix + "a/2"})
+	assert.Nil(t, err)
+	assert.Equal(t, [][]byte{{1}, {2, 2}}, res)
+
+	_, err = minioMgr.MultiRead([]string{prefix + "a/1", prefix + "invalid"})
+	assert.Error(t, err)
+
+	size, err := minioMgr.Size(prefix + "ab/3")
+	assert.Nil(t, err)
+	assert.Equal(t, int64(3), size)
+	_, err = minioMgr.Size(prefix + "invalid")
+	assert.Error(t, err)
+
+	keys, err := minioMgr.ListWithPrefix(prefix + "a")
+	assert.Nil(t, err)
+	assert.ElementsMatch(t, []string{prefix + "a/1", prefix + "a/2", prefix + "ab/3"}, keys)
+
+	keys, values, err := minioMgr.ReadWithPrefix(prefix + "a/")
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(keys))
+	for i, key := range keys {
+		assert.Equal(t, contents[key], values[i])
+	}
+
+	err = minioMgr.MultiRemove([]string{prefix + "a/1", prefix + "a/2"})
+	assert.Nil(t, err)
+	assert.False(t, minioMgr.Exist(prefix+"a/1"))
+
+	err = minioMgr.RemoveWithPrefix(prefix)
+	assert.Nil(t, err)
+	keys, err = minioMgr.ListWithPrefix(prefix)
+	assert.Nil(t, err)
+	assert.Empty(t, keys)
+}
+
+func TestMinioChunkManager_ReaderWriter(t *testing.T) {
+	bucketName := "minio-chunk-manager"
+	minioMgr, err := newMinioChunkManager(context.TODO(), bucketName)
+	assert.Nil(t, err)
+	key := "stream/key"
+	defer minioMgr.Remove(key)
+
+	_, err = minioMgr.Reader("stream/invalid")
+	assert.Error(t, err)
+
+	// larger than a part, so that the object is uploaded in multiple parts
+	content := make([]byte, minioPartSize+1024)
+	for i := range content {
+		content[i] = byte(i)
+	}
+	w, err := minioMgr.Writer(key)
+	assert.Nil(t, err)
+	_, err = io.Copy(w, bytes.NewReader(content))
+	assert.Nil(t, err)
+	err = w.Close()
+	assert.Nil(t, err)
+
+	size, err := minioMgr.Size(key)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(len(content)), size)
+
+	r, err := minioMgr.Reader(key)
+	assert.Nil(t, err)
+	res, err := ioutil.ReadAll(r)
+	assert.Nil(t, err)
+	assert.Equal(t, content, res)
+	assert.Nil(t, r.Close())
+
+	// the aborted object isn't created, in either a single part or multiple parts
+	abortedKey := "stream/aborted"
+	for _, size := range []int{1024, minioPartSize + 1024} {
+		w, err = minioMgr.Writer(abortedKey)
+		assert.Nil(t, err)
+		_, err = io.Copy(w, bytes.NewReader(content[:size]))
+		assert.Nil(t, err)
+		assert.Nil(t, w.Abort())
+		assert.False(t, minioMgr.Exist(abortedKey))
+		_, err = w.Write(content[:1])
+		assert.Error(t, err)
+	}
+}
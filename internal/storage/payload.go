@@ -14,13 +14,15 @@ package storage
 /*
 #cgo CFLAGS: -I${SRCDIR}/cwrapper
 
-#cgo LDFLAGS: -L${SRCDIR}/cwrapper/output -lwrapper -lparquet -larrow -lthrift -lutf8proc -lstdc++ -lm
+#cgo LDFLAGS: -L${SRCDIR}/cwrapper/output -lwrapper -lparquet -larrow -lthrift -lutf8proc -lzstd -llz4 -lstdc++ -lm
 #include <stdlib.h>
 #include "ParquetWrapper.h"
 */
 import "C"
 import (
 	"errors"
+	"fmt"
+	"strings"
 	"unsafe"
 
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
@@ -73,8 +75,63 @@ type PayloadReader struct {
 	colType          schemapb.DataType
 }
 
+// CompressionType is the codec to compress the payload, keep the same values as CompressionType in cwrapper
+type CompressionType int32
+
+const (
+	CompressionNone CompressionType = 0
+	CompressionZstd CompressionType = 1
+	CompressionLz4  CompressionType = 2
+)
+
+var compressionTypeNames = map[CompressionType]string{
+	CompressionNone: "none",
+	CompressionZstd: "zstd",
+	CompressionLz4:  "lz4",
+}
+
+func (c CompressionType) String() string {
+	if name, ok := compressionTypeNames[c]; ok {
+		return name
+	}
+	return fmt.Sprintf("CompressionType(%d)", int32(c))
+}
+
+// ParseCompressionType returns the CompressionType of the name, the name is one of none, zstd and lz4
+func ParseCompressionType(name string) (CompressionType, error) {
+	for c, n := range compressionTypeNames {
+		if strings.EqualFold(n, name) {
+			return c, nil
+		}
+	}
+	return CompressionNone, fmt.Errorf("unknown compression type %s", name)
+}
+
+// PayloadOptions is the compression and encoding options of a payload
+type PayloadOptions struct {
+	Compression      CompressionType
+	EnableDictionary bool
+}
+
+// DefaultPayloadOptions returns the options of the payloads written before the options are introduced,
+// which are uncompressed and dictionary encoded
+func DefaultPayloadOptions() PayloadOptions {
+	return PayloadOptions{
+		Compression:      CompressionNone,
+		EnableDictionary: true,
+	}
+}
+
 func NewPayloadWriter(colType schemapb.DataType) (*PayloadWriter, error) {
-	w := C.NewPayloadWriter(C.int(colType))
+	return NewPayloadWriterWithOptions(colType, DefaultPayloadOptions())
+}
+
+// NewPayloadWriterWithOptions creates a PayloadWriter compressing and encoding the payload as the options
+func NewPayloadWriterWithOptions(colType schemapb.DataType, opts PayloadOptions) (*PayloadWriter, error) {
+	if _, ok := compressionTypeNames[opts.Compression]; !ok {
+		return nil, fmt.Errorf("unknown compression type %d", opts.Compression)
+	}
+	w := C.NewPayloadWriterWithOptions(C.int(colType), C.int(opts.Compression), C.bool(opts.EnableDictionary))
 	if w == nil {
 		return nil, errors.New("create Payload writer failed")
 	}
@@ -892,3 +892,47 @@ func TestPayload_ReaderandWriter(t *testing.T) {
 		assert.NotNil(t, err)
 	})
 }
+
+func TestPayload_Options(t *testing.T) {
+	for _, opts := range []PayloadOptions{
+		{Compression: CompressionNone, EnableDictionary: false},
+		{Compression: CompressionZstd, EnableDictionary: true},
+		{Compression: CompressionLz4, EnableDictionary: false},
+	} {
+		w, err := NewPayloadWriterWithOptions(schemapb.DataType_Int64, opts)
+		require.Nil(t, err)
+		err = w.AddInt64ToPayload([]int64{1, 2, 3, 1, 2, 3})
+		assert.Nil(t, err)
+		err = w.FinishPayloadWriter()
+		assert.Nil(t, err)
+		buffer, err := w.GetPayloadBufferFromWriter()
+		assert.Nil(t, err)
+
+		r, err := NewPayloadReader(schemapb.DataType_Int64, buffer)
+		require.Nil(t, err)
+		int64s, err := r.GetInt64FromPayload()
+		assert.Nil(t, err)
+		assert.Equal(t, []int64{1, 2, 3, 1, 2, 3}, int64s)
+		r.ReleasePayloadReader()
+		w.ReleasePayloadWriter()
+	}
+
+	_, err := NewPayloadWriterWithOptions(schemapb.DataType_Int64, PayloadOptions{Compression: CompressionType(-1)})
+	assert.NotNil(t, err)
+}
+
+func TestParseCompressionType(t *testing.T) {
+	c, err := ParseCompressionType("ZSTD")
+	assert.Nil(t, err)
+	assert.Equal(t, CompressionZstd, c)
+	c, err = ParseCompressionType("lz4")
+	assert.Nil(t, err)
+	assert.Equal(t, CompressionLz4, c)
+	c, err = ParseCompressionType("none")
+	assert.Nil(t, err)
+	assert.Equal(t, CompressionNone, c)
+	assert.Equal(t, "zstd", CompressionZstd.String())
+
+	_, err = ParseCompressionType("gzip")
+	assert.NotNil(t, err)
+}
@@ -13,6 +13,17 @@ package storage
 
 import (
 	"encoding/json"
+	"math"
+	"strings"
+
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+)
+
+const (
+	// the keys of the extras in the descriptor event of insert binlogs
+	compressionKey = "compression"
+	dictionaryKey  = "dictionary"
+	fieldStatsKey  = "fieldStats"
 )
 
 type Int64Stats struct {
@@ -60,3 +71,158 @@ func (sr *StatsReader) GetInt64Stats() Int64Stats {
 	json.Unmarshal(sr.buffer, &stats)
 	return stats
 }
+
+// FieldStats is the statistics of a scalar field in a binlog. The bool and integer values are kept in
+// MinInt64 and MaxInt64, the float values in MinFloat64 and MaxFloat64, and the string values in MinString
+// and MaxString. HasMinMax is false if there is no non-null value or the float values are not all finite.
+type FieldStats struct {
+	FieldID    int64             `json:"fieldID"`
+	DataType   schemapb.DataType `json:"dataType"`
+	RowNum     int64             `json:"rowNum"`
+	NullCount  int64             `json:"nullCount"`
+	HasMinMax  bool              `json:"hasMinMax"`
+	MinInt64   int64             `json:"minInt64,omitempty"`
+	MaxInt64   int64             `json:"maxInt64,omitempty"`
+	MinFloat64 float64           `json:"minFloat64,omitempty"`
+	MaxFloat64 float64           `json:"maxFloat64,omitempty"`
+	MinString  string            `json:"minString,omitempty"`
+	MaxString  string            `json:"maxString,omitempty"`
+}
+
+func (stats *FieldStats) updateInt64(v int64) {
+	if !stats.HasMinMax {
+		stats.MinInt64, stats.MaxInt64 = v, v
+		stats.HasMinMax = true
+		return
+	}
+	if v < stats.MinInt64 {
+		stats.MinInt64 = v
+	}
+	if v > stats.MaxInt64 {
+		stats.MaxInt64 = v
+	}
+}
+
+func (stats *FieldStats) updateFloat64(v float64) {
+	if !stats.HasMinMax {
+		stats.MinFloat64, stats.MaxFloat64 = v, v
+		stats.HasMinMax = true
+		return
+	}
+	if v < stats.MinFloat64 {
+		stats.MinFloat64 = v
+	}
+	if v > stats.MaxFloat64 {
+		stats.MaxFloat64 = v
+	}
+}
+
+func (stats *FieldStats) updateString(v string) {
+	if !stats.HasMinMax {
+		stats.MinString, stats.MaxString = v, v
+		stats.HasMinMax = true
+		return
+	}
+	if strings.Compare(v, stats.MinString) < 0 {
+		stats.MinString = v
+	}
+	if strings.Compare(v, stats.MaxString) > 0 {
+		stats.MaxString = v
+	}
+}
+
+// NewFieldStats computes the statistics of the scalar field data, returns nil for vector fields
+func NewFieldStats(fieldID FieldID, dataType schemapb.DataType, data FieldData) *FieldStats {
+	stats := &FieldStats{
+		FieldID:  fieldID,
+		DataType: dataType,
+	}
+	finite := true
+	switch fieldData := data.(type) {
+	case *BoolFieldData:
+		for _, v := range fieldData.Data {
+			if v {
+				stats.updateInt64(1)
+			} else {
+				stats.updateInt64(0)
+			}
+		}
+		stats.RowNum = int64(len(fieldData.Data))
+	case *Int8FieldData:
+		for _, v := range fieldData.Data {
+			stats.updateInt64(int64(v))
+		}
+		stats.RowNum = int64(len(fieldData.Data))
+	case *Int16FieldData:
+		for _, v := range fieldData.Data {
+			stats.updateInt64(int64(v))
+		}
+		stats.RowNum = int64(len(fieldData.Data))
+	case *Int32FieldData:
+		for _, v := range fieldData.Data {
+			stats.updateInt64(int64(v))
+		}
+		stats.RowNum = int64(len(fieldData.Data))
+	case *Int64FieldData:
+		for _, v := range fieldData.Data {
+			stats.updateInt64(v)
+		}
+		stats.RowNum = int64(len(fieldData.Data))
+	case *FloatFieldData:
+		for _, v := range fieldData.Data {
+			f := float64(v)
+			finite = finite && !math.IsInf(f, 0) && !math.IsNaN(f)
+			stats.updateFloat64(f)
+		}
+		stats.RowNum = int64(len(fieldData.Data))
+	case *DoubleFieldData:
+		for _, v := range fieldData.Data {
+			finite = finite && !math.IsInf(v, 0) && !math.IsNaN(v)
+			stats.updateFloat64(v)
+		}
+		stats.RowNum = int64(len(fieldData.Data))
+	case *StringFieldData:
+		for _, v := range fieldData.Data {
+			stats.updateString(v)
+		}
+		stats.RowNum = int64(len(fieldData.Data))
+	default:
+		return nil
+	}
+	if !finite {
+		// the json encoding doesn't support the infinite and NaN values
+		stats.HasMinMax = false
+		stats.MinFloat64, stats.MaxFloat64 = 0, 0
+	}
+	return stats
+}
+
+// Merge merges the statistics of another binlog of the same field
+func (stats *FieldStats) Merge(other *FieldStats) {
+	if other.HasMinMax {
+		if !stats.HasMinMax && stats.RowNum-stats.NullCount > 0 {
+			// the min and max of the existing rows are unknown
+			stats.RowNum += other.RowNum
+			stats.NullCount += other.NullCount
+			return
+		}
+		switch stats.DataType {
+		case schemapb.DataType_Float, schemapb.DataType_Double:
+			stats.updateFloat64(other.MinFloat64)
+			stats.updateFloat64(other.MaxFloat64)
+		case schemapb.DataType_String:
+			stats.updateString(other.MinString)
+			stats.updateString(other.MaxString)
+		default:
+			stats.updateInt64(other.MinInt64)
+			stats.updateInt64(other.MaxInt64)
+		}
+	} else if other.RowNum-other.NullCount > 0 {
+		stats.HasMinMax = false
+		stats.MinInt64, stats.MaxInt64 = 0, 0
+		stats.MinFloat64, stats.MaxFloat64 = 0, 0
+		stats.MinString, stats.MaxString = "", ""
+	}
+	stats.RowNum += other.RowNum
+	stats.NullCount += other.NullCount
+}
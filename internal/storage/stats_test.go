@@ -12,8 +12,11 @@
 package storage
 
 import (
+	"math"
 	"testing"
 
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+
 	"github.com/stretchr/testify/assert"
 )
 
@@ -39,3 +42,42 @@ func TestStatsWriter_StatsInt64(t *testing.T) {
 	err = sw.StatsInt64(msgs)
 	assert.Nil(t, err)
 }
+
+func TestFieldStats(t *testing.T) {
+	stats := NewFieldStats(100, schemapb.DataType_Int32, &Int32FieldData{Data: []int32{3, -1, 5}})
+	assert.True(t, stats.HasMinMax)
+	assert.Equal(t, int64(3), stats.RowNum)
+	assert.Equal(t, int64(-1), stats.MinInt64)
+	assert.Equal(t, int64(5), stats.MaxInt64)
+
+	stats.Merge(NewFieldStats(100, schemapb.DataType_Int32, &Int32FieldData{Data: []int32{10, 2}}))
+	assert.True(t, stats.HasMinMax)
+	assert.Equal(t, int64(5), stats.RowNum)
+	assert.Equal(t, int64(-1), stats.MinInt64)
+	assert.Equal(t, int64(10), stats.MaxInt64)
+
+	stats = NewFieldStats(101, schemapb.DataType_Double, &DoubleFieldData{Data: []float64{1.5, -2.5}})
+	assert.Equal(t, -2.5, stats.MinFloat64)
+	assert.Equal(t, 1.5, stats.MaxFloat64)
+
+	// the min and max are unknown once a binlog has infinite values
+	stats.Merge(NewFieldStats(101, schemapb.DataType_Double, &DoubleFieldData{Data: []float64{math.Inf(1)}}))
+	assert.False(t, stats.HasMinMax)
+	assert.Equal(t, int64(3), stats.RowNum)
+	stats.Merge(NewFieldStats(101, schemapb.DataType_Double, &DoubleFieldData{Data: []float64{100}}))
+	assert.False(t, stats.HasMinMax)
+
+	stats = NewFieldStats(102, schemapb.DataType_String, &StringFieldData{Data: []string{"b", "a", "c"}})
+	assert.Equal(t, "a", stats.MinString)
+	assert.Equal(t, "c", stats.MaxString)
+
+	// empty binlog
+	stats = NewFieldStats(103, schemapb.DataType_Int64, &Int64FieldData{})
+	assert.False(t, stats.HasMinMax)
+	stats.Merge(NewFieldStats(103, schemapb.DataType_Int64, &Int64FieldData{Data: []int64{7}}))
+	assert.True(t, stats.HasMinMax)
+	assert.Equal(t, int64(7), stats.MinInt64)
+	assert.Equal(t, int64(7), stats.MaxInt64)
+
+	assert.Nil(t, NewFieldStats(104, schemapb.DataType_FloatVector, &FloatVectorFieldData{Data: []float32{1}, Dim: 1}))
+}
@@ -11,12 +11,54 @@
 
 package storage
 
+import (
+	"errors"
+	"io"
+)
+
+// errChunkWriterAborted is the error of the writes after the chunk writer is aborted
+var errChunkWriterAborted = errors.New("chunk writer aborted")
+
+// ChunkWriter writes a chunk in streaming, the chunk is saved when the writer is closed. The chunk isn't saved if
+// any write fails, Close returns the error of the write instead.
+type ChunkWriter interface {
+	io.WriteCloser
+	// Abort discards the written content without saving the chunk, it must be called on the error paths of the caller
+	// instead of Close. Abort after Close does nothing.
+	Abort() error
+}
+
 // ChunkManager is to manager chunks.
 // Include Read, Write, Remove chunks.
 type ChunkManager interface {
+	// GetPath returns the path of the chunk if exist.
 	GetPath(key string) (string, error)
+	// Size returns the size in bytes of the chunk.
+	Size(key string) (int64, error)
+	// Write writes the content to the chunk, the chunk is overwritten if exist.
 	Write(key string, content []byte) error
+	// MultiWrite writes the contents to the chunks, the first error is returned.
+	MultiWrite(contents map[string][]byte) error
+	// Exist checks whether the chunk exists.
 	Exist(key string) bool
+	// Read reads the whole chunk.
 	Read(key string) ([]byte, error)
+	// MultiRead reads the chunks in order, the first error is returned.
+	MultiRead(keys []string) ([][]byte, error)
+	// ReadAt reads len(p) bytes of the chunk from off, io.EOF is returned if fewer bytes are read.
 	ReadAt(key string, p []byte, off int64) (n int, err error)
+	// ListWithPrefix returns the keys of all the chunks with the prefix.
+	ListWithPrefix(prefix string) ([]string, error)
+	// ReadWithPrefix reads all the chunks with the prefix.
+	ReadWithPrefix(prefix string) ([]string, [][]byte, error)
+	// Reader opens the chunk for streaming read, the caller must close it.
+	Reader(key string) (io.ReadCloser, error)
+	// Writer creates the chunk for streaming write, the content is not saved until the writer is closed without error.
+	Writer(key string) (ChunkWriter, error)
+	// Remove deletes the chunk, no error is returned if the chunk does not exist.
+	Remove(key string) error
+	// MultiRemove deletes the chunks, the first error is returned.
+	MultiRemove(keys []string) error
+	// RemoveWithPrefix deletes all the chunks with the prefix.
+	RemoveWithPrefix(prefix string) error
 }
@@ -16,10 +16,13 @@ import (
 	"encoding/binary"
 	"errors"
 	"io"
+	"io/ioutil"
 
 	"github.com/milvus-io/milvus/internal/proto/etcdpb"
 )
 
+var _ ChunkManager = (*VectorChunkManager)(nil)
+
 // VectorChunkManager is responsible for read and write vector data.
 type VectorChunkManager struct {
 	localChunkManager  ChunkManager
@@ -100,6 +103,26 @@ func (vcm *VectorChunkManager) Write(key string, content []byte) error {
 	return vcm.localChunkManager.Write(key, content)
 }
 
+// Size returns the size of the pure vector data.
+func (vcm *VectorChunkManager) Size(key string) (int64, error) {
+	if vcm.localCacheEnable && vcm.localChunkManager.Exist(key) {
+		return vcm.localChunkManager.Size(key)
+	}
+	content, err := vcm.Read(key)
+	if err != nil {
+		return 0, err
+	}
+	return int64(len(content)), nil
+}
+
+// MultiWrite writes the vector data of multiple keys to local cache if cache enabled.
+func (vcm *VectorChunkManager) MultiWrite(contents map[string][]byte) error {
+	if !vcm.localCacheEnable {
+		return errors.New("Cannot write local file for local cache is not allowed")
+	}
+	return vcm.localChunkManager.MultiWrite(contents)
+}
+
 // Exist checks whether vector data is saved to local cache.
 func (vcm *VectorChunkManager) Exist(key string) bool {
 	return vcm.localChunkManager.Exist(key)
@@ -124,6 +147,73 @@ func (vcm *VectorChunkManager) Read(key string) ([]byte, error) {
 	return vcm.downloadVectorFile(key)
 }
 
+// MultiRead reads the pure vector data of multiple keys.
+func (vcm *VectorChunkManager) MultiRead(keys []string) ([][]byte, error) {
+	var resultErr error
+	results := make([][]byte, 0, len(keys))
+	for _, key := range keys {
+		content, err := vcm.Read(key)
+		if err != nil && resultErr == nil {
+			resultErr = err
+		}
+		results = append(results, content)
+	}
+	return results, resultErr
+}
+
+// ListWithPrefix returns the keys of the vector files in remote storage with the prefix.
+func (vcm *VectorChunkManager) ListWithPrefix(prefix string) ([]string, error) {
+	return vcm.remoteChunkManager.ListWithPrefix(prefix)
+}
+
+// ReadWithPrefix reads the pure vector data of the vector files with the prefix.
+func (vcm *VectorChunkManager) ReadWithPrefix(prefix string) ([]string, [][]byte, error) {
+	keys, err := vcm.ListWithPrefix(prefix)
+	if err != nil {
+		return nil, nil, err
+	}
+	contents, err := vcm.MultiRead(keys)
+	if err != nil {
+		return nil, nil, err
+	}
+	return keys, contents, nil
+}
+
+// Reader returns a reader of the pure vector data. If cached, it reads from local.
+func (vcm *VectorChunkManager) Reader(key string) (io.ReadCloser, error) {
+	if vcm.localCacheEnable && vcm.localChunkManager.Exist(key) {
+		return vcm.localChunkManager.Reader(key)
+	}
+	content, err := vcm.Read(key)
+	if err != nil {
+		return nil, err
+	}
+	return ioutil.NopCloser(bytes.NewReader(content)), nil
+}
+
+// Writer writes the vector data to local cache if cache enabled.
+func (vcm *VectorChunkManager) Writer(key string) (ChunkWriter, error) {
+	if !vcm.localCacheEnable {
+		return nil, errors.New("Cannot write local file for local cache is not allowed")
+	}
+	return vcm.localChunkManager.Writer(key)
+}
+
+// Remove deletes the vector data from local cache, the remote vector files are never changed.
+func (vcm *VectorChunkManager) Remove(key string) error {
+	return vcm.localChunkManager.Remove(key)
+}
+
+// MultiRemove deletes the vector data of multiple keys from local cache.
+func (vcm *VectorChunkManager) MultiRemove(keys []string) error {
+	return vcm.localChunkManager.MultiRemove(keys)
+}
+
+// RemoveWithPrefix deletes the vector data with the prefix from local cache.
+func (vcm *VectorChunkManager) RemoveWithPrefix(prefix string) error {
+	return vcm.localChunkManager.RemoveWithPrefix(prefix)
+}
+
 // ReadAt reads specific position data of vector. If cached, it reads from local.
 func (vcm *VectorChunkManager) ReadAt(key string, p []byte, off int64) (int, error) {
 	if vcm.localCacheEnable {
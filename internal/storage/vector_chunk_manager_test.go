@@ -26,7 +26,7 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
-func newMinIOKVClient(ctx context.Context, bucketName string) (*miniokv.MinIOKV, error) {
+func newMinioChunkManager(ctx context.Context, bucketName string) (*MinioChunkManager, error) {
 	endPoint, _ := Params.Load("_MinioAddress")
 	accessKeyID, _ := Params.Load("minio.accessKeyID")
 	secretAccessKey, _ := Params.Load("minio.secretAccessKey")
@@ -40,8 +40,7 @@ func newMinIOKVClient(ctx context.Context, bucketName string) (*miniokv.MinIOKV,
 		BucketName:        bucketName,
 		CreateBucket:      true,
 	}
-	client, err := miniokv.NewMinIOKV(ctx, option)
-	return client, err
+	return NewMinioChunkManager(ctx, option)
 }
 
 func initMeta() *etcdpb.CollectionMeta {
@@ -136,10 +135,9 @@ func buildVectorChunkManager(t *testing.T, localPath string, localCacheEnable bo
 	ctx, cancel := context.WithCancel(context.Background())
 
 	bucketName := "vector-chunk-manager"
-	minIOKV, err := newMinIOKVClient(ctx, bucketName)
+	rcm, err := newMinioChunkManager(ctx, bucketName)
 	assert.Nil(t, err)
 
-	rcm := NewMinioChunkManager(minIOKV)
 	lcm := NewLocalChunkManager(localPath)
 
 	meta := initMeta()
@@ -147,7 +145,7 @@ func buildVectorChunkManager(t *testing.T, localPath string, localCacheEnable bo
 	assert.NotNil(t, vcm)
 
 	var allCancel context.CancelFunc = func() {
-		err := minIOKV.RemoveWithPrefix("")
+		err := rcm.RemoveWithPrefix("")
 		assert.Nil(t, err)
 		cancel()
 	}
@@ -103,6 +103,11 @@ type IndexNode interface {
 	// CreateIndex receives request from IndexCoordinator to build an index.
 	// Index building is asynchronous, so when an index building request comes, IndexNode records the task and returns.
 	CreateIndex(ctx context.Context, req *indexpb.CreateIndexRequest) (*commonpb.Status, error)
+	// CancelIndexBuild aborts an index building task that is queued or in progress on IndexNode.
+	CancelIndexBuild(ctx context.Context, req *indexpb.CancelIndexBuildRequest) (*commonpb.Status, error)
+	// EstimateRecall searches vectors sampled from the raw data of a segment on its built index, and returns the
+	// recall of the results against brute force search.
+	EstimateRecall(ctx context.Context, req *indexpb.EstimateRecallRequest) (*indexpb.EstimateRecallResponse, error)
 	// GetMetrics gets the metrics about IndexNode.
 	GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error)
 }
@@ -125,6 +130,11 @@ type IndexCoord interface {
 	GetIndexStates(ctx context.Context, req *indexpb.GetIndexStatesRequest) (*indexpb.GetIndexStatesResponse, error)
 	// GetIndexFilePaths gets the index files of the IndexBuildIDs in the request from RootCoordinator.
 	GetIndexFilePaths(ctx context.Context, req *indexpb.GetIndexFilePathsRequest) (*indexpb.GetIndexFilePathsResponse, error)
+	// EstimateRecall queues a job estimating the recall of the finished index build in the request on an IndexNode,
+	// and returns the ID of the job.
+	EstimateRecall(ctx context.Context, req *indexpb.EstimateRecallRequest) (*indexpb.EstimateRecallResponse, error)
+	// GetRecallEstimations gets the states and the results of the recall estimation jobs.
+	GetRecallEstimations(ctx context.Context, req *indexpb.GetRecallEstimationsRequest) (*indexpb.GetRecallEstimationsResponse, error)
 	// GetMetrics gets the metrics about IndexCoord.
 	GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error)
 }
@@ -265,11 +275,47 @@ type RootCoord interface {
 	// error is always nil
 	DropIndex(ctx context.Context, req *milvuspb.DropIndexRequest) (*commonpb.Status, error)
 
+	// AlterIndex notifies RootCoord to rebuild the index of the specified field with new index parameters
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including database name(not used), collection name, field name and index parameters
+	//
+	// The index keeps serving until query nodes switch to the rebuilt index.
+	// The `ErrorCode` of `Status` is `Success` if the rebuild starts successfully;
+	// otherwise, the `ErrorCode` of `Status` will be `Error`, and the `Reason` of `Status` will record the fail cause.
+	// error is always nil
+	AlterIndex(ctx context.Context, req *milvuspb.AlterIndexRequest) (*commonpb.Status, error)
+
 	// collection alias
 	CreateAlias(ctx context.Context, req *milvuspb.CreateAliasRequest) (*commonpb.Status, error)
 	DropAlias(ctx context.Context, req *milvuspb.DropAliasRequest) (*commonpb.Status, error)
 	AlterAlias(ctx context.Context, req *milvuspb.AlterAliasRequest) (*commonpb.Status, error)
 
+	// credential
+	CreateCredential(ctx context.Context, req *internalpb.CredentialInfo) (*commonpb.Status, error)
+	UpdateCredential(ctx context.Context, req *internalpb.CredentialInfo) (*commonpb.Status, error)
+	DeleteCredential(ctx context.Context, req *milvuspb.DeleteCredentialRequest) (*commonpb.Status, error)
+	ListCredUsers(ctx context.Context, req *milvuspb.ListCredUsersRequest) (*milvuspb.ListCredUsersResponse, error)
+	// GetCredential returns the encrypted password of the specified user, used by proxy to authenticate requests
+	GetCredential(ctx context.Context, req *rootcoordpb.GetCredentialRequest) (*rootcoordpb.GetCredentialResponse, error)
+
+	// role based access control
+	CreateRole(ctx context.Context, req *milvuspb.CreateRoleRequest) (*commonpb.Status, error)
+	DropRole(ctx context.Context, req *milvuspb.DropRoleRequest) (*commonpb.Status, error)
+	OperateUserRole(ctx context.Context, req *milvuspb.OperateUserRoleRequest) (*commonpb.Status, error)
+	SelectRole(ctx context.Context, req *milvuspb.SelectRoleRequest) (*milvuspb.SelectRoleResponse, error)
+	SelectUser(ctx context.Context, req *milvuspb.SelectUserRequest) (*milvuspb.SelectUserResponse, error)
+	OperatePrivilege(ctx context.Context, req *milvuspb.OperatePrivilegeRequest) (*commonpb.Status, error)
+	SelectGrant(ctx context.Context, req *milvuspb.SelectGrantRequest) (*milvuspb.SelectGrantResponse, error)
+	// ListPolicy returns all the grants and user-role bindings, used by proxy to check privileges
+	ListPolicy(ctx context.Context, req *rootcoordpb.ListPolicyRequest) (*rootcoordpb.ListPolicyResponse, error)
+
+	// ListMsgTopics returns the topics and subscriptions created by milvus in the message queue
+	ListMsgTopics(ctx context.Context, req *rootcoordpb.ListMsgTopicsRequest) (*rootcoordpb.ListMsgTopicsResponse, error)
+	// PurgeMsgTopics deletes the topics and subscriptions no longer referenced by dml channels or query channels,
+	// together with the subscriptions of the referenced topics without any connected consumer
+	PurgeMsgTopics(ctx context.Context, req *rootcoordpb.PurgeMsgTopicsRequest) (*rootcoordpb.PurgeMsgTopicsResponse, error)
+
 	//global timestamp allocator
 	AllocTimestamp(ctx context.Context, req *rootcoordpb.AllocTimestampRequest) (*rootcoordpb.AllocTimestampResponse, error)
 	AllocID(ctx context.Context, req *rootcoordpb.AllocIDRequest) (*rootcoordpb.AllocIDResponse, error)
@@ -327,6 +373,40 @@ type Proxy interface {
 	//
 	// error is returned only when some communication issue occurs.
 	ReleaseDQLMessageStream(ctx context.Context, in *proxypb.ReleaseDQLMessageStreamRequest) (*commonpb.Status, error)
+
+	// InvalidateCredentialCache notifies Proxy to clear the cached credential of specific user.
+	//
+	// InvalidateCredentialCache should be called when the password of a user is updated or the user is deleted.
+	//
+	// ctx is the request to control request deadline and cancellation.
+	// request contains the request params, which is the username.
+	//
+	// InvalidateCredentialCache should always succeed even though the specific user doesn't exist in Proxy.
+	// So the code of response `Status` should be always `Success`.
+	//
+	// error is returned only when some communication issue occurs.
+	InvalidateCredentialCache(ctx context.Context, request *proxypb.InvalidateCredCacheRequest) (*commonpb.Status, error)
+
+	// InvalidatePolicyInfoCache notifies Proxy to clear the cached grants and user-role bindings.
+	//
+	// InvalidatePolicyInfoCache should be called when any role, user-role binding or grant changes.
+	//
+	// ctx is the request to control request deadline and cancellation.
+	//
+	// InvalidatePolicyInfoCache should always succeed, the code of response `Status` should be always `Success`.
+	//
+	// error is returned only when some communication issue occurs.
+	InvalidatePolicyInfoCache(ctx context.Context, request *proxypb.InvalidatePolicyInfoCacheRequest) (*commonpb.Status, error)
+
+	// SetQuotaFactors notifies Proxy to throttle dml and dql requests by the factors computed by the quota center.
+	//
+	// ctx is the request to control request deadline and cancellation.
+	// request contains the write factor and the read factor, a factor in [0, 1] scales the rate limits of Proxy,
+	// and 0 means the requests are rejected.
+	//
+	// The `ErrorCode` of `Status` is `Success` if the factors are applied.
+	// error is returned only when some communication issue occurs.
+	SetQuotaFactors(ctx context.Context, request *proxypb.SetQuotaFactorsRequest) (*commonpb.Status, error)
 }
 
 // QueryNode is the interface `querynode` package implements
@@ -349,6 +429,9 @@ type QueryNode interface {
 	ReleaseCollection(ctx context.Context, req *querypb.ReleaseCollectionRequest) (*commonpb.Status, error)
 	ReleasePartitions(ctx context.Context, req *querypb.ReleasePartitionsRequest) (*commonpb.Status, error)
 	ReleaseSegments(ctx context.Context, req *querypb.ReleaseSegmentsRequest) (*commonpb.Status, error)
+	// SwapSegmentIndex notifies QueryNode to switch the index loaded on the sealed segment to the rebuilt index.
+	// Return IndexNotExist code in status if the rebuilt index isn't ready on the segment yet.
+	SwapSegmentIndex(ctx context.Context, req *querypb.SwapSegmentIndexRequest) (*commonpb.Status, error)
 	GetSegmentInfo(ctx context.Context, req *querypb.GetSegmentInfoRequest) (*querypb.GetSegmentInfoResponse, error)
 
 	GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error)
@@ -0,0 +1,47 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package crypto
+
+import (
+	"encoding/base64"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// PasswordEncrypt encrypts the raw password with bcrypt, the result is safe to be stored in etcd.
+func PasswordEncrypt(pwd string) (string, error) {
+	bytes, err := bcrypt.GenerateFromPassword([]byte(pwd), bcrypt.DefaultCost)
+	if err != nil {
+		return "", err
+	}
+	return string(bytes), nil
+}
+
+// PasswordVerify checks whether the raw password matches the bcrypt encrypted one.
+func PasswordVerify(rawPwd, encryptedPwd string) bool {
+	err := bcrypt.CompareHashAndPassword([]byte(encryptedPwd), []byte(rawPwd))
+	return err == nil
+}
+
+// Base64Encode encodes the input string with standard base64 encoding.
+func Base64Encode(pwd string) string {
+	return base64.StdEncoding.EncodeToString([]byte(pwd))
+}
+
+// Base64Decode decodes the standard base64 encoded string.
+func Base64Decode(pwd string) (string, error) {
+	bytes, err := base64.StdEncoding.DecodeString(pwd)
+	if err != nil {
+		return "", err
+	}
+	return string(bytes), nil
+}
@@ -0,0 +1,44 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package crypto
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPasswordVerify(t *testing.T) {
+	wrongPassword := "test_my_name"
+	correctPassword := "test_my_pass_new"
+
+	encrypted, err := PasswordEncrypt(correctPassword)
+	assert.Nil(t, err)
+	assert.NotEqual(t, correctPassword, encrypted)
+
+	assert.True(t, PasswordVerify(correctPassword, encrypted))
+	assert.False(t, PasswordVerify(wrongPassword, encrypted))
+	assert.False(t, PasswordVerify(correctPassword, "not-a-bcrypt-hash"))
+}
+
+func TestBase64(t *testing.T) {
+	raw := "root:Milvus"
+	encoded := Base64Encode(raw)
+	assert.Equal(t, "cm9vdDpNaWx2dXM=", encoded)
+
+	decoded, err := Base64Decode(encoded)
+	assert.Nil(t, err)
+	assert.Equal(t, raw, decoded)
+
+	_, err = Base64Decode("%%%")
+	assert.NotNil(t, err)
+}
@@ -14,6 +14,7 @@ package flowgraph
 import (
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/msgstream"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/util/trace"
 	"github.com/opentracing/opentracing-go"
 	oplog "github.com/opentracing/opentracing-go/log"
@@ -25,6 +26,7 @@ type InputNode struct {
 	BaseNode
 	inStream msgstream.MsgStream
 	name     string
+	dedup    *msgDeduplicator
 }
 
 // IsInputNode returns whether Node is InputNode
@@ -54,6 +56,26 @@ func (inNode *InputNode) InStream() msgstream.MsgStream {
 	return inNode.inStream
 }
 
+// SetDedupWindow enables dropping the dml messages with the same identity as one of the latest
+// window messages, a non-positive window disables the deduplication
+func (inNode *InputNode) SetDedupWindow(window int) {
+	if window <= 0 {
+		inNode.dedup = nil
+		return
+	}
+	inNode.dedup = newMsgDeduplicator(window)
+}
+
+// SeedDedup adds the last dml messages recorded in the position to the deduplication window, the position is
+// the checkpoint the stream seeks to, so that the messages consumed before it are dropped if produced again after it.
+// It must be called after SetDedupWindow and before the node starts.
+func (inNode *InputNode) SeedDedup(position *internalpb.MsgPosition) {
+	if inNode.dedup == nil || position == nil {
+		return
+	}
+	inNode.dedup.seed(position.GetDedupMsgs())
+}
+
 // empty input and return one *Msg
 func (inNode *InputNode) Operate(in []Msg) []Msg {
 	msgPack := inNode.inStream.Consume()
@@ -62,6 +84,15 @@ func (inNode *InputNode) Operate(in []Msg) []Msg {
 	if msgPack == nil {
 		return nil
 	}
+	if inNode.dedup != nil {
+		msgPack.Msgs = inNode.dedup.filter(msgPack.Msgs)
+		// the end positions are persisted as the checkpoints by the consumers, from which the window is seeded
+		// after seeking to them
+		dedupMsgs := inNode.dedup.lastMsgs()
+		for _, pos := range msgPack.EndPositions {
+			pos.DedupMsgs = dedupMsgs
+		}
+	}
 	var spans []opentracing.Span
 	for _, msg := range msgPack.Msgs {
 		sp, ctx := trace.StartSpanFromContext(msg.TraceCtx())
@@ -0,0 +1,116 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package flowgraph
+
+import (
+	"sort"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/msgstream"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"go.uber.org/zap"
+)
+
+// msgKey is the identity of a dml message assigned by its producer
+type msgKey struct {
+	sourceID int64
+	msgID    msgstream.UniqueID
+}
+
+// msgDeduplicator remembers the identities of the latest dml messages, the messages replayed or
+// produced repeatedly within the window are dropped
+type msgDeduplicator struct {
+	window int
+	keys   []msgKey
+	next   int
+	seen   map[msgKey]struct{}
+	// last is the msgID of the latest message of each source in the window
+	last map[int64]msgstream.UniqueID
+}
+
+func newMsgDeduplicator(window int) *msgDeduplicator {
+	return &msgDeduplicator{
+		window: window,
+		keys:   make([]msgKey, 0, window),
+		seen:   make(map[msgKey]struct{}, window),
+		last:   make(map[int64]msgstream.UniqueID),
+	}
+}
+
+// needDedup returns whether the message carries an identity to deduplicate by
+func needDedup(msg msgstream.TsMsg) bool {
+	switch msg.Type() {
+	case commonpb.MsgType_Insert, commonpb.MsgType_Delete:
+		// msgID 0 means the producer doesn't assign an identity to the message
+		return msg.ID() != 0
+	default:
+		return false
+	}
+}
+
+// add records the key, returns false if the key is already in the window
+func (d *msgDeduplicator) add(key msgKey) bool {
+	if _, ok := d.seen[key]; ok {
+		return false
+	}
+	if len(d.keys) < d.window {
+		d.keys = append(d.keys, key)
+	} else {
+		evicted := d.keys[d.next]
+		delete(d.seen, evicted)
+		if last, ok := d.last[evicted.sourceID]; ok && last == evicted.msgID {
+			delete(d.last, evicted.sourceID)
+		}
+		d.keys[d.next] = key
+		d.next = (d.next + 1) % d.window
+	}
+	d.seen[key] = struct{}{}
+	d.last[key.sourceID] = key.msgID
+	return true
+}
+
+// lastMsgs returns the identities of the latest message of each source in the window, ordered by source
+func (d *msgDeduplicator) lastMsgs() []*internalpb.MsgIdentity {
+	ret := make([]*internalpb.MsgIdentity, 0, len(d.last))
+	for sourceID, msgID := range d.last {
+		ret = append(ret, &internalpb.MsgIdentity{SourceID: sourceID, MsgID: msgID})
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].SourceID < ret[j].SourceID
+	})
+	return ret
+}
+
+// seed adds the identities of the messages consumed before, so that they are dropped if replayed
+func (d *msgDeduplicator) seed(msgs []*internalpb.MsgIdentity) {
+	for _, msg := range msgs {
+		d.add(msgKey{sourceID: msg.GetSourceID(), msgID: msg.GetMsgID()})
+	}
+}
+
+// filter returns the messages not seen within the window
+func (d *msgDeduplicator) filter(msgs []msgstream.TsMsg) []msgstream.TsMsg {
+	ret := make([]msgstream.TsMsg, 0, len(msgs))
+	for _, msg := range msgs {
+		if needDedup(msg) && !d.add(msgKey{sourceID: msg.SourceID(), msgID: msg.ID()}) {
+			log.Warn("drop duplicated dml message",
+				zap.String("type", msg.Type().String()),
+				zap.Int64("sourceID", msg.SourceID()),
+				zap.Int64("msgID", msg.ID()),
+				zap.Uint64("timestamp", msg.BeginTs()))
+			continue
+		}
+		ret = append(ret, msg)
+	}
+	return ret
+}
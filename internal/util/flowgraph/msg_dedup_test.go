@@ -0,0 +1,172 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package flowgraph
+
+import (
+	"testing"
+
+	"github.com/milvus-io/milvus/internal/msgstream"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/stretchr/testify/assert"
+)
+
+func newDedupInsertMsg(sourceID int64, msgID msgstream.UniqueID) msgstream.TsMsg {
+	return &msgstream.InsertMsg{
+		InsertRequest: internalpb.InsertRequest{
+			Base: &commonpb.MsgBase{
+				MsgType:  commonpb.MsgType_Insert,
+				MsgID:    msgID,
+				SourceID: sourceID,
+			},
+		},
+	}
+}
+
+func newDedupDeleteMsg(sourceID int64, msgID msgstream.UniqueID) msgstream.TsMsg {
+	return &msgstream.DeleteMsg{
+		DeleteRequest: internalpb.DeleteRequest{
+			Base: &commonpb.MsgBase{
+				MsgType:  commonpb.MsgType_Delete,
+				MsgID:    msgID,
+				SourceID: sourceID,
+			},
+		},
+	}
+}
+
+func TestMsgDeduplicator_Filter(t *testing.T) {
+	d := newMsgDeduplicator(3)
+
+	msgs := d.filter([]msgstream.TsMsg{
+		newDedupInsertMsg(1, 1),
+		newDedupInsertMsg(1, 1),
+		newDedupInsertMsg(2, 1),
+		newDedupDeleteMsg(1, 2),
+	})
+	assert.Equal(t, 3, len(msgs))
+
+	// replayed messages are dropped
+	msgs = d.filter([]msgstream.TsMsg{
+		newDedupInsertMsg(1, 1),
+		newDedupDeleteMsg(1, 2),
+		newDedupInsertMsg(1, 3),
+	})
+	assert.Equal(t, 1, len(msgs))
+	assert.Equal(t, msgstream.UniqueID(3), msgs[0].ID())
+
+	// (1, 1) is evicted from the window
+	msgs = d.filter([]msgstream.TsMsg{newDedupInsertMsg(1, 1)})
+	assert.Equal(t, 1, len(msgs))
+	assert.Equal(t, 3, len(d.keys))
+	assert.Equal(t, 3, len(d.seen))
+}
+
+func TestMsgDeduplicator_Skip(t *testing.T) {
+	d := newMsgDeduplicator(10)
+
+	// messages without identity are never dropped
+	msgs := d.filter([]msgstream.TsMsg{
+		newDedupInsertMsg(1, 0),
+		newDedupInsertMsg(1, 0),
+	})
+	assert.Equal(t, 2, len(msgs))
+
+	tt := &msgstream.TimeTickMsg{
+		TimeTickMsg: internalpb.TimeTickMsg{
+			Base: &commonpb.MsgBase{
+				MsgType:  commonpb.MsgType_TimeTick,
+				MsgID:    1,
+				SourceID: 1,
+			},
+		},
+	}
+	msgs = d.filter([]msgstream.TsMsg{tt, tt})
+	assert.Equal(t, 2, len(msgs))
+	assert.Equal(t, 0, len(d.seen))
+}
+
+func TestInputNode_SetDedupWindow(t *testing.T) {
+	node := NewInputNode(nil, "input_node", 0, 100)
+	assert.Nil(t, node.dedup)
+	node.SetDedupWindow(10)
+	assert.NotNil(t, node.dedup)
+	node.SetDedupWindow(0)
+	assert.Nil(t, node.dedup)
+}
+
+func TestMsgDeduplicator_LastMsgs(t *testing.T) {
+	d := newMsgDeduplicator(3)
+	d.filter([]msgstream.TsMsg{
+		newDedupInsertMsg(2, 1),
+		newDedupInsertMsg(1, 2),
+		newDedupInsertMsg(1, 3),
+	})
+	assert.Equal(t, []*internalpb.MsgIdentity{
+		{SourceID: 1, MsgID: 3},
+		{SourceID: 2, MsgID: 1},
+	}, d.lastMsgs())
+
+	// the source is forgotten once its last message is evicted
+	d.filter([]msgstream.TsMsg{newDedupInsertMsg(1, 4)})
+	assert.Equal(t, []*internalpb.MsgIdentity{{SourceID: 1, MsgID: 4}}, d.lastMsgs())
+
+	// the seeded messages are dropped
+	d = newMsgDeduplicator(3)
+	d.seed([]*internalpb.MsgIdentity{{SourceID: 1, MsgID: 4}})
+	msgs := d.filter([]msgstream.TsMsg{
+		newDedupInsertMsg(1, 4),
+		newDedupInsertMsg(1, 5),
+	})
+	assert.Equal(t, 1, len(msgs))
+	assert.Equal(t, msgstream.UniqueID(5), msgs[0].ID())
+}
+
+// packStream returns the same pack on every Consume
+type packStream struct {
+	msgstream.MsgStream
+	pack *msgstream.MsgPack
+}
+
+func (s *packStream) Consume() *msgstream.MsgPack {
+	return s.pack
+}
+
+func TestInputNode_SeedDedup(t *testing.T) {
+	stream := &packStream{}
+	node := NewInputNode(stream, "input_node", 0, 100)
+	// no window to seed
+	node.SeedDedup(&internalpb.MsgPosition{DedupMsgs: []*internalpb.MsgIdentity{{SourceID: 1, MsgID: 1}}})
+
+	node.SetDedupWindow(10)
+	node.SeedDedup(nil)
+	node.SeedDedup(&internalpb.MsgPosition{DedupMsgs: []*internalpb.MsgIdentity{{SourceID: 1, MsgID: 1}}})
+
+	endPosition := &internalpb.MsgPosition{ChannelName: "ch"}
+	stream.pack = &msgstream.MsgPack{
+		Msgs: []msgstream.TsMsg{
+			newDedupInsertMsg(1, 1),
+			newDedupInsertMsg(1, 2),
+			newDedupInsertMsg(2, 1),
+		},
+		EndPositions: []*internalpb.MsgPosition{endPosition},
+	}
+	out := node.Operate(nil)
+	assert.Equal(t, 1, len(out))
+	assert.Equal(t, 2, len(out[0].(*MsgStreamMsg).TsMessages()))
+
+	// the last messages are recorded in the end positions
+	assert.Equal(t, []*internalpb.MsgIdentity{
+		{SourceID: 1, MsgID: 2},
+		{SourceID: 2, MsgID: 1},
+	}, endPosition.DedupMsgs)
+}
@@ -0,0 +1,168 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package indexparamcheck
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+)
+
+const (
+	// SearchLevel is the search param trading recall for latency on any index type, mapped to nprobe or ef
+	SearchLevel    = "level"
+	MinSearchLevel = 1
+	MaxSearchLevel = 5
+
+	NPROBE = "nprobe"
+	EF     = "ef"
+
+	// nlistPerSqrtRows is the number of ivf clusters chosen per square root of rows
+	nlistPerSqrtRows = 4
+	// levelNProbeDivisor makes level 1 probe 1/64 of the clusters, doubled by each level
+	levelNProbeDivisor = 64
+	// levelBaseEf is the ef of level 1, doubled by each level
+	levelBaseEf = 32
+)
+
+// AutoIndexConfig is the rules of choosing the concrete index of AUTOINDEX
+type AutoIndexConfig struct {
+	// FlatMaxRows is the max rows of collections searched by brute force
+	FlatMaxRows int64
+	// HNSWMaxRows is the max rows of collections indexed by HNSW, larger ones are indexed by IVF_SQ8
+	HNSWMaxRows int64
+	// HNSWM is the M of HNSW, 0 to choose by the dimension
+	HNSWM int
+	// HNSWEfConstruction is the efConstruction of HNSW
+	HNSWEfConstruction int
+}
+
+// DefaultAutoIndexConfig returns the rules used if milvus.yaml doesn't override them
+func DefaultAutoIndexConfig() AutoIndexConfig {
+	return AutoIndexConfig{
+		FlatMaxRows:        100000,
+		HNSWMaxRows:        5000000,
+		HNSWM:              0,
+		HNSWEfConstruction: 200,
+	}
+}
+
+// ResolveAutoIndex chooses the concrete index type and build params of AUTOINDEX by the rows of the collection,
+// the dimension, data type and metric type of the vector field. rows is 0 if the collection is empty, in which case
+// the index is chosen for a collection growing to a medium size rather than brute force.
+// An empty metric type is resolved to L2 for float vectors and JACCARD for binary vectors.
+func ResolveAutoIndex(cfg AutoIndexConfig, rows int64, dim int64, dataType schemapb.DataType, metricType string) (map[string]string, error) {
+	params := make(map[string]string)
+	small := rows > 0 && rows <= cfg.FlatMaxRows
+	nlist := strconv.Itoa(autoNList(rows))
+
+	switch dataType {
+	case schemapb.DataType_BinaryVector:
+		if metricType == "" {
+			metricType = JACCARD
+		}
+		if small {
+			params[IndexTypeKey] = IndexFaissBinIDMap
+		} else {
+			params[IndexTypeKey] = IndexFaissBinIvfFlat
+			params[NLIST] = nlist
+		}
+	case schemapb.DataType_FloatVector:
+		if metricType == "" {
+			metricType = L2
+		}
+		switch {
+		case small:
+			params[IndexTypeKey] = IndexFaissIDMap
+		case rows <= cfg.HNSWMaxRows:
+			params[IndexTypeKey] = IndexHNSW
+			params[HNSWM] = strconv.Itoa(autoHNSWM(cfg.HNSWM, dim))
+			params[EFConstruction] = strconv.Itoa(cfg.HNSWEfConstruction)
+		default:
+			params[IndexTypeKey] = IndexFaissIvfSQ8
+			params[NLIST] = nlist
+		}
+	default:
+		return nil, fmt.Errorf("%s is only supported on vector fields", IndexAutoIndex)
+	}
+	params[Metric] = metricType
+
+	adapter, err := GetConfAdapterMgrInstance().GetAdapter(params[IndexTypeKey])
+	if err != nil {
+		return nil, err
+	}
+	// some adapters fill default params into the checked params
+	checked := make(map[string]string, len(params))
+	for k, v := range params {
+		checked[k] = v
+	}
+	if !adapter.CheckTrain(checked) {
+		return nil, fmt.Errorf("invalid %s params %v resolved by %s", params[IndexTypeKey], params, IndexAutoIndex)
+	}
+	return params, nil
+}
+
+// autoNList returns the number of ivf clusters for the rows
+func autoNList(rows int64) int {
+	nlist := int(nlistPerSqrtRows * math.Sqrt(float64(rows)))
+	if nlist < MinNList {
+		return MinNList
+	}
+	if nlist > MaxNList {
+		return MaxNList
+	}
+	return nlist
+}
+
+// autoHNSWM returns the configured M of HNSW, or the M chosen by the dimension if it's not configured
+func autoHNSWM(m int, dim int64) int {
+	if m > 0 {
+		return m
+	}
+	if dim <= 128 {
+		return 16
+	}
+	return 32
+}
+
+// SearchParamsByLevel returns the search params of the index mapped from the search level, nprobe for ivf indexes,
+// ef for hnsw indexes and no params for brute force indexes. Return error if the index type doesn't support levels.
+func SearchParamsByLevel(indexParams map[string]string, level int, topK int64) (map[string]string, error) {
+	if level < MinSearchLevel || level > MaxSearchLevel {
+		return nil, fmt.Errorf("search level %d out of range [%d, %d]", level, MinSearchLevel, MaxSearchLevel)
+	}
+	scale := 1 << (level - 1)
+
+	indexType := indexParams[IndexTypeKey]
+	switch indexType {
+	case IndexFaissIDMap, IndexFaissBinIDMap:
+		return map[string]string{}, nil
+	case IndexFaissIvfFlat, IndexFaissIvfPQ, IndexFaissIvfSQ8, IndexFaissIvfSQ8H, IndexFaissBinIvfFlat:
+		nlist, err := strconv.Atoi(indexParams[NLIST])
+		if err != nil || nlist < MinNList {
+			return nil, fmt.Errorf("invalid nlist of index %s: %s", indexType, indexParams[NLIST])
+		}
+		// never more than nlist since the scale of the max level is less than the divisor
+		nprobe := (nlist*scale + levelNProbeDivisor - 1) / levelNProbeDivisor
+		return map[string]string{NPROBE: strconv.Itoa(nprobe)}, nil
+	case IndexHNSW, IndexRHNSWFlat, IndexRHNSWPQ, IndexRHNSWSQ:
+		ef := int64(levelBaseEf * scale)
+		if ef < topK {
+			ef = topK
+		}
+		return map[string]string{EF: strconv.FormatInt(ef, 10)}, nil
+	default:
+		return nil, fmt.Errorf("search level is not supported by index %s", indexType)
+	}
+}
@@ -0,0 +1,120 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package indexparamcheck
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+)
+
+func TestResolveAutoIndex(t *testing.T) {
+	cfg := DefaultAutoIndexConfig()
+
+	t.Run("small collection", func(t *testing.T) {
+		params, err := ResolveAutoIndex(cfg, 1000, 128, schemapb.DataType_FloatVector, IP)
+		assert.NoError(t, err)
+		assert.Equal(t, IndexFaissIDMap, params[IndexTypeKey])
+		assert.Equal(t, IP, params[Metric])
+
+		params, err = ResolveAutoIndex(cfg, 1000, 128, schemapb.DataType_BinaryVector, "")
+		assert.NoError(t, err)
+		assert.Equal(t, IndexFaissBinIDMap, params[IndexTypeKey])
+		assert.Equal(t, JACCARD, params[Metric])
+	})
+
+	t.Run("medium collection", func(t *testing.T) {
+		params, err := ResolveAutoIndex(cfg, 1000000, 128, schemapb.DataType_FloatVector, "")
+		assert.NoError(t, err)
+		assert.Equal(t, IndexHNSW, params[IndexTypeKey])
+		assert.Equal(t, L2, params[Metric])
+		assert.Equal(t, "16", params[HNSWM])
+		assert.Equal(t, "200", params[EFConstruction])
+
+		params, err = ResolveAutoIndex(cfg, 1000000, 768, schemapb.DataType_FloatVector, "")
+		assert.NoError(t, err)
+		assert.Equal(t, "32", params[HNSWM])
+
+		// empty collections are indexed for growing
+		params, err = ResolveAutoIndex(cfg, 0, 128, schemapb.DataType_FloatVector, "")
+		assert.NoError(t, err)
+		assert.Equal(t, IndexHNSW, params[IndexTypeKey])
+
+		cfg := cfg
+		cfg.HNSWM = 48
+		params, err = ResolveAutoIndex(cfg, 1000000, 128, schemapb.DataType_FloatVector, "")
+		assert.NoError(t, err)
+		assert.Equal(t, "48", params[HNSWM])
+	})
+
+	t.Run("large collection", func(t *testing.T) {
+		params, err := ResolveAutoIndex(cfg, 100000000, 128, schemapb.DataType_FloatVector, "")
+		assert.NoError(t, err)
+		assert.Equal(t, IndexFaissIvfSQ8, params[IndexTypeKey])
+		assert.Equal(t, "40000", params[NLIST])
+		_, ok := params[NBITS]
+		assert.False(t, ok)
+
+		params, err = ResolveAutoIndex(cfg, 1000000, 128, schemapb.DataType_BinaryVector, HAMMING)
+		assert.NoError(t, err)
+		assert.Equal(t, IndexFaissBinIvfFlat, params[IndexTypeKey])
+		assert.Equal(t, "4000", params[NLIST])
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := ResolveAutoIndex(cfg, 1000, 128, schemapb.DataType_Int64, "")
+		assert.Error(t, err)
+
+		_, err = ResolveAutoIndex(cfg, 1000000, 128, schemapb.DataType_FloatVector, HAMMING)
+		assert.Error(t, err)
+
+		cfg := cfg
+		cfg.HNSWEfConstruction = HNSWMaxEfConstruction + 1
+		_, err = ResolveAutoIndex(cfg, 1000000, 128, schemapb.DataType_FloatVector, "")
+		assert.Error(t, err)
+	})
+}
+
+func TestSearchParamsByLevel(t *testing.T) {
+	ivf := map[string]string{IndexTypeKey: IndexFaissIvfFlat, NLIST: "1024"}
+	params, err := SearchParamsByLevel(ivf, 1, 10)
+	assert.NoError(t, err)
+	assert.Equal(t, "16", params[NPROBE])
+	params, err = SearchParamsByLevel(ivf, 5, 10)
+	assert.NoError(t, err)
+	assert.Equal(t, "256", params[NPROBE])
+
+	params, err = SearchParamsByLevel(map[string]string{IndexTypeKey: IndexFaissIvfSQ8, NLIST: "8"}, MaxSearchLevel, 10)
+	assert.NoError(t, err)
+	assert.Equal(t, "2", params[NPROBE])
+
+	hnsw := map[string]string{IndexTypeKey: IndexHNSW}
+	params, err = SearchParamsByLevel(hnsw, 2, 10)
+	assert.NoError(t, err)
+	assert.Equal(t, "64", params[EF])
+	params, err = SearchParamsByLevel(hnsw, 1, 100)
+	assert.NoError(t, err)
+	assert.Equal(t, "100", params[EF])
+
+	params, err = SearchParamsByLevel(map[string]string{IndexTypeKey: IndexFaissIDMap}, 3, 10)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(params))
+
+	_, err = SearchParamsByLevel(hnsw, MaxSearchLevel+1, 10)
+	assert.Error(t, err)
+	_, err = SearchParamsByLevel(map[string]string{IndexTypeKey: IndexFaissIvfPQ}, 1, 10)
+	assert.Error(t, err)
+	_, err = SearchParamsByLevel(map[string]string{IndexTypeKey: IndexANNOY}, 1, 10)
+	assert.Error(t, err)
+}
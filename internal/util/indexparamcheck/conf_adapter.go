@@ -81,9 +81,17 @@ const (
 	OutgoingEdgeSize = "outgoing_edge_size"
 	IncomingEdgeSize = "incoming_edge_size"
 
+	IndexTypeKey = "index_type"
+
 	IndexMode = "index_mode"
 	CPUMode   = "CPU"
 	GPUMode   = "GPU"
+
+	// BitmapCardinality is the max number of distinct values a bitmap index keeps a bitmap for
+	BitmapCardinality        = "bitmap_cardinality_limit"
+	MinBitmapCardinality     = 1
+	MaxBitmapCardinality     = 65536
+	DefaultBitmapCardinality = 1024
 )
 
 var METRICS = []string{L2, IP}                                                             // const
@@ -457,3 +465,35 @@ func (adapter *NGTONNGConfAdapter) CheckTrain(params map[string]string) bool {
 func newNGTONNGConfAdapter() *NGTONNGConfAdapter {
 	return &NGTONNGConfAdapter{}
 }
+
+// ScalarConfAdapter checks the params of scalar indexes, which need no train params
+type ScalarConfAdapter struct {
+}
+
+func (adapter *ScalarConfAdapter) CheckTrain(params map[string]string) bool {
+	// metric type makes no sense for scalar fields
+	_, ok := params[Metric]
+	return !ok
+}
+
+func newScalarConfAdapter() *ScalarConfAdapter {
+	return &ScalarConfAdapter{}
+}
+
+type BitmapConfAdapter struct {
+	ScalarConfAdapter
+}
+
+func (adapter *BitmapConfAdapter) CheckTrain(params map[string]string) bool {
+	if _, ok := params[BitmapCardinality]; ok {
+		if !CheckIntByRange(params, BitmapCardinality, MinBitmapCardinality, MaxBitmapCardinality) {
+			return false
+		}
+	}
+
+	return adapter.ScalarConfAdapter.CheckTrain(params)
+}
+
+func newBitmapConfAdapter() *BitmapConfAdapter {
+	return &BitmapConfAdapter{}
+}
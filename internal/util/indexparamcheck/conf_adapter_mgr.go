@@ -55,6 +55,9 @@ func (mgr *ConfAdapterMgrImpl) registerConfAdapter() {
 	mgr.adapters[IndexRHNSWSQ] = newRHNSWSQConfAdapter()
 	mgr.adapters[IndexNGTPANNG] = newNGTPANNGConfAdapter()
 	mgr.adapters[IndexNGTONNG] = newNGTONNGConfAdapter()
+	mgr.adapters[IndexScalarSorted] = newScalarConfAdapter()
+	mgr.adapters[IndexScalarInverted] = newScalarConfAdapter()
+	mgr.adapters[IndexScalarBitmap] = newBitmapConfAdapter()
 }
 
 func newConfAdapterMgrImpl() *ConfAdapterMgrImpl {
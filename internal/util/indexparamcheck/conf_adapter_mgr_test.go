@@ -117,6 +117,24 @@ func Test_GetConfAdapterMgrInstance(t *testing.T) {
 	assert.NotEqual(t, nil, adapter)
 	_, ok = adapter.(*NGTONNGConfAdapter)
 	assert.Equal(t, true, ok)
+
+	adapter, err = adapterMgr.GetAdapter(IndexScalarSorted)
+	assert.Equal(t, nil, err)
+	assert.NotEqual(t, nil, adapter)
+	_, ok = adapter.(*ScalarConfAdapter)
+	assert.Equal(t, true, ok)
+
+	adapter, err = adapterMgr.GetAdapter(IndexScalarInverted)
+	assert.Equal(t, nil, err)
+	assert.NotEqual(t, nil, adapter)
+	_, ok = adapter.(*ScalarConfAdapter)
+	assert.Equal(t, true, ok)
+
+	adapter, err = adapterMgr.GetAdapter(IndexScalarBitmap)
+	assert.Equal(t, nil, err)
+	assert.NotEqual(t, nil, adapter)
+	_, ok = adapter.(*BitmapConfAdapter)
+	assert.Equal(t, true, ok)
 }
 
 func TestConfAdapterMgrImpl_GetAdapter(t *testing.T) {
@@ -595,3 +595,59 @@ func TestNGTONNGConfAdapter_CheckTrain(t *testing.T) {
 		}
 	}
 }
+
+func TestScalarConfAdapter_CheckTrain(t *testing.T) {
+	validParams := map[string]string{}
+
+	invalidMetricParams := map[string]string{
+		Metric: L2,
+	}
+
+	cases := []struct {
+		params map[string]string
+		want   bool
+	}{
+		{validParams, true},
+		{invalidMetricParams, false},
+	}
+
+	adapter := newScalarConfAdapter()
+	for _, test := range cases {
+		if got := adapter.CheckTrain(test.params); got != test.want {
+			t.Errorf("ScalarConfAdapter.CheckTrain(%v) = %v", test.params, test.want)
+		}
+	}
+}
+
+func TestBitmapConfAdapter_CheckTrain(t *testing.T) {
+	validParams := map[string]string{
+		BitmapCardinality: strconv.Itoa(DefaultBitmapCardinality),
+	}
+
+	invalidCardinalityParamsMin := copyParams(validParams)
+	invalidCardinalityParamsMin[BitmapCardinality] = strconv.Itoa(MinBitmapCardinality - 1)
+
+	invalidCardinalityParamsMax := copyParams(validParams)
+	invalidCardinalityParamsMax[BitmapCardinality] = strconv.Itoa(MaxBitmapCardinality + 1)
+
+	invalidMetricParams := copyParams(validParams)
+	invalidMetricParams[Metric] = L2
+
+	cases := []struct {
+		params map[string]string
+		want   bool
+	}{
+		{validParams, true},
+		{map[string]string{}, true},
+		{invalidCardinalityParamsMin, false},
+		{invalidCardinalityParamsMax, false},
+		{invalidMetricParams, false},
+	}
+
+	adapter := newBitmapConfAdapter()
+	for _, test := range cases {
+		if got := adapter.CheckTrain(test.params); got != test.want {
+			t.Errorf("BitmapConfAdapter.CheckTrain(%v) = %v", test.params, test.want)
+		}
+	}
+}
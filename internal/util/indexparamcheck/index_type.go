@@ -29,4 +29,34 @@ const (
 	IndexANNOY           IndexType = "ANNOY"
 	IndexNGTPANNG        IndexType = "NGT_PANNG"
 	IndexNGTONNG         IndexType = "NGT_ONNG"
+
+	// IndexAutoIndex is resolved to a concrete vector index type by ResolveAutoIndex
+	IndexAutoIndex IndexType = "AUTOINDEX"
+
+	// scalar index types
+	IndexScalarSorted   IndexType = "SORTED"
+	IndexScalarInverted IndexType = "INVERTED"
+	IndexScalarBitmap   IndexType = "BITMAP"
 )
+
+// IsScalarIndexType returns whether the index type is built on scalar fields
+func IsScalarIndexType(indexType IndexType) bool {
+	return indexType == IndexScalarSorted || indexType == IndexScalarInverted || indexType == IndexScalarBitmap
+}
+
+// IsDistributedBuildIndexType returns whether the build of the index type can be split into training the index,
+// adding the shards of a segment to the trained index and merging the shard indexes
+func IsDistributedBuildIndexType(indexType IndexType) bool {
+	return indexType == IndexFaissIvfSQ8 || indexType == IndexFaissIvfPQ
+}
+
+// IsIncrementalBuildIndexType returns whether the index type can be built by training the index on all the vectors
+// and then adding the vectors in batches
+func IsIncrementalBuildIndexType(indexType IndexType) bool {
+	switch indexType {
+	case IndexFaissIvfFlat, IndexFaissIvfSQ8, IndexFaissIvfPQ, IndexHNSW, IndexRHNSWFlat, IndexRHNSWPQ, IndexRHNSWSQ:
+		return true
+	default:
+		return false
+	}
+}
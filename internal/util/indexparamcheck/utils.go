@@ -3,6 +3,7 @@ package indexparamcheck
 import (
 	"strconv"
 
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
 )
 
@@ -39,3 +40,23 @@ func CheckStrByValues(params map[string]string, key string, container []string)
 
 	return funcutil.SliceContain(container, value)
 }
+
+// GetIndexType returns the index type set in the index params, the params may be nested in the json of
+// key "params". Return empty string if the index type is not set
+func GetIndexType(indexParams []*commonpb.KeyValuePair) string {
+	for _, kv := range indexParams {
+		if kv.Key == IndexTypeKey {
+			return kv.Value
+		}
+		if kv.Key == "params" {
+			params, err := funcutil.ParseIndexParamsMap(kv.Value)
+			if err != nil {
+				continue
+			}
+			if indexType, ok := params[IndexTypeKey]; ok {
+				return indexType
+			}
+		}
+	}
+	return ""
+}
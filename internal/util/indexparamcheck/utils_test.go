@@ -3,6 +3,8 @@ package indexparamcheck
 import (
 	"strconv"
 	"testing"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
 )
 
 func Test_CheckIntByRange(t *testing.T) {
@@ -85,3 +87,43 @@ func Test_CheckStrByValues(t *testing.T) {
 		}
 	}
 }
+
+func TestGetIndexType(t *testing.T) {
+	cases := []struct {
+		params []*commonpb.KeyValuePair
+		want   string
+	}{
+		{[]*commonpb.KeyValuePair{{Key: IndexTypeKey, Value: IndexScalarSorted}}, IndexScalarSorted},
+		{[]*commonpb.KeyValuePair{{Key: "params", Value: `{"index_type": "HNSW", "M": 8}`}}, IndexHNSW},
+		{[]*commonpb.KeyValuePair{{Key: "params", Value: "invalid"}}, ""},
+		{[]*commonpb.KeyValuePair{{Key: Metric, Value: L2}}, ""},
+	}
+
+	for _, test := range cases {
+		if got := GetIndexType(test.params); got != test.want {
+			t.Errorf("GetIndexType(%v) = %v", test.params, test.want)
+		}
+	}
+}
+
+func TestIsIncrementalBuildIndexType(t *testing.T) {
+	cases := []struct {
+		indexType IndexType
+		want      bool
+	}{
+		{IndexFaissIvfFlat, true},
+		{IndexFaissIvfSQ8, true},
+		{IndexHNSW, true},
+		{IndexRHNSWPQ, true},
+		{IndexANNOY, false},
+		{IndexNSG, false},
+		{IndexFaissBinIvfFlat, false},
+		{IndexScalarSorted, false},
+	}
+
+	for _, test := range cases {
+		if got := IsIncrementalBuildIndexType(test.indexType); got != test.want {
+			t.Errorf("IsIncrementalBuildIndexType(%v) = %v", test.indexType, got)
+		}
+	}
+}
@@ -83,10 +83,17 @@ type QueryNodeConfiguration struct {
 	SimdType string `json:"simd_type"`
 }
 
+// QueryNodeQuotaMetrics records the metrics of query node used by the quota center of root coordinator.
+type QueryNodeQuotaMetrics struct {
+	// MinFlowGraphTt is the minimum tSafe of the dml channels consumed by the query node, 0 if there is none
+	MinFlowGraphTt uint64 `json:"min_flow_graph_tt"`
+}
+
 // QueryNodeInfos implements ComponentInfos
 type QueryNodeInfos struct {
 	BaseComponentInfos
 	SystemConfigurations QueryNodeConfiguration `json:"system_configurations"`
+	QuotaMetrics         QueryNodeQuotaMetrics  `json:"quota_metrics"`
 }
 
 // QueryCoordConfiguration records the configuration of query coordinator.
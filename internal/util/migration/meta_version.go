@@ -0,0 +1,245 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package migration
+
+import (
+	"fmt"
+	"path"
+	"strconv"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/kv"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/util/retry"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// MetaVersionPrefix is the prefix of the keys saving the meta version of each component, relative to the meta root path.
+const MetaVersionPrefix = "meta-version"
+
+// LegacyMetaVersion is the version of the meta written by the releases without meta version.
+const LegacyMetaVersion int64 = 1
+
+// MetaVersion is the version of the meta layout of the coordinators.
+// Version 2 adds the meta version key to the legacy layout without other changes.
+const MetaVersion int64 = 2
+
+// MetaMigration upgrades the meta of a component from version From to From+1.
+// Migrate is nil if the layout of the meta is unchanged and only the version needs to be updated.
+type MetaMigration struct {
+	From    int64
+	Desc    string
+	Migrate func(txn kv.TxnKV) error
+}
+
+// MetaSchema describes the meta versions supported by a component.
+// Prefixes are the key prefixes of the meta of the component, used to tell a new deployment from a legacy one.
+type MetaSchema struct {
+	Component           string
+	Version             int64
+	MinSupportedVersion int64
+	Prefixes            []string
+	Migrations          []MetaMigration
+}
+
+// coordinatorMetaPrefixes are the key prefixes of the meta of each coordinator relative to the meta root path, in the
+// order the coordinators are upgraded. They must be updated along with the keys the coordinators write.
+var coordinatorMetaPrefixes = []struct {
+	component string
+	prefixes  []string
+}{
+	{typeutil.RootCoordRole, []string{"root-coord"}},
+	{typeutil.DataCoordRole, []string{"datacoord-meta", "cluster-prefix/"}},
+	{typeutil.QueryCoordRole, []string{"queryCoord-collectionMeta", "queryCoord-segmentMeta", "queryCoord-queryChannel",
+		"queryCoord-queryNodeInfo", "queryCoord-triggerTask"}},
+	{typeutil.IndexCoordRole, []string{"indexes"}},
+}
+
+// coordinatorMetaMigrations are the migrations of the meta of the coordinators, the layouts of all the coordinators
+// share the meta version.
+var coordinatorMetaMigrations = []MetaMigration{
+	{
+		From: LegacyMetaVersion,
+		Desc: "save meta version",
+	},
+}
+
+// CoordinatorSchemas returns the meta versions supported by the coordinators and the migrations between them, in the
+// order the coordinators are upgraded.
+func CoordinatorSchemas() []*MetaSchema {
+	schemas := make([]*MetaSchema, 0, len(coordinatorMetaPrefixes))
+	for _, coord := range coordinatorMetaPrefixes {
+		schemas = append(schemas, &MetaSchema{
+			Component:           coord.component,
+			Version:             MetaVersion,
+			MinSupportedVersion: LegacyMetaVersion,
+			Prefixes:            append([]string(nil), coord.prefixes...),
+			Migrations:          append([]MetaMigration(nil), coordinatorMetaMigrations...),
+		})
+	}
+	return schemas
+}
+
+// CoordinatorSchema returns the meta schema of the coordinator, it panics if the component isn't a coordinator.
+func CoordinatorSchema(component string) *MetaSchema {
+	for _, schema := range CoordinatorSchemas() {
+		if schema.Component == component {
+			return schema
+		}
+	}
+	panic(fmt.Sprintf("no meta schema of %s", component))
+}
+
+func metaVersionKey(component string) string {
+	return path.Join(MetaVersionPrefix, component)
+}
+
+// LoadMetaVersion returns the meta version saved for the component, the bool is false if the version is not saved.
+func LoadMetaVersion(base kv.BaseKV, component string) (int64, bool, error) {
+	key := metaVersionKey(component)
+	keys, values, err := base.LoadWithPrefix(key)
+	if err != nil {
+		return 0, false, err
+	}
+	// the keys returned may be full paths including the root path of the kv
+	for i := range keys {
+		if path.Base(keys[i]) != component {
+			continue
+		}
+		version, err := strconv.ParseInt(values[i], 10, 64)
+		if err != nil {
+			return 0, false, fmt.Errorf("invalid meta version %s of %s, %w", values[i], component, err)
+		}
+		return version, true, nil
+	}
+	return 0, false, nil
+}
+
+// SaveMetaVersion saves the meta version of the component.
+func SaveMetaVersion(base kv.BaseKV, component string, version int64) error {
+	return base.Save(metaVersionKey(component), strconv.FormatInt(version, 10))
+}
+
+// prefixChecker is implemented by the kvs that can check the keys with a prefix without loading them
+type prefixChecker interface {
+	HasPrefix(prefix string) (bool, error)
+}
+
+func (s *MetaSchema) hasMeta(base kv.BaseKV) (bool, error) {
+	for _, prefix := range s.Prefixes {
+		if checker, ok := base.(prefixChecker); ok {
+			has, err := checker.HasPrefix(prefix)
+			if err != nil || has {
+				return has, err
+			}
+			continue
+		}
+		keys, _, err := base.LoadWithPrefix(prefix)
+		if err != nil {
+			return false, err
+		}
+		if len(keys) > 0 {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// CurrentVersion returns the meta version of the component in the kv, the legacy version is returned if the
+// version is not saved but the meta exists, and the latest version is returned for a new deployment.
+func (s *MetaSchema) CurrentVersion(base kv.BaseKV) (version int64, saved bool, err error) {
+	version, saved, err = LoadMetaVersion(base, s.Component)
+	if err != nil || saved {
+		return version, saved, err
+	}
+	exist, err := s.hasMeta(base)
+	if err != nil {
+		return 0, false, err
+	}
+	if exist {
+		return LegacyMetaVersion, false, nil
+	}
+	return s.Version, false, nil
+}
+
+// Check is called before the component loads its meta. It saves the latest version for a new deployment, and fails
+// if the meta is newer than the component or older than the oldest version the component can read, in which case
+// the meta must be upgraded by the migration tool first. The version mismatch error is unrecoverable for retry.Do.
+func (s *MetaSchema) Check(base kv.BaseKV) error {
+	version, saved, err := s.CurrentVersion(base)
+	if err != nil {
+		return err
+	}
+	if version > s.Version {
+		return retry.Unrecoverable(fmt.Errorf("meta version %d of %s is newer than the supported version %d",
+			version, s.Component, s.Version))
+	}
+	if version < s.MinSupportedVersion {
+		return retry.Unrecoverable(fmt.Errorf("meta version %d of %s is older than the minimum supported version %d, please upgrade the meta by the migration tool",
+			version, s.Component, s.MinSupportedVersion))
+	}
+	if version < s.Version {
+		log.Warn("meta is written by an old version, please upgrade the meta by the migration tool",
+			zap.String("component", s.Component), zap.Int64("meta version", version), zap.Int64("latest version", s.Version))
+		return nil
+	}
+	if !saved {
+		log.Debug("save meta version", zap.String("component", s.Component), zap.Int64("version", version))
+		return SaveMetaVersion(base, s.Component, version)
+	}
+	return nil
+}
+
+// Upgrade runs the migrations one version at a time until the meta reaches the latest version, the version is saved
+// after each migration so an interrupted upgrade can be resumed. If dryRun is true, only the migrations to run are
+// returned.
+func (s *MetaSchema) Upgrade(txn kv.TxnKV, dryRun bool) ([]MetaMigration, error) {
+	version, _, err := s.CurrentVersion(txn)
+	if err != nil {
+		return nil, err
+	}
+	if version > s.Version {
+		return nil, fmt.Errorf("meta version %d of %s is newer than the supported version %d", version, s.Component, s.Version)
+	}
+	migrations := make(map[int64]MetaMigration, len(s.Migrations))
+	for _, m := range s.Migrations {
+		migrations[m.From] = m
+	}
+
+	var done []MetaMigration
+	for ; version < s.Version; version++ {
+		m, ok := migrations[version]
+		if !ok {
+			return done, fmt.Errorf("no migration of %s from meta version %d", s.Component, version)
+		}
+		if !dryRun {
+			if m.Migrate != nil {
+				if err := m.Migrate(txn); err != nil {
+					return done, fmt.Errorf("migrate %s from meta version %d failed, %w", s.Component, version, err)
+				}
+			}
+			if err := SaveMetaVersion(txn, s.Component, version+1); err != nil {
+				return done, err
+			}
+			log.Debug("meta upgraded", zap.String("component", s.Component), zap.Int64("from", version), zap.Int64("to", version+1))
+		}
+		done = append(done, m)
+	}
+	if !dryRun {
+		// save the version for a new deployment or a meta already at the latest version
+		if err := SaveMetaVersion(txn, s.Component, s.Version); err != nil {
+			return done, err
+		}
+	}
+	return done, nil
+}
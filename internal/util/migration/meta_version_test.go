@@ -0,0 +1,225 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package migration
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/kv"
+	memkv "github.com/milvus-io/milvus/internal/kv/mem"
+	"github.com/milvus-io/milvus/internal/util/retry"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+func newTestSchema(migrated *[]int64) *MetaSchema {
+	return &MetaSchema{
+		Component:           "TestCoord",
+		Version:             3,
+		MinSupportedVersion: 2,
+		Prefixes:            []string{"test-coord"},
+		Migrations: []MetaMigration{
+			{
+				From: 1,
+				Migrate: func(txn kv.TxnKV) error {
+					*migrated = append(*migrated, 1)
+					return txn.Save("test-coord/migrated", "1")
+				},
+			},
+			{
+				From: 2,
+			},
+		},
+	}
+}
+
+func TestMetaSchema_Check(t *testing.T) {
+	var migrated []int64
+	schema := newTestSchema(&migrated)
+
+	t.Run("new deployment", func(t *testing.T) {
+		base := memkv.NewMemoryKV()
+		err := schema.Check(base)
+		assert.Nil(t, err)
+		version, saved, err := LoadMetaVersion(base, schema.Component)
+		assert.Nil(t, err)
+		assert.True(t, saved)
+		assert.Equal(t, int64(3), version)
+	})
+
+	t.Run("legacy meta", func(t *testing.T) {
+		base := memkv.NewMemoryKV()
+		err := base.Save("test-coord/a", "a")
+		assert.Nil(t, err)
+		err = schema.Check(base)
+		assert.NotNil(t, err)
+		assert.True(t, retry.IsUncoverable(err))
+		_, saved, err := LoadMetaVersion(base, schema.Component)
+		assert.Nil(t, err)
+		assert.False(t, saved)
+	})
+
+	t.Run("previous version", func(t *testing.T) {
+		base := memkv.NewMemoryKV()
+		err := SaveMetaVersion(base, schema.Component, 2)
+		assert.Nil(t, err)
+		err = schema.Check(base)
+		assert.Nil(t, err)
+		version, _, err := LoadMetaVersion(base, schema.Component)
+		assert.Nil(t, err)
+		assert.Equal(t, int64(2), version)
+	})
+
+	t.Run("newer version", func(t *testing.T) {
+		base := memkv.NewMemoryKV()
+		err := SaveMetaVersion(base, schema.Component, 4)
+		assert.Nil(t, err)
+		err = schema.Check(base)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("invalid version", func(t *testing.T) {
+		base := memkv.NewMemoryKV()
+		err := base.Save(metaVersionKey(schema.Component), "abc")
+		assert.Nil(t, err)
+		err = schema.Check(base)
+		assert.NotNil(t, err)
+	})
+}
+
+func TestMetaSchema_Upgrade(t *testing.T) {
+	var migrated []int64
+	schema := newTestSchema(&migrated)
+	base := memkv.NewMemoryKV()
+	err := base.Save("test-coord/a", "a")
+	assert.Nil(t, err)
+
+	done, err := schema.Upgrade(base, true)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(done))
+	assert.Equal(t, 0, len(migrated))
+	_, saved, err := LoadMetaVersion(base, schema.Component)
+	assert.Nil(t, err)
+	assert.False(t, saved)
+
+	done, err = schema.Upgrade(base, false)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(done))
+	assert.Equal(t, []int64{1}, migrated)
+	value, err := base.Load("test-coord/migrated")
+	assert.Nil(t, err)
+	assert.Equal(t, "1", value)
+	version, saved, err := LoadMetaVersion(base, schema.Component)
+	assert.Nil(t, err)
+	assert.True(t, saved)
+	assert.Equal(t, int64(3), version)
+	assert.Nil(t, schema.Check(base))
+
+	// nothing to do at the latest version
+	done, err = schema.Upgrade(base, false)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(done))
+	assert.Equal(t, []int64{1}, migrated)
+}
+
+func TestMetaSchema_UpgradeFailed(t *testing.T) {
+	schema := &MetaSchema{
+		Component:           "TestCoord",
+		Version:             3,
+		MinSupportedVersion: 3,
+		Prefixes:            []string{"test-coord"},
+		Migrations: []MetaMigration{
+			{
+				From: 1,
+			},
+			{
+				From: 2,
+				Migrate: func(txn kv.TxnKV) error {
+					return errors.New("mock failure")
+				},
+			},
+		},
+	}
+	base := memkv.NewMemoryKV()
+	err := base.Save("test-coord/a", "a")
+	assert.Nil(t, err)
+
+	done, err := schema.Upgrade(base, false)
+	assert.NotNil(t, err)
+	assert.Equal(t, 1, len(done))
+	// the upgrade can be resumed from version 2
+	version, saved, err := LoadMetaVersion(base, schema.Component)
+	assert.Nil(t, err)
+	assert.True(t, saved)
+	assert.Equal(t, int64(2), version)
+
+	schema.Migrations = schema.Migrations[:1]
+	_, err = schema.Upgrade(base, false)
+	assert.NotNil(t, err)
+
+	err = SaveMetaVersion(base, schema.Component, 4)
+	assert.Nil(t, err)
+	_, err = schema.Upgrade(base, false)
+	assert.NotNil(t, err)
+}
+
+// prefixCheckKV checks the prefixes without loading the keys
+type prefixCheckKV struct {
+	*memkv.MemoryKV
+	checked []string
+}
+
+func (kv *prefixCheckKV) HasPrefix(prefix string) (bool, error) {
+	kv.checked = append(kv.checked, prefix)
+	keys, _, err := kv.MemoryKV.LoadWithPrefix(prefix)
+	return len(keys) > 0, err
+}
+
+func (kv *prefixCheckKV) LoadWithPrefix(prefix string) ([]string, []string, error) {
+	return nil, nil, errors.New("keys with prefix must not be loaded")
+}
+
+func TestCoordinatorSchema(t *testing.T) {
+	schemas := CoordinatorSchemas()
+	assert.Equal(t, 4, len(schemas))
+	for _, s := range schemas {
+		assert.Equal(t, s, CoordinatorSchema(s.Component))
+	}
+	assert.Panics(t, func() { CoordinatorSchema(typeutil.QueryNodeRole) })
+
+	schema := CoordinatorSchema(typeutil.DataCoordRole)
+	assert.Equal(t, MetaVersion, schema.Version)
+	assert.Equal(t, LegacyMetaVersion, schema.MinSupportedVersion)
+
+	base := &prefixCheckKV{MemoryKV: memkv.NewMemoryKV()}
+	has, err := schema.hasMeta(base)
+	assert.Nil(t, err)
+	assert.False(t, has)
+	assert.Equal(t, []string{"datacoord-meta", "cluster-prefix/"}, base.checked)
+
+	err = base.Save("cluster-prefix/a", "a")
+	assert.Nil(t, err)
+	has, err = schema.hasMeta(base)
+	assert.Nil(t, err)
+	assert.True(t, has)
+
+	// the legacy meta is upgraded by saving the version
+	done, err := schema.Upgrade(base.MemoryKV, false)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(done))
+	version, saved, err := LoadMetaVersion(base.MemoryKV, schema.Component)
+	assert.Nil(t, err)
+	assert.True(t, saved)
+	assert.Equal(t, MetaVersion, version)
+}
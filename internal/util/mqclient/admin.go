@@ -0,0 +1,44 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package mqclient
+
+import "context"
+
+// SubscriptionInfo describes a subscription of a topic
+type SubscriptionInfo struct {
+	Name string
+	// Backlog is the number of messages not acked by the subscription, -1 means unknown
+	Backlog int64
+	// Consumers is the number of connected consumers, -1 means unknown
+	Consumers int64
+}
+
+// TopicInfo describes a topic with its subscriptions
+type TopicInfo struct {
+	Name          string
+	Subscriptions []SubscriptionInfo
+}
+
+// Admin is the interface that manages the topics and subscriptions of message queues
+type Admin interface {
+	// List the topics whose name starts with prefix, together with their subscriptions
+	ListTopics(ctx context.Context, prefix string) ([]TopicInfo, error)
+
+	// Delete a subscription of the topic
+	DeleteSubscription(ctx context.Context, topic string, subName string) error
+
+	// Delete a topic with all its subscriptions
+	DeleteTopic(ctx context.Context, topic string) error
+
+	// Close the admin and free associated resources
+	Close()
+}
@@ -0,0 +1,72 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package mqclient
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/confluentinc/confluent-kafka-go/kafka"
+)
+
+// kafkaAdminTimeoutMs is the timeout of fetching metadata from kafka brokers
+const kafkaAdminTimeoutMs = 10000
+
+type kafkaAdmin struct {
+	admin *kafka.AdminClient
+}
+
+// NewKafkaAdmin returns an admin managing the topics of the kafka brokers, the address is a comma separated broker list
+func NewKafkaAdmin(address string) (Admin, error) {
+	admin, err := kafka.NewAdminClient(&kafka.ConfigMap{"bootstrap.servers": address})
+	if err != nil {
+		return nil, err
+	}
+	return &kafkaAdmin{admin: admin}, nil
+}
+
+// ListTopics lists the topics without subscriptions, the kafka client in use can't list consumer groups
+func (ka *kafkaAdmin) ListTopics(ctx context.Context, prefix string) ([]TopicInfo, error) {
+	metadata, err := ka.admin.GetMetadata(nil, true, kafkaAdminTimeoutMs)
+	if err != nil {
+		return nil, err
+	}
+	infos := make([]TopicInfo, 0, len(metadata.Topics))
+	for topic := range metadata.Topics {
+		if strings.HasPrefix(topic, prefix) {
+			infos = append(infos, TopicInfo{Name: topic})
+		}
+	}
+	return infos, nil
+}
+
+func (ka *kafkaAdmin) DeleteSubscription(ctx context.Context, topic string, subName string) error {
+	return errors.New("delete subscription is not supported by kafka")
+}
+
+func (ka *kafkaAdmin) DeleteTopic(ctx context.Context, topic string) error {
+	results, err := ka.admin.DeleteTopics(ctx, []string{topic})
+	if err != nil {
+		return err
+	}
+	for _, result := range results {
+		if result.Error.Code() != kafka.ErrNoError {
+			return result.Error
+		}
+	}
+	return nil
+}
+
+func (ka *kafkaAdmin) Close() {
+	ka.admin.Close()
+}
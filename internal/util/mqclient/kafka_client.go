@@ -0,0 +1,114 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package mqclient
+
+import (
+	"strconv"
+
+	"github.com/confluentinc/confluent-kafka-go/kafka"
+)
+
+// kafkaTopicPartition is the only partition of a topic used by milvus,
+// the messages of a channel must be ordered, so a channel is mapped to a single partition
+const kafkaTopicPartition = 0
+
+type kafkaClient struct {
+	// more configs can be set to the basic config, e.g. sasl and ssl
+	basicConfig kafka.ConfigMap
+}
+
+// NewKafkaClientInstance returns a client connecting to the kafka brokers, the address is a comma separated broker list
+func NewKafkaClientInstance(address string) *kafkaClient {
+	config := kafka.ConfigMap{
+		"bootstrap.servers":   address,
+		"socket.timeout.ms":   300000,
+		"socket.max.fails":    3,
+		"api.version.request": true,
+	}
+	return &kafkaClient{basicConfig: config}
+}
+
+func cloneKafkaConfig(config kafka.ConfigMap) *kafka.ConfigMap {
+	newConfig := make(kafka.ConfigMap)
+	for k, v := range config {
+		newConfig[k] = v
+	}
+	return &newConfig
+}
+
+func (kc *kafkaClient) newProducerConfig() *kafka.ConfigMap {
+	newConf := cloneKafkaConfig(kc.basicConfig)
+	// the producer waits for all in-sync replicas, so that a sent message survives a broker failure
+	newConf.SetKey("acks", "all")
+	newConf.SetKey("message.max.bytes", 10485760)
+	newConf.SetKey("linger.ms", 1)
+	newConf.SetKey("go.delivery.reports", true)
+	return newConf
+}
+
+func (kc *kafkaClient) newConsumerConfig(group string, offset SubscriptionInitialPosition) *kafka.ConfigMap {
+	newConf := cloneKafkaConfig(kc.basicConfig)
+	if offset == SubscriptionPositionEarliest {
+		newConf.SetKey("auto.offset.reset", "earliest")
+	} else {
+		newConf.SetKey("auto.offset.reset", "latest")
+	}
+	// the consumed positions are checkpointed by msgstream instead of kafka
+	newConf.SetKey("enable.auto.commit", false)
+	newConf.SetKey("session.timeout.ms", 180000)
+	newConf.SetKey("group.id", group)
+	newConf.SetKey("api.version.request", true)
+	newConf.SetKey("go.events.channel.enable", false)
+	return newConf
+}
+
+func (kc *kafkaClient) CreateProducer(options ProducerOptions) (Producer, error) {
+	pp, err := kafka.NewProducer(kc.newProducerConfig())
+	if err != nil {
+		return nil, err
+	}
+	deliveryChan := make(chan kafka.Event, 1)
+	producer := &kafkaProducer{p: pp, deliveryChan: deliveryChan, topic: options.Topic}
+	return producer, nil
+}
+
+func (kc *kafkaClient) Subscribe(options ConsumerOptions) (Consumer, error) {
+	config := kc.newConsumerConfig(options.SubscriptionName, options.SubscriptionInitialPosition)
+	consumer, err := newKafkaConsumer(config, options.Topic, options.SubscriptionName, options.SubscriptionInitialPosition)
+	if err != nil {
+		return nil, err
+	}
+	return consumer, nil
+}
+
+func (kc *kafkaClient) EarliestMessageID() MessageID {
+	return &kafkaID{messageID: int64(kafka.OffsetBeginning)}
+}
+
+func (kc *kafkaClient) StringToMsgID(id string) (MessageID, error) {
+	offset, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	return &kafkaID{messageID: offset}, nil
+}
+
+func (kc *kafkaClient) BytesToMsgID(id []byte) (MessageID, error) {
+	offset, err := DeserializeKafkaID(id)
+	if err != nil {
+		return nil, err
+	}
+	return &kafkaID{messageID: offset}, nil
+}
+
+func (kc *kafkaClient) Close() {
+}
@@ -0,0 +1,158 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package mqclient
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newKafkaTestClient() *kafkaClient {
+	kafkaBrokerList, _ := Params.Load("_KafkaBrokerList")
+	return NewKafkaClientInstance(kafkaBrokerList)
+}
+
+func produceKafkaMessages(t *testing.T, kc *kafkaClient, topic string, arr []int) []MessageID {
+	producer, err := kc.CreateProducer(ProducerOptions{Topic: topic})
+	assert.Nil(t, err)
+	defer producer.Close()
+
+	ids := make([]MessageID, 0, len(arr))
+	for _, v := range arr {
+		msg := &ProducerMessage{
+			Payload:    []byte(fmt.Sprintf("%d", v)),
+			Properties: map[string]string{"value": fmt.Sprintf("%d", v)},
+		}
+		id, err := producer.Send(context.Background(), msg)
+		assert.Nil(t, err)
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+func consumeKafkaMessages(t *testing.T, consumer Consumer, count int) []ConsumerMessage {
+	msgs := make([]ConsumerMessage, 0, count)
+	for len(msgs) < count {
+		select {
+		case msg, ok := <-consumer.Chan():
+			assert.True(t, ok)
+			consumer.Ack(msg)
+			msgs = append(msgs, msg)
+		case <-time.After(30 * time.Second):
+			assert.FailNow(t, "consume kafka messages timeout")
+		}
+	}
+	return msgs
+}
+
+func TestKafkaClient_ProduceConsume(t *testing.T) {
+	kc := newKafkaTestClient()
+	defer kc.Close()
+	rand.Seed(time.Now().UnixNano())
+
+	topic := fmt.Sprintf("test-topic-%d", rand.Int())
+	subName := fmt.Sprintf("test-subname-%d", rand.Int())
+	arr := []int{111, 222, 333, 444, 555, 666, 777}
+	ids := produceKafkaMessages(t, kc, topic, arr)
+	assert.Equal(t, len(arr), len(ids))
+
+	consumer, err := kc.Subscribe(ConsumerOptions{
+		Topic:                       topic,
+		SubscriptionName:            subName,
+		SubscriptionInitialPosition: SubscriptionPositionEarliest,
+	})
+	assert.Nil(t, err)
+	defer consumer.Close()
+	assert.Equal(t, subName, consumer.Subscription())
+
+	msgs := consumeKafkaMessages(t, consumer, len(arr))
+	for i, msg := range msgs {
+		assert.Equal(t, topic, msg.Topic())
+		assert.Equal(t, fmt.Sprintf("%d", arr[i]), string(msg.Payload()))
+		assert.Equal(t, fmt.Sprintf("%d", arr[i]), msg.Properties()["value"])
+		assert.Equal(t, ids[i].Serialize(), msg.ID().Serialize())
+	}
+}
+
+func TestKafkaClient_Seek(t *testing.T) {
+	kc := newKafkaTestClient()
+	defer kc.Close()
+	rand.Seed(time.Now().UnixNano())
+
+	topic := fmt.Sprintf("test-topic-%d", rand.Int())
+	subName := fmt.Sprintf("test-subname-%d", rand.Int())
+	arr := []int{111, 222, 333, 444, 555, 666, 777}
+	ids := produceKafkaMessages(t, kc, topic, arr)
+
+	// seek before consuming
+	consumer, err := kc.Subscribe(ConsumerOptions{
+		Topic:                       topic,
+		SubscriptionName:            subName,
+		SubscriptionInitialPosition: SubscriptionPositionEarliest,
+	})
+	assert.Nil(t, err)
+	defer consumer.Close()
+	err = consumer.Seek(ids[3])
+	assert.Nil(t, err)
+	msgs := consumeKafkaMessages(t, consumer, len(arr)-3)
+	for i, msg := range msgs {
+		assert.Equal(t, fmt.Sprintf("%d", arr[i+3]), string(msg.Payload()))
+	}
+
+	// seek while consuming
+	err = consumer.Seek(ids[1])
+	assert.Nil(t, err)
+	msgs = consumeKafkaMessages(t, consumer, 1)
+	assert.Equal(t, ids[1].Serialize(), msgs[0].ID().Serialize())
+}
+
+func TestKafkaClient_EarliestMessageID(t *testing.T) {
+	kc := newKafkaTestClient()
+	defer kc.Close()
+
+	mid := kc.EarliestMessageID()
+	assert.NotNil(t, mid)
+}
+
+func TestKafkaClient_StringToMsgID(t *testing.T) {
+	kc := newKafkaTestClient()
+	defer kc.Close()
+
+	res, err := kc.StringToMsgID("8")
+	assert.Nil(t, err)
+	assert.NotNil(t, res)
+	assert.Equal(t, int64(8), res.EntryID())
+
+	res, err = kc.StringToMsgID("X")
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+}
+
+func TestKafkaClient_BytesToMsgID(t *testing.T) {
+	kc := newKafkaTestClient()
+	defer kc.Close()
+
+	mid := kc.EarliestMessageID()
+	res, err := kc.BytesToMsgID(mid.Serialize())
+	assert.Nil(t, err)
+	assert.NotNil(t, res)
+
+	invalidBin := []byte{0}
+	res, err = kc.BytesToMsgID(invalidBin)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,135 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package mqclient
+
+import (
+	"sync"
+	"time"
+
+	"github.com/confluentinc/confluent-kafka-go/kafka"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+)
+
+// kafkaPollTimeout is how long a read blocks, the consumer checks whether it is closed in between
+const kafkaPollTimeout = 100 * time.Millisecond
+
+type kafkaConsumer struct {
+	c          *kafka.Consumer
+	topic      string
+	groupID    string
+	msgChannel chan ConsumerMessage
+	// the partition is assigned on Seek or on the first Chan, from the initial position
+	hasAssign       bool
+	initialPosition SubscriptionInitialPosition
+	closeCh         chan struct{}
+	chanOnce        sync.Once
+	closeOnce       sync.Once
+	wg              sync.WaitGroup
+}
+
+func newKafkaConsumer(config *kafka.ConfigMap, topic string, groupID string, position SubscriptionInitialPosition) (*kafkaConsumer, error) {
+	c, err := kafka.NewConsumer(config)
+	if err != nil {
+		return nil, err
+	}
+	return &kafkaConsumer{
+		c:               c,
+		topic:           topic,
+		groupID:         groupID,
+		msgChannel:      make(chan ConsumerMessage, 256),
+		initialPosition: position,
+		closeCh:         make(chan struct{}),
+	}, nil
+}
+
+func (kc *kafkaConsumer) Subscription() string {
+	return kc.groupID
+}
+
+// Chan starts consuming on the first call, from the seek position if Seek is called before,
+// otherwise from the beginning or the end of the topic according to the initial position
+func (kc *kafkaConsumer) Chan() <-chan ConsumerMessage {
+	kc.chanOnce.Do(func() {
+		if !kc.hasAssign {
+			offset := kafka.OffsetEnd
+			if kc.initialPosition == SubscriptionPositionEarliest {
+				offset = kafka.OffsetBeginning
+			}
+			if err := kc.assign(offset); err != nil {
+				log.Error("kafka consumer assign failed", zap.String("topic", kc.topic), zap.Any("offset", offset), zap.Error(err))
+				panic(err)
+			}
+		}
+
+		kc.wg.Add(1)
+		go func() {
+			defer kc.wg.Done()
+			defer close(kc.msgChannel)
+			for {
+				select {
+				case <-kc.closeCh:
+					log.Debug("kafka consumer closed", zap.String("topic", kc.topic), zap.String("groupID", kc.groupID))
+					return
+				default:
+				}
+				msg, err := kc.c.ReadMessage(kafkaPollTimeout)
+				if err != nil {
+					if kerr, ok := err.(kafka.Error); !ok || kerr.Code() != kafka.ErrTimedOut {
+						log.Warn("kafka consumer read message failed", zap.String("topic", kc.topic), zap.Error(err))
+					}
+					continue
+				}
+				select {
+				case kc.msgChannel <- &kafkaMessage{msg: msg}:
+				case <-kc.closeCh:
+					return
+				}
+			}
+		}()
+	})
+	return kc.msgChannel
+}
+
+func (kc *kafkaConsumer) assign(offset kafka.Offset) error {
+	err := kc.c.Assign([]kafka.TopicPartition{{Topic: &kc.topic, Partition: kafkaTopicPartition, Offset: offset}})
+	if err != nil {
+		return err
+	}
+	kc.hasAssign = true
+	return nil
+}
+
+// Seek moves the consumer to the message of id, which is the first message received afterwards
+func (kc *kafkaConsumer) Seek(id MessageID) error {
+	offset := kafka.Offset(id.(*kafkaID).messageID)
+	log.Debug("kafka consumer seek", zap.String("topic", kc.topic), zap.String("groupID", kc.groupID), zap.Any("offset", offset))
+	if !kc.hasAssign {
+		return kc.assign(offset)
+	}
+	return kc.c.Seek(kafka.TopicPartition{Topic: &kc.topic, Partition: kafkaTopicPartition, Offset: offset}, 1000)
+}
+
+func (kc *kafkaConsumer) Ack(message ConsumerMessage) {
+}
+
+func (kc *kafkaConsumer) Close() {
+	kc.closeOnce.Do(func() {
+		close(kc.closeCh)
+		// the reading goroutine must exit before the consumer is closed
+		kc.wg.Wait()
+		if err := kc.c.Close(); err != nil {
+			log.Warn("failed to close kafka consumer", zap.String("topic", kc.topic), zap.Error(err))
+		}
+	})
+}
@@ -0,0 +1,59 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package mqclient
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// kafkaID is the offset of a message in the partition of its topic
+type kafkaID struct {
+	messageID int64
+}
+
+var _ MessageID = &kafkaID{}
+
+func (kid *kafkaID) Serialize() []byte {
+	return SerializeKafkaID(kid.messageID)
+}
+
+func (kid *kafkaID) LedgerID() int64 {
+	return 0
+}
+
+func (kid *kafkaID) EntryID() int64 {
+	return kid.messageID
+}
+
+func (kid *kafkaID) BatchIdx() int32 {
+	return 0
+}
+
+func (kid *kafkaID) PartitionIdx() int32 {
+	return kafkaTopicPartition
+}
+
+// SerializeKafkaID encodes the offset in 8 bytes of little endian
+func SerializeKafkaID(messageID int64) []byte {
+	b := make([]byte, 8)
+	binary.LittleEndian.PutUint64(b, uint64(messageID))
+	return b
+}
+
+// DeserializeKafkaID decodes the offset serialized by SerializeKafkaID
+func DeserializeKafkaID(messageID []byte) (int64, error) {
+	if len(messageID) != 8 {
+		return 0, fmt.Errorf("invalid kafka message id, length = %d", len(messageID))
+	}
+	return int64(binary.LittleEndian.Uint64(messageID)), nil
+}
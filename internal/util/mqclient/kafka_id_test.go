@@ -0,0 +1,55 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package mqclient
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKafkaID_Serialize(t *testing.T) {
+	kid := &kafkaID{
+		messageID: 8,
+	}
+
+	bin := kid.Serialize()
+	assert.NotNil(t, bin)
+	assert.NotZero(t, len(bin))
+
+	assert.Equal(t, int64(0), kid.LedgerID())
+	assert.Equal(t, int64(8), kid.EntryID())
+	assert.Equal(t, int32(0), kid.BatchIdx())
+	assert.Equal(t, int32(0), kid.PartitionIdx())
+}
+
+func Test_SerializeKafkaID(t *testing.T) {
+	bin := SerializeKafkaID(10)
+	assert.NotNil(t, bin)
+	assert.NotZero(t, len(bin))
+}
+
+func Test_DeserializeKafkaID(t *testing.T) {
+	bin := SerializeKafkaID(5)
+	id, err := DeserializeKafkaID(bin)
+	assert.Nil(t, err)
+	assert.Equal(t, id, int64(5))
+
+	// the logical offsets are negative
+	bin = SerializeKafkaID(-2)
+	id, err = DeserializeKafkaID(bin)
+	assert.Nil(t, err)
+	assert.Equal(t, id, int64(-2))
+
+	_, err = DeserializeKafkaID([]byte{1, 2})
+	assert.NotNil(t, err)
+}
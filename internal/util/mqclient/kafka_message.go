@@ -0,0 +1,46 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package mqclient
+
+import (
+	"github.com/confluentinc/confluent-kafka-go/kafka"
+)
+
+// Check kafkaMessage implements ConsumerMessage
+var _ ConsumerMessage = (*kafkaMessage)(nil)
+
+type kafkaMessage struct {
+	msg *kafka.Message
+}
+
+func (km *kafkaMessage) Topic() string {
+	return *km.msg.TopicPartition.Topic
+}
+
+func (km *kafkaMessage) Properties() map[string]string {
+	if len(km.msg.Headers) == 0 {
+		return nil
+	}
+	properties := make(map[string]string, len(km.msg.Headers))
+	for _, header := range km.msg.Headers {
+		properties[header.Key] = string(header.Value)
+	}
+	return properties
+}
+
+func (km *kafkaMessage) Payload() []byte {
+	return km.msg.Value
+}
+
+func (km *kafkaMessage) ID() MessageID {
+	return &kafkaID{messageID: int64(km.msg.TopicPartition.Offset)}
+}
@@ -0,0 +1,76 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package mqclient
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/confluentinc/confluent-kafka-go/kafka"
+)
+
+var _ Producer = (*kafkaProducer)(nil)
+
+type kafkaProducer struct {
+	p            *kafka.Producer
+	topic        string
+	deliveryChan chan kafka.Event
+	// Send waits for the delivery report of its message, so the messages are sent one by one
+	sendMut   sync.Mutex
+	closeOnce sync.Once
+}
+
+func (kp *kafkaProducer) Topic() string {
+	return kp.topic
+}
+
+func (kp *kafkaProducer) Send(ctx context.Context, message *ProducerMessage) (MessageID, error) {
+	kp.sendMut.Lock()
+	defer kp.sendMut.Unlock()
+
+	headers := make([]kafka.Header, 0, len(message.Properties))
+	for key, value := range message.Properties {
+		headers = append(headers, kafka.Header{Key: key, Value: []byte(value)})
+	}
+	err := kp.p.Produce(&kafka.Message{
+		TopicPartition: kafka.TopicPartition{Topic: &kp.topic, Partition: kafkaTopicPartition},
+		Value:          message.Payload,
+		Headers:        headers,
+	}, kp.deliveryChan)
+	if err != nil {
+		return nil, err
+	}
+
+	// the delivery report is awaited even if ctx is done, otherwise the next Send would take it,
+	// librdkafka fails the message after message.timeout.ms anyway
+	e, ok := <-kp.deliveryChan
+	if !ok {
+		return nil, errors.New("kafka producer is closed")
+	}
+	m, ok := e.(*kafka.Message)
+	if !ok {
+		return nil, errors.New("unexpected kafka delivery report")
+	}
+	if m.TopicPartition.Error != nil {
+		return nil, m.TopicPartition.Error
+	}
+	return &kafkaID{messageID: int64(m.TopicPartition.Offset)}, nil
+}
+
+func (kp *kafkaProducer) Close() {
+	kp.closeOnce.Do(func() {
+		// wait at most 30 seconds for the messages in flight
+		kp.p.Flush(30 * 1000)
+		kp.p.Close()
+	})
+}
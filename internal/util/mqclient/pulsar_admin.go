@@ -0,0 +1,119 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package mqclient
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// pulsarTopicDomain is the tenant and namespace of the topics created by milvus, which only uses short topic names
+const pulsarTopicDomain = "persistent://public/default/"
+
+type pulsarAdmin struct {
+	webAddress string
+	client     *http.Client
+}
+
+type pulsarSubscriptionStats struct {
+	MsgBacklog int64             `json:"msgBacklog"`
+	Consumers  []json.RawMessage `json:"consumers"`
+}
+
+type pulsarTopicStats struct {
+	Subscriptions map[string]pulsarSubscriptionStats `json:"subscriptions"`
+}
+
+// NewPulsarAdmin returns an admin managing the topics through the pulsar admin rest api, e.g. http://localhost:8080
+func NewPulsarAdmin(webAddress string) Admin {
+	return &pulsarAdmin{
+		webAddress: strings.TrimSuffix(webAddress, "/"),
+		client:     &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+func (pa *pulsarAdmin) namespaceURL() string {
+	return pa.webAddress + "/admin/v2/persistent/public/default"
+}
+
+func (pa *pulsarAdmin) topicURL(topic string) string {
+	return pa.namespaceURL() + "/" + url.PathEscape(topic)
+}
+
+func (pa *pulsarAdmin) do(ctx context.Context, method string, reqURL string, result interface{}) error {
+	req, err := http.NewRequest(method, reqURL, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := pa.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("pulsar admin %s %s failed, status = %s, body = %s", method, reqURL, resp.Status, string(body))
+	}
+	if result == nil {
+		return nil
+	}
+	return json.Unmarshal(body, result)
+}
+
+func (pa *pulsarAdmin) ListTopics(ctx context.Context, prefix string) ([]TopicInfo, error) {
+	var topics []string
+	if err := pa.do(ctx, http.MethodGet, pa.namespaceURL(), &topics); err != nil {
+		return nil, err
+	}
+	infos := make([]TopicInfo, 0, len(topics))
+	for _, fullName := range topics {
+		topic := strings.TrimPrefix(fullName, pulsarTopicDomain)
+		if !strings.HasPrefix(topic, prefix) {
+			continue
+		}
+		stats := &pulsarTopicStats{}
+		if err := pa.do(ctx, http.MethodGet, pa.topicURL(topic)+"/stats", stats); err != nil {
+			return nil, err
+		}
+		info := TopicInfo{Name: topic}
+		for subName, subStats := range stats.Subscriptions {
+			info.Subscriptions = append(info.Subscriptions, SubscriptionInfo{
+				Name:      subName,
+				Backlog:   subStats.MsgBacklog,
+				Consumers: int64(len(subStats.Consumers)),
+			})
+		}
+		infos = append(infos, info)
+	}
+	return infos, nil
+}
+
+func (pa *pulsarAdmin) DeleteSubscription(ctx context.Context, topic string, subName string) error {
+	return pa.do(ctx, http.MethodDelete, pa.topicURL(topic)+"/subscription/"+url.PathEscape(subName)+"?force=true", nil)
+}
+
+func (pa *pulsarAdmin) DeleteTopic(ctx context.Context, topic string) error {
+	return pa.do(ctx, http.MethodDelete, pa.topicURL(topic)+"?force=true", nil)
+}
+
+func (pa *pulsarAdmin) Close() {
+	pa.client.CloseIdleConnections()
+}
@@ -0,0 +1,75 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package mqclient
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPulsarAdmin(t *testing.T) {
+	deleted := make([]string, 0)
+	mux := http.NewServeMux()
+	mux.HandleFunc("/admin/v2/persistent/public/default", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode([]string{
+			pulsarTopicDomain + "by-dev-rootcoord-dml_0",
+			pulsarTopicDomain + "other-topic",
+		})
+	})
+	mux.HandleFunc("/admin/v2/persistent/public/default/by-dev-rootcoord-dml_0/stats", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"subscriptions": {"sub_0": {"msgBacklog": 10, "consumers": [{}, {}]}, "sub_1": {"msgBacklog": 0, "consumers": []}}}`))
+	})
+	mux.HandleFunc("/admin/v2/persistent/public/default/by-dev-rootcoord-dml_0/subscription/sub_1", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method)
+		deleted = append(deleted, "sub_1")
+	})
+	mux.HandleFunc("/admin/v2/persistent/public/default/by-dev-rootcoord-dml_1", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method)
+		deleted = append(deleted, "by-dev-rootcoord-dml_1")
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	ctx := context.Background()
+	admin := NewPulsarAdmin(server.URL + "/")
+	defer admin.Close()
+
+	topics, err := admin.ListTopics(ctx, "by-dev")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(topics))
+	assert.Equal(t, "by-dev-rootcoord-dml_0", topics[0].Name)
+	assert.Equal(t, 2, len(topics[0].Subscriptions))
+	for _, sub := range topics[0].Subscriptions {
+		if sub.Name == "sub_0" {
+			assert.Equal(t, int64(10), sub.Backlog)
+			assert.Equal(t, int64(2), sub.Consumers)
+		} else {
+			assert.Equal(t, "sub_1", sub.Name)
+			assert.Equal(t, int64(0), sub.Backlog)
+			assert.Equal(t, int64(0), sub.Consumers)
+		}
+	}
+
+	err = admin.DeleteSubscription(ctx, "by-dev-rootcoord-dml_0", "sub_1")
+	assert.Nil(t, err)
+	err = admin.DeleteTopic(ctx, "by-dev-rootcoord-dml_1")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"sub_1", "by-dev-rootcoord-dml_1"}, deleted)
+
+	err = admin.DeleteTopic(ctx, "not_exist")
+	assert.Error(t, err)
+}
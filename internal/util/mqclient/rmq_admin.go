@@ -0,0 +1,75 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package mqclient
+
+import (
+	"context"
+	"strings"
+
+	"github.com/milvus-io/milvus/internal/util/rocksmq/client/rocksmq"
+)
+
+type rmqAdmin struct {
+	server rocksmq.RocksMQ
+}
+
+// NewRmqAdmin returns an admin managing the topics of the rocksmq server
+func NewRmqAdmin(server rocksmq.RocksMQ) Admin {
+	return &rmqAdmin{server: server}
+}
+
+func (ra *rmqAdmin) ListTopics(ctx context.Context, prefix string) ([]TopicInfo, error) {
+	topics, err := ra.server.ListTopics()
+	if err != nil {
+		return nil, err
+	}
+	infos := make([]TopicInfo, 0, len(topics))
+	for _, topic := range topics {
+		if !strings.HasPrefix(topic, prefix) {
+			continue
+		}
+		groups, err := ra.server.ListConsumerGroups(topic)
+		if err != nil {
+			return nil, err
+		}
+		info := TopicInfo{Name: topic}
+		for _, group := range groups {
+			backlog, err := ra.server.GetBacklog(topic, group)
+			if err != nil {
+				return nil, err
+			}
+			// consumers live in the same process as rocksmq, so a registered group is connected
+			var consumers int64
+			if exist, _ := ra.server.ExistConsumerGroup(topic, group); exist {
+				consumers = 1
+			}
+			info.Subscriptions = append(info.Subscriptions, SubscriptionInfo{
+				Name:      group,
+				Backlog:   backlog,
+				Consumers: consumers,
+			})
+		}
+		infos = append(infos, info)
+	}
+	return infos, nil
+}
+
+func (ra *rmqAdmin) DeleteSubscription(ctx context.Context, topic string, subName string) error {
+	return ra.server.DestroyConsumerGroup(topic, subName)
+}
+
+func (ra *rmqAdmin) DeleteTopic(ctx context.Context, topic string) error {
+	return ra.server.PurgeTopic(topic)
+}
+
+func (ra *rmqAdmin) Close() {
+}
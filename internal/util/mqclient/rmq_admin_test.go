@@ -0,0 +1,54 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package mqclient
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	rocksmq1 "github.com/milvus-io/milvus/internal/util/rocksmq/server/rocksmq"
+)
+
+func TestRmqAdmin(t *testing.T) {
+	ctx := context.Background()
+	admin := NewRmqAdmin(rocksmq1.Rmq)
+	defer admin.Close()
+
+	topic := "TestRmqAdmin_topic"
+	group := "TestRmqAdmin_group"
+	err := rocksmq1.Rmq.CreateTopic(topic)
+	assert.Nil(t, err)
+	_, err = rocksmq1.Rmq.Produce(topic, []rocksmq1.ProducerMessage{{Payload: []byte("a")}, {Payload: []byte("b")}})
+	assert.Nil(t, err)
+	err = rocksmq1.Rmq.CreateConsumerGroup(topic, group)
+	assert.Nil(t, err)
+
+	topics, err := admin.ListTopics(ctx, "TestRmqAdmin")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(topics))
+	assert.Equal(t, topic, topics[0].Name)
+	assert.Equal(t, []SubscriptionInfo{{Name: group, Backlog: 2, Consumers: 0}}, topics[0].Subscriptions)
+
+	err = admin.DeleteSubscription(ctx, topic, group)
+	assert.Nil(t, err)
+	topics, err = admin.ListTopics(ctx, "TestRmqAdmin")
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(topics[0].Subscriptions))
+
+	err = admin.DeleteTopic(ctx, topic)
+	assert.Nil(t, err)
+	topics, err = admin.ListTopics(ctx, "TestRmqAdmin")
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(topics))
+}
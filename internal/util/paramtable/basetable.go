@@ -158,6 +158,35 @@ func (gp *BaseTable) tryloadFromEnv() {
 		panic(err)
 	}
 
+	pulsarWebAddress := os.Getenv("PULSAR_WEB_ADDRESS")
+	if pulsarWebAddress == "" {
+		pulsarHost, err := gp.Load("pulsar.address")
+		if err != nil {
+			panic(err)
+		}
+		webPort, err := gp.LoadWithDefault("pulsar.webport", "8080")
+		if err != nil {
+			panic(err)
+		}
+		pulsarWebAddress = "http://" + pulsarHost + ":" + webPort
+	}
+	err = gp.Save("_PulsarWebAddress", pulsarWebAddress)
+	if err != nil {
+		panic(err)
+	}
+
+	kafkaBrokerList := os.Getenv("KAFKA_BROKER_LIST")
+	if kafkaBrokerList == "" {
+		kafkaBrokerList, err = gp.LoadWithDefault("kafka.brokerList", "localhost:9092")
+		if err != nil {
+			panic(err)
+		}
+	}
+	err = gp.Save("_KafkaBrokerList", kafkaBrokerList)
+	if err != nil {
+		panic(err)
+	}
+
 	rocksmqPath := os.Getenv("ROCKSMQ_PATH")
 	if rocksmqPath == "" {
 		path, err := gp.Load("rocksmq.path")
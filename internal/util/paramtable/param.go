@@ -12,6 +12,7 @@
 package paramtable
 
 import (
+	"fmt"
 	"os"
 	"strings"
 	"sync"
@@ -21,6 +22,18 @@ import (
 	"github.com/milvus-io/milvus/internal/log"
 )
 
+const (
+	// MqTypePulsar is the mq.type of pulsar, which is the default message queue of cluster mode
+	MqTypePulsar = "pulsar"
+	// MqTypeKafka is the mq.type of kafka
+	MqTypeKafka = "kafka"
+
+	// MetaStoreTypeEtcd is the metastore.type of etcd, which is the default metadata store
+	MetaStoreTypeEtcd = "etcd"
+	// MetaStoreTypeLocal is the metastore.type of the in-process metadata store of standalone mode
+	MetaStoreTypeLocal = "local"
+)
+
 var Params BaseParamTable
 var once sync.Once
 
@@ -37,6 +50,14 @@ type BaseParamTable struct {
 	EtcdConfigPath string
 	EtcdDataDir    string
 
+	// --- Meta Store ---
+	MetaStoreType      string
+	MetaStoreLocalPath string
+
+	// --- MQ ---
+	MqType          string
+	KafkaBrokerList string
+
 	initOnce sync.Once
 
 	LogConfig *log.Config
@@ -53,6 +74,8 @@ func (p *BaseParamTable) LoadCfgToMemory() {
 	p.initEtcdConf()
 	p.initMetaRootPath()
 	p.initKvRootPath()
+	p.initMetaStoreConf()
+	p.initMqConf()
 	p.initLogCfg()
 }
 
@@ -121,6 +144,39 @@ func (p *BaseParamTable) initKvRootPath() {
 	p.KvRootPath = rootPath + "/" + subPath
 }
 
+func (p *BaseParamTable) initMetaStoreConf() {
+	metaStoreType, err := p.LoadWithDefault("metastore.type", MetaStoreTypeEtcd)
+	if err != nil {
+		panic(err)
+	}
+	if metaStoreType != MetaStoreTypeEtcd && metaStoreType != MetaStoreTypeLocal {
+		panic(fmt.Sprintf("invalid metastore.type %s, should be %s or %s", metaStoreType, MetaStoreTypeEtcd, MetaStoreTypeLocal))
+	}
+	if metaStoreType == MetaStoreTypeLocal && (os.Getenv(metricsinfo.DeployModeEnvKey) != metricsinfo.StandaloneDeployMode) {
+		panic("local meta store can not be used under distributed mode")
+	}
+	p.MetaStoreType = metaStoreType
+	p.MetaStoreLocalPath, err = p.LoadWithDefault("metastore.local.path", "/var/lib/milvus/meta_data")
+	if err != nil {
+		panic(err)
+	}
+}
+
+func (p *BaseParamTable) initMqConf() {
+	mqType, err := p.LoadWithDefault("mq.type", MqTypePulsar)
+	if err != nil {
+		panic(err)
+	}
+	if mqType != MqTypePulsar && mqType != MqTypeKafka {
+		panic(fmt.Sprintf("invalid mq.type %s, should be %s or %s", mqType, MqTypePulsar, MqTypeKafka))
+	}
+	p.MqType = mqType
+	p.KafkaBrokerList, err = p.Load("_KafkaBrokerList")
+	if err != nil {
+		panic(err)
+	}
+}
+
 func (p *BaseParamTable) initLogCfg() {
 	p.LogConfig = &log.Config{}
 	format, err := p.Load("log.format")
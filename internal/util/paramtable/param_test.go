@@ -32,6 +32,31 @@ func TestBaseParamTable(t *testing.T) {
 	assert.NotEqual(t, Params.KvRootPath, "")
 	t.Logf("kv root path = %s", Params.KvRootPath)
 
+	assert.Equal(t, MqTypePulsar, Params.MqType)
+	assert.NotEqual(t, Params.KafkaBrokerList, "")
+	t.Logf("kafka broker list = %s", Params.KafkaBrokerList)
+
+	Params.Save("mq.type", MqTypeKafka)
+	Params.initMqConf()
+	assert.Equal(t, MqTypeKafka, Params.MqType)
+	Params.Save("mq.type", "nsq")
+	assert.Panics(t, func() { Params.initMqConf() })
+	Params.Save("mq.type", MqTypePulsar)
+	Params.initMqConf()
+
+	assert.Equal(t, MetaStoreTypeEtcd, Params.MetaStoreType)
+	assert.NotEqual(t, Params.MetaStoreLocalPath, "")
+	Params.Save("metastore.type", "zookeeper")
+	assert.Panics(t, func() { Params.initMetaStoreConf() })
+	Params.Save("metastore.type", MetaStoreTypeLocal)
+	assert.Nil(t, os.Setenv(metricsinfo.DeployModeEnvKey, metricsinfo.ClusterDeployMode))
+	assert.Panics(t, func() { Params.initMetaStoreConf() })
+	assert.Nil(t, os.Setenv(metricsinfo.DeployModeEnvKey, metricsinfo.StandaloneDeployMode))
+	Params.initMetaStoreConf()
+	assert.Equal(t, MetaStoreTypeLocal, Params.MetaStoreType)
+	Params.Save("metastore.type", MetaStoreTypeEtcd)
+	Params.initMetaStoreConf()
+
 	// test UseEmbedEtcd
 	Params.Save("etcd.use.embed", "true")
 	assert.Nil(t, os.Setenv(metricsinfo.DeployModeEnvKey, metricsinfo.ClusterDeployMode))
@@ -0,0 +1,105 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package ratelimitutil
+
+import (
+	"math"
+	"sync"
+	"time"
+)
+
+// Inf is the limit of a Limiter which allows all the requests
+const Inf = math.MaxFloat64
+
+// tokenEpsilon absorbs the float rounding of the refilled tokens
+const tokenEpsilon = 1e-9
+
+// Limiter is a token bucket, which is refilled at limit tokens per second and holds at most burst tokens.
+// A request is allowed when the bucket holds its tokens. A request larger than burst only waits for a full
+// bucket, and the tokens it takes make the bucket go negative, which delays the following requests.
+type Limiter struct {
+	mu     sync.Mutex
+	limit  float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+// NewLimiter returns a Limiter with a full bucket, a negative limit means no limit
+func NewLimiter(limit float64, burst float64) *Limiter {
+	if limit < 0 {
+		limit = Inf
+	}
+	return &Limiter{
+		limit:  limit,
+		burst:  burst,
+		tokens: burst,
+		last:   time.Now(),
+	}
+}
+
+// Limit returns the current limit
+func (l *Limiter) Limit() float64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.limit
+}
+
+// SetLimit changes the limit, the tokens accumulated before now are kept, a negative limit means no limit
+func (l *Limiter) SetLimit(limit float64) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.advance(time.Now())
+	if limit < 0 {
+		limit = Inf
+	}
+	l.limit = limit
+}
+
+// advance refills the bucket with the tokens generated since the last call
+func (l *Limiter) advance(now time.Time) {
+	elapsed := now.Sub(l.last)
+	if elapsed <= 0 {
+		return
+	}
+	l.last = now
+	if l.limit == Inf {
+		l.tokens = l.burst
+		return
+	}
+	l.tokens = math.Min(l.burst, l.tokens+elapsed.Seconds()*l.limit)
+}
+
+// AllowN reports whether n tokens can be taken at now, and takes them if so
+func (l *Limiter) AllowN(now time.Time, n int) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.limit == Inf {
+		return true
+	}
+	l.advance(now)
+	if l.tokens+tokenEpsilon < math.Min(float64(n), l.burst) {
+		return false
+	}
+	l.tokens -= float64(n)
+	return true
+}
+
+// Cancel gives back the n tokens taken by AllowN, when the request is rejected by another limiter
+func (l *Limiter) Cancel(n int) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.limit == Inf {
+		return
+	}
+	l.tokens = math.Min(l.burst, l.tokens+float64(n))
+}
@@ -0,0 +1,79 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package ratelimitutil
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLimiter(t *testing.T) {
+	now := time.Now()
+
+	t.Run("no limit", func(t *testing.T) {
+		l := NewLimiter(-1, 0)
+		assert.Equal(t, Inf, l.Limit())
+		for i := 0; i < 100; i++ {
+			assert.True(t, l.AllowN(now, 1000))
+		}
+	})
+
+	t.Run("refill", func(t *testing.T) {
+		l := NewLimiter(10, 10)
+		l.last = now
+		assert.True(t, l.AllowN(now, 5))
+		assert.True(t, l.AllowN(now, 5))
+		assert.False(t, l.AllowN(now, 1))
+		assert.False(t, l.AllowN(now.Add(50*time.Millisecond), 1))
+		assert.True(t, l.AllowN(now.Add(100*time.Millisecond), 1))
+		assert.False(t, l.AllowN(now.Add(100*time.Millisecond), 1))
+
+		// the bucket holds at most burst tokens
+		assert.True(t, l.AllowN(now.Add(time.Hour), 10))
+		assert.False(t, l.AllowN(now.Add(time.Hour), 1))
+	})
+
+	t.Run("large request", func(t *testing.T) {
+		l := NewLimiter(10, 10)
+		l.last = now
+		assert.True(t, l.AllowN(now, 30))
+		// the debt is paid back before the next request is allowed
+		assert.False(t, l.AllowN(now.Add(time.Second), 1))
+		assert.False(t, l.AllowN(now.Add(2*time.Second), 1))
+		assert.True(t, l.AllowN(now.Add(2100*time.Millisecond), 1))
+		// a large request waits for a full bucket
+		assert.False(t, l.AllowN(now.Add(2500*time.Millisecond), 20))
+		assert.True(t, l.AllowN(now.Add(3100*time.Millisecond), 20))
+	})
+
+	t.Run("cancel", func(t *testing.T) {
+		l := NewLimiter(10, 10)
+		l.last = now
+		assert.True(t, l.AllowN(now, 10))
+		assert.False(t, l.AllowN(now, 1))
+		l.Cancel(10)
+		assert.True(t, l.AllowN(now, 1))
+	})
+
+	t.Run("set limit", func(t *testing.T) {
+		l := NewLimiter(-1, 10)
+		l.SetLimit(1)
+		assert.Equal(t, float64(1), l.Limit())
+		assert.True(t, l.AllowN(l.last, 10))
+		assert.False(t, l.AllowN(l.last, 1))
+		l.SetLimit(-1)
+		assert.Equal(t, Inf, l.Limit())
+		assert.True(t, l.AllowN(time.Now(), 1))
+	})
+}
@@ -0,0 +1,78 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package ratelimitutil
+
+import (
+	"math"
+	"sync"
+	"time"
+)
+
+// RateCollector counts the tokens taken in the recent window in buckets of a second, to observe their rate per second
+type RateCollector struct {
+	mu      sync.Mutex
+	buckets []float64
+	// last is the unix second of the latest bucket
+	last  int64
+	start time.Time
+}
+
+// NewRateCollector returns a RateCollector observing the rate in the recent window, which is a second at least
+func NewRateCollector(window time.Duration, now time.Time) *RateCollector {
+	size := int(math.Ceil(window.Seconds()))
+	if size < 1 {
+		size = 1
+	}
+	return &RateCollector{
+		buckets: make([]float64, size),
+		last:    now.Unix(),
+		start:   now,
+	}
+}
+
+// advance clears the buckets of the seconds passed since the latest one
+func (c *RateCollector) advance(now time.Time) {
+	sec := now.Unix()
+	if sec <= c.last {
+		return
+	}
+	size := int64(len(c.buckets))
+	for s := c.last + 1; s <= sec && s <= c.last+size; s++ {
+		c.buckets[s%size] = 0
+	}
+	c.last = sec
+}
+
+// Add counts n tokens taken at now
+func (c *RateCollector) Add(now time.Time, n float64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.advance(now)
+	if now.Unix() < c.last-int64(len(c.buckets))+1 {
+		return
+	}
+	c.buckets[now.Unix()%int64(len(c.buckets))] += n
+}
+
+// Rate returns the tokens taken per second in the window till now, including the current second, or since the
+// collector is created if it's shorter than the window
+func (c *RateCollector) Rate(now time.Time) float64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.advance(now)
+	total := 0.0
+	for _, n := range c.buckets {
+		total += n
+	}
+	seconds := math.Min(float64(len(c.buckets)), float64(c.last-c.start.Unix()+1))
+	return total / seconds
+}
@@ -0,0 +1,47 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package ratelimitutil
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRateCollector(t *testing.T) {
+	now := time.Unix(1000, 0)
+	c := NewRateCollector(5*time.Second, now)
+	assert.Equal(t, float64(0), c.Rate(now))
+
+	// the rate is averaged since the collector is created before the window is full
+	c.Add(now, 10)
+	assert.Equal(t, float64(10), c.Rate(now))
+	c.Add(now.Add(time.Second), 10)
+	assert.Equal(t, float64(10), c.Rate(now.Add(time.Second)))
+
+	for i := 2; i < 5; i++ {
+		c.Add(now.Add(time.Duration(i)*time.Second), 10)
+	}
+	assert.Equal(t, float64(10), c.Rate(now.Add(4*time.Second)))
+
+	// the tokens out of the window are dropped
+	assert.Equal(t, float64(8), c.Rate(now.Add(5*time.Second)))
+	assert.Equal(t, float64(4), c.Rate(now.Add(7*time.Second)))
+	assert.Equal(t, float64(0), c.Rate(now.Add(time.Minute)))
+
+	// the tokens too old are ignored
+	c.Add(now, 10)
+	assert.Equal(t, float64(0), c.Rate(now.Add(time.Minute)))
+	c.Add(now.Add(time.Minute), 25)
+	assert.Equal(t, float64(5), c.Rate(now.Add(time.Minute)))
+}
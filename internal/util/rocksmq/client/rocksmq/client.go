@@ -33,6 +33,8 @@ type ClientOptions struct {
 	Server RocksMQ
 	Ctx    context.Context
 	Cancel context.CancelFunc
+	// TopicRetentions are the retention policies of the topics created by the producers without ProducerOptions.Retention
+	TopicRetentions []server.TopicRetention
 }
 
 // Client is the interface rocksmq client
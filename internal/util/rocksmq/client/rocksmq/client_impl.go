@@ -25,6 +25,7 @@ type client struct {
 	server          RocksMQ
 	producerOptions []ProducerOptions
 	consumerOptions []ConsumerOptions
+	topicRetentions []server.TopicRetention
 	ctx             context.Context
 	cancel          context.CancelFunc
 	wg              *sync.WaitGroup
@@ -42,6 +43,7 @@ func newClient(options ClientOptions) (*client, error) {
 	c := &client{
 		server:          options.Server,
 		producerOptions: []ProducerOptions{},
+		topicRetentions: options.TopicRetentions,
 		ctx:             options.Ctx,
 		cancel:          options.Cancel,
 		wg:              &sync.WaitGroup{},
@@ -60,7 +62,15 @@ func (c *client) CreateProducer(options ProducerOptions) (Producer, error) {
 		return nil, newError(0, "rmq server is nil")
 	}
 	// Create a topic in rocksmq, ignore if topic exists
-	err = c.server.CreateTopic(options.Topic)
+	var topicOpts []server.TopicOption
+	retention := options.Retention
+	if retention == nil {
+		retention = server.MatchTopicRetention(c.topicRetentions, options.Topic)
+	}
+	if retention != nil {
+		topicOpts = append(topicOpts, server.WithRetention(*retention))
+	}
+	err = c.server.CreateTopic(options.Topic, topicOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -15,6 +15,7 @@ import (
 	"context"
 	"testing"
 
+	server "github.com/milvus-io/milvus/internal/util/rocksmq/server/rocksmq"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -58,6 +59,43 @@ func TestClient_CreateProducer(t *testing.T) {
 	assert.NoError(t, err)
 	defer producer1.Close()
 
+	producer2, err := client1.CreateProducer(ProducerOptions{
+		Topic:     newTopicName(),
+		Retention: &server.RetentionPolicy{Type: server.RetentionTypeNone},
+	})
+	assert.NotNil(t, producer2)
+	assert.NoError(t, err)
+	defer producer2.Close()
+
+	producer3, err := client1.CreateProducer(ProducerOptions{
+		Topic:     newTopicName(),
+		Retention: &server.RetentionPolicy{Type: server.RetentionTypeSize, SizeInMB: -1},
+	})
+	assert.Nil(t, producer3)
+	assert.Error(t, err)
+
+	// the topics are created with the retention matching their names
+	client2, err := NewClient(ClientOptions{
+		Server: rmq,
+		TopicRetentions: []server.TopicRetention{
+			{Prefix: "retention-none", Policy: server.RetentionPolicy{Type: server.RetentionTypeNone}},
+			{Prefix: "retention-invalid", Policy: server.RetentionPolicy{Type: server.RetentionTypeSize, SizeInMB: -1}},
+		},
+	})
+	assert.NoError(t, err)
+	defer client2.Close()
+	producer4, err := client2.CreateProducer(ProducerOptions{
+		Topic: "retention-none-" + newTopicName(),
+	})
+	assert.NotNil(t, producer4)
+	assert.NoError(t, err)
+	defer producer4.Close()
+	producer5, err := client2.CreateProducer(ProducerOptions{
+		Topic: "retention-invalid-" + newTopicName(),
+	})
+	assert.Nil(t, producer5)
+	assert.Error(t, err)
+
 	// /////////////////////////////////////////////////
 	// dummyTopic := strings.Repeat(newTopicName(), 100)
 	// producer2, err := client1.CreateProducer(ProducerOptions{
@@ -11,9 +11,15 @@
 
 package rocksmq
 
+import (
+	server "github.com/milvus-io/milvus/internal/util/rocksmq/server/rocksmq"
+)
+
 // ProducerOptions is the options of a producer
 type ProducerOptions struct {
 	Topic string
+	// Retention is used when the topic is created, nil means following the global retention
+	Retention *server.RetentionPolicy
 }
 
 // ProducerMessage is the message of a producer
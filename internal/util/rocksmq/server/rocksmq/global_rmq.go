@@ -13,6 +13,7 @@ package rocksmq
 
 import (
 	"os"
+	"strings"
 	"sync"
 	"sync/atomic"
 
@@ -33,6 +34,56 @@ var once sync.Once
 // Params provide params that rocksmq needs
 var params paramtable.BaseTable
 
+// TopicRetentions are the retention policies of the topics of the channels configured by rocksmq.topicRetention,
+// which the rocksmq clients of msgstream create the topics with
+var TopicRetentions []TopicRetention
+
+// retentionChannels are the names under msgChannel.chanNamePrefix whose retention can be configured
+var retentionChannels = []string{
+	"rootCoordTimeTick",
+	"rootCoordStatistics",
+	"rootCoordDml",
+	"search",
+	"searchResult",
+	"proxyTimeTick",
+	"queryTimeTick",
+	"queryNodeStats",
+	"cmd",
+	"dataCoordInsertChannel",
+	"dataCoordStatistic",
+	"dataCoordTimeTick",
+	"dataCoordSegmentInfo",
+}
+
+// loadTopicRetentions loads the retention policies configured for the channels by rocksmq.topicRetention.<channel>,
+// the topics of a channel are named ${msgChannel.chanNamePrefix.cluster}-${msgChannel.chanNamePrefix.<channel>}
+func loadTopicRetentions(params *paramtable.BaseTable) ([]TopicRetention, error) {
+	cluster, err := params.Load("msgChannel.chanNamePrefix.cluster")
+	if err != nil {
+		return nil, err
+	}
+	retentions := make([]TopicRetention, 0)
+	for _, channel := range retentionChannels {
+		value, err := params.LoadWithDefault("rocksmq.topicRetention."+channel, "")
+		if err != nil || value == "" {
+			continue
+		}
+		policy, err := ParseRetentionPolicy(value)
+		if err != nil {
+			return nil, err
+		}
+		prefix, err := params.Load("msgChannel.chanNamePrefix." + channel)
+		if err != nil {
+			return nil, err
+		}
+		retentions = append(retentions, TopicRetention{
+			Prefix: strings.Join([]string{cluster, prefix}, "-"),
+			Policy: policy,
+		})
+	}
+	return retentions, nil
+}
+
 // InitRmq is deprecate implementation of global rocksmq. will be removed later
 func InitRmq(rocksdbName string, idAllocator allocator.GIDAllocator) error {
 	var err error
@@ -67,6 +118,11 @@ func InitRocksMQ() error {
 		atomic.StoreInt64(&RocksmqRetentionTimeInMinutes, params.ParseInt64("rocksmq.retentionTimeInMinutes"))
 		atomic.StoreInt64(&RocksmqRetentionSizeInMB, params.ParseInt64("rocksmq.retentionSizeInMB"))
 		log.Debug("Rocksmq retention: ", zap.Any("RocksmqRetentionTimeInMinutes", RocksmqRetentionTimeInMinutes), zap.Any("RocksmqRetentionSizeInMB", RocksmqRetentionSizeInMB))
+		TopicRetentions, err = loadTopicRetentions(&params)
+		if err != nil {
+			panic(err)
+		}
+		log.Debug("Rocksmq topic retention", zap.Any("TopicRetentions", TopicRetentions))
 		Rmq, err = NewRocksMQ(rocksdbName, idAllocator)
 		if err != nil {
 			panic(err)
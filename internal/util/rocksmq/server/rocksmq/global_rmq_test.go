@@ -20,6 +20,7 @@ import (
 	"github.com/milvus-io/milvus/internal/allocator"
 
 	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -68,3 +69,28 @@ func Test_InitRocksMQ(t *testing.T) {
 	}
 	Rmq.RegisterConsumer(consumer)
 }
+
+func Test_loadTopicRetentions(t *testing.T) {
+	var params paramtable.BaseTable
+	params.Init()
+
+	err := params.Save("rocksmq.topicRetention.searchResult", "none")
+	assert.NoError(t, err)
+	err = params.Save("rocksmq.topicRetention.rootCoordDml", "time:60")
+	assert.NoError(t, err)
+	retentions, err := loadTopicRetentions(&params)
+	assert.NoError(t, err)
+
+	cluster, err := params.Load("msgChannel.chanNamePrefix.cluster")
+	assert.NoError(t, err)
+	dml, err := params.Load("msgChannel.chanNamePrefix.rootCoordDml")
+	assert.NoError(t, err)
+	policy := MatchTopicRetention(retentions, cluster+"-"+dml+"_0")
+	assert.NotNil(t, policy)
+	assert.Equal(t, RetentionPolicy{Type: RetentionTypeTime, TimeInMinutes: 60}, *policy)
+
+	err = params.Save("rocksmq.topicRetention.searchResult", "forever")
+	assert.NoError(t, err)
+	_, err = loadTopicRetentions(&params)
+	assert.Error(t, err)
+}
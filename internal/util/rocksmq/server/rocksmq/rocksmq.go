@@ -11,6 +11,12 @@
 
 package rocksmq
 
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
 // ProducerMessage that will be write to rocksdb
 type ProducerMessage struct {
 	Payload []byte
@@ -29,10 +35,132 @@ type ConsumerMessage struct {
 	Payload []byte
 }
 
+// RetentionType decides which threshold expires the acked messages of a topic
+type RetentionType int32
+
+// Retention types supported by rocksmq
+const (
+	// RetentionTypeDefault follows the global rocksmq.retentionTimeInMinutes and rocksmq.retentionSizeInMB
+	RetentionTypeDefault RetentionType = iota
+	// RetentionTypeTime keeps acked messages for RetentionPolicy.TimeInMinutes
+	RetentionTypeTime
+	// RetentionTypeSize keeps at most RetentionPolicy.SizeInMB of acked messages
+	RetentionTypeSize
+	// RetentionTypeNone keeps no acked message, they are removed in the next retention check
+	RetentionTypeNone
+)
+
+var retentionTypeNames = map[RetentionType]string{
+	RetentionTypeDefault: "default",
+	RetentionTypeTime:    "time",
+	RetentionTypeSize:    "size",
+	RetentionTypeNone:    "none",
+}
+
+func (t RetentionType) String() string {
+	if name, ok := retentionTypeNames[t]; ok {
+		return name
+	}
+	return fmt.Sprintf("unknown(%d)", int32(t))
+}
+
+// RetentionPolicy describes how long the acked messages of a topic are kept.
+// A message is only removed after every consumer group of the topic has acked it.
+type RetentionPolicy struct {
+	Type          RetentionType
+	TimeInMinutes int64
+	SizeInMB      int64
+}
+
+// Validate checks whether the thresholds match the retention type
+func (p *RetentionPolicy) Validate() error {
+	switch p.Type {
+	case RetentionTypeDefault, RetentionTypeNone:
+		return nil
+	case RetentionTypeTime:
+		if p.TimeInMinutes < 0 {
+			return fmt.Errorf("invalid retention time %d minutes", p.TimeInMinutes)
+		}
+		return nil
+	case RetentionTypeSize:
+		if p.SizeInMB < 0 {
+			return fmt.Errorf("invalid retention size %d MB", p.SizeInMB)
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown retention type %s", p.Type)
+	}
+}
+
+// ParseRetentionPolicy parses the retention policy configured as "default", "none", "time:<minutes>" or "size:<MB>"
+func ParseRetentionPolicy(value string) (RetentionPolicy, error) {
+	value = strings.ToLower(strings.TrimSpace(value))
+	switch value {
+	case retentionTypeNames[RetentionTypeDefault]:
+		return RetentionPolicy{Type: RetentionTypeDefault}, nil
+	case retentionTypeNames[RetentionTypeNone]:
+		return RetentionPolicy{Type: RetentionTypeNone}, nil
+	}
+	fields := strings.SplitN(value, ":", 2)
+	if len(fields) != 2 {
+		return RetentionPolicy{}, fmt.Errorf("invalid retention policy %q", value)
+	}
+	threshold, err := strconv.ParseInt(strings.TrimSpace(fields[1]), 10, 64)
+	if err != nil {
+		return RetentionPolicy{}, fmt.Errorf("invalid retention policy %q: %w", value, err)
+	}
+	var policy RetentionPolicy
+	switch fields[0] {
+	case retentionTypeNames[RetentionTypeTime]:
+		policy = RetentionPolicy{Type: RetentionTypeTime, TimeInMinutes: threshold}
+	case retentionTypeNames[RetentionTypeSize]:
+		policy = RetentionPolicy{Type: RetentionTypeSize, SizeInMB: threshold}
+	default:
+		return RetentionPolicy{}, fmt.Errorf("invalid retention policy %q", value)
+	}
+	return policy, policy.Validate()
+}
+
+// TopicRetention is the retention policy of the topics whose names start with Prefix
+type TopicRetention struct {
+	Prefix string
+	Policy RetentionPolicy
+}
+
+// MatchTopicRetention returns the policy of the longest prefix matching the topic, nil if none matches
+func MatchTopicRetention(retentions []TopicRetention, topicName string) *RetentionPolicy {
+	var matched *TopicRetention
+	for i := range retentions {
+		if strings.HasPrefix(topicName, retentions[i].Prefix) && (matched == nil || len(retentions[i].Prefix) > len(matched.Prefix)) {
+			matched = &retentions[i]
+		}
+	}
+	if matched == nil {
+		return nil
+	}
+	policy := matched.Policy
+	return &policy
+}
+
+// TopicOptions contains the options used when creating a topic
+type TopicOptions struct {
+	Retention *RetentionPolicy
+}
+
+// TopicOption is used to set the TopicOptions of CreateTopic
+type TopicOption func(*TopicOptions)
+
+// WithRetention sets the retention policy of the created topic
+func WithRetention(policy RetentionPolicy) TopicOption {
+	return func(opts *TopicOptions) {
+		opts.Retention = &policy
+	}
+}
+
 // RocksMQ is an interface thatmay be implemented by the application
 // to do message queue operations based ion rocksdb
 type RocksMQ interface {
-	CreateTopic(topicName string) error
+	CreateTopic(topicName string, opts ...TopicOption) error
 	DestroyTopic(topicName string) error
 	CreateConsumerGroup(topicName string, groupName string) error
 	DestroyConsumerGroup(topicName string, groupName string) error
@@ -46,4 +174,10 @@ type RocksMQ interface {
 	ExistConsumerGroup(topicName string, groupName string) (bool, *Consumer)
 
 	Notify(topicName, groupName string)
+
+	// admin interfaces
+	ListTopics() ([]string, error)
+	ListConsumerGroups(topicName string) ([]string, error)
+	GetBacklog(topicName string, groupName string) (int64, error)
+	PurgeTopic(topicName string) error
 }
@@ -12,8 +12,10 @@
 package rocksmq
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
+	"math"
 	"strconv"
 	"sync"
 	"time"
@@ -21,6 +23,7 @@ import (
 	"github.com/milvus-io/milvus/internal/allocator"
 	"github.com/milvus-io/milvus/internal/kv"
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metrics"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 	"github.com/tecbot/gorocksdb"
 	"go.uber.org/zap"
@@ -49,6 +52,7 @@ const (
 	AckedTsTitle      = "acked_ts/"
 	AckedSizeTitle    = "acked_size/"
 	LastRetTsTitle    = "last_retention_ts/"
+	RetentionTitle    = "retention_policy/"
 )
 
 /**
@@ -192,7 +196,17 @@ func (rmq *rocksmq) checkKeyExist(key string) bool {
 	return val != ""
 }
 
-func (rmq *rocksmq) CreateTopic(topicName string) error {
+func (rmq *rocksmq) CreateTopic(topicName string, opts ...TopicOption) error {
+	options := &TopicOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+	if options.Retention != nil {
+		if err := options.Retention.Validate(); err != nil {
+			return err
+		}
+	}
+
 	beginKey := topicName + "/begin_id"
 	endKey := topicName + "/end_id"
 
@@ -247,6 +261,20 @@ func (rmq *rocksmq) CreateTopic(topicName string) error {
 	if err != nil {
 		return nil
 	}
+	// Persist the retention policy of topic, topics without policy follow the global one
+	if options.Retention != nil {
+		policyValue, err := json.Marshal(options.Retention)
+		if err != nil {
+			return err
+		}
+		err = rmq.kv.Save(RetentionTitle+topicName, string(policyValue))
+		if err != nil {
+			return err
+		}
+		rmq.retentionInfo.policies.Store(topicName, options.Retention)
+		log.Debug("RocksMQ: set retention policy", zap.String("topic", topicName), zap.Stringer("type", options.Retention.Type),
+			zap.Int64("timeInMinutes", options.Retention.TimeInMinutes), zap.Int64("sizeInMB", options.Retention.SizeInMB))
+	}
 	rmq.retentionInfo.topics = append(rmq.retentionInfo.topics, topicName)
 	rmq.retentionInfo.pageInfo.Store(topicName, &topicPageInfo{
 		pageEndID:   make([]UniqueID, 0),
@@ -298,8 +326,13 @@ func (rmq *rocksmq) DestroyTopic(topicName string) error {
 	if err != nil {
 		return err
 	}
+	err = rmq.kv.Remove(RetentionTitle + topicName)
+	if err != nil {
+		return err
+	}
 
 	topicMu.Delete(topicName)
+	rmq.retentionInfo.policies.Delete(topicName)
 	rmq.retentionInfo.ackedInfo.Delete(topicName)
 	rmq.retentionInfo.lastRetentionTime.Delete(topicName)
 	rmq.retentionInfo.pageInfo.Delete(topicName)
@@ -332,7 +365,13 @@ func (rmq *rocksmq) CreateConsumerGroup(topicName, groupName string) error {
 		return err
 	}
 
-	return nil
+	// Record the acked position of the new group, so that retention won't remove
+	// the messages it has not consumed yet
+	fixedBeginIDKey, err := constructKey(BeginIDTitle, topicName)
+	if err != nil {
+		return err
+	}
+	return rmq.kv.Save(fixedBeginIDKey+"/"+groupName, DefaultMessageID)
 }
 
 func (rmq *rocksmq) RegisterConsumer(consumer *Consumer) {
@@ -369,6 +408,14 @@ func (rmq *rocksmq) DestroyConsumerGroup(topicName, groupName string) error {
 	if err != nil {
 		return err
 	}
+	fixedBeginIDKey, err := constructKey(BeginIDTitle, topicName)
+	if err != nil {
+		return err
+	}
+	err = rmq.kv.Remove(fixedBeginIDKey + "/" + groupName)
+	if err != nil {
+		return err
+	}
 	if vals, ok := rmq.consumers.Load(topicName); ok {
 		consumers := vals.([]*Consumer)
 		for index, v := range consumers {
@@ -691,23 +738,30 @@ func (rmq *rocksmq) UpdateAckedInfo(topicName, groupName string, newID UniqueID,
 		return err
 	}
 
-	// Update begin_id for topic
+	// Update begin_id for topic, which is the position acked by the slowest consumer group
 	if vals, ok := rmq.consumers.Load(topicName); ok {
-		var minBeginID int64 = -1
+		var minBeginID int64 = math.MaxInt64
 		for _, v := range vals.([]*Consumer) {
 			curBeginIDKey := fixedBeginIDKey + "/" + v.GroupName
 			curBeginIDVal, err := rmq.kv.Load(curBeginIDKey)
 			if err != nil {
 				return err
 			}
+			if curBeginIDVal == "" {
+				continue
+			}
 			curBeginID, err := strconv.ParseInt(curBeginIDVal, 10, 64)
 			if err != nil {
 				return err
 			}
-			if curBeginID > minBeginID {
+			if curBeginID < minBeginID {
 				minBeginID = curBeginID
 			}
 		}
+		// Some consumer group hasn't acked any message, nothing is acked by all groups
+		if minBeginID == math.MaxInt64 || minBeginID < 0 {
+			return nil
+		}
 		topicBeginIDKey := TopicBeginIDTitle + topicName
 		err = rmq.kv.Save(topicBeginIDKey, strconv.FormatInt(minBeginID, 10))
 		if err != nil {
@@ -751,7 +805,103 @@ func (rmq *rocksmq) UpdateAckedInfo(topicName, groupName string, newID UniqueID,
 				ackedInfo.ackedSize = ackedSize
 				rmq.retentionInfo.ackedInfo.Store(topicName, ackedInfo)
 			}
+			metrics.RocksmqAckedSize.WithLabelValues(topicName).Set(float64(ackedSize))
 		}
 	}
 	return nil
 }
+
+// ListTopics lists all the topics in rocksmq
+func (rmq *rocksmq) ListTopics() ([]string, error) {
+	keys, _, err := prefixScan(rmq.retentionInfo.kv.DB, TopicBeginIDTitle)
+	if err != nil {
+		return nil, err
+	}
+	topics := make([]string, 0, len(keys))
+	for _, key := range keys {
+		topics = append(topics, key[len(TopicBeginIDTitle):])
+	}
+	return topics, nil
+}
+
+// ListConsumerGroups lists the consumer groups of topic
+func (rmq *rocksmq) ListConsumerGroups(topicName string) ([]string, error) {
+	fixedBeginIDKey, err := constructKey(BeginIDTitle, topicName)
+	if err != nil {
+		return nil, err
+	}
+	keys, _, err := prefixLoad(rmq.retentionInfo.kv.DB, fixedBeginIDKey+"/")
+	if err != nil {
+		return nil, err
+	}
+	groups := make([]string, 0, len(keys))
+	for _, key := range keys {
+		groups = append(groups, key[FixedChannelNameLen+1:])
+	}
+	return groups, nil
+}
+
+// GetBacklog returns the number of messages that consumer group hasn't consumed
+func (rmq *rocksmq) GetBacklog(topicName string, groupName string) (int64, error) {
+	metaKey := groupName + "/" + topicName + "/current_id"
+	currentID, err := rmq.kv.Load(metaKey)
+	if err != nil {
+		return 0, err
+	}
+	if currentID == "" {
+		return 0, fmt.Errorf("ConsumerGroup %s, channel %s not exists", groupName, topicName)
+	}
+	fixChanName, err := fixChannelName(topicName)
+	if err != nil {
+		return 0, err
+	}
+
+	readOpts := gorocksdb.NewDefaultReadOptions()
+	defer readOpts.Destroy()
+	readOpts.SetPrefixSameAsStart(true)
+	iter := rmq.store.NewIterator(readOpts)
+	defer iter.Close()
+
+	if iter.Seek([]byte(fixChanName + "/" + currentID)); currentID != DefaultMessageID && iter.Valid() {
+		iter.Next()
+	} else {
+		iter.Seek([]byte(fixChanName + "/"))
+	}
+	var backlog int64
+	for ; iter.Valid(); iter.Next() {
+		backlog++
+	}
+	return backlog, iter.Err()
+}
+
+// PurgeTopic destroys topic with its consumer groups, and removes all the messages of it
+func (rmq *rocksmq) PurgeTopic(topicName string) error {
+	groups, err := rmq.ListConsumerGroups(topicName)
+	if err != nil {
+		return err
+	}
+	for _, group := range groups {
+		if err := rmq.DestroyConsumerGroup(topicName, group); err != nil {
+			return err
+		}
+	}
+	if err := rmq.DestroyTopic(topicName); err != nil {
+		return err
+	}
+
+	fixChanName, err := fixChannelName(topicName)
+	if err != nil {
+		return err
+	}
+	writeBatch := gorocksdb.NewWriteBatch()
+	defer writeBatch.Destroy()
+	// '0' is the next character of '/', so the range covers all the message keys of topic
+	writeBatch.DeleteRange([]byte(fixChanName+"/"), []byte(fixChanName+"0"))
+	opts := gorocksdb.NewDefaultWriteOptions()
+	defer opts.Destroy()
+	if err := rmq.store.Write(opts, writeBatch); err != nil {
+		return err
+	}
+	log.Debug("RocksMQ: purge topic", zap.String("topic", topicName), zap.Strings("groups", groups))
+	return nil
+}
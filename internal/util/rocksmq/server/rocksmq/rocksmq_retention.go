@@ -13,16 +13,19 @@ package rocksmq
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"math"
 	"strconv"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
 
 	rocksdbkv "github.com/milvus-io/milvus/internal/kv/rocksdb"
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metrics"
 	"github.com/tecbot/gorocksdb"
 	"go.uber.org/zap"
 )
@@ -65,6 +68,8 @@ type retentionInfo struct {
 	// Key is last_retention_time/${topic}
 	// lastRetentionTime map[string]int64
 	lastRetentionTime sync.Map
+	// policies map[string]*RetentionPolicy, topics without policy follow the global one
+	policies sync.Map
 
 	kv *rocksdbkv.RocksdbKV
 	db *gorocksdb.DB
@@ -96,6 +101,33 @@ func prefixLoad(db *gorocksdb.DB, prefix string) ([]string, []string, error) {
 	return keys, values, nil
 }
 
+// prefixScan loads the keys with a prefix of any length. Unlike prefixLoad, it doesn't rely on
+// the fixed length prefix extractor of db, so it's slower and only used by admin interfaces.
+func prefixScan(db *gorocksdb.DB, prefix string) ([]string, []string, error) {
+	if db == nil {
+		return nil, nil, errors.New("Rocksdb instance is nil when do prefixScan")
+	}
+	readOpts := gorocksdb.NewDefaultReadOptions()
+	defer readOpts.Destroy()
+	iter := db.NewIterator(readOpts)
+	defer iter.Close()
+	keys := make([]string, 0)
+	values := make([]string, 0)
+	for iter.Seek([]byte(prefix)); iter.Valid(); iter.Next() {
+		keySlice := iter.Key()
+		key := string(keySlice.Data())
+		keySlice.Free()
+		if !strings.HasPrefix(key, prefix) {
+			break
+		}
+		value := iter.Value()
+		keys = append(keys, key)
+		values = append(values, string(value.Data()))
+		value.Free()
+	}
+	return keys, values, iter.Err()
+}
+
 func initRetentionInfo(kv *rocksdbkv.RocksdbKV, db *gorocksdb.DB) (*retentionInfo, error) {
 	ctx, cancel := context.WithCancel(context.Background())
 	ri := &retentionInfo{
@@ -105,6 +137,7 @@ func initRetentionInfo(kv *rocksdbkv.RocksdbKV, db *gorocksdb.DB) (*retentionInf
 		pageInfo:          sync.Map{},
 		ackedInfo:         sync.Map{},
 		lastRetentionTime: sync.Map{},
+		policies:          sync.Map{},
 		kv:                kv,
 		db:                db,
 	}
@@ -266,11 +299,65 @@ func (ri *retentionInfo) loadRetentionInfo(topic string, wg *sync.WaitGroup) {
 		}
 	}
 
+	// Load retention policy
+	policyVal, err := ri.kv.Load(RetentionTitle + topic)
+	if err != nil {
+		log.Debug("Load failed", zap.Any("error", err))
+		return
+	}
+	if policyVal != "" {
+		policy := &RetentionPolicy{}
+		if err := json.Unmarshal([]byte(policyVal), policy); err != nil {
+			log.Warn("Unmarshal retention policy failed", zap.String("topic", topic), zap.Error(err))
+			return
+		}
+		ri.policies.Store(topic, policy)
+	}
+
 	ri.ackedInfo.Store(topic, ackedInfo)
 	ri.pageInfo.Store(topic, topicPageInfo)
 	ri.lastRetentionTime.Store(topic, lastRetentionTs)
 }
 
+// getPolicy returns the retention policy of topic
+func (ri *retentionInfo) getPolicy(topic string) *RetentionPolicy {
+	if policy, ok := ri.policies.Load(topic); ok {
+		return policy.(*RetentionPolicy)
+	}
+	return &RetentionPolicy{Type: RetentionTypeDefault}
+}
+
+// ackedLimit returns the largest message id acked by all consumer groups of topic.
+// Messages after it must be kept no matter what the retention policy is.
+func (ri *retentionInfo) ackedLimit(topic string) (UniqueID, bool, error) {
+	fixedBeginIDKey, err := constructKey(BeginIDTitle, topic)
+	if err != nil {
+		return 0, false, err
+	}
+	_, vals, err := prefixLoad(ri.kv.DB, fixedBeginIDKey+"/")
+	if err != nil {
+		return 0, false, err
+	}
+	if len(vals) == 0 {
+		return 0, false, nil
+	}
+	var limit UniqueID = math.MaxInt64
+	for _, val := range vals {
+		beginID, err := strconv.ParseInt(val, 10, 64)
+		if err != nil {
+			return 0, false, err
+		}
+		// The consumer group hasn't acked any message
+		if beginID < 0 {
+			return 0, false, nil
+		}
+		if beginID < limit {
+			limit = beginID
+		}
+	}
+	return limit, true, nil
+}
+
 func (ri *retentionInfo) retention() error {
 	log.Debug("Rocksmq retention goroutine start!")
 	// Do retention check every 6s
@@ -283,13 +370,16 @@ func (ri *retentionInfo) retention() error {
 			return nil
 		case t := <-ticker.C:
 			timeNow := t.Unix()
-			checkTime := atomic.LoadInt64(&RocksmqRetentionTimeInMinutes) * 60 / 10
 			log.Debug("In ticker: ", zap.Any("ticker", timeNow))
 			ri.lastRetentionTime.Range(func(k, v interface{}) bool {
-				if v.(int64)+checkTime < timeNow {
-					err := ri.expiredCleanUp(k.(string))
+				topic := k.(string)
+				if v.(int64)+ri.getPolicy(topic).checkInterval() < timeNow {
+					err := ri.expiredCleanUp(topic)
 					if err != nil {
+						metrics.RocksmqRetentionCleanupCounter.WithLabelValues(topic, "fail").Inc()
 						log.Warn("Retention expired clean failed", zap.Any("error", err))
+					} else {
+						metrics.RocksmqRetentionCleanupCounter.WithLabelValues(topic, "success").Inc()
 					}
 				}
 				return true
@@ -324,6 +414,17 @@ func (ri *retentionInfo) expiredCleanUp(topic string) error {
 	lock.Lock()
 	defer lock.Unlock()
 
+	policy := ri.getPolicy(topic)
+	// Only messages acked by all consumer groups can be removed
+	ackedLimit, ok, err := ri.ackedLimit(topic)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		log.Debug("Topic "+topic+" has consumer group without acked message", zap.Any("policy", policy.Type.String()))
+		return nil
+	}
+
 	readOpts := gorocksdb.NewDefaultReadOptions()
 	defer readOpts.Destroy()
 	readOpts.SetPrefixSameAsStart(true)
@@ -353,8 +454,11 @@ func (ri *retentionInfo) expiredCleanUp(topic string) error {
 	}
 	if pageInfo != nil {
 		for i, pageEndID := range pageInfo.pageEndID {
-			// Clean by RocksmqRetentionTimeInMinutes
-			if msgTimeExpiredCheck(ackedInfo.ackedTs[pageEndID]) {
+			if pageEndID > ackedLimit {
+				break
+			}
+			// Clean by retention time
+			if policy.timeExpired(ackedInfo.ackedTs[pageEndID]) {
 				// All of the page expired, set the pageEndID to current endID
 				endID = pageEndID
 				fixedAckedTsKey, err := constructKey(AckedTsTitle, topic)
@@ -379,7 +483,7 @@ func (ri *retentionInfo) expiredCleanUp(topic string) error {
 		if err != nil {
 			return err
 		}
-		if msgTimeExpiredCheck(ackedTs) {
+		if policy.timeExpired(ackedTs) {
 			endID, err = strconv.ParseInt(string(iter.Key().Data())[FixedChannelNameLen+1:], 10, 64)
 			if err != nil {
 				return err
@@ -388,6 +492,9 @@ func (ri *retentionInfo) expiredCleanUp(topic string) error {
 			break
 		}
 	}
+	if endID > ackedLimit {
+		endID = ackedLimit
+	}
 	log.Debug("Expired check by retention time", zap.Any("topic", topic), zap.Any("startID", startID), zap.Any("endID", endID), zap.Any("deletedAckedSize", deletedAckedSize))
 	// if endID == 0 {
 	// 	log.Debug("All messages are not expired")
@@ -397,10 +504,13 @@ func (ri *retentionInfo) expiredCleanUp(topic string) error {
 	// Delete page message size in rocksdb_kv
 	if pageInfo != nil {
 		// Judge expire by ackedSize
-		if msgSizeExpiredCheck(deletedAckedSize, ackedInfo.ackedSize) {
+		if policy.sizeExpired(deletedAckedSize, ackedInfo.ackedSize) {
 			for _, pEndID := range pageInfo.pageEndID[pageRetentionOffset:] {
+				if pEndID > ackedLimit {
+					break
+				}
 				curDeletedSize := deletedAckedSize + pageInfo.pageMsgSize[pEndID]
-				if msgSizeExpiredCheck(curDeletedSize, ackedInfo.ackedSize) {
+				if policy.sizeExpired(curDeletedSize, ackedInfo.ackedSize) {
 					endID = pEndID
 					pageEndID = pEndID
 					deletedAckedSize = curDeletedSize
@@ -473,6 +583,9 @@ func (ri *retentionInfo) expiredCleanUp(topic string) error {
 	if err != nil {
 		return err
 	}
+	metrics.RocksmqRetentionDeletedSize.WithLabelValues(topic).Add(float64(deletedAckedSize))
+	metrics.RocksmqAckedSize.WithLabelValues(topic).Set(float64(ackedInfo.ackedSize))
+	metrics.RocksmqLastRetentionTime.WithLabelValues(topic).SetToCurrentTime()
 
 	for k := range ackedInfo.ackedTs {
 		if k < endID {
@@ -524,3 +637,41 @@ func msgTimeExpiredCheck(ackedTs int64) bool {
 func msgSizeExpiredCheck(deletedAckedSize, ackedSize int64) bool {
 	return ackedSize-deletedAckedSize > atomic.LoadInt64(&RocksmqRetentionSizeInMB)*MB
 }
+
+// checkInterval returns the interval in seconds between two expired checks of the policy
+func (p *RetentionPolicy) checkInterval() int64 {
+	switch p.Type {
+	case RetentionTypeTime:
+		return p.TimeInMinutes * MINUTE / 10
+	case RetentionTypeSize, RetentionTypeNone:
+		return 0
+	default:
+		return atomic.LoadInt64(&RocksmqRetentionTimeInMinutes) * MINUTE / 10
+	}
+}
+
+func (p *RetentionPolicy) timeExpired(ackedTs int64) bool {
+	switch p.Type {
+	case RetentionTypeTime:
+		return ackedTs+p.TimeInMinutes*MINUTE < time.Now().Unix()
+	case RetentionTypeSize:
+		return false
+	case RetentionTypeNone:
+		return true
+	default:
+		return msgTimeExpiredCheck(ackedTs)
+	}
+}
+
+func (p *RetentionPolicy) sizeExpired(deletedAckedSize, ackedSize int64) bool {
+	switch p.Type {
+	case RetentionTypeTime:
+		return false
+	case RetentionTypeSize:
+		return ackedSize-deletedAckedSize > p.SizeInMB*MB
+	case RetentionTypeNone:
+		return ackedSize-deletedAckedSize > 0
+	default:
+		return msgSizeExpiredCheck(deletedAckedSize, ackedSize)
+	}
+}
@@ -422,3 +422,148 @@ func TestRmqRetention_PageTimeExpire(t *testing.T) {
 	assert.Equal(t, len(newRes), 0)
 	// assert.NotEqual(t, newRes[0].MsgID, cMsgs[11].MsgID)
 }
+
+func TestRetentionPolicy(t *testing.T) {
+	assert.NoError(t, (&RetentionPolicy{}).Validate())
+	assert.NoError(t, (&RetentionPolicy{Type: RetentionTypeNone}).Validate())
+	assert.NoError(t, (&RetentionPolicy{Type: RetentionTypeTime, TimeInMinutes: 10}).Validate())
+	assert.Error(t, (&RetentionPolicy{Type: RetentionTypeTime, TimeInMinutes: -1}).Validate())
+	assert.NoError(t, (&RetentionPolicy{Type: RetentionTypeSize, SizeInMB: 10}).Validate())
+	assert.Error(t, (&RetentionPolicy{Type: RetentionTypeSize, SizeInMB: -1}).Validate())
+	assert.Error(t, (&RetentionPolicy{Type: RetentionType(100)}).Validate())
+
+	now := time.Now().Unix()
+	timePolicy := &RetentionPolicy{Type: RetentionTypeTime, TimeInMinutes: 1}
+	assert.Equal(t, int64(6), timePolicy.checkInterval())
+	assert.True(t, timePolicy.timeExpired(now-2*MINUTE))
+	assert.False(t, timePolicy.timeExpired(now))
+	assert.False(t, timePolicy.sizeExpired(0, 100*MB))
+
+	sizePolicy := &RetentionPolicy{Type: RetentionTypeSize, SizeInMB: 1}
+	assert.Equal(t, int64(0), sizePolicy.checkInterval())
+	assert.False(t, sizePolicy.timeExpired(0))
+	assert.True(t, sizePolicy.sizeExpired(0, 2*MB))
+	assert.False(t, sizePolicy.sizeExpired(MB, 2*MB))
+
+	nonePolicy := &RetentionPolicy{Type: RetentionTypeNone}
+	assert.Equal(t, int64(0), nonePolicy.checkInterval())
+	assert.True(t, nonePolicy.timeExpired(now))
+	assert.True(t, nonePolicy.sizeExpired(0, 1))
+	assert.False(t, nonePolicy.sizeExpired(1, 1))
+}
+
+func TestParseRetentionPolicy(t *testing.T) {
+	policy, err := ParseRetentionPolicy("none")
+	assert.NoError(t, err)
+	assert.Equal(t, RetentionPolicy{Type: RetentionTypeNone}, policy)
+	policy, err = ParseRetentionPolicy(" Default ")
+	assert.NoError(t, err)
+	assert.Equal(t, RetentionPolicy{Type: RetentionTypeDefault}, policy)
+	policy, err = ParseRetentionPolicy("time:30")
+	assert.NoError(t, err)
+	assert.Equal(t, RetentionPolicy{Type: RetentionTypeTime, TimeInMinutes: 30}, policy)
+	policy, err = ParseRetentionPolicy("size: 128")
+	assert.NoError(t, err)
+	assert.Equal(t, RetentionPolicy{Type: RetentionTypeSize, SizeInMB: 128}, policy)
+
+	for _, value := range []string{"", "time", "time:abc", "size:-1", "count:10"} {
+		_, err = ParseRetentionPolicy(value)
+		assert.Error(t, err, value)
+	}
+}
+
+func TestMatchTopicRetention(t *testing.T) {
+	retentions := []TopicRetention{
+		{Prefix: "by-dev-search", Policy: RetentionPolicy{Type: RetentionTypeTime, TimeInMinutes: 10}},
+		{Prefix: "by-dev-searchResult", Policy: RetentionPolicy{Type: RetentionTypeNone}},
+	}
+	assert.Nil(t, MatchTopicRetention(retentions, "by-dev-rootcoord-dml_0"))
+	assert.Equal(t, RetentionTypeTime, MatchTopicRetention(retentions, "by-dev-search-0").Type)
+	// the longest prefix wins
+	assert.Equal(t, RetentionTypeNone, MatchTopicRetention(retentions, "by-dev-searchResult-1").Type)
+	assert.Nil(t, MatchTopicRetention(nil, "by-dev-search-0"))
+}
+
+func TestRmqRetention_Policy(t *testing.T) {
+	atomic.StoreInt64(&RocksmqRetentionSizeInMB, 1024)
+	atomic.StoreInt64(&RocksmqRetentionTimeInMinutes, 60)
+	kvPath := retentionPath + "kv_policy"
+	defer os.RemoveAll(kvPath)
+	idAllocator := InitIDAllocator(kvPath)
+
+	rocksdbPath := retentionPath + "db_policy"
+	defer os.RemoveAll(rocksdbPath)
+	metaPath := retentionPath + "db_policy" + kvSuffix
+	defer os.RemoveAll(metaPath)
+
+	rmq, err := NewRocksMQ(rocksdbPath, idAllocator)
+	assert.Nil(t, err)
+	defer rmq.stopRetention()
+
+	err = rmq.CreateTopic("topic_invalid", WithRetention(RetentionPolicy{Type: RetentionTypeTime, TimeInMinutes: -1}))
+	assert.Error(t, err)
+
+	topicName := "topic_none"
+	err = rmq.CreateTopic(topicName, WithRetention(RetentionPolicy{Type: RetentionTypeNone}))
+	assert.Nil(t, err)
+	defer rmq.DestroyTopic(topicName)
+	assert.Equal(t, RetentionTypeNone, rmq.retentionInfo.getPolicy(topicName).Type)
+	assert.Equal(t, RetentionTypeDefault, rmq.retentionInfo.getPolicy("topic_unknown").Type)
+
+	msgNum := 100
+	pMsgs := make([]ProducerMessage, msgNum)
+	for i := 0; i < msgNum; i++ {
+		pMsgs[i] = ProducerMessage{Payload: []byte("message_" + strconv.Itoa(i))}
+	}
+	ids, err := rmq.Produce(topicName, pMsgs)
+	assert.Nil(t, err)
+	assert.Equal(t, len(pMsgs), len(ids))
+
+	fastGroup := "fast_group"
+	slowGroup := "slow_group"
+	for _, groupName := range []string{fastGroup, slowGroup} {
+		err = rmq.CreateConsumerGroup(topicName, groupName)
+		assert.Nil(t, err)
+		rmq.RegisterConsumer(&Consumer{Topic: topicName, GroupName: groupName})
+	}
+
+	// The slow group hasn't acked any message, nothing can be removed
+	for i := 0; i < msgNum; i++ {
+		_, err := rmq.Consume(topicName, fastGroup, 1)
+		assert.Nil(t, err)
+	}
+	_, ok, err := rmq.retentionInfo.ackedLimit(topicName)
+	assert.Nil(t, err)
+	assert.False(t, ok)
+
+	// Messages after the position of the slow group must be kept
+	for i := 0; i < msgNum/2; i++ {
+		_, err := rmq.Consume(topicName, slowGroup, 1)
+		assert.Nil(t, err)
+	}
+	// Acked info is updated asynchronously
+	time.Sleep(100 * time.Millisecond)
+	limit, ok, err := rmq.retentionInfo.ackedLimit(topicName)
+	assert.Nil(t, err)
+	assert.True(t, ok)
+	assert.LessOrEqual(t, limit, ids[msgNum/2-1])
+
+	time.Sleep(time.Duration(TickerTimeInMinutes*MINUTE/10+1) * time.Second)
+	// Seek to a removed message, consume starts after the position of the slow group
+	err = rmq.Seek(topicName, fastGroup, ids[0])
+	assert.Nil(t, err)
+	cMsgs, err := rmq.Consume(topicName, fastGroup, msgNum)
+	assert.Nil(t, err)
+	assert.NotEmpty(t, cMsgs)
+	assert.Greater(t, cMsgs[0].MsgID, ids[1])
+	assert.LessOrEqual(t, cMsgs[0].MsgID, ids[msgNum/2])
+
+	// Policy is reloaded after restart
+	rmq.stopRetention()
+	ri, err := initRetentionInfo(rmq.retentionInfo.kv, rmq.store)
+	assert.Nil(t, err)
+	var wg sync.WaitGroup
+	wg.Add(1)
+	ri.loadRetentionInfo(topicName, &wg)
+	assert.Equal(t, RetentionTypeNone, ri.getPolicy(topicName).Type)
+}
@@ -0,0 +1,127 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package scalarindex
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/bits-and-blooms/bitset"
+
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/indexparamcheck"
+)
+
+// bitmapIndex keeps a bitmap of rows for each distinct key, it's only built on low-cardinality fields
+// since the size grows with the number of distinct keys.
+type bitmapIndex struct {
+	dataType         schemapb.DataType
+	cardinalityLimit int
+	rowCount         int
+	keys             []string
+	bitmaps          []*bitset.BitSet
+}
+
+func (idx *bitmapIndex) IndexType() string {
+	return indexparamcheck.IndexScalarBitmap
+}
+
+func (idx *bitmapIndex) DataType() schemapb.DataType {
+	return idx.dataType
+}
+
+func (idx *bitmapIndex) RowCount() int {
+	return idx.rowCount
+}
+
+func (idx *bitmapIndex) Build(keys []string) error {
+	if err := checkRowCount(keys); err != nil {
+		return err
+	}
+	distinct, postings := distinctKeys(keys)
+	if len(distinct) > idx.cardinalityLimit {
+		return fmt.Errorf("too many distinct values for bitmap index, cardinality: %d, limit: %d",
+			len(distinct), idx.cardinalityLimit)
+	}
+	idx.bitmaps = make([]*bitset.BitSet, len(distinct))
+	for i, posting := range postings {
+		idx.bitmaps[i] = bitset.New(uint(len(keys)))
+		for _, offset := range posting {
+			idx.bitmaps[i].Set(uint(offset))
+		}
+	}
+	idx.keys = distinct
+	idx.rowCount = len(keys)
+	return nil
+}
+
+func (idx *bitmapIndex) Serialize() ([]byte, error) {
+	var buf bytes.Buffer
+	writeHeader(&buf, idx)
+	writeUint32(&buf, uint32(len(idx.keys)))
+	for _, key := range idx.keys {
+		writeString(&buf, key)
+	}
+	for _, bitmap := range idx.bitmaps {
+		data, err := bitmap.MarshalBinary()
+		if err != nil {
+			return nil, err
+		}
+		writeString(&buf, string(data))
+	}
+	return buf.Bytes(), nil
+}
+
+func (idx *bitmapIndex) load(r *bytes.Reader, rowCount int) error {
+	keys, err := readSortedStrings(r)
+	if err != nil {
+		return err
+	}
+	bitmaps := make([]*bitset.BitSet, len(keys))
+	for i := range bitmaps {
+		data, err := readString(r)
+		if err != nil {
+			return err
+		}
+		bitmaps[i] = &bitset.BitSet{}
+		if err := bitmaps[i].UnmarshalBinary([]byte(data)); err != nil {
+			return err
+		}
+		if bitmaps[i].Len() != uint(rowCount) {
+			return errors.New("row count mismatch in bitmap index")
+		}
+	}
+	idx.keys, idx.bitmaps, idx.rowCount = keys, bitmaps, rowCount
+	idx.cardinalityLimit = len(keys)
+	return nil
+}
+
+func (idx *bitmapIndex) In(keys []string) *bitset.BitSet {
+	rows := bitset.New(uint(idx.rowCount))
+	for _, key := range keys {
+		begin, end := keyRange(idx.keys, &Bound{Key: key, Inclusive: true}, &Bound{Key: key, Inclusive: true})
+		for _, bitmap := range idx.bitmaps[begin:end] {
+			rows.InPlaceUnion(bitmap)
+		}
+	}
+	return rows
+}
+
+func (idx *bitmapIndex) Range(lower, upper *Bound) *bitset.BitSet {
+	rows := bitset.New(uint(idx.rowCount))
+	begin, end := keyRange(idx.keys, lower, upper)
+	for _, bitmap := range idx.bitmaps[begin:end] {
+		rows.InPlaceUnion(bitmap)
+	}
+	return rows
+}
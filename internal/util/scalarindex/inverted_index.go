@@ -0,0 +1,108 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package scalarindex
+
+import (
+	"bytes"
+	"errors"
+
+	"github.com/bits-and-blooms/bitset"
+
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/indexparamcheck"
+)
+
+// invertedIndex keeps the row offsets of each distinct key, which suits term queries.
+type invertedIndex struct {
+	dataType schemapb.DataType
+	rowCount int
+	keys     []string
+	postings [][]uint32
+}
+
+func (idx *invertedIndex) IndexType() string {
+	return indexparamcheck.IndexScalarInverted
+}
+
+func (idx *invertedIndex) DataType() schemapb.DataType {
+	return idx.dataType
+}
+
+func (idx *invertedIndex) RowCount() int {
+	return idx.rowCount
+}
+
+func (idx *invertedIndex) Build(keys []string) error {
+	if err := checkRowCount(keys); err != nil {
+		return err
+	}
+	idx.keys, idx.postings = distinctKeys(keys)
+	idx.rowCount = len(keys)
+	return nil
+}
+
+func (idx *invertedIndex) Serialize() ([]byte, error) {
+	var buf bytes.Buffer
+	writeHeader(&buf, idx)
+	writeUint32(&buf, uint32(len(idx.keys)))
+	for _, key := range idx.keys {
+		writeString(&buf, key)
+	}
+	for _, posting := range idx.postings {
+		writeUint32s(&buf, posting)
+	}
+	return buf.Bytes(), nil
+}
+
+func (idx *invertedIndex) load(r *bytes.Reader, rowCount int) error {
+	keys, err := readSortedStrings(r)
+	if err != nil {
+		return err
+	}
+	postings := make([][]uint32, len(keys))
+	total := 0
+	for i := range postings {
+		if postings[i], err = readUint32s(r, rowCount); err != nil {
+			return err
+		}
+		total += len(postings[i])
+	}
+	if total != rowCount {
+		return errors.New("row count mismatch in inverted index")
+	}
+	idx.keys, idx.postings, idx.rowCount = keys, postings, rowCount
+	return nil
+}
+
+func (idx *invertedIndex) In(keys []string) *bitset.BitSet {
+	rows := bitset.New(uint(idx.rowCount))
+	for _, key := range keys {
+		begin, end := keyRange(idx.keys, &Bound{Key: key, Inclusive: true}, &Bound{Key: key, Inclusive: true})
+		for _, posting := range idx.postings[begin:end] {
+			for _, offset := range posting {
+				rows.Set(uint(offset))
+			}
+		}
+	}
+	return rows
+}
+
+func (idx *invertedIndex) Range(lower, upper *Bound) *bitset.BitSet {
+	rows := bitset.New(uint(idx.rowCount))
+	begin, end := keyRange(idx.keys, lower, upper)
+	for _, posting := range idx.postings[begin:end] {
+		for _, offset := range posting {
+			rows.Set(uint(offset))
+		}
+	}
+	return rows
+}
@@ -0,0 +1,303 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+// Package scalarindex implements the indexes built on scalar fields, which find the rows matching
+// term and range filters without scanning the raw data.
+package scalarindex
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+
+	"github.com/bits-and-blooms/bitset"
+
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/indexparamcheck"
+)
+
+// formatVersion is the version of the serialized scalar index
+const formatVersion uint32 = 1
+
+// Index is an index of a scalar field of a segment, the values are represented by their encoded keys,
+// which sort in the same order as the values.
+type Index interface {
+	IndexType() string
+	DataType() schemapb.DataType
+	// RowCount returns the number of rows indexed
+	RowCount() int
+	// Build builds the index with the keys of all rows, in row order
+	Build(keys []string) error
+	Serialize() ([]byte, error)
+	// In returns the rows whose key is one of the keys
+	In(keys []string) *bitset.BitSet
+	// Range returns the rows whose key is within the bounds, a nil bound means unbounded
+	Range(lower, upper *Bound) *bitset.BitSet
+}
+
+// indexLoader is implemented by all indexes to load the body of the serialized index
+type indexLoader interface {
+	load(r *bytes.Reader, rowCount int) error
+}
+
+// Bound is the bound of a range query
+type Bound struct {
+	Key       string
+	Inclusive bool
+}
+
+// NewIndex creates an empty index of the index type set in the index params
+func NewIndex(dataType schemapb.DataType, indexParams map[string]string) (Index, error) {
+	if !isSupportedDataType(dataType) {
+		return nil, fmt.Errorf("scalar index is not supported on data type %s", dataType.String())
+	}
+	indexType := indexParams[indexparamcheck.IndexTypeKey]
+	switch indexType {
+	case indexparamcheck.IndexScalarSorted:
+		return &sortedIndex{dataType: dataType}, nil
+	case indexparamcheck.IndexScalarInverted:
+		return &invertedIndex{dataType: dataType}, nil
+	case indexparamcheck.IndexScalarBitmap:
+		limit := indexparamcheck.DefaultBitmapCardinality
+		if value, ok := indexParams[indexparamcheck.BitmapCardinality]; ok {
+			var err error
+			limit, err = strconv.Atoi(value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid %s: %s", indexparamcheck.BitmapCardinality, value)
+			}
+		}
+		return &bitmapIndex{dataType: dataType, cardinalityLimit: limit}, nil
+	}
+	return nil, fmt.Errorf("unknown scalar index type: %s", indexType)
+}
+
+// Load deserializes the index serialized by Index.Serialize
+func Load(data []byte) (Index, error) {
+	r := bytes.NewReader(data)
+	version, err := readUint32(r)
+	if err != nil {
+		return nil, err
+	}
+	if version != formatVersion {
+		return nil, fmt.Errorf("unsupported scalar index format version %d", version)
+	}
+	indexType, err := readString(r)
+	if err != nil {
+		return nil, err
+	}
+	dataType, err := readUint32(r)
+	if err != nil {
+		return nil, err
+	}
+	rowCount, err := readUint32(r)
+	if err != nil {
+		return nil, err
+	}
+	index, err := NewIndex(schemapb.DataType(dataType), map[string]string{indexparamcheck.IndexTypeKey: indexType})
+	if err != nil {
+		return nil, err
+	}
+	if err := index.(indexLoader).load(r, int(rowCount)); err != nil {
+		return nil, err
+	}
+	if r.Len() != 0 {
+		return nil, errors.New("unexpected trailing bytes in scalar index")
+	}
+	return index, nil
+}
+
+func isSupportedDataType(dataType schemapb.DataType) bool {
+	switch dataType {
+	case schemapb.DataType_Bool, schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32,
+		schemapb.DataType_Int64, schemapb.DataType_Float, schemapb.DataType_Double, schemapb.DataType_String:
+		return true
+	}
+	return false
+}
+
+// EncodeInt64 encodes the integer into the key ordered by the value, bool and all integer types are encoded by it
+func EncodeInt64(value int64) string {
+	var buf [8]byte
+	binary.BigEndian.PutUint64(buf[:], uint64(value)^(1<<63))
+	return string(buf[:])
+}
+
+// EncodeBool encodes the bool as integer 0 or 1
+func EncodeBool(value bool) string {
+	if value {
+		return EncodeInt64(1)
+	}
+	return EncodeInt64(0)
+}
+
+// EncodeFloat64 encodes the float into the key ordered by the value, float and double are encoded by it
+func EncodeFloat64(value float64) string {
+	if value == 0 {
+		// -0 equals to 0
+		value = 0
+	}
+	bits := math.Float64bits(value)
+	if bits&(1<<63) != 0 {
+		bits = ^bits
+	} else {
+		bits |= 1 << 63
+	}
+	var buf [8]byte
+	binary.BigEndian.PutUint64(buf[:], bits)
+	return string(buf[:])
+}
+
+// EncodeString encodes the string as itself, which is ordered bytewise
+func EncodeString(value string) string {
+	return value
+}
+
+// keyRange returns [begin, end) of the sorted keys within the bounds
+func keyRange(keys []string, lower, upper *Bound) (int, int) {
+	begin, end := 0, len(keys)
+	if lower != nil {
+		if lower.Inclusive {
+			begin = sort.SearchStrings(keys, lower.Key)
+		} else {
+			begin = sort.Search(len(keys), func(i int) bool { return keys[i] > lower.Key })
+		}
+	}
+	if upper != nil {
+		if upper.Inclusive {
+			end = sort.Search(len(keys), func(i int) bool { return keys[i] > upper.Key })
+		} else {
+			end = sort.SearchStrings(keys, upper.Key)
+		}
+	}
+	if end < begin {
+		end = begin
+	}
+	return begin, end
+}
+
+// distinctKeys groups the rows by key, the keys are returned in order
+func distinctKeys(keys []string) ([]string, [][]uint32) {
+	rows := make(map[string][]uint32)
+	for offset, key := range keys {
+		rows[key] = append(rows[key], uint32(offset))
+	}
+	distinct := make([]string, 0, len(rows))
+	for key := range rows {
+		distinct = append(distinct, key)
+	}
+	sort.Strings(distinct)
+	postings := make([][]uint32, len(distinct))
+	for i, key := range distinct {
+		postings[i] = rows[key]
+	}
+	return distinct, postings
+}
+
+func checkRowCount(keys []string) error {
+	if len(keys) > math.MaxUint32 {
+		return fmt.Errorf("too many rows to build scalar index: %d", len(keys))
+	}
+	return nil
+}
+
+func writeHeader(buf *bytes.Buffer, index Index) {
+	writeUint32(buf, formatVersion)
+	writeString(buf, index.IndexType())
+	writeUint32(buf, uint32(index.DataType()))
+	writeUint32(buf, uint32(index.RowCount()))
+}
+
+func writeUint32(buf *bytes.Buffer, value uint32) {
+	var b [4]byte
+	binary.LittleEndian.PutUint32(b[:], value)
+	buf.Write(b[:])
+}
+
+func writeString(buf *bytes.Buffer, value string) {
+	writeUint32(buf, uint32(len(value)))
+	buf.WriteString(value)
+}
+
+func writeUint32s(buf *bytes.Buffer, values []uint32) {
+	writeUint32(buf, uint32(len(values)))
+	for _, value := range values {
+		writeUint32(buf, value)
+	}
+}
+
+func readUint32(r *bytes.Reader) (uint32, error) {
+	var b [4]byte
+	if _, err := io.ReadFull(r, b[:]); err != nil {
+		return 0, errors.New("truncated scalar index")
+	}
+	return binary.LittleEndian.Uint32(b[:]), nil
+}
+
+func readString(r *bytes.Reader) (string, error) {
+	n, err := readUint32(r)
+	if err != nil {
+		return "", err
+	}
+	if int64(n) > int64(r.Len()) {
+		return "", errors.New("truncated scalar index")
+	}
+	b := make([]byte, n)
+	if _, err := io.ReadFull(r, b); err != nil {
+		return "", errors.New("truncated scalar index")
+	}
+	return string(b), nil
+}
+
+// readUint32s reads the offsets and checks them against the row count
+func readUint32s(r *bytes.Reader, rowCount int) ([]uint32, error) {
+	n, err := readUint32(r)
+	if err != nil {
+		return nil, err
+	}
+	if int64(n)*4 > int64(r.Len()) {
+		return nil, errors.New("truncated scalar index")
+	}
+	values := make([]uint32, n)
+	for i := range values {
+		values[i], _ = readUint32(r)
+		if int(values[i]) >= rowCount {
+			return nil, fmt.Errorf("row offset %d out of range %d", values[i], rowCount)
+		}
+	}
+	return values, nil
+}
+
+// readSortedStrings reads the keys and checks they are sorted
+func readSortedStrings(r *bytes.Reader) ([]string, error) {
+	n, err := readUint32(r)
+	if err != nil {
+		return nil, err
+	}
+	// every string takes at least 4 bytes of length
+	if int64(n)*4 > int64(r.Len()) {
+		return nil, errors.New("truncated scalar index")
+	}
+	values := make([]string, n)
+	for i := range values {
+		if values[i], err = readString(r); err != nil {
+			return nil, err
+		}
+		if i > 0 && values[i] < values[i-1] {
+			return nil, errors.New("keys of scalar index are not sorted")
+		}
+	}
+	return values, nil
+}
@@ -0,0 +1,145 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package scalarindex
+
+import (
+	"math"
+	"sort"
+	"testing"
+
+	"github.com/bits-and-blooms/bitset"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/indexparamcheck"
+)
+
+func rowsOf(rows *bitset.BitSet) []uint {
+	result := make([]uint, 0)
+	for i, ok := rows.NextSet(0); ok; i, ok = rows.NextSet(i + 1) {
+		result = append(result, i)
+	}
+	return result
+}
+
+func TestEncode(t *testing.T) {
+	ints := []int64{math.MinInt64, -100, -1, 0, 1, 100, math.MaxInt64}
+	for i := 1; i < len(ints); i++ {
+		assert.True(t, EncodeInt64(ints[i-1]) < EncodeInt64(ints[i]))
+	}
+	floats := []float64{math.Inf(-1), -1e10, -1.5, -math.SmallestNonzeroFloat64, 0, math.SmallestNonzeroFloat64, 1.5, 1e10, math.Inf(1)}
+	for i := 1; i < len(floats); i++ {
+		assert.True(t, EncodeFloat64(floats[i-1]) < EncodeFloat64(floats[i]))
+	}
+	assert.Equal(t, EncodeFloat64(0), EncodeFloat64(math.Copysign(0, -1)))
+	assert.Equal(t, EncodeInt64(1), EncodeBool(true))
+	assert.Equal(t, EncodeInt64(0), EncodeBool(false))
+	assert.Equal(t, "abc", EncodeString("abc"))
+}
+
+func TestNewIndex(t *testing.T) {
+	_, err := NewIndex(schemapb.DataType_FloatVector, map[string]string{indexparamcheck.IndexTypeKey: indexparamcheck.IndexScalarSorted})
+	assert.Error(t, err)
+	_, err = NewIndex(schemapb.DataType_Int64, map[string]string{indexparamcheck.IndexTypeKey: indexparamcheck.IndexFaissIvfFlat})
+	assert.Error(t, err)
+	_, err = NewIndex(schemapb.DataType_Int64, map[string]string{
+		indexparamcheck.IndexTypeKey:      indexparamcheck.IndexScalarBitmap,
+		indexparamcheck.BitmapCardinality: "many",
+	})
+	assert.Error(t, err)
+}
+
+func TestIndex_Query(t *testing.T) {
+	values := []int64{5, 3, 8, 3, -1, 5, 5, 10}
+	keys := make([]string, len(values))
+	for i, v := range values {
+		keys[i] = EncodeInt64(v)
+	}
+	bound := func(v int64, inclusive bool) *Bound {
+		return &Bound{Key: EncodeInt64(v), Inclusive: inclusive}
+	}
+	// expected returns the rows matching the condition by scanning
+	expected := func(match func(v int64) bool) []uint {
+		rows := make([]uint, 0)
+		for i, v := range values {
+			if match(v) {
+				rows = append(rows, uint(i))
+			}
+		}
+		return rows
+	}
+
+	for _, indexType := range []string{indexparamcheck.IndexScalarSorted, indexparamcheck.IndexScalarInverted, indexparamcheck.IndexScalarBitmap} {
+		index, err := NewIndex(schemapb.DataType_Int64, map[string]string{indexparamcheck.IndexTypeKey: indexType})
+		assert.NoError(t, err)
+		assert.NoError(t, index.Build(keys))
+
+		data, err := index.Serialize()
+		assert.NoError(t, err)
+		loaded, err := Load(data)
+		assert.NoError(t, err)
+		assert.Equal(t, indexType, loaded.IndexType())
+		assert.Equal(t, schemapb.DataType_Int64, loaded.DataType())
+		assert.Equal(t, len(values), loaded.RowCount())
+
+		for _, idx := range []Index{index, loaded} {
+			assert.Equal(t, expected(func(v int64) bool { return v == 5 || v == -1 }),
+				rowsOf(idx.In([]string{EncodeInt64(5), EncodeInt64(-1), EncodeInt64(7)})), indexType)
+			assert.Empty(t, rowsOf(idx.In([]string{EncodeInt64(4)})), indexType)
+			assert.Equal(t, expected(func(v int64) bool { return v > 3 && v <= 8 }),
+				rowsOf(idx.Range(bound(3, false), bound(8, true))), indexType)
+			assert.Equal(t, expected(func(v int64) bool { return v >= 3 && v < 8 }),
+				rowsOf(idx.Range(bound(3, true), bound(8, false))), indexType)
+			assert.Equal(t, expected(func(v int64) bool { return v < 5 }),
+				rowsOf(idx.Range(nil, bound(5, false))), indexType)
+			assert.Equal(t, expected(func(v int64) bool { return v >= 10 }),
+				rowsOf(idx.Range(bound(10, true), nil)), indexType)
+			assert.Empty(t, rowsOf(idx.Range(bound(8, true), bound(3, true))), indexType)
+		}
+	}
+}
+
+func TestBitmapIndex_Cardinality(t *testing.T) {
+	index, err := NewIndex(schemapb.DataType_String, map[string]string{
+		indexparamcheck.IndexTypeKey:      indexparamcheck.IndexScalarBitmap,
+		indexparamcheck.BitmapCardinality: "2",
+	})
+	assert.NoError(t, err)
+	assert.NoError(t, index.Build([]string{"a", "b", "a"}))
+	assert.Error(t, index.Build([]string{"a", "b", "c"}))
+}
+
+func TestLoad_Corrupted(t *testing.T) {
+	keys := []string{"b", "a", "c", "a"}
+	for _, indexType := range []string{indexparamcheck.IndexScalarSorted, indexparamcheck.IndexScalarInverted, indexparamcheck.IndexScalarBitmap} {
+		index, err := NewIndex(schemapb.DataType_String, map[string]string{indexparamcheck.IndexTypeKey: indexType})
+		assert.NoError(t, err)
+		assert.NoError(t, index.Build(keys))
+		data, err := index.Serialize()
+		assert.NoError(t, err)
+
+		_, err = Load(data[:len(data)-1])
+		assert.Error(t, err, indexType)
+		_, err = Load(append(data, 0))
+		assert.Error(t, err, indexType)
+	}
+
+	_, err := Load(nil)
+	assert.Error(t, err)
+}
+
+func TestDistinctKeys(t *testing.T) {
+	distinct, postings := distinctKeys([]string{"b", "a", "b", "c"})
+	assert.True(t, sort.StringsAreSorted(distinct))
+	assert.Equal(t, []string{"a", "b", "c"}, distinct)
+	assert.Equal(t, [][]uint32{{1}, {0, 2}, {3}}, postings)
+}
@@ -0,0 +1,111 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package scalarindex
+
+import (
+	"bytes"
+	"errors"
+	"sort"
+
+	"github.com/bits-and-blooms/bitset"
+
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/indexparamcheck"
+)
+
+// sortedIndex keeps the keys of all rows in order along with their row offsets, which suits range queries
+// on high-cardinality fields.
+type sortedIndex struct {
+	dataType schemapb.DataType
+	rowCount int
+	keys     []string
+	offsets  []uint32
+}
+
+func (idx *sortedIndex) IndexType() string {
+	return indexparamcheck.IndexScalarSorted
+}
+
+func (idx *sortedIndex) DataType() schemapb.DataType {
+	return idx.dataType
+}
+
+func (idx *sortedIndex) RowCount() int {
+	return idx.rowCount
+}
+
+func (idx *sortedIndex) Build(keys []string) error {
+	if err := checkRowCount(keys); err != nil {
+		return err
+	}
+	offsets := make([]uint32, len(keys))
+	for i := range offsets {
+		offsets[i] = uint32(i)
+	}
+	sort.SliceStable(offsets, func(i, j int) bool {
+		return keys[offsets[i]] < keys[offsets[j]]
+	})
+	idx.keys = make([]string, len(keys))
+	for i, offset := range offsets {
+		idx.keys[i] = keys[offset]
+	}
+	idx.offsets = offsets
+	idx.rowCount = len(keys)
+	return nil
+}
+
+func (idx *sortedIndex) Serialize() ([]byte, error) {
+	var buf bytes.Buffer
+	writeHeader(&buf, idx)
+	writeUint32(&buf, uint32(len(idx.keys)))
+	for _, key := range idx.keys {
+		writeString(&buf, key)
+	}
+	writeUint32s(&buf, idx.offsets)
+	return buf.Bytes(), nil
+}
+
+func (idx *sortedIndex) load(r *bytes.Reader, rowCount int) error {
+	keys, err := readSortedStrings(r)
+	if err != nil {
+		return err
+	}
+	offsets, err := readUint32s(r, rowCount)
+	if err != nil {
+		return err
+	}
+	if len(keys) != rowCount || len(offsets) != rowCount {
+		return errors.New("row count mismatch in sorted index")
+	}
+	idx.keys, idx.offsets, idx.rowCount = keys, offsets, rowCount
+	return nil
+}
+
+func (idx *sortedIndex) In(keys []string) *bitset.BitSet {
+	rows := bitset.New(uint(idx.rowCount))
+	for _, key := range keys {
+		begin, end := keyRange(idx.keys, &Bound{Key: key, Inclusive: true}, &Bound{Key: key, Inclusive: true})
+		for _, offset := range idx.offsets[begin:end] {
+			rows.Set(uint(offset))
+		}
+	}
+	return rows
+}
+
+func (idx *sortedIndex) Range(lower, upper *Bound) *bitset.BitSet {
+	rows := bitset.New(uint(idx.rowCount))
+	begin, end := keyRange(idx.keys, lower, upper)
+	for _, offset := range idx.offsets[begin:end] {
+		rows.Set(uint(offset))
+	}
+	return rows
+}
@@ -9,10 +9,10 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/milvus-io/milvus/internal/kv"
+	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/util/retry"
-	"go.etcd.io/etcd/api/v3/mvccpb"
-	clientv3 "go.etcd.io/etcd/client/v3"
 	"go.uber.org/zap"
 )
 
@@ -41,16 +41,17 @@ type Session struct {
 	Address    string `json:"Address,omitempty"`
 	Exclusive  bool   `json:"Exclusive,omitempty"`
 
-	etcdCli  *clientv3.Client
-	leaseID  clientv3.LeaseID
+	metaKV   kv.MetaKv
+	leaseID  kv.LeaseID
 	cancel   context.CancelFunc
 	metaRoot string
 }
 
 // NewSession is a helper to build Session object.
 // ServerID, ServerName, Address, Exclusive will be assigned after Init().
-// metaRoot is a path in etcd to save session information.
-// etcdEndpoints is to init etcdCli when NewSession
+// metaRoot is a path in the meta store to save session information.
+// etcdEndpoints is to init the etcd MetaKv when NewSession, it is not used if
+// the local meta store is in use, see etcdkv.SetLocalMetaStore.
 func NewSession(ctx context.Context, metaRoot string, etcdEndpoints []string) *Session {
 	ctx, cancel := context.WithCancel(ctx)
 	session := &Session{
@@ -61,16 +62,15 @@ func NewSession(ctx context.Context, metaRoot string, etcdEndpoints []string) *S
 
 	connectEtcdFn := func() error {
 		log.Debug("Session try to connect to etcd")
-		etcdCli, err := clientv3.New(clientv3.Config{Endpoints: etcdEndpoints, DialTimeout: 5 * time.Second})
+		metaKV, err := etcdkv.NewMetaKv(etcdEndpoints, metaRoot)
 		if err != nil {
 			return err
 		}
-		ctx2, cancel2 := context.WithTimeout(session.ctx, 5*time.Second)
-		defer cancel2()
-		if _, err = etcdCli.Get(ctx2, "health"); err != nil {
+		if _, _, err = metaKV.LoadWithPrefix("health"); err != nil {
+			metaKV.Close()
 			return err
 		}
-		session.etcdCli = etcdCli
+		session.metaKV = metaKV
 		return nil
 	}
 	err := retry.Do(ctx, connectEtcdFn, retry.Attempts(300))
@@ -109,46 +109,28 @@ func (s *Session) getServerID() (int64, error) {
 
 func (s *Session) checkIDExist() {
 	log.Debug("Session checkIDExist Begin")
-	s.etcdCli.Txn(s.ctx).If(
-		clientv3.Compare(
-			clientv3.Version(path.Join(s.metaRoot, DefaultServiceRoot, DefaultIDKey)),
-			"=",
-			0)).
-		Then(clientv3.OpPut(path.Join(s.metaRoot, DefaultServiceRoot, DefaultIDKey), "1")).Commit()
+	// fails if the id key already exists, which is expected
+	_ = s.metaKV.CompareVersionAndSwap(path.Join(DefaultServiceRoot, DefaultIDKey), 0, "1")
 	log.Debug("Session checkIDExist End")
 }
 
 func (s *Session) getServerIDWithKey(key string, retryTimes uint) (int64, error) {
+	key = path.Join(DefaultServiceRoot, key)
 	for {
 		log.Debug("Session try to get serverID")
-		getResp, err := s.etcdCli.Get(s.ctx, path.Join(s.metaRoot, DefaultServiceRoot, key))
+		value, err := s.metaKV.Load(key)
 		if err != nil {
 			log.Debug("Session get etcd key error", zap.String("key", key), zap.Error(err))
 			return -1, err
 		}
-		if getResp.Count <= 0 {
-			log.Debug("Session there is no value", zap.String("key", key))
-			continue
-		}
-		value := string(getResp.Kvs[0].Value)
 		valueInt, err := strconv.ParseInt(value, 10, 64)
 		if err != nil {
 			log.Debug("Session ParseInt error", zap.String("value", value), zap.Error(err))
 			continue
 		}
-		txnResp, err := s.etcdCli.Txn(s.ctx).If(
-			clientv3.Compare(
-				clientv3.Value(path.Join(s.metaRoot, DefaultServiceRoot, key)),
-				"=",
-				value)).
-			Then(clientv3.OpPut(path.Join(s.metaRoot, DefaultServiceRoot, key), strconv.FormatInt(valueInt+1, 10))).Commit()
+		err = s.metaKV.CompareValueAndSwap(key, value, strconv.FormatInt(valueInt+1, 10))
 		if err != nil {
-			log.Debug("Session Txn failed", zap.String("key", key), zap.Error(err))
-			return -1, err
-		}
-
-		if !txnResp.Succeeded {
-			log.Debug("Session Txn unsuccessful", zap.String("key", key))
+			log.Debug("Session CompareValueAndSwap unsuccessful", zap.String("key", key), zap.Error(err))
 			continue
 		}
 		log.Debug("Session get serverID success")
@@ -169,16 +151,16 @@ func (s *Session) getServerIDWithKey(key string, retryTimes uint) (int64, error)
 // }
 // Exclusive means whether this service can exist two at the same time, if so,
 // it is false. Otherwise, set it to true.
-func (s *Session) registerService() (<-chan *clientv3.LeaseKeepAliveResponse, error) {
-	var ch <-chan *clientv3.LeaseKeepAliveResponse
+func (s *Session) registerService() (<-chan *kv.LeaseKeepAliveResponse, error) {
+	var ch <-chan *kv.LeaseKeepAliveResponse
 	log.Debug("Session Register Begin")
 	registerFn := func() error {
-		resp, err := s.etcdCli.Grant(s.ctx, DefaultTTL)
+		leaseID, err := s.metaKV.Grant(DefaultTTL)
 		if err != nil {
 			log.Error("register service", zap.Error(err))
 			return err
 		}
-		s.leaseID = resp.ID
+		s.leaseID = leaseID
 
 		sessionJSON, err := json.Marshal(s)
 		if err != nil {
@@ -189,23 +171,13 @@ func (s *Session) registerService() (<-chan *clientv3.LeaseKeepAliveResponse, er
 		if !s.Exclusive {
 			key = key + "-" + strconv.FormatInt(s.ServerID, 10)
 		}
-		txnResp, err := s.etcdCli.Txn(s.ctx).If(
-			clientv3.Compare(
-				clientv3.Version(path.Join(s.metaRoot, DefaultServiceRoot, key)),
-				"=",
-				0)).
-			Then(clientv3.OpPut(path.Join(s.metaRoot, DefaultServiceRoot, key), string(sessionJSON), clientv3.WithLease(resp.ID))).Commit()
-
+		err = s.metaKV.CompareVersionAndSwap(path.Join(DefaultServiceRoot, key), 0, string(sessionJSON), kv.WithLease(leaseID))
 		if err != nil {
 			log.Warn("compare and swap error, maybe the key has ben registered", zap.Error(err))
 			return err
 		}
 
-		if !txnResp.Succeeded {
-			return fmt.Errorf("function CompareAndSwap error for compare is false for key: %s", key)
-		}
-
-		ch, err = s.etcdCli.KeepAlive(s.ctx, resp.ID)
+		ch, err = s.metaKV.KeepAlive(leaseID)
 		if err != nil {
 			fmt.Printf("keep alive error %s\n", err)
 			return err
@@ -222,13 +194,15 @@ func (s *Session) registerService() (<-chan *clientv3.LeaseKeepAliveResponse, er
 
 // processKeepAliveResponse processes the response of etcd keepAlive interface
 // If keepAlive fails for unexpected error, it will send a signal to the channel.
-func (s *Session) processKeepAliveResponse(ch <-chan *clientv3.LeaseKeepAliveResponse) (failChannel <-chan bool) {
+// The meta store connection is closed when the session context is done, which stops keepAlive.
+func (s *Session) processKeepAliveResponse(ch <-chan *kv.LeaseKeepAliveResponse) (failChannel <-chan bool) {
 	failCh := make(chan bool)
 	go func() {
 		for {
 			select {
 			case <-s.ctx.Done():
 				log.Error("keep alive", zap.Error(errors.New("context done")))
+				s.metaKV.Close()
 				return
 			case resp, ok := <-ch:
 				if !ok {
@@ -252,23 +226,21 @@ func (s *Session) processKeepAliveResponse(ch <-chan *clientv3.LeaseKeepAliveRes
 // Revision is returned for WatchServices to prevent key events from being missed.
 func (s *Session) GetSessions(prefix string) (map[string]*Session, int64, error) {
 	res := make(map[string]*Session)
-	key := path.Join(s.metaRoot, DefaultServiceRoot, prefix)
-	resp, err := s.etcdCli.Get(s.ctx, key, clientv3.WithPrefix(),
-		clientv3.WithSort(clientv3.SortByKey, clientv3.SortAscend))
+	keys, values, revision, err := s.metaKV.LoadWithRevision(path.Join(DefaultServiceRoot, prefix))
 	if err != nil {
 		return nil, 0, err
 	}
-	log.Debug("SessionUtil GetSessions", zap.Any("prefix", prefix), zap.Any("resp", resp))
-	for _, kv := range resp.Kvs {
+	log.Debug("SessionUtil GetSessions", zap.Any("prefix", prefix), zap.Strings("keys", keys))
+	for i, key := range keys {
 		session := &Session{}
-		err = json.Unmarshal(kv.Value, session)
+		err = json.Unmarshal([]byte(values[i]), session)
 		if err != nil {
 			return nil, 0, err
 		}
-		_, mapKey := path.Split(string(kv.Key))
+		_, mapKey := path.Split(key)
 		res[mapKey] = session
 	}
-	return res, resp.Header.Revision, nil
+	return res, revision, nil
 }
 
 // SessionEvent indicates the changes of other servers.
@@ -290,7 +262,7 @@ type SessionEvent struct {
 // If a server down, a event will be add to channel with eventType SessionDelType.
 func (s *Session) WatchServices(prefix string, revision int64) (eventChannel <-chan *SessionEvent) {
 	eventCh := make(chan *SessionEvent, 100)
-	rch := s.etcdCli.Watch(s.ctx, path.Join(s.metaRoot, DefaultServiceRoot, prefix), clientv3.WithPrefix(), clientv3.WithPrevKV(), clientv3.WithRev(revision))
+	rch := s.metaKV.WatchWithRevision(path.Join(DefaultServiceRoot, prefix), revision)
 	go func() {
 		for {
 			select {
@@ -304,19 +276,19 @@ func (s *Session) WatchServices(prefix string, revision int64) (eventChannel <-c
 					session := &Session{}
 					var eventType SessionEventType
 					switch ev.Type {
-					case mvccpb.PUT:
+					case kv.EventTypePut:
 						log.Debug("watch services",
 							zap.Any("add kv", ev.Kv))
-						err := json.Unmarshal([]byte(ev.Kv.Value), session)
+						err := json.Unmarshal(ev.Kv.Value, session)
 						if err != nil {
 							log.Error("watch services", zap.Error(err))
 							continue
 						}
 						eventType = SessionAddEvent
-					case mvccpb.DELETE:
+					case kv.EventTypeDelete:
 						log.Debug("watch services",
 							zap.Any("delete kv", ev.PrevKv))
-						err := json.Unmarshal([]byte(ev.PrevKv.Value), session)
+						err := json.Unmarshal(ev.PrevKv.Value, session)
 						if err != nil {
 							log.Error("watch services", zap.Error(err))
 							continue
@@ -11,6 +11,7 @@ import (
 	"time"
 
 	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
+	memkv "github.com/milvus-io/milvus/internal/kv/mem"
 	"github.com/milvus-io/milvus/internal/util/paramtable"
 	"github.com/stretchr/testify/assert"
 )
@@ -166,6 +167,50 @@ func TestUpdateSessions(t *testing.T) {
 	assert.Equal(t, delEventLen, 10)
 }
 
+func TestSessionWithLocalMetaStore(t *testing.T) {
+	store, err := memkv.NewMetaStore(nil)
+	assert.NoError(t, err)
+	defer store.Close()
+	etcdkv.SetLocalMetaStore(store)
+	defer etcdkv.SetLocalMetaStore(nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	metaRoot := fmt.Sprintf("%d/%s", rand.Int(), DefaultServiceRoot)
+
+	s := NewSession(ctx, metaRoot, nil)
+	assert.NotNil(t, s)
+	sessions, rev, err := s.GetSessions("test")
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(sessions))
+	eventCh := s.WatchServices("test", rev+1)
+
+	s1 := NewSession(ctx, metaRoot, nil)
+	s1.Init("test", "testAddr1", false)
+	s2 := NewSession(ctx, metaRoot, nil)
+	s2.Init("test", "testAddr2", false)
+	assert.NotEqual(t, s1.ServerID, s2.ServerID)
+
+	sessions, _, err = s.GetSessions("test")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(sessions))
+	assert.Equal(t, "testAddr1", sessions["test-"+strconv.FormatInt(s1.ServerID, 10)].Address)
+
+	for _, expected := range []*Session{s1, s2} {
+		event := <-eventCh
+		assert.Equal(t, SessionAddEvent, event.EventType)
+		assert.Equal(t, expected.ServerID, event.Session.ServerID)
+	}
+
+	metaKV := store.NewMetaKV(metaRoot)
+	defer metaKV.Close()
+	err = metaKV.Remove(DefaultServiceRoot + "test-" + strconv.FormatInt(s1.ServerID, 10))
+	assert.NoError(t, err)
+	event := <-eventCh
+	assert.Equal(t, SessionDelEvent, event.EventType)
+	assert.Equal(t, s1.ServerID, event.Session.ServerID)
+}
+
 func TestSessionLivenessCheck(t *testing.T) {
 	s := &Session{}
 	ctx := context.Background()
@@ -0,0 +1,139 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package tlsutil
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+const (
+	// TLSModeDisabled serves the proxy public endpoint in plain text
+	TLSModeDisabled = 0
+	// TLSModeOneWay serves the proxy public endpoint with server side TLS only
+	TLSModeOneWay = 1
+	// TLSModeMutual requires the clients of the proxy public endpoint to present a certificate signed by the CA
+	TLSModeMutual = 2
+
+	defaultReloadInterval = 60 * time.Second
+)
+
+// ParamTable stores the tls parameters
+type ParamTable struct {
+	paramtable.BaseTable
+
+	TLSMode            int
+	InternalTLSEnabled bool
+	InternalToken      string
+
+	ServerPemPath  string
+	ServerKeyPath  string
+	CaPemPath      string
+	ReloadInterval time.Duration
+	// MemberNames are the subject alternative names identifying the certificates of the cluster members
+	MemberNames []string
+}
+
+// Params tls parameter table
+var Params ParamTable
+var once sync.Once
+
+// Init initialize param table
+func (pt *ParamTable) Init() {
+	once.Do(func() {
+		pt.BaseTable.Init()
+
+		pt.initTLSMode()
+		pt.initInternalTLSEnabled()
+		pt.initInternalToken()
+		pt.initServerPemPath()
+		pt.initServerKeyPath()
+		pt.initCaPemPath()
+		pt.initReloadInterval()
+		pt.initMemberNames()
+	})
+}
+
+// Enabled returns whether any grpc endpoint of this process serves tls
+func (pt *ParamTable) Enabled() bool {
+	return pt.TLSMode != TLSModeDisabled || pt.InternalTLSEnabled
+}
+
+func (pt *ParamTable) initTLSMode() {
+	valueStr, err := pt.LoadWithDefault("common.security.tlsMode", "0")
+	if err != nil {
+		panic(err)
+	}
+	switch valueStr {
+	case "0":
+		pt.TLSMode = TLSModeDisabled
+	case "1":
+		pt.TLSMode = TLSModeOneWay
+	case "2":
+		pt.TLSMode = TLSModeMutual
+	default:
+		panic("invalid common.security.tlsMode: " + valueStr + ", should be 0, 1 or 2")
+	}
+}
+
+func (pt *ParamTable) initInternalTLSEnabled() {
+	pt.InternalTLSEnabled = pt.ParseBool("common.security.internalTlsEnabled", false)
+}
+
+func (pt *ParamTable) initInternalToken() {
+	pt.InternalToken, _ = pt.LoadWithDefault("common.security.internalToken", "")
+}
+
+func (pt *ParamTable) initServerPemPath() {
+	pt.ServerPemPath, _ = pt.LoadWithDefault("tls.serverPemPath", "")
+}
+
+func (pt *ParamTable) initServerKeyPath() {
+	pt.ServerKeyPath, _ = pt.LoadWithDefault("tls.serverKeyPath", "")
+}
+
+func (pt *ParamTable) initCaPemPath() {
+	pt.CaPemPath, _ = pt.LoadWithDefault("tls.caPemPath", "")
+}
+
+func (pt *ParamTable) initMemberNames() {
+	pt.MemberNames = nil
+	valueStr, _ := pt.LoadWithDefault("tls.memberNames", "")
+	for _, name := range strings.Split(valueStr, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			pt.MemberNames = append(pt.MemberNames, name)
+		}
+	}
+}
+
+func (pt *ParamTable) initReloadInterval() {
+	pt.ReloadInterval = defaultReloadInterval
+	valueStr, err := pt.Load("tls.reloadInterval")
+	if err != nil { // not set
+		return
+	}
+	value, err := strconv.Atoi(valueStr)
+	if err != nil || value <= 0 { // not in valid format
+		log.Warn("Failed to parse tls.reloadInterval, set to default",
+			zap.String("tls.reloadInterval", valueStr),
+			zap.Duration("default", defaultReloadInterval))
+		return
+	}
+	pt.ReloadInterval = time.Duration(value) * time.Second
+}
@@ -0,0 +1,384 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package tlsutil
+
+import (
+	"context"
+	"crypto/subtle"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// CertReloader holds the key pair and the CA certificates loaded from files,
+// and reloads them once the files are modified, so certificates can be rotated without restart
+type CertReloader struct {
+	certPath string
+	keyPath  string
+	caPath   string
+	interval time.Duration
+
+	mu        sync.RWMutex
+	cert      *tls.Certificate
+	caPool    *x509.CertPool
+	modTimes  [3]time.Time
+	lastCheck time.Time
+}
+
+// NewCertReloader loads the key pair and the CA certificates, the files are checked for modification
+// at most once per interval. The CA is optional, the peers are verified against the system roots without it.
+func NewCertReloader(certPath, keyPath, caPath string, interval time.Duration) (*CertReloader, error) {
+	if certPath == "" || keyPath == "" {
+		return nil, errors.New("tls is enabled but tls.serverPemPath or tls.serverKeyPath is empty")
+	}
+	r := &CertReloader{
+		certPath: certPath,
+		keyPath:  keyPath,
+		caPath:   caPath,
+		interval: interval,
+	}
+	modTimes, err := r.stat()
+	if err != nil {
+		return nil, err
+	}
+	if err := r.load(modTimes); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (r *CertReloader) stat() ([3]time.Time, error) {
+	var modTimes [3]time.Time
+	for i, path := range []string{r.certPath, r.keyPath, r.caPath} {
+		if path == "" {
+			continue
+		}
+		info, err := os.Stat(path)
+		if err != nil {
+			return modTimes, err
+		}
+		modTimes[i] = info.ModTime()
+	}
+	return modTimes, nil
+}
+
+func (r *CertReloader) load(modTimes [3]time.Time) error {
+	cert, err := tls.LoadX509KeyPair(r.certPath, r.keyPath)
+	if err != nil {
+		return fmt.Errorf("failed to load key pair %s, %s, error = %w", r.certPath, r.keyPath, err)
+	}
+	var caPool *x509.CertPool
+	if r.caPath != "" {
+		caPem, err := ioutil.ReadFile(r.caPath)
+		if err != nil {
+			return err
+		}
+		caPool = x509.NewCertPool()
+		if !caPool.AppendCertsFromPEM(caPem) {
+			return fmt.Errorf("no valid certificate found in %s", r.caPath)
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.cert = &cert
+	r.caPool = caPool
+	r.modTimes = modTimes
+	r.lastCheck = time.Now()
+	return nil
+}
+
+// maybeReload reloads the files if they are modified, the current certificates are kept on failure
+func (r *CertReloader) maybeReload() {
+	r.mu.RLock()
+	due := time.Since(r.lastCheck) >= r.interval
+	modTimes := r.modTimes
+	r.mu.RUnlock()
+	if !due {
+		return
+	}
+
+	newModTimes, err := r.stat()
+	if err == nil && newModTimes != modTimes {
+		err = r.load(newModTimes)
+		if err == nil {
+			log.Info("tls certificates reloaded", zap.String("cert", r.certPath), zap.String("ca", r.caPath))
+			return
+		}
+	}
+	if err != nil {
+		log.Warn("failed to reload tls certificates, keep using the current ones", zap.Error(err))
+	}
+	r.mu.Lock()
+	r.lastCheck = time.Now()
+	r.mu.Unlock()
+}
+
+func (r *CertReloader) current() (*tls.Certificate, *x509.CertPool) {
+	r.maybeReload()
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.cert, r.caPool
+}
+
+// ServerConfig returns the tls config of a server, a new config is built for each handshake
+// so that the reloaded certificates take effect on new connections
+func (r *CertReloader) ServerConfig(clientAuth tls.ClientAuthType) *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			cert, caPool := r.current()
+			return &tls.Config{
+				MinVersion:   tls.VersionTLS12,
+				Certificates: []tls.Certificate{*cert},
+				ClientAuth:   clientAuth,
+				ClientCAs:    caPool,
+			}, nil
+		},
+	}
+}
+
+// ClientConfig returns the tls config of a client, which presents the certificate and verifies
+// the server against the current CA certificates
+func (r *CertReloader) ClientConfig() *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetClientCertificate: func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			cert, _ := r.current()
+			return cert, nil
+		},
+		// the server certificate is verified in VerifyConnection with the reloaded CA certificates
+		InsecureSkipVerify: true,
+		VerifyConnection: func(cs tls.ConnectionState) error {
+			if len(cs.PeerCertificates) == 0 {
+				return errors.New("server presents no certificate")
+			}
+			_, caPool := r.current()
+			opts := x509.VerifyOptions{
+				DNSName:       cs.ServerName,
+				Roots:         caPool,
+				Intermediates: x509.NewCertPool(),
+			}
+			for _, cert := range cs.PeerCertificates[1:] {
+				opts.Intermediates.AddCert(cert)
+			}
+			_, err := cs.PeerCertificates[0].Verify(opts)
+			return err
+		},
+	}
+}
+
+var (
+	reloader     *CertReloader
+	reloaderErr  error
+	reloaderOnce sync.Once
+)
+
+// getReloader returns the reloader of the certificates, the CA is only required to verify the client certificates,
+// which is the case of mutual tls
+func getReloader() (*CertReloader, error) {
+	reloaderOnce.Do(func() {
+		if Params.CaPemPath == "" && (Params.TLSMode == TLSModeMutual || Params.InternalTLSEnabled) {
+			reloaderErr = errors.New("mutual tls is enabled but tls.caPemPath is empty")
+			return
+		}
+		reloader, reloaderErr = NewCertReloader(Params.ServerPemPath, Params.ServerKeyPath, Params.CaPemPath, Params.ReloadInterval)
+	})
+	return reloader, reloaderErr
+}
+
+// ServerOption returns the credentials option of the grpc servers of coordinators and nodes,
+// clients must present a certificate signed by the CA when internal tls is enabled
+func ServerOption() (grpc.ServerOption, error) {
+	Params.Init()
+	if !Params.InternalTLSEnabled {
+		return grpc.EmptyServerOption{}, nil
+	}
+	r, err := getReloader()
+	if err != nil {
+		return nil, err
+	}
+	return grpc.Creds(credentials.NewTLS(r.ServerConfig(tls.RequireAndVerifyClientCert))), nil
+}
+
+// ProxyServerOption returns the credentials option of the proxy grpc server. The server serves both
+// the sdk and the coordinators, so client certificates are verified if given when only one-way tls
+// is configured for the public endpoint, and the internal calls are checked by VerifyInternalPeer
+func ProxyServerOption() (grpc.ServerOption, error) {
+	Params.Init()
+	if !Params.Enabled() {
+		return grpc.EmptyServerOption{}, nil
+	}
+	r, err := getReloader()
+	if err != nil {
+		return nil, err
+	}
+	clientAuth := tls.NoClientCert
+	if Params.TLSMode == TLSModeMutual {
+		clientAuth = tls.RequireAndVerifyClientCert
+	} else if Params.InternalTLSEnabled {
+		clientAuth = tls.VerifyClientCertIfGiven
+	}
+	return grpc.Creds(credentials.NewTLS(r.ServerConfig(clientAuth))), nil
+}
+
+// DialOption returns the credentials option used by the clients of coordinators and nodes
+func DialOption() (grpc.DialOption, error) {
+	Params.Init()
+	if !Params.InternalTLSEnabled {
+		return grpc.WithInsecure(), nil
+	}
+	r, err := getReloader()
+	if err != nil {
+		return nil, err
+	}
+	return grpc.WithTransportCredentials(credentials.NewTLS(r.ClientConfig())), nil
+}
+
+// HeaderInternalToken is the grpc metadata key carrying the internal token of the cluster members
+const HeaderInternalToken = "internal-token"
+
+// InternalCredentialEnabled returns whether the cluster members prove their identity to each other,
+// by the shared certificate of internal tls or by the internal token
+func InternalCredentialEnabled() bool {
+	Params.Init()
+	return Params.InternalTLSEnabled || Params.InternalToken != ""
+}
+
+// VerifyInternalPeer checks the caller is a member of the cluster. A member either presents a certificate of the
+// members over mutual tls, or carries the internal token in the metadata. Certificates merely signed by the CA are
+// not enough, since the CA may also sign the certificates of the sdk clients.
+func VerifyInternalPeer(ctx context.Context) error {
+	Params.Init()
+	if Params.InternalToken != "" {
+		md, ok := metadata.FromIncomingContext(ctx)
+		if ok {
+			for _, token := range md.Get(HeaderInternalToken) {
+				if subtle.ConstantTimeCompare([]byte(token), []byte(Params.InternalToken)) == 1 {
+					return nil
+				}
+			}
+		}
+	}
+	if Params.InternalTLSEnabled {
+		return verifyMemberCertificate(ctx)
+	}
+	return errors.New("the caller presents no internal credential")
+}
+
+func verifyMemberCertificate(ctx context.Context) error {
+	r, err := getReloader()
+	if err != nil {
+		return err
+	}
+	cert, caPool := r.current()
+	memberNames := Params.MemberNames
+	if len(memberNames) == 0 {
+		// the members share the names of the local certificate by default
+		if memberNames, err = certificateNames(cert); err != nil {
+			return err
+		}
+	}
+	return checkMemberCertificate(ctx, caPool, memberNames)
+}
+
+// certificateNames returns the subject alternative names of the certificate
+func certificateNames(cert *tls.Certificate) ([]string, error) {
+	if len(cert.Certificate) == 0 {
+		return nil, errors.New("no certificate is loaded")
+	}
+	leaf, err := x509.ParseCertificate(cert.Certificate[0])
+	if err != nil {
+		return nil, err
+	}
+	return leafNames(leaf), nil
+}
+
+func leafNames(leaf *x509.Certificate) []string {
+	names := append([]string{}, leaf.DNSNames...)
+	for _, uri := range leaf.URIs {
+		names = append(names, uri.String())
+	}
+	return names
+}
+
+// checkMemberCertificate checks the caller presents a certificate of the cluster members, which chains to the CA of
+// the cluster and carries one of the member names in its subject alternative names
+func checkMemberCertificate(ctx context.Context, caPool *x509.CertPool, memberNames []string) error {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return errors.New("fail to get peer from the context")
+	}
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(tlsInfo.State.PeerCertificates) == 0 {
+		return errors.New("the caller presents no certificate")
+	}
+	leaf := tlsInfo.State.PeerCertificates[0]
+	opts := x509.VerifyOptions{
+		Roots:         caPool,
+		Intermediates: x509.NewCertPool(),
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	for _, cert := range tlsInfo.State.PeerCertificates[1:] {
+		opts.Intermediates.AddCert(cert)
+	}
+	if _, err := leaf.Verify(opts); err != nil {
+		return fmt.Errorf("the caller presents a certificate not signed by the cluster CA, error = %w", err)
+	}
+	for _, name := range leafNames(leaf) {
+		for _, memberName := range memberNames {
+			if name == memberName {
+				return nil
+			}
+		}
+	}
+	return errors.New("the caller presents a certificate which is not the one of the cluster members")
+}
+
+// InternalUnaryServerInterceptor rejects the calls to the methods with the prefix, such as the internal
+// service of proxy, from the callers which are not members of the cluster, once the internal credential is enabled
+func InternalUnaryServerInterceptor(methodPrefix string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if strings.HasPrefix(info.FullMethod, methodPrefix) && InternalCredentialEnabled() {
+			if err := VerifyInternalPeer(ctx); err != nil {
+				return nil, status.Error(codes.Unauthenticated, err.Error())
+			}
+		}
+		return handler(ctx, req)
+	}
+}
+
+// InternalTokenUnaryClientInterceptor attaches the internal token to the outgoing calls if it's configured
+func InternalTokenUnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		Params.Init()
+		if Params.InternalToken != "" {
+			ctx = metadata.AppendToOutgoingContext(ctx, HeaderInternalToken, Params.InternalToken)
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
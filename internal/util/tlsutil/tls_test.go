@@ -0,0 +1,373 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package tlsutil
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+type testCerts struct {
+	certPath string
+	keyPath  string
+	caPath   string
+}
+
+// writeCerts generates a CA and a key pair signed by it, usable by both servers and clients
+func writeCerts(t *testing.T, dir string) testCerts {
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	caDer, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+	require.NoError(t, err)
+	caCert, err := x509.ParseCertificate(caDer)
+	require.NoError(t, err)
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: "localhost"},
+		DNSNames:     []string{"localhost"},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, caCert, &key.PublicKey, caKey)
+	require.NoError(t, err)
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	certs := testCerts{
+		certPath: filepath.Join(dir, "server.pem"),
+		keyPath:  filepath.Join(dir, "server.key"),
+		caPath:   filepath.Join(dir, "ca.pem"),
+	}
+	write := func(path, blockType string, bytes []byte) {
+		err := ioutil.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: bytes}), 0600)
+		require.NoError(t, err)
+	}
+	write(certs.certPath, "CERTIFICATE", der)
+	write(certs.keyPath, "EC PRIVATE KEY", keyDer)
+	write(certs.caPath, "CERTIFICATE", caDer)
+	return certs
+}
+
+func startServer(t *testing.T, config *tls.Config) (string, func()) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server := grpc.NewServer(grpc.Creds(credentials.NewTLS(config)))
+	grpc_health_v1.RegisterHealthServer(server, health.NewServer())
+	go server.Serve(lis)
+	return lis.Addr().String(), server.Stop
+}
+
+func checkHealth(addr string, config *tls.Config) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	conn, err := grpc.DialContext(ctx, addr, grpc.WithTransportCredentials(credentials.NewTLS(config)))
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	_, err = grpc_health_v1.NewHealthClient(conn).Check(ctx, &grpc_health_v1.HealthCheckRequest{})
+	return err
+}
+
+func TestNewCertReloader(t *testing.T) {
+	dir := t.TempDir()
+	certs := writeCerts(t, dir)
+
+	_, err := NewCertReloader("", certs.keyPath, certs.caPath, time.Minute)
+	assert.Error(t, err)
+
+	_, err = NewCertReloader(filepath.Join(dir, "not_exist.pem"), certs.keyPath, certs.caPath, time.Minute)
+	assert.Error(t, err)
+
+	_, err = NewCertReloader(certs.caPath, certs.keyPath, certs.caPath, time.Minute)
+	assert.Error(t, err)
+
+	_, err = NewCertReloader(certs.certPath, certs.keyPath, certs.keyPath, time.Minute)
+	assert.Error(t, err)
+
+	r, err := NewCertReloader(certs.certPath, certs.keyPath, certs.caPath, time.Minute)
+	assert.NoError(t, err)
+	cert, caPool := r.current()
+	assert.NotNil(t, cert)
+	assert.NotNil(t, caPool)
+}
+
+func TestCertReloader_MutualTLS(t *testing.T) {
+	certs := writeCerts(t, t.TempDir())
+	r, err := NewCertReloader(certs.certPath, certs.keyPath, certs.caPath, time.Minute)
+	require.NoError(t, err)
+
+	addr, stop := startServer(t, r.ServerConfig(tls.RequireAndVerifyClientCert))
+	defer stop()
+
+	assert.NoError(t, checkHealth(addr, r.ClientConfig()))
+
+	// the client without certificate is rejected
+	assert.Error(t, checkHealth(addr, &tls.Config{InsecureSkipVerify: true}))
+
+	// the client trusting another CA rejects the server
+	other := writeCerts(t, t.TempDir())
+	otherReloader, err := NewCertReloader(other.certPath, other.keyPath, other.caPath, time.Minute)
+	require.NoError(t, err)
+	assert.Error(t, checkHealth(addr, otherReloader.ClientConfig()))
+}
+
+func TestCertReloader_OneWayTLS(t *testing.T) {
+	certs := writeCerts(t, t.TempDir())
+	// the CA isn't required to serve one-way tls
+	r, err := NewCertReloader(certs.certPath, certs.keyPath, "", time.Minute)
+	require.NoError(t, err)
+	_, serverCAPool := r.current()
+	assert.Nil(t, serverCAPool)
+
+	addr, stop := startServer(t, r.ServerConfig(tls.NoClientCert))
+	defer stop()
+
+	caPem, err := ioutil.ReadFile(certs.caPath)
+	require.NoError(t, err)
+	caPool := x509.NewCertPool()
+	require.True(t, caPool.AppendCertsFromPEM(caPem))
+	assert.NoError(t, checkHealth(addr, &tls.Config{RootCAs: caPool, ServerName: "localhost"}))
+}
+
+func TestGetReloader(t *testing.T) {
+	Params.Init()
+	certs := writeCerts(t, t.TempDir())
+	oldParams := Params
+	defer func() {
+		Params = oldParams
+		reloaderOnce = sync.Once{}
+	}()
+	Params.ServerPemPath = certs.certPath
+	Params.ServerKeyPath = certs.keyPath
+	Params.CaPemPath = ""
+
+	for _, c := range []struct {
+		tlsMode     int
+		internalTLS bool
+		ok          bool
+	}{
+		{TLSModeOneWay, false, true},
+		{TLSModeMutual, false, false},
+		{TLSModeOneWay, true, false},
+	} {
+		reloaderOnce = sync.Once{}
+		Params.TLSMode = c.tlsMode
+		Params.InternalTLSEnabled = c.internalTLS
+		_, err := getReloader()
+		assert.Equal(t, c.ok, err == nil, "tlsMode %d, internalTLS %v", c.tlsMode, c.internalTLS)
+	}
+
+	reloaderOnce = sync.Once{}
+	Params.CaPemPath = certs.caPath
+	_, err := getReloader()
+	assert.NoError(t, err)
+}
+
+func TestCertReloader_Reload(t *testing.T) {
+	dir := t.TempDir()
+	certs := writeCerts(t, dir)
+	r, err := NewCertReloader(certs.certPath, certs.keyPath, certs.caPath, 0)
+	require.NoError(t, err)
+	oldCert, _ := r.current()
+
+	// broken files are ignored, the current certificates are kept
+	require.NoError(t, ioutil.WriteFile(certs.caPath, []byte("broken"), 0600))
+	future := time.Now().Add(time.Minute)
+	require.NoError(t, os.Chtimes(certs.caPath, future, future))
+	cert, _ := r.current()
+	assert.Equal(t, oldCert, cert)
+
+	// rotated files are reloaded
+	writeCerts(t, dir)
+	future = future.Add(time.Minute)
+	for _, path := range []string{certs.certPath, certs.keyPath, certs.caPath} {
+		require.NoError(t, os.Chtimes(path, future, future))
+	}
+	cert, _ = r.current()
+	assert.NotEqual(t, oldCert.Certificate[0], cert.Certificate[0])
+
+	addr, stop := startServer(t, r.ServerConfig(tls.RequireAndVerifyClientCert))
+	defer stop()
+	assert.NoError(t, checkHealth(addr, r.ClientConfig()))
+}
+
+func TestVerifyInternalPeer(t *testing.T) {
+	Params.Init()
+	defer func() {
+		Params.InternalTLSEnabled = false
+		Params.InternalToken = ""
+	}()
+
+	// no internal credential is configured
+	Params.InternalTLSEnabled = false
+	assert.False(t, InternalCredentialEnabled())
+	assert.Error(t, VerifyInternalPeer(context.Background()))
+	interceptor := InternalUnaryServerInterceptor("/internal/")
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "ok", nil
+	}
+	resp, err := interceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/internal/Foo"}, handler)
+	assert.NoError(t, err)
+	assert.Equal(t, "ok", resp)
+
+	Params.InternalTLSEnabled = true
+	assert.Error(t, VerifyInternalPeer(context.Background()))
+
+	_, err = interceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/internal/Foo"}, handler)
+	assert.Error(t, err)
+	resp, err = interceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/public/Foo"}, handler)
+	assert.NoError(t, err)
+	assert.Equal(t, "ok", resp)
+
+	// internal token
+	Params.InternalTLSEnabled = false
+	Params.InternalToken = "token"
+	assert.True(t, InternalCredentialEnabled())
+	assert.Error(t, VerifyInternalPeer(metadata.NewIncomingContext(context.Background(), metadata.Pairs(HeaderInternalToken, "wrong"))))
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(HeaderInternalToken, "token"))
+	assert.NoError(t, VerifyInternalPeer(ctx))
+	resp, err = interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: "/internal/Foo"}, handler)
+	assert.NoError(t, err)
+	assert.Equal(t, "ok", resp)
+
+	// the token is attached to the outgoing calls
+	clientInterceptor := InternalTokenUnaryClientInterceptor()
+	err = clientInterceptor(context.Background(), "/internal/Foo", nil, nil, nil,
+		func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+			md, ok := metadata.FromOutgoingContext(ctx)
+			assert.True(t, ok)
+			assert.Equal(t, []string{"token"}, md.Get(HeaderInternalToken))
+			return nil
+		})
+	assert.NoError(t, err)
+}
+
+// newTestCertificate generates a certificate with the names, signed by the parent, or self-signed as a CA if the
+// parent is nil
+func newTestCertificate(t *testing.T, parent *x509.Certificate, parentKey *ecdsa.PrivateKey, names ...string) (*x509.Certificate, *ecdsa.PrivateKey) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: "test"},
+		DNSNames:     names,
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	if parent == nil {
+		template.IsCA = true
+		template.KeyUsage = x509.KeyUsageCertSign
+		template.ExtKeyUsage = nil
+		template.BasicConstraintsValid = true
+		parent, parentKey = template, key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return cert, key
+}
+
+func TestCheckMemberCertificate(t *testing.T) {
+	ca, caKey := newTestCertificate(t, nil, nil)
+	caPool := x509.NewCertPool()
+	caPool.AddCert(ca)
+	// the members may present different certificates, such as the ones rotated or issued per node
+	member1, _ := newTestCertificate(t, ca, caKey, "querynode-1", "milvus-member")
+	member2, _ := newTestCertificate(t, ca, caKey, "milvus-member")
+	client, _ := newTestCertificate(t, ca, caKey, "sdk-client")
+	otherCA, otherCAKey := newTestCertificate(t, nil, nil)
+	forged, _ := newTestCertificate(t, otherCA, otherCAKey, "milvus-member")
+
+	peerContext := func(cert *x509.Certificate) context.Context {
+		state := tls.ConnectionState{}
+		if cert != nil {
+			state.PeerCertificates = []*x509.Certificate{cert}
+		}
+		return peer.NewContext(context.Background(), &peer.Peer{AuthInfo: credentials.TLSInfo{State: state}})
+	}
+
+	memberNames := []string{"milvus-member"}
+	assert.Error(t, checkMemberCertificate(context.Background(), caPool, memberNames))
+	assert.Error(t, checkMemberCertificate(peerContext(nil), caPool, memberNames))
+	// signed by the cluster CA, but not a certificate of the members
+	assert.Error(t, checkMemberCertificate(peerContext(client), caPool, memberNames))
+	// a member name, but not signed by the cluster CA
+	assert.Error(t, checkMemberCertificate(peerContext(forged), caPool, memberNames))
+	assert.NoError(t, checkMemberCertificate(peerContext(member1), caPool, memberNames))
+	assert.NoError(t, checkMemberCertificate(peerContext(member2), caPool, memberNames))
+
+	// the names of the local certificate are the member names by default
+	certs := writeCerts(t, t.TempDir())
+	local, err := tls.LoadX509KeyPair(certs.certPath, certs.keyPath)
+	require.NoError(t, err)
+	names, err := certificateNames(&local)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"localhost"}, names)
+	_, err = certificateNames(&tls.Certificate{})
+	assert.Error(t, err)
+}
+
+func TestOptions(t *testing.T) {
+	Params.Init()
+	assert.Equal(t, TLSModeDisabled, Params.TLSMode)
+	assert.False(t, Params.InternalTLSEnabled)
+	assert.Equal(t, time.Minute, Params.ReloadInterval)
+
+	_, err := ServerOption()
+	assert.NoError(t, err)
+	_, err = ProxyServerOption()
+	assert.NoError(t, err)
+	_, err = DialOption()
+	assert.NoError(t, err)
+}
@@ -15,6 +15,7 @@ import (
 	"path"
 	"time"
 
+	"github.com/milvus-io/milvus/internal/kv"
 	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
 )
 
@@ -50,6 +51,6 @@ func Mod24H(ts uint64) uint64 {
 	return (physical << logicalBits) | logical
 }
 
-func NewTSOKVBase(etcdEndpoints []string, tsoRoot, subPath string) (*etcdkv.EtcdKV, error) {
-	return etcdkv.NewEtcdKV(etcdEndpoints, path.Join(tsoRoot, subPath))
+func NewTSOKVBase(etcdEndpoints []string, tsoRoot, subPath string) (kv.MetaKv, error) {
+	return etcdkv.NewMetaKv(etcdEndpoints, path.Join(tsoRoot, subPath))
 }